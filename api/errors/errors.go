@@ -76,6 +76,12 @@ var ErrInvalidEpoch = errors.New("invalid epoch parameter")
 // ErrValidationEmptyBlockHash signals an empty block hash was provided
 var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 
+// ErrEnqueueTransaction signals an error happening when trying to add a transaction in the transactions queue
+var ErrEnqueueTransaction = errors.New("adding transaction in the transactions queue failed")
+
+// ErrCheckTransaction signals an error happening when trying to check a transaction against the validity rules
+var ErrCheckTransaction = errors.New("checking transaction failed")
+
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
@@ -109,12 +115,33 @@ var ErrValidationEmptyAddress = errors.New("address is empty")
 // ErrValidationEmptyKey signals that an empty key was provided
 var ErrValidationEmptyKey = errors.New("key is empty")
 
+// ErrValidationEmptyFromNonce signals that the starting nonce of an events query was not provided
+var ErrValidationEmptyFromNonce = errors.New("fromNonce is empty")
+
+// ErrValidationInvalidEventsCursor signals that an invalid cursor was provided for an events query
+var ErrValidationInvalidEventsCursor = errors.New("invalid events cursor")
+
+// ErrQueryEvents signals an error happening when trying to query the indexed events
+var ErrQueryEvents = errors.New("querying events failed")
+
 // ErrGetProof signals an error happening when trying to compute a Merkle proof
 var ErrGetProof = errors.New("getting proof failed")
 
 // ErrVerifyProof signals an error happening when trying to verify a Merkle proof
 var ErrVerifyProof = errors.New("verifying proof failed")
 
+// ErrValidationEmptyAddresses signals that an empty list of addresses was provided
+var ErrValidationEmptyAddresses = errors.New("addresses list is empty")
+
+// ErrValidationTooManyAddresses signals that too many addresses were provided
+var ErrValidationTooManyAddresses = errors.New("too many addresses")
+
+// ErrValidationValuesMismatch signals that the number of the provided values differs from the number of addresses
+var ErrValidationValuesMismatch = errors.New("the number of values does not match the number of addresses")
+
+// ErrValidationInvalidMaxLeaves signals that an invalid maximum number of leaves was provided
+var ErrValidationInvalidMaxLeaves = errors.New("invalid maxLeaves")
+
 // ErrNilHttpServer signals that a nil http server has been provided
 var ErrNilHttpServer = errors.New("nil http server")
 
@@ -151,6 +178,12 @@ var ErrFetchingLatestNonceCannotIncludeFields = errors.New("fetching latest nonc
 // ErrFetchingNonceGapsCannotIncludeFields signals that an error happened when trying to fetch nonce gaps
 var ErrFetchingNonceGapsCannotIncludeFields = errors.New("fetching nonce gaps cannot include fields")
 
+// ErrEmptySenderToGetSenderScore signals that an error happened when trying to fetch the score of a sender
+var ErrEmptySenderToGetSenderScore = errors.New("empty sender to get sender score")
+
+// ErrFetchingSenderScoreCannotIncludeFields signals that an error happened when trying to fetch the score of a sender
+var ErrFetchingSenderScoreCannotIncludeFields = errors.New("fetching sender score cannot include fields")
+
 // ErrInvalidFields signals that invalid fields were provided
 var ErrInvalidFields = errors.New("invalid fields")
 
@@ -174,3 +207,9 @@ var ErrGetWaitingManagedKeys = errors.New("error getting the waiting managed key
 
 // ErrGetWaitingEpochsLeftForPublicKey signals that an error occurred while getting the waiting epochs left for public key
 var ErrGetWaitingEpochsLeftForPublicKey = errors.New("error getting the waiting epochs left for public key")
+
+// ErrGetTrieStatistics signals that an error occurred while getting the trie statistics
+var ErrGetTrieStatistics = errors.New("error getting the trie statistics")
+
+// ErrValidationInvalidNumLargestDataTries signals that an invalid number of largest data tries was provided
+var ErrValidationInvalidNumLargestDataTries = errors.New("invalid numLargestDataTries")
@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/logs"
+	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
 	"gopkg.in/go-playground/validator.v8"
 )
@@ -57,6 +58,21 @@ func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
 	return false
 }
 
+func isSubscriptionsRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
+	subscriptionsConfig, ok := routesConfig.APIPackages["subscriptions"]
+	if !ok {
+		return false
+	}
+
+	for _, cfg := range subscriptionsConfig.Routes {
+		if cfg.Name == "/subscriptions" && cfg.Open {
+			return true
+		}
+	}
+
+	return false
+}
+
 func registerValidators() error {
 	validators := []validatorInput{
 		{
@@ -99,3 +115,27 @@ func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
 		ls.StartSendingBlocking()
 	})
 }
+
+func registerSubscriptionsWsRoute(ws *gin.Engine, subscriptionsHub shared.SubscriptionsHandler) {
+	upgrader := websocket.Upgrader{}
+
+	ws.GET("/subscriptions", func(c *gin.Context) {
+		upgrader.CheckOrigin = func(r *http.Request) bool {
+			return true
+		}
+
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			log.Error(err.Error())
+			return
+		}
+
+		err = subscriptionsHub.HandleConnection(conn)
+		if err != nil {
+			log.Debug("cannot handle subscriptions connection", "error", err.Error())
+			closeMessage := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, err.Error())
+			_ = conn.WriteMessage(websocket.CloseMessage, closeMessage)
+			_ = conn.Close()
+		}
+	})
+}
@@ -53,3 +53,23 @@ func TestCommon_isLogRouteEnabled(t *testing.T) {
 	require.True(t, isLogRouteEnabled(routesConfig))
 	require.False(t, isLogRouteEnabled(config.ApiRoutesConfig{}))
 }
+
+func TestCommon_isSubscriptionsRouteEnabled(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, isSubscriptionsRouteEnabled(config.ApiRoutesConfig{}))
+
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"subscriptions": {
+				Routes: []config.RouteConfig{
+					{Name: "/subscriptions", Open: false},
+				},
+			},
+		},
+	}
+	require.False(t, isSubscriptionsRouteEnabled(routesConfig))
+
+	routesConfig.APIPackages["subscriptions"].Routes[0].Open = true
+	require.True(t, isSubscriptionsRouteEnabled(routesConfig))
+}
@@ -31,16 +31,19 @@ type ArgsNewWebServer struct {
 	Facade          shared.FacadeHandler
 	ApiConfig       config.ApiRoutesConfig
 	AntiFloodConfig config.WebServerAntifloodConfig
+	// SubscriptionsHub is optional, the subscriptions route is not registered if it is nil
+	SubscriptionsHub shared.SubscriptionsHandler
 }
 
 type webServer struct {
 	sync.RWMutex
-	facade          shared.FacadeHandler
-	apiConfig       config.ApiRoutesConfig
-	antiFloodConfig config.WebServerAntifloodConfig
-	httpServer      shared.HttpServerCloser
-	groups          map[string]shared.GroupHandler
-	cancelFunc      func()
+	facade           shared.FacadeHandler
+	apiConfig        config.ApiRoutesConfig
+	antiFloodConfig  config.WebServerAntifloodConfig
+	subscriptionsHub shared.SubscriptionsHandler
+	httpServer       shared.HttpServerCloser
+	groups           map[string]shared.GroupHandler
+	cancelFunc       func()
 }
 
 // NewGinWebServerHandler returns a new instance of webServer
@@ -51,9 +54,10 @@ func NewGinWebServerHandler(args ArgsNewWebServer) (*webServer, error) {
 	}
 
 	return &webServer{
-		facade:          args.Facade,
-		antiFloodConfig: args.AntiFloodConfig,
-		apiConfig:       args.ApiConfig,
+		facade:           args.Facade,
+		antiFloodConfig:  args.AntiFloodConfig,
+		apiConfig:        args.ApiConfig,
+		subscriptionsHub: args.SubscriptionsHub,
 	}, nil
 }
 
@@ -162,6 +166,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["block"] = blockGroup
 
+	eventsGroup, err := groups.NewEventsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["events"] = eventsGroup
+
 	internalBlockGroup, err := groups.NewInternalBlockGroup(ws.facade)
 	if err != nil {
 		return err
@@ -227,6 +237,10 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 		registerLoggerWsRoute(ginRouter, marshalizerForLogs)
 	}
 
+	if !check.IfNil(ws.subscriptionsHub) && isSubscriptionsRouteEnabled(ws.apiConfig) {
+		registerSubscriptionsWsRoute(ginRouter, ws.subscriptionsHub)
+	}
+
 	if ws.facade.PprofEnabled() {
 		pprof.Register(ginRouter)
 	}
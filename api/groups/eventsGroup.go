@@ -0,0 +1,222 @@
+package groups
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	queryEventsEndpoint = "/events/query"
+	queryEventsPath     = "/query"
+
+	urlParamAddress    = "address"
+	urlParamIdentifier = "identifier"
+	urlParamTopics     = "topics"
+	urlParamFromNonce  = "fromNonce"
+	urlParamToNonce    = "toNonce"
+	urlParamCursor     = "cursor"
+	urlParamSize       = "size"
+
+	topicsSeparator        = ","
+	cursorSeparator        = "-"
+	defaultEventsQuerySize = uint32(20)
+)
+
+// eventsFacadeHandler defines the methods to be implemented by a facade for handling events requests
+type eventsFacadeHandler interface {
+	QueryEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+type eventsGroup struct {
+	*baseGroup
+	facade    eventsFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewEventsGroup returns a new instance of eventsGroup
+func NewEventsGroup(facade eventsFacadeHandler) (*eventsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for events group", errors.ErrNilFacadeHandler)
+	}
+
+	eg := &eventsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    queryEventsPath,
+			Method:  http.MethodGet,
+			Handler: eg.queryEvents,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(queryEventsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+	}
+	eg.endpoints = endpoints
+
+	return eg, nil
+}
+
+// queryEvents returns a page of the indexed events matching the filters provided as url parameters. The next page is
+// requested by providing the returned cursor
+func (eg *eventsGroup) queryEvents(c *gin.Context) {
+	options, err := parseEventsQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	response, err := eg.getFacade().QueryEvents(options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrQueryEvents, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"events": response.Events, "nextCursor": formatEventsCursor(response.NextCursor)})
+}
+
+func parseEventsQueryOptions(c *gin.Context) (common.EventsQueryOptions, error) {
+	fromNonce, err := parseUint64UrlParam(c, urlParamFromNonce)
+	if err != nil {
+		return common.EventsQueryOptions{}, err
+	}
+	if !fromNonce.HasValue {
+		return common.EventsQueryOptions{}, errors.ErrValidationEmptyFromNonce
+	}
+
+	toNonce, err := parseUint64UrlParam(c, urlParamToNonce)
+	if err != nil {
+		return common.EventsQueryOptions{}, err
+	}
+
+	after, err := parseEventsCursor(c.Query(urlParamCursor))
+	if err != nil {
+		return common.EventsQueryOptions{}, err
+	}
+
+	size, err := parseUint32UrlParam(c, urlParamSize)
+	if err != nil {
+		return common.EventsQueryOptions{}, err
+	}
+	if !size.HasValue {
+		size.Value = defaultEventsQuerySize
+	}
+
+	topics, err := parseTopicsUrlParam(c)
+	if err != nil {
+		return common.EventsQueryOptions{}, err
+	}
+
+	return common.EventsQueryOptions{
+		Address:    c.Query(urlParamAddress),
+		Identifier: c.Query(urlParamIdentifier),
+		Topics:     topics,
+		FromNonce:  fromNonce.Value,
+		ToNonce:    toNonce,
+		After:      after,
+		Limit:      size.Value,
+	}, nil
+}
+
+func parseTopicsUrlParam(c *gin.Context) ([][]byte, error) {
+	param := c.Query(urlParamTopics)
+	if len(param) == 0 {
+		return nil, nil
+	}
+
+	hexTopics := strings.Split(param, topicsSeparator)
+	topics := make([][]byte, 0, len(hexTopics))
+	for _, hexTopic := range hexTopics {
+		topic, err := hex.DecodeString(hexTopic)
+		if err != nil {
+			return nil, fmt.Errorf("%w for topic %s", err, hexTopic)
+		}
+
+		topics = append(topics, topic)
+	}
+
+	return topics, nil
+}
+
+// parseEventsCursor decodes a cursor formatted as <block nonce>-<entry index>
+func parseEventsCursor(param string) (*common.EventsQueryCursor, error) {
+	if len(param) == 0 {
+		return nil, nil
+	}
+
+	parts := strings.Split(param, cursorSeparator)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("%w: %s", errors.ErrValidationInvalidEventsCursor, param)
+	}
+
+	blockNonce, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errors.ErrValidationInvalidEventsCursor, param)
+	}
+
+	entryIndex, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errors.ErrValidationInvalidEventsCursor, param)
+	}
+
+	return &common.EventsQueryCursor{
+		BlockNonce: blockNonce,
+		EntryIndex: uint32(entryIndex),
+	}, nil
+}
+
+func formatEventsCursor(cursor *common.EventsQueryCursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d%s%d", cursor.BlockNonce, cursorSeparator, cursor.EntryIndex)
+}
+
+func (eg *eventsGroup) getFacade() eventsFacadeHandler {
+	eg.mutFacade.RLock()
+	defer eg.mutFacade.RUnlock()
+
+	return eg.facade
+}
+
+// UpdateFacade will update the facade
+func (eg *eventsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(eventsFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	eg.mutFacade.Lock()
+	eg.facade = castFacade
+	eg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eg *eventsGroup) IsInterfaceNil() bool {
+	return eg == nil
+}
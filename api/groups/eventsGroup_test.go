@@ -0,0 +1,296 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type queryEventsResponseData struct {
+	Events     []*common.IndexedEventAPIResponse `json:"events"`
+	NextCursor string                            `json:"nextCursor"`
+}
+
+type queryEventsResponse struct {
+	Data  queryEventsResponseData `json:"data"`
+	Error string                  `json:"error"`
+	Code  string                  `json:"code"`
+}
+
+func TestNewEventsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		eg, err := groups.NewEventsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, eg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		eg, err := groups.NewEventsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, eg)
+	})
+}
+
+func TestEventsGroup_QueryEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing fromNonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		testEventsGroupErrorScenario(t, "/events/query?identifier=ESDTTransfer", http.StatusBadRequest, apiErrors.ErrValidationEmptyFromNonce)
+	})
+	t.Run("invalid toNonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		testEventsGroupErrorScenario(t, "/events/query?identifier=ESDTTransfer&fromNonce=1&toNonce=abc", http.StatusBadRequest, apiErrors.ErrValidation)
+	})
+	t.Run("invalid size should error", func(t *testing.T) {
+		t.Parallel()
+
+		testEventsGroupErrorScenario(t, "/events/query?identifier=ESDTTransfer&fromNonce=1&size=-1", http.StatusBadRequest, apiErrors.ErrValidation)
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		t.Parallel()
+
+		testEventsGroupErrorScenario(t, "/events/query?identifier=ESDTTransfer&fromNonce=1&cursor=5", http.StatusBadRequest, apiErrors.ErrValidationInvalidEventsCursor)
+		testEventsGroupErrorScenario(t, "/events/query?identifier=ESDTTransfer&fromNonce=1&cursor=a-1", http.StatusBadRequest, apiErrors.ErrValidationInvalidEventsCursor)
+		testEventsGroupErrorScenario(t, "/events/query?identifier=ESDTTransfer&fromNonce=1&cursor=5-4294967296", http.StatusBadRequest, apiErrors.ErrValidationInvalidEventsCursor)
+	})
+	t.Run("invalid topic should error", func(t *testing.T) {
+		t.Parallel()
+
+		testEventsGroupErrorScenario(t, "/events/query?fromNonce=1&topics=aa,zz", http.StatusBadRequest, apiErrors.ErrValidation)
+	})
+	t.Run("too many requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				assert.Equal(t, "/events/query", endpoint)
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool { return false },
+				}, true
+			},
+			QueryEventsCalled: func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		eventsGroup, err := groups.NewEventsGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(eventsGroup, "events", getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/events/query?identifier=ESDTTransfer&fromNonce=1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrTooManyRequests.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			QueryEventsCalled: func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		eventsGroup, err := groups.NewEventsGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(eventsGroup, "events", getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/events/query?identifier=ESDTTransfer&fromNonce=1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrQueryEvents.Error())
+		assert.Contains(t, response.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedEvents := []*common.IndexedEventAPIResponse{
+			{
+				TxHash:     "aabb",
+				BlockNonce: 7,
+				Identifier: "ESDTTransfer",
+				Topics:     [][]byte{[]byte("token")},
+			},
+		}
+		var providedOptions common.EventsQueryOptions
+		facade := &mock.FacadeStub{
+			QueryEventsCalled: func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+				providedOptions = options
+				return &common.EventsQueryAPIResponse{
+					Events:     expectedEvents,
+					NextCursor: &common.EventsQueryCursor{BlockNonce: 8, EntryIndex: 1},
+				}, nil
+			},
+		}
+
+		eventsGroup, err := groups.NewEventsGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(eventsGroup, "events", getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/events/query?address=erd1qqq&identifier=ESDTTransfer&topics=746f6b656e,01&fromNonce=5&toNonce=10&cursor=6-4&size=3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := queryEventsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, expectedEvents, response.Data.Events)
+		assert.Equal(t, "8-1", response.Data.NextCursor)
+		assert.Equal(t, "erd1qqq", providedOptions.Address)
+		assert.Equal(t, "ESDTTransfer", providedOptions.Identifier)
+		assert.Equal(t, [][]byte{[]byte("token"), {1}}, providedOptions.Topics)
+		assert.Equal(t, uint64(5), providedOptions.FromNonce)
+		assert.True(t, providedOptions.ToNonce.HasValue)
+		assert.Equal(t, uint64(10), providedOptions.ToNonce.Value)
+		assert.Equal(t, &common.EventsQueryCursor{BlockNonce: 6, EntryIndex: 4}, providedOptions.After)
+		assert.Equal(t, uint32(3), providedOptions.Limit)
+	})
+	t.Run("should apply the default size", func(t *testing.T) {
+		t.Parallel()
+
+		var providedOptions common.EventsQueryOptions
+		facade := &mock.FacadeStub{
+			QueryEventsCalled: func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+				providedOptions = options
+				return &common.EventsQueryAPIResponse{
+					Events: make([]*common.IndexedEventAPIResponse, 0),
+				}, nil
+			},
+		}
+
+		eventsGroup, err := groups.NewEventsGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(eventsGroup, "events", getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/events/query?identifier=ESDTTransfer&fromNonce=5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := queryEventsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.False(t, providedOptions.ToNonce.HasValue)
+		assert.Nil(t, providedOptions.After)
+		assert.Equal(t, uint32(20), providedOptions.Limit)
+		assert.Empty(t, response.Data.NextCursor)
+	})
+}
+
+func TestEventsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		eventsGroup, err := groups.NewEventsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		err = eventsGroup.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		eventsGroup, err := groups.NewEventsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		err = eventsGroup.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		eventsGroup, err := groups.NewEventsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		newFacade := &mock.FacadeStub{
+			QueryEventsCalled: func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		err = eventsGroup.UpdateFacade(newFacade)
+		require.NoError(t, err)
+
+		ws := startWebServer(eventsGroup, "events", getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/events/query?identifier=ESDTTransfer&fromNonce=1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+}
+
+func TestEventsGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	eventsGroup, _ := groups.NewEventsGroup(nil)
+	require.True(t, eventsGroup.IsInterfaceNil())
+
+	eventsGroup, _ = groups.NewEventsGroup(&mock.FacadeStub{})
+	require.False(t, eventsGroup.IsInterfaceNil())
+}
+
+func testEventsGroupErrorScenario(t *testing.T, url string, expectedCode int, expectedErr error) {
+	eventsGroup, err := groups.NewEventsGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(eventsGroup, "events", getEventsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, expectedCode, resp.Code)
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func getEventsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "/query", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/debug"
@@ -33,6 +34,14 @@ const (
 	eligibleManagedKeys       = "/managed-keys/eligible"
 	waitingManagedKeys        = "/managed-keys/waiting"
 	epochsLeftInWaiting       = "/waiting-epochs-left/:key"
+	trieStatisticsPath        = "/trie-statistics/:roothash"
+	trieStatisticsEndpoint    = "/node/trie-statistics/:roothash"
+)
+
+const (
+	urlParamNumLargestDataTries = "numLargestDataTries"
+	defaultNumLargestDataTries  = 10
+	maxNumLargestDataTries      = 100
 )
 
 // nodeFacadeHandler defines the methods to be implemented by a facade for node requests
@@ -49,6 +58,8 @@ type nodeFacadeHandler interface {
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
+	GetTrieStorageReport(rootHash string, numLargestDataTries int) (*common.TrieStorageReport, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
 
@@ -151,6 +162,17 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.waitingEpochsLeft,
 		},
+		{
+			Path:    trieStatisticsPath,
+			Method:  http.MethodGet,
+			Handler: ng.trieStatistics,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(trieStatisticsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -479,6 +501,37 @@ func (ng *nodeGroup) waitingEpochsLeft(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"epochsLeft": epochsLeft})
 }
 
+// trieStatistics walks the state trie with the provided root hash, together with the data tries of its accounts,
+// and returns the statistics of the storage used by them
+func (ng *nodeGroup) trieStatistics(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return
+	}
+
+	numLargestDataTries, err := parseUint32UrlParam(c, urlParamNumLargestDataTries)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if !numLargestDataTries.HasValue {
+		numLargestDataTries.Value = defaultNumLargestDataTries
+	}
+	if numLargestDataTries.Value > maxNumLargestDataTries {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationInvalidNumLargestDataTries)
+		return
+	}
+
+	report, err := ng.getFacade().GetTrieStorageReport(rootHash, int(numLargestDataTries.Value))
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetTrieStatistics, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"statistics": report})
+}
+
 func (ng *nodeGroup) getFacade() nodeFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
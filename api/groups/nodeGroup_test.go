@@ -109,6 +109,13 @@ type waitingEpochsLeftResponse struct {
 	generalResponse
 }
 
+type trieStatisticsResponse struct {
+	Data struct {
+		Statistics *common.TrieStorageReport `json:"statistics"`
+	} `json:"data"`
+	generalResponse
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -974,6 +981,131 @@ func TestNodeGroup_WaitingEpochsLeft(t *testing.T) {
 	})
 }
 
+func TestNodeGroup_TrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of data tries should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/trie-statistics/roothash?numLargestDataTries=1000", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationInvalidNumLargestDataTries.Error()))
+	})
+	t.Run("too many requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				assert.Equal(t, "/node/trie-statistics/:roothash", endpoint)
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool { return false },
+				}, true
+			},
+			GetTrieStorageReportCalled: func(rootHash string, numLargestDataTries int) (*common.TrieStorageReport, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/trie-statistics/roothash", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrTooManyRequests.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTrieStorageReportCalled: func(rootHash string, numLargestDataTries int) (*common.TrieStorageReport, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/trie-statistics/roothash", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTrieStatistics.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedReport := &common.TrieStorageReport{
+			RootHash: "roothash",
+			MainTrie: &common.TrieNodesStatistics{
+				NumNodes:        3,
+				NumBranchNodes:  1,
+				NumLeafNodes:    2,
+				TotalSize:       100,
+				MaxDepth:        1,
+				NumNodesByDepth: []uint64{1, 2},
+			},
+			DataTries:    &common.TrieNodesStatistics{NumNodesByDepth: []uint64{}},
+			NumDataTries: 1,
+			LargestDataTries: []*common.DataTrieStorageUsage{
+				{
+					Address:             "erd1address",
+					RootHash:            "dataTrieRootHash",
+					TrieNodesStatistics: &common.TrieNodesStatistics{NumNodes: 1, NumNodesByDepth: []uint64{1}},
+				},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetTrieStorageReportCalled: func(rootHash string, numLargestDataTries int) (*common.TrieStorageReport, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, 5, numLargestDataTries)
+				return providedReport, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/trie-statistics/roothash?numLargestDataTries=5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &trieStatisticsResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, providedReport, response.Data.Statistics)
+	})
+}
+
 func TestNodeGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -1087,6 +1219,7 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/managed-keys/eligible", Open: true},
 					{Name: "/managed-keys/waiting", Open: true},
 					{Name: "/waiting-epochs-left/:key", Open: true},
+					{Name: "/trie-statistics/:roothash", Open: true},
 				},
 			},
 		},
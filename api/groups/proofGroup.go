@@ -20,10 +20,32 @@ const (
 	getProofEndpoint                = "/proof/root-hash/:roothash/address/:address"
 	getProofDataTrieEndpoint        = "/proof/root-hash/:roothash/address/:address/key/:key"
 	verifyProofEndpoint             = "/proof/verify"
+	getMultiProofEndpoint           = "/proof/multi"
+	verifyMultiProofEndpoint        = "/proof/verify-multi"
+	getRangeProofDataTrieEndpoint   = "/proof/root-hash/:roothash/address/:address/range"
+	verifyRangeProofEndpoint        = "/proof/verify-range"
+	getAbsenceProofEndpoint         = "/proof/root-hash/:roothash/address/:address/absence"
+	getAbsenceProofDataTrieEndpoint = "/proof/root-hash/:roothash/address/:address/key/:key/absence"
+	verifyAbsenceProofEndpoint      = "/proof/verify-absence"
 	getProofCurrentRootHashPath     = "/address/:address"
 	getProofPath                    = "/root-hash/:roothash/address/:address"
 	getProofDataTriePath            = "/root-hash/:roothash/address/:address/key/:key"
 	verifyProofPath                 = "/verify"
+	getMultiProofPath               = "/multi"
+	verifyMultiProofPath            = "/verify-multi"
+	getRangeProofDataTriePath       = "/root-hash/:roothash/address/:address/range"
+	verifyRangeProofPath            = "/verify-range"
+	getAbsenceProofPath             = "/root-hash/:roothash/address/:address/absence"
+	getAbsenceProofDataTriePath     = "/root-hash/:roothash/address/:address/key/:key/absence"
+	verifyAbsenceProofPath          = "/verify-absence"
+
+	urlParamStartKey  = "startKey"
+	urlParamEndKey    = "endKey"
+	urlParamMaxLeaves = "maxLeaves"
+
+	maxAddressesInMultiProof     = 100
+	defaultMaxLeavesInRangeProof = 100
+	maxLeavesInRangeProof        = 1000
 )
 
 // proofFacadeHandler defines the methods to be implemented by a facade for proof requests
@@ -32,6 +54,14 @@ type proofFacadeHandler interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProof(rootHash string, addresses []string, values []string, proof [][]byte) (bool, error)
+	GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyRangeProof(rootHash string, address string, startKey string, endKey string, proof [][]byte) (bool, []core.KeyValueHolder, error)
+	GetAbsenceProof(rootHash string, address string) (*common.GetProofResponse, error)
+	GetAbsenceProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyAbsenceProof(rootHash string, address string, proof [][]byte) (bool, error)
+	VerifyAbsenceProofDataTrie(dataTrieRootHash string, key string, proof [][]byte) (bool, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -98,6 +128,83 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 				},
 			},
 		},
+		{
+			Path:    getMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.getMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getRangeProofDataTriePath,
+			Method:  http.MethodGet,
+			Handler: pg.getRangeProofDataTrie,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getRangeProofDataTrieEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyRangeProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyRangeProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyRangeProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getAbsenceProofPath,
+			Method:  http.MethodGet,
+			Handler: pg.getAbsenceProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getAbsenceProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getAbsenceProofDataTriePath,
+			Method:  http.MethodGet,
+			Handler: pg.getAbsenceProofDataTrie,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getAbsenceProofDataTrieEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyAbsenceProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyAbsenceProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyAbsenceProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	pg.endpoints = endpoints
 
@@ -111,6 +218,42 @@ type VerifyProofRequest struct {
 	Proof    []string `json:"proof"`
 }
 
+// MultiProofRequest represents the parameters needed to compute a Merkle proof for multiple addresses
+type MultiProofRequest struct {
+	RootHash  string   `json:"roothash"`
+	Addresses []string `json:"addresses"`
+}
+
+// VerifyMultiProofRequest represents the parameters needed to verify a Merkle proof for multiple addresses. The hex
+// encoded values, as returned along with the multi proof, are expected in the same order as the addresses
+type VerifyMultiProofRequest struct {
+	RootHash  string   `json:"roothash"`
+	Addresses []string `json:"addresses"`
+	Values    []string `json:"values"`
+	Proof     []string `json:"proof"`
+}
+
+// VerifyAbsenceProofRequest represents the parameters needed to verify a Merkle absence proof. If the key is provided,
+// the root hash is the root hash of a data trie and the proof must show that the key is not present in it, otherwise
+// the proof must show that the address is not present in the trie with the given root hash. A data trie root hash is
+// not checked against any account: the client has to verify the account proof to bind it to the on-chain state
+type VerifyAbsenceProofRequest struct {
+	RootHash string   `json:"roothash"`
+	Address  string   `json:"address"`
+	Key      string   `json:"key"`
+	Proof    []string `json:"proof"`
+}
+
+// VerifyRangeProofRequest represents the parameters needed to verify a Merkle range proof. If the address is provided,
+// the root hash is the root hash of its data trie and the returned values are decoded
+type VerifyRangeProofRequest struct {
+	RootHash string   `json:"roothash"`
+	Address  string   `json:"address"`
+	StartKey string   `json:"startKey"`
+	EndKey   string   `json:"endKey"`
+	Proof    []string `json:"proof"`
+}
+
 // getProof will receive a rootHash and an address from the client, and it will return the Merkle proof
 func (pg *proofGroup) getProof(c *gin.Context) {
 	rootHash := c.Param("roothash")
@@ -217,15 +360,10 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 		return
 	}
 
-	proof := make([][]byte, 0)
-	for _, hexProof := range verifyProofParams.Proof {
-		bytesProof, err := hex.DecodeString(hexProof)
-		if err != nil {
-			shared.RespondWithValidationError(c, errors.ErrValidation, err)
-			return
-		}
-
-		proof = append(proof, bytesProof)
+	proof, err := hexToBytes(verifyProofParams.Proof)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
 	}
 
 	var proofOk bool
@@ -238,6 +376,301 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"ok": proofOk})
 }
 
+// getMultiProof will receive a rootHash and a list of addresses from the client, and it will return a single Merkle
+// proof for all the addresses
+func (pg *proofGroup) getMultiProof(c *gin.Context) {
+	var multiProofParams = &MultiProofRequest{}
+	err := c.ShouldBindJSON(&multiProofParams)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = checkMultiProofParams(multiProofParams.RootHash, multiProofParams.Addresses)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	response, err := pg.getFacade().GetMultiProof(multiProofParams.RootHash, multiProofParams.Addresses)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proof":    bytesToHex(response.Proof),
+		"values":   bytesToHex(response.Values),
+		"rootHash": response.RootHash,
+	})
+}
+
+// verifyMultiProof will receive a rootHash, a list of addresses with their values and a Merkle proof from the client,
+// and it will verify the proof for all the addresses and values
+func (pg *proofGroup) verifyMultiProof(c *gin.Context) {
+	var verifyMultiProofParams = &VerifyMultiProofRequest{}
+	err := c.ShouldBindJSON(&verifyMultiProofParams)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = checkMultiProofParams(verifyMultiProofParams.RootHash, verifyMultiProofParams.Addresses)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if len(verifyMultiProofParams.Values) != len(verifyMultiProofParams.Addresses) {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationValuesMismatch)
+		return
+	}
+
+	proof, err := hexToBytes(verifyMultiProofParams.Proof)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	proofOk, err := pg.getFacade().VerifyMultiProof(
+		verifyMultiProofParams.RootHash,
+		verifyMultiProofParams.Addresses,
+		verifyMultiProofParams.Values,
+		proof,
+	)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrVerifyProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"ok": proofOk})
+}
+
+func checkMultiProofParams(rootHash string, addresses []string) error {
+	if rootHash == "" {
+		return errors.ErrValidationEmptyRootHash
+	}
+	if len(addresses) == 0 {
+		return errors.ErrValidationEmptyAddresses
+	}
+	if len(addresses) > maxAddressesInMultiProof {
+		return fmt.Errorf("%w, provided %d, maximum %d", errors.ErrValidationTooManyAddresses, len(addresses), maxAddressesInMultiProof)
+	}
+
+	return nil
+}
+
+// getRangeProofDataTrie will receive a rootHash, an address and the bounds of a keys range from the client, and it
+// will return the Merkle proof for the address and a Merkle range proof for the keys of its data trie
+func (pg *proofGroup) getRangeProofDataTrie(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyAddress)
+		return
+	}
+
+	maxLeaves, err := parseUint32UrlParam(c, urlParamMaxLeaves)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if !maxLeaves.HasValue {
+		maxLeaves.Value = defaultMaxLeavesInRangeProof
+	}
+	if maxLeaves.Value == 0 || maxLeaves.Value > maxLeavesInRangeProof {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationInvalidMaxLeaves)
+		return
+	}
+
+	startKey := c.Query(urlParamStartKey)
+	endKey := c.Query(urlParamEndKey)
+	mainTrieResponse, rangeProofResponse, err := pg.getFacade().GetRangeProofDataTrie(rootHash, address, startKey, endKey, int(maxLeaves.Value))
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	proofs := make(map[string]interface{})
+	proofs["mainProof"] = bytesToHex(mainTrieResponse.Proof)
+	proofs["dataTrieRangeProof"] = bytesToHex(rangeProofResponse.Proof)
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proofs":           proofs,
+		"keys":             bytesToHex(rangeProofResponse.Keys),
+		"values":           bytesToHex(rangeProofResponse.Values),
+		"lastKey":          hex.EncodeToString(rangeProofResponse.LastKey),
+		"complete":         rangeProofResponse.Complete,
+		"dataTrieRootHash": rangeProofResponse.RootHash,
+	})
+}
+
+// verifyRangeProof will receive a rootHash, the bounds of a keys range and a Merkle range proof from the client,
+// and it will verify the proof and return the proven leaves
+func (pg *proofGroup) verifyRangeProof(c *gin.Context) {
+	var verifyRangeProofParams = &VerifyRangeProofRequest{}
+	err := c.ShouldBindJSON(&verifyRangeProofParams)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if verifyRangeProofParams.RootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return
+	}
+
+	proof, err := hexToBytes(verifyRangeProofParams.Proof)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	proofOk, leaves, err := pg.getFacade().VerifyRangeProof(
+		verifyRangeProofParams.RootHash,
+		verifyRangeProofParams.Address,
+		verifyRangeProofParams.StartKey,
+		verifyRangeProofParams.EndKey,
+		proof,
+	)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrVerifyProof, err)
+		return
+	}
+
+	keys := make([]string, 0, len(leaves))
+	values := make([]string, 0, len(leaves))
+	for _, leaf := range leaves {
+		keys = append(keys, hex.EncodeToString(leaf.Key()))
+		values = append(values, hex.EncodeToString(leaf.Value()))
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"ok":     proofOk,
+		"keys":   keys,
+		"values": values,
+	})
+}
+
+// getAbsenceProof will receive a rootHash and an address from the client, and it will return the Merkle proof
+// that the address is not present in the trie
+func (pg *proofGroup) getAbsenceProof(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyAddress)
+		return
+	}
+
+	response, err := pg.getFacade().GetAbsenceProof(rootHash, address)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proof":    bytesToHex(response.Proof),
+		"rootHash": response.RootHash,
+	})
+}
+
+// getAbsenceProofDataTrie will receive a rootHash, an address and a key from the client, and it will return the
+// Merkle proof for the address and the Merkle proof that the key is not present in the data trie of the address
+func (pg *proofGroup) getAbsenceProofDataTrie(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyAddress)
+		return
+	}
+
+	key := c.Param("key")
+	if key == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyKey)
+		return
+	}
+
+	mainTrieResponse, dataTrieResponse, err := pg.getFacade().GetAbsenceProofDataTrie(rootHash, address, key)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	proofs := make(map[string]interface{})
+	proofs["mainProof"] = bytesToHex(mainTrieResponse.Proof)
+	proofs["dataTrieAbsenceProof"] = bytesToHex(dataTrieResponse.Proof)
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proofs":           proofs,
+		"dataTrieRootHash": dataTrieResponse.RootHash,
+	})
+}
+
+// verifyAbsenceProof will receive a rootHash, an address or a key and a Merkle absence proof from the client,
+// and it will verify the proof
+func (pg *proofGroup) verifyAbsenceProof(c *gin.Context) {
+	var verifyAbsenceProofParams = &VerifyAbsenceProofRequest{}
+	err := c.ShouldBindJSON(&verifyAbsenceProofParams)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if verifyAbsenceProofParams.RootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return
+	}
+
+	proof, err := hexToBytes(verifyAbsenceProofParams.Proof)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	var isAbsent bool
+	switch {
+	case verifyAbsenceProofParams.Key != "":
+		isAbsent, err = pg.getFacade().VerifyAbsenceProofDataTrie(verifyAbsenceProofParams.RootHash, verifyAbsenceProofParams.Key, proof)
+	case verifyAbsenceProofParams.Address != "":
+		isAbsent, err = pg.getFacade().VerifyAbsenceProof(verifyAbsenceProofParams.RootHash, verifyAbsenceProofParams.Address, proof)
+	default:
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyAddress)
+		return
+	}
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrVerifyProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"ok": isAbsent})
+}
+
+func hexToBytes(hexValues []string) ([][]byte, error) {
+	bytesValues := make([][]byte, 0, len(hexValues))
+	for _, hexValue := range hexValues {
+		bytesValue, err := hex.DecodeString(hexValue)
+		if err != nil {
+			return nil, err
+		}
+
+		bytesValues = append(bytesValues, bytesValue)
+	}
+
+	return bytesValues, nil
+}
+
 func (pg *proofGroup) getFacade() proofFacadeHandler {
 	pg.mutFacade.RLock()
 	defer pg.mutFacade.RUnlock()
@@ -11,6 +11,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/keyValStorage"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
@@ -379,6 +381,411 @@ func TestVerifyProof(t *testing.T) {
 	assert.True(t, isValid)
 }
 
+func TestGetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("too many addresses should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetMultiProofCalled: func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		multiProofBytes, _ := json.Marshal(groups.MultiProofRequest{
+			RootHash:  "roothash",
+			Addresses: make([]string, 101),
+		})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+		req, _ := http.NewRequest("POST", "/proof/multi", bytes.NewBuffer(multiProofBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationTooManyAddresses.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		addresses := []string{"addr1", "addr2"}
+		facade := &mock.FacadeStub{
+			GetMultiProofCalled: func(rootHash string, providedAddresses []string) (*common.GetMultiProofResponse, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, addresses, providedAddresses)
+				return &common.GetMultiProofResponse{
+					Proof:    [][]byte{[]byte("proof")},
+					Values:   [][]byte{[]byte("value1"), []byte("value2")},
+					RootHash: rootHash,
+				}, nil
+			},
+		}
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		multiProofBytes, _ := json.Marshal(groups.MultiProofRequest{
+			RootHash:  "roothash",
+			Addresses: addresses,
+		})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+		req, _ := http.NewRequest("POST", "/proof/multi", bytes.NewBuffer(multiProofBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, ok := response.Data.(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("proof"))}, responseMap["proof"])
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("value1")), hex.EncodeToString([]byte("value2"))}, responseMap["values"])
+		assert.Equal(t, "roothash", responseMap["rootHash"])
+	})
+}
+
+func TestVerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	addresses := []string{"addr1", "addr2"}
+	values := []string{"aa", "bb"}
+	validProof := []string{hex.EncodeToString([]byte("valid")), hex.EncodeToString([]byte("proof"))}
+	facade := &mock.FacadeStub{
+		VerifyMultiProofCalled: func(rootHash string, providedAddresses []string, providedValues []string, proof [][]byte) (bool, error) {
+			assert.Equal(t, "roothash", rootHash)
+			assert.Equal(t, addresses, providedAddresses)
+			assert.Equal(t, values, providedValues)
+			assert.Equal(t, [][]byte{[]byte("valid"), []byte("proof")}, proof)
+			return true, nil
+		},
+	}
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	verifyMultiProofBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{
+		RootHash:  "roothash",
+		Addresses: addresses,
+		Values:    values[:1],
+		Proof:     validProof,
+	})
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+	req, _ := http.NewRequest("POST", "/proof/verify-multi", bytes.NewBuffer(verifyMultiProofBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationValuesMismatch.Error()))
+
+	verifyMultiProofBytes, _ = json.Marshal(groups.VerifyMultiProofRequest{
+		RootHash:  "roothash",
+		Addresses: addresses,
+		Values:    values,
+		Proof:     validProof,
+	})
+	req, _ = http.NewRequest("POST", "/proof/verify-multi", bytes.NewBuffer(verifyMultiProofBytes))
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response = shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, true, responseMap["ok"])
+}
+
+func TestGetRangeProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max leaves should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+		req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/range?maxLeaves=1001", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationInvalidMaxLeaves.Error()))
+	})
+	t.Run("get proof error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetRangeProofDataTrieCalled: func(_ string, _ string, _ string, _ string, _ int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+		req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/range", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetRangeProofDataTrieCalled: func(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, "addr", address)
+				assert.Equal(t, "aa", startKey)
+				assert.Equal(t, "", endKey)
+				assert.Equal(t, 100, maxLeaves)
+				return &common.GetProofResponse{Proof: [][]byte{[]byte("main")}},
+					&common.GetRangeProofResponse{
+						TrieRangeProof: &common.TrieRangeProof{
+							Proof:   [][]byte{[]byte("range")},
+							Keys:    [][]byte{[]byte("key")},
+							Values:  [][]byte{[]byte("value")},
+							LastKey: []byte("key"),
+						},
+						RootHash: "dataTrieRootHash",
+					},
+					nil
+			},
+		}
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+		req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/range?startKey=aa", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, ok := response.Data.(map[string]interface{})
+		require.True(t, ok)
+		proofs, ok := responseMap["proofs"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("main"))}, proofs["mainProof"])
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("range"))}, proofs["dataTrieRangeProof"])
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("key"))}, responseMap["keys"])
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("value"))}, responseMap["values"])
+		assert.Equal(t, hex.EncodeToString([]byte("key")), responseMap["lastKey"])
+		assert.Equal(t, false, responseMap["complete"])
+		assert.Equal(t, "dataTrieRootHash", responseMap["dataTrieRootHash"])
+	})
+}
+
+func TestVerifyRangeProof(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		VerifyRangeProofCalled: func(rootHash string, address string, startKey string, endKey string, proof [][]byte) (bool, []core.KeyValueHolder, error) {
+			assert.Equal(t, "roothash", rootHash)
+			assert.Equal(t, "addr", address)
+			assert.Equal(t, "aa", startKey)
+			assert.Equal(t, "bb", endKey)
+			assert.Equal(t, [][]byte{[]byte("proof")}, proof)
+			return true, []core.KeyValueHolder{keyValStorage.NewKeyValStorage([]byte("key"), []byte("value"))}, nil
+		},
+	}
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	verifyRangeProofBytes, _ := json.Marshal(groups.VerifyRangeProofRequest{
+		RootHash: "roothash",
+		Address:  "addr",
+		StartKey: "aa",
+		EndKey:   "bb",
+		Proof:    []string{hex.EncodeToString([]byte("proof"))},
+	})
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+	req, _ := http.NewRequest("POST", "/proof/verify-range", bytes.NewBuffer(verifyRangeProofBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, true, responseMap["ok"])
+	assert.Equal(t, []interface{}{hex.EncodeToString([]byte("key"))}, responseMap["keys"])
+	assert.Equal(t, []interface{}{hex.EncodeToString([]byte("value"))}, responseMap["values"])
+}
+
+func TestGetAbsenceProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("get proof error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetAbsenceProofCalled: func(_ string, _ string) (*common.GetProofResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+		req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/absence", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAbsenceProofCalled: func(rootHash string, address string) (*common.GetProofResponse, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, "addr", address)
+				return &common.GetProofResponse{Proof: [][]byte{[]byte("absence")}, RootHash: rootHash}, nil
+			},
+		}
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+		req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/absence", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, ok := response.Data.(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("absence"))}, responseMap["proof"])
+		assert.Equal(t, "roothash", responseMap["rootHash"])
+	})
+}
+
+func TestGetAbsenceProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAbsenceProofDataTrieCalled: func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+			assert.Equal(t, "roothash", rootHash)
+			assert.Equal(t, "addr", address)
+			assert.Equal(t, "key", key)
+			return &common.GetProofResponse{Proof: [][]byte{[]byte("main")}},
+				&common.GetProofResponse{Proof: [][]byte{[]byte("absence")}, RootHash: "dataTrieRootHash"},
+				nil
+		},
+	}
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+	req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/key/key/absence", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	proofs, ok := responseMap["proofs"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, []interface{}{hex.EncodeToString([]byte("main"))}, proofs["mainProof"])
+	assert.Equal(t, []interface{}{hex.EncodeToString([]byte("absence"))}, proofs["dataTrieAbsenceProof"])
+	assert.Equal(t, "dataTrieRootHash", responseMap["dataTrieRootHash"])
+}
+
+func TestVerifyAbsenceProof(t *testing.T) {
+	t.Parallel()
+
+	sendVerifyAbsenceProofRequest := func(facade *mock.FacadeStub, params groups.VerifyAbsenceProofRequest) (*httptest.ResponseRecorder, shared.GenericAPIResponse) {
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		paramsBytes, _ := json.Marshal(params)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+		req, _ := http.NewRequest("POST", "/proof/verify-absence", bytes.NewBuffer(paramsBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		return resp, response
+	}
+
+	t.Run("no address and no key should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp, response := sendVerifyAbsenceProofRequest(&mock.FacadeStub{}, groups.VerifyAbsenceProofRequest{
+			RootHash: "roothash",
+		})
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyAddress.Error()))
+	})
+	t.Run("address should verify in the main trie", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			VerifyAbsenceProofCalled: func(rootHash string, address string, proof [][]byte) (bool, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, "addr", address)
+				assert.Equal(t, [][]byte{[]byte("proof")}, proof)
+				return true, nil
+			},
+		}
+		_, response := sendVerifyAbsenceProofRequest(facade, groups.VerifyAbsenceProofRequest{
+			RootHash: "roothash",
+			Address:  "addr",
+			Proof:    []string{hex.EncodeToString([]byte("proof"))},
+		})
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+		assert.Equal(t, true, response.Data.(map[string]interface{})["ok"])
+	})
+	t.Run("key should verify in the data trie", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			VerifyAbsenceProofDataTrieCalled: func(dataTrieRootHash string, key string, proof [][]byte) (bool, error) {
+				assert.Equal(t, "dataTrieRootHash", dataTrieRootHash)
+				assert.Equal(t, "aa", key)
+				return false, nil
+			},
+		}
+		_, response := sendVerifyAbsenceProofRequest(facade, groups.VerifyAbsenceProofRequest{
+			RootHash: "dataTrieRootHash",
+			Key:      "aa",
+			Proof:    []string{hex.EncodeToString([]byte("proof"))},
+		})
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+		assert.Equal(t, false, response.Data.(map[string]interface{})["ok"])
+	})
+}
+
 func TestProofGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -476,6 +883,13 @@ func getProofRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/root-hash/:roothash/address/:address/key/:key", Open: true},
 					{Name: "/address/:address", Open: true},
 					{Name: "/verify", Open: true},
+					{Name: "/multi", Open: true},
+					{Name: "/verify-multi", Open: true},
+					{Name: "/root-hash/:roothash/address/:address/range", Open: true},
+					{Name: "/verify-range", Open: true},
+					{Name: "/root-hash/:roothash/address/:address/absence", Open: true},
+					{Name: "/root-hash/:roothash/address/:address/key/:key/absence", Open: true},
+					{Name: "/verify-absence", Open: true},
 				},
 			},
 		},
@@ -24,21 +24,39 @@ import (
 const (
 	sendTransactionEndpoint          = "/transaction/send"
 	simulateTransactionEndpoint      = "/transaction/simulate"
+	simulateBundleEndpoint           = "/transaction/simulate-bundle"
+	traceTransactionEndpoint         = "/transaction/trace"
+	traceExecutedTransactionEndpoint = "/transaction/trace/:txhash"
+	replayTransactionEndpoint        = "/transaction/replay/:txhash"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
+	getTransactionStatusEndpoint     = "/transaction/:hash/status"
+	transactionsQueueEndpoint        = "/transaction/queue"
+	checkTransactionEndpoint         = "/transaction/check"
+	selectionPreviewEndpoint         = "/transaction/pool/selection-preview"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
+	simulateBundlePath               = "/simulate-bundle"
+	traceTransactionPath             = "/trace"
+	traceExecutedTransactionPath     = "/trace/:txhash"
+	replayTransactionPath            = "/replay/:txhash"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
+	getTransactionStatusPath         = "/:txhash/status"
 	getTransactionsPool              = "/pool"
+	transactionsQueuePath            = "/queue"
+	checkTransactionPath             = "/check"
+	selectionPreviewPath             = "/pool/selection-preview"
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
+	queryParamWithStateDiff  = "withStateDiff"
 	queryParamSender         = "by-sender"
 	queryParamFields         = "fields"
 	queryParamLastNonce      = "last-nonce"
 	queryParamNonceGaps      = "nonce-gaps"
+	queryParamScore          = "score"
 )
 
 // transactionFacadeHandler defines the methods to be implemented by a facade for transaction requests
@@ -47,12 +65,23 @@ type transactionFacadeHandler interface {
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error)
+	SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionExecution(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceExecutedTransaction(txHash string) (*txSimData.TransactionTrace, error)
+	ReplayTransaction(txHash string) (*txSimData.ReplayResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionStatus(hash string) (*common.TransactionStatusApiResponse, error)
+	GetTransactionsPoolSenderScore(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error)
+	GetTransactionsPoolSelectionPreview(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error)
+	EnqueueTransaction(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error)
+	GetTransactionsQueueState() *common.TransactionsQueueStateApiResponse
+	CheckTransaction(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
@@ -99,6 +128,50 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    simulateBundlePath,
+			Method:  http.MethodPost,
+			Handler: tg.simulateTransactionsBundle,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateBundleEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    traceTransactionPath,
+			Method:  http.MethodPost,
+			Handler: tg.traceTransaction,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(traceTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    traceExecutedTransactionPath,
+			Method:  http.MethodGet,
+			Handler: tg.traceExecutedTransaction,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(traceExecutedTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    replayTransactionPath,
+			Method:  http.MethodGet,
+			Handler: tg.replayTransaction,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(replayTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    costPath,
 			Method:  http.MethodPost,
@@ -115,6 +188,44 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    selectionPreviewPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionsPoolSelectionPreview,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(selectionPreviewEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    transactionsQueuePath,
+			Method:  http.MethodPost,
+			Handler: tg.enqueueTransaction,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(transactionsQueueEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    transactionsQueuePath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionsQueueState,
+		},
+		{
+			Path:    checkTransactionPath,
+			Method:  http.MethodPost,
+			Handler: tg.checkTransaction,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(checkTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    sendMultiplePath,
 			Method:  http.MethodPost,
@@ -137,6 +248,17 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    getTransactionStatusPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionStatus,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionStatusEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	tg.endpoints = endpoints
 
@@ -182,6 +304,19 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
+	withStateDiff, err := getQueryParamWithStateDiff(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	txArgs := &external.ArgsCreateTransaction{
 		Nonce:            ftx.Nonce,
 		Value:            ftx.Value,
@@ -208,31 +343,318 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 			http.StatusBadRequest,
 			shared.GenericAPIResponse{
 				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
-				Code:  shared.ReturnCodeRequestError,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start = time.Now()
+	err = tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransactionForSimulation")
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start = time.Now()
+	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx, withStateDiff)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionExecution")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	executionResults.Hash = hex.EncodeToString(txHash)
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": executionResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// simulateTransactionsBundle will receive an ordered list of transactions from the client and will simulate their
+// execution on top of the same state, returning the results of each transaction and the aggregated state changes
+func (tg *transactionGroup) simulateTransactionsBundle(c *gin.Context) {
+	var ftxs []transaction.FrontendTransaction
+	err := c.ShouldBindJSON(&ftxs)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	checkSignature, err := getQueryParameterCheckSignature(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(ftxs))
+	txsHashes := make([]string, 0, len(ftxs))
+	for idx, receivedTx := range ftxs {
+		txArgs := &external.ArgsCreateTransaction{
+			Nonce:            receivedTx.Nonce,
+			Value:            receivedTx.Value,
+			Receiver:         receivedTx.Receiver,
+			ReceiverUsername: receivedTx.ReceiverUsername,
+			Sender:           receivedTx.Sender,
+			SenderUsername:   receivedTx.SenderUsername,
+			GasPrice:         receivedTx.GasPrice,
+			GasLimit:         receivedTx.GasLimit,
+			DataField:        receivedTx.Data,
+			SignatureHex:     receivedTx.Signature,
+			ChainID:          receivedTx.ChainID,
+			Version:          receivedTx.Version,
+			Options:          receivedTx.Options,
+			Guardian:         receivedTx.GuardianAddr,
+			GuardianSigHex:   receivedTx.GuardianSignature,
+		}
+		tx, txHash, errCreate := tg.getFacade().CreateTransaction(txArgs)
+		if errCreate == nil {
+			errCreate = tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature)
+		}
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s for transaction at index %d: %s", errors.ErrTxGenerationFailed.Error(), idx, errCreate.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		txs = append(txs, tx)
+		txsHashes = append(txsHashes, hex.EncodeToString(txHash))
+	}
+
+	start := time.Now()
+	bundleResults, err := tg.getFacade().SimulateTransactionsBundle(txs)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionsBundle")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	for idx, result := range bundleResults.Results {
+		if idx < len(txsHashes) {
+			result.Hash = txsHashes[idx]
+		}
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": bundleResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// traceTransaction will receive a transaction from the client and will simulate its execution, returning the
+// results together with the step-level execution trace
+func (tg *transactionGroup) traceTransaction(c *gin.Context) {
+	var ftx = transaction.FrontendTransaction{}
+	err := c.ShouldBindJSON(&ftx)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	checkSignature, err := getQueryParameterCheckSignature(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txArgs := &external.ArgsCreateTransaction{
+		Nonce:            ftx.Nonce,
+		Value:            ftx.Value,
+		Receiver:         ftx.Receiver,
+		ReceiverUsername: ftx.ReceiverUsername,
+		Sender:           ftx.Sender,
+		SenderUsername:   ftx.SenderUsername,
+		GasPrice:         ftx.GasPrice,
+		GasLimit:         ftx.GasLimit,
+		DataField:        ftx.Data,
+		SignatureHex:     ftx.Signature,
+		ChainID:          ftx.ChainID,
+		Version:          ftx.Version,
+		Options:          ftx.Options,
+		Guardian:         ftx.GuardianAddr,
+		GuardianSigHex:   ftx.GuardianSignature,
+	}
+	start := time.Now()
+	tx, txHash, err := tg.getFacade().CreateTransaction(txArgs)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start = time.Now()
+	err = tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransactionForSimulation")
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start = time.Now()
+	trace, err := tg.getFacade().TraceTransactionExecution(tx)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: TraceTransactionExecution")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	trace.Hash = hex.EncodeToString(txHash)
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": trace},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// traceExecutedTransaction will re-execute an already executed transaction against the historical state,
+// returning the results together with the step-level execution trace
+func (tg *transactionGroup) traceExecutedTransaction(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	trace, err := tg.getFacade().TraceExecutedTransaction(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: TraceExecutedTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
 			},
 		)
 		return
 	}
 
-	start = time.Now()
-	err = tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature)
-	logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransactionForSimulation")
-	if err != nil {
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": trace},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// replayTransaction will re-execute an already executed transaction against the historical state, returning the
+// results together with their differences against the stored ones
+func (tg *transactionGroup) replayTransaction(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
 		c.JSON(
 			http.StatusBadRequest,
 			shared.GenericAPIResponse{
 				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
 				Code:  shared.ReturnCodeRequestError,
 			},
 		)
 		return
 	}
 
-	start = time.Now()
-	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx)
-	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionExecution")
+	start := time.Now()
+	results, err := tg.getFacade().ReplayTransaction(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: ReplayTransaction")
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -245,11 +667,10 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
-	executionResults.Hash = hex.EncodeToString(txHash)
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"result": executionResults},
+			Data:  gin.H{"result": results},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -458,6 +879,19 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
+	withStateDiff, err := getQueryParamWithStateDiff(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	start := time.Now()
 	tx, err := tg.getFacade().GetTransaction(txhash, withResults)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransaction")
@@ -473,10 +907,71 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
+	responseData := gin.H{"transaction": tx}
+	if withStateDiff {
+		start = time.Now()
+		stateDiff, errStateDiff := tg.getFacade().GetTransactionStateDiff(txhash)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionStateDiff")
+		if errStateDiff != nil {
+			c.JSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: %s", errors.ErrGetTransaction.Error(), errStateDiff.Error()),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		responseData["stateDiff"] = stateDiff
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  responseData,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionStatus returns the status of a transaction, reporting the hash of the replacing transaction for the
+// transactions replaced in the pool by higher gas price ones
+func (tg *transactionGroup) getTransactionStatus(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	status, err := tg.getFacade().GetTransactionStatus(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionStatus")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"transaction": tx},
+			Data:  status,
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -556,10 +1051,18 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 	)
 }
 
+type txPoolQueryParameters struct {
+	sender    string
+	fields    string
+	lastNonce bool
+	nonceGaps bool
+	score     bool
+}
+
 // getTransactionsPool returns the transactions details in the pool
 func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 	// extract and validate query parameters
-	sender, fields, lastNonce, nonceGaps, err := tg.extractQueryParameters(c)
+	queryParams, err := tg.extractQueryParameters(c)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -572,7 +1075,7 @@ func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 		return
 	}
 
-	err = validateQuery(sender, fields, lastNonce, nonceGaps)
+	err = validateQuery(queryParams)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -586,38 +1089,52 @@ func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 	}
 
 	// if no sender was provided, the fields for all transactions from pool should be returned in response
-	if sender == "" {
-		tg.getTxPool(fields, c)
+	if queryParams.sender == "" {
+		tg.getTxPool(queryParams.fields, c)
+		return
+	}
+
+	if queryParams.lastNonce {
+		tg.getLastPoolNonceForSender(queryParams.sender, c)
 		return
 	}
 
-	if lastNonce {
-		tg.getLastPoolNonceForSender(sender, c)
+	if queryParams.nonceGaps {
+		tg.getTransactionsPoolNonceGapsForSender(queryParams.sender, c)
 		return
 	}
 
-	if nonceGaps {
-		tg.getTransactionsPoolNonceGapsForSender(sender, c)
+	if queryParams.score {
+		tg.getTransactionsPoolSenderScore(queryParams.sender, c)
 		return
 	}
 
-	tg.getTxPoolForSender(sender, fields, c)
+	tg.getTxPoolForSender(queryParams.sender, queryParams.fields, c)
 }
 
-func (tg *transactionGroup) extractQueryParameters(c *gin.Context) (string, string, bool, bool, error) {
-	senderAddress := getQueryParameterSender(c)
-	fields := getQueryParameterFields(c)
+func (tg *transactionGroup) extractQueryParameters(c *gin.Context) (*txPoolQueryParameters, error) {
 	lastNonce, err := getQueryParameterLastNonce(c)
 	if err != nil {
-		return "", "", false, false, err
+		return nil, err
 	}
 
 	nonceGaps, err := getQueryParameterNonceGaps(c)
 	if err != nil {
-		return "", "", false, false, err
+		return nil, err
 	}
 
-	return senderAddress, fields, lastNonce, nonceGaps, nil
+	score, err := getQueryParameterScore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txPoolQueryParameters{
+		sender:    getQueryParameterSender(c),
+		fields:    getQueryParameterFields(c),
+		lastNonce: lastNonce,
+		nonceGaps: nonceGaps,
+		score:     score,
+	}, nil
 }
 
 // getTxPool returns the fields for all txs in pool
@@ -728,25 +1245,222 @@ func (tg *transactionGroup) getTransactionsPoolNonceGapsForSender(sender string,
 	)
 }
 
-func validateQuery(sender, fields string, lastNonce, nonceGaps bool) error {
-	if fields != "" && lastNonce {
+// getTransactionsPoolSenderScore returns an approximation of the score of the sender and its rank among the senders from pool
+func (tg *transactionGroup) getTransactionsPoolSenderScore(sender string, c *gin.Context) {
+	start := time.Now()
+	score, err := tg.getFacade().GetTransactionsPoolSenderScore(sender)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPoolSenderScore")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"score": score},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionsPoolSelectionPreview returns an approximate preview of the transactions to be selected from pool for the next
+// block, optionally filtered by sender
+func (tg *transactionGroup) getTransactionsPoolSelectionPreview(c *gin.Context) {
+	sender := getQueryParameterSender(c)
+	start := time.Now()
+	preview, err := tg.getFacade().GetTransactionsPoolSelectionPreview(sender)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPoolSelectionPreview")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"selectionPreview": preview},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// enqueueTransaction adds a transaction in the transactions queue, which assigns its nonce, signs it with the
+// sender's wallet key registered on the node and tracks it until it is executed or it fails
+func (tg *transactionGroup) enqueueTransaction(c *gin.Context) {
+	var request = common.TransactionsQueueEnqueueRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	response, err := tg.getFacade().EnqueueTransaction(&request)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: EnqueueTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrEnqueueTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"txHash": response.TxHash, "nonce": response.Nonce},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionsQueueState returns the nonces of the senders registered in the transactions queue, along with the
+// transactions tracked by the queue
+func (tg *transactionGroup) getTransactionsQueueState(c *gin.Context) {
+	start := time.Now()
+	queueState := tg.getFacade().GetTransactionsQueueState()
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsQueueState")
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"queue": queueState},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// checkTransaction runs all the validity rules against the provided transaction, without propagating it, and returns
+// every violated rule instead of stopping at the first one
+func (tg *transactionGroup) checkTransaction(c *gin.Context) {
+	var ftx = transaction.FrontendTransaction{}
+	err := c.ShouldBindJSON(&ftx)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txArgs := &external.ArgsCreateTransaction{
+		Nonce:            ftx.Nonce,
+		Value:            ftx.Value,
+		Receiver:         ftx.Receiver,
+		ReceiverUsername: ftx.ReceiverUsername,
+		Sender:           ftx.Sender,
+		SenderUsername:   ftx.SenderUsername,
+		GasPrice:         ftx.GasPrice,
+		GasLimit:         ftx.GasLimit,
+		DataField:        ftx.Data,
+		SignatureHex:     ftx.Signature,
+		ChainID:          ftx.ChainID,
+		Version:          ftx.Version,
+		Options:          ftx.Options,
+		Guardian:         ftx.GuardianAddr,
+		GuardianSigHex:   ftx.GuardianSignature,
+	}
+	start := time.Now()
+	tx, txHash, err := tg.getFacade().CreateTransaction(txArgs)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start = time.Now()
+	response, err := tg.getFacade().CheckTransaction(tx)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: CheckTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCheckTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"txHash": hex.EncodeToString(txHash), "result": response},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func validateQuery(queryParams *txPoolQueryParameters) error {
+	if queryParams.fields != "" && queryParams.lastNonce {
 		return errors.ErrFetchingLatestNonceCannotIncludeFields
 	}
 
-	if fields != "" && nonceGaps {
+	if queryParams.fields != "" && queryParams.nonceGaps {
 		return errors.ErrFetchingNonceGapsCannotIncludeFields
 	}
 
-	if sender == "" && lastNonce {
+	if queryParams.fields != "" && queryParams.score {
+		return errors.ErrFetchingSenderScoreCannotIncludeFields
+	}
+
+	if queryParams.sender == "" && queryParams.lastNonce {
 		return errors.ErrEmptySenderToGetLatestNonce
 	}
 
-	if sender == "" && nonceGaps {
+	if queryParams.sender == "" && queryParams.nonceGaps {
 		return errors.ErrEmptySenderToGetNonceGaps
 	}
 
-	if fields != "" {
-		return validateFields(fields)
+	if queryParams.sender == "" && queryParams.score {
+		return errors.ErrEmptySenderToGetSenderScore
+	}
+
+	if queryParams.fields != "" {
+		return validateFields(queryParams.fields)
 	}
 
 	return nil
@@ -777,6 +1491,15 @@ func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(withResultsStr)
 }
 
+func getQueryParamWithStateDiff(c *gin.Context) (bool, error) {
+	withStateDiffStr := c.Request.URL.Query().Get(queryParamWithStateDiff)
+	if withStateDiffStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(withStateDiffStr)
+}
+
 func getQueryParameterCheckSignature(c *gin.Context) (bool, error) {
 	bypassSignatureStr := c.Request.URL.Query().Get(queryParamCheckSignature)
 	if bypassSignatureStr == "" {
@@ -814,6 +1537,15 @@ func getQueryParameterNonceGaps(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(nonceGapsStr)
 }
 
+func getQueryParameterScore(c *gin.Context) (bool, error) {
+	scoreStr := c.Request.URL.Query().Get(queryParamScore)
+	if scoreStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(scoreStr)
+}
+
 func (tg *transactionGroup) getFacade() transactionFacadeHandler {
 	tg.mutFacade.RLock()
 	defer tg.mutFacade.RUnlock()
@@ -43,7 +43,8 @@ func TestNewTransactionGroup(t *testing.T) {
 }
 
 type transactionResponseData struct {
-	TxResp *groups.TxResponse `json:"transaction,omitempty"`
+	TxResp    *groups.TxResponse            `json:"transaction,omitempty"`
+	StateDiff []*txSimData.AccountStateDiff `json:"stateDiff,omitempty"`
 }
 
 type transactionResponse struct {
@@ -69,6 +70,39 @@ type simulateTxResponse struct {
 	Code  string      `json:"code"`
 }
 
+type transactionStatusResponse struct {
+	Data  common.TransactionStatusApiResponse `json:"data"`
+	Error string                              `json:"error"`
+	Code  string                              `json:"code"`
+}
+
+type enqueueTransactionResponse struct {
+	Data  common.TransactionsQueueEnqueueApiResponse `json:"data"`
+	Error string                                     `json:"error"`
+	Code  string                                     `json:"code"`
+}
+
+type transactionsQueueStateResponseData struct {
+	Queue common.TransactionsQueueStateApiResponse `json:"queue"`
+}
+
+type transactionsQueueStateResponse struct {
+	Data  transactionsQueueStateResponseData `json:"data"`
+	Error string                             `json:"error"`
+	Code  string                             `json:"code"`
+}
+
+type checkTransactionResponseData struct {
+	TxHash string                             `json:"txHash"`
+	Result common.TransactionCheckApiResponse `json:"result"`
+}
+
+type checkTransactionResponse struct {
+	Data  checkTransactionResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
 type sendSingleTxResponseData struct {
 	TxHash string `json:"txHash"`
 }
@@ -129,6 +163,26 @@ type txPoolNonceGapsForSenderResponse struct {
 	Code  string                               `json:"code"`
 }
 
+type txPoolSenderScoreResponseData struct {
+	Score common.TransactionsPoolSenderScoreApiResponse `json:"score"`
+}
+
+type txPoolSenderScoreResponse struct {
+	Data  txPoolSenderScoreResponseData `json:"data"`
+	Error string                        `json:"error"`
+	Code  string                        `json:"code"`
+}
+
+type txPoolSelectionPreviewResponseData struct {
+	SelectionPreview common.TransactionsPoolSelectionPreviewApiResponse `json:"selectionPreview"`
+}
+
+type txPoolSelectionPreviewResponse struct {
+	Data  txPoolSelectionPreviewResponseData `json:"data"`
+	Error string                             `json:"error"`
+	Code  string                             `json:"code"`
+}
+
 var (
 	sender      = "sender"
 	receiver    = "receiver"
@@ -233,6 +287,91 @@ func TestTransactionsGroup_getTransaction(t *testing.T) {
 		assert.Equal(t, value, txResp.Value)
 		assert.Equal(t, txData, txResp.Data)
 		assert.Equal(t, guardian, txResp.GuardianAddr)
+		assert.Nil(t, response.Data.StateDiff)
+	})
+	t.Run("invalid withStateDiff param should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTransactionHandler: func(hash string, withEvents bool) (*dataTx.ApiTransactionResult, error) {
+				require.Fail(t, "should have not been called")
+				return &dataTx.ApiTransactionResult{}, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/hash?withStateDiff=not-bool", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txResp := transactionResponse{}
+		loadResponse(resp.Body, &txResp)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Empty(t, txResp.Data)
+	})
+	t.Run("state diff error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTransactionHandler: func(hash string, withEvents bool) (*dataTx.ApiTransactionResult, error) {
+				return &dataTx.ApiTransactionResult{}, nil
+			},
+			GetTransactionStateDiffHandler: func(hash string) ([]*txSimData.AccountStateDiff, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/hash?withStateDiff=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txResp := transactionResponse{}
+		loadResponse(resp.Body, &txResp)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(txResp.Error, expectedErr.Error()))
+		assert.Empty(t, txResp.Data)
+	})
+	t.Run("should work with state diff", func(t *testing.T) {
+		t.Parallel()
+
+		stateDiff := []*txSimData.AccountStateDiff{
+			{Address: sender, NonceBefore: 1, NonceAfter: 2, BalanceBefore: "10", BalanceAfter: "5"},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionHandler: func(hash string, withEvents bool) (i *dataTx.ApiTransactionResult, e error) {
+				return &dataTx.ApiTransactionResult{
+					Sender:   sender,
+					Receiver: receiver,
+				}, nil
+			},
+			GetTransactionStateDiffHandler: func(txHash string) ([]*txSimData.AccountStateDiff, error) {
+				require.Equal(t, hash, txHash)
+				return stateDiff, nil
+			},
+		}
+
+		response := &transactionResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/"+hash+"?withStateDiff=true",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, sender, response.Data.TxResp.Sender)
+		assert.Equal(t, stateDiff, response.Data.StateDiff)
 	})
 }
 
@@ -569,6 +708,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/simulate", &dataTx.FrontendTransaction{}))
 	t.Run("invalid param transaction should error", testTransactionGroupErrorScenario("/transaction/simulate", "POST", jsonTxStr, http.StatusBadRequest, apiErrors.ErrValidation))
 	t.Run("invalid param checkSignature should error", testTransactionGroupErrorScenario("/transaction/simulate?checkSignature=not-bool", "POST", &dataTx.FrontendTransaction{}, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("invalid param withStateDiff should error", testTransactionGroupErrorScenario("/transaction/simulate?withStateDiff=not-bool", "POST", &dataTx.FrontendTransaction{}, http.StatusBadRequest, apiErrors.ErrValidation))
 	t.Run("CreateTransaction error should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -601,7 +741,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
 				return expectedErr
 			},
-			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 				require.Fail(t, "should have not been called")
 				return nil, nil
 			},
@@ -626,7 +766,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
 				return nil
 			},
-			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 				return nil, expectedErr
 			},
 		}
@@ -646,8 +786,9 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 		processTxWasCalled := false
 
 		facade := &mock.FacadeStub{
-			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 				processTxWasCalled = true
+				require.True(t, withStateDiff)
 				return &txSimData.SimulationResultsWithVMOutput{
 					SimulationResults: dataTx.SimulationResults{
 						Status:     "ok",
@@ -682,7 +823,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 		loadTransactionGroupResponse(
 			t,
 			facade,
-			"/transaction/simulate",
+			"/transaction/simulate?withStateDiff=true",
 			"POST",
 			bytes.NewBuffer(jsonBytes),
 			response,
@@ -692,179 +833,800 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 	})
 }
 
-func TestTransactionGroup_getTransactionsPool(t *testing.T) {
+func TestTransactionGroup_simulateTransactionsBundle(t *testing.T) {
 	t.Parallel()
 
-	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/pool", nil))
-	t.Run("invalid last-nonce param should error", testTransactionGroupErrorScenario("/transaction/pool?last-nonce=not-bool", "GET", nil, http.StatusBadRequest, apiErrors.ErrValidation))
-	t.Run("invalid nonce-gaps param should error", testTransactionGroupErrorScenario("/transaction/pool?nonce-gaps=not-bool", "GET", nil, http.StatusBadRequest, apiErrors.ErrValidation))
-	t.Run("empty sender, requesting latest nonce", testTxPoolWithInvalidQuery("?last-nonce=true", apiErrors.ErrEmptySenderToGetLatestNonce))
-	t.Run("empty sender, requesting nonce gaps", testTxPoolWithInvalidQuery("?nonce-gaps=true", apiErrors.ErrEmptySenderToGetNonceGaps))
-	t.Run("fields + latest nonce", testTxPoolWithInvalidQuery("?fields=sender,receiver&last-nonce=true", apiErrors.ErrFetchingLatestNonceCannotIncludeFields))
-	t.Run("fields + nonce gaps", testTxPoolWithInvalidQuery("?fields=sender,receiver&nonce-gaps=true", apiErrors.ErrFetchingNonceGapsCannotIncludeFields))
-	t.Run("fields has spaces", testTxPoolWithInvalidQuery("?fields=sender ,receiver", apiErrors.ErrInvalidFields))
-	t.Run("fields has numbers", testTxPoolWithInvalidQuery("?fields=sender1", apiErrors.ErrInvalidFields))
-	t.Run("GetTransactionsPool error should error", func(t *testing.T) {
+	bundle := []*dataTx.FrontendTransaction{{Nonce: 1}, {Nonce: 2}}
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/simulate-bundle", bundle))
+	t.Run("invalid param transactions should error", testTransactionGroupErrorScenario("/transaction/simulate-bundle", "POST", &dataTx.FrontendTransaction{}, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("invalid param checkSignature should error", testTransactionGroupErrorScenario("/transaction/simulate-bundle?checkSignature=not-bool", "POST", bundle, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("CreateTransaction error should error", func(t *testing.T) {
 		t.Parallel()
 
 		facade := &mock.FacadeStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
-				return nil, expectedErr
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, expectedErr
 			},
-		}
-		testTransactionsGroup(
-			t,
-			facade,
-			"/transaction/pool",
-			"GET",
-			nil,
-			http.StatusInternalServerError,
-			expectedErr,
-		)
-	})
-	t.Run("GetTransactionsPoolForSender error should error", func(t *testing.T) {
-		t.Parallel()
-
-		facade := &mock.FacadeStub{
-			GetTransactionsPoolForSenderCalled: func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error) {
-				return nil, expectedErr
+			SimulateTransactionsBundleHandler: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
 			},
 		}
 		testTransactionsGroup(
 			t,
 			facade,
-			"/transaction/pool?by-sender=sender",
-			"GET",
-			nil,
-			http.StatusInternalServerError,
+			"/transaction/simulate-bundle",
+			"POST",
+			bundle,
+			http.StatusBadRequest,
 			expectedErr,
 		)
 	})
-	t.Run("GetLastPoolNonceForSender error should error", func(t *testing.T) {
+	t.Run("ValidateTransactionForSimulation error should error", func(t *testing.T) {
 		t.Parallel()
 
 		facade := &mock.FacadeStub{
-			GetLastPoolNonceForSenderCalled: func(sender string) (uint64, error) {
-				return 0, expectedErr
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return expectedErr
+			},
+			SimulateTransactionsBundleHandler: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
 			},
 		}
 		testTransactionsGroup(
 			t,
 			facade,
-			"/transaction/pool?by-sender=sender&last-nonce=true",
-			"GET",
-			nil,
-			http.StatusInternalServerError,
+			"/transaction/simulate-bundle",
+			"POST",
+			bundle,
+			http.StatusBadRequest,
 			expectedErr,
 		)
 	})
-	t.Run("GetTransactionsPoolNonceGapsForSender error should error", func(t *testing.T) {
+	t.Run("SimulateTransactionsBundle error should error", func(t *testing.T) {
 		t.Parallel()
 
 		facade := &mock.FacadeStub{
-			GetTransactionsPoolNonceGapsForSenderCalled: func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error) {
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return nil
+			},
+			SimulateTransactionsBundleHandler: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
 				return nil, expectedErr
 			},
 		}
 		testTransactionsGroup(
 			t,
 			facade,
-			"/transaction/pool?by-sender=sender&nonce-gaps=true",
-			"GET",
-			nil,
+			"/transaction/simulate-bundle",
+			"POST",
+			bundle,
 			http.StatusInternalServerError,
 			expectedErr,
 		)
 	})
-
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
-		expectedTxPool := &common.TransactionsPoolAPIResponse{
-			RegularTransactions: []common.Transaction{
-				{
-					TxFields: map[string]interface{}{
-						"hash": "tx",
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{Nonce: txArgs.Nonce}, []byte(fmt.Sprintf("hash%d", txArgs.Nonce)), nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return nil
+			},
+			SimulateTransactionsBundleHandler: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+				require.Len(t, txs, 2)
+				require.Equal(t, uint64(1), txs[0].Nonce)
+				require.Equal(t, uint64(2), txs[1].Nonce)
+
+				return &txSimData.BundleSimulationResults{
+					Results: []*txSimData.SimulationResultsWithVMOutput{
+						{SimulationResults: dataTx.SimulationResults{Status: "success"}},
+						{SimulationResults: dataTx.SimulationResults{Status: "success"}},
 					},
-				},
-				{
-					TxFields: map[string]interface{}{
-						"hash": "tx2",
+					StateChanges: []*txSimData.AccountStateDiff{
+						{Address: "erd1sender", NonceBefore: 1, NonceAfter: 3},
 					},
-				},
-			},
-		}
-		facade := &mock.FacadeStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
-				return expectedTxPool, nil
+				}, nil
 			},
 		}
 
-		response := &txsPoolResponse{}
+		jsonBytes, _ := json.Marshal(bundle)
+		response := &simulateTxResponse{}
 		loadTransactionGroupResponse(
 			t,
 			facade,
-			"/transaction/pool",
-			"GET",
-			nil,
+			"/transaction/simulate-bundle",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
 			response,
 		)
-		assert.Empty(t, response.Error)
-		assert.Equal(t, *expectedTxPool, response.Data.TxPool)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		responseData := fmt.Sprintf("%v", response.Data)
+		assert.Contains(t, responseData, hex.EncodeToString([]byte("hash1")))
+		assert.Contains(t, responseData, hex.EncodeToString([]byte("hash2")))
+		assert.Contains(t, responseData, "erd1sender")
 	})
-	t.Run("should work for sender", func(t *testing.T) {
+}
+
+func TestTransactionGroup_traceTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/trace", &dataTx.FrontendTransaction{}))
+	t.Run("invalid param transaction should error", testTransactionGroupErrorScenario("/transaction/trace", "POST", jsonTxStr, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("invalid param checkSignature should error", testTransactionGroupErrorScenario("/transaction/trace?checkSignature=not-bool", "POST", &dataTx.FrontendTransaction{}, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("CreateTransaction error should error", func(t *testing.T) {
 		t.Parallel()
 
-		expectedSender := "sender"
-		providedFields := "sender,receiver"
-		query := "?by-sender=" + expectedSender + "&fields=" + providedFields
-		expectedResp := &common.TransactionsPoolForSenderApiResponse{
-			Transactions: []common.Transaction{
-				{
-					TxFields: map[string]interface{}{
-						"hash":     "txHash1",
-						"sender":   expectedSender,
-						"receiver": "receiver1",
-					},
-				},
-				{
-					TxFields: map[string]interface{}{
-						"hash":     "txHash2",
-						"sender":   expectedSender,
-						"receiver": "receiver2",
-					},
-				},
-			},
-		}
 		facade := &mock.FacadeStub{
-			GetTransactionsPoolForSenderCalled: func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error) {
-				return expectedResp, nil
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, expectedErr
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				require.Fail(t, "should have not been called")
+				return nil
 			},
 		}
-
-		response := &poolForSenderResponse{}
-		loadTransactionGroupResponse(
+		testTransactionsGroup(
 			t,
 			facade,
-			"/transaction/pool"+query,
-			"GET",
-			nil,
-			response,
+			"/transaction/trace",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusBadRequest,
+			expectedErr,
 		)
-		assert.Empty(t, response.Error)
-		assert.Equal(t, *expectedResp, response.Data.TxPool)
 	})
-	t.Run("should work for last pool nonce", func(t *testing.T) {
+	t.Run("ValidateTransactionForSimulation error should error", func(t *testing.T) {
 		t.Parallel()
 
-		expectedSender := "sender"
-		query := "?by-sender=" + expectedSender + "&last-nonce=true"
-		expectedNonce := uint64(33)
 		facade := &mock.FacadeStub{
-			GetLastPoolNonceForSenderCalled: func(sender string) (uint64, error) {
-				return expectedNonce, nil
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, nil
 			},
-		}
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return expectedErr
+			},
+			TraceTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.TransactionTrace, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/trace",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusBadRequest,
+			expectedErr,
+		)
+	})
+	t.Run("TraceTransactionExecution error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return nil
+			},
+			TraceTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.TransactionTrace, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/trace",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		traceWasCalled := false
+		facade := &mock.FacadeStub{
+			TraceTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.TransactionTrace, error) {
+				traceWasCalled = true
+				return &txSimData.TransactionTrace{
+					SimulationResults: dataTx.SimulationResults{
+						Status: "success",
+					},
+					Frames: []*txSimData.TraceFrame{
+						{
+							Type:     "contractCall",
+							Function: "add",
+						},
+					},
+				}, nil
+			},
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return nil
+			},
+		}
+
+		tx := dataTx.FrontendTransaction{
+			Sender:   "sender1",
+			Receiver: "receiver1",
+			Value:    "100",
+		}
+		jsonBytes, _ := json.Marshal(tx)
+
+		response := &simulateTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/trace",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.True(t, traceWasCalled)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Contains(t, fmt.Sprintf("%v", response.Data), "contractCall")
+	})
+}
+
+func TestTransactionGroup_traceExecutedTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/trace/hash", nil))
+	t.Run("TraceExecutedTransaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			TraceExecutedTransactionHandler: func(txHash string) (*txSimData.TransactionTrace, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/trace/hash",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedHash := "aabb"
+		facade := &mock.FacadeStub{
+			TraceExecutedTransactionHandler: func(txHash string) (*txSimData.TransactionTrace, error) {
+				assert.Equal(t, providedHash, txHash)
+				return &txSimData.TransactionTrace{
+					SimulationResults: dataTx.SimulationResults{
+						Status: "success",
+						Hash:   txHash,
+					},
+				}, nil
+			},
+		}
+
+		response := &simulateTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/trace/"+providedHash,
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Contains(t, fmt.Sprintf("%v", response.Data), providedHash)
+	})
+}
+
+func TestTransactionGroup_replayTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/replay/hash", nil))
+	t.Run("ReplayTransaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			ReplayTransactionHandler: func(txHash string) (*txSimData.ReplayResults, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/replay/hash",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedHash := "aabb"
+		facade := &mock.FacadeStub{
+			ReplayTransactionHandler: func(txHash string) (*txSimData.ReplayResults, error) {
+				assert.Equal(t, providedHash, txHash)
+				return &txSimData.ReplayResults{
+					BlockHash: "block hash",
+					Diff: &txSimData.ReplayDiff{
+						Matches: true,
+					},
+				}, nil
+			},
+		}
+
+		response := &simulateTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/replay/"+providedHash,
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Contains(t, fmt.Sprintf("%v", response.Data), "block hash")
+	})
+}
+
+func TestTransactionGroup_getTransactionStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/hash/status", nil))
+	t.Run("GetTransactionStatus error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionStatusCalled: func(hash string) (*common.TransactionStatusApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/hash/status",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedHash := "aabb"
+		facade := &mock.FacadeStub{
+			GetTransactionStatusCalled: func(hash string) (*common.TransactionStatusApiResponse, error) {
+				assert.Equal(t, providedHash, hash)
+				return &common.TransactionStatusApiResponse{
+					Status:     string(common.TxStatusReplaced),
+					ReplacedBy: "ccdd",
+				}, nil
+			},
+		}
+
+		response := &transactionStatusResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/"+providedHash+"/status",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Equal(t, "replaced", response.Data.Status)
+		assert.Equal(t, "ccdd", response.Data.ReplacedBy)
+	})
+}
+
+func TestTransactionGroup_enqueueTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid params should error", testTransactionGroupErrorScenario("/transaction/queue", "POST", "invalid request", http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("EnqueueTransaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			EnqueueTransactionCalled: func(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/queue",
+			"POST",
+			&common.TransactionsQueueEnqueueRequest{},
+			http.StatusBadRequest,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedRequest := &common.TransactionsQueueEnqueueRequest{
+			Sender:   "sender",
+			Receiver: "receiver",
+			Value:    "100",
+			Data:     []byte("data"),
+			GasPrice: 1000000000,
+			GasLimit: 70000,
+		}
+		facade := &mock.FacadeStub{
+			EnqueueTransactionCalled: func(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error) {
+				assert.Equal(t, providedRequest, request)
+				return &common.TransactionsQueueEnqueueApiResponse{
+					TxHash: "aabb",
+					Nonce:  37,
+				}, nil
+			},
+		}
+
+		requestBytes, _ := json.Marshal(providedRequest)
+		response := &enqueueTransactionResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/queue",
+			"POST",
+			bytes.NewBuffer(requestBytes),
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Equal(t, "aabb", response.Data.TxHash)
+		assert.Equal(t, uint64(37), response.Data.Nonce)
+	})
+}
+
+func TestTransactionGroup_getTransactionsQueueState(t *testing.T) {
+	t.Parallel()
+
+	providedState := &common.TransactionsQueueStateApiResponse{
+		Senders: []common.TransactionsQueueSenderState{
+			{
+				Address:     "sender",
+				NextNonce:   3,
+				NumPending:  1,
+				NumExecuted: 2,
+			},
+		},
+		Transactions: []common.TransactionsQueueTransactionState{
+			{
+				Hash:           "ccdd",
+				Sender:         "sender",
+				Nonce:          2,
+				GasPrice:       1100000000,
+				Status:         "pending",
+				Resubmissions:  1,
+				PreviousHashes: []string{"aabb"},
+			},
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetTransactionsQueueStateCalled: func() *common.TransactionsQueueStateApiResponse {
+			return providedState
+		},
+	}
+
+	response := &transactionsQueueStateResponse{}
+	loadTransactionGroupResponse(
+		t,
+		facade,
+		"/transaction/queue",
+		"GET",
+		nil,
+		response,
+	)
+	assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+	assert.Equal(t, *providedState, response.Data.Queue)
+}
+
+func TestTransactionGroup_checkTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/check", &dataTx.FrontendTransaction{}))
+	t.Run("invalid params should error", testTransactionGroupErrorScenario("/transaction/check", "POST", jsonTxStr, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("CreateTransaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, expectedErr
+			},
+			CheckTransactionCalled: func(tx *dataTx.Transaction) (*common.TransactionCheckApiResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/check",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusBadRequest,
+			expectedErr,
+		)
+	})
+	t.Run("CheckTransaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			CheckTransactionCalled: func(tx *dataTx.Transaction) (*common.TransactionCheckApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/check",
+			"POST",
+			&dataTx.FrontendTransaction{},
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedTx := &dataTx.Transaction{Nonce: 7}
+		expectedResult := &common.TransactionCheckApiResponse{
+			Valid: false,
+			Diagnostics: []common.TransactionCheckDiagnostic{
+				{
+					Rule:       "economics.minGasPrice",
+					Severity:   "error",
+					Field:      "gasPrice",
+					Expected:   ">= 1000000000",
+					Actual:     "10",
+					Message:    "the gas price is lower than the minimum gas price",
+					Suggestion: "set the gas price to at least 1000000000",
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				assert.Equal(t, uint64(7), txArgs.Nonce)
+				txHash, _ := hex.DecodeString(hexTxHash)
+				return providedTx, txHash, nil
+			},
+			CheckTransactionCalled: func(tx *dataTx.Transaction) (*common.TransactionCheckApiResponse, error) {
+				assert.Equal(t, providedTx, tx)
+				return expectedResult, nil
+			},
+		}
+
+		txBytes, _ := json.Marshal(&dataTx.FrontendTransaction{Nonce: 7})
+		response := &checkTransactionResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/check",
+			"POST",
+			bytes.NewBuffer(txBytes),
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Equal(t, hexTxHash, response.Data.TxHash)
+		assert.Equal(t, *expectedResult, response.Data.Result)
+	})
+}
+
+func TestTransactionGroup_getTransactionsPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/pool", nil))
+	t.Run("invalid last-nonce param should error", testTransactionGroupErrorScenario("/transaction/pool?last-nonce=not-bool", "GET", nil, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("invalid nonce-gaps param should error", testTransactionGroupErrorScenario("/transaction/pool?nonce-gaps=not-bool", "GET", nil, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("empty sender, requesting latest nonce", testTxPoolWithInvalidQuery("?last-nonce=true", apiErrors.ErrEmptySenderToGetLatestNonce))
+	t.Run("empty sender, requesting nonce gaps", testTxPoolWithInvalidQuery("?nonce-gaps=true", apiErrors.ErrEmptySenderToGetNonceGaps))
+	t.Run("fields + latest nonce", testTxPoolWithInvalidQuery("?fields=sender,receiver&last-nonce=true", apiErrors.ErrFetchingLatestNonceCannotIncludeFields))
+	t.Run("fields + nonce gaps", testTxPoolWithInvalidQuery("?fields=sender,receiver&nonce-gaps=true", apiErrors.ErrFetchingNonceGapsCannotIncludeFields))
+	t.Run("invalid score param should error", testTransactionGroupErrorScenario("/transaction/pool?score=not-bool", "GET", nil, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("empty sender, requesting score", testTxPoolWithInvalidQuery("?score=true", apiErrors.ErrEmptySenderToGetSenderScore))
+	t.Run("fields + score", testTxPoolWithInvalidQuery("?by-sender=sender&fields=sender&score=true", apiErrors.ErrFetchingSenderScoreCannotIncludeFields))
+	t.Run("fields has spaces", testTxPoolWithInvalidQuery("?fields=sender ,receiver", apiErrors.ErrInvalidFields))
+	t.Run("fields has numbers", testTxPoolWithInvalidQuery("?fields=sender1", apiErrors.ErrInvalidFields))
+	t.Run("GetTransactionsPool error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("GetTransactionsPoolForSender error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolForSenderCalled: func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool?by-sender=sender",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("GetLastPoolNonceForSender error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetLastPoolNonceForSenderCalled: func(sender string) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool?by-sender=sender&last-nonce=true",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("GetTransactionsPoolNonceGapsForSender error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolNonceGapsForSenderCalled: func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool?by-sender=sender&nonce-gaps=true",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("GetTransactionsPoolSenderScore error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolSenderScoreCalled: func(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool?by-sender=sender&score=true",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTxPool := &common.TransactionsPoolAPIResponse{
+			RegularTransactions: []common.Transaction{
+				{
+					TxFields: map[string]interface{}{
+						"hash": "tx",
+					},
+				},
+				{
+					TxFields: map[string]interface{}{
+						"hash": "tx2",
+					},
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+				return expectedTxPool, nil
+			},
+		}
+
+		response := &txsPoolResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/pool",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedTxPool, response.Data.TxPool)
+	})
+	t.Run("should work for sender", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSender := "sender"
+		providedFields := "sender,receiver"
+		query := "?by-sender=" + expectedSender + "&fields=" + providedFields
+		expectedResp := &common.TransactionsPoolForSenderApiResponse{
+			Transactions: []common.Transaction{
+				{
+					TxFields: map[string]interface{}{
+						"hash":     "txHash1",
+						"sender":   expectedSender,
+						"receiver": "receiver1",
+					},
+				},
+				{
+					TxFields: map[string]interface{}{
+						"hash":     "txHash2",
+						"sender":   expectedSender,
+						"receiver": "receiver2",
+					},
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolForSenderCalled: func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error) {
+				return expectedResp, nil
+			},
+		}
+
+		response := &poolForSenderResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/pool"+query,
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedResp, response.Data.TxPool)
+	})
+	t.Run("should work for last pool nonce", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSender := "sender"
+		query := "?by-sender=" + expectedSender + "&last-nonce=true"
+		expectedNonce := uint64(33)
+		facade := &mock.FacadeStub{
+			GetLastPoolNonceForSenderCalled: func(sender string) (uint64, error) {
+				return expectedNonce, nil
+			},
+		}
 
 		response := &lastPoolNonceForSenderResponse{}
 		loadTransactionGroupResponse(
@@ -910,6 +1672,108 @@ func TestTransactionGroup_getTransactionsPool(t *testing.T) {
 		assert.Empty(t, response.Error)
 		assert.Equal(t, *expectedNonceGaps, response.Data.NonceGaps)
 	})
+	t.Run("should work for sender score", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSender := "sender"
+		query := "?by-sender=" + expectedSender + "&score=true"
+		expectedScore := &common.TransactionsPoolSenderScoreApiResponse{
+			Sender:     expectedSender,
+			Score:      42,
+			Rank:       2,
+			NumSenders: 10,
+			NumTxs:     3,
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolSenderScoreCalled: func(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error) {
+				assert.Equal(t, expectedSender, sender)
+				return expectedScore, nil
+			},
+		}
+
+		response := &txPoolSenderScoreResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/pool"+query,
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedScore, response.Data.Score)
+	})
+}
+
+func TestTransactionGroup_getTransactionsPoolSelectionPreview(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/pool/selection-preview", nil))
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolSelectionPreviewCalled: func(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool/selection-preview",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSender := "sender"
+		expectedPreview := &common.TransactionsPoolSelectionPreviewApiResponse{
+			NumSelected: 1,
+			GasSelected: 50000,
+			Selected: []common.TransactionsPoolSelectionPreviewItem{
+				{
+					Hash:     "txHash1",
+					Sender:   expectedSender,
+					Nonce:    1,
+					GasLimit: 50000,
+					GasPrice: 1000000000,
+				},
+			},
+			Skipped: []common.TransactionsPoolSelectionPreviewItem{
+				{
+					Hash:       "txHash2",
+					Sender:     expectedSender,
+					Nonce:      3,
+					GasLimit:   50000,
+					GasPrice:   1000000000,
+					SkipReason: "nonce gap",
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolSelectionPreviewCalled: func(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error) {
+				assert.Equal(t, expectedSender, sender)
+				return expectedPreview, nil
+			},
+		}
+
+		response := &txPoolSelectionPreviewResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/pool/selection-preview?by-sender="+expectedSender,
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedPreview, response.Data.SelectionPreview)
+	})
 }
 
 func testTxPoolWithInvalidQuery(query string, expectedErr error) func(t *testing.T) {
@@ -1119,9 +1983,16 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/send-multiple", Open: true},
 					{Name: "/cost", Open: true},
 					{Name: "/pool", Open: true},
+					{Name: "/pool/selection-preview", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/simulate-bundle", Open: true},
+					{Name: "/trace", Open: true},
+					{Name: "/trace/:txhash", Open: true},
+					{Name: "/replay/:txhash", Open: true},
+					{Name: "/queue", Open: true},
+					{Name: "/check", Open: true},
 				},
 			},
 		},
@@ -49,7 +49,12 @@ type FacadeStub struct {
 	GetUsernameCalled                           func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetCodeHashCalled                           func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
 	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundleHandler           func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionExecutionHandler            func(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceExecutedTransactionHandler             func(txHash string) (*txSimData.TransactionTrace, error)
+	ReplayTransactionHandler                    func(txHash string) (*txSimData.ReplayResults, error)
+	GetTransactionStateDiffHandler              func(hash string) ([]*txSimData.AccountStateDiff, error)
 	GetESDTDataCalled                           func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetAllESDTTokensCalled                      func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetESDTsWithRoleCalled                      func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
@@ -76,6 +81,15 @@ type FacadeStub struct {
 	GetProofCurrentRootHashCalled               func(string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                      func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                           func(string, string, [][]byte) (bool, error)
+	GetMultiProofCalled                         func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProofCalled                      func(rootHash string, addresses []string, values []string, proof [][]byte) (bool, error)
+	GetRangeProofDataTrieCalled                 func(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyRangeProofCalled                      func(rootHash string, address string, startKey string, endKey string, proof [][]byte) (bool, []core.KeyValueHolder, error)
+	GetAbsenceProofCalled                       func(rootHash string, address string) (*common.GetProofResponse, error)
+	GetAbsenceProofDataTrieCalled               func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyAbsenceProofCalled                    func(rootHash string, address string, proof [][]byte) (bool, error)
+	VerifyAbsenceProofDataTrieCalled            func(dataTrieRootHash string, key string, proof [][]byte) (bool, error)
+	GetTrieStorageReportCalled                  func(rootHash string, numLargestDataTries int) (*common.TrieStorageReport, error)
 	GetTokenSupplyCalled                        func(token string) (*api.ESDTSupply, error)
 	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
@@ -83,6 +97,12 @@ type FacadeStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionStatusCalled                  func(hash string) (*common.TransactionStatusApiResponse, error)
+	GetTransactionsPoolSenderScoreCalled        func(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error)
+	GetTransactionsPoolSelectionPreviewCalled   func(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error)
+	EnqueueTransactionCalled                    func(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error)
+	GetTransactionsQueueStateCalled             func() *common.TransactionsQueueStateApiResponse
+	CheckTransactionCalled                      func(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error)
 	GetGasConfigsCalled                         func() (map[string]map[string]uint64, error)
 	RestApiInterfaceCalled                      func() string
 	RestAPIServerDebugModeCalled                func() bool
@@ -97,6 +117,7 @@ type FacadeStub struct {
 	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
 	P2PPrometheusMetricsEnabledCalled           func() bool
 	AuctionListHandler                          func() ([]*common.AuctionListValidatorAPIResponse, error)
+	QueryEventsCalled                           func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error)
 }
 
 // GetTokenSupply -
@@ -108,6 +129,15 @@ func (f *FacadeStub) GetTokenSupply(token string) (*api.ESDTSupply, error) {
 	return nil, nil
 }
 
+// QueryEvents -
+func (f *FacadeStub) QueryEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+	if f.QueryEventsCalled != nil {
+		return f.QueryEventsCalled(options)
+	}
+
+	return nil, nil
+}
+
 // GetProof -
 func (f *FacadeStub) GetProof(rootHash string, address string) (*common.GetProofResponse, error) {
 	if f.GetProofCalled != nil {
@@ -144,6 +174,87 @@ func (f *FacadeStub) VerifyProof(rootHash string, address string, proof [][]byte
 	return false, nil
 }
 
+// GetMultiProof -
+func (f *FacadeStub) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	if f.GetMultiProofCalled != nil {
+		return f.GetMultiProofCalled(rootHash, addresses)
+	}
+
+	return nil, nil
+}
+
+// VerifyMultiProof -
+func (f *FacadeStub) VerifyMultiProof(rootHash string, addresses []string, values []string, proof [][]byte) (bool, error) {
+	if f.VerifyMultiProofCalled != nil {
+		return f.VerifyMultiProofCalled(rootHash, addresses, values, proof)
+	}
+
+	return false, nil
+}
+
+// GetRangeProofDataTrie -
+func (f *FacadeStub) GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	if f.GetRangeProofDataTrieCalled != nil {
+		return f.GetRangeProofDataTrieCalled(rootHash, address, startKey, endKey, maxLeaves)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyRangeProof -
+func (f *FacadeStub) VerifyRangeProof(rootHash string, address string, startKey string, endKey string, proof [][]byte) (bool, []core.KeyValueHolder, error) {
+	if f.VerifyRangeProofCalled != nil {
+		return f.VerifyRangeProofCalled(rootHash, address, startKey, endKey, proof)
+	}
+
+	return false, nil, nil
+}
+
+// GetAbsenceProof -
+func (f *FacadeStub) GetAbsenceProof(rootHash string, address string) (*common.GetProofResponse, error) {
+	if f.GetAbsenceProofCalled != nil {
+		return f.GetAbsenceProofCalled(rootHash, address)
+	}
+
+	return nil, nil
+}
+
+// GetAbsenceProofDataTrie -
+func (f *FacadeStub) GetAbsenceProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+	if f.GetAbsenceProofDataTrieCalled != nil {
+		return f.GetAbsenceProofDataTrieCalled(rootHash, address, key)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyAbsenceProof -
+func (f *FacadeStub) VerifyAbsenceProof(rootHash string, address string, proof [][]byte) (bool, error) {
+	if f.VerifyAbsenceProofCalled != nil {
+		return f.VerifyAbsenceProofCalled(rootHash, address, proof)
+	}
+
+	return false, nil
+}
+
+// VerifyAbsenceProofDataTrie -
+func (f *FacadeStub) VerifyAbsenceProofDataTrie(dataTrieRootHash string, key string, proof [][]byte) (bool, error) {
+	if f.VerifyAbsenceProofDataTrieCalled != nil {
+		return f.VerifyAbsenceProofDataTrieCalled(dataTrieRootHash, key, proof)
+	}
+
+	return false, nil
+}
+
+// GetTrieStorageReport -
+func (f *FacadeStub) GetTrieStorageReport(rootHash string, numLargestDataTries int) (*common.TrieStorageReport, error) {
+	if f.GetTrieStorageReportCalled != nil {
+		return f.GetTrieStorageReportCalled(rootHash, numLargestDataTries)
+	}
+
+	return nil, nil
+}
+
 // GetUsername -
 func (f *FacadeStub) GetUsername(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if f.GetUsernameCalled != nil {
@@ -330,9 +441,54 @@ func (f *FacadeStub) GetTransaction(hash string, withResults bool) (*transaction
 }
 
 // SimulateTransactionExecution is the mock implementation of a handler's SimulateTransactionExecution method
-func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if f.SimulateTransactionExecutionHandler != nil {
-		return f.SimulateTransactionExecutionHandler(tx)
+		return f.SimulateTransactionExecutionHandler(tx, withStateDiff)
+	}
+
+	return nil, nil
+}
+
+// SimulateTransactionsBundle is the mock implementation of a handler's SimulateTransactionsBundle method
+func (f *FacadeStub) SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if f.SimulateTransactionsBundleHandler != nil {
+		return f.SimulateTransactionsBundleHandler(txs)
+	}
+
+	return nil, nil
+}
+
+// TraceTransactionExecution is the mock implementation of a handler's TraceTransactionExecution method
+func (f *FacadeStub) TraceTransactionExecution(tx *transaction.Transaction) (*txSimData.TransactionTrace, error) {
+	if f.TraceTransactionExecutionHandler != nil {
+		return f.TraceTransactionExecutionHandler(tx)
+	}
+
+	return nil, nil
+}
+
+// TraceExecutedTransaction is the mock implementation of a handler's TraceExecutedTransaction method
+func (f *FacadeStub) TraceExecutedTransaction(txHash string) (*txSimData.TransactionTrace, error) {
+	if f.TraceExecutedTransactionHandler != nil {
+		return f.TraceExecutedTransactionHandler(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionStateDiff is the mock implementation of a handler's GetTransactionStateDiff method
+func (f *FacadeStub) GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error) {
+	if f.GetTransactionStateDiffHandler != nil {
+		return f.GetTransactionStateDiffHandler(hash)
+	}
+
+	return nil, nil
+}
+
+// ReplayTransaction is the mock implementation of a handler's ReplayTransaction method
+func (f *FacadeStub) ReplayTransaction(txHash string) (*txSimData.ReplayResults, error) {
+	if f.ReplayTransactionHandler != nil {
+		return f.ReplayTransactionHandler(txHash)
 	}
 
 	return nil, nil
@@ -642,6 +798,60 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*comm
 	return nil, nil
 }
 
+// GetTransactionStatus -
+func (f *FacadeStub) GetTransactionStatus(hash string) (*common.TransactionStatusApiResponse, error) {
+	if f.GetTransactionStatusCalled != nil {
+		return f.GetTransactionStatusCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolSenderScore -
+func (f *FacadeStub) GetTransactionsPoolSenderScore(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error) {
+	if f.GetTransactionsPoolSenderScoreCalled != nil {
+		return f.GetTransactionsPoolSenderScoreCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolSelectionPreview -
+func (f *FacadeStub) GetTransactionsPoolSelectionPreview(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error) {
+	if f.GetTransactionsPoolSelectionPreviewCalled != nil {
+		return f.GetTransactionsPoolSelectionPreviewCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// EnqueueTransaction -
+func (f *FacadeStub) EnqueueTransaction(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error) {
+	if f.EnqueueTransactionCalled != nil {
+		return f.EnqueueTransactionCalled(request)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsQueueState -
+func (f *FacadeStub) GetTransactionsQueueState() *common.TransactionsQueueStateApiResponse {
+	if f.GetTransactionsQueueStateCalled != nil {
+		return f.GetTransactionsQueueStateCalled()
+	}
+
+	return nil
+}
+
+// CheckTransaction -
+func (f *FacadeStub) CheckTransaction(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error) {
+	if f.CheckTransactionCalled != nil {
+		return f.CheckTransactionCalled(tx)
+	}
+
+	return nil, nil
+}
+
 // GetGasConfigs -
 func (f *FacadeStub) GetGasConfigs() (map[string]map[string]uint64, error) {
 	if f.GetGasConfigsCalled != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/api/subscriptions"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/debug"
@@ -50,6 +51,12 @@ type UpgradeableHttpServerHandler interface {
 	IsInterfaceNil() bool
 }
 
+// SubscriptionsHandler defines the actions of the component serving the web socket subscriptions
+type SubscriptionsHandler interface {
+	HandleConnection(conn subscriptions.WsConn) error
+	IsInterfaceNil() bool
+}
+
 // GroupHandler defines the actions needed to be performed by a gin API group
 type GroupHandler interface {
 	UpdateFacade(newFacade interface{}) error
@@ -95,6 +102,7 @@ type FacadeHandler interface {
 	GetDelegatorsList() ([]*api.Delegator, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
+	QueryEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error)
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	GetQueryHandler(name string) (debug.QueryHandler, error)
@@ -105,12 +113,26 @@ type FacadeHandler interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProof(rootHash string, addresses []string, values []string, proof [][]byte) (bool, error)
+	GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyRangeProof(rootHash string, address string, startKey string, endKey string, proof [][]byte) (bool, []core.KeyValueHolder, error)
+	GetAbsenceProof(rootHash string, address string) (*common.GetProofResponse, error)
+	GetAbsenceProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyAbsenceProof(rootHash string, address string, proof [][]byte) (bool, error)
+	VerifyAbsenceProofDataTrie(dataTrieRootHash string, key string, proof [][]byte) (bool, error)
+	GetTrieStorageReport(rootHash string, numLargestDataTries int) (*common.TrieStorageReport, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionExecution(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceExecutedTransaction(txHash string) (*txSimData.TransactionTrace, error)
+	ReplayTransaction(txHash string) (*txSimData.ReplayResults, error)
+	GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
@@ -128,6 +150,12 @@ type FacadeHandler interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionStatus(hash string) (*common.TransactionStatusApiResponse, error)
+	GetTransactionsPoolSenderScore(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error)
+	GetTransactionsPoolSelectionPreview(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error)
+	EnqueueTransaction(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error)
+	GetTransactionsQueueState() *common.TransactionsQueueStateApiResponse
+	CheckTransaction(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
 	GetManagedKeysCount() int
 	GetManagedKeys() []string
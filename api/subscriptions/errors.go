@@ -0,0 +1,30 @@
+package subscriptions
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilWsConn signals that a nil web socket connection has been provided
+var ErrNilWsConn = errors.New("nil web socket connection")
+
+// ErrInvalidConfigValue signals that an invalid configuration value has been provided
+var ErrInvalidConfigValue = errors.New("invalid configuration value")
+
+// ErrTooManyConnections signals that the maximum number of web socket connections has been reached
+var ErrTooManyConnections = errors.New("too many web socket connections")
+
+// ErrHubClosed signals that the subscriptions hub was closed
+var ErrHubClosed = errors.New("subscriptions hub is closed")
+
+var errUnknownMethod = errors.New("unknown method")
+var errUnknownTopic = errors.New("unknown topic")
+var errUnknownSubscription = errors.New("unknown subscription")
+var errTooManySubscriptions = errors.New("too many subscriptions on this connection")
+var errTooManyFilterValues = errors.New("too many filter values")
+var errMissingHashes = errors.New("the txStatus topic requires at least one transaction hash")
+var errInvalidHash = errors.New("invalid transaction hash")
+var errInvalidAddress = errors.New("invalid address")
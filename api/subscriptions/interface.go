@@ -0,0 +1,22 @@
+package subscriptions
+
+import (
+	"io"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+)
+
+// WsConn defines the web socket connection operations used by a subscriber
+type WsConn interface {
+	io.Closer
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+	SetWriteDeadline(t time.Time) error
+	SetReadLimit(limit int64)
+}
+
+type blockContainerHandler interface {
+	Get(headerType core.HeaderType) (block.EmptyBlockCreator, error)
+}
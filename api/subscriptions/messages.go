@@ -0,0 +1,87 @@
+package subscriptions
+
+const (
+	// TopicBlocks is the topic notifying every block committed by the node
+	TopicBlocks = "blocks"
+	// TopicFinalizedBlocks is the topic notifying every block finalized by the node
+	TopicFinalizedBlocks = "finalizedBlocks"
+	// TopicTxStatus is the topic notifying the status changes of the watched transactions
+	TopicTxStatus = "txStatus"
+	// TopicLogs is the topic notifying the events emitted by the executed transactions
+	TopicLogs = "logs"
+
+	// MethodSubscribe is the request method creating a new subscription
+	MethodSubscribe = "subscribe"
+	// MethodUnsubscribe is the request method removing an existing subscription
+	MethodUnsubscribe = "unsubscribe"
+
+	// TxStatusIncluded signals that the transaction was included in a committed block
+	TxStatusIncluded = "included"
+	// TxStatusInvalid signals that the transaction was included in a committed block as invalid
+	TxStatusInvalid = "invalid"
+	// TxStatusFinalized signals that the block including the transaction was finalized
+	TxStatusFinalized = "finalized"
+	// TxStatusReverted signals that the block including the transaction was reverted
+	TxStatusReverted = "reverted"
+)
+
+// Request is the message sent by a client on the subscriptions web socket
+type Request struct {
+	ID     uint64        `json:"id"`
+	Method string        `json:"method"`
+	Params RequestParams `json:"params"`
+}
+
+// RequestParams holds the parameters of a subscriptions request
+type RequestParams struct {
+	Topic        string   `json:"topic,omitempty"`
+	Hashes       []string `json:"hashes,omitempty"`
+	Addresses    []string `json:"addresses,omitempty"`
+	Identifiers  []string `json:"identifiers,omitempty"`
+	Subscription string   `json:"subscription,omitempty"`
+}
+
+// Response is the message sent to a client as an answer to one of its requests
+type Response struct {
+	ID     uint64      `json:"id"`
+	Result interface{} `json:"result,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// Notification is the message sent to a client for each event matching one of its subscriptions
+type Notification struct {
+	Subscription string      `json:"subscription"`
+	Topic        string      `json:"topic"`
+	Data         interface{} `json:"data"`
+}
+
+// BlockNotification holds the data of a committed, finalized or reverted block
+type BlockNotification struct {
+	Hash      string `json:"hash"`
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Epoch     uint32 `json:"epoch"`
+	ShardID   uint32 `json:"shardID"`
+	Timestamp uint64 `json:"timestamp"`
+	NumTxs    uint32 `json:"numTxs"`
+	Reverted  bool   `json:"reverted,omitempty"`
+}
+
+// TxStatusNotification holds the new status of a watched transaction
+type TxStatusNotification struct {
+	Hash       string `json:"hash"`
+	Status     string `json:"status"`
+	BlockHash  string `json:"blockHash"`
+	BlockNonce uint64 `json:"blockNonce"`
+}
+
+// LogNotification holds an event emitted by an executed transaction
+type LogNotification struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+	BlockHash  string   `json:"blockHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+}
@@ -0,0 +1,151 @@
+package subscriptions
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const maxRequestSizeInBytes = 64 * 1024
+
+type subscriber struct {
+	conn         WsConn
+	sendQueue    chan []byte
+	writeTimeout time.Duration
+	closeChan    chan struct{}
+	closeOnce    sync.Once
+	closeCode    int
+	closeReason  string
+
+	mutSubscriptions   sync.RWMutex
+	subscriptions      map[string]*subscription
+	subscriptionsNonce uint64
+}
+
+func newSubscriber(conn WsConn, sendBufferSize uint32, writeTimeout time.Duration) *subscriber {
+	return &subscriber{
+		conn:          conn,
+		sendQueue:     make(chan []byte, sendBufferSize),
+		writeTimeout:  writeTimeout,
+		closeChan:     make(chan struct{}),
+		subscriptions: make(map[string]*subscription),
+	}
+}
+
+// enqueue adds the message to the send queue without blocking. A subscriber that does not keep up with
+// the produced messages gets disconnected so it will not slow down the node or hold an unbounded queue
+func (s *subscriber) enqueue(message []byte) {
+	select {
+	case <-s.closeChan:
+		return
+	default:
+	}
+
+	select {
+	case s.sendQueue <- message:
+	default:
+		log.Debug("subscriber is too slow, closing the connection", "queue size", cap(s.sendQueue))
+		s.close(websocket.ClosePolicyViolation, "send buffer is full")
+	}
+}
+
+func (s *subscriber) enqueueJSON(value interface{}) {
+	message, err := json.Marshal(value)
+	if err != nil {
+		log.Warn("subscriber.enqueueJSON: cannot marshal message", "error", err)
+		return
+	}
+
+	s.enqueue(message)
+}
+
+func (s *subscriber) notify(topic string, data interface{}, matches func(sub *subscription) bool) {
+	s.mutSubscriptions.RLock()
+	ids := make([]string, 0)
+	for id, sub := range s.subscriptions {
+		if sub.topic == topic && matches(sub) {
+			ids = append(ids, id)
+		}
+	}
+	s.mutSubscriptions.RUnlock()
+
+	for _, id := range ids {
+		s.enqueueJSON(&Notification{
+			Subscription: id,
+			Topic:        topic,
+			Data:         data,
+		})
+	}
+}
+
+func (s *subscriber) addSubscription(sub *subscription, maxSubscriptions uint32) error {
+	s.mutSubscriptions.Lock()
+	defer s.mutSubscriptions.Unlock()
+
+	if uint32(len(s.subscriptions)) >= maxSubscriptions {
+		return errTooManySubscriptions
+	}
+
+	s.subscriptionsNonce++
+	sub.id = fmt.Sprintf("0x%x", s.subscriptionsNonce)
+	s.subscriptions[sub.id] = sub
+
+	return nil
+}
+
+func (s *subscriber) removeSubscription(id string) error {
+	s.mutSubscriptions.Lock()
+	defer s.mutSubscriptions.Unlock()
+
+	_, found := s.subscriptions[id]
+	if !found {
+		return errUnknownSubscription
+	}
+
+	delete(s.subscriptions, id)
+
+	return nil
+}
+
+func (s *subscriber) writeLoop() {
+	defer func() {
+		_ = s.conn.Close()
+	}()
+
+	for {
+		select {
+		case message := <-s.sendQueue:
+			err := s.write(websocket.TextMessage, message)
+			if err != nil {
+				log.Debug("subscriber.writeLoop: cannot write message", "error", err)
+				s.close(websocket.CloseAbnormalClosure, "")
+				return
+			}
+		case <-s.closeChan:
+			if s.closeCode != websocket.CloseAbnormalClosure {
+				_ = s.write(websocket.CloseMessage, websocket.FormatCloseMessage(s.closeCode, s.closeReason))
+			}
+			return
+		}
+	}
+}
+
+func (s *subscriber) write(messageType int, message []byte) error {
+	err := s.conn.SetWriteDeadline(time.Now().Add(s.writeTimeout))
+	if err != nil {
+		return err
+	}
+
+	return s.conn.WriteMessage(messageType, message)
+}
+
+func (s *subscriber) close(code int, reason string) {
+	s.closeOnce.Do(func() {
+		s.closeCode = code
+		s.closeReason = reason
+		close(s.closeChan)
+	})
+}
@@ -0,0 +1,41 @@
+package subscriptions
+
+type subscription struct {
+	id          string
+	topic       string
+	hashes      map[string]struct{}
+	addresses   map[string]struct{}
+	identifiers map[string]struct{}
+}
+
+func (s *subscription) matchesTxHash(hash string) bool {
+	_, found := s.hashes[hash]
+	return found
+}
+
+// matchesLogEvent returns true if the event matches the address and identifier filters. An empty filter matches everything
+func (s *subscription) matchesLogEvent(address []byte, identifier []byte) bool {
+	if len(s.addresses) > 0 {
+		_, found := s.addresses[string(address)]
+		if !found {
+			return false
+		}
+	}
+	if len(s.identifiers) > 0 {
+		_, found := s.identifiers[string(identifier)]
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sliceToSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+
+	return set
+}
@@ -0,0 +1,590 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const maxRecentBlocks = 100
+
+var log = logger.GetOrCreate("api/subscriptions")
+
+// ArgsSubscriptionsHub holds the arguments needed to create a subscriptions hub
+type ArgsSubscriptionsHub struct {
+	Config          config.WebSocketSubscriptionsConfig
+	Marshaller      marshal.Marshalizer
+	PubkeyConverter core.PubkeyConverter
+}
+
+type recentBlock struct {
+	notification BlockNotification
+	txHashes     []string
+}
+
+type subscriptionsHub struct {
+	config          config.WebSocketSubscriptionsConfig
+	marshaller      marshal.Marshalizer
+	pubkeyConverter core.PubkeyConverter
+	blockContainer  blockContainerHandler
+
+	mutSubscribers sync.RWMutex
+	subscribers    map[*subscriber]struct{}
+	closed         bool
+
+	mutRecentBlocks   sync.Mutex
+	recentBlocks      map[string]*recentBlock
+	recentBlocksOrder []string
+}
+
+// NewSubscriptionsHub creates the component that feeds the web socket subscribers with the data pushed by the node's outport.
+// It implements the outport driver interface so it can be subscribed to the outport handler
+func NewSubscriptionsHub(args ArgsSubscriptionsHub) (*subscriptionsHub, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	blockContainer, err := createBlockCreatorsContainer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionsHub{
+		config:          args.Config,
+		marshaller:      args.Marshaller,
+		pubkeyConverter: args.PubkeyConverter,
+		blockContainer:  blockContainer,
+		subscribers:     make(map[*subscriber]struct{}),
+		recentBlocks:    make(map[string]*recentBlock),
+	}, nil
+}
+
+func checkArgs(args ArgsSubscriptionsHub) error {
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return ErrNilPubkeyConverter
+	}
+	if args.Config.MaxConnections == 0 {
+		return fmt.Errorf("%w for MaxConnections", ErrInvalidConfigValue)
+	}
+	if args.Config.MaxSubscriptionsPerConnection == 0 {
+		return fmt.Errorf("%w for MaxSubscriptionsPerConnection", ErrInvalidConfigValue)
+	}
+	if args.Config.MaxFilterValues == 0 {
+		return fmt.Errorf("%w for MaxFilterValues", ErrInvalidConfigValue)
+	}
+	if args.Config.SendBufferSize == 0 {
+		return fmt.Errorf("%w for SendBufferSize", ErrInvalidConfigValue)
+	}
+	if args.Config.WriteTimeoutInSec == 0 {
+		return fmt.Errorf("%w for WriteTimeoutInSec", ErrInvalidConfigValue)
+	}
+
+	return nil
+}
+
+func createBlockCreatorsContainer() (blockContainerHandler, error) {
+	container := block.NewEmptyBlockCreatorsContainer()
+	err := container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.ShardHeaderV2, block.NewEmptyHeaderV2Creator())
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.MetaHeader, block.NewEmptyMetaBlockCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	return container, nil
+}
+
+// HandleConnection serves the subscriptions requests received on the provided connection and pushes the
+// matching notifications. It blocks until the connection is closed
+func (hub *subscriptionsHub) HandleConnection(conn WsConn) error {
+	if conn == nil {
+		return ErrNilWsConn
+	}
+
+	sub := newSubscriber(conn, hub.config.SendBufferSize, time.Duration(hub.config.WriteTimeoutInSec)*time.Second)
+	err := hub.addSubscriber(sub)
+	if err != nil {
+		return err
+	}
+
+	writeDone := make(chan struct{})
+	go func() {
+		sub.writeLoop()
+		close(writeDone)
+	}()
+
+	hub.readLoop(sub)
+
+	hub.removeSubscriber(sub)
+	sub.close(websocket.CloseNormalClosure, "")
+	<-writeDone
+
+	return nil
+}
+
+func (hub *subscriptionsHub) addSubscriber(sub *subscriber) error {
+	hub.mutSubscribers.Lock()
+	defer hub.mutSubscribers.Unlock()
+
+	if hub.closed {
+		return ErrHubClosed
+	}
+	if uint32(len(hub.subscribers)) >= hub.config.MaxConnections {
+		return ErrTooManyConnections
+	}
+
+	hub.subscribers[sub] = struct{}{}
+
+	return nil
+}
+
+func (hub *subscriptionsHub) removeSubscriber(sub *subscriber) {
+	hub.mutSubscribers.Lock()
+	delete(hub.subscribers, sub)
+	hub.mutSubscribers.Unlock()
+}
+
+func (hub *subscriptionsHub) getSubscribers() []*subscriber {
+	hub.mutSubscribers.RLock()
+	defer hub.mutSubscribers.RUnlock()
+
+	subscribers := make([]*subscriber, 0, len(hub.subscribers))
+	for sub := range hub.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+
+	return subscribers
+}
+
+func (hub *subscriptionsHub) readLoop(sub *subscriber) {
+	sub.conn.SetReadLimit(maxRequestSizeInBytes)
+
+	for {
+		_, message, err := sub.conn.ReadMessage()
+		if err != nil {
+			log.Trace("subscriptionsHub.readLoop: connection ended", "error", err)
+			return
+		}
+
+		request := &Request{}
+		err = json.Unmarshal(message, request)
+		if err != nil {
+			sub.enqueueJSON(&Response{Error: err.Error()})
+			continue
+		}
+
+		sub.enqueueJSON(hub.handleRequest(sub, request))
+	}
+}
+
+func (hub *subscriptionsHub) handleRequest(sub *subscriber, request *Request) *Response {
+	response := &Response{
+		ID: request.ID,
+	}
+
+	switch request.Method {
+	case MethodSubscribe:
+		newSubscription, err := hub.createSubscription(request.Params)
+		if err != nil {
+			response.Error = err.Error()
+			return response
+		}
+
+		err = sub.addSubscription(newSubscription, hub.config.MaxSubscriptionsPerConnection)
+		if err != nil {
+			response.Error = err.Error()
+			return response
+		}
+
+		response.Result = newSubscription.id
+	case MethodUnsubscribe:
+		err := sub.removeSubscription(request.Params.Subscription)
+		if err != nil {
+			response.Error = err.Error()
+			return response
+		}
+
+		response.Result = true
+	default:
+		response.Error = fmt.Sprintf("%s: %s", errUnknownMethod.Error(), request.Method)
+	}
+
+	return response
+}
+
+func (hub *subscriptionsHub) createSubscription(params RequestParams) (*subscription, error) {
+	numFilterValues := len(params.Hashes) + len(params.Addresses) + len(params.Identifiers)
+	if uint32(numFilterValues) > hub.config.MaxFilterValues {
+		return nil, fmt.Errorf("%w, provided %d, maximum %d", errTooManyFilterValues, numFilterValues, hub.config.MaxFilterValues)
+	}
+
+	newSubscription := &subscription{
+		topic: params.Topic,
+	}
+
+	switch params.Topic {
+	case TopicBlocks, TopicFinalizedBlocks:
+		return newSubscription, nil
+	case TopicTxStatus:
+		hashes, err := normalizeHashes(params.Hashes)
+		if err != nil {
+			return nil, err
+		}
+
+		newSubscription.hashes = sliceToSet(hashes)
+		return newSubscription, nil
+	case TopicLogs:
+		addresses, err := hub.decodeAddresses(params.Addresses)
+		if err != nil {
+			return nil, err
+		}
+
+		newSubscription.addresses = sliceToSet(addresses)
+		newSubscription.identifiers = sliceToSet(params.Identifiers)
+		return newSubscription, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownTopic, params.Topic)
+	}
+}
+
+func normalizeHashes(hashes []string) ([]string, error) {
+	if len(hashes) == 0 {
+		return nil, errMissingHashes
+	}
+
+	normalized := make([]string, 0, len(hashes))
+	for _, hash := range hashes {
+		hash = strings.ToLower(hash)
+		_, err := hex.DecodeString(hash)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: %s", errInvalidHash, hash, err.Error())
+		}
+
+		normalized = append(normalized, hash)
+	}
+
+	return normalized, nil
+}
+
+func (hub *subscriptionsHub) decodeAddresses(addresses []string) ([]string, error) {
+	decoded := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		addressBytes, err := hub.pubkeyConverter.Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: %s", errInvalidAddress, address, err.Error())
+		}
+
+		decoded = append(decoded, string(addressBytes))
+	}
+
+	return decoded, nil
+}
+
+// SaveBlock notifies the new block, the included watched transactions and the emitted events
+func (hub *subscriptionsHub) SaveBlock(outportBlock *outportcore.OutportBlock) error {
+	if outportBlock == nil || outportBlock.BlockData == nil {
+		return nil
+	}
+
+	header, err := hub.getHeader(outportBlock.BlockData)
+	if err != nil {
+		log.Warn("subscriptionsHub.SaveBlock: cannot decode header", "error", err)
+		return nil
+	}
+
+	blockHash := hex.EncodeToString(outportBlock.BlockData.HeaderHash)
+	notification := createBlockNotification(blockHash, header)
+	txStatuses := getTxStatuses(outportBlock.TransactionPool)
+	hub.addRecentBlock(notification, txStatuses)
+
+	subscribers := hub.getSubscribers()
+	hub.notifyBlock(subscribers, TopicBlocks, notification)
+	hub.notifyTxStatuses(subscribers, txStatuses, notification)
+	hub.notifyLogs(subscribers, outportBlock.TransactionPool, notification)
+
+	return nil
+}
+
+// RevertIndexedBlock notifies the reverted block and the reverted watched transactions
+func (hub *subscriptionsHub) RevertIndexedBlock(blockData *outportcore.BlockData) error {
+	if blockData == nil {
+		return nil
+	}
+
+	header, err := hub.getHeader(blockData)
+	if err != nil {
+		log.Warn("subscriptionsHub.RevertIndexedBlock: cannot decode header", "error", err)
+		return nil
+	}
+
+	blockHash := hex.EncodeToString(blockData.HeaderHash)
+	notification := createBlockNotification(blockHash, header)
+	notification.Reverted = true
+	recent := hub.removeRecentBlock(blockHash)
+
+	txStatuses := make(map[string]string)
+	if recent != nil {
+		for _, txHash := range recent.txHashes {
+			txStatuses[txHash] = TxStatusReverted
+		}
+	}
+
+	subscribers := hub.getSubscribers()
+	hub.notifyBlock(subscribers, TopicBlocks, notification)
+	hub.notifyTxStatuses(subscribers, txStatuses, notification)
+
+	return nil
+}
+
+// FinalizedBlock notifies the finalized block and the finalized watched transactions. Only the blocks
+// recently saved by the hub can be notified since the outport only provides the header hash
+func (hub *subscriptionsHub) FinalizedBlock(finalizedBlock *outportcore.FinalizedBlock) error {
+	if finalizedBlock == nil {
+		return nil
+	}
+
+	blockHash := hex.EncodeToString(finalizedBlock.HeaderHash)
+	recent := hub.removeRecentBlock(blockHash)
+	if recent == nil {
+		log.Trace("subscriptionsHub.FinalizedBlock: block not found in the recent blocks", "hash", blockHash)
+		return nil
+	}
+
+	txStatuses := make(map[string]string, len(recent.txHashes))
+	for _, txHash := range recent.txHashes {
+		txStatuses[txHash] = TxStatusFinalized
+	}
+
+	subscribers := hub.getSubscribers()
+	hub.notifyBlock(subscribers, TopicFinalizedBlocks, recent.notification)
+	hub.notifyTxStatuses(subscribers, txStatuses, recent.notification)
+
+	return nil
+}
+
+func (hub *subscriptionsHub) getHeader(blockData *outportcore.BlockData) (data.HeaderHandler, error) {
+	creator, err := hub.blockContainer.Get(core.HeaderType(blockData.HeaderType))
+	if err != nil {
+		return nil, err
+	}
+
+	return block.GetHeaderFromBytes(hub.marshaller, creator, blockData.HeaderBytes)
+}
+
+func createBlockNotification(blockHash string, header data.HeaderHandler) BlockNotification {
+	return BlockNotification{
+		Hash:      blockHash,
+		Nonce:     header.GetNonce(),
+		Round:     header.GetRound(),
+		Epoch:     header.GetEpoch(),
+		ShardID:   header.GetShardID(),
+		Timestamp: header.GetTimeStamp(),
+		NumTxs:    header.GetTxCount(),
+	}
+}
+
+func getTxStatuses(pool *outportcore.TransactionPool) map[string]string {
+	txStatuses := make(map[string]string)
+	if pool == nil {
+		return txStatuses
+	}
+
+	for txHash := range pool.Transactions {
+		txStatuses[txHash] = TxStatusIncluded
+	}
+	for scrHash := range pool.SmartContractResults {
+		txStatuses[scrHash] = TxStatusIncluded
+	}
+	for txHash := range pool.InvalidTxs {
+		txStatuses[txHash] = TxStatusInvalid
+	}
+
+	return txStatuses
+}
+
+func (hub *subscriptionsHub) addRecentBlock(notification BlockNotification, txStatuses map[string]string) {
+	txHashes := make([]string, 0, len(txStatuses))
+	for txHash := range txStatuses {
+		txHashes = append(txHashes, txHash)
+	}
+
+	hub.mutRecentBlocks.Lock()
+	defer hub.mutRecentBlocks.Unlock()
+
+	_, exists := hub.recentBlocks[notification.Hash]
+	if !exists {
+		hub.recentBlocksOrder = append(hub.recentBlocksOrder, notification.Hash)
+	}
+	hub.recentBlocks[notification.Hash] = &recentBlock{
+		notification: notification,
+		txHashes:     txHashes,
+	}
+
+	for len(hub.recentBlocksOrder) > maxRecentBlocks {
+		delete(hub.recentBlocks, hub.recentBlocksOrder[0])
+		hub.recentBlocksOrder = hub.recentBlocksOrder[1:]
+	}
+}
+
+func (hub *subscriptionsHub) removeRecentBlock(blockHash string) *recentBlock {
+	hub.mutRecentBlocks.Lock()
+	defer hub.mutRecentBlocks.Unlock()
+
+	recent, found := hub.recentBlocks[blockHash]
+	if !found {
+		return nil
+	}
+
+	delete(hub.recentBlocks, blockHash)
+	for index, hash := range hub.recentBlocksOrder {
+		if hash == blockHash {
+			hub.recentBlocksOrder = append(hub.recentBlocksOrder[:index], hub.recentBlocksOrder[index+1:]...)
+			break
+		}
+	}
+
+	return recent
+}
+
+func (hub *subscriptionsHub) notifyBlock(subscribers []*subscriber, topic string, notification BlockNotification) {
+	for _, sub := range subscribers {
+		sub.notify(topic, notification, func(_ *subscription) bool {
+			return true
+		})
+	}
+}
+
+func (hub *subscriptionsHub) notifyTxStatuses(subscribers []*subscriber, txStatuses map[string]string, blockNotification BlockNotification) {
+	if len(subscribers) == 0 {
+		return
+	}
+
+	for txHash, status := range txStatuses {
+		notification := &TxStatusNotification{
+			Hash:       txHash,
+			Status:     status,
+			BlockHash:  blockNotification.Hash,
+			BlockNonce: blockNotification.Nonce,
+		}
+
+		for _, sub := range subscribers {
+			sub.notify(TopicTxStatus, notification, func(s *subscription) bool {
+				return s.matchesTxHash(txHash)
+			})
+		}
+	}
+}
+
+func (hub *subscriptionsHub) notifyLogs(subscribers []*subscriber, pool *outportcore.TransactionPool, blockNotification BlockNotification) {
+	if len(subscribers) == 0 || pool == nil {
+		return
+	}
+
+	for _, logData := range pool.Logs {
+		if logData == nil || logData.Log == nil {
+			continue
+		}
+
+		for _, event := range logData.Log.Events {
+			if event == nil {
+				continue
+			}
+
+			notification := &LogNotification{
+				TxHash:     logData.TxHash,
+				Address:    hub.pubkeyConverter.SilentEncode(event.Address, log),
+				Identifier: string(event.Identifier),
+				Topics:     event.Topics,
+				Data:       event.Data,
+				BlockHash:  blockNotification.Hash,
+				BlockNonce: blockNotification.Nonce,
+			}
+
+			for _, sub := range subscribers {
+				sub.notify(TopicLogs, notification, func(s *subscription) bool {
+					return s.matchesLogEvent(event.Address, event.Identifier)
+				})
+			}
+		}
+	}
+}
+
+// SaveRoundsInfo does nothing
+func (hub *subscriptionsHub) SaveRoundsInfo(_ *outportcore.RoundsInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys does nothing
+func (hub *subscriptionsHub) SaveValidatorsPubKeys(_ *outportcore.ValidatorsPubKeys) error {
+	return nil
+}
+
+// SaveValidatorsRating does nothing
+func (hub *subscriptionsHub) SaveValidatorsRating(_ *outportcore.ValidatorsRating) error {
+	return nil
+}
+
+// SaveAccounts does nothing
+func (hub *subscriptionsHub) SaveAccounts(_ *outportcore.Accounts) error {
+	return nil
+}
+
+// GetMarshaller returns the marshaller used by the outport to serialize the headers
+func (hub *subscriptionsHub) GetMarshaller() marshal.Marshalizer {
+	return hub.marshaller
+}
+
+// SetCurrentSettings does nothing
+func (hub *subscriptionsHub) SetCurrentSettings(_ outportcore.OutportConfig) error {
+	return nil
+}
+
+// RegisterHandler does nothing
+func (hub *subscriptionsHub) RegisterHandler(_ func() error, _ string) error {
+	return nil
+}
+
+// Close closes all the subscribers connections and rejects the new ones
+func (hub *subscriptionsHub) Close() error {
+	hub.mutSubscribers.Lock()
+	hub.closed = true
+	subscribers := make([]*subscriber, 0, len(hub.subscribers))
+	for sub := range hub.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	hub.mutSubscribers.Unlock()
+
+	for _, sub := range subscribers {
+		sub.close(websocket.CloseGoingAway, "node is shutting down")
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hub *subscriptionsHub) IsInterfaceNil() bool {
+	return hub == nil
+}
@@ -0,0 +1,544 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = 2 * time.Second
+
+var errConnClosed = errors.New("connection closed")
+var errWriteTimeout = errors.New("write timeout")
+
+type wsConnMock struct {
+	incoming    chan []byte
+	outgoing    chan []byte
+	closeChan   chan struct{}
+	closeOnce   sync.Once
+	closeFrames chan []byte
+
+	mutDeadline   sync.Mutex
+	writeDeadline time.Time
+}
+
+func newWsConnMock() *wsConnMock {
+	return &wsConnMock{
+		incoming:    make(chan []byte, 10),
+		outgoing:    make(chan []byte, 100),
+		closeChan:   make(chan struct{}),
+		closeFrames: make(chan []byte, 1),
+	}
+}
+
+// ReadMessage -
+func (conn *wsConnMock) ReadMessage() (int, []byte, error) {
+	select {
+	case message := <-conn.incoming:
+		return websocket.TextMessage, message, nil
+	case <-conn.closeChan:
+		return 0, nil, errConnClosed
+	}
+}
+
+// WriteMessage -
+func (conn *wsConnMock) WriteMessage(messageType int, data []byte) error {
+	if messageType == websocket.CloseMessage {
+		conn.closeFrames <- data
+		return nil
+	}
+
+	conn.mutDeadline.Lock()
+	timeout := time.Until(conn.writeDeadline)
+	conn.mutDeadline.Unlock()
+
+	select {
+	case <-conn.closeChan:
+		return errConnClosed
+	case conn.outgoing <- data:
+		return nil
+	case <-time.After(timeout):
+		return errWriteTimeout
+	}
+}
+
+// SetWriteDeadline -
+func (conn *wsConnMock) SetWriteDeadline(t time.Time) error {
+	conn.mutDeadline.Lock()
+	conn.writeDeadline = t
+	conn.mutDeadline.Unlock()
+
+	return nil
+}
+
+// SetReadLimit -
+func (conn *wsConnMock) SetReadLimit(_ int64) {
+}
+
+// Close -
+func (conn *wsConnMock) Close() error {
+	conn.closeOnce.Do(func() {
+		close(conn.closeChan)
+	})
+
+	return nil
+}
+
+func (conn *wsConnMock) send(t *testing.T, request *Request) {
+	message, err := json.Marshal(request)
+	require.Nil(t, err)
+
+	conn.incoming <- message
+}
+
+func (conn *wsConnMock) receive(t *testing.T, value interface{}) {
+	select {
+	case message := <-conn.outgoing:
+		require.Nil(t, json.Unmarshal(message, value))
+	case <-time.After(testTimeout):
+		require.Fail(t, "timeout waiting for message")
+	}
+}
+
+func (conn *wsConnMock) requireNoMessage(t *testing.T) {
+	select {
+	case message := <-conn.outgoing:
+		require.Fail(t, "unexpected message", string(message))
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func createMockArgs() ArgsSubscriptionsHub {
+	return ArgsSubscriptionsHub{
+		Config: config.WebSocketSubscriptionsConfig{
+			Enabled:                       true,
+			MaxConnections:                2,
+			MaxSubscriptionsPerConnection: 2,
+			MaxFilterValues:               3,
+			SendBufferSize:                10,
+			WriteTimeoutInSec:             1,
+		},
+		Marshaller:      &marshal.GogoProtoMarshalizer{},
+		PubkeyConverter: testscommon.RealWorldBech32PubkeyConverter,
+	}
+}
+
+func connect(t *testing.T, hub *subscriptionsHub) (*wsConnMock, chan error) {
+	conn := newWsConnMock()
+	numSubscribers := len(hub.getSubscribers())
+	handleErr := make(chan error, 1)
+	go func() {
+		handleErr <- hub.HandleConnection(conn)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(hub.getSubscribers()) == numSubscribers+1
+	}, testTimeout, time.Millisecond)
+
+	return conn, handleErr
+}
+
+func subscribe(t *testing.T, conn *wsConnMock, params RequestParams) string {
+	conn.send(t, &Request{ID: 1, Method: MethodSubscribe, Params: params})
+
+	response := &Response{}
+	conn.receive(t, response)
+	require.Empty(t, response.Error)
+	require.Equal(t, uint64(1), response.ID)
+
+	return response.Result.(string)
+}
+
+func createOutportBlock(t *testing.T, headerHash []byte, nonce uint64) *outportcore.OutportBlock {
+	header := &block.Header{
+		Nonce:     nonce,
+		Round:     nonce + 1,
+		Epoch:     2,
+		ShardID:   1,
+		TimeStamp: 1000,
+		TxCount:   2,
+	}
+	headerBytes, err := (&marshal.GogoProtoMarshalizer{}).Marshal(header)
+	require.Nil(t, err)
+
+	return &outportcore.OutportBlock{
+		BlockData: &outportcore.BlockData{
+			HeaderBytes: headerBytes,
+			HeaderType:  string(core.ShardHeaderV1),
+			HeaderHash:  headerHash,
+		},
+		TransactionPool: &outportcore.TransactionPool{
+			Transactions: map[string]*outportcore.TxInfo{
+				"aa01": {},
+			},
+			InvalidTxs: map[string]*outportcore.TxInfo{
+				"aa02": {},
+			},
+			Logs: []*outportcore.LogData{
+				{
+					TxHash: "aa01",
+					Log: &transaction.Log{
+						Events: []*transaction.Event{
+							{Address: testscommon.TestPubKeyAlice, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("topic")}},
+							{Address: testscommon.TestPubKeyBob, Identifier: []byte("transfer")},
+							{Address: testscommon.TestPubKeyAlice, Identifier: []byte("other")},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewSubscriptionsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Marshaller = nil
+		hub, err := NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.PubkeyConverter = nil
+		hub, err := NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, ErrNilPubkeyConverter, err)
+	})
+	t.Run("invalid config values should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Config.SendBufferSize = 0
+		hub, err := NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.ErrorIs(t, err, ErrInvalidConfigValue)
+		require.Contains(t, err.Error(), "SendBufferSize")
+
+		args = createMockArgs()
+		args.Config.MaxConnections = 0
+		hub, err = NewSubscriptionsHub(args)
+		require.Nil(t, hub)
+		require.ErrorIs(t, err, ErrInvalidConfigValue)
+		require.Contains(t, err.Error(), "MaxConnections")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := NewSubscriptionsHub(createMockArgs())
+		require.Nil(t, err)
+		require.False(t, hub.IsInterfaceNil())
+	})
+}
+
+func TestSubscriptionsHub_HandleConnection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil connection should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgs())
+		require.Equal(t, ErrNilWsConn, hub.HandleConnection(nil))
+	})
+	t.Run("too many connections should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgs())
+		_, _ = connect(t, hub)
+		_, _ = connect(t, hub)
+
+		require.Equal(t, ErrTooManyConnections, hub.HandleConnection(newWsConnMock()))
+	})
+	t.Run("closed connection should remove the subscriber", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgs())
+		conn, handleErr := connect(t, hub)
+
+		_ = conn.Close()
+		require.Nil(t, <-handleErr)
+		require.Empty(t, hub.getSubscribers())
+	})
+	t.Run("invalid requests should respond with errors", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgs())
+		conn, _ := connect(t, hub)
+
+		conn.incoming <- []byte("not a json")
+		response := &Response{}
+		conn.receive(t, response)
+		require.NotEmpty(t, response.Error)
+
+		conn.send(t, &Request{ID: 2, Method: "missing"})
+		response = &Response{}
+		conn.receive(t, response)
+		require.Equal(t, uint64(2), response.ID)
+		require.Contains(t, response.Error, errUnknownMethod.Error())
+
+		conn.send(t, &Request{ID: 3, Method: MethodSubscribe, Params: RequestParams{Topic: "missing"}})
+		response = &Response{}
+		conn.receive(t, response)
+		require.Contains(t, response.Error, errUnknownTopic.Error())
+
+		conn.send(t, &Request{ID: 4, Method: MethodSubscribe, Params: RequestParams{Topic: TopicTxStatus}})
+		response = &Response{}
+		conn.receive(t, response)
+		require.Equal(t, errMissingHashes.Error(), response.Error)
+
+		conn.send(t, &Request{ID: 5, Method: MethodSubscribe, Params: RequestParams{Topic: TopicTxStatus, Hashes: []string{"zz"}}})
+		response = &Response{}
+		conn.receive(t, response)
+		require.Contains(t, response.Error, errInvalidHash.Error())
+
+		conn.send(t, &Request{ID: 6, Method: MethodSubscribe, Params: RequestParams{Topic: TopicLogs, Addresses: []string{"erd1invalid"}}})
+		response = &Response{}
+		conn.receive(t, response)
+		require.Contains(t, response.Error, errInvalidAddress.Error())
+
+		conn.send(t, &Request{ID: 7, Method: MethodSubscribe, Params: RequestParams{Topic: TopicLogs, Identifiers: []string{"a", "b", "c", "d"}}})
+		response = &Response{}
+		conn.receive(t, response)
+		require.Contains(t, response.Error, errTooManyFilterValues.Error())
+
+		conn.send(t, &Request{ID: 8, Method: MethodUnsubscribe, Params: RequestParams{Subscription: "0x1"}})
+		response = &Response{}
+		conn.receive(t, response)
+		require.Equal(t, errUnknownSubscription.Error(), response.Error)
+	})
+	t.Run("too many subscriptions should respond with error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := NewSubscriptionsHub(createMockArgs())
+		conn, _ := connect(t, hub)
+
+		_ = subscribe(t, conn, RequestParams{Topic: TopicBlocks})
+		_ = subscribe(t, conn, RequestParams{Topic: TopicFinalizedBlocks})
+
+		conn.send(t, &Request{ID: 3, Method: MethodSubscribe, Params: RequestParams{Topic: TopicBlocks}})
+		response := &Response{}
+		conn.receive(t, response)
+		require.Equal(t, errTooManySubscriptions.Error(), response.Error)
+	})
+}
+
+func TestSubscriptionsHub_SaveBlockShouldNotifyMatchingSubscriptions(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgs())
+	conn, _ := connect(t, hub)
+	blocksSubscription := subscribe(t, conn, RequestParams{Topic: TopicBlocks})
+	logsSubscription := subscribe(t, conn, RequestParams{
+		Topic:       TopicLogs,
+		Addresses:   []string{testscommon.TestAddressAlice},
+		Identifiers: []string{"transfer"},
+	})
+
+	otherConn, _ := connect(t, hub)
+	txSubscription := subscribe(t, otherConn, RequestParams{Topic: TopicTxStatus, Hashes: []string{"AA02"}})
+
+	headerHash := []byte("hash")
+	err := hub.SaveBlock(createOutportBlock(t, headerHash, 10))
+	require.Nil(t, err)
+
+	blockNotification := &Notification{Data: &BlockNotification{}}
+	conn.receive(t, blockNotification)
+	require.Equal(t, blocksSubscription, blockNotification.Subscription)
+	require.Equal(t, TopicBlocks, blockNotification.Topic)
+	require.Equal(t, &BlockNotification{
+		Hash:      hex.EncodeToString(headerHash),
+		Nonce:     10,
+		Round:     11,
+		Epoch:     2,
+		ShardID:   1,
+		Timestamp: 1000,
+		NumTxs:    2,
+	}, blockNotification.Data)
+
+	logNotification := &Notification{Data: &LogNotification{}}
+	conn.receive(t, logNotification)
+	require.Equal(t, logsSubscription, logNotification.Subscription)
+	require.Equal(t, &LogNotification{
+		TxHash:     "aa01",
+		Address:    testscommon.TestAddressAlice,
+		Identifier: "transfer",
+		Topics:     [][]byte{[]byte("topic")},
+		BlockHash:  hex.EncodeToString(headerHash),
+		BlockNonce: 10,
+	}, logNotification.Data)
+	conn.requireNoMessage(t)
+
+	txNotification := &Notification{Data: &TxStatusNotification{}}
+	otherConn.receive(t, txNotification)
+	require.Equal(t, txSubscription, txNotification.Subscription)
+	require.Equal(t, &TxStatusNotification{
+		Hash:       "aa02",
+		Status:     TxStatusInvalid,
+		BlockHash:  hex.EncodeToString(headerHash),
+		BlockNonce: 10,
+	}, txNotification.Data)
+	otherConn.requireNoMessage(t)
+
+	otherConn.send(t, &Request{ID: 5, Method: MethodUnsubscribe, Params: RequestParams{Subscription: txSubscription}})
+	response := &Response{}
+	otherConn.receive(t, response)
+	require.Empty(t, response.Error)
+	require.Equal(t, true, response.Result)
+
+	err = hub.SaveBlock(createOutportBlock(t, []byte("hash2"), 11))
+	require.Nil(t, err)
+	otherConn.requireNoMessage(t)
+}
+
+func TestSubscriptionsHub_FinalizedBlock(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgs())
+	conn, _ := connect(t, hub)
+	finalizedSubscription := subscribe(t, conn, RequestParams{Topic: TopicFinalizedBlocks})
+	txSubscription := subscribe(t, conn, RequestParams{Topic: TopicTxStatus, Hashes: []string{"aa01"}})
+
+	headerHash := []byte("hash")
+	_ = hub.SaveBlock(createOutportBlock(t, headerHash, 10))
+
+	includedNotification := &Notification{Data: &TxStatusNotification{}}
+	conn.receive(t, includedNotification)
+	require.Equal(t, TxStatusIncluded, includedNotification.Data.(*TxStatusNotification).Status)
+
+	err := hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: []byte("unknown")})
+	require.Nil(t, err)
+	conn.requireNoMessage(t)
+
+	err = hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: headerHash})
+	require.Nil(t, err)
+
+	blockNotification := &Notification{Data: &BlockNotification{}}
+	conn.receive(t, blockNotification)
+	require.Equal(t, finalizedSubscription, blockNotification.Subscription)
+	require.Equal(t, uint64(10), blockNotification.Data.(*BlockNotification).Nonce)
+
+	finalizedNotification := &Notification{Data: &TxStatusNotification{}}
+	conn.receive(t, finalizedNotification)
+	require.Equal(t, txSubscription, finalizedNotification.Subscription)
+	require.Equal(t, TxStatusFinalized, finalizedNotification.Data.(*TxStatusNotification).Status)
+}
+
+func TestSubscriptionsHub_RevertIndexedBlock(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgs())
+	conn, _ := connect(t, hub)
+	_ = subscribe(t, conn, RequestParams{Topic: TopicTxStatus, Hashes: []string{"aa01"}})
+
+	outportBlock := createOutportBlock(t, []byte("hash"), 10)
+	_ = hub.SaveBlock(outportBlock)
+	conn.receive(t, &Notification{})
+
+	blocksSubscription := subscribe(t, conn, RequestParams{Topic: TopicBlocks})
+
+	err := hub.RevertIndexedBlock(outportBlock.BlockData)
+	require.Nil(t, err)
+
+	blockNotification := &Notification{Data: &BlockNotification{}}
+	conn.receive(t, blockNotification)
+	require.Equal(t, blocksSubscription, blockNotification.Subscription)
+	require.True(t, blockNotification.Data.(*BlockNotification).Reverted)
+
+	txNotification := &Notification{Data: &TxStatusNotification{}}
+	conn.receive(t, txNotification)
+	require.Equal(t, TxStatusReverted, txNotification.Data.(*TxStatusNotification).Status)
+
+	err = hub.FinalizedBlock(&outportcore.FinalizedBlock{HeaderHash: []byte("hash")})
+	require.Nil(t, err)
+	conn.requireNoMessage(t)
+}
+
+func TestSubscriptionsHub_InvalidHeaderShouldNotError(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgs())
+	blockData := &outportcore.BlockData{
+		HeaderBytes: []byte("invalid"),
+		HeaderType:  "unknown",
+	}
+
+	require.Nil(t, hub.SaveBlock(&outportcore.OutportBlock{BlockData: blockData}))
+	require.Nil(t, hub.RevertIndexedBlock(blockData))
+	require.Nil(t, hub.SaveBlock(nil))
+	require.Nil(t, hub.RevertIndexedBlock(nil))
+	require.Nil(t, hub.FinalizedBlock(nil))
+}
+
+func TestSubscriptionsHub_SlowSubscriberShouldBeDisconnected(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Config.SendBufferSize = 1
+	hub, _ := NewSubscriptionsHub(args)
+	conn, handleErr := connect(t, hub)
+	_ = subscribe(t, conn, RequestParams{Topic: TopicBlocks})
+
+	// fill the connection buffer so the write loop blocks and the send queue fills up
+	for i := 0; i < cap(conn.outgoing); i++ {
+		conn.outgoing <- nil
+	}
+	for i := uint64(0); i < 5; i++ {
+		_ = hub.SaveBlock(createOutportBlock(t, []byte{byte(i)}, i))
+	}
+
+	select {
+	case err := <-handleErr:
+		require.Nil(t, err)
+	case <-time.After(2 * testTimeout):
+		require.Fail(t, "slow subscriber was not disconnected")
+	}
+	require.Empty(t, hub.getSubscribers())
+}
+
+func TestSubscriptionsHub_CloseShouldDisconnectSubscribers(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := NewSubscriptionsHub(createMockArgs())
+	conn, handleErr := connect(t, hub)
+
+	require.Nil(t, hub.Close())
+	require.Nil(t, <-handleErr)
+
+	closeFrame := <-conn.closeFrames
+	require.Equal(t, websocket.FormatCloseMessage(websocket.CloseGoingAway, "node is shutting down"), closeFrame)
+	require.Equal(t, ErrHubClosed, hub.HandleConnection(newWsConnMock()))
+}
+
+func TestSubscriptionsHub_NoOperationMethods(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	hub, _ := NewSubscriptionsHub(args)
+
+	require.Nil(t, hub.SaveRoundsInfo(nil))
+	require.Nil(t, hub.SaveValidatorsPubKeys(nil))
+	require.Nil(t, hub.SaveValidatorsRating(nil))
+	require.Nil(t, hub.SaveAccounts(nil))
+	require.Nil(t, hub.SetCurrentSettings(outportcore.OutportConfig{}))
+	require.Nil(t, hub.RegisterHandler(nil, ""))
+	require.Equal(t, args.Marshaller, hub.GetMarshaller())
+}
@@ -2,11 +2,15 @@
 
 generate() {
     generateForAssessmentTool
+    generateForDbMigrator
+    generateForDbTool
     generateForKeyGenerator
     generateForLogViewer
     generateForNode
     generateForSeedNode
+    generateForStateExporter
     generateForTermUi
+    generateForTxReplay
 }
 
 generateForAssessmentTool() {
@@ -23,6 +27,34 @@ $(code)
     echo "$HELP" > ./assessment/CLI.md
 }
 
+generateForDbMigrator() {
+    HELP="
+# MultiversX Database Migrator CLI
+
+The **MultiversX Database Migrator App** exposes the following Command Line Interface:
+$(code)
+\$ dbmigrator --help
+
+$(./dbmigrator/dbmigrator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbmigrator/CLI.md
+}
+
+generateForDbTool() {
+    HELP="
+# MultiversX Database Tool CLI
+
+The **MultiversX Database Tool App** exposes the following Command Line Interface:
+$(code)
+\$ dbtool --help
+
+$(./dbtool/dbtool --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbtool/CLI.md
+}
+
 generateForKeyGenerator() {
     HELP="
 # Keygenerator CLI
@@ -79,6 +111,20 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForStateExporter() {
+    HELP="
+# MultiversX State Exporter CLI
+
+The **MultiversX State Exporter App** exposes the following Command Line Interface:
+$(code)
+\$ stateexporter --help
+
+$(./stateexporter/stateexporter --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./stateexporter/CLI.md
+}
+
 generateForTermUi() {
     HELP="
 # MultiversX TermUI CLI
@@ -93,6 +139,20 @@ $(code)
     echo "$HELP" > ./termui/CLI.md
 }
 
+generateForTxReplay() {
+    HELP="
+# MultiversX Transaction Replay CLI
+
+The **MultiversX Transaction Replay App** exposes the following Command Line Interface:
+$(code)
+\$ txreplay --help
+
+$(./txreplay/txreplay --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./txreplay/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
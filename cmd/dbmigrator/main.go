@@ -0,0 +1,198 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage/migration"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	sourcePath         string
+	destinationPath    string
+	batchDelaySeconds  int
+	maxBatchSize       int
+	maxOpenFiles       int
+	skipVerification   bool
+	logLevel           string
+	logWithCorrelation bool
+	logWithLoggerName  bool
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// sourcePath defines a flag for setting the directory holding the leveldb databases
+	sourcePath = cli.StringFlag{
+		Name:        "source",
+		Usage:       "The directory holding the leveldb databases to be migrated, usually a db/<chainID>/Epoch_X directory. It is only read",
+		Destination: &argsConfig.sourcePath,
+	}
+	// destinationPath defines a flag for setting the directory where the pebble databases will be written
+	destinationPath = cli.StringFlag{
+		Name:        "destination",
+		Usage:       "The directory where the pebble databases will be written, mirroring the source structure. It should not exist or be empty",
+		Destination: &argsConfig.destinationPath,
+	}
+	// batchDelaySeconds defines a flag for the value written in the configs of the databases that do not have one
+	batchDelaySeconds = cli.IntFlag{
+		Name:        "batch-delay-seconds",
+		Usage:       "The BatchDelaySeconds value written in the configs of the databases that do not have their own config file",
+		Value:       2,
+		Destination: &argsConfig.batchDelaySeconds,
+	}
+	// maxBatchSize defines a flag for the value written in the configs of the databases that do not have one
+	maxBatchSize = cli.IntFlag{
+		Name:        "max-batch-size",
+		Usage:       "The MaxBatchSize value written in the configs of the databases that do not have their own config file",
+		Value:       100,
+		Destination: &argsConfig.maxBatchSize,
+	}
+	// maxOpenFiles defines a flag for the maximum number of files each database can keep open during the migration
+	maxOpenFiles = cli.IntFlag{
+		Name:        "max-open-files",
+		Usage:       "The maximum number of files each database can keep open. It is also written in the configs of the databases that do not have their own config file",
+		Value:       10,
+		Destination: &argsConfig.maxOpenFiles,
+	}
+	// skipVerification disables reading back the migrated data
+	skipVerification = cli.BoolFlag{
+		Name:        "skip-verification",
+		Usage:       "Boolean option for not reading back and comparing the migrated data with the source data.",
+		Destination: &argsConfig.skipVerification,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+	//logWithCorrelation is used to enable log correlation elements
+	logWithCorrelation = cli.BoolFlag{
+		Name:        "log-correlation",
+		Usage:       "Boolean option for enabling log correlation elements.",
+		Destination: &argsConfig.logWithCorrelation,
+	}
+	//logWithLoggerName is used to enable log correlation elements
+	logWithLoggerName = cli.BoolFlag{
+		Name:        "log-logger-name",
+		Usage:       "Boolean option for logger name in the logs.",
+		Destination: &argsConfig.logWithLoggerName,
+	}
+	argsConfig = &cfg{}
+
+	errMissingSourcePath      = errors.New("the source directory was not provided")
+	errMissingDestinationPath = errors.New("the destination directory was not provided")
+
+	log    = logger.GetOrCreate("dbmigrator")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	cliApp.Action = func(c *cli.Context) error {
+		return migrate()
+	}
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func migrate() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+	logger.ToggleCorrelation(argsConfig.logWithCorrelation)
+	logger.ToggleLoggerName(argsConfig.logWithLoggerName)
+
+	if len(argsConfig.sourcePath) == 0 {
+		return errMissingSourcePath
+	}
+	if len(argsConfig.destinationPath) == 0 {
+		return errMissingDestinationPath
+	}
+
+	migrator, err := migration.NewLevelDBToPebbleMigrator(migration.ArgsLevelDBToPebbleMigrator{
+		SourcePath:      argsConfig.sourcePath,
+		DestinationPath: argsConfig.destinationPath,
+		DefaultDBConfig: config.DBConfig{
+			Type:              string(storageunit.LvlDBSerial),
+			BatchDelaySeconds: argsConfig.batchDelaySeconds,
+			MaxBatchSize:      argsConfig.maxBatchSize,
+			MaxOpenFiles:      argsConfig.maxOpenFiles,
+		},
+		Verify: !argsConfig.skipVerification,
+	})
+	if err != nil {
+		return err
+	}
+
+	stats, err := migrator.Migrate()
+	if err != nil {
+		return err
+	}
+
+	log.Info("migration finished",
+		"source", argsConfig.sourcePath,
+		"destination", argsConfig.destinationPath,
+		"num databases", stats.NumDatabases,
+		"num keys", stats.NumKeys,
+		"size", core.ConvertBytes(stats.NumBytes),
+	)
+
+	return nil
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "MultiversX Database Migrator App"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Offline database migrator used to convert the leveldb databases of a node into pebble databases"
+	cliApp.Flags = []cli.Flag{
+		sourcePath,
+		destinationPath,
+		batchDelaySeconds,
+		maxBatchSize,
+		maxOpenFiles,
+		skipVerification,
+		logLevel,
+		logWithCorrelation,
+		logWithLoggerName,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+}
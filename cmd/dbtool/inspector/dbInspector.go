@@ -0,0 +1,746 @@
+package inspector
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/trie"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const dbConfigFileName = "config.toml"
+
+var log = logger.GetOrCreate("dbtool/inspector")
+
+// ArgsDBInspector is the DTO used to create a new instance of dbInspector
+type ArgsDBInspector struct {
+	DefaultDBConfig config.DBConfig
+	UnitsResolver   UnitsResolver
+	ValueDecoder    ValueDecoder
+	Marshaller      marshal.Marshalizer
+	Hasher          hashing.Hasher
+}
+
+// UnitInfo holds the details of a storage unit found on disk
+type UnitInfo struct {
+	Path     string `json:"path"`
+	UnitType string `json:"unitType"`
+	DBType   string `json:"dbType"`
+	Size     uint64 `json:"size"`
+}
+
+// Entry holds a key-value pair read from a storage unit. DecodedValue is nil if the value could not be decoded
+type Entry struct {
+	Key          []byte
+	Value        []byte
+	DecodedValue interface{}
+}
+
+// UnitError holds the error encountered while opening a storage unit. The path is relative to the epoch directory
+type UnitError struct {
+	Path  string `json:"path"`
+	Error string `json:"error"`
+}
+
+// EpochReport holds the outcome of checking all the storage units of an epoch. CorruptedUnits only holds the units
+// reported as corrupted by LevelDB or Pebble, while UnreadableUnits holds the units that could not be opened for other reasons
+// (missing files, wrong permissions and so on), which should not be removed
+type EpochReport struct {
+	Epoch           uint32       `json:"epoch"`
+	Path            string       `json:"path"`
+	NumUnits        int          `json:"numUnits"`
+	CorruptedUnits  []*UnitError `json:"corruptedUnits"`
+	UnreadableUnits []*UnitError `json:"unreadableUnits"`
+}
+
+// IsCorrupted returns true if at least one storage unit of the epoch is corrupted
+func (er *EpochReport) IsCorrupted() bool {
+	return len(er.CorruptedUnits) > 0
+}
+
+// ArgsTrieAnalysis holds the arguments used to analyze a trie saved in the trie storage units of all the epochs
+type ArgsTrieAnalysis struct {
+	ChainPath           string
+	ShardDir            string
+	UnitPath            string
+	RootHash            []byte
+	NumLargestDataTries int
+	CountDeadNodes      bool
+}
+
+// EpochTrieNodes holds the number and the size of the trie nodes saved in the trie storage unit of an epoch
+type EpochTrieNodes struct {
+	Epoch uint32
+	Path  string
+	Size  uint64
+	// DeadNodes is nil if the dead nodes were not counted
+	DeadNodes *trie.DeadNodesReport
+}
+
+// ArgsTrieGarbageCollection holds the arguments used to remove the unreachable trie nodes from the trie storage units
+// of all the epochs
+type ArgsTrieGarbageCollection struct {
+	ChainPath            string
+	ShardDir             string
+	UnitPath             string
+	RootHashes           [][]byte
+	MarkSetSizeInMB      uint32
+	MaxRemovalsPerSecond uint32
+	DryRun               bool
+}
+
+// TrieGarbageCollection holds the outcome of removing the unreachable trie nodes and the swept epochs
+type TrieGarbageCollection struct {
+	Report *trie.GarbageCollectionReport
+	Epochs []uint32
+}
+
+// TrieAnalysis holds the outcome of analyzing a trie saved in the trie storage units of all the epochs
+type TrieAnalysis struct {
+	Report *trie.StorageReport
+	Epochs []*EpochTrieNodes
+}
+
+type dbInspector struct {
+	persisterFactory storage.PersisterFactoryHandler
+	unitsResolver    UnitsResolver
+	valueDecoder     ValueDecoder
+	marshaller       marshal.Marshalizer
+	hasher           hashing.Hasher
+}
+
+// NewDBInspector creates a component able to inspect and repair the storage units of a stopped node
+func NewDBInspector(args ArgsDBInspector) (*dbInspector, error) {
+	if check.IfNil(args.UnitsResolver) {
+		return nil, ErrNilUnitsResolver
+	}
+	if check.IfNil(args.ValueDecoder) {
+		return nil, ErrNilValueDecoder
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	dbConfigHandler := factory.NewDBConfigHandler(args.DefaultDBConfig)
+	persisterFactory, err := factory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbInspector{
+		persisterFactory: persisterFactory,
+		unitsResolver:    args.UnitsResolver,
+		valueDecoder:     args.ValueDecoder,
+		marshaller:       args.Marshaller,
+		hasher:           args.Hasher,
+	}, nil
+}
+
+// ListUnits returns all the storage units found in the provided shard directory, e.g. db/<chainID>/Epoch_X/Shard_Y
+func (inspector *dbInspector) ListUnits(shardPath string) ([]*UnitInfo, error) {
+	units := make([]*UnitInfo, 0)
+	err := filepath.WalkDir(shardPath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() || path == shardPath {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(shardPath, path)
+		if err != nil {
+			return err
+		}
+		if !inspector.isUnitDir(path, relativePath) {
+			return nil
+		}
+
+		unitInfo, err := inspector.createUnitInfo(path, relativePath)
+		if err != nil {
+			return err
+		}
+		units = append(units, unitInfo)
+
+		// the numeric sub-directories of a sharded persister are not separate units
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return units, nil
+}
+
+func (inspector *dbInspector) isUnitDir(path string, relativePath string) bool {
+	_, isKnownUnit := inspector.unitsResolver.Resolve(relativePath)
+	if isKnownUnit {
+		return true
+	}
+
+	return fileExists(filepath.Join(path, dbConfigFileName)) || fileExists(filepath.Join(path, levelDBCurrentFile))
+}
+
+func (inspector *dbInspector) createUnitInfo(path string, relativePath string) (*UnitInfo, error) {
+	dbType, err := detectDBType(path)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := computeDirSize(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UnitInfo{
+		Path:     relativePath,
+		UnitType: inspector.unitTypeName(relativePath),
+		DBType:   string(dbType),
+		Size:     size,
+	}, nil
+}
+
+func (inspector *dbInspector) unitTypeName(relativePath string) string {
+	unitType, found := inspector.unitsResolver.Resolve(relativePath)
+	if !found {
+		return "unknown"
+	}
+
+	return unitType.String()
+}
+
+// Get returns the entry stored under the provided key in the unit found at shardPath/unitPath. The unit is opened in
+// read only mode
+func (inspector *dbInspector) Get(shardPath string, unitPath string, key []byte) (*Entry, error) {
+	persister, err := openUnitReadOnly(shardPath, unitPath)
+	if err != nil {
+		return nil, err
+	}
+	defer closePersister(persister)
+
+	value, err := persister.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return inspector.createEntry(unitPath, key, value), nil
+}
+
+// Iterate calls the handler for the entries of the unit found at shardPath/unitPath having keys starting with the
+// provided prefix. The iteration stops after limit entries (0 means no limit) or when the handler returns false. The
+// unit is opened in read only mode
+func (inspector *dbInspector) Iterate(
+	shardPath string,
+	unitPath string,
+	prefix []byte,
+	limit int,
+	handler func(entry *Entry) bool,
+) error {
+	if handler == nil {
+		return ErrNilHandler
+	}
+
+	persister, err := openUnitReadOnly(shardPath, unitPath)
+	if err != nil {
+		return err
+	}
+	defer closePersister(persister)
+
+	numEntries := 0
+	persister.RangeKeys(func(key []byte, value []byte) bool {
+		if !bytes.HasPrefix(key, prefix) {
+			return true
+		}
+
+		numEntries++
+		shouldContinue := handler(inspector.createEntry(unitPath, key, value))
+
+		return shouldContinue && (limit <= 0 || numEntries < limit)
+	})
+
+	return nil
+}
+
+func (inspector *dbInspector) createEntry(unitPath string, key []byte, value []byte) *Entry {
+	entry := &Entry{
+		Key:   key,
+		Value: value,
+	}
+
+	unitType, found := inspector.unitsResolver.Resolve(unitPath)
+	if !found {
+		return entry
+	}
+
+	decodedValue, err := inspector.valueDecoder.Decode(unitType, key, value)
+	if err != nil {
+		log.Trace("could not decode value", "unit", unitPath, "key", key, "error", err)
+		return entry
+	}
+	entry.DecodedValue = decodedValue
+
+	return entry
+}
+
+// VerifyTrie checks that all the nodes of the trie with the provided root hash can be loaded from the unit found at
+// shardPath/unitPath
+func (inspector *dbInspector) VerifyTrie(shardPath string, unitPath string, rootHash []byte) (*trie.IntegrityCheckResult, error) {
+	persister, err := openUnitReadOnly(shardPath, unitPath)
+	if err != nil {
+		return nil, err
+	}
+	defer closePersister(persister)
+
+	return trie.CheckIntegrity(rootHash, persister, inspector.marshaller, inspector.hasher)
+}
+
+// AnalyzeTrie walks the trie with the provided root hash, searching its nodes in the trie storage units of all the
+// epochs found in the chain directory, newest epoch first. The data tries are analyzed as well if the unit holds the
+// user accounts. Optionally, the nodes of each epoch that are not reachable from the root hash are counted. The units
+// are opened in read only mode
+func (inspector *dbInspector) AnalyzeTrie(args ArgsTrieAnalysis) (*TrieAnalysis, error) {
+	persisters, epochsNodes, err := inspector.openTrieUnitsOfAllEpochs(args.ChainPath, args.ShardDir, args.UnitPath, true)
+	if err != nil {
+		return nil, err
+	}
+	defer closePersisters(persisters)
+
+	accountRootHashParser, err := inspector.createAccountRootHashParser(args.UnitPath)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := trie.AnalyzeStorage(trie.ArgsStorageAnalysis{
+		Ctx:                   context.Background(),
+		RootHash:              args.RootHash,
+		DB:                    newEpochsStorer(persisters),
+		Marshaller:            inspector.marshaller,
+		Hasher:                inspector.hasher,
+		AccountRootHashParser: accountRootHashParser,
+		NumLargestDataTries:   args.NumLargestDataTries,
+		TrackReachableNodes:   args.CountDeadNodes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if args.CountDeadNodes {
+		for i, persister := range persisters {
+			epochsNodes[i].DeadNodes, err = report.CountDeadNodes(persister.RangeKeys)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &TrieAnalysis{
+		Report: report,
+		Epochs: epochsNodes,
+	}, nil
+}
+
+// CollectTrieGarbage removes, from the trie storage units of all the epochs found in the chain directory, the trie
+// nodes which are not reachable from any of the provided root hashes. The data tries are kept as well if the unit
+// holds the user accounts. Nothing is removed if a node of a kept trie is missing. The units are opened in read only
+// mode for a dry run
+func (inspector *dbInspector) CollectTrieGarbage(ctx context.Context, args ArgsTrieGarbageCollection) (*TrieGarbageCollection, error) {
+	persisters, epochsNodes, err := inspector.openTrieUnitsOfAllEpochs(args.ChainPath, args.ShardDir, args.UnitPath, args.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	defer closePersisters(persisters)
+
+	accountRootHashParser, err := inspector.createAccountRootHashParser(args.UnitPath)
+	if err != nil {
+		return nil, err
+	}
+
+	garbageCollector, err := trie.NewGarbageCollector(trie.ArgsGarbageCollector{
+		Marshaller:            inspector.marshaller,
+		Hasher:                inspector.hasher,
+		AccountRootHashParser: accountRootHashParser,
+		MarkSetSizeInBytes:    uint64(args.MarkSetSizeInMB) * core.MegabyteSize,
+		MaxRemovalsPerSecond:  args.MaxRemovalsPerSecond,
+		DryRun:                args.DryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sweepableStorers := make([]trie.SweepableStorer, 0, len(persisters))
+	for _, persister := range persisters {
+		sweepableStorers = append(sweepableStorers, persister)
+	}
+
+	report, err := garbageCollector.CollectGarbage(ctx, args.RootHashes, newEpochsStorer(persisters), sweepableStorers)
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := make([]uint32, 0, len(epochsNodes))
+	for _, epochNodes := range epochsNodes {
+		epochs = append(epochs, epochNodes.Epoch)
+	}
+
+	return &TrieGarbageCollection{
+		Report: report,
+		Epochs: epochs,
+	}, nil
+}
+
+func (inspector *dbInspector) openTrieUnitsOfAllEpochs(
+	chainPath string,
+	shardDir string,
+	relativeUnitPath string,
+	readOnly bool,
+) ([]storage.Persister, []*EpochTrieNodes, error) {
+	epochs, err := getEpochs(chainPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	persisters := make([]storage.Persister, 0, len(epochs))
+	epochsNodes := make([]*EpochTrieNodes, 0, len(epochs))
+	for i := len(epochs) - 1; i >= 0; i-- {
+		shardPath := filepath.Join(chainPath, epochDirName(epochs[i]), shardDir)
+		unitPath := filepath.Join(shardPath, relativeUnitPath)
+		if !dirExists(unitPath) {
+			continue
+		}
+
+		persister, errOpen := inspector.openTrieUnit(shardPath, relativeUnitPath, readOnly)
+		if errOpen != nil {
+			closePersisters(persisters)
+			return nil, nil, fmt.Errorf("%w for epoch %d", errOpen, epochs[i])
+		}
+		persisters = append(persisters, persister)
+
+		size, errSize := computeDirSize(unitPath)
+		if errSize != nil {
+			closePersisters(persisters)
+			return nil, nil, errSize
+		}
+		epochsNodes = append(epochsNodes, &EpochTrieNodes{
+			Epoch: epochs[i],
+			Path:  unitPath,
+			Size:  size,
+		})
+	}
+	if len(persisters) == 0 {
+		return nil, nil, fmt.Errorf("%w: %s", ErrNoTrieUnitFound, relativeUnitPath)
+	}
+
+	return persisters, epochsNodes, nil
+}
+
+func (inspector *dbInspector) createAccountRootHashParser(unitPath string) (trie.AccountRootHashParser, error) {
+	unitType, _ := inspector.unitsResolver.Resolve(unitPath)
+	if unitType != dataRetriever.UserAccountsUnit {
+		return nil, nil
+	}
+
+	return accounts.NewAccountRootHashParser(inspector.marshaller)
+}
+
+func closePersisters(persisters []storage.Persister) {
+	for _, persister := range persisters {
+		closePersister(persister)
+	}
+}
+
+// CheckEpochs opens, in read only mode, all the storage units of all the epochs found in the provided directory, usually
+// db/<chainID>, and reports the units that could not be opened. It fails if any unit is locked, as the node might still
+// be running
+func (inspector *dbInspector) CheckEpochs(chainPath string) ([]*EpochReport, error) {
+	epochs, err := getEpochs(chainPath)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*EpochReport, 0, len(epochs))
+	for _, epoch := range epochs {
+		report, errCheck := inspector.checkEpoch(chainPath, epoch)
+		if errCheck != nil {
+			return nil, errCheck
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+func (inspector *dbInspector) checkEpoch(chainPath string, epoch uint32) (*EpochReport, error) {
+	epochPath := filepath.Join(chainPath, epochDirName(epoch))
+	report := &EpochReport{
+		Epoch:           epoch,
+		Path:            epochPath,
+		CorruptedUnits:  make([]*UnitError, 0),
+		UnreadableUnits: make([]*UnitError, 0),
+	}
+
+	shardDirs, err := os.ReadDir(epochPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, shardDir := range shardDirs {
+		if !shardDir.IsDir() || !strings.HasPrefix(shardDir.Name(), storage.DefaultShardString) {
+			continue
+		}
+
+		shardPath := filepath.Join(epochPath, shardDir.Name())
+		units, errList := inspector.ListUnits(shardPath)
+		if errList != nil {
+			return nil, errList
+		}
+
+		for _, unit := range units {
+			report.NumUnits++
+
+			unitError := &UnitError{
+				Path: filepath.Join(shardDir.Name(), unit.Path),
+			}
+			errOpen := checkUnit(filepath.Join(shardPath, unit.Path))
+			switch {
+			case errOpen == nil:
+				continue
+			case errors.Is(errOpen, ErrUnitLocked):
+				return nil, errOpen
+			case isCorrupted(errOpen):
+				unitError.Error = errOpen.Error()
+				report.CorruptedUnits = append(report.CorruptedUnits, unitError)
+			default:
+				unitError.Error = errOpen.Error()
+				report.UnreadableUnits = append(report.UnreadableUnits, unitError)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// checkUnit opens the databases of the unit in read only mode, so no recovery is attempted and nothing is written on
+// disk
+func checkUnit(path string) error {
+	unit, err := openReadOnlyUnit(path)
+	if err != nil {
+		return err
+	}
+
+	return unit.Close()
+}
+
+// RemoveCorruptedUnits deletes only the corrupted storage units listed in the provided report, after checking that
+// none of them is locked
+func (inspector *dbInspector) RemoveCorruptedUnits(report *EpochReport) error {
+	if report == nil {
+		return ErrNilEpochReport
+	}
+
+	paths := make([]string, 0, len(report.CorruptedUnits))
+	for _, unitError := range report.CorruptedUnits {
+		paths = append(paths, filepath.Join(report.Path, unitError.Path))
+	}
+
+	return removeUnlockedUnits(paths)
+}
+
+// RemoveEpoch deletes all the storage units of the provided epoch from the provided directory, usually db/<chainID>,
+// after checking that none of them is locked
+func (inspector *dbInspector) RemoveEpoch(chainPath string, epoch uint32) error {
+	epochPath := filepath.Join(chainPath, epochDirName(epoch))
+	if !dirExists(epochPath) {
+		return fmt.Errorf("%w: %s", ErrEpochNotFound, epochPath)
+	}
+
+	paths, err := inspector.listUnitsOfEpoch(epochPath)
+	if err != nil {
+		return err
+	}
+
+	err = checkUnitsNotLocked(paths)
+	if err != nil {
+		return err
+	}
+
+	log.Info("removing epoch", "path", epochPath)
+
+	return os.RemoveAll(epochPath)
+}
+
+func (inspector *dbInspector) listUnitsOfEpoch(epochPath string) ([]string, error) {
+	shardDirs, err := os.ReadDir(epochPath)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0)
+	for _, shardDir := range shardDirs {
+		if !shardDir.IsDir() {
+			continue
+		}
+
+		shardPath := filepath.Join(epochPath, shardDir.Name())
+		units, errList := inspector.ListUnits(shardPath)
+		if errList != nil {
+			return nil, errList
+		}
+
+		for _, unit := range units {
+			paths = append(paths, filepath.Join(shardPath, unit.Path))
+		}
+	}
+
+	return paths, nil
+}
+
+func removeUnlockedUnits(paths []string) error {
+	err := checkUnitsNotLocked(paths)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		log.Info("removing storage unit", "path", path)
+
+		err = os.RemoveAll(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkUnitsNotLocked(paths []string) error {
+	for _, path := range paths {
+		unlock, err := lockUnit(path)
+		if err != nil {
+			return err
+		}
+
+		unlock()
+	}
+
+	return nil
+}
+
+func openUnitReadOnly(shardPath string, unitPath string) (storage.Persister, error) {
+	path := filepath.Join(shardPath, unitPath)
+	if !dirExists(path) {
+		return nil, fmt.Errorf("%w: %s", ErrUnitNotFound, path)
+	}
+
+	return openReadOnlyUnit(path)
+}
+
+// openTrieUnit opens the unit in read write mode only if the trie nodes are going to be removed, as the persister
+// factory might save the unit's config
+func (inspector *dbInspector) openTrieUnit(shardPath string, unitPath string, readOnly bool) (storage.Persister, error) {
+	if readOnly {
+		return openUnitReadOnly(shardPath, unitPath)
+	}
+
+	path := filepath.Join(shardPath, unitPath)
+	if !dirExists(path) {
+		return nil, fmt.Errorf("%w: %s", ErrUnitNotFound, path)
+	}
+
+	return inspector.persisterFactory.Create(path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (inspector *dbInspector) IsInterfaceNil() bool {
+	return inspector == nil
+}
+
+func getEpochs(chainPath string) ([]uint32, error) {
+	dirs, err := os.ReadDir(chainPath)
+	if err != nil {
+		return nil, err
+	}
+
+	epochPrefix := storage.DefaultEpochString + "_"
+	epochs := make([]uint32, 0, len(dirs))
+	for _, dir := range dirs {
+		if !dir.IsDir() || !strings.HasPrefix(dir.Name(), epochPrefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(dir.Name(), epochPrefix), 10, 32)
+		if errParse != nil {
+			continue
+		}
+
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs, nil
+}
+
+func epochDirName(epoch uint32) string {
+	return fmt.Sprintf("%s_%d", storage.DefaultEpochString, epoch)
+}
+
+func computeDirSize(path string) (uint64, error) {
+	size := uint64(0)
+	err := filepath.WalkDir(path, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		size += uint64(info.Size())
+
+		return nil
+	})
+
+	return size, err
+}
+
+func closePersister(persister storage.Persister) {
+	err := persister.Close()
+	if err != nil {
+		log.Warn("could not close persister", "error", err)
+	}
+}
+
+func dirExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
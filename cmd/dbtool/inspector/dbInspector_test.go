@@ -0,0 +1,522 @@
+package inspector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	testStorage "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDefaultDBConfig() config.DBConfig {
+	return config.DBConfig{
+		Type:              string(storageunit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+}
+
+func createMockArgsDBInspector() ArgsDBInspector {
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	unitsResolver, _ := NewUnitsResolver(createGeneralConfig())
+	valueDecoder, _ := NewValueDecoder(marshaller)
+
+	return ArgsDBInspector{
+		DefaultDBConfig: createDefaultDBConfig(),
+		UnitsResolver:   unitsResolver,
+		ValueDecoder:    valueDecoder,
+		Marshaller:      marshaller,
+		Hasher:          &testscommon.HasherStub{},
+	}
+}
+
+func createUnit(t *testing.T, path string, data map[string][]byte) {
+	persisterFactory, err := factory.NewPersisterFactory(factory.NewDBConfigHandler(createDefaultDBConfig()))
+	require.Nil(t, err)
+
+	persister, err := persisterFactory.Create(path)
+	require.Nil(t, err)
+	for key, value := range data {
+		require.Nil(t, persister.Put([]byte(key), value))
+	}
+	require.Nil(t, persister.Close())
+}
+
+func createUnreadableUnit(t *testing.T, path string) {
+	require.Nil(t, os.MkdirAll(path, os.ModePerm))
+	require.Nil(t, os.WriteFile(filepath.Join(path, dbConfigFileName), []byte("Type = \"invalid\"\nBatchDelaySeconds = 1\nMaxBatchSize = 1\nMaxOpenFiles = 10\n"), os.ModePerm))
+}
+
+func createCorruptedUnit(t *testing.T, path string) {
+	createUnit(t, path, map[string][]byte{"key": []byte("value")})
+
+	manifests, err := filepath.Glob(filepath.Join(path, "MANIFEST-*"))
+	require.Nil(t, err)
+	require.NotEmpty(t, manifests)
+	for _, manifest := range manifests {
+		require.Nil(t, os.WriteFile(manifest, []byte("corrupted manifest"), os.ModePerm))
+	}
+}
+
+func TestNewDBInspector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil units resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBInspector()
+		args.UnitsResolver = nil
+		dbInspector, err := NewDBInspector(args)
+		assert.Equal(t, ErrNilUnitsResolver, err)
+		assert.True(t, check.IfNil(dbInspector))
+	})
+	t.Run("nil value decoder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBInspector()
+		args.ValueDecoder = nil
+		dbInspector, err := NewDBInspector(args)
+		assert.Equal(t, ErrNilValueDecoder, err)
+		assert.True(t, check.IfNil(dbInspector))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBInspector()
+		args.Marshaller = nil
+		dbInspector, err := NewDBInspector(args)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.True(t, check.IfNil(dbInspector))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBInspector()
+		args.Hasher = nil
+		dbInspector, err := NewDBInspector(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.True(t, check.IfNil(dbInspector))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dbInspector, err := NewDBInspector(createMockArgsDBInspector())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(dbInspector))
+	})
+}
+
+func TestDbInspector_ListUnits(t *testing.T) {
+	t.Parallel()
+
+	shardPath := t.TempDir()
+	createUnit(t, filepath.Join(shardPath, "BlockHeaders"), map[string][]byte{"key": []byte("value")})
+	createUnit(t, filepath.Join(shardPath, "DbLookupExtensions", "MiniblocksMetadata"), nil)
+	createUnit(t, filepath.Join(shardPath, "Custom"), nil)
+	require.Nil(t, os.MkdirAll(filepath.Join(shardPath, "NotAUnit"), os.ModePerm))
+
+	dbInspector, _ := NewDBInspector(createMockArgsDBInspector())
+	units, err := dbInspector.ListUnits(shardPath)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(units))
+
+	assert.Equal(t, "BlockHeaders", units[0].Path)
+	assert.Equal(t, "BlockHeaderUnit", units[0].UnitType)
+	assert.Equal(t, string(storageunit.LvlDBSerial), units[0].DBType)
+	assert.True(t, units[0].Size > 0)
+	assert.Equal(t, "Custom", units[1].Path)
+	assert.Equal(t, "unknown", units[1].UnitType)
+	assert.Equal(t, filepath.Join("DbLookupExtensions", "MiniblocksMetadata"), units[2].Path)
+	assert.Equal(t, "MiniblocksMetadataUnit", units[2].UnitType)
+}
+
+func TestDbInspector_GetAndIterate(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	header := &block.HeaderV2{Header: &block.Header{Nonce: 5}}
+	headerBytes, _ := marshaller.Marshal(header)
+
+	shardPath := t.TempDir()
+	createUnit(t, filepath.Join(shardPath, "BlockHeaders"), map[string][]byte{
+		"aa-hash1": headerBytes,
+		"aa-hash2": headerBytes,
+		"bb-hash3": []byte("not a header"),
+	})
+
+	dbInspector, _ := NewDBInspector(createMockArgsDBInspector())
+
+	t.Run("missing unit should error", func(t *testing.T) {
+		t.Parallel()
+
+		entry, err := dbInspector.Get(shardPath, "MiniBlocks", []byte("key"))
+		assert.True(t, errors.Is(err, ErrUnitNotFound))
+		assert.Nil(t, entry)
+
+		err = dbInspector.Iterate(shardPath, "MiniBlocks", nil, 0, func(entry *Entry) bool { return true })
+		assert.True(t, errors.Is(err, ErrUnitNotFound))
+	})
+	t.Run("missing key should error", func(t *testing.T) {
+		t.Parallel()
+
+		entry, err := dbInspector.Get(shardPath, "BlockHeaders", []byte("missing"))
+		assert.NotNil(t, err)
+		assert.Nil(t, entry)
+	})
+	t.Run("get should decode the value", func(t *testing.T) {
+		t.Parallel()
+
+		entry, err := dbInspector.Get(shardPath, "BlockHeaders", []byte("aa-hash1"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("aa-hash1"), entry.Key)
+		assert.Equal(t, headerBytes, entry.Value)
+		assert.Equal(t, header, entry.DecodedValue)
+	})
+	t.Run("get should return the raw value if it can not be decoded", func(t *testing.T) {
+		t.Parallel()
+
+		entry, err := dbInspector.Get(shardPath, "BlockHeaders", []byte("bb-hash3"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("not a header"), entry.Value)
+		assert.Nil(t, entry.DecodedValue)
+	})
+	t.Run("iterate with nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := dbInspector.Iterate(shardPath, "BlockHeaders", nil, 0, nil)
+		assert.Equal(t, ErrNilHandler, err)
+	})
+	t.Run("iterate should filter by prefix", func(t *testing.T) {
+		t.Parallel()
+
+		keys := make(map[string]struct{})
+		err := dbInspector.Iterate(shardPath, "BlockHeaders", []byte("aa-"), 0, func(entry *Entry) bool {
+			keys[string(entry.Key)] = struct{}{}
+			assert.Equal(t, header, entry.DecodedValue)
+			return true
+		})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]struct{}{"aa-hash1": {}, "aa-hash2": {}}, keys)
+	})
+	t.Run("iterate should stop at limit", func(t *testing.T) {
+		t.Parallel()
+
+		numEntries := 0
+		err := dbInspector.Iterate(shardPath, "BlockHeaders", nil, 2, func(entry *Entry) bool {
+			numEntries++
+			return true
+		})
+		require.Nil(t, err)
+		assert.Equal(t, 2, numEntries)
+	})
+}
+
+func TestDbInspector_VerifyTrie(t *testing.T) {
+	t.Parallel()
+
+	shardPath := t.TempDir()
+	createUnit(t, filepath.Join(shardPath, "AccountsTrie"), nil)
+
+	dbInspector, _ := NewDBInspector(createMockArgsDBInspector())
+	result, err := dbInspector.VerifyTrie(shardPath, "AccountsTrie", []byte("root hash"))
+	require.Nil(t, err)
+	assert.False(t, result.IsValid())
+	assert.Equal(t, uint64(1), result.NumMissingNodes)
+}
+
+// accountsTrieInEpochs holds an accounts trie committed in Epoch_0, then changed and committed in Epoch_1
+type accountsTrieInEpochs struct {
+	chainPath           string
+	hasher              hashing.Hasher
+	rootHash            []byte
+	dataTrieRootHash    []byte
+	addressWithDataTrie []byte
+	epoch0Data          map[string][]byte
+	epoch1Data          map[string][]byte
+}
+
+func createAccountsTrieInEpochs(t *testing.T) *accountsTrieInEpochs {
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := blake2b.NewBlake2b()
+	storageManagerArgs := testStorage.GetStorageManagerArgs()
+	storageManagerArgs.Marshalizer = marshaller
+	storageManagerArgs.Hasher = hasher
+	db := storageManagerArgs.MainStorer.(*testscommon.SnapshotPruningStorerMock)
+	storageManager, _ := trie.NewTrieStorageManager(storageManagerArgs)
+
+	dataTrie, _ := trie.NewTrie(storageManager, marshaller, hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	for i := 0; i < 10; i++ {
+		require.Nil(t, dataTrie.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.Nil(t, dataTrie.Commit())
+	dataTrieRootHash, _ := dataTrie.RootHash()
+
+	mainTrie, _ := trie.NewTrie(storageManager, marshaller, hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	addressWithDataTrie := hasher.Compute("address0")
+	for i := 0; i < 30; i++ {
+		account := &accounts.UserAccountData{Nonce: uint64(i)}
+		if i == 0 {
+			account.RootHash = dataTrieRootHash
+		}
+		accountBytes, err := marshaller.Marshal(account)
+		require.Nil(t, err)
+		require.Nil(t, mainTrie.Update(hasher.Compute(fmt.Sprintf("address%d", i)), accountBytes))
+	}
+	require.Nil(t, mainTrie.Commit())
+
+	chainPath := t.TempDir()
+	epoch0Data := make(map[string][]byte)
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		epoch0Data[string(key)] = value
+		return true
+	})
+	createUnit(t, filepath.Join(chainPath, "Epoch_0", "Shard_0", "AccountsTrie"), epoch0Data)
+
+	accountBytes, err := marshaller.Marshal(&accounts.UserAccountData{Nonce: 100})
+	require.Nil(t, err)
+	require.Nil(t, mainTrie.Update(hasher.Compute("address1"), accountBytes))
+	require.Nil(t, mainTrie.Commit())
+	rootHash, _ := mainTrie.RootHash()
+
+	epoch1Data := make(map[string][]byte)
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		if _, found := epoch0Data[string(key)]; !found {
+			epoch1Data[string(key)] = value
+		}
+		return true
+	})
+	require.NotEmpty(t, epoch1Data)
+	createUnit(t, filepath.Join(chainPath, "Epoch_1", "Shard_0", "AccountsTrie"), epoch1Data)
+
+	return &accountsTrieInEpochs{
+		chainPath:           chainPath,
+		hasher:              hasher,
+		rootHash:            rootHash,
+		dataTrieRootHash:    dataTrieRootHash,
+		addressWithDataTrie: addressWithDataTrie,
+		epoch0Data:          epoch0Data,
+		epoch1Data:          epoch1Data,
+	}
+}
+
+func TestDbInspector_AnalyzeTrie(t *testing.T) {
+	t.Parallel()
+
+	trieInEpochs := createAccountsTrieInEpochs(t)
+	chainPath := trieInEpochs.chainPath
+	hasher := trieInEpochs.hasher
+	rootHash := trieInEpochs.rootHash
+	dataTrieRootHash := trieInEpochs.dataTrieRootHash
+	addressWithDataTrie := trieInEpochs.addressWithDataTrie
+	epoch0Data := trieInEpochs.epoch0Data
+	epoch1Data := trieInEpochs.epoch1Data
+
+	args := createMockArgsDBInspector()
+	args.Hasher = hasher
+	dbInspector, _ := NewDBInspector(args)
+
+	t.Run("missing unit should error", func(t *testing.T) {
+		t.Parallel()
+
+		analysis, err := dbInspector.AnalyzeTrie(ArgsTrieAnalysis{
+			ChainPath: chainPath,
+			ShardDir:  "Shard_1",
+			UnitPath:  "AccountsTrie",
+			RootHash:  rootHash,
+		})
+		assert.Nil(t, analysis)
+		assert.True(t, errors.Is(err, ErrNoTrieUnitFound))
+	})
+	t.Run("should analyze the trie from all the epochs", func(t *testing.T) {
+		t.Parallel()
+
+		analysis, err := dbInspector.AnalyzeTrie(ArgsTrieAnalysis{
+			ChainPath:           chainPath,
+			ShardDir:            "Shard_0",
+			UnitPath:            "AccountsTrie",
+			RootHash:            rootHash,
+			NumLargestDataTries: 5,
+			CountDeadNodes:      true,
+		})
+		require.Nil(t, err)
+
+		report := analysis.Report
+		assert.Equal(t, uint64(0), report.NumMissingNodes)
+		assert.Equal(t, uint64(30), report.MainTrie.NumLeafNodes)
+		assert.Equal(t, uint64(1), report.NumDataTries)
+		assert.Equal(t, uint64(10), report.DataTries.NumLeafNodes)
+		require.Equal(t, 1, len(report.LargestDataTries))
+		assert.Equal(t, addressWithDataTrie, report.LargestDataTries[0].Address)
+		assert.Equal(t, dataTrieRootHash, report.LargestDataTries[0].RootHash)
+
+		require.Equal(t, 2, len(analysis.Epochs))
+		assert.Equal(t, uint32(1), analysis.Epochs[0].Epoch)
+		assert.Equal(t, uint64(len(epoch1Data)), analysis.Epochs[0].DeadNodes.NumStoredNodes)
+		assert.Equal(t, uint64(0), analysis.Epochs[0].DeadNodes.NumDeadNodes)
+		assert.Equal(t, uint32(0), analysis.Epochs[1].Epoch)
+		assert.Equal(t, uint64(len(epoch0Data)), analysis.Epochs[1].DeadNodes.NumStoredNodes)
+		assert.Equal(t, uint64(len(epoch1Data)), analysis.Epochs[1].DeadNodes.NumDeadNodes)
+
+		numReachableNodes := report.MainTrie.NumNodes + report.DataTries.NumNodes
+		assert.Equal(t, uint64(len(epoch0Data)+len(epoch1Data))-analysis.Epochs[1].DeadNodes.NumDeadNodes, numReachableNodes)
+	})
+}
+
+func TestDbInspector_CollectTrieGarbage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing unit should error", func(t *testing.T) {
+		t.Parallel()
+
+		trieInEpochs := createAccountsTrieInEpochs(t)
+		args := createMockArgsDBInspector()
+		args.Hasher = trieInEpochs.hasher
+		dbInspector, _ := NewDBInspector(args)
+
+		result, err := dbInspector.CollectTrieGarbage(context.Background(), ArgsTrieGarbageCollection{
+			ChainPath:  trieInEpochs.chainPath,
+			ShardDir:   "Shard_1",
+			UnitPath:   "AccountsTrie",
+			RootHashes: [][]byte{trieInEpochs.rootHash},
+		})
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, ErrNoTrieUnitFound))
+	})
+	t.Run("should remove the unreachable nodes from all the epochs", func(t *testing.T) {
+		t.Parallel()
+
+		trieInEpochs := createAccountsTrieInEpochs(t)
+		args := createMockArgsDBInspector()
+		args.Hasher = trieInEpochs.hasher
+		dbInspector, _ := NewDBInspector(args)
+
+		gcArgs := ArgsTrieGarbageCollection{
+			ChainPath:       trieInEpochs.chainPath,
+			ShardDir:        "Shard_0",
+			UnitPath:        "AccountsTrie",
+			RootHashes:      [][]byte{trieInEpochs.rootHash},
+			MarkSetSizeInMB: 1,
+			DryRun:          true,
+		}
+		result, err := dbInspector.CollectTrieGarbage(context.Background(), gcArgs)
+		require.Nil(t, err)
+		numDeadNodes := uint64(len(trieInEpochs.epoch1Data))
+		assert.Equal(t, []uint32{1, 0}, result.Epochs)
+		assert.Equal(t, numDeadNodes, result.Report.NumRemovedNodes)
+
+		gcArgs.DryRun = false
+		result, err = dbInspector.CollectTrieGarbage(context.Background(), gcArgs)
+		require.Nil(t, err)
+		assert.Equal(t, numDeadNodes, result.Report.NumRemovedNodes)
+		assert.Equal(t, uint64(len(trieInEpochs.epoch0Data)+len(trieInEpochs.epoch1Data))-numDeadNodes, result.Report.NumReachableNodes)
+
+		analysis, err := dbInspector.AnalyzeTrie(ArgsTrieAnalysis{
+			ChainPath:      trieInEpochs.chainPath,
+			ShardDir:       "Shard_0",
+			UnitPath:       "AccountsTrie",
+			RootHash:       trieInEpochs.rootHash,
+			CountDeadNodes: true,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), analysis.Report.NumMissingNodes)
+		assert.Equal(t, uint64(1), analysis.Report.NumDataTries)
+		for _, epochNodes := range analysis.Epochs {
+			assert.Equal(t, uint64(0), epochNodes.DeadNodes.NumDeadNodes)
+		}
+
+		result, err = dbInspector.CollectTrieGarbage(context.Background(), gcArgs)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), result.Report.NumRemovedNodes)
+	})
+}
+
+func TestDbInspector_CheckAndRemoveEpochs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should report and remove only the corrupted units", func(t *testing.T) {
+		t.Parallel()
+
+		chainPath := t.TempDir()
+		createUnit(t, filepath.Join(chainPath, "Epoch_0", "Shard_0", "BlockHeaders"), nil)
+		createUnit(t, filepath.Join(chainPath, "Epoch_0", "Shard_0", "MiniBlocks"), nil)
+		createUnit(t, filepath.Join(chainPath, "Epoch_10", "Shard_0", "BlockHeaders"), nil)
+		createCorruptedUnit(t, filepath.Join(chainPath, "Epoch_10", "Shard_0", "MiniBlocks"))
+		createUnreadableUnit(t, filepath.Join(chainPath, "Epoch_10", "Shard_0", "Transactions"))
+		createUnit(t, filepath.Join(chainPath, "Static", "Shard_0", "Custom"), nil)
+
+		dbInspector, _ := NewDBInspector(createMockArgsDBInspector())
+		reports, err := dbInspector.CheckEpochs(chainPath)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(reports))
+
+		assert.Equal(t, uint32(0), reports[0].Epoch)
+		assert.Equal(t, 2, reports[0].NumUnits)
+		assert.False(t, reports[0].IsCorrupted())
+		assert.Empty(t, reports[0].UnreadableUnits)
+		assert.Equal(t, uint32(10), reports[1].Epoch)
+		assert.Equal(t, 3, reports[1].NumUnits)
+		require.True(t, reports[1].IsCorrupted())
+		require.Equal(t, 1, len(reports[1].CorruptedUnits))
+		assert.Equal(t, filepath.Join("Shard_0", "MiniBlocks"), reports[1].CorruptedUnits[0].Path)
+		require.Equal(t, 1, len(reports[1].UnreadableUnits))
+		assert.Equal(t, filepath.Join("Shard_0", "Transactions"), reports[1].UnreadableUnits[0].Path)
+
+		err = dbInspector.RemoveCorruptedUnits(nil)
+		assert.Equal(t, ErrNilEpochReport, err)
+
+		err = dbInspector.RemoveCorruptedUnits(reports[1])
+		require.Nil(t, err)
+		assert.False(t, dirExists(filepath.Join(chainPath, "Epoch_10", "Shard_0", "MiniBlocks")))
+		assert.True(t, dirExists(filepath.Join(chainPath, "Epoch_10", "Shard_0", "BlockHeaders")))
+		assert.True(t, dirExists(filepath.Join(chainPath, "Epoch_10", "Shard_0", "Transactions")))
+
+		err = dbInspector.RemoveEpoch(chainPath, 2)
+		assert.True(t, errors.Is(err, ErrEpochNotFound))
+
+		err = dbInspector.RemoveEpoch(chainPath, 10)
+		require.Nil(t, err)
+		assert.False(t, dirExists(filepath.Join(chainPath, "Epoch_10")))
+		assert.True(t, dirExists(filepath.Join(chainPath, "Epoch_0")))
+	})
+	t.Run("locked unit should fail without removing anything", func(t *testing.T) {
+		t.Parallel()
+
+		chainPath := t.TempDir()
+		createUnit(t, filepath.Join(chainPath, "Epoch_0", "Shard_0", "BlockHeaders"), nil)
+		createCorruptedUnit(t, filepath.Join(chainPath, "Epoch_0", "Shard_0", "MiniBlocks"))
+
+		persisterFactory, _ := factory.NewPersisterFactory(factory.NewDBConfigHandler(createDefaultDBConfig()))
+		persister, err := persisterFactory.Create(filepath.Join(chainPath, "Epoch_0", "Shard_0", "BlockHeaders"))
+		require.Nil(t, err)
+		defer func() {
+			_ = persister.Close()
+		}()
+
+		dbInspector, _ := NewDBInspector(createMockArgsDBInspector())
+		reports, err := dbInspector.CheckEpochs(chainPath)
+		assert.True(t, errors.Is(err, ErrUnitLocked))
+		assert.Nil(t, reports)
+
+		err = dbInspector.RemoveEpoch(chainPath, 0)
+		assert.True(t, errors.Is(err, ErrUnitLocked))
+		assert.True(t, dirExists(filepath.Join(chainPath, "Epoch_0", "Shard_0", "MiniBlocks")))
+	})
+}
@@ -0,0 +1,49 @@
+package inspector
+
+import (
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+// epochsStorer is a read only storer searching the keys in the units of multiple epochs, in the order of the
+// provided persisters. The persisters are not closed by the epochsStorer
+type epochsStorer struct {
+	persisters []storage.Persister
+}
+
+func newEpochsStorer(persisters []storage.Persister) *epochsStorer {
+	return &epochsStorer{
+		persisters: persisters,
+	}
+}
+
+// Get returns the value found under the provided key in the first persister holding it
+func (es *epochsStorer) Get(key []byte) ([]byte, error) {
+	for _, persister := range es.persisters {
+		value, err := persister.Get(key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Put returns ErrReadOnlyStorer
+func (es *epochsStorer) Put(_ []byte, _ []byte) error {
+	return ErrReadOnlyStorer
+}
+
+// Remove returns ErrReadOnlyStorer
+func (es *epochsStorer) Remove(_ []byte) error {
+	return ErrReadOnlyStorer
+}
+
+// Close does nothing, the persisters are closed by their owner
+func (es *epochsStorer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (es *epochsStorer) IsInterfaceNil() bool {
+	return es == nil
+}
@@ -0,0 +1,41 @@
+package inspector
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpochsStorer(t *testing.T) {
+	t.Parallel()
+
+	newestEpoch := testscommon.NewMemDbMock()
+	oldestEpoch := testscommon.NewMemDbMock()
+	require.Nil(t, newestEpoch.Put([]byte("key"), []byte("new value")))
+	require.Nil(t, oldestEpoch.Put([]byte("key"), []byte("old value")))
+	require.Nil(t, oldestEpoch.Put([]byte("old key"), []byte("old value")))
+
+	es := newEpochsStorer([]storage.Persister{newestEpoch, oldestEpoch})
+	assert.False(t, es.IsInterfaceNil())
+
+	value, err := es.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new value"), value)
+
+	value, err = es.Get([]byte("old key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("old value"), value)
+
+	value, err = es.Get([]byte("missing key"))
+	assert.Nil(t, value)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	assert.Equal(t, ErrReadOnlyStorer, es.Put([]byte("key"), []byte("value")))
+	assert.Equal(t, ErrReadOnlyStorer, es.Remove([]byte("key")))
+	assert.Nil(t, es.Close())
+	value, _ = newestEpoch.Get([]byte("key"))
+	assert.Equal(t, []byte("new value"), value)
+}
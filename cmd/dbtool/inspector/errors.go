@@ -0,0 +1,41 @@
+package inspector
+
+import "errors"
+
+// ErrNilConfig signals that a nil config has been provided
+var ErrNilConfig = errors.New("nil config")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilUnitsResolver signals that a nil units resolver has been provided
+var ErrNilUnitsResolver = errors.New("nil units resolver")
+
+// ErrUnitNotFound signals that the provided storage unit directory does not exist
+var ErrUnitNotFound = errors.New("storage unit not found")
+
+// ErrEpochNotFound signals that the provided epoch directory does not exist
+var ErrEpochNotFound = errors.New("epoch not found")
+
+// ErrUnitLocked signals that a storage unit is locked, usually because the node is still running
+var ErrUnitLocked = errors.New("storage unit is locked or in use")
+
+// ErrNilEpochReport signals that a nil epoch report has been provided
+var ErrNilEpochReport = errors.New("nil epoch report")
+
+// ErrNilValueDecoder signals that a nil value decoder has been provided
+var ErrNilValueDecoder = errors.New("nil value decoder")
+
+// ErrNilHandler signals that a nil handler has been provided
+var ErrNilHandler = errors.New("nil handler")
+
+// ErrReadOnlyStorer signals that a write operation was attempted on a read only storer
+var ErrReadOnlyStorer = errors.New("read only storer")
+
+// ErrNoTrieUnitFound signals that the trie storage unit was not found in any epoch
+var ErrNoTrieUnitFound = errors.New("trie storage unit not found in any epoch")
+
+var errUnknownUnitValue = errors.New("the values of this storage unit cannot be decoded")
@@ -0,0 +1,34 @@
+package inspector
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// UnitsResolver defines the component able to tell the unit type of a storage unit directory
+type UnitsResolver interface {
+	Resolve(relativePath string) (dataRetriever.UnitType, bool)
+	IsInterfaceNil() bool
+}
+
+// ValueDecoder defines the component able to decode the values stored in a storage unit
+type ValueDecoder interface {
+	Decode(unitType dataRetriever.UnitType, key []byte, value []byte) (interface{}, error)
+	IsInterfaceNil() bool
+}
+
+// DBInspector defines the operations available on the databases of a stopped node
+type DBInspector interface {
+	ListUnits(shardPath string) ([]*UnitInfo, error)
+	Get(shardPath string, unitPath string, key []byte) (*Entry, error)
+	Iterate(shardPath string, unitPath string, prefix []byte, limit int, handler func(entry *Entry) bool) error
+	VerifyTrie(shardPath string, unitPath string, rootHash []byte) (*trie.IntegrityCheckResult, error)
+	AnalyzeTrie(args ArgsTrieAnalysis) (*TrieAnalysis, error)
+	CollectTrieGarbage(ctx context.Context, args ArgsTrieGarbageCollection) (*TrieGarbageCollection, error)
+	CheckEpochs(chainPath string) ([]*EpochReport, error)
+	RemoveCorruptedUnits(report *EpochReport) error
+	RemoveEpoch(chainPath string, epoch uint32) error
+	IsInterfaceNil() bool
+}
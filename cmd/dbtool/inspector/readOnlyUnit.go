@@ -0,0 +1,462 @@
+package inspector
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbErrors "github.com/syndtr/goleveldb/leveldb/errors"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	leveldbStorage "github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+const (
+	levelDBCurrentFile    = "CURRENT"
+	pebbleOptionsFileGlob = "OPTIONS-*"
+)
+
+type readOnlyDB interface {
+	Get(key []byte) ([]byte, error)
+	RangeKeys(handler func(key []byte, value []byte) bool) bool
+	Close() error
+}
+
+// readOnlyUnit is a storage unit opened in read only mode: the databases are not recovered, compacted or upgraded
+// and nothing is written on disk, the unit's config included. A sharded unit holds one database for each shard
+type readOnlyUnit struct {
+	path string
+	dbs  []readOnlyDB
+}
+
+// openReadOnlyUnit opens the databases of the unit found at the provided path, using the DB type saved in the
+// unit's config
+func openReadOnlyUnit(path string) (*readOnlyUnit, error) {
+	dbType, err := detectDBType(path)
+	if err != nil {
+		return nil, err
+	}
+
+	dbPaths, err := getDBPaths(path)
+	if err != nil {
+		return nil, err
+	}
+
+	unit := &readOnlyUnit{
+		path: path,
+		dbs:  make([]readOnlyDB, 0, len(dbPaths)),
+	}
+	for _, dbPath := range dbPaths {
+		db, errOpen := openReadOnlyDB(dbPath, dbType)
+		if errOpen != nil {
+			_ = unit.Close()
+			return nil, errOpen
+		}
+
+		unit.dbs = append(unit.dbs, db)
+	}
+
+	return unit, nil
+}
+
+func openReadOnlyDB(path string, dbType storageunit.DBType) (readOnlyDB, error) {
+	switch dbType {
+	case storageunit.LvlDB, storageunit.LvlDBSerial:
+		return openReadOnlyLevelDB(path)
+	case storageunit.PebbleDB:
+		return openReadOnlyPebbleDB(path)
+	default:
+		return nil, fmt.Errorf("%w: %s", storage.ErrNotSupportedDBType, dbType)
+	}
+}
+
+// detectDBType returns the DB type saved by the node in the unit's config. The units without a config are
+// recognized by their files, as only Pebble writes OPTIONS files
+func detectDBType(unitPath string) (storageunit.DBType, error) {
+	dbConfig := &config.DBConfig{}
+	err := core.LoadTomlFile(dbConfig, filepath.Join(unitPath, dbConfigFileName))
+	if err == nil && len(dbConfig.Type) > 0 {
+		return storageunit.DBType(dbConfig.Type), nil
+	}
+
+	dbPaths, err := getDBPaths(unitPath)
+	if err != nil {
+		return "", err
+	}
+
+	for _, dbPath := range dbPaths {
+		optionsFiles, errGlob := filepath.Glob(filepath.Join(dbPath, pebbleOptionsFileGlob))
+		if errGlob != nil {
+			return "", errGlob
+		}
+		if len(optionsFiles) > 0 {
+			return storageunit.PebbleDB, nil
+		}
+	}
+
+	return storageunit.LvlDBSerial, nil
+}
+
+// getDBPaths returns the directories of the databases of a unit: the unit directory itself or, for a sharded
+// persister, its numeric sub-directories
+func getDBPaths(unitPath string) ([]string, error) {
+	if fileExists(filepath.Join(unitPath, levelDBCurrentFile)) {
+		return []string{unitPath}, nil
+	}
+
+	entries, err := os.ReadDir(unitPath)
+	if err != nil {
+		return nil, err
+	}
+
+	dbPaths := make([]string, 0)
+	for _, entry := range entries {
+		_, errParse := strconv.ParseUint(entry.Name(), 10, 32)
+		if !entry.IsDir() || errParse != nil {
+			continue
+		}
+
+		dbPaths = append(dbPaths, filepath.Join(unitPath, entry.Name()))
+	}
+	if len(dbPaths) == 0 {
+		return []string{unitPath}, nil
+	}
+
+	return dbPaths, nil
+}
+
+// lockUnit acquires the locks of the databases of the unit, failing if any of them is held by another process, like
+// a running node. The returned function releases the locks
+func lockUnit(unitPath string) (func(), error) {
+	dbType, err := detectDBType(unitPath)
+	if err != nil {
+		return nil, err
+	}
+
+	dbPaths, err := getDBPaths(unitPath)
+	if err != nil {
+		return nil, err
+	}
+
+	unlockHandlers := make([]func(), 0, len(dbPaths))
+	unlockAll := func() {
+		for _, unlock := range unlockHandlers {
+			unlock()
+		}
+	}
+	for _, dbPath := range dbPaths {
+		unlock, errLock := lockDB(dbPath, dbType)
+		if errLock != nil {
+			unlockAll()
+			return nil, errLock
+		}
+
+		unlockHandlers = append(unlockHandlers, unlock)
+	}
+
+	return unlockAll, nil
+}
+
+func lockDB(path string, dbType storageunit.DBType) (func(), error) {
+	if dbType == storageunit.PebbleDB {
+		lock, err := lockPebbleDB(path)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() { _ = lock.Close() }, nil
+	}
+
+	stor, err := openLevelDBStorage(path, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() { _ = stor.Close() }, nil
+}
+
+// isCorrupted returns true if the error was returned by the database while reading its corrupted files
+func isCorrupted(err error) bool {
+	return leveldbErrors.IsCorrupted(err) || pebble.IsCorruptionError(err)
+}
+
+// Get returns the value found under the provided key in any of the unit's databases
+func (unit *readOnlyUnit) Get(key []byte) ([]byte, error) {
+	for _, db := range unit.dbs {
+		value, err := db.Get(key)
+		if errors.Is(err, storage.ErrKeyNotFound) {
+			continue
+		}
+
+		return value, err
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Has returns nil if the provided key is found in any of the unit's databases
+func (unit *readOnlyUnit) Has(key []byte) error {
+	_, err := unit.Get(key)
+	return err
+}
+
+// RangeKeys calls the handler for all the entries of the unit's databases, until the handler returns false
+func (unit *readOnlyUnit) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	for _, db := range unit.dbs {
+		shouldContinue := db.RangeKeys(handler)
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+// Put returns ErrReadOnlyStorer
+func (unit *readOnlyUnit) Put(_ []byte, _ []byte) error {
+	return ErrReadOnlyStorer
+}
+
+// Remove returns ErrReadOnlyStorer
+func (unit *readOnlyUnit) Remove(_ []byte) error {
+	return ErrReadOnlyStorer
+}
+
+// Destroy returns ErrReadOnlyStorer
+func (unit *readOnlyUnit) Destroy() error {
+	return ErrReadOnlyStorer
+}
+
+// DestroyClosed returns ErrReadOnlyStorer
+func (unit *readOnlyUnit) DestroyClosed() error {
+	return ErrReadOnlyStorer
+}
+
+// Close closes all the unit's databases, releasing their locks
+func (unit *readOnlyUnit) Close() error {
+	var lastErr error
+	for _, db := range unit.dbs {
+		err := db.Close()
+		if err != nil {
+			log.Warn("could not close database", "path", unit.path, "error", err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (unit *readOnlyUnit) IsInterfaceNil() bool {
+	return unit == nil
+}
+
+type readOnlyLevelDB struct {
+	storage leveldbStorage.Storage
+	db      *leveldb.DB
+}
+
+func openReadOnlyLevelDB(path string) (*readOnlyLevelDB, error) {
+	stor, err := openLevelDBStorage(path, true)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := leveldb.Open(stor, &opt.Options{
+		ReadOnly:       true,
+		ErrorIfMissing: true,
+	})
+	if err != nil {
+		_ = stor.Close()
+		return nil, err
+	}
+
+	return &readOnlyLevelDB{
+		storage: stor,
+		db:      db,
+	}, nil
+}
+
+// openLevelDBStorage acquires the lock of the database, failing if it is held by another process. In read only mode,
+// the lock is shared and the lock file is not created
+func openLevelDBStorage(path string, readOnly bool) (leveldbStorage.Storage, error) {
+	stor, err := leveldbStorage.OpenFile(path, readOnly)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s, %s", ErrUnitLocked, path, err.Error())
+	}
+
+	return stor, nil
+}
+
+// Get returns the value associated to the key
+func (ldb *readOnlyLevelDB) Get(key []byte) ([]byte, error) {
+	value, err := ldb.db.Get(key, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	return value, err
+}
+
+// RangeKeys calls the handler for all the entries and returns false if the handler stopped the iteration
+func (ldb *readOnlyLevelDB) RangeKeys(handler func(key []byte, value []byte) bool) bool {
+	iterator := ldb.db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		key := cloneBytes(iterator.Key())
+		value := cloneBytes(iterator.Value())
+
+		shouldContinue := handler(key, value)
+		if !shouldContinue {
+			return false
+		}
+	}
+
+	err := iterator.Error()
+	if err != nil {
+		log.Warn("could not iterate the database", "error", err)
+	}
+
+	return true
+}
+
+// Close closes the database and releases its lock
+func (ldb *readOnlyLevelDB) Close() error {
+	err := ldb.db.Close()
+	errStorage := ldb.storage.Close()
+	if err != nil {
+		return err
+	}
+
+	return errStorage
+}
+
+type readOnlyPebbleDB struct {
+	lock *pebble.Lock
+	db   *pebble.DB
+}
+
+func openReadOnlyPebbleDB(path string) (*readOnlyPebbleDB, error) {
+	lock, err := lockPebbleDB(path)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := pebble.Open(path, &pebble.Options{
+		ReadOnly:         true,
+		ErrorIfNotExists: true,
+		Lock:             lock,
+		Logger:           &pebbleLogger{path: path},
+	})
+	if err != nil {
+		_ = lock.Close()
+		return nil, err
+	}
+
+	return &readOnlyPebbleDB{
+		lock: lock,
+		db:   db,
+	}, nil
+}
+
+// lockPebbleDB acquires the lock of an existing Pebble database, failing if it is held by another process
+func lockPebbleDB(path string) (*pebble.Lock, error) {
+	if !dirExists(path) {
+		return nil, fmt.Errorf("%w: %s", ErrUnitNotFound, path)
+	}
+
+	lock, err := pebble.LockDirectory(path, vfs.Default)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s, %s", ErrUnitLocked, path, err.Error())
+	}
+
+	return lock, nil
+}
+
+// Get returns the value associated to the key
+func (pdb *readOnlyPebbleDB) Get(key []byte) ([]byte, error) {
+	value, closer, err := pdb.db.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the returned slice is only valid until the closer is called
+	clonedValue := cloneBytes(value)
+	_ = closer.Close()
+
+	return clonedValue, nil
+}
+
+// RangeKeys calls the handler for all the entries and returns false if the handler stopped the iteration
+func (pdb *readOnlyPebbleDB) RangeKeys(handler func(key []byte, value []byte) bool) bool {
+	iterator, err := pdb.db.NewIter(nil)
+	if err != nil {
+		log.Warn("could not iterate the database", "error", err)
+		return true
+	}
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	for valid := iterator.First(); valid; valid = iterator.Next() {
+		key := cloneBytes(iterator.Key())
+		value := cloneBytes(iterator.Value())
+
+		shouldContinue := handler(key, value)
+		if !shouldContinue {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Close closes the database and releases its lock
+func (pdb *readOnlyPebbleDB) Close() error {
+	err := pdb.db.Close()
+	errLock := pdb.lock.Close()
+	if err != nil {
+		return err
+	}
+
+	return errLock
+}
+
+// pebbleLogger redirects the pebble internal messages towards the tool's logger
+type pebbleLogger struct {
+	path string
+}
+
+// Infof logs the pebble informational messages
+func (pl *pebbleLogger) Infof(format string, args ...interface{}) {
+	log.Trace("pebble", "path", pl.path, "message", fmt.Sprintf(format, args...))
+}
+
+// Fatalf logs the pebble unrecoverable errors. Pebble expects this call not to return
+func (pl *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	log.Error("pebble fatal error", "path", pl.path, "message", message)
+
+	panic(message)
+}
+
+func cloneBytes(buff []byte) []byte {
+	cloned := make([]byte, len(buff))
+	copy(cloned, buff)
+
+	return cloned
+}
@@ -0,0 +1,233 @@
+package inspector
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPebbleUnit(t *testing.T, path string, data map[string][]byte, withConfig bool) {
+	db, err := database.NewPebbleDB(path, 10)
+	require.Nil(t, err)
+	for key, value := range data {
+		require.Nil(t, db.Put([]byte(key), value))
+	}
+	require.Nil(t, db.Close())
+
+	if withConfig {
+		dbConfig := createDefaultDBConfig()
+		dbConfig.Type = string(storageunit.PebbleDB)
+		require.Nil(t, core.SaveTomlFile(&dbConfig, filepath.Join(path, dbConfigFileName)))
+	}
+}
+
+func listFiles(t *testing.T, path string) map[string]int64 {
+	files := make(map[string]int64)
+	err := filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
+		require.Nil(t, err)
+		if !info.IsDir() {
+			files[filePath] = info.Size()
+		}
+
+		return nil
+	})
+	require.Nil(t, err)
+
+	return files
+}
+
+func TestDetectDBType(t *testing.T) {
+	t.Parallel()
+
+	t.Run("type from config", func(t *testing.T) {
+		t.Parallel()
+
+		unitPath := filepath.Join(t.TempDir(), "Unit")
+		createPebbleUnit(t, unitPath, nil, true)
+
+		dbType, err := detectDBType(unitPath)
+		require.Nil(t, err)
+		assert.Equal(t, storageunit.PebbleDB, dbType)
+	})
+	t.Run("pebble unit without config", func(t *testing.T) {
+		t.Parallel()
+
+		unitPath := filepath.Join(t.TempDir(), "Unit")
+		createPebbleUnit(t, unitPath, nil, false)
+
+		dbType, err := detectDBType(unitPath)
+		require.Nil(t, err)
+		assert.Equal(t, storageunit.PebbleDB, dbType)
+	})
+	t.Run("leveldb unit without config", func(t *testing.T) {
+		t.Parallel()
+
+		unitPath := filepath.Join(t.TempDir(), "Unit")
+		createUnit(t, unitPath, nil)
+		require.Nil(t, os.Remove(filepath.Join(unitPath, dbConfigFileName)))
+
+		dbType, err := detectDBType(unitPath)
+		require.Nil(t, err)
+		assert.Equal(t, storageunit.LvlDBSerial, dbType)
+	})
+	t.Run("sharded pebble unit without config", func(t *testing.T) {
+		t.Parallel()
+
+		unitPath := filepath.Join(t.TempDir(), "Unit")
+		createPebbleUnit(t, filepath.Join(unitPath, "0"), nil, false)
+		createPebbleUnit(t, filepath.Join(unitPath, "1"), nil, false)
+
+		dbType, err := detectDBType(unitPath)
+		require.Nil(t, err)
+		assert.Equal(t, storageunit.PebbleDB, dbType)
+	})
+}
+
+func TestOpenReadOnlyUnit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unsupported db type should error", func(t *testing.T) {
+		t.Parallel()
+
+		unitPath := filepath.Join(t.TempDir(), "Unit")
+		createUnreadableUnit(t, unitPath)
+
+		unit, err := openReadOnlyUnit(unitPath)
+		assert.True(t, errors.Is(err, storage.ErrNotSupportedDBType))
+		assert.Nil(t, unit)
+	})
+	t.Run("locked pebble unit should error", func(t *testing.T) {
+		t.Parallel()
+
+		unitPath := filepath.Join(t.TempDir(), "Unit")
+		createPebbleUnit(t, unitPath, nil, true)
+		db, err := database.NewPebbleDB(unitPath, 10)
+		require.Nil(t, err)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		unit, err := openReadOnlyUnit(unitPath)
+		assert.True(t, errors.Is(err, ErrUnitLocked))
+		assert.Nil(t, unit)
+	})
+	t.Run("sharded pebble unit should read all the shards", func(t *testing.T) {
+		t.Parallel()
+
+		unitPath := filepath.Join(t.TempDir(), "Unit")
+		createPebbleUnit(t, filepath.Join(unitPath, "0"), map[string][]byte{"key0": []byte("value0")}, false)
+		createPebbleUnit(t, filepath.Join(unitPath, "1"), map[string][]byte{"key1": []byte("value1")}, false)
+		dbConfig := createDefaultDBConfig()
+		dbConfig.Type = string(storageunit.PebbleDB)
+		dbConfig.NumShards = 2
+		require.Nil(t, core.SaveTomlFile(&dbConfig, filepath.Join(unitPath, dbConfigFileName)))
+
+		unit, err := openReadOnlyUnit(unitPath)
+		require.Nil(t, err)
+		defer func() {
+			_ = unit.Close()
+		}()
+
+		value, err := unit.Get([]byte("key1"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("value1"), value)
+		assert.Nil(t, unit.Has([]byte("key0")))
+		assert.Equal(t, storage.ErrKeyNotFound, unit.Has([]byte("missing")))
+
+		numEntries := 0
+		unit.RangeKeys(func(key []byte, value []byte) bool {
+			numEntries++
+			return true
+		})
+		assert.Equal(t, 2, numEntries)
+
+		assert.Equal(t, ErrReadOnlyStorer, unit.Put([]byte("key"), []byte("value")))
+		assert.Equal(t, ErrReadOnlyStorer, unit.Remove([]byte("key0")))
+		assert.Equal(t, ErrReadOnlyStorer, unit.Destroy())
+		assert.Equal(t, ErrReadOnlyStorer, unit.DestroyClosed())
+	})
+}
+
+func TestDbInspector_ShouldNotWriteInTheInspectedUnits(t *testing.T) {
+	t.Parallel()
+
+	shardPath := t.TempDir()
+	createUnit(t, filepath.Join(shardPath, "BlockHeaders"), map[string][]byte{"key": []byte("value")})
+	require.Nil(t, os.Remove(filepath.Join(shardPath, "BlockHeaders", dbConfigFileName)))
+	createPebbleUnit(t, filepath.Join(shardPath, "MiniBlocks"), map[string][]byte{"key": []byte("value")}, false)
+	filesBefore := listFiles(t, shardPath)
+
+	dbInspector, _ := NewDBInspector(createMockArgsDBInspector())
+	for _, unitPath := range []string{"BlockHeaders", "MiniBlocks"} {
+		entry, err := dbInspector.Get(shardPath, unitPath, []byte("key"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("value"), entry.Value)
+
+		numEntries := 0
+		err = dbInspector.Iterate(shardPath, unitPath, nil, 0, func(entry *Entry) bool {
+			numEntries++
+			return true
+		})
+		require.Nil(t, err)
+		assert.Equal(t, 1, numEntries)
+
+		_, err = dbInspector.VerifyTrie(shardPath, unitPath, []byte("root hash"))
+		require.Nil(t, err)
+	}
+
+	units, err := dbInspector.ListUnits(shardPath)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(units))
+	assert.Equal(t, string(storageunit.LvlDBSerial), units[0].DBType)
+	assert.Equal(t, string(storageunit.PebbleDB), units[1].DBType)
+
+	assert.Equal(t, filesBefore, listFiles(t, shardPath))
+	assert.False(t, fileExists(filepath.Join(shardPath, "BlockHeaders", dbConfigFileName)))
+}
+
+func TestDbInspector_CheckEpochsWithPebbleUnits(t *testing.T) {
+	t.Parallel()
+
+	chainPath := t.TempDir()
+	shardPath := filepath.Join(chainPath, "Epoch_0", "Shard_0")
+	createPebbleUnit(t, filepath.Join(shardPath, "BlockHeaders"), map[string][]byte{"key": []byte("value")}, true)
+	createPebbleUnit(t, filepath.Join(shardPath, "MiniBlocks"), map[string][]byte{"key": []byte("value")}, true)
+
+	manifests, err := filepath.Glob(filepath.Join(shardPath, "MiniBlocks", "MANIFEST-*"))
+	require.Nil(t, err)
+	require.NotEmpty(t, manifests)
+	for _, manifest := range manifests {
+		require.Nil(t, os.WriteFile(manifest, []byte("corrupted manifest"), os.ModePerm))
+	}
+
+	dbInspector, _ := NewDBInspector(createMockArgsDBInspector())
+	reports, err := dbInspector.CheckEpochs(chainPath)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(reports))
+	assert.Equal(t, 2, reports[0].NumUnits)
+	assert.Empty(t, reports[0].UnreadableUnits)
+	require.Equal(t, 1, len(reports[0].CorruptedUnits))
+	assert.Equal(t, filepath.Join("Shard_0", "MiniBlocks"), reports[0].CorruptedUnits[0].Path)
+
+	db, err := database.NewPebbleDB(filepath.Join(shardPath, "BlockHeaders"), 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	reports, err = dbInspector.CheckEpochs(chainPath)
+	assert.True(t, errors.Is(err, ErrUnitLocked))
+	assert.Nil(t, reports)
+
+	err = dbInspector.RemoveEpoch(chainPath, 0)
+	assert.True(t, errors.Is(err, ErrUnitLocked))
+	assert.True(t, dirExists(shardPath))
+}
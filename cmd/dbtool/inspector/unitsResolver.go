@@ -0,0 +1,90 @@
+package inspector
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+)
+
+type unitsResolver struct {
+	unitsByPath           map[string]dataRetriever.UnitType
+	shardHdrNonceHashPath string
+}
+
+// NewUnitsResolver creates a component able to tell the unit type of a storage unit directory, based on the
+// paths defined in the node's main config
+func NewUnitsResolver(generalConfig *config.Config) (*unitsResolver, error) {
+	if generalConfig == nil {
+		return nil, ErrNilConfig
+	}
+
+	unitsByPath := map[string]dataRetriever.UnitType{
+		generalConfig.TxStorage.DB.FilePath:                                                dataRetriever.TransactionUnit,
+		generalConfig.MiniBlocksStorage.DB.FilePath:                                        dataRetriever.MiniBlockUnit,
+		generalConfig.PeerBlockBodyStorage.DB.FilePath:                                     dataRetriever.PeerChangesUnit,
+		generalConfig.BlockHeaderStorage.DB.FilePath:                                       dataRetriever.BlockHeaderUnit,
+		generalConfig.MetaBlockStorage.DB.FilePath:                                         dataRetriever.MetaBlockUnit,
+		generalConfig.UnsignedTransactionStorage.DB.FilePath:                               dataRetriever.UnsignedTransactionUnit,
+		generalConfig.RewardTxStorage.DB.FilePath:                                          dataRetriever.RewardTransactionUnit,
+		generalConfig.MetaHdrNonceHashStorage.DB.FilePath:                                  dataRetriever.MetaHdrNonceHashDataUnit,
+		generalConfig.BootstrapStorage.DB.FilePath:                                         dataRetriever.BootstrapUnit,
+		generalConfig.StatusMetricsStorage.DB.FilePath:                                     dataRetriever.StatusMetricsUnit,
+		generalConfig.LogsAndEvents.TxLogsStorage.DB.FilePath:                              dataRetriever.TxLogsUnit,
+		generalConfig.DbLookupExtensions.MiniblocksMetadataStorageConfig.DB.FilePath:       dataRetriever.MiniblocksMetadataUnit,
+		generalConfig.DbLookupExtensions.EpochByHashStorageConfig.DB.FilePath:              dataRetriever.EpochByHashUnit,
+		generalConfig.DbLookupExtensions.MiniblockHashByTxHashStorageConfig.DB.FilePath:    dataRetriever.MiniblockHashByTxHashUnit,
+		generalConfig.ReceiptsStorage.DB.FilePath:                                          dataRetriever.ReceiptsUnit,
+		generalConfig.DbLookupExtensions.ResultsHashesByTxHashStorageConfig.DB.FilePath:    dataRetriever.ResultsHashesByTxHashUnit,
+		generalConfig.TrieEpochRootHashStorage.DB.FilePath:                                 dataRetriever.TrieEpochRootHashUnit,
+		generalConfig.DbLookupExtensions.ESDTSuppliesStorageConfig.DB.FilePath:             dataRetriever.ESDTSuppliesUnit,
+		generalConfig.DbLookupExtensions.RoundHashStorageConfig.DB.FilePath:                dataRetriever.RoundHdrHashDataUnit,
+		generalConfig.AccountsTrieStorage.DB.FilePath:                                      dataRetriever.UserAccountsUnit,
+		generalConfig.PeerAccountsTrieStorage.DB.FilePath:                                  dataRetriever.PeerAccountsUnit,
+		generalConfig.ScheduledSCRsStorage.DB.FilePath:                                     dataRetriever.ScheduledSCRsUnit,
+		generalConfig.DbLookupExtensions.EventsIndex.ByAddressStorageConfig.DB.FilePath:    dataRetriever.EventsByAddressUnit,
+		generalConfig.DbLookupExtensions.EventsIndex.ByIdentifierStorageConfig.DB.FilePath: dataRetriever.EventsByIdentifierUnit,
+		generalConfig.DbLookupExtensions.EventsIndex.ByTopicStorageConfig.DB.FilePath:      dataRetriever.EventsByTopicUnit,
+		generalConfig.DbLookupExtensions.AccountsDiffs.StorageConfig.DB.FilePath:           dataRetriever.AccountsDiffsUnit,
+	}
+	// units not defined in the provided config should not be resolved
+	delete(unitsByPath, "")
+
+	cleanedUnitsByPath := make(map[string]dataRetriever.UnitType, len(unitsByPath))
+	for path, unitType := range unitsByPath {
+		cleanedUnitsByPath[filepath.Clean(path)] = unitType
+	}
+
+	return &unitsResolver{
+		unitsByPath:           cleanedUnitsByPath,
+		shardHdrNonceHashPath: generalConfig.ShardHdrNonceHashStorage.DB.FilePath,
+	}, nil
+}
+
+// Resolve returns the unit type stored in the provided directory, relative to the shard directory
+func (ur *unitsResolver) Resolve(relativePath string) (dataRetriever.UnitType, bool) {
+	relativePath = filepath.Clean(relativePath)
+	unitType, found := ur.unitsByPath[relativePath]
+	if found {
+		return unitType, true
+	}
+
+	// the shard header nonce-hash units are suffixed with the shard ID
+	if len(ur.shardHdrNonceHashPath) == 0 || !strings.HasPrefix(relativePath, ur.shardHdrNonceHashPath) {
+		return 0, false
+	}
+
+	shardID, err := strconv.ParseUint(strings.TrimPrefix(relativePath, ur.shardHdrNonceHashPath), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	return dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardID), true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ur *unitsResolver) IsInterfaceNil() bool {
+	return ur == nil
+}
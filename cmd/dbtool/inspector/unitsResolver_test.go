@@ -0,0 +1,73 @@
+package inspector
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createGeneralConfig() *config.Config {
+	generalConfig := &config.Config{}
+	generalConfig.BlockHeaderStorage.DB.FilePath = "BlockHeaders"
+	generalConfig.MiniBlocksStorage.DB.FilePath = "MiniBlocks"
+	generalConfig.TxStorage.DB.FilePath = "Transactions"
+	generalConfig.AccountsTrieStorage.DB.FilePath = "AccountsTrie"
+	generalConfig.ShardHdrNonceHashStorage.DB.FilePath = "ShardHdrHashNonce"
+	generalConfig.DbLookupExtensions.MiniblocksMetadataStorageConfig.DB.FilePath = "DbLookupExtensions/MiniblocksMetadata"
+
+	return generalConfig
+}
+
+func TestNewUnitsResolver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil config should error", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := NewUnitsResolver(nil)
+		assert.Equal(t, ErrNilConfig, err)
+		assert.Nil(t, resolver)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := NewUnitsResolver(createGeneralConfig())
+		assert.Nil(t, err)
+		assert.False(t, resolver.IsInterfaceNil())
+	})
+}
+
+func TestUnitsResolver_Resolve(t *testing.T) {
+	t.Parallel()
+
+	resolver, err := NewUnitsResolver(createGeneralConfig())
+	require.Nil(t, err)
+
+	testCases := []struct {
+		path             string
+		expectedUnitType dataRetriever.UnitType
+		expectedFound    bool
+	}{
+		{path: "BlockHeaders", expectedUnitType: dataRetriever.BlockHeaderUnit, expectedFound: true},
+		{path: "BlockHeaders/", expectedUnitType: dataRetriever.BlockHeaderUnit, expectedFound: true},
+		{path: "AccountsTrie", expectedUnitType: dataRetriever.UserAccountsUnit, expectedFound: true},
+		{path: "DbLookupExtensions/MiniblocksMetadata", expectedUnitType: dataRetriever.MiniblocksMetadataUnit, expectedFound: true},
+		{path: "ShardHdrHashNonce2", expectedUnitType: dataRetriever.ShardHdrNonceHashDataUnit + 2, expectedFound: true},
+		{path: "ShardHdrHashNonce", expectedFound: false},
+		{path: "ShardHdrHashNonceX", expectedFound: false},
+		{path: "DbLookupExtensions", expectedFound: false},
+		{path: "Unknown", expectedFound: false},
+		{path: "", expectedFound: false},
+	}
+
+	for _, tc := range testCases {
+		unitType, found := resolver.Resolve(tc.path)
+		assert.Equal(t, tc.expectedFound, found, tc.path)
+		if tc.expectedFound {
+			assert.Equal(t, tc.expectedUnitType, unitType, tc.path)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package inspector
+
+import (
+	"encoding/hex"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/scheduled"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+)
+
+const esdtSuppliesProcessedBlockKey = "processed-block"
+
+type valueDecoder struct {
+	marshaller marshal.Marshalizer
+}
+
+// NewValueDecoder creates a component able to decode the values stored in the storage units of a node
+func NewValueDecoder(marshaller marshal.Marshalizer) (*valueDecoder, error) {
+	if check.IfNil(marshaller) {
+		return nil, ErrNilMarshaller
+	}
+
+	return &valueDecoder{
+		marshaller: marshaller,
+	}, nil
+}
+
+// Decode unmarshals the value stored under the provided key in a storage unit of the provided type. Units holding
+// hashes return them hex encoded, while units holding raw or trie data return errUnknownUnitValue
+func (vd *valueDecoder) Decode(unitType dataRetriever.UnitType, key []byte, value []byte) (interface{}, error) {
+	if unitType >= dataRetriever.ShardHdrNonceHashDataUnit {
+		return hex.EncodeToString(value), nil
+	}
+
+	switch unitType {
+	case dataRetriever.TransactionUnit:
+		return vd.unmarshal(&transaction.Transaction{}, value)
+	case dataRetriever.UnsignedTransactionUnit:
+		return vd.unmarshal(&smartContractResult.SmartContractResult{}, value)
+	case dataRetriever.RewardTransactionUnit:
+		return vd.unmarshal(&rewardTx.RewardTx{}, value)
+	case dataRetriever.MiniBlockUnit, dataRetriever.PeerChangesUnit:
+		return vd.unmarshal(&block.MiniBlock{}, value)
+	case dataRetriever.BlockHeaderUnit:
+		return process.UnmarshalShardHeader(vd.marshaller, value)
+	case dataRetriever.MetaBlockUnit:
+		return process.UnmarshalMetaHeader(vd.marshaller, value)
+	case dataRetriever.TxLogsUnit:
+		return vd.unmarshal(&transaction.Log{}, value)
+	case dataRetriever.ReceiptsUnit:
+		return vd.decodeReceipts(value)
+	case dataRetriever.BootstrapUnit:
+		if string(key) == common.HighestRoundFromBootStorage {
+			return vd.unmarshal(&bootstrapStorage.RoundNum{}, value)
+		}
+		return vd.unmarshal(&bootstrapStorage.BootstrapData{}, value)
+	case dataRetriever.ScheduledSCRsUnit:
+		return vd.unmarshal(&scheduled.ScheduledSCRs{}, value)
+	case dataRetriever.MiniblocksMetadataUnit:
+		return vd.unmarshal(&dblookupext.MiniblockMetadata{}, value)
+	case dataRetriever.EpochByHashUnit:
+		return vd.unmarshal(&dblookupext.EpochByHash{}, value)
+	case dataRetriever.ResultsHashesByTxHashUnit:
+		return vd.unmarshal(&dblookupext.ResultsHashesByTxHash{}, value)
+	case dataRetriever.ESDTSuppliesUnit:
+		if string(key) == esdtSuppliesProcessedBlockKey {
+			return vd.unmarshal(&esdtSupply.ProcessedBlockNonce{}, value)
+		}
+		return vd.unmarshal(&esdtSupply.SupplyESDT{}, value)
+	case dataRetriever.MetaHdrNonceHashDataUnit, dataRetriever.RoundHdrHashDataUnit,
+		dataRetriever.MiniblockHashByTxHashUnit, dataRetriever.TrieEpochRootHashUnit:
+		return hex.EncodeToString(value), nil
+	default:
+		return nil, errUnknownUnitValue
+	}
+}
+
+func (vd *valueDecoder) unmarshal(obj interface{}, value []byte) (interface{}, error) {
+	err := vd.marshaller.Unmarshal(obj, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// decodeReceipts unpacks the batch of marshalled miniblocks stored under a receipts hash
+func (vd *valueDecoder) decodeReceipts(value []byte) (interface{}, error) {
+	if len(value) == 0 {
+		return make([]*block.MiniBlock, 0), nil
+	}
+
+	receiptsBatch := &batch.Batch{}
+	err := vd.marshaller.Unmarshal(receiptsBatch, value)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlocks := make([]*block.MiniBlock, 0, len(receiptsBatch.Data))
+	for _, miniBlockBytes := range receiptsBatch.Data {
+		miniBlock := &block.MiniBlock{}
+		err = vd.marshaller.Unmarshal(miniBlock, miniBlockBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		miniBlocks = append(miniBlocks, miniBlock)
+	}
+
+	return miniBlocks, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vd *valueDecoder) IsInterfaceNil() bool {
+	return vd == nil
+}
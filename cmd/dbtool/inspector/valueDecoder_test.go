@@ -0,0 +1,129 @@
+package inspector
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewValueDecoder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder, err := NewValueDecoder(nil)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.Nil(t, decoder)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		decoder, err := NewValueDecoder(&marshal.GogoProtoMarshalizer{})
+		assert.Nil(t, err)
+		assert.False(t, decoder.IsInterfaceNil())
+	})
+}
+
+func TestValueDecoder_Decode(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	decoder, _ := NewValueDecoder(marshaller)
+
+	t.Run("transaction", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.Transaction{Nonce: 7, Value: nil, Data: []byte("data")}
+		txBytes, _ := marshaller.Marshal(tx)
+
+		decoded, err := decoder.Decode(dataRetriever.TransactionUnit, []byte("hash"), txBytes)
+		require.Nil(t, err)
+		assert.Equal(t, tx, decoded)
+	})
+	t.Run("shard header", func(t *testing.T) {
+		t.Parallel()
+
+		header := &block.HeaderV2{Header: &block.Header{Nonce: 5, Round: 6}}
+		headerBytes, _ := marshaller.Marshal(header)
+
+		decoded, err := decoder.Decode(dataRetriever.BlockHeaderUnit, []byte("hash"), headerBytes)
+		require.Nil(t, err)
+		assert.Equal(t, header, decoded)
+	})
+	t.Run("meta header", func(t *testing.T) {
+		t.Parallel()
+
+		header := &block.MetaBlock{Nonce: 5, Round: 6}
+		headerBytes, _ := marshaller.Marshal(header)
+
+		decoded, err := decoder.Decode(dataRetriever.MetaBlockUnit, []byte("hash"), headerBytes)
+		require.Nil(t, err)
+		assert.Equal(t, header, decoded)
+	})
+	t.Run("receipts", func(t *testing.T) {
+		t.Parallel()
+
+		miniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("tx")}, Type: block.ReceiptBlock}
+		miniBlockBytes, _ := marshaller.Marshal(miniBlock)
+		receiptsBytes, _ := marshaller.Marshal(&batch.Batch{Data: [][]byte{miniBlockBytes}})
+
+		decoded, err := decoder.Decode(dataRetriever.ReceiptsUnit, []byte("hash"), receiptsBytes)
+		require.Nil(t, err)
+		assert.Equal(t, []*block.MiniBlock{miniBlock}, decoded)
+
+		decoded, err = decoder.Decode(dataRetriever.ReceiptsUnit, []byte("hash"), make([]byte, 0))
+		require.Nil(t, err)
+		assert.Equal(t, make([]*block.MiniBlock, 0), decoded)
+	})
+	t.Run("bootstrap data", func(t *testing.T) {
+		t.Parallel()
+
+		roundNum := &bootstrapStorage.RoundNum{Num: 37}
+		roundNumBytes, _ := marshaller.Marshal(roundNum)
+		decoded, err := decoder.Decode(dataRetriever.BootstrapUnit, []byte(common.HighestRoundFromBootStorage), roundNumBytes)
+		require.Nil(t, err)
+		assert.Equal(t, roundNum, decoded)
+
+		bootstrapData := &bootstrapStorage.BootstrapData{LastRound: 36, HighestFinalBlockNonce: 30}
+		bootstrapDataBytes, _ := marshaller.Marshal(bootstrapData)
+		decoded, err = decoder.Decode(dataRetriever.BootstrapUnit, []byte("37"), bootstrapDataBytes)
+		require.Nil(t, err)
+		assert.Equal(t, bootstrapData, decoded)
+	})
+	t.Run("hashes should be hex encoded", func(t *testing.T) {
+		t.Parallel()
+
+		hash := []byte("header hash")
+		decoded, err := decoder.Decode(dataRetriever.ShardHdrNonceHashDataUnit+1, []byte("nonce"), hash)
+		require.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString(hash), decoded)
+
+		decoded, err = decoder.Decode(dataRetriever.MetaHdrNonceHashDataUnit, []byte("nonce"), hash)
+		require.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString(hash), decoded)
+	})
+	t.Run("invalid value should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := decoder.Decode(dataRetriever.MiniBlockUnit, []byte("hash"), []byte("invalid"))
+		assert.NotNil(t, err)
+		assert.Nil(t, decoded)
+	})
+	t.Run("unknown unit should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := decoder.Decode(dataRetriever.UserAccountsUnit, []byte("hash"), []byte("trie node"))
+		assert.Equal(t, errUnknownUnitValue, err)
+		assert.Nil(t, decoded)
+	})
+}
@@ -0,0 +1,657 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"runtime"
+	"syscall"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/cmd/dbtool/inspector"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/trie"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	configFile         string
+	path               string
+	unit               string
+	key                string
+	prefix             string
+	limit              int
+	rootHash           string
+	epoch              uint
+	shard              string
+	numDataTries       int
+	deadNodes          bool
+	keptRootHashes     cli.StringSlice
+	markSetSize        uint
+	maxRemovals        uint
+	dryRun             bool
+	removeCorrupted    bool
+	logLevel           string
+	logWithCorrelation bool
+	logWithLoggerName  bool
+}
+
+type entryOutput struct {
+	Key          string      `json:"key"`
+	Value        string      `json:"value"`
+	DecodedValue interface{} `json:"decodedValue,omitempty"`
+}
+
+type trieCheckOutput struct {
+	Valid             bool     `json:"valid"`
+	NumNodes          uint64   `json:"numNodes"`
+	NumLeaves         uint64   `json:"numLeaves"`
+	Size              string   `json:"size"`
+	MaxDepth          uint32   `json:"maxDepth"`
+	NumMissingNodes   uint64   `json:"numMissingNodes"`
+	NumCorruptedNodes uint64   `json:"numCorruptedNodes"`
+	MissingNodes      []string `json:"missingNodes"`
+	CorruptedNodes    []string `json:"corruptedNodes"`
+}
+
+type epochTrieNodesOutput struct {
+	Epoch           uint32 `json:"epoch"`
+	Path            string `json:"path"`
+	Size            string `json:"size"`
+	NumStoredNodes  uint64 `json:"numStoredNodes,omitempty"`
+	StoredNodesSize string `json:"storedNodesSize,omitempty"`
+	NumDeadNodes    uint64 `json:"numDeadNodes,omitempty"`
+	DeadNodesSize   string `json:"deadNodesSize,omitempty"`
+}
+
+type trieStatsOutput struct {
+	*common.TrieStorageReport
+	Epochs []*epochTrieNodesOutput `json:"epochs"`
+}
+
+type trieGCOutput struct {
+	DryRun            bool     `json:"dryRun"`
+	Epochs            []uint32 `json:"epochs"`
+	NumReachableNodes uint64   `json:"numReachableNodes"`
+	NumScannedNodes   uint64   `json:"numScannedNodes"`
+	NumRemovedNodes   uint64   `json:"numRemovedNodes"`
+	RemovedNodesSize  string   `json:"removedNodesSize"`
+	Duration          string   `json:"duration"`
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .VisibleCommands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// configFile defines a flag for the path to the node's main config file
+	configFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the node's main configuration file, used to tell which data is held by each storage unit",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// shardPath defines a flag for the directory holding the storage units of a shard
+	shardPath = cli.StringFlag{
+		Name:        "path",
+		Usage:       "The directory holding the storage units of a shard, e.g. db/<chainID>/Epoch_X/Shard_Y or db/<chainID>/Static/Shard_Y",
+		Destination: &argsConfig.path,
+	}
+	// chainPath defines a flag for the directory holding the epochs of a chain
+	chainPath = cli.StringFlag{
+		Name:        "path",
+		Usage:       "The directory holding the epochs of a chain, e.g. db/<chainID>",
+		Destination: &argsConfig.path,
+	}
+	// unit defines a flag for the storage unit directory, relative to the shard directory
+	unit = cli.StringFlag{
+		Name:        "unit",
+		Usage:       "The storage unit directory, relative to the shard directory, as printed by the units command. E.g. BlockHeaders",
+		Destination: &argsConfig.unit,
+	}
+	// key defines a flag for the hex encoded key to be fetched
+	key = cli.StringFlag{
+		Name:        "key",
+		Usage:       "The hex encoded key to be fetched",
+		Destination: &argsConfig.key,
+	}
+	// prefix defines a flag for filtering the iterated keys
+	prefix = cli.StringFlag{
+		Name:        "prefix",
+		Usage:       "The hex encoded prefix the iterated keys should start with. If empty, all keys are iterated",
+		Destination: &argsConfig.prefix,
+	}
+	// limit defines a flag for the maximum number of iterated entries
+	limit = cli.IntFlag{
+		Name:        "limit",
+		Usage:       "The maximum number of entries to be printed. 0 means no limit",
+		Value:       100,
+		Destination: &argsConfig.limit,
+	}
+	// rootHash defines a flag for the hex encoded root hash of the trie to be verified or analyzed
+	rootHash = cli.StringFlag{
+		Name:        "root-hash",
+		Usage:       "The hex encoded root hash of the trie to be verified or analyzed",
+		Destination: &argsConfig.rootHash,
+	}
+	// epoch defines a flag for the epoch to be removed
+	epoch = cli.UintFlag{
+		Name:        "epoch",
+		Usage:       "The epoch whose directory will be removed",
+		Destination: &argsConfig.epoch,
+	}
+	// shard defines a flag for the shard whose trie is analyzed
+	shard = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The shard whose trie is analyzed, e.g. 0 or metachain",
+		Value:       "0",
+		Destination: &argsConfig.shard,
+	}
+	// numDataTries defines a flag for the number of largest data tries to be reported
+	numDataTries = cli.IntFlag{
+		Name:        "num-data-tries",
+		Usage:       "The number of largest data tries to be reported",
+		Value:       10,
+		Destination: &argsConfig.numDataTries,
+	}
+	// deadNodes defines a flag for counting the nodes not reachable from the analyzed root hash
+	deadNodes = cli.BoolFlag{
+		Name: "dead-nodes",
+		Usage: "Boolean option for counting, in each epoch, the stored trie nodes that are not reachable from the " +
+			"analyzed root hash. All the reachable node hashes are kept in memory.",
+		Destination: &argsConfig.deadNodes,
+	}
+	// keptRootHashes defines a flag for the root hashes whose tries are kept by the garbage collection
+	keptRootHashes = cli.StringSliceFlag{
+		Name: "keep-root-hash",
+		Usage: "The hex encoded root hash of a trie to be kept by the garbage collection. Should be provided for " +
+			"the current state, the last snapshot and any historical state that should remain available. Can be repeated",
+		Value: &argsConfig.keptRootHashes,
+	}
+	// markSetSize defines a flag for the memory used to mark the reachable trie nodes
+	markSetSize = cli.UintFlag{
+		Name: "mark-set-size",
+		Usage: "The memory, in MB, used to mark the reachable trie nodes. About 10 bits are needed for each reachable " +
+			"node. A too small value keeps some unreachable nodes",
+		Value:       1024,
+		Destination: &argsConfig.markSetSize,
+	}
+	// maxRemovals defines a flag for throttling the removal of the unreachable trie nodes
+	maxRemovals = cli.UintFlag{
+		Name:        "max-removals-per-second",
+		Usage:       "The maximum number of trie nodes removed per second. 0 means no throttling",
+		Destination: &argsConfig.maxRemovals,
+	}
+	// dryRun defines a flag for only counting the unreachable trie nodes
+	dryRun = cli.BoolFlag{
+		Name:        "dry-run",
+		Usage:       "Boolean option for only counting the unreachable trie nodes, without removing them.",
+		Destination: &argsConfig.dryRun,
+	}
+	// removeCorrupted defines a flag for removing the storage units reported as corrupted
+	removeCorrupted = cli.BoolFlag{
+		Name:        "remove-corrupted",
+		Usage:       "Boolean option for removing the storage units reported as corrupted. The rest of the epoch is kept.",
+		Destination: &argsConfig.removeCorrupted,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+	//logWithCorrelation is used to enable log correlation elements
+	logWithCorrelation = cli.BoolFlag{
+		Name:        "log-correlation",
+		Usage:       "Boolean option for enabling log correlation elements.",
+		Destination: &argsConfig.logWithCorrelation,
+	}
+	//logWithLoggerName is used to enable log correlation elements
+	logWithLoggerName = cli.BoolFlag{
+		Name:        "log-logger-name",
+		Usage:       "Boolean option for logger name in the logs.",
+		Destination: &argsConfig.logWithLoggerName,
+	}
+	argsConfig = &cfg{}
+
+	errMissingPath     = errors.New("the path was not provided")
+	errMissingUnit     = errors.New("the storage unit was not provided")
+	errMissingKey      = errors.New("the key was not provided")
+	errMissingRootHash = errors.New("the root hash was not provided")
+
+	log    = logger.GetOrCreate("dbtool")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func listUnits(dbInspector inspector.DBInspector) error {
+	if len(argsConfig.path) == 0 {
+		return errMissingPath
+	}
+
+	units, err := dbInspector.ListUnits(argsConfig.path)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(units)
+}
+
+func get(dbInspector inspector.DBInspector) error {
+	err := checkUnitArgs()
+	if err != nil {
+		return err
+	}
+	if len(argsConfig.key) == 0 {
+		return errMissingKey
+	}
+
+	keyBytes, err := hex.DecodeString(argsConfig.key)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the key", err)
+	}
+
+	entry, err := dbInspector.Get(argsConfig.path, argsConfig.unit, keyBytes)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(createEntryOutput(entry))
+}
+
+func iterate(dbInspector inspector.DBInspector) error {
+	err := checkUnitArgs()
+	if err != nil {
+		return err
+	}
+
+	prefixBytes, err := hex.DecodeString(argsConfig.prefix)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the prefix", err)
+	}
+
+	entries := make([]*entryOutput, 0)
+	err = dbInspector.Iterate(argsConfig.path, argsConfig.unit, prefixBytes, argsConfig.limit, func(entry *inspector.Entry) bool {
+		entries = append(entries, createEntryOutput(entry))
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	return printJSON(entries)
+}
+
+func verifyTrie(dbInspector inspector.DBInspector) error {
+	err := checkUnitArgs()
+	if err != nil {
+		return err
+	}
+	if len(argsConfig.rootHash) == 0 {
+		return errMissingRootHash
+	}
+
+	rootHashBytes, err := hex.DecodeString(argsConfig.rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the root hash", err)
+	}
+
+	result, err := dbInspector.VerifyTrie(argsConfig.path, argsConfig.unit, rootHashBytes)
+	if err != nil {
+		return err
+	}
+
+	return printJSON(createTrieCheckOutput(result))
+}
+
+func trieStats(dbInspector inspector.DBInspector) error {
+	if len(argsConfig.path) == 0 {
+		return errMissingPath
+	}
+	if len(argsConfig.unit) == 0 {
+		return errMissingUnit
+	}
+	if len(argsConfig.rootHash) == 0 {
+		return errMissingRootHash
+	}
+
+	rootHashBytes, err := hex.DecodeString(argsConfig.rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the root hash", err)
+	}
+
+	analysis, err := dbInspector.AnalyzeTrie(inspector.ArgsTrieAnalysis{
+		ChainPath:           argsConfig.path,
+		ShardDir:            fmt.Sprintf("%s_%s", storage.DefaultShardString, argsConfig.shard),
+		UnitPath:            argsConfig.unit,
+		RootHash:            rootHashBytes,
+		NumLargestDataTries: argsConfig.numDataTries,
+		CountDeadNodes:      argsConfig.deadNodes,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printJSON(createTrieStatsOutput(rootHashBytes, analysis))
+}
+
+func trieGC(dbInspector inspector.DBInspector) error {
+	if len(argsConfig.path) == 0 {
+		return errMissingPath
+	}
+	if len(argsConfig.unit) == 0 {
+		return errMissingUnit
+	}
+	if len(argsConfig.keptRootHashes) == 0 {
+		return errMissingRootHash
+	}
+
+	rootHashes := make([][]byte, 0, len(argsConfig.keptRootHashes))
+	for _, encodedRootHash := range argsConfig.keptRootHashes {
+		rootHashBytes, err := hex.DecodeString(encodedRootHash)
+		if err != nil {
+			return fmt.Errorf("%w while decoding the root hash %s", err, encodedRootHash)
+		}
+
+		rootHashes = append(rootHashes, rootHashBytes)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	result, err := dbInspector.CollectTrieGarbage(ctx, inspector.ArgsTrieGarbageCollection{
+		ChainPath:            argsConfig.path,
+		ShardDir:             fmt.Sprintf("%s_%s", storage.DefaultShardString, argsConfig.shard),
+		UnitPath:             argsConfig.unit,
+		RootHashes:           rootHashes,
+		MarkSetSizeInMB:      uint32(argsConfig.markSetSize),
+		MaxRemovalsPerSecond: uint32(argsConfig.maxRemovals),
+		DryRun:               argsConfig.dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printJSON(&trieGCOutput{
+		DryRun:            argsConfig.dryRun,
+		Epochs:            result.Epochs,
+		NumReachableNodes: result.Report.NumReachableNodes,
+		NumScannedNodes:   result.Report.NumScannedNodes,
+		NumRemovedNodes:   result.Report.NumRemovedNodes,
+		RemovedNodesSize:  core.ConvertBytes(result.Report.RemovedNodesSize),
+		Duration:          result.Report.Duration.String(),
+	})
+}
+
+func checkEpochs(dbInspector inspector.DBInspector) error {
+	if len(argsConfig.path) == 0 {
+		return errMissingPath
+	}
+
+	reports, err := dbInspector.CheckEpochs(argsConfig.path)
+	if err != nil {
+		return err
+	}
+
+	err = printJSON(reports)
+	if err != nil {
+		return err
+	}
+	if !argsConfig.removeCorrupted {
+		return nil
+	}
+
+	for _, report := range reports {
+		if !report.IsCorrupted() {
+			continue
+		}
+
+		err = dbInspector.RemoveCorruptedUnits(report)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func removeEpoch(dbInspector inspector.DBInspector) error {
+	if len(argsConfig.path) == 0 {
+		return errMissingPath
+	}
+
+	return dbInspector.RemoveEpoch(argsConfig.path, uint32(argsConfig.epoch))
+}
+
+func checkUnitArgs() error {
+	if len(argsConfig.path) == 0 {
+		return errMissingPath
+	}
+	if len(argsConfig.unit) == 0 {
+		return errMissingUnit
+	}
+
+	return nil
+}
+
+func createEntryOutput(entry *inspector.Entry) *entryOutput {
+	return &entryOutput{
+		Key:          hex.EncodeToString(entry.Key),
+		Value:        hex.EncodeToString(entry.Value),
+		DecodedValue: entry.DecodedValue,
+	}
+}
+
+func createTrieCheckOutput(result *trie.IntegrityCheckResult) *trieCheckOutput {
+	return &trieCheckOutput{
+		Valid:             result.IsValid(),
+		NumNodes:          result.NumNodes,
+		NumLeaves:         result.NumLeaves,
+		Size:              core.ConvertBytes(result.NumBytes),
+		MaxDepth:          result.MaxDepth,
+		NumMissingNodes:   result.NumMissingNodes,
+		NumCorruptedNodes: result.NumCorruptedNodes,
+		MissingNodes:      encodeHashes(result.MissingNodes),
+		CorruptedNodes:    encodeHashes(result.CorruptedNodes),
+	}
+}
+
+func createTrieStatsOutput(rootHash []byte, analysis *inspector.TrieAnalysis) *trieStatsOutput {
+	epochs := make([]*epochTrieNodesOutput, 0, len(analysis.Epochs))
+	for _, epochNodes := range analysis.Epochs {
+		epochOutput := &epochTrieNodesOutput{
+			Epoch: epochNodes.Epoch,
+			Path:  epochNodes.Path,
+			Size:  core.ConvertBytes(epochNodes.Size),
+		}
+		if epochNodes.DeadNodes != nil {
+			epochOutput.NumStoredNodes = epochNodes.DeadNodes.NumStoredNodes
+			epochOutput.StoredNodesSize = core.ConvertBytes(epochNodes.DeadNodes.StoredNodesSize)
+			epochOutput.NumDeadNodes = epochNodes.DeadNodes.NumDeadNodes
+			epochOutput.DeadNodesSize = core.ConvertBytes(epochNodes.DeadNodes.DeadNodesSize)
+		}
+
+		epochs = append(epochs, epochOutput)
+	}
+
+	return &trieStatsOutput{
+		TrieStorageReport: analysis.Report.ToAPIReport(rootHash, hex.EncodeToString),
+		Epochs:            epochs,
+	}
+}
+
+func encodeHashes(hashes [][]byte) []string {
+	encodedHashes := make([]string, 0, len(hashes))
+	for _, hash := range hashes {
+		encodedHashes = append(encodedHashes, hex.EncodeToString(hash))
+	}
+
+	return encodedHashes
+}
+
+func printJSON(obj interface{}) error {
+	buff, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(buff))
+
+	return nil
+}
+
+func createDBInspector() (inspector.DBInspector, error) {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return nil, err
+	}
+	logger.ToggleCorrelation(argsConfig.logWithCorrelation)
+	logger.ToggleLoggerName(argsConfig.logWithLoggerName)
+
+	generalConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	unitsResolver, err := inspector.NewUnitsResolver(generalConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	valueDecoder, err := inspector.NewValueDecoder(marshaller)
+	if err != nil {
+		return nil, err
+	}
+
+	return inspector.NewDBInspector(inspector.ArgsDBInspector{
+		DefaultDBConfig: config.DBConfig{
+			Type:              string(storageunit.LvlDBSerial),
+			BatchDelaySeconds: 2,
+			MaxBatchSize:      100,
+			MaxOpenFiles:      10,
+		},
+		UnitsResolver: unitsResolver,
+		ValueDecoder:  valueDecoder,
+		Marshaller:    marshaller,
+		Hasher:        blake2b.NewBlake2b(),
+	})
+}
+
+func withDBInspector(handler func(dbInspector inspector.DBInspector) error) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		dbInspector, err := createDBInspector()
+		if err != nil {
+			return err
+		}
+
+		return handler(dbInspector)
+	}
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "MultiversX Database Tool App"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Offline tool used to inspect and repair the databases of a stopped node"
+	cliApp.Flags = []cli.Flag{
+		configFile,
+		logLevel,
+		logWithCorrelation,
+		logWithLoggerName,
+	}
+	cliApp.Commands = []cli.Command{
+		{
+			Name:   "units",
+			Usage:  "lists the storage units of a shard directory, with their data type, database type and size",
+			Flags:  []cli.Flag{shardPath},
+			Action: withDBInspector(listUnits),
+		},
+		{
+			Name:   "get",
+			Usage:  "prints the value stored under a key, decoded when the data type of the unit is known",
+			Flags:  []cli.Flag{shardPath, unit, key},
+			Action: withDBInspector(get),
+		},
+		{
+			Name:   "iterate",
+			Usage:  "prints the entries of a storage unit, decoded when the data type of the unit is known",
+			Flags:  []cli.Flag{shardPath, unit, prefix, limit},
+			Action: withDBInspector(iterate),
+		},
+		{
+			Name:   "verify-trie",
+			Usage:  "checks that all the nodes of a trie can be loaded and decoded, starting from a root hash",
+			Flags:  []cli.Flag{shardPath, unit, rootHash},
+			Action: withDBInspector(verifyTrie),
+		},
+		{
+			Name:   "trie-stats",
+			Usage:  "reports the depth distribution, the node types, the size and the largest data tries of a trie, searching its nodes in all the epochs",
+			Flags:  []cli.Flag{chainPath, shard, unit, rootHash, numDataTries, deadNodes},
+			Action: withDBInspector(trieStats),
+		},
+		{
+			Name:   "trie-gc",
+			Usage:  "removes, from all the epochs, the trie nodes which are not reachable from any of the kept root hashes",
+			Flags:  []cli.Flag{chainPath, shard, unit, keptRootHashes, markSetSize, maxRemovals, dryRun},
+			Action: withDBInspector(trieGC),
+		},
+		{
+			Name:   "check-epochs",
+			Usage:  "opens, in read only mode, all the storage units of all the epochs and reports the ones that can not be opened",
+			Flags:  []cli.Flag{chainPath, removeCorrupted},
+			Action: withDBInspector(checkEpochs),
+		},
+		{
+			Name:   "remove-epoch",
+			Usage:  "removes the directory of an epoch",
+			Flags:  []cli.Flag{chainPath, epoch},
+			Action: withDBInspector(removeEpoch),
+		},
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math"
 	"os"
@@ -331,6 +332,22 @@ var (
 		Name:  "import-db-save-epoch-root-hash",
 		Usage: "This flag, if set, will export the trie snapshots at every new epoch",
 	}
+	// importStateSnapshot defines a flag for the optional state snapshot archive imported before the start in epoch bootstrap
+	importStateSnapshot = cli.StringFlag{
+		Name: "import-state-snapshot",
+		Usage: "This flag, if set, will import the epoch start state from the provided archive `filepath`, created with " +
+			"the stateexporter tool, before starting the bootstrap process. The imported tries are verified against the " +
+			"epoch start meta block and then used by the start in epoch process instead of requesting them from the network",
+		Value: "",
+	}
+	// importStateSnapshotMetaHash defines a flag for the trusted epoch start meta block hash the imported state snapshot is verified against
+	importStateSnapshotMetaHash = cli.StringFlag{
+		Name: "import-state-snapshot-meta-hash",
+		Usage: "The hex encoded `hash` of the epoch start meta block of the imported state snapshot archive. It is " +
+			"mandatory when the import-state-snapshot flag is set and should be taken from a trusted source (e.g. the " +
+			"explorer or an own node), as the archive is only accepted if its epoch start meta block has this hash",
+		Value: "",
+	}
 	// redundancyLevel defines a flag that specifies the level of redundancy used by the current instance for the node (-1 = disabled, 0 = main instance (default), 1 = first backup, 2 = second backup, etc.)
 	redundancyLevel = cli.Int64Flag{
 		Name:  "redundancy-level",
@@ -392,7 +409,7 @@ var (
 	// operationMode defines the flag for specifying how configs should be altered depending on the node's intent
 	operationMode = cli.StringFlag{
 		Name:  "operation-mode",
-		Usage: "String flag for specifying the desired `operation mode`(s) of the node, resulting in altering some configuration values accordingly. Possible values are: snapshotless-observer, full-archive, db-lookup-extension, historical-balances or `\"\"` (empty). Multiple values can be separated via ,",
+		Usage: "String flag for specifying the desired `operation mode`(s) of the node, resulting in altering some configuration values accordingly. Possible values are: snapshotless-observer, full-archive, db-lookup-extension, historical-balances, historical-balances-diffs or `\"\"` (empty). Multiple values can be separated via ,",
 		Value: "",
 	}
 
@@ -455,6 +472,8 @@ func getFlags() []cli.Flag {
 		importDbDirectory,
 		importDbNoSigCheck,
 		importDbSaveEpochRootHash,
+		importStateSnapshot,
+		importStateSnapshotMetaHash,
 		redundancyLevel,
 		fullArchive,
 		memBallast,
@@ -499,6 +518,8 @@ func getFlagsConfig(ctx *cli.Context, log logger.Logger) *config.ContextFlagsCon
 	flagsConfig.OperationMode = ctx.GlobalString(operationMode.Name)
 	flagsConfig.RepopulateTokensSupplies = ctx.GlobalBool(repopulateTokensSupplies.Name)
 	flagsConfig.P2PPrometheusMetricsEnabled = ctx.GlobalBool(p2pPrometheusMetrics.Name)
+	flagsConfig.ImportStateSnapshotFile = ctx.GlobalString(importStateSnapshot.Name)
+	flagsConfig.ImportStateSnapshotMetaHash = ctx.GlobalString(importStateSnapshotMetaHash.Name)
 
 	if ctx.GlobalBool(noKey.Name) {
 		log.Warn("the provided -no-key option is deprecated and will soon be removed. To start a node without " +
@@ -612,6 +633,17 @@ func applyCompatibleConfigs(log logger.Logger, configs *config.Configs) error {
 		return fmt.Errorf("import-db-no-sig-check can only be used with the import-db flag")
 	}
 
+	if len(configs.FlagsConfig.ImportStateSnapshotFile) > 0 {
+		if isInImportDBMode {
+			return fmt.Errorf("import-state-snapshot can not be used with the import-db flag")
+		}
+		_, err := hex.DecodeString(configs.FlagsConfig.ImportStateSnapshotMetaHash)
+		if err != nil || len(configs.FlagsConfig.ImportStateSnapshotMetaHash) == 0 {
+			return fmt.Errorf("import-state-snapshot requires a valid hex encoded import-state-snapshot-meta-hash")
+		}
+		processConfigImportStateSnapshot(log, configs)
+	}
+
 	if configs.PreferencesConfig.BlockProcessingCutoff.Enabled {
 		log.Debug("node is started by using the block processing cut-off - will disable the watchdog")
 		configs.FlagsConfig.DisableConsensusWatchdog = true
@@ -637,6 +669,11 @@ func applyCompatibleConfigs(log logger.Logger, configs *config.Configs) error {
 		operationmodes.ProcessHistoricalBalancesMode(log, configs)
 	}
 
+	isInHistoricalBalancesDiffsMode := operationmodes.SliceContainsElement(operationModes, operationmodes.OperationModeHistoricalBalancesDiffs)
+	if isInHistoricalBalancesDiffsMode {
+		operationmodes.ProcessHistoricalBalancesDiffsMode(log, configs)
+	}
+
 	isInDbLookupExtensionMode := operationmodes.SliceContainsElement(operationModes, operationmodes.OperationModeDbLookupExtension)
 	if isInDbLookupExtensionMode {
 		processDbLookupExtensionMode(log, configs)
@@ -711,6 +748,20 @@ func processConfigImportDBMode(log logger.Logger, configs *config.Configs) error
 	return nil
 }
 
+func processConfigImportStateSnapshot(log logger.Logger, configs *config.Configs) {
+	generalConfigs := configs.GeneralConfig
+
+	// the imported tries are only used if the node bootstraps from the network and looks for the trie nodes on disk
+	generalConfigs.GeneralSettings.StartInEpochEnabled = true
+	generalConfigs.TrieSync.CheckNodesOnDisk = true
+
+	log.Warn("the node will import a state snapshot! Will auto-set some config values",
+		"archive", configs.FlagsConfig.ImportStateSnapshotFile,
+		"GeneralSettings.StartInEpochEnabled", generalConfigs.GeneralSettings.StartInEpochEnabled,
+		"TrieSync.CheckNodesOnDisk", generalConfigs.TrieSync.CheckNodesOnDisk,
+	)
+}
+
 func processConfigFullArchiveMode(log logger.Logger, configs *config.Configs) {
 	generalConfigs := configs.GeneralConfig
 
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/snapshotArchive"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	configFile         string
+	dbPath             string
+	shard              string
+	epoch              uint
+	numTrieEpochs      uint
+	output             string
+	maxChunkSize       uint
+	logLevel           string
+	logWithCorrelation bool
+	logWithLoggerName  bool
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// configFile defines a flag for the path to the node's main config file
+	configFile = cli.StringFlag{
+		Name:        "config",
+		Usage:       "The `filepath` for the node's main configuration file, used to locate and open the storage units",
+		Value:       "./config/config.toml",
+		Destination: &argsConfig.configFile,
+	}
+	// dbPath defines a flag for the directory holding the epochs of a chain
+	dbPath = cli.StringFlag{
+		Name:        "db-path",
+		Usage:       "The directory holding the epochs of a chain, e.g. db/<chainID>. The node using it should be stopped",
+		Destination: &argsConfig.dbPath,
+	}
+	// shard defines a flag for the shard whose state is exported
+	shard = cli.StringFlag{
+		Name:        "shard",
+		Usage:       "The shard whose state is exported: a shard number or metachain",
+		Destination: &argsConfig.shard,
+	}
+	// epoch defines a flag for the epoch whose start state is exported
+	epoch = cli.UintFlag{
+		Name:        "epoch",
+		Usage:       "The epoch whose start state is exported. The epoch start meta block should be finalized",
+		Destination: &argsConfig.epoch,
+	}
+	// numTrieEpochs defines a flag for the number of epochs in which the trie nodes are searched
+	numTrieEpochs = cli.UintFlag{
+		Name: "num-trie-epochs",
+		Usage: "The number of epochs, going back from the exported one, in which the trie nodes are searched. It should " +
+			"cover the epoch of the last full state snapshot when incremental snapshots are enabled",
+		Value:       10,
+		Destination: &argsConfig.numTrieEpochs,
+	}
+	// output defines a flag for the archive file to be written
+	output = cli.StringFlag{
+		Name:        "output",
+		Usage:       "The `filepath` of the archive to be written. It should not exist",
+		Destination: &argsConfig.output,
+	}
+	// maxChunkSize defines a flag for the maximum size of an archive chunk
+	maxChunkSize = cli.UintFlag{
+		Name:        "max-chunk-size",
+		Usage:       "The size in bytes after which the archive entries are written in a new checksummed chunk",
+		Value:       16 * 1024 * 1024,
+		Destination: &argsConfig.maxChunkSize,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+	//logWithCorrelation is used to enable log correlation elements
+	logWithCorrelation = cli.BoolFlag{
+		Name:        "log-correlation",
+		Usage:       "Boolean option for enabling log correlation elements.",
+		Destination: &argsConfig.logWithCorrelation,
+	}
+	//logWithLoggerName is used to enable log correlation elements
+	logWithLoggerName = cli.BoolFlag{
+		Name:        "log-logger-name",
+		Usage:       "Boolean option for logger name in the logs.",
+		Destination: &argsConfig.logWithLoggerName,
+	}
+	argsConfig = &cfg{}
+
+	errMissingDBPath       = errors.New("the db path was not provided")
+	errMissingShard        = errors.New("the shard was not provided")
+	errMissingOutput       = errors.New("the output file was not provided")
+	errOutputExists        = errors.New("the output file already exists")
+	errEpochDirNotFound    = errors.New("the epoch directory of the shard was not found")
+	errInvalidEpochsNumber = errors.New("the number of trie epochs should be at least 1")
+
+	log    = logger.GetOrCreate("stateexporter")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	cliApp.Action = func(c *cli.Context) error {
+		return export()
+	}
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func export() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+	logger.ToggleCorrelation(argsConfig.logWithCorrelation)
+	logger.ToggleLoggerName(argsConfig.logWithLoggerName)
+
+	shardID, err := checkArgs()
+	if err != nil {
+		return err
+	}
+
+	generalConfig, err := common.LoadMainConfig(argsConfig.configFile)
+	if err != nil {
+		return err
+	}
+
+	pathManager, err := factory.CreatePathManagerFromSinglePathString(argsConfig.dbPath)
+	if err != nil {
+		return err
+	}
+
+	exportedEpoch := uint32(argsConfig.epoch)
+	shardPath := filepath.Dir(pathManager.PathForEpoch(core.GetShardIDString(shardID), exportedEpoch, "unit"))
+	if _, err = os.Stat(shardPath); err != nil {
+		return fmt.Errorf("%w: %s", errEpochDirNotFound, shardPath)
+	}
+
+	storersCreator, err := snapshotArchive.NewStorersCreator(snapshotArchive.ArgsStorersCreator{
+		GeneralConfig: *generalConfig,
+		PathManager:   pathManager,
+		NumTrieEpochs: uint32(argsConfig.numTrieEpochs),
+	})
+	if err != nil {
+		return err
+	}
+
+	exporter, err := snapshotArchive.NewStateExporter(snapshotArchive.ArgsStateExporter{
+		Marshaller:     &marshal.GogoProtoMarshalizer{},
+		Hasher:         blake2b.NewBlake2b(),
+		StorersCreator: storersCreator,
+		MaxChunkSize:   uint32(argsConfig.maxChunkSize),
+	})
+	if err != nil {
+		return err
+	}
+
+	summary, err := writeArchive(exporter, shardID, exportedEpoch)
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(buff))
+
+	return nil
+}
+
+func checkArgs() (uint32, error) {
+	if len(argsConfig.dbPath) == 0 {
+		return 0, errMissingDBPath
+	}
+	if len(argsConfig.shard) == 0 {
+		return 0, errMissingShard
+	}
+	if len(argsConfig.output) == 0 {
+		return 0, errMissingOutput
+	}
+	if _, err := os.Stat(argsConfig.output); err == nil {
+		return 0, fmt.Errorf("%w: %s", errOutputExists, argsConfig.output)
+	}
+	if argsConfig.numTrieEpochs == 0 {
+		return 0, errInvalidEpochsNumber
+	}
+
+	return core.ConvertShardIDToUint32(argsConfig.shard)
+}
+
+// writeArchive writes the archive in a temporary file, renamed only after a successful export
+func writeArchive(exporter snapshotArchive.StateExporter, shardID uint32, exportedEpoch uint32) (*snapshotArchive.ArchiveSummary, error) {
+	tmpOutput := argsConfig.output + ".tmp"
+	file, err := os.Create(tmpOutput)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(tmpOutput)
+	}()
+
+	writer := bufio.NewWriter(file)
+	summary, err := exporter.Export(shardID, exportedEpoch, writer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writer.Flush()
+	if err != nil {
+		return nil, err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Rename(tmpOutput, argsConfig.output)
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "MultiversX State Exporter App"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Offline tool used to export the epoch start state of a shard into an archive, which can be " +
+		"imported by a new node through the --import-state-snapshot option. The importing node should check the printed " +
+		"epoch start meta block hash against a trusted source before passing it through the --import-state-snapshot-meta-hash option"
+	cliApp.Flags = []cli.Flag{
+		configFile,
+		dbPath,
+		shard,
+		epoch,
+		numTrieEpochs,
+		output,
+		maxChunkSize,
+		logLevel,
+		logWithCorrelation,
+		logWithLoggerName,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"runtime"
+	"time"
+
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const replayEndpointFormat = "%s://%s/transaction/replay/%s"
+
+type config struct {
+	address            string
+	txHash             string
+	outputFile         string
+	logLevel           string
+	timeoutInSeconds   int
+	useHttps           bool
+	failOnMismatch     bool
+	logWithCorrelation bool
+	logWithLoggerName  bool
+}
+
+type replayResponse struct {
+	Data struct {
+		Result json.RawMessage `json:"result"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// address defines a flag for setting the address and port of the node's REST API
+	address = cli.StringFlag{
+		Name:        "address",
+		Usage:       "Address and port number on which the application will try to connect to the mx-chain-go node's REST API",
+		Value:       "127.0.0.1:8080",
+		Destination: &argsConfig.address,
+	}
+	// txHash defines a flag for setting the hash of the transaction to be replayed
+	txHash = cli.StringFlag{
+		Name:        "tx-hash",
+		Usage:       "The hex encoded hash of the already executed transaction to be replayed",
+		Destination: &argsConfig.txHash,
+	}
+	// outputFile defines a flag for setting the file where the replay results will be written
+	outputFile = cli.StringFlag{
+		Name:        "output-file",
+		Usage:       "The file where the replay results will be written in JSON format. If not set, the results are written on the standard output",
+		Destination: &argsConfig.outputFile,
+	}
+	// timeoutInSeconds defines a flag for setting the request timeout
+	timeoutInSeconds = cli.IntFlag{
+		Name:        "timeout",
+		Usage:       "The duration in seconds after which the replay request is abandoned",
+		Value:       120,
+		Destination: &argsConfig.timeoutInSeconds,
+	}
+	// useHttps is used when the node's REST API is exposed through https
+	useHttps = cli.BoolFlag{
+		Name:        "use-https",
+		Usage:       "Will use https instead of http when calling the node's REST API",
+		Destination: &argsConfig.useHttps,
+	}
+	// failOnMismatch makes the application exit with error if the replayed results differ from the stored ones
+	failOnMismatch = cli.BoolFlag{
+		Name:        "fail-on-mismatch",
+		Usage:       "Boolean option for exiting with error if the replayed results differ from the stored ones.",
+		Destination: &argsConfig.failOnMismatch,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value:       "*:" + logger.LogInfo.String(),
+		Destination: &argsConfig.logLevel,
+	}
+	//logWithCorrelation is used to enable log correlation elements
+	logWithCorrelation = cli.BoolFlag{
+		Name:        "log-correlation",
+		Usage:       "Boolean option for enabling log correlation elements.",
+		Destination: &argsConfig.logWithCorrelation,
+	}
+	//logWithLoggerName is used to enable log correlation elements
+	logWithLoggerName = cli.BoolFlag{
+		Name:        "log-logger-name",
+		Usage:       "Boolean option for logger name in the logs.",
+		Destination: &argsConfig.logWithLoggerName,
+	}
+	argsConfig = &config{}
+
+	errMissingTxHash     = errors.New("the transaction hash was not provided")
+	errResultsMismatch   = errors.New("the replayed results differ from the stored ones")
+	errEmptyReplayResult = errors.New("the node returned an empty replay result")
+
+	log    = logger.GetOrCreate("txreplay")
+	cliApp *cli.App
+)
+
+func main() {
+	initCliFlags()
+
+	cliApp.Action = func(c *cli.Context) error {
+		return replayTransaction()
+	}
+
+	err := cliApp.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func replayTransaction() error {
+	err := logger.SetLogLevel(argsConfig.logLevel)
+	if err != nil {
+		return err
+	}
+	logger.ToggleCorrelation(argsConfig.logWithCorrelation)
+	logger.ToggleLoggerName(argsConfig.logWithLoggerName)
+
+	if len(argsConfig.txHash) == 0 {
+		return errMissingTxHash
+	}
+
+	resultBytes, err := requestReplay()
+	if err != nil {
+		return err
+	}
+
+	results := &txSimData.ReplayResults{}
+	err = json.Unmarshal(resultBytes, results)
+	if err != nil {
+		return err
+	}
+
+	err = writeResults(resultBytes)
+	if err != nil {
+		return err
+	}
+
+	matches := results.Diff != nil && results.Diff.Matches
+	log.Info("transaction replayed",
+		"hash", argsConfig.txHash,
+		"block nonce", results.BlockNonce,
+		"block hash", results.BlockHash,
+		"replayed preceding transactions", results.NumReplayedTransactions,
+		"matches stored results", matches,
+	)
+
+	if argsConfig.failOnMismatch && !matches {
+		return errResultsMismatch
+	}
+
+	return nil
+}
+
+func requestReplay() ([]byte, error) {
+	scheme := "http"
+	if argsConfig.useHttps {
+		scheme = "https"
+	}
+
+	client := http.Client{
+		Timeout: time.Duration(argsConfig.timeoutInSeconds) * time.Second,
+	}
+	resp, err := client.Get(fmt.Sprintf(replayEndpointFormat, scheme, argsConfig.address, argsConfig.txHash))
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		errClose := resp.Body.Close()
+		if errClose != nil {
+			log.Error("close response body", "error", errClose.Error())
+		}
+	}()
+
+	responseBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &replayResponse{}
+	err = json.Unmarshal(responseBytes, response)
+	if err != nil {
+		return nil, fmt.Errorf("%w, status: %s", err, resp.Status)
+	}
+	if len(response.Error) > 0 {
+		return nil, fmt.Errorf("%s, code: %s", response.Error, response.Code)
+	}
+	if len(response.Data.Result) == 0 {
+		return nil, errEmptyReplayResult
+	}
+
+	return response.Data.Result, nil
+}
+
+func writeResults(resultBytes []byte) error {
+	var indentedResult interface{}
+	err := json.Unmarshal(resultBytes, &indentedResult)
+	if err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(indentedResult, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if len(argsConfig.outputFile) == 0 {
+		fmt.Println(string(output))
+		return nil
+	}
+
+	return os.WriteFile(argsConfig.outputFile, output, 0644)
+}
+
+func initCliFlags() {
+	cliApp = cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	cliApp.Name = "MultiversX Transaction Replay App"
+	cliApp.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	cliApp.Usage = "Transaction replay application used to re-execute an already executed transaction against the historical state of a node"
+	cliApp.Flags = []cli.Flag{
+		address,
+		txHash,
+		outputFile,
+		timeoutInSeconds,
+		useHttps,
+		failOnMismatch,
+		logLevel,
+		logWithCorrelation,
+		logWithLoggerName,
+	}
+	cliApp.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+}
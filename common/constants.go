@@ -5,8 +5,13 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 )
 
+// TxStatusReplaced signals that the transaction was dropped from the pool, being replaced by another transaction of the
+// same sender and nonce, paying a higher gas price
+const TxStatusReplaced transaction.TxStatus = "replaced"
+
 // NodeOperation defines the p2p node operation
 type NodeOperation string
 
@@ -895,6 +900,12 @@ const MetricTrieSyncNumReceivedBytes = "erd_trie_sync_num_bytes_received"
 // MetricTrieSyncNumProcessedNodes is the metric that outputs the number of trie nodes processed for accounts during trie sync
 const MetricTrieSyncNumProcessedNodes = "erd_trie_sync_num_nodes_processed"
 
+// MetricTrieSyncProgressPercent is the metric that outputs the estimated progress of the main accounts trie sync, as percent
+const MetricTrieSyncProgressPercent = "erd_trie_sync_progress_percent"
+
+// MetricTrieSyncEstimatedTimeLeft is the metric that outputs the estimated number of seconds left until the main accounts trie sync completes
+const MetricTrieSyncEstimatedTimeLeft = "erd_trie_sync_estimated_time_left_sec"
+
 // FullArchiveMetricSuffix is the suffix added to metrics specific for full archive network
 const FullArchiveMetricSuffix = "_full_archive"
 
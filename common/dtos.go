@@ -1,6 +1,7 @@
 package common
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 )
 
@@ -11,6 +12,61 @@ type GetProofResponse struct {
 	RootHash string
 }
 
+// GetMultiProofResponse is a struct that stores the response of a GetMultiProof API request
+type GetMultiProofResponse struct {
+	Proof    [][]byte
+	Values   [][]byte
+	RootHash string
+}
+
+// TrieRangeProof holds the Merkle proof for the trie leaves that have the keys between two bounds. If the range was
+// truncated, LastKey is the key of the last leaf in the proof and the proof is valid only up to that key
+type TrieRangeProof struct {
+	Proof    [][]byte
+	Keys     [][]byte
+	Values   [][]byte
+	LastKey  []byte
+	Complete bool
+}
+
+// GetRangeProofResponse is a struct that stores the response of a GetRangeProof API request
+type GetRangeProofResponse struct {
+	*TrieRangeProof
+	RootHash string
+}
+
+// TrieNodesStatistics holds the number and the size of the nodes of one or more tries, grouped by node type
+type TrieNodesStatistics struct {
+	NumNodes           uint64 `json:"numNodes"`
+	NumBranchNodes     uint64 `json:"numBranchNodes"`
+	NumExtensionNodes  uint64 `json:"numExtensionNodes"`
+	NumLeafNodes       uint64 `json:"numLeafNodes"`
+	TotalSize          uint64 `json:"totalSize"`
+	BranchNodesSize    uint64 `json:"branchNodesSize"`
+	ExtensionNodesSize uint64 `json:"extensionNodesSize"`
+	LeafNodesSize      uint64 `json:"leafNodesSize"`
+	MaxDepth           uint32 `json:"maxDepth"`
+	// NumNodesByDepth holds on position i the number of nodes found at depth i
+	NumNodesByDepth []uint64 `json:"numNodesByDepth"`
+}
+
+// DataTrieStorageUsage holds the statistics of the data trie of an account
+type DataTrieStorageUsage struct {
+	Address  string `json:"address"`
+	RootHash string `json:"rootHash"`
+	*TrieNodesStatistics
+}
+
+// TrieStorageReport is a struct that stores the response of a trie statistics API request
+type TrieStorageReport struct {
+	RootHash         string                  `json:"rootHash"`
+	MainTrie         *TrieNodesStatistics    `json:"mainTrie"`
+	DataTries        *TrieNodesStatistics    `json:"dataTries"`
+	NumDataTries     uint64                  `json:"numDataTries"`
+	LargestDataTries []*DataTrieStorageUsage `json:"largestDataTries"`
+	NumMissingNodes  uint64                  `json:"numMissingNodes"`
+}
+
 // TransactionsPoolAPIResponse is a struct that holds the data to be returned when getting the transaction pool from an API call
 type TransactionsPoolAPIResponse struct {
 	RegularTransactions  []Transaction `json:"regularTransactions"`
@@ -42,6 +98,104 @@ type TransactionsPoolNonceGapsForSenderApiResponse struct {
 	Gaps   []NonceGapApiResponse `json:"gaps"`
 }
 
+// TransactionStatusApiResponse is a struct that holds the data to be returned when getting the status of a transaction from an API call
+type TransactionStatusApiResponse struct {
+	Status     string `json:"status"`
+	ReplacedBy string `json:"replacedBy,omitempty"`
+}
+
+// TransactionsPoolSenderScoreApiResponse is a struct that holds the data to be returned when getting the score of a sender from transactions pool from an API call.
+// The score is an approximation of the one kept by the transactions cache
+type TransactionsPoolSenderScoreApiResponse struct {
+	Sender          string `json:"sender"`
+	Score           uint32 `json:"score"`
+	Rank            int    `json:"rank"`
+	NumSenders      int    `json:"numSenders"`
+	NumTxs          int    `json:"numTxs"`
+	IsApproximation bool   `json:"isApproximation"`
+}
+
+// TransactionsPoolSelectionPreviewItem is a struct that holds a transaction of the transactions pool, as seen by a selection preview
+type TransactionsPoolSelectionPreviewItem struct {
+	Hash       string `json:"hash"`
+	Sender     string `json:"sender"`
+	Nonce      uint64 `json:"nonce"`
+	GasLimit   uint64 `json:"gasLimit"`
+	GasPrice   uint64 `json:"gasPrice"`
+	SkipReason string `json:"skipReason,omitempty"`
+}
+
+// TransactionsPoolSelectionPreviewApiResponse is a struct that holds the data to be returned when previewing the selection of transactions for the next block from an API call.
+// The preview is an approximation of the selection run by the transactions cache
+type TransactionsPoolSelectionPreviewApiResponse struct {
+	NumSelected     int                                    `json:"numSelected"`
+	GasSelected     uint64                                 `json:"gasSelected"`
+	Selected        []TransactionsPoolSelectionPreviewItem `json:"selected"`
+	Skipped         []TransactionsPoolSelectionPreviewItem `json:"skipped"`
+	IsApproximation bool                                   `json:"isApproximation"`
+}
+
+// TransactionsQueueEnqueueRequest is a struct that holds the fields of a transaction to be sent through the transactions queue. The nonce
+// is assigned by the queue, while a missing gas price defaults to the minimum one
+type TransactionsQueueEnqueueRequest struct {
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Value    string `json:"value"`
+	Data     []byte `json:"data,omitempty"`
+	GasPrice uint64 `json:"gasPrice,omitempty"`
+	GasLimit uint64 `json:"gasLimit"`
+}
+
+// TransactionsQueueEnqueueApiResponse is a struct that holds the data to be returned when adding a transaction in the transactions queue from an API call
+type TransactionsQueueEnqueueApiResponse struct {
+	TxHash string `json:"txHash"`
+	Nonce  uint64 `json:"nonce"`
+}
+
+// TransactionsQueueSenderState is a struct that holds the nonces tracked by the transactions queue for a registered sender
+type TransactionsQueueSenderState struct {
+	Address     string `json:"address"`
+	NextNonce   uint64 `json:"nextNonce"`
+	NumPending  int    `json:"numPending"`
+	NumExecuted int    `json:"numExecuted"`
+	NumFailed   int    `json:"numFailed"`
+}
+
+// TransactionsQueueTransactionState is a struct that holds a transaction tracked by the transactions queue
+type TransactionsQueueTransactionState struct {
+	Hash           string   `json:"hash"`
+	Sender         string   `json:"sender"`
+	Nonce          uint64   `json:"nonce"`
+	GasPrice       uint64   `json:"gasPrice"`
+	Status         string   `json:"status"`
+	Reason         string   `json:"reason,omitempty"`
+	Resubmissions  uint32   `json:"resubmissions"`
+	PreviousHashes []string `json:"previousHashes,omitempty"`
+}
+
+// TransactionsQueueStateApiResponse is a struct that holds the data to be returned when getting the state of the transactions queue from an API call
+type TransactionsQueueStateApiResponse struct {
+	Senders      []TransactionsQueueSenderState      `json:"senders"`
+	Transactions []TransactionsQueueTransactionState `json:"transactions"`
+}
+
+// TransactionCheckDiagnostic is a struct that holds a rule violated by a transaction checked from an API call
+type TransactionCheckDiagnostic struct {
+	Rule       string `json:"rule"`
+	Severity   string `json:"severity"`
+	Field      string `json:"field"`
+	Expected   string `json:"expected,omitempty"`
+	Actual     string `json:"actual,omitempty"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// TransactionCheckApiResponse is a struct that holds the data to be returned when checking a transaction from an API call
+type TransactionCheckApiResponse struct {
+	Valid       bool                         `json:"valid"`
+	Diagnostics []TransactionCheckDiagnostic `json:"diagnostics"`
+}
+
 // DelegationDataAPI will be used when requesting the genesis balances from API
 type DelegationDataAPI struct {
 	Address string `json:"address"`
@@ -91,3 +245,42 @@ type AuctionListValidatorAPIResponse struct {
 	QualifiedTopUp string         `json:"qualifiedTopUp"`
 	Nodes          []*AuctionNode `json:"nodes"`
 }
+
+// EventsQueryOptions holds the filters of an events query received from the API
+type EventsQueryOptions struct {
+	Address    string
+	Identifier string
+	Topics     [][]byte
+	FromNonce  uint64
+	ToNonce    core.OptionalUint64
+	After      *EventsQueryCursor
+	Limit      uint32
+}
+
+// EventsQueryCursor points to an event returned by an events query, by the nonce of its block and the position of its
+// entry among the ones indexed in that block for the queried filter
+type EventsQueryCursor struct {
+	BlockNonce uint64
+	EntryIndex uint32
+}
+
+// EventsQueryAPIResponse holds a page of events returned by the events query API. The cursor of the next page is nil
+// when the queried nonces range was entirely walked
+type EventsQueryAPIResponse struct {
+	Events     []*IndexedEventAPIResponse
+	NextCursor *EventsQueryCursor
+}
+
+// IndexedEventAPIResponse is a struct that holds an event returned by the events query API
+type IndexedEventAPIResponse struct {
+	TxHash         string   `json:"txHash"`
+	LogAddress     string   `json:"logAddress"`
+	BlockNonce     uint64   `json:"blockNonce"`
+	Epoch          uint32   `json:"epoch"`
+	EventIndex     uint32   `json:"eventIndex"`
+	Address        string   `json:"address"`
+	Identifier     string   `json:"identifier"`
+	Topics         [][]byte `json:"topics"`
+	Data           []byte   `json:"data"`
+	AdditionalData [][]byte `json:"additionalData"`
+}
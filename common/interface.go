@@ -54,6 +54,12 @@ type Trie interface {
 	GetAllHashes() ([][]byte, error)
 	GetProof(key []byte) ([][]byte, []byte, error)
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error)
+	VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error)
+	GetRangeProof(startKey []byte, endKey []byte, maxLeaves int) (*TrieRangeProof, error)
+	VerifyRangeProof(rootHash []byte, startKey []byte, endKey []byte, proof [][]byte) (bool, []core.KeyValueHolder, error)
+	GetAbsenceProof(key []byte) ([][]byte, error)
+	VerifyAbsenceProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
 	GetStorageManager() StorageManager
 	IsMigratedToLatestVersion() (bool, error)
 	Close() error
@@ -108,6 +114,10 @@ type StorageManager interface {
 	RemoveFromAllActiveEpochs(hash []byte) error
 	SetEpochForPutOperation(uint32)
 	ShouldTakeSnapshot() bool
+	PrepareSnapshot(epoch uint32) bool
+	FinalizeSnapshot(isSuccessful bool)
+	PrepareSnapshotsCompaction(epoch uint32) bool
+	FinalizeSnapshotsCompaction(isSuccessful bool) error
 	IsSnapshotSupported() bool
 	GetBaseTrieStorageManager() StorageManager
 	IsClosed() bool
@@ -163,6 +173,9 @@ type Locker interface {
 // MerkleProofVerifier is used to verify merkle proofs
 type MerkleProofVerifier interface {
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error)
+	VerifyRangeProof(rootHash []byte, startKey []byte, endKey []byte, proof [][]byte) (bool, []core.KeyValueHolder, error)
+	VerifyAbsenceProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
 }
 
 // SizeSyncStatisticsHandler extends the SyncStatisticsHandler interface by allowing setting up the trie node size
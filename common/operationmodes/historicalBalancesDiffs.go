@@ -0,0 +1,35 @@
+package operationmodes
+
+import (
+	"github.com/multiversx/mx-chain-go/config"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ProcessHistoricalBalancesDiffsMode will process the provided flags for the historical balances with diffs mode.
+// Instead of keeping all the old trie roots, the state pruning remains active and only the epoch start states are
+// retained on disk. The accounts altered by each block are recorded, so a past state can be rebuilt from the retained
+// epoch start state plus the diffs of the following blocks. The diffs are stored by the db lookup extensions, which
+// are enabled here, so this mode can also be combined with the db-lookup-extension one
+func ProcessHistoricalBalancesDiffsMode(log logger.Logger, configs *config.Configs) {
+	configs.GeneralConfig.StoragePruning.Enabled = true
+	configs.GeneralConfig.StoragePruning.ValidatorCleanOldEpochsData = false
+	configs.GeneralConfig.StoragePruning.ObserverCleanOldEpochsData = false
+	configs.GeneralConfig.GeneralSettings.StartInEpochEnabled = false
+	configs.GeneralConfig.StoragePruning.AccountsTrieCleanOldEpochsData = false
+	configs.GeneralConfig.StateTriesConfig.AccountsStatePruningEnabled = true
+	configs.GeneralConfig.DbLookupExtensions.Enabled = true
+	configs.GeneralConfig.DbLookupExtensions.AccountsDiffs.Enabled = true
+	configs.PreferencesConfig.Preferences.FullArchive = true
+
+	log.Warn("the node is in historical balances with diffs mode! Will auto-set some config values",
+		"StoragePruning.Enabled", configs.GeneralConfig.StoragePruning.Enabled,
+		"StoragePruning.ValidatorCleanOldEpochsData", configs.GeneralConfig.StoragePruning.ValidatorCleanOldEpochsData,
+		"StoragePruning.ObserverCleanOldEpochsData", configs.GeneralConfig.StoragePruning.ObserverCleanOldEpochsData,
+		"StoragePruning.AccountsTrieCleanOldEpochsData", configs.GeneralConfig.StoragePruning.AccountsTrieCleanOldEpochsData,
+		"GeneralSettings.StartInEpochEnabled", configs.GeneralConfig.GeneralSettings.StartInEpochEnabled,
+		"StateTriesConfig.AccountsStatePruningEnabled", configs.GeneralConfig.StateTriesConfig.AccountsStatePruningEnabled,
+		"DbLookupExtensions.Enabled", configs.GeneralConfig.DbLookupExtensions.Enabled,
+		"DbLookupExtensions.AccountsDiffs.Enabled", configs.GeneralConfig.DbLookupExtensions.AccountsDiffs.Enabled,
+		"Preferences.FullArchive", configs.PreferencesConfig.Preferences.FullArchive,
+	)
+}
@@ -0,0 +1,30 @@
+package operationmodes
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessHistoricalBalancesDiffsMode(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Configs{
+		GeneralConfig:     &config.Config{},
+		PreferencesConfig: &config.Preferences{},
+	}
+	ProcessHistoricalBalancesDiffsMode(&testscommon.LoggerStub{}, cfg)
+
+	assert.True(t, cfg.GeneralConfig.StoragePruning.Enabled)
+	assert.False(t, cfg.GeneralConfig.StoragePruning.ValidatorCleanOldEpochsData)
+	assert.False(t, cfg.GeneralConfig.StoragePruning.ObserverCleanOldEpochsData)
+	assert.False(t, cfg.GeneralConfig.GeneralSettings.StartInEpochEnabled)
+	assert.False(t, cfg.GeneralConfig.StoragePruning.AccountsTrieCleanOldEpochsData)
+	assert.True(t, cfg.GeneralConfig.StateTriesConfig.AccountsStatePruningEnabled)
+	assert.True(t, cfg.GeneralConfig.DbLookupExtensions.Enabled)
+	assert.True(t, cfg.GeneralConfig.DbLookupExtensions.AccountsDiffs.Enabled)
+	assert.True(t, cfg.PreferencesConfig.Preferences.FullArchive)
+	assert.False(t, IsInHistoricalBalancesMode(cfg))
+}
@@ -7,10 +7,11 @@ import (
 
 // constants that define the operation mode of the node
 const (
-	OperationModeFullArchive          = "full-archive"
-	OperationModeDbLookupExtension    = "db-lookup-extension"
-	OperationModeHistoricalBalances   = "historical-balances"
-	OperationModeSnapshotlessObserver = "snapshotless-observer"
+	OperationModeFullArchive             = "full-archive"
+	OperationModeDbLookupExtension       = "db-lookup-extension"
+	OperationModeHistoricalBalances      = "historical-balances"
+	OperationModeHistoricalBalancesDiffs = "historical-balances-diffs"
+	OperationModeSnapshotlessObserver    = "snapshotless-observer"
 )
 
 // ParseOperationModes will check and parse the operation modes
@@ -45,12 +46,24 @@ func ParseOperationModes(operationModeList string) ([]string, error) {
 		return []string{}, fmt.Errorf("operation-mode flag cannot contain both snapshotless-observer and full-archive")
 	}
 
+	// historical balances and historical balances with diffs
+	isInvalid = sliceContainsBothElements(modes, OperationModeHistoricalBalances, OperationModeHistoricalBalancesDiffs)
+	if isInvalid {
+		return []string{}, fmt.Errorf("operation-mode flag cannot contain both historical-balances and historical-balances-diffs")
+	}
+
+	// snapshotless observer and historical balances with diffs, as the diffs are applied on the epoch start snapshots
+	isInvalid = sliceContainsBothElements(modes, OperationModeSnapshotlessObserver, OperationModeHistoricalBalancesDiffs)
+	if isInvalid {
+		return []string{}, fmt.Errorf("operation-mode flag cannot contain both snapshotless-observer and historical-balances-diffs")
+	}
+
 	return modes, nil
 }
 
 func checkOperationModeValidity(mode string) error {
 	switch mode {
-	case OperationModeFullArchive, OperationModeDbLookupExtension, OperationModeHistoricalBalances, OperationModeSnapshotlessObserver:
+	case OperationModeFullArchive, OperationModeDbLookupExtension, OperationModeHistoricalBalances, OperationModeHistoricalBalancesDiffs, OperationModeSnapshotlessObserver:
 		return nil
 	default:
 		return fmt.Errorf("invalid operation mode <%s>", mode)
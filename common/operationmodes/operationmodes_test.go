@@ -36,6 +36,14 @@ func TestCheckOperationModes(t *testing.T) {
 		res, err = ParseOperationModes(fmt.Sprintf("%s,%s", OperationModeSnapshotlessObserver, OperationModeFullArchive))
 		require.Empty(t, res)
 		require.Equal(t, "operation-mode flag cannot contain both snapshotless-observer and full-archive", err.Error())
+
+		res, err = ParseOperationModes(fmt.Sprintf("%s,%s", OperationModeHistoricalBalances, OperationModeHistoricalBalancesDiffs))
+		require.Empty(t, res)
+		require.Equal(t, "operation-mode flag cannot contain both historical-balances and historical-balances-diffs", err.Error())
+
+		res, err = ParseOperationModes(fmt.Sprintf("%s,%s", OperationModeSnapshotlessObserver, OperationModeHistoricalBalancesDiffs))
+		require.Empty(t, res)
+		require.Equal(t, "operation-mode flag cannot contain both snapshotless-observer and historical-balances-diffs", err.Error())
 	})
 
 	t.Run("ok config", func(t *testing.T) {
@@ -49,6 +57,14 @@ func TestCheckOperationModes(t *testing.T) {
 		require.Equal(t, []string{OperationModeDbLookupExtension, OperationModeFullArchive}, res)
 		require.NoError(t, err)
 
+		res, err = ParseOperationModes(fmt.Sprintf("%s,%s", OperationModeHistoricalBalancesDiffs, OperationModeFullArchive))
+		require.Equal(t, []string{OperationModeHistoricalBalancesDiffs, OperationModeFullArchive}, res)
+		require.NoError(t, err)
+
+		res, err = ParseOperationModes(fmt.Sprintf("%s,%s", OperationModeDbLookupExtension, OperationModeHistoricalBalancesDiffs))
+		require.Equal(t, []string{OperationModeDbLookupExtension, OperationModeHistoricalBalancesDiffs}, res)
+		require.NoError(t, err)
+
 		res, err = ParseOperationModes("")
 		require.Empty(t, res)
 		require.NoError(t, err)
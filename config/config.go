@@ -13,6 +13,20 @@ type CacheConfig struct {
 	Shards               uint32
 }
 
+// TxPoolReplacementConfig will map the configuration of the transactions replacement (replace-by-fee) policy
+type TxPoolReplacementConfig struct {
+	Enabled                   bool
+	MinGasPriceBumpPercentage uint32
+	ReplacedTxsCacheCapacity  int
+}
+
+// TxPoolJournalConfig will map the configuration of the persistent journal of the transactions pool
+type TxPoolJournalConfig struct {
+	Enabled                     bool
+	DirectoryName               string
+	CompactionIntervalInSeconds uint32
+}
+
 // HeadersPoolConfig will map the headers cache configuration
 type HeadersPoolConfig struct {
 	MaxHeadersPerShard            int
@@ -166,11 +180,14 @@ type Config struct {
 	EvictionWaitingList      EvictionWaitingListConfig
 	StateTriesConfig         StateTriesConfig
 	TrieStorageManagerConfig TrieStorageManagerConfig
+	TrieGarbageCollection    TrieGarbageCollectionConfig
 	BadBlocksCache           CacheConfig
 
 	TxBlockBodyDataPool         CacheConfig
 	PeerBlockBodyDataPool       CacheConfig
 	TxDataPool                  CacheConfig
+	TxPoolReplacement           TxPoolReplacementConfig
+	TxPoolJournal               TxPoolJournalConfig
 	UnsignedTransactionDataPool CacheConfig
 	RewardTransactionDataPool   CacheConfig
 	TrieNodesChunksDataPool     CacheConfig
@@ -206,6 +223,9 @@ type Config struct {
 	LogsAndEvents        LogsAndEventsConfig
 	HardwareRequirements HardwareRequirementsConfig
 
+	WebSocketSubscriptions WebSocketSubscriptionsConfig
+	TxsQueue               TxsQueueConfig
+
 	NTPConfig               NTPConfig
 	HeadersPoolConfig       HeadersPoolConfig
 	BlockSizeThrottleConfig BlockSizeThrottleConfig
@@ -306,19 +326,29 @@ type FacadeConfig struct {
 
 // StateTriesConfig will hold information about state tries
 type StateTriesConfig struct {
-	SnapshotsEnabled            bool
-	AccountsStatePruningEnabled bool
-	PeerStatePruningEnabled     bool
-	MaxStateTrieLevelInMemory   uint
-	MaxPeerTrieLevelInMemory    uint
-	StateStatisticsEnabled      bool
+	SnapshotsEnabled                    bool
+	AccountsIncrementalSnapshotsEnabled bool
+	AccountsStatePruningEnabled         bool
+	PeerStatePruningEnabled             bool
+	MaxStateTrieLevelInMemory           uint
+	MaxPeerTrieLevelInMemory            uint
+	StateStatisticsEnabled              bool
 }
 
 // TrieStorageManagerConfig will hold config information about trie storage manager
 type TrieStorageManagerConfig struct {
-	PruningBufferLen      uint32
-	SnapshotsBufferLen    uint32
-	SnapshotsGoroutineNum uint32
+	PruningBufferLen                        uint32
+	SnapshotsBufferLen                      uint32
+	SnapshotsGoroutineNum                   uint32
+	MaxTrackedHashesForIncrementalSnapshots uint32
+}
+
+// TrieGarbageCollectionConfig will hold the configuration of the background garbage collection of the accounts trie nodes
+type TrieGarbageCollectionConfig struct {
+	Enabled              bool
+	MarkSetSizeInMB      uint32
+	MaxRemovalsPerSecond uint32
+	HistoricalRootHashes []string
 }
 
 // EndpointsThrottlersConfig holds a pair of an endpoint and its maximum number of simultaneous go routines
@@ -467,6 +497,28 @@ type LogsAndEventsConfig struct {
 	TxLogsStorage        StorageConfig
 }
 
+// WebSocketSubscriptionsConfig holds the configuration for the web socket subscriptions served by the REST API
+type WebSocketSubscriptionsConfig struct {
+	Enabled                       bool
+	MaxConnections                uint32
+	MaxSubscriptionsPerConnection uint32
+	MaxFilterValues               uint32
+	SendBufferSize                uint32
+	WriteTimeoutInSec             uint32
+}
+
+// TxsQueueConfig holds the configuration for the transactions queue, which sends transactions on behalf of the wallet
+// keys registered on the node
+type TxsQueueConfig struct {
+	Enabled                       bool
+	WalletKeysFile                string
+	MonitorIntervalInMilliseconds uint32
+	ResubmitAfterInSeconds        uint32
+	MaxResubmissions              uint32
+	GasPriceBumpPercentage        uint32
+	MaxFinishedTransactions       uint32
+}
+
 // DbLookupExtensionsConfig holds the configuration for the db lookup extensions
 type DbLookupExtensionsConfig struct {
 	Enabled                            bool
@@ -477,6 +529,25 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	EventsIndex                        EventsIndexConfig
+	AccountsDiffs                      AccountsDiffsConfig
+}
+
+// AccountsDiffsConfig will hold the configuration of the per-block accounts diffs, used for answering the historical
+// account queries without keeping all the old trie roots
+type AccountsDiffsConfig struct {
+	Enabled       bool
+	StorageConfig StorageConfig
+}
+
+// EventsIndexConfig will hold the configuration of the events index, used for querying events by address, identifier and topics
+type EventsIndexConfig struct {
+	Enabled                   bool
+	MaxNoncesRangeInQuery     uint64
+	MaxResultsInQuery         uint32
+	ByAddressStorageConfig    StorageConfig
+	ByIdentifierStorageConfig StorageConfig
+	ByTopicStorageConfig      StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -616,10 +687,13 @@ type ConfigurationPathsHolder struct {
 
 // TrieSyncConfig represents the trie synchronization configuration area
 type TrieSyncConfig struct {
-	NumConcurrentTrieSyncers  int
-	MaxHardCapForMissingNodes int
-	TrieSyncerVersion         int
-	CheckNodesOnDisk          bool
+	NumConcurrentTrieSyncers    int
+	MaxHardCapForMissingNodes   int
+	TrieSyncerVersion           int
+	CheckNodesOnDisk            bool
+	EnableSyncResumption        bool
+	CheckpointIntervalInSeconds uint32
+	CheckpointDB                DBConfig
 }
 
 // RequesterConfig represents the config options to be used when setting up the requester instances
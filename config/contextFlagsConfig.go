@@ -28,6 +28,8 @@ type ContextFlagsConfig struct {
 	OperationMode                string
 	RepopulateTokensSupplies     bool
 	P2PPrometheusMetricsEnabled  bool
+	ImportStateSnapshotFile      string
+	ImportStateSnapshotMetaHash  string
 }
 
 // ImportDbConfig will hold the import-db parameters
@@ -122,6 +122,9 @@ var ErrCacheConfigInvalidEconomics = errors.New("cache-economics parameter is no
 // ErrCacheConfigInvalidSharding signals that a sharding parameter required by the cache is invalid
 var ErrCacheConfigInvalidSharding = errors.New("cache-sharding parameter is not valid")
 
+// ErrCacheConfigInvalidTxReplacement signals that a parameter of the transactions replacement policy is invalid
+var ErrCacheConfigInvalidTxReplacement = errors.New("cache-replacement parameter is not valid")
+
 // ErrNilTrieNodesPool signals that a nil trie nodes data pool was provided
 var ErrNilTrieNodesPool = errors.New("nil trie nodes data pool")
 
@@ -61,10 +61,11 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 	mainConfig := args.Config
 
 	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
-		Config:         factory.GetCacherFromConfig(mainConfig.TxDataPool),
-		NumberOfShards: args.ShardCoordinator.NumberOfShards(),
-		SelfShardID:    args.ShardCoordinator.SelfId(),
-		TxGasHandler:   args.EconomicsData,
+		Config:            factory.GetCacherFromConfig(mainConfig.TxDataPool),
+		ReplacementConfig: mainConfig.TxPoolReplacement,
+		NumberOfShards:    args.ShardCoordinator.NumberOfShards(),
+		SelfShardID:       args.ShardCoordinator.SelfId(),
+		TxGasHandler:      args.EconomicsData,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("%w while creating the cache for the transactions", err)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
@@ -12,10 +13,11 @@ import (
 
 // ArgShardedTxPool is the argument for ShardedTxPool's constructor
 type ArgShardedTxPool struct {
-	Config         storageunit.CacheConfig
-	TxGasHandler   txcache.TxGasHandler
-	NumberOfShards uint32
-	SelfShardID    uint32
+	Config            storageunit.CacheConfig
+	ReplacementConfig config.TxPoolReplacementConfig
+	TxGasHandler      txcache.TxGasHandler
+	NumberOfShards    uint32
+	SelfShardID       uint32
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -46,6 +48,14 @@ func (args *ArgShardedTxPool) verify() error {
 	if args.NumberOfShards == 0 {
 		return fmt.Errorf("%w: NumberOfShards is not valid", dataRetriever.ErrCacheConfigInvalidSharding)
 	}
+	if args.ReplacementConfig.Enabled {
+		if args.ReplacementConfig.MinGasPriceBumpPercentage == 0 {
+			return fmt.Errorf("%w: ReplacementConfig.MinGasPriceBumpPercentage is not valid", dataRetriever.ErrCacheConfigInvalidTxReplacement)
+		}
+		if args.ReplacementConfig.ReplacedTxsCacheCapacity <= 0 {
+			return fmt.Errorf("%w: ReplacementConfig.ReplacedTxsCacheCapacity is not valid", dataRetriever.ErrCacheConfigInvalidTxReplacement)
+		}
+	}
 
 	return nil
 }
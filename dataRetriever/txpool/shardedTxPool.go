@@ -1,15 +1,19 @@
 package txpool
 
 import (
+	"bytes"
+	"math/big"
 	"strconv"
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/counting"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/cache"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
@@ -28,6 +32,9 @@ type shardedTxPool struct {
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
 	txGasHandler                 txcache.TxGasHandler
+	replacementConfig            config.TxPoolReplacementConfig
+	mutReplacement               sync.Mutex
+	replacedTxs                  storage.Cacher
 }
 
 type txPoolShard struct {
@@ -78,6 +85,14 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		configPrototypeSourceMe:      configPrototypeSourceMe,
 		selfShardID:                  args.SelfShardID,
 		txGasHandler:                 args.TxGasHandler,
+		replacementConfig:            args.ReplacementConfig,
+	}
+
+	if args.ReplacementConfig.Enabled {
+		shardedTxPoolObject.replacedTxs, err = cache.NewLRUCache(args.ReplacementConfig.ReplacedTxsCacheCapacity)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return shardedTxPoolObject, nil
@@ -160,40 +175,151 @@ func (txPool *shardedTxPool) ImmunizeSetOfDataAgainstEviction(keys [][]byte, cac
 	shard.Cache.ImmunizeTxsAgainstEviction(keys)
 }
 
-// AddData adds the transaction to the cache
+// AddData adds the transaction to the cache. The replace-by-fee policy is not applied, as this is the path of the
+// transactions requested for processing a block and of the ones restored in pool, which must never be dropped
 func (txPool *shardedTxPool) AddData(key []byte, value interface{}, sizeInBytes int, cacheID string) {
-	valueAsTransaction, ok := value.(data.TransactionHandler)
+	wrapper, ok := createWrappedTransaction(key, value, sizeInBytes, cacheID)
+	if !ok {
+		return
+	}
+
+	txPool.addTx(wrapper, cacheID, false)
+}
+
+// AddDataWithReplacement adds the transaction to the cache, applying the replace-by-fee policy. It should only be
+// called for the transactions received through gossip or from the API, never for the requested ones
+func (txPool *shardedTxPool) AddDataWithReplacement(key []byte, value interface{}, sizeInBytes int, cacheID string) {
+	wrapper, ok := createWrappedTransaction(key, value, sizeInBytes, cacheID)
 	if !ok {
 		return
 	}
 
+	txPool.addTx(wrapper, cacheID, true)
+}
+
+func createWrappedTransaction(key []byte, value interface{}, sizeInBytes int, cacheID string) (*txcache.WrappedTransaction, bool) {
+	valueAsTransaction, ok := value.(data.TransactionHandler)
+	if !ok {
+		return nil, false
+	}
+
 	sourceShardID, destinationShardID, err := process.ParseShardCacherIdentifier(cacheID)
 	if err != nil {
 		log.Error("shardedTxPool.AddData()", "err", err)
-		return
+		return nil, false
 	}
 
-	wrapper := &txcache.WrappedTransaction{
+	return &txcache.WrappedTransaction{
 		Tx:              valueAsTransaction,
 		TxHash:          key,
 		SenderShardID:   sourceShardID,
 		ReceiverShardID: destinationShardID,
 		Size:            int64(sizeInBytes),
-	}
-
-	txPool.addTx(wrapper, cacheID)
+	}, true
 }
 
 // addTx adds the transaction to the cache
-func (txPool *shardedTxPool) addTx(tx *txcache.WrappedTransaction, cacheID string) {
+func (txPool *shardedTxPool) addTx(tx *txcache.WrappedTransaction, cacheID string, withReplacement bool) {
 	shard := txPool.getOrCreateShard(cacheID)
 	cache := shard.Cache
-	_, added := cache.AddTx(tx)
+
+	var added bool
+	if withReplacement && txPool.isReplacementPolicyApplicable(shard.CacheID) {
+		added = txPool.addTxWithReplacement(tx, cache)
+	} else {
+		_, added = cache.AddTx(tx)
+	}
+
 	if added {
 		txPool.onAdded(tx.TxHash, tx)
 	}
 }
 
+// the replace-by-fee policy only concerns the transactions of our own senders: the ones having another source
+// shard were already accepted by their own shard and are only waiting to be executed here
+func (txPool *shardedTxPool) isReplacementPolicyApplicable(cacheID string) bool {
+	return txPool.replacementConfig.Enabled && process.IsShardCacherIdentifierForSourceMe(cacheID, txPool.selfShardID)
+}
+
+// addTxWithReplacement adds the transaction to the cache only if it does not compete with pending transactions of the
+// same sender and nonce, or if it pays a gas price high enough to replace them. The replaced transactions are removed
+// from the cache and remembered, so that their status can be reported on the API
+func (txPool *shardedTxPool) addTxWithReplacement(tx *txcache.WrappedTransaction, cache txCache) bool {
+	txPool.mutReplacement.Lock()
+	defer txPool.mutReplacement.Unlock()
+
+	replaceableTxs := getPendingTxsWithSameNonce(tx, cache)
+	for _, pendingTx := range replaceableTxs {
+		if !txPool.isGasPriceHighEnoughForReplacement(tx, pendingTx) {
+			log.Trace("shardedTxPool.addTxWithReplacement(): gas price too low for replacement",
+				"tx", tx.TxHash, "gas price", tx.Tx.GetGasPrice(),
+				"pending tx", pendingTx.TxHash, "pending gas price", pendingTx.Tx.GetGasPrice())
+			return false
+		}
+	}
+
+	_, added := cache.AddTx(tx)
+	if !added {
+		return false
+	}
+
+	for _, pendingTx := range replaceableTxs {
+		_ = cache.RemoveTxByHash(pendingTx.TxHash)
+		_ = txPool.replacedTxs.Put(pendingTx.TxHash, tx.TxHash, len(tx.TxHash))
+
+		log.Debug("shardedTxPool: transaction replaced",
+			"replaced tx", pendingTx.TxHash, "replacing tx", tx.TxHash, "nonce", tx.Tx.GetNonce())
+	}
+
+	return true
+}
+
+func getPendingTxsWithSameNonce(tx *txcache.WrappedTransaction, cache txCache) []*txcache.WrappedTransaction {
+	pendingTxs := make([]*txcache.WrappedTransaction, 0)
+	senderTxs := cache.GetTransactionsPoolForSender(string(tx.Tx.GetSndAddr()))
+	for _, senderTx := range senderTxs {
+		if senderTx.Tx.GetNonce() != tx.Tx.GetNonce() {
+			continue
+		}
+		if bytes.Equal(senderTx.TxHash, tx.TxHash) {
+			continue
+		}
+
+		pendingTxs = append(pendingTxs, senderTx)
+	}
+
+	return pendingTxs
+}
+
+func (txPool *shardedTxPool) isGasPriceHighEnoughForReplacement(tx *txcache.WrappedTransaction, pendingTx *txcache.WrappedTransaction) bool {
+	pendingGasPrice := big.NewInt(0).SetUint64(pendingTx.Tx.GetGasPrice())
+	gasPrice := big.NewInt(0).SetUint64(tx.Tx.GetGasPrice())
+	if gasPrice.Cmp(pendingGasPrice) <= 0 {
+		return false
+	}
+
+	// gasPrice * 100 >= pendingGasPrice * (100 + bump)
+	bumpedPendingGasPrice := big.NewInt(0).Mul(pendingGasPrice, big.NewInt(int64(100+uint64(txPool.replacementConfig.MinGasPriceBumpPercentage))))
+	scaledGasPrice := big.NewInt(0).Mul(gasPrice, big.NewInt(100))
+
+	return scaledGasPrice.Cmp(bumpedPendingGasPrice) >= 0
+}
+
+// GetReplacingTxHash returns the hash of the transaction which replaced the provided one in the pool, if any
+func (txPool *shardedTxPool) GetReplacingTxHash(txHash []byte) ([]byte, bool) {
+	if !txPool.replacementConfig.Enabled {
+		return nil, false
+	}
+
+	value, ok := txPool.replacedTxs.Get(txHash)
+	if !ok {
+		return nil, false
+	}
+
+	replacingTxHash, ok := value.([]byte)
+	return replacingTxHash, ok
+}
+
 func (txPool *shardedTxPool) onAdded(key []byte, value interface{}) {
 	txPool.mutexAddCallbacks.RLock()
 	defer txPool.mutexAddCallbacks.RUnlock()
@@ -279,7 +405,7 @@ func (txPool *shardedTxPool) MergeShardStores(sourceCacheID, destCacheID string)
 	sourceCache := sourceShard.Cache
 
 	sourceCache.ForEachTransaction(func(txHash []byte, tx *txcache.WrappedTransaction) {
-		txPool.addTx(tx, destCacheID)
+		txPool.addTx(tx, destCacheID, false)
 	})
 
 	txPool.mutexBackingMap.Lock()
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/testscommon/txcachemocks"
@@ -100,6 +101,18 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 	require.Nil(t, pool)
 	require.NotNil(t, err)
 	require.Errorf(t, err, dataRetriever.ErrCacheConfigInvalidSharding.Error())
+
+	args = goodArgs
+	args.ReplacementConfig = config.TxPoolReplacementConfig{Enabled: true, ReplacedTxsCacheCapacity: 10}
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.ErrorIs(t, err, dataRetriever.ErrCacheConfigInvalidTxReplacement)
+
+	args = goodArgs
+	args.ReplacementConfig = config.TxPoolReplacementConfig{Enabled: true, MinGasPriceBumpPercentage: 10}
+	pool, err = NewShardedTxPool(args)
+	require.Nil(t, pool)
+	require.ErrorIs(t, err, dataRetriever.ErrCacheConfigInvalidTxReplacement)
 }
 
 func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
@@ -214,6 +227,91 @@ func Test_AddData_CallsOnAddedHandlers(t *testing.T) {
 	require.Equal(t, uint32(1), atomic.LoadUint32(&numAdded))
 }
 
+func Test_AddDataWithReplacement(t *testing.T) {
+	pool := newTxPoolWithReplacementToTest(t)
+	cache := pool.getTxCache("0")
+
+	numAdded := uint32(0)
+	pool.RegisterOnAdded(func(key []byte, value interface{}) {
+		atomic.AddUint32(&numAdded, 1)
+	})
+
+	pool.AddDataWithReplacement([]byte("hash-x"), createTxWithGasPrice("alice", 42, 1000000000), 0, "0")
+	pool.AddDataWithReplacement([]byte("hash-y"), createTxWithGasPrice("alice", 43, 1000000000), 0, "0")
+
+	// gas price not bumped enough (10% required)
+	pool.AddDataWithReplacement([]byte("hash-x-low"), createTxWithGasPrice("alice", 42, 1099999999), 0, "0")
+	require.Equal(t, 2, cache.Len())
+	_, ok := cache.GetByTxHash([]byte("hash-x-low"))
+	require.False(t, ok)
+	_, ok = pool.GetReplacingTxHash([]byte("hash-x"))
+	require.False(t, ok)
+
+	pool.AddDataWithReplacement([]byte("hash-x-bumped"), createTxWithGasPrice("alice", 42, 1100000000), 0, "0")
+	require.Equal(t, 2, cache.Len())
+	_, ok = cache.GetByTxHash([]byte("hash-x"))
+	require.False(t, ok)
+	_, ok = cache.GetByTxHash([]byte("hash-x-bumped"))
+	require.True(t, ok)
+	replacingTxHash, ok := pool.GetReplacingTxHash([]byte("hash-x"))
+	require.True(t, ok)
+	require.Equal(t, []byte("hash-x-bumped"), replacingTxHash)
+
+	// the replaced transaction cannot come back
+	pool.AddDataWithReplacement([]byte("hash-x"), createTxWithGasPrice("alice", 42, 1000000000), 0, "0")
+	_, ok = cache.GetByTxHash([]byte("hash-x"))
+	require.False(t, ok)
+
+	// cross-shard transactions are not subject to replacement
+	pool.AddDataWithReplacement([]byte("hash-cross"), createTxWithGasPrice("bob", 7, 1000000000), 0, "1_0")
+	pool.AddDataWithReplacement([]byte("hash-cross-same-price"), createTxWithGasPrice("bob", 7, 1000000000), 0, "1_0")
+	require.Equal(t, 2, pool.getTxCache("1_0").Len())
+
+	waitABit()
+	require.Equal(t, uint32(5), atomic.LoadUint32(&numAdded))
+}
+
+func Test_AddDataWithReplacement_ReplacementDisabled(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	cache := pool.getTxCache("0")
+
+	pool.AddDataWithReplacement([]byte("hash-x"), createTxWithGasPrice("alice", 42, 1000000000), 0, "0")
+	pool.AddDataWithReplacement([]byte("hash-x-same-nonce"), createTxWithGasPrice("alice", 42, 1000000000), 0, "0")
+	require.Equal(t, 2, cache.Len())
+
+	_, ok := pool.GetReplacingTxHash([]byte("hash-x"))
+	require.False(t, ok)
+}
+
+func Test_AddData_RequestedReplacedTxShouldBeAdded(t *testing.T) {
+	pool := newTxPoolWithReplacementToTest(t)
+	cache := pool.getTxCache("0")
+
+	pool.AddDataWithReplacement([]byte("hash-x"), createTxWithGasPrice("alice", 42, 1000000000), 0, "0")
+	pool.AddDataWithReplacement([]byte("hash-x-bumped"), createTxWithGasPrice("alice", 42, 1100000000), 0, "0")
+	_, ok := cache.GetByTxHash([]byte("hash-x"))
+	require.False(t, ok)
+
+	addedTxs := make(chan string, 2)
+	pool.RegisterOnAdded(func(key []byte, value interface{}) {
+		addedTxs <- string(key)
+	})
+
+	// the replaced transaction was included in a block, so it is requested for processing that block
+	pool.AddData([]byte("hash-x"), createTxWithGasPrice("alice", 42, 1000000000), 0, "0")
+	_, ok = cache.GetByTxHash([]byte("hash-x"))
+	require.True(t, ok)
+	require.Equal(t, "hash-x", <-addedTxs)
+
+	// a requested transaction is never dropped for paying less than a pending one with the same nonce
+	pool.AddData([]byte("hash-x-low"), createTxWithGasPrice("alice", 42, 1050000000), 0, "0")
+	_, ok = cache.GetByTxHash([]byte("hash-x-low"))
+	require.True(t, ok)
+	require.Equal(t, "hash-x-low", <-addedTxs)
+	require.Equal(t, 3, cache.Len())
+}
+
 func Test_SearchFirstData(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -419,6 +517,14 @@ func createTx(sender string, nonce uint64) data.TransactionHandler {
 	}
 }
 
+func createTxWithGasPrice(sender string, nonce uint64, gasPrice uint64) data.TransactionHandler {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+	}
+}
+
 func waitABit() {
 	time.Sleep(10 * time.Millisecond)
 }
@@ -446,3 +552,31 @@ func newTxPoolToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
 	}
 	return NewShardedTxPool(args)
 }
+
+func newTxPoolWithReplacementToTest(t *testing.T) *shardedTxPool {
+	args := ArgShardedTxPool{
+		Config: storageunit.CacheConfig{
+			Capacity:             100,
+			SizePerSender:        10,
+			SizeInBytes:          409600,
+			SizeInBytesPerSender: 40960,
+			Shards:               1,
+		},
+		ReplacementConfig: config.TxPoolReplacementConfig{
+			Enabled:                   true,
+			MinGasPriceBumpPercentage: 10,
+			ReplacedTxsCacheCapacity:  100,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       50000,
+			MinimumGasPrice:      200000000000,
+			GasProcessingDivisor: 100,
+		},
+		NumberOfShards: 4,
+		SelfShardID:    0,
+	}
+	pool, err := NewShardedTxPool(args)
+	require.Nil(t, err)
+
+	return pool
+}
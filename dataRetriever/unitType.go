@@ -49,6 +49,14 @@ const (
 	PeerAccountsUnit UnitType = 21
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 22
+	// EventsByAddressUnit is the events index by address storage unit identifier
+	EventsByAddressUnit UnitType = 23
+	// EventsByIdentifierUnit is the events index by identifier storage unit identifier
+	EventsByIdentifierUnit UnitType = 24
+	// EventsByTopicUnit is the events index by topic storage unit identifier
+	EventsByTopicUnit UnitType = 25
+	// AccountsDiffsUnit is the per-block accounts diffs storage unit identifier
+	AccountsDiffsUnit UnitType = 26
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -110,6 +118,14 @@ func (ut UnitType) String() string {
 		return "PeerAccountsUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case EventsByAddressUnit:
+		return "EventsByAddressUnit"
+	case EventsByIdentifierUnit:
+		return "EventsByIdentifierUnit"
+	case EventsByTopicUnit:
+		return "EventsByTopicUnit"
+	case AccountsDiffsUnit:
+		return "AccountsDiffsUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
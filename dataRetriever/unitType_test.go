@@ -55,6 +55,14 @@ func TestUnitType_String(t *testing.T) {
 	require.Equal(t, "PeerAccountsUnit", ut.String())
 	ut = ScheduledSCRsUnit
 	require.Equal(t, "ScheduledSCRsUnit", ut.String())
+	ut = EventsByAddressUnit
+	require.Equal(t, "EventsByAddressUnit", ut.String())
+	ut = EventsByIdentifierUnit
+	require.Equal(t, "EventsByIdentifierUnit", ut.String())
+	ut = EventsByTopicUnit
+	require.Equal(t, "EventsByTopicUnit", ut.String())
+	ut = AccountsDiffsUnit
+	require.Equal(t, "AccountsDiffsUnit", ut.String())
 
 	ut = 200
 	require.Equal(t, "ShardHdrNonceHashDataUnit100", ut.String())
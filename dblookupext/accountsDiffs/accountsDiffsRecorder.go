@@ -0,0 +1,493 @@
+package accountsDiffs
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("dblookupext/accountsDiffs")
+
+// ArgsAccountsDiffsRecorder holds the arguments needed to create an accounts diffs recorder
+type ArgsAccountsDiffsRecorder struct {
+	Storer           storage.Storer
+	Marshaller       marshal.Marshalizer
+	AddressConverter core.PubkeyConverter
+}
+
+type blockContainerHandler interface {
+	Get(headerType core.HeaderType) (block.EmptyBlockCreator, error)
+}
+
+// accountsDiffsRecorder is an outport driver which stores, for each block, the state of the accounts altered by it.
+// It also records the root of the first block of each epoch, as the epoch start states are kept on disk even if the
+// state pruning is enabled. The state of an account at a past block is then rebuilt by walking back the diffs up to
+// the retained root of the block's epoch, following the per address index of the blocks which altered the account
+type accountsDiffsRecorder struct {
+	storer           storage.Storer
+	marshaller       marshal.Marshalizer
+	addressConverter core.PubkeyConverter
+	blockContainer   blockContainerHandler
+
+	mutex          sync.RWMutex
+	unusableRanges map[uint32]uint64
+	lastEpoch      uint32
+	lastNonce      uint64
+	hasLastBlock   bool
+}
+
+// NewAccountsDiffsRecorder creates a new accounts diffs recorder
+func NewAccountsDiffsRecorder(args ArgsAccountsDiffsRecorder) (*accountsDiffsRecorder, error) {
+	if check.IfNil(args.Storer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, core.ErrNilPubkeyConverter
+	}
+
+	blockContainer, err := createBlockCreatorsContainer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountsDiffsRecorder{
+		storer:           args.Storer,
+		marshaller:       args.Marshaller,
+		addressConverter: args.AddressConverter,
+		blockContainer:   blockContainer,
+		unusableRanges:   make(map[uint32]uint64),
+	}, nil
+}
+
+func createBlockCreatorsContainer() (blockContainerHandler, error) {
+	container := block.NewEmptyBlockCreatorsContainer()
+	err := container.Add(core.ShardHeaderV1, block.NewEmptyHeaderCreator())
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.ShardHeaderV2, block.NewEmptyHeaderV2Creator())
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.MetaHeader, block.NewEmptyMetaBlockCreator())
+	if err != nil {
+		return nil, err
+	}
+
+	return container, nil
+}
+
+// SaveBlock stores the accounts altered by the provided block. The errors are not returned, as the outport would
+// otherwise retry indefinitely, but the diffs of the block's epoch are marked as unusable starting with the block, so
+// that the queries spanning over it fail instead of returning a wrong state
+func (adr *accountsDiffsRecorder) SaveBlock(outportBlock *outportcore.OutportBlock) error {
+	if outportBlock == nil || outportBlock.BlockData == nil {
+		return nil
+	}
+
+	adr.mutex.Lock()
+	defer adr.mutex.Unlock()
+
+	header, err := adr.getHeader(outportBlock.BlockData)
+	if err != nil {
+		log.Error("accountsDiffsRecorder.SaveBlock: cannot decode header", "hash", outportBlock.BlockData.HeaderHash, "error", err)
+		if adr.hasLastBlock {
+			adr.markRangeUnusable(adr.lastEpoch, adr.lastNonce+1)
+		}
+		return nil
+	}
+
+	err = adr.saveBlock(header, outportBlock.AlteredAccounts)
+	if err != nil {
+		log.Error("accountsDiffsRecorder.SaveBlock", "nonce", header.GetNonce(), "hash", outportBlock.BlockData.HeaderHash, "error", err)
+		adr.markRangeUnusable(header.GetEpoch(), header.GetNonce())
+	}
+
+	adr.lastEpoch = header.GetEpoch()
+	adr.lastNonce = header.GetNonce()
+	adr.hasLastBlock = true
+
+	return nil
+}
+
+func (adr *accountsDiffsRecorder) saveBlock(header data.HeaderHandler, alteredAccounts map[string]*alteredAccount.AlteredAccount) error {
+	accountsDiff := &outportcore.Accounts{
+		ShardID:         header.GetShardID(),
+		BlockTimestamp:  header.GetTimeStamp(),
+		AlteredAccounts: make(map[string]*alteredAccount.AlteredAccount, len(alteredAccounts)),
+	}
+	for encodedAddress, account := range alteredAccounts {
+		if account == nil {
+			continue
+		}
+
+		// the address is already the key of the map, and the tokens are not needed for the account queries
+		accountsDiff.AlteredAccounts[encodedAddress] = &alteredAccount.AlteredAccount{
+			Nonce:          account.Nonce,
+			Balance:        account.Balance,
+			AdditionalData: account.AdditionalData,
+		}
+	}
+
+	buff, err := adr.marshaller.Marshal(accountsDiff)
+	if err != nil {
+		return err
+	}
+
+	err = adr.storer.Put(accountsDiffKey(header.GetNonce()), buff)
+	if err != nil {
+		return err
+	}
+
+	for encodedAddress := range accountsDiff.AlteredAccounts {
+		err = adr.indexModification(encodedAddress, header.GetNonce())
+		if err != nil {
+			return err
+		}
+	}
+
+	if !header.IsStartOfEpochBlock() {
+		return nil
+	}
+
+	retainedRoot := &RetainedRoot{
+		BlockNonce: header.GetNonce(),
+		RootHash:   header.GetRootHash(),
+	}
+	log.Debug("accountsDiffsRecorder: recorded the retained root of epoch",
+		"epoch", header.GetEpoch(), "nonce", retainedRoot.BlockNonce, "root hash", retainedRoot.RootHash)
+
+	return adr.storer.Put(retainedRootKey(header.GetEpoch()), encodeRetainedRoot(retainedRoot))
+}
+
+// indexModification links the block to the previous one which altered the account and makes it the most recent one
+func (adr *accountsDiffsRecorder) indexModification(encodedAddress string, blockNonce uint64) error {
+	previous := make([]byte, 0)
+	lastModifiedNonce, found, err := adr.getLastModifiedNonce(encodedAddress)
+	if err != nil {
+		return err
+	}
+	if found {
+		if lastModifiedNonce == blockNonce {
+			// the block is saved again, so it is already indexed
+			return nil
+		}
+		if lastModifiedNonce > blockNonce {
+			return fmt.Errorf("%w, address %s, last altered at block %d, saved block %d",
+				errUnorderedBlock, encodedAddress, lastModifiedNonce, blockNonce)
+		}
+
+		previous = encodeNonce(lastModifiedNonce)
+	}
+
+	err = adr.storer.Put(modificationKey(encodedAddress, blockNonce), previous)
+	if err != nil {
+		return err
+	}
+
+	return adr.storer.Put(lastModifiedKey(encodedAddress), encodeNonce(blockNonce))
+}
+
+// revertModification restores the previous block which altered the account as the most recent one
+func (adr *accountsDiffsRecorder) revertModification(encodedAddress string, blockNonce uint64) error {
+	lastModifiedNonce, found, err := adr.getLastModifiedNonce(encodedAddress)
+	if err != nil {
+		return err
+	}
+	if !found || lastModifiedNonce != blockNonce {
+		return nil
+	}
+
+	previous, err := adr.storer.Get(modificationKey(encodedAddress, blockNonce))
+	if err != nil {
+		return err
+	}
+
+	if len(previous) == 0 {
+		err = adr.storer.Remove(lastModifiedKey(encodedAddress))
+	} else {
+		err = adr.storer.Put(lastModifiedKey(encodedAddress), previous)
+	}
+	if err != nil {
+		return err
+	}
+
+	return adr.storer.Remove(modificationKey(encodedAddress, blockNonce))
+}
+
+// markRangeUnusable records that the diffs of the epoch are incomplete starting with the provided block. The range
+// ends with the epoch, as the following one has its own retained root
+func (adr *accountsDiffsRecorder) markRangeUnusable(epoch uint32, fromNonce uint64) {
+	firstUnusableNonce, isMarked := adr.getFirstUnusableNonce(epoch)
+	if isMarked && firstUnusableNonce <= fromNonce {
+		return
+	}
+
+	log.Warn("accountsDiffsRecorder: the accounts diffs are unusable until the end of epoch", "epoch", epoch, "from nonce", fromNonce)
+	adr.unusableRanges[epoch] = fromNonce
+	err := adr.storer.Put(unusableRangeKey(epoch), encodeNonce(fromNonce))
+	if err != nil {
+		log.Error("accountsDiffsRecorder: the unusable range is kept only until the node restarts", "epoch", epoch, "error", err)
+	}
+}
+
+func (adr *accountsDiffsRecorder) getFirstUnusableNonce(epoch uint32) (uint64, bool) {
+	firstUnusableNonce, found := adr.unusableRanges[epoch]
+	if found {
+		return firstUnusableNonce, true
+	}
+
+	buff, err := adr.storer.Get(unusableRangeKey(epoch))
+	if err != nil {
+		return 0, false
+	}
+
+	firstUnusableNonce, err = decodeNonce(buff)
+	if err != nil {
+		// a corrupted marker makes the whole epoch unusable
+		return 0, true
+	}
+
+	return firstUnusableNonce, true
+}
+
+// RevertIndexedBlock removes the accounts diff of the reverted block and its entries from the accounts index
+func (adr *accountsDiffsRecorder) RevertIndexedBlock(blockData *outportcore.BlockData) error {
+	if blockData == nil {
+		return nil
+	}
+
+	adr.mutex.Lock()
+	defer adr.mutex.Unlock()
+
+	header, err := adr.getHeader(blockData)
+	if err != nil {
+		log.Warn("accountsDiffsRecorder.RevertIndexedBlock: cannot decode header", "error", err)
+		return nil
+	}
+
+	err = adr.revertBlock(header)
+	if err != nil {
+		log.Error("accountsDiffsRecorder.RevertIndexedBlock", "nonce", header.GetNonce(), "error", err)
+		adr.markRangeUnusable(header.GetEpoch(), header.GetNonce())
+	}
+
+	return nil
+}
+
+func (adr *accountsDiffsRecorder) revertBlock(header data.HeaderHandler) error {
+	accountsDiff, err := adr.getAccountsDiff(header.GetNonce())
+	if err == nil {
+		for encodedAddress := range accountsDiff.AlteredAccounts {
+			err = adr.revertModification(encodedAddress, header.GetNonce())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = adr.storer.Remove(accountsDiffKey(header.GetNonce()))
+	if err != nil {
+		return err
+	}
+	if !header.IsStartOfEpochBlock() {
+		return nil
+	}
+
+	return adr.storer.Remove(retainedRootKey(header.GetEpoch()))
+}
+
+func (adr *accountsDiffsRecorder) getHeader(blockData *outportcore.BlockData) (data.HeaderHandler, error) {
+	creator, err := adr.blockContainer.Get(core.HeaderType(blockData.HeaderType))
+	if err != nil {
+		return nil, err
+	}
+
+	return block.GetHeaderFromBytes(adr.marshaller, creator, blockData.HeaderBytes)
+}
+
+// GetAccountAtBlock returns the state of the account at the end of the provided block, as recorded in the most recent
+// accounts diff which altered it, along with the retained root of the block's epoch. If the account was not altered
+// since the retained root, the returned account is nil and its state should be loaded from the retained root
+func (adr *accountsDiffsRecorder) GetAccountAtBlock(address []byte, blockNonce uint64, epoch uint32) (*alteredAccount.AlteredAccount, *RetainedRoot, error) {
+	adr.mutex.RLock()
+	defer adr.mutex.RUnlock()
+
+	retainedRoot, err := adr.getRetainedRoot(epoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	if blockNonce < retainedRoot.BlockNonce {
+		return nil, nil, fmt.Errorf("%w, epoch %d starts at block %d, requested block %d",
+			ErrNoRetainedRoot, epoch, retainedRoot.BlockNonce, blockNonce)
+	}
+
+	firstUnusableNonce, isMarked := adr.getFirstUnusableNonce(epoch)
+	if isMarked && blockNonce >= firstUnusableNonce {
+		return nil, nil, fmt.Errorf("%w, epoch %d is incomplete starting with block %d, requested block %d",
+			ErrUnusableAccountsDiffs, epoch, firstUnusableNonce, blockNonce)
+	}
+
+	err = adr.storer.Has(accountsDiffKey(blockNonce))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for block %d", ErrMissingAccountsDiff, blockNonce)
+	}
+
+	encodedAddress, err := adr.addressConverter.Encode(address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	modifiedNonce, found, err := adr.getModifiedNonceAtBlock(encodedAddress, blockNonce)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !found || modifiedNonce <= retainedRoot.BlockNonce {
+		return nil, retainedRoot, nil
+	}
+
+	accountsDiff, err := adr.getAccountsDiff(modifiedNonce)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account, found := accountsDiff.AlteredAccounts[encodedAddress]
+	if !found || account == nil {
+		return nil, nil, fmt.Errorf("%w, the accounts diff of block %d does not contain the indexed address %s",
+			ErrUnusableAccountsDiffs, modifiedNonce, encodedAddress)
+	}
+
+	account.Address = encodedAddress
+
+	return account, retainedRoot, nil
+}
+
+// getModifiedNonceAtBlock returns the nonce of the most recent block, not newer than the provided one, which altered
+// the account
+func (adr *accountsDiffsRecorder) getModifiedNonceAtBlock(encodedAddress string, blockNonce uint64) (uint64, bool, error) {
+	modifiedNonce, found, err := adr.getLastModifiedNonce(encodedAddress)
+	for err == nil && found && modifiedNonce > blockNonce {
+		modifiedNonce, found, err = adr.getPreviousModifiedNonce(encodedAddress, modifiedNonce)
+	}
+
+	return modifiedNonce, found, err
+}
+
+func (adr *accountsDiffsRecorder) getLastModifiedNonce(encodedAddress string) (uint64, bool, error) {
+	buff, err := adr.storer.Get(lastModifiedKey(encodedAddress))
+	if err != nil {
+		// the account was not altered since the diffs are recorded
+		return 0, false, nil
+	}
+
+	nonce, err := decodeNonce(buff)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return nonce, true, nil
+}
+
+func (adr *accountsDiffsRecorder) getPreviousModifiedNonce(encodedAddress string, blockNonce uint64) (uint64, bool, error) {
+	buff, err := adr.storer.Get(modificationKey(encodedAddress, blockNonce))
+	if err != nil {
+		return 0, false, fmt.Errorf("%w, missing index entry of block %d for address %s",
+			ErrUnusableAccountsDiffs, blockNonce, encodedAddress)
+	}
+	if len(buff) == 0 {
+		return 0, false, nil
+	}
+
+	nonce, err := decodeNonce(buff)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return nonce, true, nil
+}
+
+func (adr *accountsDiffsRecorder) getRetainedRoot(epoch uint32) (*RetainedRoot, error) {
+	buff, err := adr.storer.Get(retainedRootKey(epoch))
+	if err != nil {
+		return nil, fmt.Errorf("%w, epoch %d", ErrNoRetainedRoot, epoch)
+	}
+
+	return decodeRetainedRoot(buff)
+}
+
+func (adr *accountsDiffsRecorder) getAccountsDiff(blockNonce uint64) (*outportcore.Accounts, error) {
+	buff, err := adr.storer.Get(accountsDiffKey(blockNonce))
+	if err != nil {
+		return nil, fmt.Errorf("%w for block %d", ErrMissingAccountsDiff, blockNonce)
+	}
+
+	accountsDiff := &outportcore.Accounts{}
+	err = adr.marshaller.Unmarshal(accountsDiff, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsDiff, nil
+}
+
+// SaveRoundsInfo does nothing
+func (adr *accountsDiffsRecorder) SaveRoundsInfo(_ *outportcore.RoundsInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys does nothing
+func (adr *accountsDiffsRecorder) SaveValidatorsPubKeys(_ *outportcore.ValidatorsPubKeys) error {
+	return nil
+}
+
+// SaveValidatorsRating does nothing
+func (adr *accountsDiffsRecorder) SaveValidatorsRating(_ *outportcore.ValidatorsRating) error {
+	return nil
+}
+
+// SaveAccounts does nothing
+func (adr *accountsDiffsRecorder) SaveAccounts(_ *outportcore.Accounts) error {
+	return nil
+}
+
+// FinalizedBlock does nothing
+func (adr *accountsDiffsRecorder) FinalizedBlock(_ *outportcore.FinalizedBlock) error {
+	return nil
+}
+
+// GetMarshaller returns the marshaller used by the outport to serialize the headers
+func (adr *accountsDiffsRecorder) GetMarshaller() marshal.Marshalizer {
+	return adr.marshaller
+}
+
+// SetCurrentSettings does nothing
+func (adr *accountsDiffsRecorder) SetCurrentSettings(_ outportcore.OutportConfig) error {
+	return nil
+}
+
+// RegisterHandler does nothing
+func (adr *accountsDiffsRecorder) RegisterHandler(_ func() error, _ string) error {
+	return nil
+}
+
+// Close does nothing, as the storer is closed by its owner
+func (adr *accountsDiffsRecorder) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adr *accountsDiffsRecorder) IsInterfaceNil() bool {
+	return adr == nil
+}
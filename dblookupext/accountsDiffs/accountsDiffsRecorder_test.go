@@ -0,0 +1,267 @@
+package accountsDiffs
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	firstAddress  = []byte("first-address-of-32-bytes-length")
+	secondAddress = []byte("second-address-of-32-bytes-lengt")
+)
+
+func createMockArgsAccountsDiffsRecorder() ArgsAccountsDiffsRecorder {
+	return ArgsAccountsDiffsRecorder{
+		Storer:           testscommon.CreateMemUnit(),
+		Marshaller:       &marshal.GogoProtoMarshalizer{},
+		AddressConverter: testscommon.NewPubkeyConverterMock(32),
+	}
+}
+
+func createOutportBlock(t *testing.T, header data.HeaderHandler, alteredAccounts map[string]*alteredAccount.AlteredAccount) *outportcore.OutportBlock {
+	headerBytes, headerType, err := outportcore.GetHeaderBytesAndType(&marshal.GogoProtoMarshalizer{}, header)
+	require.Nil(t, err)
+
+	return &outportcore.OutportBlock{
+		BlockData: &outportcore.BlockData{
+			HeaderBytes: headerBytes,
+			HeaderType:  string(headerType),
+			HeaderHash:  []byte("hash"),
+		},
+		AlteredAccounts: alteredAccounts,
+	}
+}
+
+func createAlteredAccounts(converter core.PubkeyConverter, address []byte, nonce uint64, balance string) map[string]*alteredAccount.AlteredAccount {
+	encodedAddress, _ := converter.Encode(address)
+
+	return map[string]*alteredAccount.AlteredAccount{
+		encodedAddress: {
+			Address: encodedAddress,
+			Nonce:   nonce,
+			Balance: balance,
+			Tokens:  []*alteredAccount.AccountTokenData{{Identifier: "TKN-123456", Balance: "1"}},
+			AdditionalData: &alteredAccount.AdditionalAccountData{
+				UserName: "alice",
+			},
+		},
+	}
+}
+
+func TestNewAccountsDiffsRecorder(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountsDiffsRecorder()
+	args.Storer = nil
+	recorder, err := NewAccountsDiffsRecorder(args)
+	assert.Equal(t, core.ErrNilStore, err)
+	assert.Nil(t, recorder)
+
+	args = createMockArgsAccountsDiffsRecorder()
+	args.Marshaller = nil
+	recorder, err = NewAccountsDiffsRecorder(args)
+	assert.Equal(t, core.ErrNilMarshalizer, err)
+	assert.Nil(t, recorder)
+
+	args = createMockArgsAccountsDiffsRecorder()
+	args.AddressConverter = nil
+	recorder, err = NewAccountsDiffsRecorder(args)
+	assert.Equal(t, core.ErrNilPubkeyConverter, err)
+	assert.Nil(t, recorder)
+
+	recorder, err = NewAccountsDiffsRecorder(createMockArgsAccountsDiffsRecorder())
+	assert.Nil(t, err)
+	assert.False(t, recorder.IsInterfaceNil())
+}
+
+func TestAccountsDiffsRecorder_GetAccountAtBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountsDiffsRecorder()
+	recorder, _ := NewAccountsDiffsRecorder(args)
+	converter := args.AddressConverter
+
+	epochStartHeader := &block.Header{Nonce: 10, Epoch: 2, RootHash: []byte("epoch start root hash"), EpochStartMetaHash: []byte("meta hash")}
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, epochStartHeader, createAlteredAccounts(converter, firstAddress, 1, "100"))))
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 11, Epoch: 2}, createAlteredAccounts(converter, firstAddress, 2, "90"))))
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 12, Epoch: 2}, nil)))
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 13, Epoch: 2}, createAlteredAccounts(converter, secondAddress, 1, "5"))))
+
+	t.Run("account altered after the retained root should be returned from the diffs", func(t *testing.T) {
+		t.Parallel()
+
+		account, retainedRoot, err := recorder.GetAccountAtBlock(firstAddress, 13, 2)
+		require.Nil(t, err)
+		assert.Equal(t, &RetainedRoot{BlockNonce: 10, RootHash: []byte("epoch start root hash")}, retainedRoot)
+		assert.Equal(t, uint64(2), account.Nonce)
+		assert.Equal(t, "90", account.Balance)
+		assert.Equal(t, "alice", account.AdditionalData.UserName)
+		assert.Empty(t, account.Tokens)
+
+		expectedAddress, _ := converter.Encode(firstAddress)
+		assert.Equal(t, expectedAddress, account.Address)
+	})
+	t.Run("account not altered after the retained root should not be returned", func(t *testing.T) {
+		t.Parallel()
+
+		account, retainedRoot, err := recorder.GetAccountAtBlock(secondAddress, 12, 2)
+		require.Nil(t, err)
+		assert.Nil(t, account)
+		assert.Equal(t, uint64(10), retainedRoot.BlockNonce)
+
+		account, _, err = recorder.GetAccountAtBlock(firstAddress, 10, 2)
+		require.Nil(t, err)
+		assert.Nil(t, account)
+	})
+	t.Run("epoch without retained root should error", func(t *testing.T) {
+		t.Parallel()
+
+		account, retainedRoot, err := recorder.GetAccountAtBlock(firstAddress, 13, 3)
+		assert.True(t, errors.Is(err, ErrNoRetainedRoot))
+		assert.Nil(t, account)
+		assert.Nil(t, retainedRoot)
+
+		_, _, err = recorder.GetAccountAtBlock(firstAddress, 9, 2)
+		assert.True(t, errors.Is(err, ErrNoRetainedRoot))
+	})
+	t.Run("missing diff should error", func(t *testing.T) {
+		t.Parallel()
+
+		account, _, err := recorder.GetAccountAtBlock(secondAddress, 14, 2)
+		assert.True(t, errors.Is(err, ErrMissingAccountsDiff))
+		assert.Nil(t, account)
+	})
+}
+
+func TestAccountsDiffsRecorder_GetAccountAtBlockShouldFollowTheAccountIndex(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountsDiffsRecorder()
+	recorder, _ := NewAccountsDiffsRecorder(args)
+	converter := args.AddressConverter
+
+	epochStartHeader := &block.Header{Nonce: 10, Epoch: 2, RootHash: []byte("root hash"), EpochStartMetaHash: []byte("meta hash")}
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, epochStartHeader, nil)))
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 11, Epoch: 2}, createAlteredAccounts(converter, firstAddress, 1, "100"))))
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 12, Epoch: 2}, createAlteredAccounts(converter, secondAddress, 1, "5"))))
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 13, Epoch: 2}, createAlteredAccounts(converter, firstAddress, 2, "90"))))
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 14, Epoch: 2}, nil)))
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 15, Epoch: 2}, createAlteredAccounts(converter, firstAddress, 3, "80"))))
+
+	expectedBalances := map[uint64]string{11: "100", 12: "100", 13: "90", 14: "90", 15: "80"}
+	for blockNonce, expectedBalance := range expectedBalances {
+		account, _, err := recorder.GetAccountAtBlock(firstAddress, blockNonce, 2)
+		require.Nil(t, err)
+		assert.Equal(t, expectedBalance, account.Balance, "block %d", blockNonce)
+	}
+
+	account, _, err := recorder.GetAccountAtBlock(firstAddress, 10, 2)
+	require.Nil(t, err)
+	assert.Nil(t, account)
+
+	require.Nil(t, recorder.RevertIndexedBlock(createOutportBlock(t, &block.Header{Nonce: 15, Epoch: 2}, nil).BlockData))
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 15, Epoch: 2}, nil)))
+	account, _, err = recorder.GetAccountAtBlock(firstAddress, 15, 2)
+	require.Nil(t, err)
+	assert.Equal(t, "90", account.Balance)
+}
+
+func TestAccountsDiffsRecorder_SaveBlockFailureShouldMarkTheRangeUnusable(t *testing.T) {
+	t.Parallel()
+
+	memUnit := testscommon.CreateMemUnit()
+	args := createMockArgsAccountsDiffsRecorder()
+	args.Storer = &storageStubs.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			if bytes.Equal(key, accountsDiffKey(12)) {
+				return errors.New("put error")
+			}
+			return memUnit.Put(key, data)
+		},
+		GetCalled:    memUnit.Get,
+		HasCalled:    memUnit.Has,
+		RemoveCalled: memUnit.Remove,
+	}
+	recorder, _ := NewAccountsDiffsRecorder(args)
+	converter := args.AddressConverter
+
+	epochStartHeader := &block.Header{Nonce: 10, Epoch: 2, RootHash: []byte("root hash"), EpochStartMetaHash: []byte("meta hash")}
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, epochStartHeader, nil)))
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 11, Epoch: 2}, createAlteredAccounts(converter, firstAddress, 1, "100"))))
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 12, Epoch: 2}, createAlteredAccounts(converter, firstAddress, 2, "90"))))
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 13, Epoch: 2}, nil)))
+
+	account, _, err := recorder.GetAccountAtBlock(firstAddress, 11, 2)
+	require.Nil(t, err)
+	assert.Equal(t, "100", account.Balance)
+
+	account, _, err = recorder.GetAccountAtBlock(firstAddress, 13, 2)
+	assert.True(t, errors.Is(err, ErrUnusableAccountsDiffs))
+	assert.Nil(t, account)
+
+	// the marker is persisted, so it is loaded by a new recorder as well
+	recorder, _ = NewAccountsDiffsRecorder(args)
+	_, _, err = recorder.GetAccountAtBlock(firstAddress, 12, 2)
+	assert.True(t, errors.Is(err, ErrUnusableAccountsDiffs))
+
+	// the next epoch has its own retained root
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 20, Epoch: 3, RootHash: []byte("root hash"), EpochStartMetaHash: []byte("meta hash")}, nil)))
+	account, _, err = recorder.GetAccountAtBlock(firstAddress, 20, 3)
+	require.Nil(t, err)
+	assert.Nil(t, account)
+}
+
+func TestAccountsDiffsRecorder_RevertIndexedBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsAccountsDiffsRecorder()
+	recorder, _ := NewAccountsDiffsRecorder(args)
+	converter := args.AddressConverter
+
+	epochStartHeader := &block.Header{Nonce: 10, Epoch: 2, RootHash: []byte("root hash"), EpochStartMetaHash: []byte("meta hash")}
+	epochStartBlock := createOutportBlock(t, epochStartHeader, nil)
+	require.Nil(t, recorder.SaveBlock(epochStartBlock))
+	revertedBlock := createOutportBlock(t, &block.Header{Nonce: 11, Epoch: 2}, createAlteredAccounts(converter, firstAddress, 2, "90"))
+	require.Nil(t, recorder.SaveBlock(revertedBlock))
+
+	require.Nil(t, recorder.RevertIndexedBlock(revertedBlock.BlockData))
+	_, _, err := recorder.GetAccountAtBlock(firstAddress, 11, 2)
+	assert.True(t, errors.Is(err, ErrMissingAccountsDiff))
+
+	require.Nil(t, recorder.SaveBlock(createOutportBlock(t, &block.Header{Nonce: 11, Epoch: 2}, nil)))
+	account, _, err := recorder.GetAccountAtBlock(firstAddress, 11, 2)
+	require.Nil(t, err)
+	assert.Nil(t, account)
+
+	require.Nil(t, recorder.RevertIndexedBlock(epochStartBlock.BlockData))
+	_, _, err = recorder.GetAccountAtBlock(firstAddress, 11, 2)
+	assert.True(t, errors.Is(err, ErrNoRetainedRoot))
+}
+
+func TestAccountsDiffsRecorder_SaveBlockWithInvalidHeaderShouldNotError(t *testing.T) {
+	t.Parallel()
+
+	recorder, _ := NewAccountsDiffsRecorder(createMockArgsAccountsDiffsRecorder())
+
+	err := recorder.SaveBlock(&outportcore.OutportBlock{
+		BlockData: &outportcore.BlockData{
+			HeaderBytes: []byte("invalid header"),
+			HeaderType:  string(core.ShardHeaderV1),
+		},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, recorder.SaveBlock(nil))
+	assert.Nil(t, recorder.RevertIndexedBlock(nil))
+}
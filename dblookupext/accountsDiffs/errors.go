@@ -0,0 +1,19 @@
+package accountsDiffs
+
+import "errors"
+
+// ErrNoRetainedRoot signals that no retained root was recorded for the requested epoch
+var ErrNoRetainedRoot = errors.New("no retained root recorded for the requested epoch")
+
+// ErrMissingAccountsDiff signals that the accounts diff of a block was not recorded
+var ErrMissingAccountsDiff = errors.New("missing accounts diff")
+
+// ErrUnusableAccountsDiffs signals that the accounts diffs of the requested block are incomplete, as a previous block
+// of the same epoch could not be recorded
+var ErrUnusableAccountsDiffs = errors.New("unusable accounts diffs")
+
+var errInvalidRetainedRoot = errors.New("invalid retained root")
+
+var errInvalidNonce = errors.New("invalid nonce")
+
+var errUnorderedBlock = errors.New("block older than the last one which altered the account")
@@ -0,0 +1,95 @@
+package accountsDiffs
+
+import (
+	"encoding/binary"
+)
+
+const (
+	sizeOfNonce = 8
+	sizeOfEpoch = 4
+)
+
+var (
+	accountsDiffKeyPrefix  = []byte("diff_")
+	retainedRootKeyPrefix  = []byte("root_")
+	lastModifiedKeyPrefix  = []byte("last_")
+	modificationKeyPrefix  = []byte("modif_")
+	unusableRangeKeyPrefix = []byte("unusable_")
+)
+
+// RetainedRoot is the state kept on disk at the start of an epoch, on which the accounts diffs are applied
+type RetainedRoot struct {
+	BlockNonce uint64
+	RootHash   []byte
+}
+
+// encodeRetainedRoot serializes the retained root as: block nonce | root hash
+func encodeRetainedRoot(retainedRoot *RetainedRoot) []byte {
+	buff := make([]byte, 0, sizeOfNonce+len(retainedRoot.RootHash))
+	buff = binary.BigEndian.AppendUint64(buff, retainedRoot.BlockNonce)
+
+	return append(buff, retainedRoot.RootHash...)
+}
+
+func decodeRetainedRoot(buff []byte) (*RetainedRoot, error) {
+	if len(buff) <= sizeOfNonce {
+		return nil, errInvalidRetainedRoot
+	}
+
+	return &RetainedRoot{
+		BlockNonce: binary.BigEndian.Uint64(buff),
+		RootHash:   buff[sizeOfNonce:],
+	}, nil
+}
+
+func accountsDiffKey(blockNonce uint64) []byte {
+	key := make([]byte, 0, len(accountsDiffKeyPrefix)+sizeOfNonce)
+	key = append(key, accountsDiffKeyPrefix...)
+
+	return binary.BigEndian.AppendUint64(key, blockNonce)
+}
+
+func retainedRootKey(epoch uint32) []byte {
+	key := make([]byte, 0, len(retainedRootKeyPrefix)+sizeOfEpoch)
+	key = append(key, retainedRootKeyPrefix...)
+
+	return binary.BigEndian.AppendUint32(key, epoch)
+}
+
+// lastModifiedKey is the key of the nonce of the most recent block which altered the account
+func lastModifiedKey(encodedAddress string) []byte {
+	key := make([]byte, 0, len(lastModifiedKeyPrefix)+len(encodedAddress))
+	key = append(key, lastModifiedKeyPrefix...)
+
+	return append(key, encodedAddress...)
+}
+
+// modificationKey is the key of the nonce of the block which altered the account before the provided one, so that
+// the blocks altering an account are linked from the most recent one backwards
+func modificationKey(encodedAddress string, blockNonce uint64) []byte {
+	key := make([]byte, 0, len(modificationKeyPrefix)+len(encodedAddress)+sizeOfNonce)
+	key = append(key, modificationKeyPrefix...)
+	key = append(key, encodedAddress...)
+
+	return binary.BigEndian.AppendUint64(key, blockNonce)
+}
+
+// unusableRangeKey is the key of the first block of the epoch from which the accounts diffs are no longer complete
+func unusableRangeKey(epoch uint32) []byte {
+	key := make([]byte, 0, len(unusableRangeKeyPrefix)+sizeOfEpoch)
+	key = append(key, unusableRangeKeyPrefix...)
+
+	return binary.BigEndian.AppendUint32(key, epoch)
+}
+
+func encodeNonce(nonce uint64) []byte {
+	return binary.BigEndian.AppendUint64(make([]byte, 0, sizeOfNonce), nonce)
+}
+
+func decodeNonce(buff []byte) (uint64, error) {
+	if len(buff) != sizeOfNonce {
+		return 0, errInvalidNonce
+	}
+
+	return binary.BigEndian.Uint64(buff), nil
+}
@@ -0,0 +1,35 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
+)
+
+type eventsIndexer struct {
+}
+
+// NewDisabledEventsIndexer returns an events indexer that does not index anything
+func NewDisabledEventsIndexer() *eventsIndexer {
+	return &eventsIndexer{}
+}
+
+// ProcessLogs does nothing
+func (ei *eventsIndexer) ProcessLogs(_ data.HeaderHandler, _ []*data.LogData) error {
+	return nil
+}
+
+// RevertChanges does nothing
+func (ei *eventsIndexer) RevertChanges(_ data.HeaderHandler, _ data.BodyHandler) error {
+	return nil
+}
+
+// QueryEvents returns ErrEventsIndexNotEnabled
+func (ei *eventsIndexer) QueryEvents(_ eventsIndex.EventsQuery) ([]*eventsIndex.IndexedEvent, *common.EventsQueryCursor, error) {
+	return nil, nil, eventsIndex.ErrEventsIndexNotEnabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ei *eventsIndexer) IsInterfaceNil() bool {
+	return ei == nil
+}
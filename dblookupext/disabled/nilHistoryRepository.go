@@ -5,8 +5,10 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 )
 
 var errorDisabledHistoryRepository = errors.New("history repository is disabled")
@@ -58,6 +60,11 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 	return nil, nil
 }
 
+// QueryEvents -
+func (nhr *nilHistoryRepository) QueryEvents(_ eventsIndex.EventsQuery) ([]*eventsIndex.IndexedEvent, *common.EventsQueryCursor, error) {
+	return nil, nil, errorDisabledHistoryRepository
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
@@ -0,0 +1,19 @@
+package eventsIndex
+
+import "errors"
+
+// ErrEmptyEventsQuery signals that an events query without address, identifier or topics was provided
+var ErrEmptyEventsQuery = errors.New("the events query requires an address, an identifier or at least one topic")
+
+// ErrInvalidNoncesRange signals that an invalid nonces range was provided
+var ErrInvalidNoncesRange = errors.New("invalid nonces range")
+
+// ErrInvalidQueryLimit signals that an invalid limit was provided
+var ErrInvalidQueryLimit = errors.New("invalid query limit")
+
+// ErrEventsIndexNotEnabled signals that the events index is not enabled
+var ErrEventsIndexNotEnabled = errors.New("events index is not enabled")
+
+var errCannotCastToBlockBody = errors.New("cannot cast to block body")
+
+var errInvalidIndexEntries = errors.New("invalid index entries")
@@ -0,0 +1,442 @@
+package eventsIndex
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("dblookupext/eventsIndex")
+
+const sizeOfNonce = 8
+
+// ArgsEventsIndexer holds the arguments needed to create an events indexer
+type ArgsEventsIndexer struct {
+	Marshaller               marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	EventsByAddressStorer    storage.Storer
+	EventsByIdentifierStorer storage.Storer
+	EventsByTopicStorer      storage.Storer
+	TxLogsStorer             storage.Storer
+	MaxNoncesRangeInQuery    uint64
+	MaxResultsInQuery        uint32
+}
+
+// eventsIndexer indexes the events of each block under keys built from the filter value (address, identifier or topic)
+// and the block nonce, so that a range query only needs one lookup per block
+type eventsIndexer struct {
+	marshaller               marshal.Marshalizer
+	hasher                   hashing.Hasher
+	eventsByAddressStorer    storage.Storer
+	eventsByIdentifierStorer storage.Storer
+	eventsByTopicStorer      storage.Storer
+	txLogsStorer             storage.Storer
+	maxNoncesRangeInQuery    uint64
+	maxResultsInQuery        uint32
+	mutex                    sync.RWMutex
+}
+
+type keyedEntries map[string]*indexEntries
+
+// NewEventsIndexer creates a new events indexer
+func NewEventsIndexer(args ArgsEventsIndexer) (*eventsIndexer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventsIndexer{
+		marshaller:               args.Marshaller,
+		hasher:                   args.Hasher,
+		eventsByAddressStorer:    args.EventsByAddressStorer,
+		eventsByIdentifierStorer: args.EventsByIdentifierStorer,
+		eventsByTopicStorer:      args.EventsByTopicStorer,
+		txLogsStorer:             args.TxLogsStorer,
+		maxNoncesRangeInQuery:    args.MaxNoncesRangeInQuery,
+		maxResultsInQuery:        args.MaxResultsInQuery,
+	}, nil
+}
+
+func checkArgs(args ArgsEventsIndexer) error {
+	if check.IfNil(args.Marshaller) {
+		return core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return core.ErrNilHasher
+	}
+	if check.IfNil(args.EventsByAddressStorer) {
+		return fmt.Errorf("%w for events by address", core.ErrNilStore)
+	}
+	if check.IfNil(args.EventsByIdentifierStorer) {
+		return fmt.Errorf("%w for events by identifier", core.ErrNilStore)
+	}
+	if check.IfNil(args.EventsByTopicStorer) {
+		return fmt.Errorf("%w for events by topic", core.ErrNilStore)
+	}
+	if check.IfNil(args.TxLogsStorer) {
+		return fmt.Errorf("%w for transactions logs", core.ErrNilStore)
+	}
+	if args.MaxNoncesRangeInQuery == 0 {
+		return fmt.Errorf("%w, MaxNoncesRangeInQuery should be greater than 0", ErrInvalidNoncesRange)
+	}
+	if args.MaxResultsInQuery == 0 {
+		return fmt.Errorf("%w, MaxResultsInQuery should be greater than 0", ErrInvalidQueryLimit)
+	}
+
+	return nil
+}
+
+// ProcessLogs indexes the events of the provided block logs
+func (ei *eventsIndexer) ProcessLogs(header data.HeaderHandler, logs []*data.LogData) error {
+	if check.IfNil(header) {
+		return nil
+	}
+
+	ei.mutex.Lock()
+	defer ei.mutex.Unlock()
+
+	byAddress, byIdentifier, byTopic := ei.groupEntries(header, logs)
+
+	err := ei.saveEntries(ei.eventsByAddressStorer, byAddress)
+	if err != nil {
+		return err
+	}
+	err = ei.saveEntries(ei.eventsByIdentifierStorer, byIdentifier)
+	if err != nil {
+		return err
+	}
+
+	return ei.saveEntries(ei.eventsByTopicStorer, byTopic)
+}
+
+// RevertChanges removes the index entries of the provided block, loading its logs from storage
+func (ei *eventsIndexer) RevertChanges(header data.HeaderHandler, body data.BodyHandler) error {
+	if check.IfNil(header) || check.IfNil(body) {
+		return nil
+	}
+
+	ei.mutex.Lock()
+	defer ei.mutex.Unlock()
+
+	logs, err := ei.getLogsBasedOnBody(body)
+	if err != nil {
+		return err
+	}
+
+	byAddress, byIdentifier, byTopic := ei.groupEntries(header, logs)
+	ei.removeEntries(ei.eventsByAddressStorer, byAddress)
+	ei.removeEntries(ei.eventsByIdentifierStorer, byIdentifier)
+	ei.removeEntries(ei.eventsByTopicStorer, byTopic)
+
+	return nil
+}
+
+func (ei *eventsIndexer) groupEntries(header data.HeaderHandler, logs []*data.LogData) (keyedEntries, keyedEntries, keyedEntries) {
+	byAddress := make(keyedEntries)
+	byIdentifier := make(keyedEntries)
+	byTopic := make(keyedEntries)
+
+	nonce := header.GetNonce()
+	epoch := header.GetEpoch()
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		for index, event := range logData.LogHandler.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			entry := &indexEntry{
+				txHash:     []byte(logData.TxHash),
+				eventIndex: uint32(index),
+			}
+
+			ei.addEntry(byAddress, event.GetAddress(), nonce, epoch, entry)
+			ei.addEntry(byIdentifier, event.GetIdentifier(), nonce, epoch, entry)
+			for _, topic := range uniqueValues(event.GetTopics()) {
+				ei.addEntry(byTopic, topic, nonce, epoch, entry)
+			}
+		}
+	}
+
+	return byAddress, byIdentifier, byTopic
+}
+
+func (ei *eventsIndexer) addEntry(entriesByKey keyedEntries, value []byte, nonce uint64, epoch uint32, entry *indexEntry) {
+	if len(value) == 0 {
+		return
+	}
+
+	key := string(ei.createKey(value, nonce))
+	entries, found := entriesByKey[key]
+	if !found {
+		entries = &indexEntries{
+			epoch: epoch,
+		}
+		entriesByKey[key] = entries
+	}
+
+	entries.entries = append(entries.entries, entry)
+}
+
+func (ei *eventsIndexer) createKey(value []byte, nonce uint64) []byte {
+	return createKeyFromPrefix(ei.hasher.Compute(string(value)), nonce)
+}
+
+func createKeyFromPrefix(prefix []byte, nonce uint64) []byte {
+	key := make([]byte, 0, len(prefix)+sizeOfNonce)
+	key = append(key, prefix...)
+
+	return binary.BigEndian.AppendUint64(key, nonce)
+}
+
+func uniqueValues(values [][]byte) [][]byte {
+	unique := make([][]byte, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		_, found := seen[string(value)]
+		if found {
+			continue
+		}
+
+		seen[string(value)] = struct{}{}
+		unique = append(unique, value)
+	}
+
+	return unique
+}
+
+func (ei *eventsIndexer) saveEntries(storer storage.Storer, entriesByKey keyedEntries) error {
+	for key, entries := range entriesByKey {
+		err := storer.Put([]byte(key), encodeIndexEntries(entries))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ei *eventsIndexer) removeEntries(storer storage.Storer, entriesByKey keyedEntries) {
+	for key := range entriesByKey {
+		err := storer.Remove([]byte(key))
+		if err != nil {
+			log.Debug("eventsIndexer.removeEntries: cannot remove key", "key", []byte(key), "error", err)
+		}
+	}
+}
+
+func (ei *eventsIndexer) getLogsBasedOnBody(blockBody data.BodyHandler) ([]*data.LogData, error) {
+	body, ok := blockBody.(*block.Body)
+	if !ok {
+		return nil, errCannotCastToBlockBody
+	}
+
+	logs := make([]*data.LogData, 0)
+	for _, mb := range body.MiniBlocks {
+		shouldIgnore := mb.Type != block.TxBlock && mb.Type != block.SmartContractResultBlock
+		if shouldIgnore {
+			continue
+		}
+
+		for _, txHash := range mb.TxHashes {
+			logBytes, err := ei.txLogsStorer.Get(txHash)
+			if err != nil {
+				continue
+			}
+
+			txLog := &transaction.Log{}
+			err = ei.marshaller.Unmarshal(txLog, logBytes)
+			if err != nil {
+				return nil, err
+			}
+
+			logs = append(logs, &data.LogData{
+				LogHandler: txLog,
+				TxHash:     string(txHash),
+			})
+		}
+	}
+
+	return logs, nil
+}
+
+// QueryEvents returns the indexed events matching the provided query, in the order of their block nonces. When the
+// limit is reached, the cursor of the last returned event is returned as well, so that the next page can be queried
+// without scanning again the blocks already walked
+func (ei *eventsIndexer) QueryEvents(query EventsQuery) ([]*IndexedEvent, *common.EventsQueryCursor, error) {
+	err := ei.checkQuery(query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storer, value := ei.selectIndex(query)
+	keyPrefix := ei.hasher.Compute(string(value))
+
+	startNonce := query.FromNonce
+	if query.After != nil && query.After.BlockNonce > startNonce {
+		startNonce = query.After.BlockNonce
+	}
+
+	ei.mutex.RLock()
+	defer ei.mutex.RUnlock()
+
+	results := make([]*IndexedEvent, 0)
+	if startNonce > query.ToNonce {
+		return results, nil, nil
+	}
+
+	for i := uint64(0); i <= query.ToNonce-startNonce; i++ {
+		nonce := startNonce + i
+		entriesBytes, errGet := storer.Get(createKeyFromPrefix(keyPrefix, nonce))
+		if errGet != nil {
+			continue
+		}
+
+		entries, errDecode := decodeIndexEntries(entriesBytes)
+		if errDecode != nil {
+			log.Warn("eventsIndexer.QueryEvents: cannot decode index entries", "nonce", nonce, "error", errDecode)
+			continue
+		}
+
+		logsCache := make(map[string]*transaction.Log)
+		for position, entry := range entries.entries {
+			entryIndex := uint32(position)
+			if isBeforeCursor(query.After, nonce, entryIndex) {
+				continue
+			}
+
+			indexedEvent, found := ei.loadEvent(entry, entries.epoch, nonce, logsCache)
+			if !found || !eventMatchesQuery(indexedEvent.Event, query) {
+				continue
+			}
+
+			results = append(results, indexedEvent)
+			if uint32(len(results)) == query.Limit {
+				return results, &common.EventsQueryCursor{
+					BlockNonce: nonce,
+					EntryIndex: entryIndex,
+				}, nil
+			}
+		}
+	}
+
+	return results, nil, nil
+}
+
+// isBeforeCursor returns true if the index entry was already walked by the query that returned the cursor
+func isBeforeCursor(cursor *common.EventsQueryCursor, nonce uint64, entryIndex uint32) bool {
+	if cursor == nil {
+		return false
+	}
+
+	return nonce < cursor.BlockNonce || (nonce == cursor.BlockNonce && entryIndex <= cursor.EntryIndex)
+}
+
+func (ei *eventsIndexer) checkQuery(query EventsQuery) error {
+	if len(query.Address) == 0 && len(query.Identifier) == 0 && len(query.Topics) == 0 {
+		return ErrEmptyEventsQuery
+	}
+	if query.FromNonce > query.ToNonce {
+		return fmt.Errorf("%w, fromNonce %d is greater than toNonce %d", ErrInvalidNoncesRange, query.FromNonce, query.ToNonce)
+	}
+	if query.ToNonce-query.FromNonce >= ei.maxNoncesRangeInQuery {
+		return fmt.Errorf("%w, at most %d nonces can be queried at once", ErrInvalidNoncesRange, ei.maxNoncesRangeInQuery)
+	}
+	if query.Limit == 0 || query.Limit > ei.maxResultsInQuery {
+		return fmt.Errorf("%w, the limit should be between 1 and %d", ErrInvalidQueryLimit, ei.maxResultsInQuery)
+	}
+
+	return nil
+}
+
+func (ei *eventsIndexer) selectIndex(query EventsQuery) (storage.Storer, []byte) {
+	if len(query.Address) > 0 {
+		return ei.eventsByAddressStorer, query.Address
+	}
+	if len(query.Identifier) > 0 {
+		return ei.eventsByIdentifierStorer, []byte(query.Identifier)
+	}
+
+	return ei.eventsByTopicStorer, query.Topics[0]
+}
+
+func (ei *eventsIndexer) loadEvent(entry *indexEntry, epoch uint32, nonce uint64, logsCache map[string]*transaction.Log) (*IndexedEvent, bool) {
+	txLog, found := logsCache[string(entry.txHash)]
+	if !found {
+		logBytes, err := ei.txLogsStorer.GetFromEpoch(entry.txHash, epoch)
+		if err != nil {
+			log.Debug("eventsIndexer.loadEvent: log not found", "txHash", hex.EncodeToString(entry.txHash), "epoch", epoch, "error", err)
+			return nil, false
+		}
+
+		txLog = &transaction.Log{}
+		err = ei.marshaller.Unmarshal(txLog, logBytes)
+		if err != nil {
+			log.Warn("eventsIndexer.loadEvent: cannot unmarshal log", "txHash", hex.EncodeToString(entry.txHash), "error", err)
+			return nil, false
+		}
+
+		logsCache[string(entry.txHash)] = txLog
+	}
+
+	if int(entry.eventIndex) >= len(txLog.Events) || txLog.Events[entry.eventIndex] == nil {
+		return nil, false
+	}
+
+	return &IndexedEvent{
+		TxHash:     entry.txHash,
+		LogAddress: txLog.Address,
+		BlockNonce: nonce,
+		Epoch:      epoch,
+		EventIndex: entry.eventIndex,
+		Event:      txLog.Events[entry.eventIndex],
+	}, true
+}
+
+func eventMatchesQuery(event *transaction.Event, query EventsQuery) bool {
+	if len(query.Address) > 0 && !bytes.Equal(event.Address, query.Address) {
+		return false
+	}
+	if len(query.Identifier) > 0 && string(event.Identifier) != query.Identifier {
+		return false
+	}
+
+	for _, topic := range query.Topics {
+		if !containsValue(event.Topics, topic) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsValue(values [][]byte, value []byte) bool {
+	for _, v := range values {
+		if bytes.Equal(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ei *eventsIndexer) IsInterfaceNil() bool {
+	return ei == nil
+}
@@ -0,0 +1,297 @@
+package eventsIndex
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	contractAddress = []byte("contract-address")
+	otherAddress    = []byte("other-address")
+	tokenTopic      = []byte("TKN-123456")
+)
+
+func createMockArgsEventsIndexer() ArgsEventsIndexer {
+	return ArgsEventsIndexer{
+		Marshaller:               &marshallerMock.MarshalizerMock{},
+		Hasher:                   &hashingMocks.HasherMock{},
+		EventsByAddressStorer:    testscommon.CreateMemUnit(),
+		EventsByIdentifierStorer: testscommon.CreateMemUnit(),
+		EventsByTopicStorer:      testscommon.CreateMemUnit(),
+		TxLogsStorer:             testscommon.CreateMemUnit(),
+		MaxNoncesRangeInQuery:    100,
+		MaxResultsInQuery:        10,
+	}
+}
+
+// saveBlockLogs saves the logs in the logs storer, as the transaction logs processor does, then indexes them
+func saveBlockLogs(t *testing.T, indexer *eventsIndexer, args ArgsEventsIndexer, nonce uint64, logs map[string]*transaction.Log) {
+	logsData := make([]*data.LogData, 0, len(logs))
+	for txHash, txLog := range logs {
+		logBytes, err := args.Marshaller.Marshal(txLog)
+		require.Nil(t, err)
+		require.Nil(t, args.TxLogsStorer.Put([]byte(txHash), logBytes))
+
+		logsData = append(logsData, &data.LogData{
+			LogHandler: txLog,
+			TxHash:     txHash,
+		})
+	}
+
+	err := indexer.ProcessLogs(&block.Header{Nonce: nonce, Epoch: 1}, logsData)
+	require.Nil(t, err)
+}
+
+func createTransferLog(address []byte, topics ...[]byte) *transaction.Log {
+	return &transaction.Log{
+		Address: address,
+		Events: []*transaction.Event{
+			{Address: address, Identifier: []byte(core.BuiltInFunctionESDTTransfer), Topics: topics},
+			{Address: address, Identifier: []byte("writeLog")},
+		},
+	}
+}
+
+func TestNewEventsIndexer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsIndexer()
+		args.Marshaller = nil
+		indexer, err := NewEventsIndexer(args)
+		require.Nil(t, indexer)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsIndexer()
+		args.Hasher = nil
+		indexer, err := NewEventsIndexer(args)
+		require.Nil(t, indexer)
+		require.Equal(t, core.ErrNilHasher, err)
+	})
+	t.Run("nil storers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsIndexer()
+		args.EventsByAddressStorer = nil
+		indexer, err := NewEventsIndexer(args)
+		require.Nil(t, indexer)
+		require.True(t, errors.Is(err, core.ErrNilStore))
+
+		args = createMockArgsEventsIndexer()
+		args.EventsByIdentifierStorer = nil
+		_, err = NewEventsIndexer(args)
+		require.True(t, errors.Is(err, core.ErrNilStore))
+
+		args = createMockArgsEventsIndexer()
+		args.EventsByTopicStorer = nil
+		_, err = NewEventsIndexer(args)
+		require.True(t, errors.Is(err, core.ErrNilStore))
+
+		args = createMockArgsEventsIndexer()
+		args.TxLogsStorer = nil
+		_, err = NewEventsIndexer(args)
+		require.True(t, errors.Is(err, core.ErrNilStore))
+	})
+	t.Run("invalid limits should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsIndexer()
+		args.MaxNoncesRangeInQuery = 0
+		indexer, err := NewEventsIndexer(args)
+		require.Nil(t, indexer)
+		require.True(t, errors.Is(err, ErrInvalidNoncesRange))
+
+		args = createMockArgsEventsIndexer()
+		args.MaxResultsInQuery = 0
+		indexer, err = NewEventsIndexer(args)
+		require.Nil(t, indexer)
+		require.True(t, errors.Is(err, ErrInvalidQueryLimit))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		indexer, err := NewEventsIndexer(createMockArgsEventsIndexer())
+		require.Nil(t, err)
+		require.False(t, indexer.IsInterfaceNil())
+	})
+}
+
+func TestEventsIndexer_QueryEvents(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsIndexer()
+	indexer, _ := NewEventsIndexer(args)
+
+	saveBlockLogs(t, indexer, args, 10, map[string]*transaction.Log{
+		"tx1": createTransferLog(contractAddress, tokenTopic, []byte("receiver")),
+		"tx2": createTransferLog(otherAddress, tokenTopic),
+	})
+	saveBlockLogs(t, indexer, args, 11, map[string]*transaction.Log{
+		"tx3": createTransferLog(contractAddress, []byte("OTHER-654321"), tokenTopic, tokenTopic),
+	})
+	saveBlockLogs(t, indexer, args, 13, map[string]*transaction.Log{
+		"tx4": createTransferLog(contractAddress, tokenTopic),
+	})
+
+	t.Run("by address and identifier", func(t *testing.T) {
+		events, _, err := indexer.QueryEvents(EventsQuery{
+			Address:    contractAddress,
+			Identifier: core.BuiltInFunctionESDTTransfer,
+			FromNonce:  10,
+			ToNonce:    13,
+			Limit:      10,
+		})
+		require.Nil(t, err)
+		require.Len(t, events, 3)
+		require.Equal(t, []byte("tx1"), events[0].TxHash)
+		require.Equal(t, uint64(10), events[0].BlockNonce)
+		require.Equal(t, uint32(1), events[0].Epoch)
+		require.Equal(t, uint32(0), events[0].EventIndex)
+		require.Equal(t, contractAddress, events[0].LogAddress)
+		require.Equal(t, []byte("tx3"), events[1].TxHash)
+		require.Equal(t, []byte("tx4"), events[2].TxHash)
+	})
+	t.Run("by topic", func(t *testing.T) {
+		events, _, err := indexer.QueryEvents(EventsQuery{
+			Topics:    [][]byte{tokenTopic},
+			FromNonce: 10,
+			ToNonce:   11,
+			Limit:     10,
+		})
+		require.Nil(t, err)
+		require.Len(t, events, 3)
+		require.Equal(t, uint64(11), events[2].BlockNonce)
+	})
+	t.Run("by identifier and all topics", func(t *testing.T) {
+		events, _, err := indexer.QueryEvents(EventsQuery{
+			Identifier: core.BuiltInFunctionESDTTransfer,
+			Topics:     [][]byte{tokenTopic, []byte("receiver")},
+			FromNonce:  0,
+			ToNonce:    20,
+			Limit:      10,
+		})
+		require.Nil(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, []byte("tx1"), events[0].TxHash)
+	})
+	t.Run("pagination", func(t *testing.T) {
+		events, cursor, err := indexer.QueryEvents(EventsQuery{
+			Address:   contractAddress,
+			FromNonce: 10,
+			ToNonce:   13,
+			Limit:     4,
+		})
+		require.Nil(t, err)
+		require.Len(t, events, 4)
+		require.Equal(t, []byte("tx1"), events[0].TxHash)
+		require.Equal(t, []byte("tx3"), events[3].TxHash)
+		require.Equal(t, uint32(1), events[3].EventIndex)
+		require.Equal(t, &common.EventsQueryCursor{BlockNonce: 11, EntryIndex: 1}, cursor)
+
+		events, cursor, err = indexer.QueryEvents(EventsQuery{
+			Address:   contractAddress,
+			FromNonce: 10,
+			ToNonce:   13,
+			After:     cursor,
+			Limit:     4,
+		})
+		require.Nil(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, []byte("tx4"), events[0].TxHash)
+		require.Equal(t, uint32(0), events[0].EventIndex)
+		require.Equal(t, []byte("tx4"), events[1].TxHash)
+		require.Equal(t, uint32(1), events[1].EventIndex)
+		require.Nil(t, cursor)
+	})
+	t.Run("cursor past the upper nonce", func(t *testing.T) {
+		events, cursor, err := indexer.QueryEvents(EventsQuery{
+			Address:   contractAddress,
+			FromNonce: 10,
+			ToNonce:   13,
+			After:     &common.EventsQueryCursor{BlockNonce: 14},
+			Limit:     2,
+		})
+		require.Nil(t, err)
+		require.Empty(t, events)
+		require.Nil(t, cursor)
+	})
+	t.Run("outside range", func(t *testing.T) {
+		events, _, err := indexer.QueryEvents(EventsQuery{
+			Address:   contractAddress,
+			FromNonce: 14,
+			ToNonce:   50,
+			Limit:     10,
+		})
+		require.Nil(t, err)
+		require.Empty(t, events)
+	})
+	t.Run("invalid queries should error", func(t *testing.T) {
+		_, _, err := indexer.QueryEvents(EventsQuery{FromNonce: 0, ToNonce: 1, Limit: 1})
+		require.Equal(t, ErrEmptyEventsQuery, err)
+
+		_, _, err = indexer.QueryEvents(EventsQuery{Address: contractAddress, FromNonce: 2, ToNonce: 1, Limit: 1})
+		require.True(t, errors.Is(err, ErrInvalidNoncesRange))
+
+		_, _, err = indexer.QueryEvents(EventsQuery{Address: contractAddress, FromNonce: 0, ToNonce: 100, Limit: 1})
+		require.True(t, errors.Is(err, ErrInvalidNoncesRange))
+
+		_, _, err = indexer.QueryEvents(EventsQuery{Address: contractAddress, FromNonce: 0, ToNonce: 1, Limit: 0})
+		require.True(t, errors.Is(err, ErrInvalidQueryLimit))
+
+		_, _, err = indexer.QueryEvents(EventsQuery{Address: contractAddress, FromNonce: 0, ToNonce: 1, Limit: 11})
+		require.True(t, errors.Is(err, ErrInvalidQueryLimit))
+	})
+}
+
+func TestEventsIndexer_RevertChanges(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsIndexer()
+	indexer, _ := NewEventsIndexer(args)
+
+	saveBlockLogs(t, indexer, args, 10, map[string]*transaction.Log{
+		"tx1": createTransferLog(contractAddress, tokenTopic),
+	})
+	saveBlockLogs(t, indexer, args, 11, map[string]*transaction.Log{
+		"tx2": createTransferLog(contractAddress, tokenTopic),
+	})
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{Type: block.TxBlock, TxHashes: [][]byte{[]byte("tx2")}},
+			{Type: block.RewardsBlock, TxHashes: [][]byte{[]byte("tx1")}},
+		},
+	}
+	err := indexer.RevertChanges(&block.Header{Nonce: 11, Epoch: 1}, body)
+	require.Nil(t, err)
+
+	query := EventsQuery{
+		Topics:    [][]byte{tokenTopic},
+		FromNonce: 10,
+		ToNonce:   11,
+		Limit:     10,
+	}
+	events, _, err := indexer.QueryEvents(query)
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, []byte("tx1"), events[0].TxHash)
+
+	_, err = indexer.getLogsBasedOnBody(nil)
+	require.Equal(t, errCannotCastToBlockBody, err)
+	require.Nil(t, indexer.RevertChanges(nil, body))
+}
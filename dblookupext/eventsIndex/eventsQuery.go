@@ -0,0 +1,28 @@
+package eventsIndex
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// EventsQuery holds the filters of an events query. All the provided filters must match for an event to be returned.
+// When a cursor is provided, the query resumes right after the event it points to
+type EventsQuery struct {
+	Address    []byte
+	Identifier string
+	Topics     [][]byte
+	FromNonce  uint64
+	ToNonce    uint64
+	After      *common.EventsQueryCursor
+	Limit      uint32
+}
+
+// IndexedEvent holds an event returned by an events query, together with its location
+type IndexedEvent struct {
+	TxHash     []byte
+	LogAddress []byte
+	BlockNonce uint64
+	Epoch      uint32
+	EventIndex uint32
+	Event      *transaction.Event
+}
@@ -0,0 +1,73 @@
+package eventsIndex
+
+import (
+	"encoding/binary"
+)
+
+const (
+	sizeOfEpoch      = 4
+	sizeOfHashLength = 2
+	sizeOfEventIndex = 4
+)
+
+// indexEntry points to an event, by its position in the log of a transaction
+type indexEntry struct {
+	txHash     []byte
+	eventIndex uint32
+}
+
+// indexEntries holds all the entries of a block indexed under the same key
+type indexEntries struct {
+	epoch   uint32
+	entries []*indexEntry
+}
+
+// encodeIndexEntries serializes the entries as: epoch | (hash length | hash | event index)*
+func encodeIndexEntries(entries *indexEntries) []byte {
+	size := sizeOfEpoch
+	for _, entry := range entries.entries {
+		size += sizeOfHashLength + len(entry.txHash) + sizeOfEventIndex
+	}
+
+	buff := make([]byte, 0, size)
+	buff = binary.BigEndian.AppendUint32(buff, entries.epoch)
+	for _, entry := range entries.entries {
+		buff = binary.BigEndian.AppendUint16(buff, uint16(len(entry.txHash)))
+		buff = append(buff, entry.txHash...)
+		buff = binary.BigEndian.AppendUint32(buff, entry.eventIndex)
+	}
+
+	return buff
+}
+
+func decodeIndexEntries(buff []byte) (*indexEntries, error) {
+	if len(buff) < sizeOfEpoch {
+		return nil, errInvalidIndexEntries
+	}
+
+	result := &indexEntries{
+		epoch:   binary.BigEndian.Uint32(buff),
+		entries: make([]*indexEntry, 0),
+	}
+	buff = buff[sizeOfEpoch:]
+
+	for len(buff) > 0 {
+		if len(buff) < sizeOfHashLength {
+			return nil, errInvalidIndexEntries
+		}
+
+		hashLength := int(binary.BigEndian.Uint16(buff))
+		buff = buff[sizeOfHashLength:]
+		if len(buff) < hashLength+sizeOfEventIndex {
+			return nil, errInvalidIndexEntries
+		}
+
+		result.entries = append(result.entries, &indexEntry{
+			txHash:     buff[:hashLength],
+			eventIndex: binary.BigEndian.Uint32(buff[hashLength:]),
+		})
+		buff = buff[hashLength+sizeOfEventIndex:]
+	}
+
+	return result, nil
+}
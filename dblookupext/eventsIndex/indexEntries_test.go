@@ -0,0 +1,43 @@
+package eventsIndex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexEntries_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	entries := &indexEntries{
+		epoch: 7,
+		entries: []*indexEntry{
+			{txHash: []byte("hash-1"), eventIndex: 0},
+			{txHash: []byte("h2"), eventIndex: 12},
+		},
+	}
+
+	decoded, err := decodeIndexEntries(encodeIndexEntries(entries))
+	require.Nil(t, err)
+	require.Equal(t, entries, decoded)
+
+	decoded, err = decodeIndexEntries(encodeIndexEntries(&indexEntries{epoch: 1}))
+	require.Nil(t, err)
+	require.Equal(t, uint32(1), decoded.epoch)
+	require.Empty(t, decoded.entries)
+}
+
+func TestIndexEntries_DecodeInvalidDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	encoded := encodeIndexEntries(&indexEntries{
+		epoch:   7,
+		entries: []*indexEntry{{txHash: []byte("hash"), eventIndex: 1}},
+	})
+
+	for _, buff := range [][]byte{nil, encoded[:3], encoded[:5], encoded[:len(encoded)-1]} {
+		decoded, err := decodeIndexEntries(buff)
+		require.Nil(t, decoded)
+		require.Equal(t, errInvalidIndexEntries, err)
+	}
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/dblookupext/disabled"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/storage"
 )
 
 // ArgsHistoryRepositoryFactory holds all dependencies required by the history processor factory in order to create
@@ -84,6 +86,11 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return nil, err
 	}
 
+	eventsIndexHandler, err := hpf.createEventsIndexHandler(txLogsStorer)
+	if err != nil {
+		return nil, err
+	}
+
 	roundHdrHashDataStorer, err := hpf.store.GetStorer(dataRetriever.RoundHdrHashDataUnit)
 	if err != nil {
 		return nil, err
@@ -120,10 +127,44 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: miniblockHashByTxHashStorer,
 		EventsHashesByTxHashStorer:  resultsHashesByTxHashStorer,
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
+		EventsIndexHandler:          eventsIndexHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
+func (hpf *historyRepositoryFactory) createEventsIndexHandler(txLogsStorer storage.Storer) (dblookupext.EventsIndexHandler, error) {
+	eventsIndexConfig := hpf.dbLookupExtensionsConfig.EventsIndex
+	if !eventsIndexConfig.Enabled {
+		return disabled.NewDisabledEventsIndexer(), nil
+	}
+
+	eventsByAddressStorer, err := hpf.store.GetStorer(dataRetriever.EventsByAddressUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsByIdentifierStorer, err := hpf.store.GetStorer(dataRetriever.EventsByIdentifierUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsByTopicStorer, err := hpf.store.GetStorer(dataRetriever.EventsByTopicUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsIndex.NewEventsIndexer(eventsIndex.ArgsEventsIndexer{
+		Marshaller:               hpf.marshalizer,
+		Hasher:                   hpf.hasher,
+		EventsByAddressStorer:    eventsByAddressStorer,
+		EventsByIdentifierStorer: eventsByIdentifierStorer,
+		EventsByTopicStorer:      eventsByTopicStorer,
+		TxLogsStorer:             txLogsStorer,
+		MaxNoncesRangeInQuery:    eventsIndexConfig.MaxNoncesRangeInQuery,
+		MaxResultsInQuery:        eventsIndexConfig.MaxResultsInQuery,
+	})
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hpf *historyRepositoryFactory) IsInterfaceNil() bool {
 	return hpf == nil
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common/mock"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 	"github.com/multiversx/mx-chain-go/dblookupext/factory"
 	"github.com/multiversx/mx-chain-go/process"
 	processMock "github.com/multiversx/mx-chain-go/process/mock"
@@ -77,6 +78,28 @@ func TestHistoryRepositoryFactory_CreateShouldCreateRegularRepository(t *testing
 	require.True(t, repository.IsEnabled())
 }
 
+func TestHistoryRepositoryFactory_CreateShouldCreateRegularRepositoryWithEventsIndex(t *testing.T) {
+	t.Parallel()
+
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Config.EventsIndex = createEventsIndexConfig()
+	args.Store = &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			return &storageStubs.StorerStub{}, nil
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+
+	repository, err := hrf.Create()
+	require.NoError(t, err)
+	require.True(t, repository.IsEnabled())
+
+	_, _, err = repository.QueryEvents(eventsIndex.EventsQuery{})
+	require.Equal(t, eventsIndex.ErrEmptyEventsQuery, err)
+}
+
 func TestHistoryRepositoryFactory_CreateMissingStorersReturnsError(t *testing.T) {
 	t.Parallel()
 
@@ -87,6 +110,9 @@ func TestHistoryRepositoryFactory_CreateMissingStorersReturnsError(t *testing.T)
 	t.Run("missing EpochByHashUnit", testWithMissingStorer(dataRetriever.EpochByHashUnit))
 	t.Run("missing MiniblockHashByTxHashUnit", testWithMissingStorer(dataRetriever.MiniblockHashByTxHashUnit))
 	t.Run("missing ResultsHashesByTxHashUnit", testWithMissingStorer(dataRetriever.ResultsHashesByTxHashUnit))
+	t.Run("missing EventsByAddressUnit", testWithMissingStorer(dataRetriever.EventsByAddressUnit))
+	t.Run("missing EventsByIdentifierUnit", testWithMissingStorer(dataRetriever.EventsByIdentifierUnit))
+	t.Run("missing EventsByTopicUnit", testWithMissingStorer(dataRetriever.EventsByTopicUnit))
 }
 
 func testWithMissingStorer(missingUnit dataRetriever.UnitType) func(t *testing.T) {
@@ -95,6 +121,7 @@ func testWithMissingStorer(missingUnit dataRetriever.UnitType) func(t *testing.T
 
 		args := getArgs()
 		args.Config.Enabled = true
+		args.Config.EventsIndex = createEventsIndexConfig()
 		args.Store = &storageStubs.ChainStorerStub{
 			GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
 				if unitType == missingUnit {
@@ -112,6 +139,14 @@ func testWithMissingStorer(missingUnit dataRetriever.UnitType) func(t *testing.T
 	}
 }
 
+func createEventsIndexConfig() config.EventsIndexConfig {
+	return config.EventsIndexConfig{
+		Enabled:               true,
+		MaxNoncesRangeInQuery: 100,
+		MaxResultsInQuery:     10,
+	}
+}
+
 func getArgs() *factory.ArgsHistoryRepositoryFactory {
 	return &factory.ArgsHistoryRepositoryFactory{
 		SelfShardID:              0,
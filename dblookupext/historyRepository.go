@@ -14,8 +14,10 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/logging"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
@@ -38,6 +40,7 @@ type HistoryRepositoryArguments struct {
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
+	EventsIndexHandler          EventsIndexHandler
 }
 
 type historyRepository struct {
@@ -51,6 +54,7 @@ type historyRepository struct {
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
+	eventsIndexHandler         EventsIndexHandler
 
 	// These maps temporarily hold notifications of "notarized at source or destination", to deal with unwanted concurrency effects
 	// The unwanted concurrency effects could be accentuated by the fast db-replay-validate mechanism.
@@ -97,6 +101,9 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if check.IfNil(arguments.EventsIndexHandler) {
+		return nil, errNilEventsIndexHandler
+	}
 
 	hashToEpochIndex := newHashToEpochIndex(arguments.EpochByHashStorer, arguments.Marshalizer)
 	deduplicationCacheForInsertMiniblockMetadata, _ := cache.NewLRUCache(sizeOfDeduplicationCache)
@@ -118,6 +125,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
+		eventsIndexHandler:                           arguments.EventsIndexHandler,
 	}, nil
 }
 
@@ -178,6 +186,11 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	err = hr.eventsIndexHandler.ProcessLogs(blockHeader, logs)
+	if err != nil {
+		return err
+	}
+
 	err = hr.putHashByRound(blockHeaderHash, blockHeader)
 	if err != nil {
 		return err
@@ -480,7 +493,12 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
-	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	err := hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+
+	return hr.eventsIndexHandler.RevertChanges(blockHeader, blockBody)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -488,6 +506,11 @@ func (hr *historyRepository) GetESDTSupply(token string) (*esdtSupply.SupplyESDT
 	return hr.esdtSuppliesHandler.GetESDTSupply(token)
 }
 
+// QueryEvents will return the indexed events matching the provided query
+func (hr *historyRepository) QueryEvents(query eventsIndex.EventsQuery) ([]*eventsIndex.IndexedEvent, *common.EventsQueryCursor, error) {
+	return hr.eventsIndexHandler.QueryEvents(query)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hr *historyRepository) IsInterfaceNil() bool {
 	return hr == nil
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/common/mock"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 	epochStartMocks "github.com/multiversx/mx-chain-go/epochStart/mock"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -26,6 +27,16 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 			return nil, storage.ErrKeyNotFound
 		},
 	}, &storageStubs.StorerStub{})
+	ei, _ := eventsIndex.NewEventsIndexer(eventsIndex.ArgsEventsIndexer{
+		Marshaller:               &mock.MarshalizerMock{},
+		Hasher:                   &hashingMocks.HasherMock{},
+		EventsByAddressStorer:    genericMocks.NewStorerMockWithEpoch(epoch),
+		EventsByIdentifierStorer: genericMocks.NewStorerMockWithEpoch(epoch),
+		EventsByTopicStorer:      genericMocks.NewStorerMockWithEpoch(epoch),
+		TxLogsStorer:             genericMocks.NewStorerMockWithEpoch(epoch),
+		MaxNoncesRangeInQuery:    100,
+		MaxResultsInQuery:        10,
+	})
 
 	args := HistoryRepositoryArguments{
 		SelfShardID:                 0,
@@ -38,6 +49,7 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		Hasher:                      &hashingMocks.HasherMock{},
 		ESDTSuppliesHandler:         sp,
 		Uint64ByteSliceConverter:    &epochStartMocks.Uint64ByteSliceConverterMock{},
+		EventsIndexHandler:          ei,
 	}
 
 	return args
@@ -88,6 +100,12 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, process.ErrNilUint64Converter, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.EventsIndexHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilEventsIndexHandler, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
@@ -3,7 +3,9 @@ package dblookupext
 import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 )
 
 // HistoryRepositoryFactory can create new instances of HistoryRepository
@@ -27,6 +29,7 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	QueryEvents(query eventsIndex.EventsQuery) ([]*eventsIndex.IndexedEvent, *common.EventsQueryCursor, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -47,3 +50,11 @@ type SuppliesHandler interface {
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
 	IsInterfaceNil() bool
 }
+
+// EventsIndexHandler defines the interface of an events indexer
+type EventsIndexHandler interface {
+	ProcessLogs(header data.HeaderHandler, logs []*data.LogData) error
+	RevertChanges(header data.HeaderHandler, body data.BodyHandler) error
+	QueryEvents(query eventsIndex.EventsQuery) ([]*eventsIndex.IndexedEvent, *common.EventsQueryCursor, error)
+	IsInterfaceNil() bool
+}
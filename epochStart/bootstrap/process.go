@@ -47,6 +47,7 @@ import (
 	"github.com/multiversx/mx-chain-go/storage/cache"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/trie"
 	"github.com/multiversx/mx-chain-go/trie/factory"
 	"github.com/multiversx/mx-chain-go/trie/statistics"
 	"github.com/multiversx/mx-chain-go/trie/storageMarker"
@@ -1109,6 +1110,12 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	trieStorageManager := e.trieStorageManagers[dataRetriever.UserAccountsUnit.String()]
 	e.mutTrieStorageManagers.RUnlock()
 
+	syncCheckpointHandler, closeSyncCheckpointHandler, err := e.createSyncCheckpointHandler()
+	if err != nil {
+		return err
+	}
+	defer closeSyncCheckpointHandler()
+
 	argsUserAccountsSyncer := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                            e.coreComponentsHolder.Hasher(),
@@ -1124,6 +1131,7 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 			UserAccountsSyncStatisticsHandler: e.trieSyncStatisticsProvider,
 			AppStatusHandler:                  e.statusHandler,
 			EnableEpochsHandler:               e.coreComponentsHolder.EnableEpochsHandler(),
+			SyncCheckpointHandler:             syncCheckpointHandler,
 		},
 		ShardId:                e.shardCoordinator.SelfId(),
 		Throttler:              thr,
@@ -1142,6 +1150,46 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	return nil
 }
 
+// createSyncCheckpointHandler returns the handler saving the trie sync checkpoints, along with the function that
+// closes its storer
+func (e *epochStartBootstrap) createSyncCheckpointHandler() (trie.SyncCheckpointHandler, func(), error) {
+	trieSyncConfig := e.generalConfig.TrieSync
+	if !trieSyncConfig.EnableSyncResumption {
+		return trie.NewDisabledSyncCheckpointer(), func() {}, nil
+	}
+
+	shardId := core.GetShardIDString(e.shardCoordinator.SelfId())
+	path := e.coreComponentsHolder.PathHandler().PathForStatic(shardId, trieSyncConfig.CheckpointDB.FilePath)
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageFactory.NewDBConfigHandler(trieSyncConfig.CheckpointDB))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := storageunit.NewDB(persisterFactory, path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while creating the db for the trie sync checkpoints", err)
+	}
+
+	syncCheckpointHandler, err := trie.NewSyncCheckpointer(trie.ArgsSyncCheckpointer{
+		Storer:             db,
+		Marshaller:         e.coreComponentsHolder.InternalMarshalizer(),
+		CheckpointInterval: time.Duration(trieSyncConfig.CheckpointIntervalInSeconds) * time.Second,
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, nil, err
+	}
+
+	closeHandler := func() {
+		errClose := db.Close()
+		if errClose != nil {
+			log.Warn("could not close the trie sync checkpoints db", "error", errClose)
+		}
+	}
+
+	return syncCheckpointHandler, closeHandler, nil
+}
+
 func (e *epochStartBootstrap) createStorageServiceForImportDB(
 	shardCoordinator sharding.Coordinator,
 	pathManager storage.PathManagerHandler,
@@ -1183,6 +1231,12 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 	peerTrieStorageManager := e.trieStorageManagers[dataRetriever.PeerAccountsUnit.String()]
 	e.mutTrieStorageManagers.RUnlock()
 
+	syncCheckpointHandler, closeSyncCheckpointHandler, err := e.createSyncCheckpointHandler()
+	if err != nil {
+		return err
+	}
+	defer closeSyncCheckpointHandler()
+
 	argsValidatorAccountsSyncer := syncer.ArgsNewValidatorAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                            e.coreComponentsHolder.Hasher(),
@@ -1198,6 +1252,7 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 			UserAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 			AppStatusHandler:                  disabledCommon.NewAppStatusHandler(),
 			EnableEpochsHandler:               e.coreComponentsHolder.EnableEpochsHandler(),
+			SyncCheckpointHandler:             syncCheckpointHandler,
 		},
 	}
 	accountsDBSyncer, err := syncer.NewValidatorAccountsSyncer(argsValidatorAccountsSyncer)
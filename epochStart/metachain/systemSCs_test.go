@@ -842,6 +842,7 @@ func createFullArgumentsForSystemSCProcessing(enableEpochsConfig config.EnableEp
 		GasSchedule:              gasScheduleNotifier,
 		Counter:                  &testscommon.BlockChainHookCounterStub{},
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          &testscommon.ExecutionTracerStub{},
 	}
 
 	defaults.FillGasMapInternal(gasSchedule, 1)
@@ -466,6 +466,9 @@ var ErrNilScheduledProcessor = errors.New("nil scheduled processor")
 // ErrNilTxsSender signals that a nil transactions sender has been provided
 var ErrNilTxsSender = errors.New("nil transactions sender has been provided")
 
+// ErrNilTxPoolJournal signals that a nil transactions pool journal has been provided
+var ErrNilTxPoolJournal = errors.New("nil transactions pool journal has been provided")
+
 // ErrNilProcessStatusHandler signals that a nil process status handler was provided
 var ErrNilProcessStatusHandler = errors.New("nil process status handler")
 
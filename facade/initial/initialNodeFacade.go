@@ -81,6 +81,51 @@ func (inf *initialNodeFacade) VerifyProof(_ string, _ string, _ [][]byte) (bool,
 	return false, errNodeStarting
 }
 
+// GetMultiProof -
+func (inf *initialNodeFacade) GetMultiProof(_ string, _ []string) (*common.GetMultiProofResponse, error) {
+	return nil, errNodeStarting
+}
+
+// VerifyMultiProof -
+func (inf *initialNodeFacade) VerifyMultiProof(_ string, _ []string, _ []string, _ [][]byte) (bool, error) {
+	return false, errNodeStarting
+}
+
+// GetRangeProofDataTrie -
+func (inf *initialNodeFacade) GetRangeProofDataTrie(_ string, _ string, _ string, _ string, _ int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	return nil, nil, errNodeStarting
+}
+
+// VerifyRangeProof -
+func (inf *initialNodeFacade) VerifyRangeProof(_ string, _ string, _ string, _ string, _ [][]byte) (bool, []core.KeyValueHolder, error) {
+	return false, nil, errNodeStarting
+}
+
+// GetAbsenceProof -
+func (inf *initialNodeFacade) GetAbsenceProof(_ string, _ string) (*common.GetProofResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetAbsenceProofDataTrie -
+func (inf *initialNodeFacade) GetAbsenceProofDataTrie(_ string, _ string, _ string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+	return nil, nil, errNodeStarting
+}
+
+// VerifyAbsenceProof -
+func (inf *initialNodeFacade) VerifyAbsenceProof(_ string, _ string, _ [][]byte) (bool, error) {
+	return false, errNodeStarting
+}
+
+// VerifyAbsenceProofDataTrie -
+func (inf *initialNodeFacade) VerifyAbsenceProofDataTrie(_ string, _ string, _ [][]byte) (bool, error) {
+	return false, errNodeStarting
+}
+
+// GetTrieStorageReport -
+func (inf *initialNodeFacade) GetTrieStorageReport(_ string, _ int) (*common.TrieStorageReport, error) {
+	return nil, errNodeStarting
+}
+
 // SetSyncer does nothing
 func (inf *initialNodeFacade) SetSyncer(_ ntp.SyncTimer) {
 }
@@ -167,7 +212,32 @@ func (inf *initialNodeFacade) SendBulkTransactions(_ []*transaction.Transaction)
 }
 
 // SimulateTransactionExecution returns nil and error
-func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction, _ bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nil, errNodeStarting
+}
+
+// SimulateTransactionsBundle returns nil and error
+func (inf *initialNodeFacade) SimulateTransactionsBundle(_ []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	return nil, errNodeStarting
+}
+
+// TraceTransactionExecution returns nil and error
+func (inf *initialNodeFacade) TraceTransactionExecution(_ *transaction.Transaction) (*txSimData.TransactionTrace, error) {
+	return nil, errNodeStarting
+}
+
+// TraceExecutedTransaction returns nil and error
+func (inf *initialNodeFacade) TraceExecutedTransaction(_ string) (*txSimData.TransactionTrace, error) {
+	return nil, errNodeStarting
+}
+
+// ReplayTransaction returns nil and error
+func (inf *initialNodeFacade) ReplayTransaction(_ string) (*txSimData.ReplayResults, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionStateDiff returns nil and error
+func (inf *initialNodeFacade) GetTransactionStateDiff(_ string) ([]*txSimData.AccountStateDiff, error) {
 	return nil, errNodeStarting
 }
 
@@ -381,6 +451,11 @@ func (inf *initialNodeFacade) GetTokenSupply(_ string) (*api.ESDTSupply, error)
 	return nil, errNodeStarting
 }
 
+// QueryEvents returns nil and error
+func (inf *initialNodeFacade) QueryEvents(_ common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetGenesisNodesPubKeys returns nil and error
 func (inf *initialNodeFacade) GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error) {
 	return nil, nil, errNodeStarting
@@ -406,6 +481,36 @@ func (inf *initialNodeFacade) GetTransactionsPoolNonceGapsForSender(_ string) (*
 	return nil, errNodeStarting
 }
 
+// GetTransactionStatus returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionStatus(_ string) (*common.TransactionStatusApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolSenderScore returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsPoolSenderScore(_ string) (*common.TransactionsPoolSenderScoreApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolSelectionPreview returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsPoolSelectionPreview(_ string) (*common.TransactionsPoolSelectionPreviewApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// EnqueueTransaction returns a nil structure and error
+func (inf *initialNodeFacade) EnqueueTransaction(_ *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsQueueState returns nil
+func (inf *initialNodeFacade) GetTransactionsQueueState() *common.TransactionsQueueStateApiResponse {
+	return nil
+}
+
+// CheckTransaction returns a nil structure and error
+func (inf *initialNodeFacade) CheckTransaction(_ *transaction.Transaction) (*common.TransactionCheckApiResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransactionsPoolForSender returns a nil structure and error
 func (inf *initialNodeFacade) GetTransactionsPoolForSender(_, _ string) (*common.TransactionsPoolForSenderApiResponse, error) {
 	return nil, errNodeStarting
@@ -103,10 +103,30 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Equal(t, uint64(0), u1)
 	assert.Equal(t, errNodeStarting, err)
 
-	u2, err := inf.SimulateTransactionExecution(nil)
+	u2, err := inf.SimulateTransactionExecution(nil, false)
 	assert.Nil(t, u2)
 	assert.Equal(t, errNodeStarting, err)
 
+	bundleResults, err := inf.SimulateTransactionsBundle(nil)
+	assert.Nil(t, bundleResults)
+	assert.Equal(t, errNodeStarting, err)
+
+	trace, err := inf.TraceTransactionExecution(nil)
+	assert.Nil(t, trace)
+	assert.Equal(t, errNodeStarting, err)
+
+	trace, err = inf.TraceExecutedTransaction("")
+	assert.Nil(t, trace)
+	assert.Equal(t, errNodeStarting, err)
+
+	replayResults, err := inf.ReplayTransaction("")
+	assert.Nil(t, replayResults)
+	assert.Equal(t, errNodeStarting, err)
+
+	stateDiff, err := inf.GetTransactionStateDiff("")
+	assert.Nil(t, stateDiff)
+	assert.Equal(t, errNodeStarting, err)
+
 	t1, err := inf.GetTransaction("", false)
 	assert.Nil(t, t1)
 	assert.Equal(t, errNodeStarting, err)
@@ -189,6 +209,45 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.False(t, b)
 	assert.Equal(t, errNodeStarting, err)
 
+	multiProof, err := inf.GetMultiProof("", nil)
+	assert.Nil(t, multiProof)
+	assert.Equal(t, errNodeStarting, err)
+
+	b, err = inf.VerifyMultiProof("", nil, nil, nil)
+	assert.False(t, b)
+	assert.Equal(t, errNodeStarting, err)
+
+	proof, rangeProof, err := inf.GetRangeProofDataTrie("", "", "", "", 0)
+	assert.Nil(t, proof)
+	assert.Nil(t, rangeProof)
+	assert.Equal(t, errNodeStarting, err)
+
+	b, leaves, err := inf.VerifyRangeProof("", "", "", "", nil)
+	assert.False(t, b)
+	assert.Nil(t, leaves)
+	assert.Equal(t, errNodeStarting, err)
+
+	proof, err = inf.GetAbsenceProof("", "")
+	assert.Nil(t, proof)
+	assert.Equal(t, errNodeStarting, err)
+
+	proof, absenceProof, err := inf.GetAbsenceProofDataTrie("", "", "")
+	assert.Nil(t, proof)
+	assert.Nil(t, absenceProof)
+	assert.Equal(t, errNodeStarting, err)
+
+	b, err = inf.VerifyAbsenceProof("", "", nil)
+	assert.False(t, b)
+	assert.Equal(t, errNodeStarting, err)
+
+	b, err = inf.VerifyAbsenceProofDataTrie("", "", nil)
+	assert.False(t, b)
+	assert.Equal(t, errNodeStarting, err)
+
+	trieStorageReport, err := inf.GetTrieStorageReport("", 0)
+	assert.Nil(t, trieStorageReport)
+	assert.Equal(t, errNodeStarting, err)
+
 	sa, _, err := inf.GetNFTTokenIDsRegisteredByAddress("", api.AccountQueryOptions{})
 	assert.Nil(t, sa)
 	assert.Equal(t, errNodeStarting, err)
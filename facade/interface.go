@@ -62,6 +62,9 @@ type NodeHandler interface {
 	// GetTokenSupply returns the provided token supply from current shard
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
 
+	// QueryEvents returns the indexed events matching the provided filters
+	QueryEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error)
+
 	// CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
 
@@ -108,12 +111,22 @@ type NodeHandler interface {
 	GetProof(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProof(rootHash string, addresses []string, values []string, proof [][]byte) (bool, error)
+	GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyRangeProof(rootHash string, address string, startKey string, endKey string, proof [][]byte) (bool, []core.KeyValueHolder, error)
+	GetAbsenceProof(rootHash string, address string) (*common.GetProofResponse, error)
+	GetAbsenceProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyAbsenceProof(rootHash string, address string, proof [][]byte) (bool, error)
+	VerifyAbsenceProofDataTrie(dataTrieRootHash string, key string, proof [][]byte) (bool, error)
+	GetTrieStorageReport(rootHash string, numLargestDataTries int, ctx context.Context) (*common.TrieStorageReport, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction, currentHeader coreData.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTx(tx *transaction.Transaction, currentHeader coreData.HeaderHandler) (*txSimData.TransactionTrace, error)
 	IsInterfaceNil() bool
 }
 
@@ -121,7 +134,12 @@ type TransactionSimulatorProcessor interface {
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionExecution(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceExecutedTransaction(txHash string) (*txSimData.TransactionTrace, error)
+	ReplayTransaction(txHash string) (*txSimData.ReplayResults, error)
+	GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
@@ -131,6 +149,12 @@ type ApiResolver interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionStatus(hash string) (*common.TransactionStatusApiResponse, error)
+	GetTransactionsPoolSenderScore(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error)
+	GetTransactionsPoolSelectionPreview(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error)
+	EnqueueTransaction(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error)
+	GetTransactionsQueueState() *common.TransactionsQueueStateApiResponse
+	CheckTransaction(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
@@ -19,7 +19,12 @@ type ApiResolverStub struct {
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundleHandler           func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionExecutionHandler            func(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceExecutedTransactionHandler             func(txHash string) (*txSimData.TransactionTrace, error)
+	ReplayTransactionHandler                    func(txHash string) (*txSimData.ReplayResults, error)
+	GetTransactionStateDiffHandler              func(hash string) ([]*txSimData.AccountStateDiff, error)
 	GetTotalStakedValueHandler                  func(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedListHandler                  func(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                    func(ctx context.Context) ([]*api.Delegator, error)
@@ -43,6 +48,12 @@ type ApiResolverStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionStatusCalled                  func(hash string) (*common.TransactionStatusApiResponse, error)
+	GetTransactionsPoolSenderScoreCalled        func(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error)
+	GetTransactionsPoolSelectionPreviewCalled   func(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error)
+	EnqueueTransactionCalled                    func(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error)
+	GetTransactionsQueueStateCalled             func() *common.TransactionsQueueStateApiResponse
+	CheckTransactionCalled                      func(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error)
 	GetGasConfigsCalled                         func() map[string]map[string]uint64
 	GetManagedKeysCountCalled                   func() int
 	GetManagedKeysCalled                        func() []string
@@ -125,9 +136,49 @@ func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transacti
 }
 
 // SimulateTransactionExecution -
-func (ars *ApiResolverStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (ars *ApiResolverStub) SimulateTransactionExecution(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if ars.SimulateTransactionExecutionHandler != nil {
-		return ars.SimulateTransactionExecutionHandler(tx)
+		return ars.SimulateTransactionExecutionHandler(tx, withStateDiff)
+	}
+	return nil, nil
+}
+
+// SimulateTransactionsBundle -
+func (ars *ApiResolverStub) SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if ars.SimulateTransactionsBundleHandler != nil {
+		return ars.SimulateTransactionsBundleHandler(txs)
+	}
+	return nil, nil
+}
+
+// TraceTransactionExecution -
+func (ars *ApiResolverStub) TraceTransactionExecution(tx *transaction.Transaction) (*txSimData.TransactionTrace, error) {
+	if ars.TraceTransactionExecutionHandler != nil {
+		return ars.TraceTransactionExecutionHandler(tx)
+	}
+	return nil, nil
+}
+
+// TraceExecutedTransaction -
+func (ars *ApiResolverStub) TraceExecutedTransaction(txHash string) (*txSimData.TransactionTrace, error) {
+	if ars.TraceExecutedTransactionHandler != nil {
+		return ars.TraceExecutedTransactionHandler(txHash)
+	}
+	return nil, nil
+}
+
+// GetTransactionStateDiff -
+func (ars *ApiResolverStub) GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error) {
+	if ars.GetTransactionStateDiffHandler != nil {
+		return ars.GetTransactionStateDiffHandler(hash)
+	}
+	return nil, nil
+}
+
+// ReplayTransaction -
+func (ars *ApiResolverStub) ReplayTransaction(txHash string) (*txSimData.ReplayResults, error) {
+	if ars.ReplayTransactionHandler != nil {
+		return ars.ReplayTransactionHandler(txHash)
 	}
 	return nil, nil
 }
@@ -227,6 +278,60 @@ func (ars *ApiResolverStub) GetTransactionsPoolNonceGapsForSender(sender string,
 	return nil, nil
 }
 
+// GetTransactionStatus -
+func (ars *ApiResolverStub) GetTransactionStatus(hash string) (*common.TransactionStatusApiResponse, error) {
+	if ars.GetTransactionStatusCalled != nil {
+		return ars.GetTransactionStatusCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolSenderScore -
+func (ars *ApiResolverStub) GetTransactionsPoolSenderScore(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error) {
+	if ars.GetTransactionsPoolSenderScoreCalled != nil {
+		return ars.GetTransactionsPoolSenderScoreCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolSelectionPreview -
+func (ars *ApiResolverStub) GetTransactionsPoolSelectionPreview(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error) {
+	if ars.GetTransactionsPoolSelectionPreviewCalled != nil {
+		return ars.GetTransactionsPoolSelectionPreviewCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// EnqueueTransaction -
+func (ars *ApiResolverStub) EnqueueTransaction(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error) {
+	if ars.EnqueueTransactionCalled != nil {
+		return ars.EnqueueTransactionCalled(request)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsQueueState -
+func (ars *ApiResolverStub) GetTransactionsQueueState() *common.TransactionsQueueStateApiResponse {
+	if ars.GetTransactionsQueueStateCalled != nil {
+		return ars.GetTransactionsQueueStateCalled()
+	}
+
+	return nil
+}
+
+// CheckTransaction -
+func (ars *ApiResolverStub) CheckTransaction(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error) {
+	if ars.CheckTransactionCalled != nil {
+		return ars.CheckTransactionCalled(tx)
+	}
+
+	return nil, nil
+}
+
 // GetInternalMetaBlockByHash -
 func (ars *ApiResolverStub) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	if ars.GetInternalMetaBlockByHashCalled != nil {
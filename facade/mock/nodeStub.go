@@ -53,7 +53,17 @@ type NodeStub struct {
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProofCalled                            func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProofCalled                         func(rootHash string, addresses []string, values []string, proof [][]byte) (bool, error)
+	GetRangeProofDataTrieCalled                    func(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyRangeProofCalled                         func(rootHash string, address string, startKey string, endKey string, proof [][]byte) (bool, []core.KeyValueHolder, error)
+	GetAbsenceProofCalled                          func(rootHash string, address string) (*common.GetProofResponse, error)
+	GetAbsenceProofDataTrieCalled                  func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyAbsenceProofCalled                       func(rootHash string, address string, proof [][]byte) (bool, error)
+	VerifyAbsenceProofDataTrieCalled               func(dataTrieRootHash string, key string, proof [][]byte) (bool, error)
+	GetTrieStorageReportCalled                     func(rootHash string, numLargestDataTries int, ctx context.Context) (*common.TrieStorageReport, error)
 	GetTokenSupplyCalled                           func(token string) (*api.ESDTSupply, error)
+	QueryEventsCalled                              func(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error)
 	IsDataTrieMigratedCalled                       func(address string, options api.AccountQueryOptions) (bool, error)
 	AuctionListApiCalled                           func() ([]*common.AuctionListValidatorAPIResponse, error)
 }
@@ -85,6 +95,87 @@ func (ns *NodeStub) VerifyProof(rootHash string, address string, proof [][]byte)
 	return false, nil
 }
 
+// GetMultiProof -
+func (ns *NodeStub) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	if ns.GetMultiProofCalled != nil {
+		return ns.GetMultiProofCalled(rootHash, addresses)
+	}
+
+	return nil, nil
+}
+
+// VerifyMultiProof -
+func (ns *NodeStub) VerifyMultiProof(rootHash string, addresses []string, values []string, proof [][]byte) (bool, error) {
+	if ns.VerifyMultiProofCalled != nil {
+		return ns.VerifyMultiProofCalled(rootHash, addresses, values, proof)
+	}
+
+	return false, nil
+}
+
+// GetRangeProofDataTrie -
+func (ns *NodeStub) GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	if ns.GetRangeProofDataTrieCalled != nil {
+		return ns.GetRangeProofDataTrieCalled(rootHash, address, startKey, endKey, maxLeaves)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyRangeProof -
+func (ns *NodeStub) VerifyRangeProof(rootHash string, address string, startKey string, endKey string, proof [][]byte) (bool, []core.KeyValueHolder, error) {
+	if ns.VerifyRangeProofCalled != nil {
+		return ns.VerifyRangeProofCalled(rootHash, address, startKey, endKey, proof)
+	}
+
+	return false, nil, nil
+}
+
+// GetAbsenceProof -
+func (ns *NodeStub) GetAbsenceProof(rootHash string, address string) (*common.GetProofResponse, error) {
+	if ns.GetAbsenceProofCalled != nil {
+		return ns.GetAbsenceProofCalled(rootHash, address)
+	}
+
+	return nil, nil
+}
+
+// GetAbsenceProofDataTrie -
+func (ns *NodeStub) GetAbsenceProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+	if ns.GetAbsenceProofDataTrieCalled != nil {
+		return ns.GetAbsenceProofDataTrieCalled(rootHash, address, key)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyAbsenceProof -
+func (ns *NodeStub) VerifyAbsenceProof(rootHash string, address string, proof [][]byte) (bool, error) {
+	if ns.VerifyAbsenceProofCalled != nil {
+		return ns.VerifyAbsenceProofCalled(rootHash, address, proof)
+	}
+
+	return false, nil
+}
+
+// VerifyAbsenceProofDataTrie -
+func (ns *NodeStub) VerifyAbsenceProofDataTrie(dataTrieRootHash string, key string, proof [][]byte) (bool, error) {
+	if ns.VerifyAbsenceProofDataTrieCalled != nil {
+		return ns.VerifyAbsenceProofDataTrieCalled(dataTrieRootHash, key, proof)
+	}
+
+	return false, nil
+}
+
+// GetTrieStorageReport -
+func (ns *NodeStub) GetTrieStorageReport(rootHash string, numLargestDataTries int, ctx context.Context) (*common.TrieStorageReport, error) {
+	if ns.GetTrieStorageReportCalled != nil {
+		return ns.GetTrieStorageReportCalled(rootHash, numLargestDataTries, ctx)
+	}
+
+	return nil, nil
+}
+
 // GetUsername -
 func (ns *NodeStub) GetUsername(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if ns.GetUsernameCalled != nil {
@@ -333,6 +424,14 @@ func (ns *NodeStub) GetTokenSupply(token string) (*api.ESDTSupply, error) {
 	return nil, nil
 }
 
+// QueryEvents -
+func (ns *NodeStub) QueryEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+	if ns.QueryEventsCalled != nil {
+		return ns.QueryEventsCalled(options)
+	}
+	return nil, nil
+}
+
 // GetAllIssuedESDTs -
 func (ns *NodeStub) GetAllIssuedESDTs(tokenType string, ctx context.Context) ([]string, error) {
 	if ns.GetAllIssuedESDTsCalled != nil {
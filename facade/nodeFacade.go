@@ -247,6 +247,11 @@ func (nf *nodeFacade) GetTokenSupply(token string) (*apiData.ESDTSupply, error)
 	return nf.node.GetTokenSupply(token)
 }
 
+// QueryEvents returns the indexed events matching the provided filters
+func (nf *nodeFacade) QueryEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+	return nf.node.QueryEvents(options)
+}
+
 // GetAllIssuedESDTs returns all the issued esdts from the esdt system smart contract
 func (nf *nodeFacade) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	ctx, cancel := nf.getContextForApiTrieRangeOperations()
@@ -295,8 +300,46 @@ func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint
 }
 
 // SimulateTransactionExecution will simulate a transaction's execution and will return the results
-func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
-	return nf.apiResolver.SimulateTransactionExecution(tx)
+func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nf.apiResolver.SimulateTransactionExecution(tx, withStateDiff)
+}
+
+// SimulateTransactionsBundle will simulate the provided transactions sequentially, on top of the same state, and will
+// return the results of each transaction together with the aggregated state changes
+func (nf *nodeFacade) SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	return nf.apiResolver.SimulateTransactionsBundle(txs)
+}
+
+// TraceTransactionExecution will simulate a transaction's execution and will return the results together with the
+// recorded execution frames
+func (nf *nodeFacade) TraceTransactionExecution(tx *transaction.Transaction) (*txSimData.TransactionTrace, error) {
+	return nf.apiResolver.TraceTransactionExecution(tx)
+}
+
+// TraceExecutedTransaction will re-execute an already executed transaction against the historical state and will
+// return the results together with the recorded execution frames
+func (nf *nodeFacade) TraceExecutedTransaction(txHash string) (*txSimData.TransactionTrace, error) {
+	return nf.apiResolver.TraceExecutedTransaction(txHash)
+}
+
+// ReplayTransaction will re-execute an already executed transaction against the historical state and will return the
+// results together with their differences against the stored ones
+func (nf *nodeFacade) ReplayTransaction(txHash string) (*txSimData.ReplayResults, error) {
+	results, err := nf.apiResolver.ReplayTransaction(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if results.VMOutput != nil {
+		results.VMOutputApi = nf.convertVmOutputToApiResponse(results.VMOutput)
+	}
+
+	return results, nil
+}
+
+// GetTransactionStateDiff returns the before and after values of the accounts altered by the executed transaction
+func (nf *nodeFacade) GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error) {
+	return nf.apiResolver.GetTransactionStateDiff(hash)
 }
 
 // GetTransaction gets the transaction with a specified hash
@@ -319,6 +362,36 @@ func (nf *nodeFacade) GetLastPoolNonceForSender(sender string) (uint64, error) {
 	return nf.apiResolver.GetLastPoolNonceForSender(sender)
 }
 
+// GetTransactionStatus will return the status of the transaction, reporting the replaced transactions as well
+func (nf *nodeFacade) GetTransactionStatus(hash string) (*common.TransactionStatusApiResponse, error) {
+	return nf.apiResolver.GetTransactionStatus(hash)
+}
+
+// GetTransactionsPoolSenderScore will return the score of the sender and its rank among the senders from pool
+func (nf *nodeFacade) GetTransactionsPoolSenderScore(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error) {
+	return nf.apiResolver.GetTransactionsPoolSenderScore(sender)
+}
+
+// GetTransactionsPoolSelectionPreview will return a preview of the transactions to be selected from pool for the next block
+func (nf *nodeFacade) GetTransactionsPoolSelectionPreview(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error) {
+	return nf.apiResolver.GetTransactionsPoolSelectionPreview(sender)
+}
+
+// EnqueueTransaction will add the transaction in the transactions queue, which assigns its nonce, signs and sends it
+func (nf *nodeFacade) EnqueueTransaction(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error) {
+	return nf.apiResolver.EnqueueTransaction(request)
+}
+
+// GetTransactionsQueueState will return the state of the transactions queue
+func (nf *nodeFacade) GetTransactionsQueueState() *common.TransactionsQueueStateApiResponse {
+	return nf.apiResolver.GetTransactionsQueueState()
+}
+
+// CheckTransaction will run all the validity rules against the provided transaction and return all the violated ones
+func (nf *nodeFacade) CheckTransaction(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error) {
+	return nf.apiResolver.CheckTransaction(tx)
+}
+
 // GetTransactionsPoolNonceGapsForSender will return the nonce gaps from pool for sender, if exists, that is to be returned on API calls
 func (nf *nodeFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error) {
 	accountResponse, _, err := nf.node.GetAccount(sender, apiData.AccountQueryOptions{})
@@ -603,6 +676,64 @@ func (nf *nodeFacade) VerifyProof(rootHash string, address string, proof [][]byt
 	return nf.node.VerifyProof(rootHash, address, proof)
 }
 
+// GetMultiProof returns a single Merkle proof for all the given addresses and root hash
+func (nf *nodeFacade) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	return nf.node.GetMultiProof(rootHash, addresses)
+}
+
+// VerifyMultiProof verifies the given Merkle proof for all the given addresses and their hex encoded values
+func (nf *nodeFacade) VerifyMultiProof(rootHash string, addresses []string, values []string, proof [][]byte) (bool, error) {
+	return nf.node.VerifyMultiProof(rootHash, addresses, values, proof)
+}
+
+// GetRangeProofDataTrie returns the Merkle proof for the given address, and a Merkle range proof for the keys
+// of its data trie between startKey and endKey
+func (nf *nodeFacade) GetRangeProofDataTrie(
+	rootHash string,
+	address string,
+	startKey string,
+	endKey string,
+	maxLeaves int,
+) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	return nf.node.GetRangeProofDataTrie(rootHash, address, startKey, endKey, maxLeaves)
+}
+
+// VerifyRangeProof verifies the given Merkle range proof and returns the leaves between startKey and endKey. If the
+// address is provided, the root hash is the data trie root hash of the address and the leaf values are decoded
+func (nf *nodeFacade) VerifyRangeProof(rootHash string, address string, startKey string, endKey string, proof [][]byte) (bool, []core.KeyValueHolder, error) {
+	return nf.node.VerifyRangeProof(rootHash, address, startKey, endKey, proof)
+}
+
+// GetAbsenceProof returns the Merkle proof that the given address is not present in the trie with the given root hash
+func (nf *nodeFacade) GetAbsenceProof(rootHash string, address string) (*common.GetProofResponse, error) {
+	return nf.node.GetAbsenceProof(rootHash, address)
+}
+
+// GetAbsenceProofDataTrie returns the Merkle proof for the given address, and a Merkle proof that the given key
+// is not present in the data trie of the address
+func (nf *nodeFacade) GetAbsenceProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+	return nf.node.GetAbsenceProofDataTrie(rootHash, address, key)
+}
+
+// VerifyAbsenceProof verifies that the given Merkle proof shows that the address is not present in the trie
+func (nf *nodeFacade) VerifyAbsenceProof(rootHash string, address string, proof [][]byte) (bool, error) {
+	return nf.node.VerifyAbsenceProof(rootHash, address, proof)
+}
+
+// VerifyAbsenceProofDataTrie verifies that the given Merkle proof shows that the key is not present in the data trie.
+// The data trie root hash is not bound to any account, so the caller has to verify it through the account proof
+func (nf *nodeFacade) VerifyAbsenceProofDataTrie(dataTrieRootHash string, key string, proof [][]byte) (bool, error) {
+	return nf.node.VerifyAbsenceProofDataTrie(dataTrieRootHash, key, proof)
+}
+
+// GetTrieStorageReport returns the statistics of the storage used by the state trie with the given root hash
+func (nf *nodeFacade) GetTrieStorageReport(rootHash string, numLargestDataTries int) (*common.TrieStorageReport, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetTrieStorageReport(rootHash, numLargestDataTries, ctx)
+}
+
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
 func (nf *nodeFacade) IsDataTrieMigrated(address string, options apiData.AccountQueryOptions) (bool, error) {
 	return nf.node.IsDataTrieMigrated(address, options)
@@ -1190,6 +1190,107 @@ func TestNodeFacade_VerifyProof(t *testing.T) {
 	require.True(t, response)
 }
 
+func TestNodeFacade_MultiAndRangeProofs(t *testing.T) {
+	t.Parallel()
+
+	expectedMultiProof := &common.GetMultiProofResponse{Proof: [][]byte{[]byte("proof")}}
+	expectedMainProof := &common.GetProofResponse{Proof: [][]byte{[]byte("main")}}
+	expectedRangeProof := &common.GetRangeProofResponse{RootHash: "dataTrieRootHash"}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetMultiProofCalled: func(_ string, _ []string) (*common.GetMultiProofResponse, error) {
+			return expectedMultiProof, nil
+		},
+		VerifyMultiProofCalled: func(_ string, _ []string, _ []string, _ [][]byte) (bool, error) {
+			return true, nil
+		},
+		GetRangeProofDataTrieCalled: func(_ string, _ string, _ string, _ string, _ int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+			return expectedMainProof, expectedRangeProof, nil
+		},
+		VerifyRangeProofCalled: func(_ string, _ string, _ string, _ string, _ [][]byte) (bool, []core.KeyValueHolder, error) {
+			return true, nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	multiProof, err := nf.GetMultiProof("hash", []string{"addr"})
+	require.NoError(t, err)
+	require.Equal(t, expectedMultiProof, multiProof)
+
+	ok, err := nf.VerifyMultiProof("hash", []string{"addr"}, []string{"value"}, [][]byte{[]byte("proof")})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	mainProof, rangeProof, err := nf.GetRangeProofDataTrie("hash", "addr", "", "", 10)
+	require.NoError(t, err)
+	require.Equal(t, expectedMainProof, mainProof)
+	require.Equal(t, expectedRangeProof, rangeProof)
+
+	ok, _, err = nf.VerifyRangeProof("hash", "", "", "", [][]byte{[]byte("proof")})
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestNodeFacade_AbsenceProofs(t *testing.T) {
+	t.Parallel()
+
+	expectedMainProof := &common.GetProofResponse{Proof: [][]byte{[]byte("main")}}
+	expectedAbsenceProof := &common.GetProofResponse{Proof: [][]byte{[]byte("absence")}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetAbsenceProofCalled: func(_ string, _ string) (*common.GetProofResponse, error) {
+			return expectedAbsenceProof, nil
+		},
+		GetAbsenceProofDataTrieCalled: func(_ string, _ string, _ string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+			return expectedMainProof, expectedAbsenceProof, nil
+		},
+		VerifyAbsenceProofCalled: func(_ string, _ string, _ [][]byte) (bool, error) {
+			return true, nil
+		},
+		VerifyAbsenceProofDataTrieCalled: func(_ string, _ string, _ [][]byte) (bool, error) {
+			return true, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	absenceProof, err := nf.GetAbsenceProof("hash", "addr")
+	require.NoError(t, err)
+	require.Equal(t, expectedAbsenceProof, absenceProof)
+
+	mainProof, absenceProof, err := nf.GetAbsenceProofDataTrie("hash", "addr", "key")
+	require.NoError(t, err)
+	require.Equal(t, expectedMainProof, mainProof)
+	require.Equal(t, expectedAbsenceProof, absenceProof)
+
+	ok, err := nf.VerifyAbsenceProof("hash", "addr", [][]byte{[]byte("proof")})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = nf.VerifyAbsenceProofDataTrie("hash", "key", [][]byte{[]byte("proof")})
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestNodeFacade_GetTrieStorageReport(t *testing.T) {
+	t.Parallel()
+
+	expectedReport := &common.TrieStorageReport{RootHash: "hash", NumDataTries: 2}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetTrieStorageReportCalled: func(rootHash string, numLargestDataTries int, ctx context.Context) (*common.TrieStorageReport, error) {
+			assert.Equal(t, "hash", rootHash)
+			assert.Equal(t, 7, numLargestDataTries)
+			assert.NotNil(t, ctx)
+			return expectedReport, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	report, err := nf.GetTrieStorageReport("hash", 7)
+	require.NoError(t, err)
+	require.Equal(t, expectedReport, report)
+}
+
 func TestNodeFacade_IsDataTrieMigrated(t *testing.T) {
 	t.Parallel()
 
@@ -2000,6 +2101,153 @@ func TestNodeFacade_GetLastPoolNonceForSender(t *testing.T) {
 	})
 }
 
+func TestNodeFacade_GetTransactionStatus(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedResponse := &common.TransactionStatusApiResponse{
+		Status:     string(common.TxStatusReplaced),
+		ReplacedBy: "aabb",
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionStatusCalled: func(hash string) (*common.TransactionStatusApiResponse, error) {
+			require.Equal(t, "ccdd", hash)
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res, err := nf.GetTransactionStatus("ccdd")
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, res)
+}
+
+func TestNodeFacade_EnqueueTransaction(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	providedRequest := &common.TransactionsQueueEnqueueRequest{
+		Sender:   "sender",
+		Receiver: "receiver",
+		GasLimit: 50000,
+	}
+	expectedResponse := &common.TransactionsQueueEnqueueApiResponse{
+		TxHash: "aabb",
+		Nonce:  7,
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		EnqueueTransactionCalled: func(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error) {
+			require.Equal(t, providedRequest, request)
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res, err := nf.EnqueueTransaction(providedRequest)
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, res)
+}
+
+func TestNodeFacade_GetTransactionsQueueState(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedResponse := &common.TransactionsQueueStateApiResponse{
+		Senders: []common.TransactionsQueueSenderState{
+			{
+				Address:   "sender",
+				NextNonce: 8,
+			},
+		},
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionsQueueStateCalled: func() *common.TransactionsQueueStateApiResponse {
+			return expectedResponse
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res := nf.GetTransactionsQueueState()
+	require.Equal(t, expectedResponse, res)
+}
+
+func TestNodeFacade_CheckTransaction(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	providedTx := &transaction.Transaction{Nonce: 7}
+	expectedResponse := &common.TransactionCheckApiResponse{
+		Valid: false,
+		Diagnostics: []common.TransactionCheckDiagnostic{
+			{
+				Rule:     "economics.minGasPrice",
+				Severity: "error",
+				Field:    "gasPrice",
+				Expected: ">= 1000000000",
+				Actual:   "10",
+			},
+		},
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		CheckTransactionCalled: func(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error) {
+			require.Equal(t, providedTx, tx)
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res, err := nf.CheckTransaction(providedTx)
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, res)
+}
+
+func TestNodeFacade_GetTransactionsPoolSenderScore(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedResponse := &common.TransactionsPoolSenderScoreApiResponse{
+		Sender:     "alice",
+		Score:      42,
+		Rank:       1,
+		NumSenders: 2,
+		NumTxs:     1,
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionsPoolSenderScoreCalled: func(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error) {
+			require.Equal(t, "alice", sender)
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res, err := nf.GetTransactionsPoolSenderScore("alice")
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, res)
+}
+
+func TestNodeFacade_GetTransactionsPoolSelectionPreview(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedResponse := &common.TransactionsPoolSelectionPreviewApiResponse{
+		NumSelected: 1,
+		GasSelected: 50000,
+		Selected:    []common.TransactionsPoolSelectionPreviewItem{{Hash: "aa", Sender: "alice", GasLimit: 50000}},
+		Skipped:     make([]common.TransactionsPoolSelectionPreviewItem, 0),
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionsPoolSelectionPreviewCalled: func(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error) {
+			require.Equal(t, "alice", sender)
+			return expectedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res, err := nf.GetTransactionsPoolSelectionPreview("alice")
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, res)
+}
+
 func TestNodeFacade_GetTransactionsPoolNonceGapsForSender(t *testing.T) {
 	t.Parallel()
 
@@ -2197,18 +2445,146 @@ func TestNodeFacade_SimulateTransactionExecution(t *testing.T) {
 	}
 	args := createMockArguments()
 	args.ApiResolver = &mock.ApiResolverStub{
-		SimulateTransactionExecutionHandler: func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+		SimulateTransactionExecutionHandler: func(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+			require.True(t, withStateDiff)
+			return providedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.SimulateTransactionExecution(&transaction.Transaction{}, true)
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_GetTransactionStateDiff(t *testing.T) {
+	t.Parallel()
+
+	providedResponse := []*txSimData.AccountStateDiff{{Address: "address", BalanceBefore: "1", BalanceAfter: "2"}}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionStateDiffHandler: func(hash string) ([]*txSimData.AccountStateDiff, error) {
+			require.Equal(t, "hash", hash)
+			return providedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.GetTransactionStateDiff("hash")
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_SimulateTransactionsBundle(t *testing.T) {
+	t.Parallel()
+
+	providedTxs := []*transaction.Transaction{{Nonce: 1}, {Nonce: 2}}
+	providedResponse := &txSimData.BundleSimulationResults{
+		StateChanges: []*txSimData.AccountStateDiff{{Address: "address"}},
+	}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		SimulateTransactionsBundleHandler: func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+			require.Equal(t, providedTxs, txs)
+			return providedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.SimulateTransactionsBundle(providedTxs)
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_TraceTransactionExecution(t *testing.T) {
+	t.Parallel()
+
+	providedResponse := &txSimData.TransactionTrace{
+		Frames: []*txSimData.TraceFrame{{Function: "call"}},
+	}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		TraceTransactionExecutionHandler: func(tx *transaction.Transaction) (*txSimData.TransactionTrace, error) {
 			return providedResponse, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(args)
 
-	response, err := nf.SimulateTransactionExecution(&transaction.Transaction{})
+	response, err := nf.TraceTransactionExecution(&transaction.Transaction{})
 	require.NoError(t, err)
 	require.Equal(t, providedResponse, response)
 }
 
+func TestNodeFacade_TraceExecutedTransaction(t *testing.T) {
+	t.Parallel()
+
+	providedHash := "hash"
+	providedResponse := &txSimData.TransactionTrace{
+		Frames: []*txSimData.TraceFrame{{Function: "call"}},
+	}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		TraceExecutedTransactionHandler: func(txHash string) (*txSimData.TransactionTrace, error) {
+			require.Equal(t, providedHash, txHash)
+			return providedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.TraceExecutedTransaction(providedHash)
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_ReplayTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("resolver error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArguments()
+		args.ApiResolver = &mock.ApiResolverStub{
+			ReplayTransactionHandler: func(txHash string) (*txSimData.ReplayResults, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nf, _ := NewNodeFacade(args)
+
+		response, err := nf.ReplayTransaction("hash")
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, response)
+	})
+	t.Run("should convert the vm output", func(t *testing.T) {
+		t.Parallel()
+
+		providedResponse := &txSimData.ReplayResults{}
+		providedResponse.VMOutput = &vmcommon.VMOutput{
+			ReturnCode:    vmcommon.UserError,
+			ReturnMessage: "message",
+		}
+		args := createMockArguments()
+		args.ApiResolver = &mock.ApiResolverStub{
+			ReplayTransactionHandler: func(txHash string) (*txSimData.ReplayResults, error) {
+				return providedResponse, nil
+			},
+		}
+
+		nf, _ := NewNodeFacade(args)
+
+		response, err := nf.ReplayTransaction("hash")
+		require.NoError(t, err)
+		require.Equal(t, providedResponse, response)
+		require.Equal(t, vmcommon.UserError.String(), response.VMOutputApi.ReturnCode)
+		require.Equal(t, "message", response.VMOutputApi.ReturnMessage)
+	})
+}
+
 func TestNodeFacade_ComputeTransactionGasLimit(t *testing.T) {
 	t.Parallel()
 
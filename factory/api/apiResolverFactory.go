@@ -20,8 +20,10 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/external/blockAPI"
 	"github.com/multiversx/mx-chain-go/node/external/logs"
+	"github.com/multiversx/mx-chain-go/node/external/mempoolAPI"
 	"github.com/multiversx/mx-chain-go/node/external/timemachine/fee"
 	"github.com/multiversx/mx-chain-go/node/external/transactionAPI"
+	"github.com/multiversx/mx-chain-go/node/external/transactionCheckAPI"
 	"github.com/multiversx/mx-chain-go/node/trieIterators"
 	trieIteratorsFactory "github.com/multiversx/mx-chain-go/node/trieIterators/factory"
 	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts"
@@ -33,6 +35,8 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract/builtInFunctions"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks/counters"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
+	"github.com/multiversx/mx-chain-go/process/txsSender"
 	"github.com/multiversx/mx-chain-go/process/txstatus"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
@@ -250,6 +254,48 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	argsMempoolInspector := mempoolAPI.ArgsMempoolInspector{
+		DataPool:               args.DataComponents.Datapool(),
+		ShardCoordinator:       args.ProcessComponents.ShardCoordinator(),
+		AddressPubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+		EconomicsHandler:       args.CoreComponents.EconomicsData(),
+		AccountsRepository:     args.StateComponents.AccountsRepository(),
+	}
+	mempoolInspector, err := mempoolAPI.NewMempoolInspector(argsMempoolInspector)
+	if err != nil {
+		return nil, err
+	}
+
+	txsQueue, err := createTxsQueue(args, apiTransactionProcessor)
+	if err != nil {
+		return nil, fmt.Errorf("%w when creating the transactions queue", err)
+	}
+
+	argsTransactionChecker := transactionCheckAPI.ArgsTransactionChecker{
+		ShardCoordinator:       args.ProcessComponents.ShardCoordinator(),
+		AddressPubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+		FeeHandler:             args.CoreComponents.APIEconomicsData(),
+		Accounts:               args.StateComponents.AccountsAdapterAPI(),
+		GuardianChecker:        args.BootstrapComponents.GuardedAccountHandler(),
+		TxVersionChecker:       args.CoreComponents.TxVersionChecker(),
+		EnableEpochsHandler:    args.CoreComponents.EnableEpochsHandler(),
+		Marshaller:             args.CoreComponents.InternalMarshalizer(),
+		Hasher:                 args.CoreComponents.Hasher(),
+		TxSignMarshaller:       args.CoreComponents.TxMarshalizer(),
+		TxSignHasher:           args.CoreComponents.TxSignHasher(),
+		KeyGen:                 args.CryptoComponents.TxSignKeyGen(),
+		SingleSigner:           args.CryptoComponents.TxSingleSigner(),
+		ArgumentsParser:        smartContract.NewArgumentParser(),
+		BuiltInFunctions:       builtInFuncFactory.BuiltInFunctionContainer(),
+		ESDTTransferParser:     esdtTransferParser,
+		ChainID:                []byte(args.CoreComponents.ChainID()),
+		MinTxVersion:           args.CoreComponents.MinTransactionVersion(),
+	}
+	transactionChecker, err := transactionCheckAPI.NewTransactionChecker(argsTransactionChecker)
+	if err != nil {
+		return nil, err
+	}
+
 	apiBlockProcessor, err := createAPIBlockProcessor(args, apiTransactionProcessor)
 	if err != nil {
 		return nil, err
@@ -268,6 +314,9 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
 		APITransactionHandler:    apiTransactionProcessor,
+		APIMempoolHandler:        mempoolInspector,
+		APITxsQueueHandler:       txsQueue,
+		APITransactionChecker:    transactionChecker,
 		APIBlockHandler:          apiBlockProcessor,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
 		GenesisNodesSetupHandler: args.CoreComponents.GenesisNodesSetup(),
@@ -409,6 +458,7 @@ func createScQueryElement(
 		GasSchedule:              args.gasScheduleNotifier,
 		Counter:                  counters.NewDisabledCounter(),
 		MissingTrieNodesNotifier: syncer.NewMissingTrieNodesNotifier(),
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 		Accounts:                 accountsAdapterApi,
 		BlockChain:               apiBlockchain,
 	}
@@ -669,6 +719,34 @@ func createBuiltinFuncs(
 	return builtInFunctions.CreateBuiltInFunctionsFactory(argsBuiltIn)
 }
 
+func createTxsQueue(args *ApiResolverArgs, txStatusProvider txsSender.TxStatusProvider) (external.APITxsQueueHandler, error) {
+	txsQueueConfig := args.Configs.GeneralConfig.TxsQueue
+	if !txsQueueConfig.Enabled {
+		return txsSender.NewDisabledTxsQueue(), nil
+	}
+
+	argsTxsQueue := txsSender.ArgsTxsQueue{
+		Config:                 txsQueueConfig,
+		TxsSender:              args.ProcessComponents.TxsSenderHandler(),
+		TxStatusProvider:       txStatusProvider,
+		AccountsRepository:     args.StateComponents.AccountsRepository(),
+		ShardCoordinator:       args.ProcessComponents.ShardCoordinator(),
+		AddressPubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+		Marshaller:             args.CoreComponents.InternalMarshalizer(),
+		Hasher:                 args.CoreComponents.Hasher(),
+		TxSignMarshaller:       args.CoreComponents.TxMarshalizer(),
+		TxSignHasher:           args.CoreComponents.TxSignHasher(),
+		KeyGen:                 args.CryptoComponents.TxSignKeyGen(),
+		SingleSigner:           args.CryptoComponents.TxSingleSigner(),
+		ChainID:                []byte(args.CoreComponents.ChainID()),
+		MinTxVersion:           args.CoreComponents.MinTransactionVersion(),
+		MinGasPrice:            args.CoreComponents.EconomicsData().MinGasPrice(),
+		MinGasLimit:            args.CoreComponents.EconomicsData().MinGasLimit(),
+	}
+
+	return txsSender.NewTxsQueue(argsTxsQueue)
+}
+
 func createAPIBlockProcessor(args *ApiResolverArgs, apiTransactionHandler external.APITransactionHandler) (blockAPI.APIBlockHandler, error) {
 	blockApiArgs, err := createAPIBlockProcessorArgs(args, apiTransactionHandler)
 	if err != nil {
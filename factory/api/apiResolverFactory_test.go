@@ -262,17 +262,33 @@ func TestCreateApiResolver(t *testing.T) {
 		require.True(t, strings.Contains(strings.ToLower(err.Error()), "uint64"))
 		require.True(t, check.IfNil(apiResolver))
 	})
-	t.Run("createAPIBlockProcessorArgs fails because createLogsFacade fails should error", func(t *testing.T) {
+	t.Run("NewTransactionChecker fails because of the marshaller should error", func(t *testing.T) {
 		failingStepsInstance.reset()
 		failingStepsInstance.marshallerFailingStep = 12
 		apiResolver, err := api.CreateApiResolver(failingArgs)
 		require.NotNil(t, err)
+		require.True(t, strings.Contains(strings.ToLower(err.Error()), "nil marshaller"))
+		require.True(t, check.IfNil(apiResolver))
+	})
+	t.Run("createAPIBlockProcessorArgs fails because createLogsFacade fails should error", func(t *testing.T) {
+		failingStepsInstance.reset()
+		failingStepsInstance.marshallerFailingStep = 13
+		apiResolver, err := api.CreateApiResolver(failingArgs)
+		require.NotNil(t, err)
 		require.True(t, strings.Contains(strings.ToLower(err.Error()), "marshalizer"))
 		require.True(t, check.IfNil(apiResolver))
 	})
+	t.Run("NewTransactionChecker fails should error", func(t *testing.T) {
+		failingStepsInstance.reset()
+		failingStepsInstance.addressPublicKeyConverterFailingStep = 11
+		apiResolver, err := api.CreateApiResolver(failingArgs)
+		require.NotNil(t, err)
+		require.True(t, strings.Contains(strings.ToLower(err.Error()), "pubkey converter"))
+		require.True(t, check.IfNil(apiResolver))
+	})
 	t.Run("createAPIBlockProcessorArgs fails because NewAlteredAccountsProvider fails should error", func(t *testing.T) {
 		failingStepsInstance.reset()
-		failingStepsInstance.addressPublicKeyConverterFailingStep = 10
+		failingStepsInstance.addressPublicKeyConverterFailingStep = 12
 		apiResolver, err := api.CreateApiResolver(failingArgs)
 		require.NotNil(t, err)
 		require.True(t, strings.Contains(strings.ToLower(err.Error()), "public key converter"))
@@ -262,7 +262,11 @@ type NetworkComponentsHandler interface {
 
 // TransactionEvaluator defines the transaction evaluator actions
 type TransactionEvaluator interface {
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionExecution(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceExecutedTransaction(txHash []byte, blockHash []byte) (*txSimData.TransactionTrace, error)
+	ReplayExecutedTransaction(txHash []byte, blockHash []byte) (*txSimData.ReplayResults, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	IsInterfaceNil() bool
 }
@@ -304,6 +308,7 @@ type ProcessComponentsHolder interface {
 	CurrentEpochProvider() process.CurrentNetworkEpochProviderHandler
 	ScheduledTxsExecutionHandler() process.ScheduledTxsExecutionHandler
 	TxsSenderHandler() process.TxsSenderHandler
+	TxPoolJournal() process.TxPoolJournalHandler
 	HardforkTrigger() HardforkTrigger
 	ProcessedMiniBlocksTracker() process.ProcessedMiniBlocksTracker
 	ESDTDataStorageHandlerForAPI() vmcommon.ESDTNFTStorageHandler
@@ -51,6 +51,7 @@ type ProcessComponentsMock struct {
 	CurrentEpochProviderInternal         process.CurrentNetworkEpochProviderHandler
 	ScheduledTxsExecutionHandlerInternal process.ScheduledTxsExecutionHandler
 	TxsSenderHandlerField                process.TxsSenderHandler
+	TxPoolJournalField                   process.TxPoolJournalHandler
 	HardforkTriggerField                 factory.HardforkTrigger
 	ProcessedMiniBlocksTrackerInternal   process.ProcessedMiniBlocksTracker
 	ESDTDataStorageHandlerForAPIInternal vmcommon.ESDTNFTStorageHandler
@@ -260,6 +261,11 @@ func (pcm *ProcessComponentsMock) TxsSenderHandler() process.TxsSenderHandler {
 	return pcm.TxsSenderHandlerField
 }
 
+// TxPoolJournal -
+func (pcm *ProcessComponentsMock) TxPoolJournal() process.TxPoolJournalHandler {
+	return pcm.TxPoolJournalField
+}
+
 // HardforkTrigger -
 func (pcm *ProcessComponentsMock) HardforkTrigger() factory.HardforkTrigger {
 	return pcm.HardforkTriggerField
@@ -51,15 +51,19 @@ import (
 	"github.com/multiversx/mx-chain-go/process/block/poolsCleaner"
 	"github.com/multiversx/mx-chain-go/process/block/preprocess"
 	"github.com/multiversx/mx-chain-go/process/block/processedMb"
+	"github.com/multiversx/mx-chain-go/process/dataValidators"
 	"github.com/multiversx/mx-chain-go/process/factory/interceptorscontainer"
 	"github.com/multiversx/mx-chain-go/process/headerCheck"
 	"github.com/multiversx/mx-chain-go/process/heartbeat/validator"
+	interceptorFactory "github.com/multiversx/mx-chain-go/process/interceptors/factory"
+	interceptorProcessor "github.com/multiversx/mx-chain-go/process/interceptors/processor"
 	"github.com/multiversx/mx-chain-go/process/peer"
 	"github.com/multiversx/mx-chain-go/process/receipts"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	"github.com/multiversx/mx-chain-go/process/sync"
 	"github.com/multiversx/mx-chain-go/process/track"
 	"github.com/multiversx/mx-chain-go/process/transactionLog"
+	"github.com/multiversx/mx-chain-go/process/txPoolJournal"
 	"github.com/multiversx/mx-chain-go/process/txsSender"
 	"github.com/multiversx/mx-chain-go/redundancy"
 	"github.com/multiversx/mx-chain-go/sharding"
@@ -125,6 +129,7 @@ type processComponents struct {
 	vmFactoryForProcessing           process.VirtualMachinesContainerFactory
 	scheduledTxsExecutionHandler     process.ScheduledTxsExecutionHandler
 	txsSender                        process.TxsSenderHandler
+	txPoolJournal                    process.TxPoolJournalHandler
 	hardforkTrigger                  factory.HardforkTrigger
 	processedMiniBlocksTracker       process.ProcessedMiniBlocksTracker
 	esdtDataStorageForApi            vmcommon.ESDTNFTStorageHandler
@@ -708,6 +713,11 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	txPoolJournalHandler, err := pcf.createTxPoolJournal(epochStartTrigger)
+	if err != nil {
+		return nil, err
+	}
+
 	apiTransactionEvaluator, vmFactoryForTxSimulate, err := pcf.createAPITransactionEvaluator()
 	if err != nil {
 		return nil, fmt.Errorf("%w when assembling components for the transactions simulator processor", err)
@@ -755,6 +765,7 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		epochSystemSCProcessor:           blockProcessorComponents.epochSystemSCProcessor,
 		scheduledTxsExecutionHandler:     scheduledTxsExecutionHandler,
 		txsSender:                        txsSenderWithAccumulator,
+		txPoolJournal:                    txPoolJournalHandler,
 		hardforkTrigger:                  hardforkTrigger,
 		processedMiniBlocksTracker:       processedMiniBlocksTracker,
 		esdtDataStorageForApi:            pcf.esdtNftStorage,
@@ -1877,6 +1888,67 @@ func (pcf *processComponentsFactory) createHardforkTrigger(epochStartTrigger upd
 	return trigger.NewTrigger(argTrigger)
 }
 
+// createTxPoolJournal creates the journal of the transactions pool. The journaled transactions are replayed through
+// the same validation steps as the transactions received by the interceptors
+func (pcf *processComponentsFactory) createTxPoolJournal(epochStartTrigger process.EpochStartTriggerHandler) (process.TxPoolJournalHandler, error) {
+	shardCoordinator := pcf.bootstrapComponents.ShardCoordinator()
+	journalConfig := pcf.config.TxPoolJournal
+	isJournalApplicable := journalConfig.Enabled &&
+		shardCoordinator.SelfId() != core.MetachainShardId &&
+		!pcf.importDBConfig.IsImportDBMode
+	if !isJournalApplicable {
+		return txPoolJournal.NewDisabledTxPoolJournal(), nil
+	}
+
+	txValidator, err := dataValidators.NewTxValidator(
+		pcf.state.AccountsAdapter(),
+		shardCoordinator,
+		pcf.whiteListHandler,
+		pcf.coreData.AddressPubKeyConverter(),
+		pcf.coreData.TxVersionChecker(),
+		common.MaxTxNonceDeltaAllowed,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	txInterceptorProcessor, err := interceptorProcessor.NewTxInterceptorProcessor(&interceptorProcessor.ArgTxInterceptorProcessor{
+		ShardedDataCache: pcf.data.Datapool().Transactions(),
+		TxValidator:      txValidator,
+		WhiteListRequest: pcf.whiteListHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txDataFactory, err := interceptorFactory.NewInterceptedTxDataFactory(&interceptorFactory.ArgInterceptedDataFactory{
+		CoreComponents:         pcf.coreData,
+		CryptoComponents:       pcf.crypto,
+		ShardCoordinator:       shardCoordinator,
+		FeeHandler:             pcf.coreData.EconomicsData(),
+		WhiteListerVerifiedTxs: pcf.whiteListerVerifiedTxs,
+		EpochStartTrigger:      epochStartTrigger,
+		ArgsParser:             smartContract.NewArgumentParser(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	replayer, err := txPoolJournal.NewInterceptedTxReplayer(txDataFactory, txInterceptorProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	return txPoolJournal.NewTxPoolJournal(txPoolJournal.ArgsTxPoolJournal{
+		Config:           journalConfig,
+		WorkingDir:       pcf.flagsConfig.WorkingDir,
+		Marshaller:       pcf.coreData.InternalMarshalizer(),
+		ShardCoordinator: shardCoordinator,
+		TxPool:           pcf.data.Datapool().Transactions(),
+		Replayer:         replayer,
+	})
+}
+
 func createNetworkShardingCollector(
 	config *config.Config,
 	nodesCoordinator nodesCoordinator.NodesCoordinator,
@@ -2044,6 +2116,9 @@ func (pc *processComponents) Close() error {
 	if !check.IfNil(pc.txsSender) {
 		log.LogIfError(pc.txsSender.Close())
 	}
+	if !check.IfNil(pc.txPoolJournal) {
+		log.LogIfError(pc.txPoolJournal.Close())
+	}
 
 	return nil
 }
@@ -168,6 +168,9 @@ func (m *managedProcessComponents) CheckSubcomponents() error {
 	if check.IfNil(m.processComponents.txsSender) {
 		return errors.ErrNilTxsSender
 	}
+	if check.IfNil(m.processComponents.txPoolJournal) {
+		return errors.ErrNilTxPoolJournal
+	}
 	if check.IfNil(m.processComponents.processedMiniBlocksTracker) {
 		return process.ErrNilProcessedMiniBlocksTracker
 	}
@@ -616,6 +619,18 @@ func (m *managedProcessComponents) TxsSenderHandler() process.TxsSenderHandler {
 	return m.processComponents.txsSender
 }
 
+// TxPoolJournal returns the journal of the transactions pool
+func (m *managedProcessComponents) TxPoolJournal() process.TxPoolJournalHandler {
+	m.mutProcessComponents.RLock()
+	defer m.mutProcessComponents.RUnlock()
+
+	if m.processComponents == nil {
+		return nil
+	}
+
+	return m.processComponents.txPoolJournal
+}
+
 // HardforkTrigger returns the hardfork trigger
 func (m *managedProcessComponents) HardforkTrigger() factory.HardforkTrigger {
 	m.mutProcessComponents.RLock()
@@ -84,6 +84,7 @@ func TestManagedProcessComponents_Create(t *testing.T) {
 		require.True(t, check.IfNil(managedProcessComponents.PeerShardMapper()))
 		require.True(t, check.IfNil(managedProcessComponents.ShardCoordinator()))
 		require.True(t, check.IfNil(managedProcessComponents.TxsSenderHandler()))
+		require.True(t, check.IfNil(managedProcessComponents.TxPoolJournal()))
 		require.True(t, check.IfNil(managedProcessComponents.HardforkTrigger()))
 		require.True(t, check.IfNil(managedProcessComponents.ProcessedMiniBlocksTracker()))
 		require.True(t, check.IfNil(managedProcessComponents.AccountsParser()))
@@ -129,6 +130,7 @@ func TestManagedProcessComponents_Create(t *testing.T) {
 		require.False(t, check.IfNil(managedProcessComponents.PeerShardMapper()))
 		require.False(t, check.IfNil(managedProcessComponents.ShardCoordinator()))
 		require.False(t, check.IfNil(managedProcessComponents.TxsSenderHandler()))
+		require.False(t, check.IfNil(managedProcessComponents.TxPoolJournal()))
 		require.False(t, check.IfNil(managedProcessComponents.HardforkTrigger()))
 		require.False(t, check.IfNil(managedProcessComponents.ProcessedMiniBlocksTracker()))
 		require.False(t, check.IfNil(managedProcessComponents.AccountsParser()))
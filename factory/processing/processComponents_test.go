@@ -771,6 +771,27 @@ func TestProcessComponentsFactory_Create(t *testing.T) {
 		args.CoreData = coreCompStub
 		testCreateWithArgs(t, args, "no one staked")
 	})
+	t.Run("should work with the transactions pool journal enabled", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockProcessComponentsFactoryArgs()
+		args.Config.TxPoolJournal = config.TxPoolJournalConfig{
+			Enabled:                     true,
+			DirectoryName:               "txpool",
+			CompactionIntervalInSeconds: 60,
+		}
+		args.FlagsConfig.WorkingDir = t.TempDir()
+		pcf, _ := processComp.NewProcessComponentsFactory(args)
+		require.NotNil(t, pcf)
+
+		instance, err := pcf.Create()
+		require.Nil(t, err)
+		require.NotNil(t, instance)
+
+		err = instance.Close()
+		require.NoError(t, err)
+		_ = args.State.Close()
+	})
 	t.Run("should work with indexAndReturnGenesisAccounts failing due to RootHash failure", func(t *testing.T) {
 		t.Parallel()
 
@@ -918,6 +939,7 @@ func TestProcessComponentsFactory_Create(t *testing.T) {
 			PeersAcc:             realStateComp.PeerAccounts(),
 			Tries:                realStateComp.TriesContainer(),
 			AccountsAPI:          realStateComp.AccountsAdapterAPI(),
+			AccountsRepo:         realStateComp.AccountsRepository(),
 			StorageManagers:      realStateComp.TrieStorageManagers(),
 			MissingNodesNotifier: realStateComp.MissingTrieNodesNotifier(),
 		}
@@ -959,6 +981,7 @@ func TestProcessComponentsFactory_Create(t *testing.T) {
 			PeersAcc:             realStateComp.PeerAccounts(),
 			Tries:                realStateComp.TriesContainer(),
 			AccountsAPI:          realStateComp.AccountsAdapterAPI(),
+			AccountsRepo:         realStateComp.AccountsRepository(),
 			StorageManagers:      realStateComp.TrieStorageManagers(),
 			MissingNodesNotifier: realStateComp.MissingTrieNodesNotifier(),
 		}
@@ -4,6 +4,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	dataBlock "github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/common/disabled"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
 	bootstrapDisabled "github.com/multiversx/mx-chain-go/epochStart/bootstrap/disabled"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/genesis"
@@ -14,10 +15,15 @@ import (
 	"github.com/multiversx/mx-chain-go/process/factory/shard"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/process/transaction"
 	"github.com/multiversx/mx-chain-go/process/transactionEvaluator"
 	"github.com/multiversx/mx-chain-go/process/transactionLog"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/blockInfoProviders"
+	stateDisabled "github.com/multiversx/mx-chain-go/state/disabled"
+	factoryState "github.com/multiversx/mx-chain-go/state/factory"
+	storagePruningDisabled "github.com/multiversx/mx-chain-go/state/storagePruningManager/disabled"
 	"github.com/multiversx/mx-chain-go/state/syncer"
 	"github.com/multiversx/mx-chain-go/storage"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
@@ -28,7 +34,22 @@ import (
 )
 
 func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.TransactionEvaluator, process.VirtualMachinesContainerFactory, error) {
-	simulationAccountsDB, err := transactionEvaluator.NewSimulationAccountsDB(pcf.state.AccountsAdapterAPI())
+	blockInfoProvider, err := blockInfoProviders.NewSettableBlockInfo(pcf.data.Blockchain())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accountsAdapter, err := pcf.createSimulationAccountsAdapter(blockInfoProvider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	simulationAccountsDB, err := transactionEvaluator.NewSimulationAccountsDB(accountsAdapter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	executionTracer, err := tracing.NewExecutionTracer(pcf.coreData.AddressPubKeyConverter())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -47,7 +68,7 @@ func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.Tr
 		return nil, nil, err
 	}
 
-	txSimulatorProcessorArgs, vmContainerFactory, txTypeHandler, err := pcf.createArgsTxSimulatorProcessor(simulationAccountsDB, vmOutputCacher, txLogsProcessor)
+	txSimulatorProcessorArgs, vmContainerFactory, txTypeHandler, err := pcf.createArgsTxSimulatorProcessor(simulationAccountsDB, vmOutputCacher, txLogsProcessor, executionTracer)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -66,6 +87,7 @@ func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.Tr
 	txSimulatorProcessorArgs.Hasher = pcf.coreData.Hasher()
 	txSimulatorProcessorArgs.Marshalizer = pcf.coreData.InternalMarshalizer()
 	txSimulatorProcessorArgs.DataFieldParser = dataFieldParser
+	txSimulatorProcessorArgs.ExecutionTracer = executionTracer
 
 	txSimulator, err := transactionEvaluator.NewTransactionSimulator(txSimulatorProcessorArgs)
 	if err != nil {
@@ -80,21 +102,55 @@ func (pcf *processComponentsFactory) createAPITransactionEvaluator() (factory.Tr
 		ShardCoordinator:    pcf.bootstrapComponents.ShardCoordinator(),
 		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
 		BlockChain:          pcf.data.Blockchain(),
+		BlockInfoSetter:     blockInfoProvider,
+		StorageService:      pcf.data.StorageService(),
+		Marshaller:          pcf.coreData.InternalMarshalizer(),
+		AccountsRepository:  pcf.state.AccountsRepository(),
+		PubkeyConverter:     pcf.coreData.AddressPubKeyConverter(),
 	})
 
 	return apiTransactionEvaluator, vmContainerFactory, err
 }
 
+// createSimulationAccountsAdapter creates a dedicated read-only accounts adapter for the transaction simulator, so the
+// simulation state can be moved on past blocks without affecting the other API components
+func (pcf *processComponentsFactory) createSimulationAccountsAdapter(blockInfoProvider state.BlockInfoProvider) (state.AccountsAdapter, error) {
+	accountFactory, err := factoryState.NewAccountCreator(factoryState.ArgsAccountCreator{
+		Hasher:              pcf.coreData.Hasher(),
+		Marshaller:          pcf.coreData.InternalMarshalizer(),
+		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := state.NewAccountsDB(state.ArgsAccountsDB{
+		Trie:                  pcf.state.TriesContainer().Get([]byte(dataRetriever.UserAccountsUnit.String())),
+		Hasher:                pcf.coreData.Hasher(),
+		Marshaller:            pcf.coreData.InternalMarshalizer(),
+		AccountFactory:        accountFactory,
+		StoragePruningManager: storagePruningDisabled.NewDisabledStoragePruningManager(),
+		AddressConverter:      pcf.coreData.AddressPubKeyConverter(),
+		SnapshotsManager:      stateDisabled.NewDisabledSnapshotsManager(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDBApi(accounts, blockInfoProvider)
+}
+
 func (pcf *processComponentsFactory) createArgsTxSimulatorProcessor(
 	accountsAdapter state.AccountsAdapter,
 	vmOutputCacher storage.Cacher,
 	txLogsProcessor process.TransactionLogProcessor,
+	executionTracer process.ExecutionTracer,
 ) (transactionEvaluator.ArgsTxSimulator, process.VirtualMachinesContainerFactory, process.TxTypeHandler, error) {
 	shardID := pcf.bootstrapComponents.ShardCoordinator().SelfId()
 	if shardID == core.MetachainShardId {
-		return pcf.createArgsTxSimulatorProcessorForMeta(accountsAdapter, vmOutputCacher, txLogsProcessor)
+		return pcf.createArgsTxSimulatorProcessorForMeta(accountsAdapter, vmOutputCacher, txLogsProcessor, executionTracer)
 	} else {
-		return pcf.createArgsTxSimulatorProcessorShard(accountsAdapter, vmOutputCacher, txLogsProcessor)
+		return pcf.createArgsTxSimulatorProcessorShard(accountsAdapter, vmOutputCacher, txLogsProcessor, executionTracer)
 	}
 }
 
@@ -102,6 +158,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 	accountsAdapter state.AccountsAdapter,
 	vmOutputCacher storage.Cacher,
 	txLogsProcessor process.TransactionLogProcessor,
+	executionTracer process.ExecutionTracer,
 ) (transactionEvaluator.ArgsTxSimulator, process.VirtualMachinesContainerFactory, process.TxTypeHandler, error) {
 	args := transactionEvaluator.ArgsTxSimulator{}
 
@@ -137,6 +194,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 		pcf.config.SmartContractsStorageSimulate,
 		builtInFuncFactory.NFTStorageHandler(),
 		builtInFuncFactory.ESDTGlobalSettingsHandler(),
+		executionTracer,
 	)
 	if err != nil {
 		return args, nil, nil, err
@@ -193,6 +251,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 		EnableRoundsHandler: pcf.coreData.EnableRoundsHandler(),
 		BadTxForwarder:      badTxInterim,
 		VMOutputCacher:      vmOutputCacher,
+		ExecutionTracer:     executionTracer,
 		WasmVMChangeLocker:  pcf.coreData.WasmVMChangeLocker(),
 		IsGenesisProcessing: false,
 	}
@@ -249,6 +308,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 	accountsAdapter state.AccountsAdapter,
 	vmOutputCacher storage.Cacher,
 	txLogsProcessor process.TransactionLogProcessor,
+	executionTracer process.ExecutionTracer,
 ) (transactionEvaluator.ArgsTxSimulator, process.VirtualMachinesContainerFactory, process.TxTypeHandler, error) {
 	args := transactionEvaluator.ArgsTxSimulator{}
 
@@ -299,6 +359,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 		smartContractStorageSimulate,
 		builtInFuncFactory.NFTStorageHandler(),
 		builtInFuncFactory.ESDTGlobalSettingsHandler(),
+		executionTracer,
 	)
 	if err != nil {
 		return args, nil, nil, err
@@ -367,6 +428,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 		EnableRoundsHandler: pcf.coreData.EnableRoundsHandler(),
 		BadTxForwarder:      badTxInterim,
 		VMOutputCacher:      vmOutputCacher,
+		ExecutionTracer:     executionTracer,
 		WasmVMChangeLocker:  pcf.coreData.WasmVMChangeLocker(),
 		IsGenesisProcessing: false,
 	}
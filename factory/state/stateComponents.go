@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
 	"github.com/multiversx/mx-chain-go/state/disabled"
 	factoryState "github.com/multiversx/mx-chain-go/state/factory"
 	"github.com/multiversx/mx-chain-go/state/iteratorChannelsProvider"
@@ -18,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/state/storagePruningManager"
 	"github.com/multiversx/mx-chain-go/state/storagePruningManager/evictionWaitingList"
 	"github.com/multiversx/mx-chain-go/state/syncer"
+	"github.com/multiversx/mx-chain-go/trie"
 	trieFactory "github.com/multiversx/mx-chain-go/trie/factory"
 )
 
@@ -53,6 +55,7 @@ type stateComponents struct {
 	triesContainer           common.TriesHolder
 	trieStorageManagers      map[string]common.StorageManager
 	missingTrieNodesNotifier common.MissingTrieNodesNotifier
+	trieGarbageCollector     state.TrieGarbageCollector
 }
 
 // NewStateComponentsFactory will return a new instance of stateComponentsFactory
@@ -90,7 +93,12 @@ func (scf *stateComponentsFactory) Create() (*stateComponents, error) {
 		return nil, err
 	}
 
-	accountsAdapter, accountsAdapterAPI, accountsRepository, err := scf.createAccountsAdapters(triesContainer)
+	trieGarbageCollector, err := scf.createTrieGarbageCollector()
+	if err != nil {
+		return nil, err
+	}
+
+	accountsAdapter, accountsAdapterAPI, accountsRepository, err := scf.createAccountsAdapters(triesContainer, trieGarbageCollector)
 	if err != nil {
 		return nil, err
 	}
@@ -108,13 +116,43 @@ func (scf *stateComponentsFactory) Create() (*stateComponents, error) {
 		triesContainer:           triesContainer,
 		trieStorageManagers:      trieStorageManagers,
 		missingTrieNodesNotifier: syncer.NewMissingTrieNodesNotifier(),
+		trieGarbageCollector:     trieGarbageCollector,
 	}, nil
 }
 
+func (scf *stateComponentsFactory) createTrieGarbageCollector() (state.TrieGarbageCollector, error) {
+	if !scf.config.TrieGarbageCollection.Enabled {
+		return nil, nil
+	}
+
+	storer, err := scf.storageService.GetStorer(dataRetriever.UserAccountsUnit)
+	if err != nil {
+		return nil, err
+	}
+	oldEpochsStorer, ok := storer.(trie.OldEpochsStorer)
+	if !ok {
+		return nil, fmt.Errorf("%w for the trie garbage collection", errors.ErrWrongTypeAssertion)
+	}
+
+	accountRootHashParser, err := accounts.NewAccountRootHashParser(scf.core.InternalMarshalizer())
+	if err != nil {
+		return nil, err
+	}
+
+	return trie.NewBackgroundGarbageCollector(trie.ArgsBackgroundGarbageCollector{
+		Config:                scf.config.TrieGarbageCollection,
+		Marshaller:            scf.core.InternalMarshalizer(),
+		Hasher:                scf.core.Hasher(),
+		AccountRootHashParser: accountRootHashParser,
+		Storer:                oldEpochsStorer,
+	})
+}
+
 func (scf *stateComponentsFactory) createSnapshotManager(
 	accountFactory state.AccountFactory,
 	stateMetrics state.StateMetrics,
 	iteratorChannelsProvider state.IteratorChannelsProvider,
+	trieGarbageCollector state.TrieGarbageCollector,
 ) (state.SnapshotsManager, error) {
 	if !scf.config.StateTriesConfig.SnapshotsEnabled {
 		return disabled.NewDisabledSnapshotsManager(), nil
@@ -131,11 +169,15 @@ func (scf *stateComponentsFactory) createSnapshotManager(
 		AccountFactory:           accountFactory,
 		LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
 		StateStatsHandler:        scf.statusCore.StateStatsHandler(),
+		TrieGarbageCollector:     trieGarbageCollector,
 	}
 	return state.NewSnapshotsManager(argsSnapshotsManager)
 }
 
-func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.TriesHolder) (state.AccountsAdapter, state.AccountsAdapter, state.AccountsRepository, error) {
+func (scf *stateComponentsFactory) createAccountsAdapters(
+	triesContainer common.TriesHolder,
+	trieGarbageCollector state.TrieGarbageCollector,
+) (state.AccountsAdapter, state.AccountsAdapter, state.AccountsRepository, error) {
 	argsAccCreator := factoryState.ArgsAccountCreator{
 		Hasher:              scf.core.Hasher(),
 		Marshaller:          scf.core.InternalMarshalizer(),
@@ -162,7 +204,7 @@ func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.
 		return nil, nil, nil, err
 	}
 
-	snapshotsManager, err := scf.createSnapshotManager(accountFactory, sm, iteratorChannelsProvider.NewUserStateIteratorChannelsProvider())
+	snapshotsManager, err := scf.createSnapshotManager(accountFactory, sm, iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(), trieGarbageCollector)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -238,7 +280,7 @@ func (scf *stateComponentsFactory) createPeerAdapter(triesContainer common.Tries
 		return nil, err
 	}
 
-	snapshotManager, err := scf.createSnapshotManager(accountFactory, sm, iteratorChannelsProvider.NewPeerStateIteratorChannelsProvider())
+	snapshotManager, err := scf.createSnapshotManager(accountFactory, sm, iteratorChannelsProvider.NewPeerStateIteratorChannelsProvider(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -305,6 +347,13 @@ func (pc *stateComponents) Close() error {
 		errString += fmt.Errorf("peerAccounts close failed: %w ", err).Error()
 	}
 
+	if !check.IfNil(pc.trieGarbageCollector) {
+		err = pc.trieGarbageCollector.Close()
+		if err != nil {
+			errString += fmt.Errorf("trieGarbageCollector close failed: %w ", err).Error()
+		}
+	}
+
 	tries := pc.triesContainer.GetAll()
 	for _, trie := range tries {
 		err = trie.Close()
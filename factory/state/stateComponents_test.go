@@ -125,6 +125,18 @@ func TestStateComponentsFactory_Create(t *testing.T) {
 		require.Error(t, err)
 		require.Nil(t, sc)
 	})
+	t.Run("trie garbage collection with unsupported storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetStateFactoryArgs(coreComponents, componentsMock.GetStatusCoreComponents())
+		args.Config.TrieGarbageCollection.Enabled = true
+		scf, _ := stateComp.NewStateComponentsFactory(args)
+
+		sc, err := scf.Create()
+		require.ErrorIs(t, err, errors.ErrWrongTypeAssertion)
+		require.Nil(t, sc)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -151,6 +151,11 @@ func (uam *UserAccountMock) SaveDirtyData(_ common.Trie) ([]core.TrieData, error
 	return nil, nil
 }
 
+// GetDirtyDataKeys -
+func (uam *UserAccountMock) GetDirtyDataKeys() [][]byte {
+	return nil
+}
+
 // IsGuarded -
 func (uam *UserAccountMock) IsGuarded() bool {
 	return false
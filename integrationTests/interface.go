@@ -80,6 +80,7 @@ type Facade interface {
 	GetDelegatorsList() ([]*dataApi.Delegator, error)
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetTokenSupply(token string) (*dataApi.ESDTSupply, error)
+	QueryEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error)
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
@@ -90,7 +91,12 @@ type Facade interface {
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionExecution(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceExecutedTransaction(txHash string) (*txSimData.TransactionTrace, error)
+	ReplayTransaction(txHash string) (*txSimData.ReplayResults, error)
+	GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
@@ -103,6 +109,15 @@ type Facade interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProof(rootHash string, addresses []string, values []string, proof [][]byte) (bool, error)
+	GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyRangeProof(rootHash string, address string, startKey string, endKey string, proof [][]byte) (bool, []core.KeyValueHolder, error)
+	GetAbsenceProof(rootHash string, address string) (*common.GetProofResponse, error)
+	GetAbsenceProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyAbsenceProof(rootHash string, address string, proof [][]byte) (bool, error)
+	VerifyAbsenceProofDataTrie(dataTrieRootHash string, key string, proof [][]byte) (bool, error)
+	GetTrieStorageReport(rootHash string, numLargestDataTries int) (*common.TrieStorageReport, error)
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalances() ([]*common.InitialAccountAPI, error)
 	GetGasConfigs() (map[string]map[string]uint64, error)
@@ -110,6 +125,12 @@ type Facade interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionStatus(hash string) (*common.TransactionStatusApiResponse, error)
+	GetTransactionsPoolSenderScore(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error)
+	GetTransactionsPoolSelectionPreview(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error)
+	EnqueueTransaction(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error)
+	GetTransactionsQueueState() *common.TransactionsQueueStateApiResponse
+	CheckTransaction(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error)
 	GetAlteredAccountsForBlock(options dataApi.GetAlteredAccountsForBlockOptions) ([]*alteredAccount.AlteredAccount, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
 	GetManagedKeysCount() int
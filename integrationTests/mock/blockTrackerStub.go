@@ -34,6 +34,7 @@ type BlockTrackerStub struct {
 	RegisterSelfNotarizedHeadersHandlerCalled          func(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
 	RegisterFinalMetachainHeadersHandlerCalled         func(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
 	RemoveLastNotarizedHeadersCalled                   func()
+	RemoveHeadersHigherThanNonceCalled                 func(shardID uint32, nonce uint64)
 	RestoreToGenesisCalled                             func()
 	ShouldAddHeaderCalled                              func(headerHandler data.HeaderHandler) bool
 }
@@ -254,6 +255,13 @@ func (bts *BlockTrackerStub) RemoveLastNotarizedHeaders() {
 	}
 }
 
+// RemoveHeadersHigherThanNonce -
+func (bts *BlockTrackerStub) RemoveHeadersHigherThanNonce(shardID uint32, nonce uint64) {
+	if bts.RemoveHeadersHigherThanNonceCalled != nil {
+		bts.RemoveHeadersHigherThanNonceCalled(shardID, nonce)
+	}
+}
+
 // RestoreToGenesis -
 func (bts *BlockTrackerStub) RestoreToGenesis() {
 	if bts.RestoreToGenesisCalled != nil {
@@ -55,6 +55,7 @@ type ProcessComponentsStub struct {
 	CurrentEpochProviderInternal         process.CurrentNetworkEpochProviderHandler
 	ScheduledTxsExecutionHandlerInternal process.ScheduledTxsExecutionHandler
 	TxsSenderHandlerField                process.TxsSenderHandler
+	TxPoolJournalField                   process.TxPoolJournalHandler
 	HardforkTriggerField                 factory.HardforkTrigger
 	ProcessedMiniBlocksTrackerInternal   process.ProcessedMiniBlocksTracker
 	ReceiptsRepositoryInternal           factory.ReceiptsRepository
@@ -272,6 +273,11 @@ func (pcs *ProcessComponentsStub) TxsSenderHandler() process.TxsSenderHandler {
 	return pcs.TxsSenderHandlerField
 }
 
+// TxPoolJournal -
+func (pcs *ProcessComponentsStub) TxPoolJournal() process.TxPoolJournalHandler {
+	return pcs.TxPoolJournalField
+}
+
 // HardforkTrigger -
 func (pcs *ProcessComponentsStub) HardforkTrigger() factory.HardforkTrigger {
 	return pcs.HardforkTriggerField
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/versioning"
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
@@ -14,13 +15,19 @@ import (
 	"github.com/multiversx/mx-chain-go/integrationTests/mock"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/external/blockAPI"
+	"github.com/multiversx/mx-chain-go/node/external/mempoolAPI"
 	"github.com/multiversx/mx-chain-go/node/external/transactionAPI"
+	"github.com/multiversx/mx-chain-go/node/external/transactionCheckAPI"
 	"github.com/multiversx/mx-chain-go/node/trieIterators"
 	"github.com/multiversx/mx-chain-go/node/trieIterators/factory"
 	"github.com/multiversx/mx-chain-go/process/coordinator"
+	"github.com/multiversx/mx-chain-go/process/smartContract"
 	"github.com/multiversx/mx-chain-go/process/smartContract/builtInFunctions"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/process/transactionEvaluator"
+	"github.com/multiversx/mx-chain-go/process/txsSender"
 	"github.com/multiversx/mx-chain-go/process/txstatus"
+	"github.com/multiversx/mx-chain-go/state/blockInfoProviders"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
@@ -108,7 +115,7 @@ func createTestApiConfig() config.ApiRoutesConfig {
 		"log":         {"/log"},
 		"validator":   {"/statistics"},
 		"vm-values":   {"/hex", "/string", "/int", "/query"},
-		"transaction": {"/send", "/simulate", "/send-multiple", "/cost", "/:txhash", "/pool"},
+		"transaction": {"/send", "/simulate", "/simulate-bundle", "/trace", "/trace/:txhash", "/replay/:txhash", "/send-multiple", "/cost", "/:txhash", "/pool"},
 		"block":       {"/by-nonce/:nonce", "/by-hash/:hash", "/by-round/:round"},
 	}
 
@@ -170,6 +177,9 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	dataFieldParser, err := datafield.NewOperationDataFieldParser(argsDataFieldParser)
 	log.LogIfError(err)
 
+	executionTracer, err := tracing.NewExecutionTracer(TestAddressPubkeyConverter)
+	log.LogIfError(err)
+
 	argSimulator := transactionEvaluator.ArgsTxSimulator{
 		TransactionProcessor:      tpn.TxProcessor,
 		IntermediateProcContainer: tpn.InterimProcContainer,
@@ -180,6 +190,7 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		VMOutputCacher:            &testscommon.CacherMock{},
 		DataFieldParser:           dataFieldParser,
 		BlockChainHook:            tpn.BlockchainHook,
+		ExecutionTracer:           executionTracer,
 	}
 
 	txSimulator, err := transactionEvaluator.NewTransactionSimulator(argSimulator)
@@ -188,6 +199,9 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	wrappedAccounts, err := transactionEvaluator.NewSimulationAccountsDB(tpn.AccntState)
 	log.LogIfError(err)
 
+	blockInfoProvider, err := blockInfoProviders.NewSettableBlockInfo(tpn.BlockChain)
+	log.LogIfError(err)
+
 	argsTransactionEvaluator := transactionEvaluator.ArgsApiTransactionEvaluator{
 		TxTypeHandler:       txTypeHandler,
 		FeeHandler:          tpn.EconomicsData,
@@ -196,6 +210,11 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		ShardCoordinator:    tpn.ShardCoordinator,
 		EnableEpochsHandler: tpn.EnableEpochsHandler,
 		BlockChain:          tpn.BlockChain,
+		BlockInfoSetter:     blockInfoProvider,
+		StorageService:      tpn.Storage,
+		Marshaller:          TestMarshalizer,
+		AccountsRepository:  &state.AccountsRepositoryStub{},
+		PubkeyConverter:     TestAddressPubkeyConverter,
 	}
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(argsTransactionEvaluator)
 	log.LogIfError(err)
@@ -239,6 +258,39 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	apiTransactionHandler, err := transactionAPI.NewAPITransactionProcessor(argsApiTransactionProc)
 	log.LogIfError(err)
 
+	argsMempoolInspector := mempoolAPI.ArgsMempoolInspector{
+		DataPool:               tpn.DataPool,
+		ShardCoordinator:       tpn.ShardCoordinator,
+		AddressPubKeyConverter: TestAddressPubkeyConverter,
+		EconomicsHandler:       tpn.EconomicsData,
+		AccountsRepository:     &state.AccountsRepositoryStub{},
+	}
+	mempoolInspector, err := mempoolAPI.NewMempoolInspector(argsMempoolInspector)
+	log.LogIfError(err)
+
+	argsTransactionChecker := transactionCheckAPI.ArgsTransactionChecker{
+		ShardCoordinator:       tpn.ShardCoordinator,
+		AddressPubKeyConverter: TestAddressPubkeyConverter,
+		FeeHandler:             tpn.EconomicsData,
+		Accounts:               tpn.AccntState,
+		GuardianChecker:        tpn.GuardedAccountHandler,
+		TxVersionChecker:       versioning.NewTxVersionChecker(tpn.MinTransactionVersion),
+		EnableEpochsHandler:    tpn.EnableEpochsHandler,
+		Marshaller:             TestMarshalizer,
+		Hasher:                 TestHasher,
+		TxSignMarshaller:       TestTxSignMarshalizer,
+		TxSignHasher:           TestTxSignHasher,
+		KeyGen:                 tpn.OwnAccount.KeygenTxSign,
+		SingleSigner:           tpn.OwnAccount.SingleSigner,
+		ArgumentsParser:        smartContract.NewArgumentParser(),
+		BuiltInFunctions:       builtInFuncs.BuiltInFunctionContainer(),
+		ESDTTransferParser:     esdtTransferParser,
+		ChainID:                tpn.ChainID,
+		MinTxVersion:           tpn.MinTransactionVersion,
+	}
+	transactionChecker, err := transactionCheckAPI.NewTransactionChecker(argsTransactionChecker)
+	log.LogIfError(err)
+
 	statusCom, err := txstatus.NewStatusComputer(tpn.ShardCoordinator.SelfId(), TestUint64Converter, tpn.Storage)
 	log.LogIfError(err)
 
@@ -273,6 +325,9 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
 		APITransactionHandler:    apiTransactionHandler,
+		APIMempoolHandler:        mempoolInspector,
+		APITxsQueueHandler:       txsSender.NewDisabledTxsQueue(),
+		APITransactionChecker:    transactionChecker,
 		APIBlockHandler:          blockAPIHandler,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
 		GenesisNodesSetupHandler: &genesisMocks.NodesSetupStub{},
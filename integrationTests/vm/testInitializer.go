@@ -44,12 +44,14 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks/counters"
 	"github.com/multiversx/mx-chain-go/process/smartContract/processProxy"
 	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	syncDisabled "github.com/multiversx/mx-chain-go/process/sync/disabled"
 	"github.com/multiversx/mx-chain-go/process/transaction"
 	"github.com/multiversx/mx-chain-go/process/transactionEvaluator"
 	"github.com/multiversx/mx-chain-go/process/transactionLog"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/blockInfoProviders"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
@@ -63,6 +65,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/integrationtests"
 	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/multiversx/mx-chain-go/testscommon/txDataBuilder"
 	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts/defaults"
@@ -415,6 +418,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 		GasSchedule:              gasScheduleNotifier,
 		Counter:                  &testscommon.BlockChainHookCounterStub{},
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 
 	blockChainHook, _ := hooks.NewBlockChainHookImpl(args)
@@ -465,6 +469,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 		EnableEpochsHandler: enableEpochsHandler,
 		EnableRoundsHandler: enableRoundsHandler,
 		VMOutputCacher:      txcache.NewDisabledCache(),
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 		WasmVMChangeLocker:  wasmVMChangeLocker,
 	}
 
@@ -523,6 +528,7 @@ func CreateOneSCExecutorMockVM(accnts state.AccountsAdapter) vmcommon.VMExecutio
 		GasSchedule:              CreateMockGasScheduleNotifier(),
 		Counter:                  &testscommon.BlockChainHookCounterStub{},
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 	blockChainHook, _ := hooks.NewBlockChainHookImpl(args)
 	vm, _ := mock.NewOneSCExecutorMockVM(blockChainHook, integrationtests.TestHasher)
@@ -594,6 +600,7 @@ func CreateVMAndBlockchainHookAndDataPool(
 		GasSchedule:              gasSchedule,
 		Counter:                  counter,
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 
 	maxGasLimitPerBlock := uint64(0xFFFFFFFFFFFFFFFF)
@@ -683,6 +690,7 @@ func CreateVMAndBlockchainHookMeta(
 		GasSchedule:              gasSchedule,
 		Counter:                  &testscommon.BlockChainHookCounterStub{},
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 	}
 
 	economicsData, err := createEconomicsData(config.EnableEpochs{})
@@ -884,6 +892,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		EnableEpochsHandler: enableEpochsHandler,
 		WasmVMChangeLocker:  wasmVMChangeLocker,
 		VMOutputCacher:      txcache.NewDisabledCache(),
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 	}
 
 	scProcessorProxy, _ := processProxy.NewTestSmartContractProcessorProxy(argsNewSCProcessor, epochNotifierInstance)
@@ -979,6 +988,11 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		return nil, err
 	}
 
+	executionTracer, err := tracing.NewExecutionTracer(pubkeyConv)
+	if err != nil {
+		return nil, err
+	}
+
 	txSimulatorProcessorArgs := transactionEvaluator.ArgsTxSimulator{
 		AddressPubKeyConverter: pubkeyConv,
 		ShardCoordinator:       shardCoordinator,
@@ -987,9 +1001,11 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		Hasher:                 integrationtests.TestHasher,
 		DataFieldParser:        dataFieldParser,
 		BlockChainHook:         blockChainHook,
+		ExecutionTracer:        executionTracer,
 	}
 
 	argsNewSCProcessor.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
+	argsNewSCProcessor.ExecutionTracer = executionTracer
 	proxyProcessor, _ := processProxy.NewTestSmartContractProcessorProxy(argsNewSCProcessor, epochNotifierInstance)
 	argsNewTxProcessor.ScProcessor = proxyProcessor
 	argsNewTxProcessor.Accounts = simulationAccountsDB
@@ -1006,6 +1022,11 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		return nil, err
 	}
 
+	blockInfoProvider, err := blockInfoProviders.NewSettableBlockInfo(chainHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	argsTransactionEvaluator := transactionEvaluator.ArgsApiTransactionEvaluator{
 		TxTypeHandler:       txTypeHandler,
 		FeeHandler:          economicsData,
@@ -1014,6 +1035,11 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		ShardCoordinator:    shardCoordinator,
 		EnableEpochsHandler: argsNewSCProcessor.EnableEpochsHandler,
 		BlockChain:          chainHandler,
+		BlockInfoSetter:     blockInfoProvider,
+		StorageService:      disabled.NewChainStorer(),
+		Marshaller:          integrationtests.TestMarshalizer,
+		AccountsRepository:  &stateMock.AccountsRepositoryStub{},
+		PubkeyConverter:     pubkeyConv,
 	}
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(argsTransactionEvaluator)
 	if err != nil {
@@ -67,6 +67,12 @@ type simulator struct {
 	validatorsPrivateKeys  []crypto.PrivateKey
 	nodes                  map[uint32]process.NodeHandler
 	numOfShards            uint32
+	snapshots              map[string]*networkSnapshot
+	snapshotsOrder         []string
+	lastSnapshotID         uint64
+	impersonatedAddresses  impersonatedAddressesHolder
+	bypassTxSignatureCheck bool
+	generalConfig          *config.Config
 	mutex                  sync.RWMutex
 }
 
@@ -82,6 +88,9 @@ func NewChainSimulator(args ArgsChainSimulator) (*simulator, error) {
 		chanStopNodeProcess:    make(chan endProcess.ArgEndProcess),
 		mutex:                  sync.RWMutex{},
 		initialStakedKeys:      make(map[string]*dtos.BLSKey),
+		snapshots:              make(map[string]*networkSnapshot),
+		impersonatedAddresses:  components.NewImpersonatedAddresses(),
+		bypassTxSignatureCheck: args.BypassTxSignatureCheck,
 	}
 
 	err := instance.createChainHandlers(args)
@@ -159,6 +168,7 @@ func (s *simulator) createChainHandlers(args ArgsChainSimulator) error {
 
 	s.initialWalletKeys = outputConfigs.InitialWallets
 	s.validatorsPrivateKeys = outputConfigs.ValidatorsPrivateKeys
+	s.generalConfig = outputConfigs.Configs.GeneralConfig
 
 	log.Info("running the chain simulator with the following parameters",
 		"number of shards (including meta)", args.NumOfShards+1,
@@ -187,6 +197,7 @@ func (s *simulator) createTestNode(
 		ShardIDStr:             shardIDStr,
 		APIInterface:           args.ApiInterface,
 		BypassTxSignatureCheck: args.BypassTxSignatureCheck,
+		ImpersonatedAddresses:  s.impersonatedAddresses,
 		InitialRound:           args.InitialRound,
 		InitialNonce:           args.InitialNonce,
 		MinNodesPerShard:       args.MinNodesPerShard,
@@ -217,6 +228,10 @@ func (s *simulator) GenerateBlocksUntilEpochIsReached(targetEpoch int32) error {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
+	return s.generateBlocksUntilEpochIsReached(targetEpoch)
+}
+
+func (s *simulator) generateBlocksUntilEpochIsReached(targetEpoch int32) error {
 	maxNumberOfRounds := 10000
 	for idx := 0; idx < maxNumberOfRounds; idx++ {
 		s.incrementRoundOnAllValidators()
@@ -237,6 +252,61 @@ func (s *simulator) GenerateBlocksUntilEpochIsReached(targetEpoch int32) error {
 	return fmt.Errorf("exceeded rounds to generate blocks")
 }
 
+// JumpToRound will move all nodes to the provided round and will generate a block in that round. The rounds in between
+// are skipped, so the block timestamps and the epoch start trigger will behave as if the time has passed
+func (s *simulator) JumpToRound(targetRound int64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.jumpToRound(targetRound)
+}
+
+// AdvanceTime will move the simulated clock forward with the provided duration and will generate a block at the
+// resulting round. The duration is rounded up to a multiple of the round duration
+func (s *simulator) AdvanceTime(duration time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	roundHandler := s.nodes[core.MetachainShardId].GetCoreComponents().RoundHandler()
+	roundDuration := roundHandler.TimeDuration()
+	numRounds := int64(duration / roundDuration)
+	if duration%roundDuration != 0 {
+		numRounds++
+	}
+
+	return s.jumpToRound(roundHandler.Index() + numRounds)
+}
+
+func (s *simulator) jumpToRound(targetRound int64) error {
+	currentRound := s.nodes[core.MetachainShardId].GetCoreComponents().RoundHandler().Index()
+	if targetRound <= currentRound {
+		return fmt.Errorf("%w, current round: %d, target round: %d", errInvalidTargetRound, currentRound, targetRound)
+	}
+
+	for _, node := range s.handlers {
+		node.SetRound(targetRound)
+	}
+
+	return s.allNodesCreateBlocks()
+}
+
+// ForceChangeOfEpoch will force the change of the current epoch and will generate blocks until the new epoch
+// is reached on all nodes. The epoch start blocks are processed as usual, including rewards and validators shuffling
+func (s *simulator) ForceChangeOfEpoch() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	metachainNode := s.nodes[core.MetachainShardId]
+	err := metachainNode.ForceChangeOfEpoch()
+	if err != nil {
+		return fmt.Errorf("force change of epoch error: %w", err)
+	}
+
+	epoch := metachainNode.GetProcessComponents().EpochStartTrigger().Epoch()
+
+	return s.generateBlocksUntilEpochIsReached(int32(epoch + 1))
+}
+
 // ForceResetValidatorStatisticsCache will force the reset of the cache used for the validators statistics endpoint
 func (s *simulator) ForceResetValidatorStatisticsCache() error {
 	metachainNode := s.GetNodeHandler(core.MetachainShardId)
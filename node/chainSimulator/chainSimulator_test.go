@@ -3,6 +3,7 @@ package chainSimulator
 import (
 	"encoding/base64"
 	"math/big"
+	"sync"
 	"testing"
 	"time"
 
@@ -542,6 +543,287 @@ func TestSimulator_SendTransactions(t *testing.T) {
 	})
 }
 
+func TestSimulator_SnapshotAndRevert(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    100,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: false,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	oneEgld := big.NewInt(1000000000000000000)
+	initialMinting := big.NewInt(0).Mul(oneEgld, big.NewInt(100))
+	transferValue := big.NewInt(0).Mul(oneEgld, big.NewInt(5))
+
+	sender, err := chainSimulator.GenerateAndMintWalletAddress(0, initialMinting)
+	require.Nil(t, err)
+
+	receiver, err := chainSimulator.GenerateAndMintWalletAddress(1, initialMinting)
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	snapshotID, err := chainSimulator.Snapshot()
+	require.Nil(t, err)
+
+	metaNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	snapshotMetaNonce := metaNode.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+	snapshotRound := metaNode.GetCoreComponents().RoundHandler().Index()
+
+	tx := generateTransaction(sender.Bytes, 0, receiver.Bytes, transferValue, "", 50000)
+	_, err = chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 15)
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(5)
+	require.Nil(t, err)
+
+	expectedBalanceAfterTransfer := big.NewInt(0).Add(initialMinting, transferValue).String()
+	account, err := chainSimulator.GetAccount(receiver)
+	require.Nil(t, err)
+	require.Equal(t, expectedBalanceAfterTransfer, account.Balance)
+
+	err = chainSimulator.Revert("missing")
+	require.ErrorIs(t, err, errSnapshotNotFound)
+
+	err = chainSimulator.Revert(snapshotID)
+	require.Nil(t, err)
+	require.Equal(t, snapshotMetaNonce, metaNode.GetChainHandler().GetCurrentBlockHeader().GetNonce())
+	require.Equal(t, snapshotRound, metaNode.GetCoreComponents().RoundHandler().Index())
+
+	account, err = chainSimulator.GetAccount(receiver)
+	require.Nil(t, err)
+	require.Equal(t, initialMinting.String(), account.Balance)
+
+	account, err = chainSimulator.GetAccount(sender)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), account.Nonce)
+
+	// a transaction with the same nonce can be executed again after the revert
+	tx = generateTransaction(sender.Bytes, 0, receiver.Bytes, transferValue, "after revert", 100000)
+	_, err = chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 15)
+	require.Nil(t, err)
+
+	account, err = chainSimulator.GetAccount(receiver)
+	require.Nil(t, err)
+	require.Equal(t, expectedBalanceAfterTransfer, account.Balance)
+
+	// the snapshot can be used multiple times
+	err = chainSimulator.Revert(snapshotID)
+	require.Nil(t, err)
+
+	account, err = chainSimulator.GetAccount(receiver)
+	require.Nil(t, err)
+	require.Equal(t, initialMinting.String(), account.Balance)
+}
+
+func TestSimulator_TimeTravel(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: false,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch: core.OptionalUint64{
+			HasValue: true,
+			Value:    20,
+		},
+		ApiInterface:      api.NewNoApiInterface(),
+		MinNodesPerShard:  1,
+		MetaChainMinNodes: 1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.GenerateBlocks(5)
+	require.Nil(t, err)
+
+	metaNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	currentRound := metaNode.GetCoreComponents().RoundHandler().Index()
+
+	err = chainSimulator.JumpToRound(currentRound)
+	require.ErrorIs(t, err, errInvalidTargetRound)
+
+	timeToAdvance := 30 * 24 * time.Hour
+	err = chainSimulator.AdvanceTime(timeToAdvance)
+	require.Nil(t, err)
+
+	expectedRound := currentRound + int64(timeToAdvance/(time.Duration(roundDurationInMillis)*time.Millisecond))
+	for shardID := range chainSimulator.nodes {
+		header := chainSimulator.GetNodeHandler(shardID).GetChainHandler().GetCurrentBlockHeader()
+		require.Equal(t, uint64(expectedRound), header.GetRound())
+
+		expectedTimestamp := uint64(metaNode.GetCoreComponents().RoundHandler().TimeStamp().Unix())
+		require.Equal(t, expectedTimestamp, header.GetTimeStamp())
+	}
+
+	// the skipped rounds exceed the rounds per epoch, so the next blocks will start a new epoch
+	err = chainSimulator.GenerateBlocksUntilEpochIsReached(1)
+	require.Nil(t, err)
+
+	roundBeforeForcingEpoch := metaNode.GetCoreComponents().RoundHandler().Index()
+	err = chainSimulator.ForceChangeOfEpoch()
+	require.Nil(t, err)
+
+	for shardID := range chainSimulator.nodes {
+		currentEpoch := chainSimulator.GetNodeHandler(shardID).GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch()
+		require.Equal(t, uint32(2), currentEpoch)
+	}
+	require.Less(t, metaNode.GetCoreComponents().RoundHandler().Index()-roundBeforeForcingEpoch, int64(20))
+
+	// concurrent calls should not interleave the generated blocks, each one moving the network exactly one epoch ahead
+	numConcurrentCalls := 2
+	wg := sync.WaitGroup{}
+	wg.Add(numConcurrentCalls)
+	for i := 0; i < numConcurrentCalls; i++ {
+		go func() {
+			defer wg.Done()
+
+			errForce := chainSimulator.ForceChangeOfEpoch()
+			assert.Nil(t, errForce)
+		}()
+	}
+	wg.Wait()
+
+	for shardID := range chainSimulator.nodes {
+		currentEpoch := chainSimulator.GetNodeHandler(shardID).GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch()
+		require.Equal(t, uint32(2+numConcurrentCalls), currentEpoch)
+	}
+}
+
+func TestSimulator_ImpersonationAndAutoFilledTransactions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: false,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch: core.OptionalUint64{
+			HasValue: true,
+			Value:    20,
+		},
+		ApiInterface:      api.NewNoApiInterface(),
+		MinNodesPerShard:  1,
+		MetaChainMinNodes: 1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	oneEGLD := big.NewInt(1000000000000000000)
+	initialMinting := big.NewInt(0).Mul(oneEGLD, big.NewInt(100))
+	sender, err := chainSimulator.GenerateAndMintWalletAddress(0, initialMinting)
+	require.Nil(t, err)
+
+	receiver, err := chainSimulator.GenerateAndMintWalletAddress(1, big.NewInt(0))
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	// the private key of the sender is not known, so the transaction can not be signed
+	tx := &transaction.Transaction{
+		SndAddr: sender.Bytes,
+		RcvAddr: receiver.Bytes,
+		Value:   oneEGLD,
+	}
+	_, err = chainSimulator.SendAutoFilledTxAndGenerateBlockTilTxIsExecuted(tx, dtos.AutoFillOptions{FillNonce: true}, 3)
+	require.ErrorContains(t, err, "signature")
+
+	err = chainSimulator.Impersonate(sender.Bech32)
+	require.Nil(t, err)
+
+	tx = &transaction.Transaction{
+		SndAddr: sender.Bytes,
+		RcvAddr: receiver.Bytes,
+		Value:   oneEGLD,
+	}
+	err = chainSimulator.AutoFillTransaction(tx, dtos.AutoFillOptions{FillNonce: true})
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), tx.Nonce)
+	require.Equal(t, uint64(50000), tx.GasLimit)
+	require.Equal(t, []byte(configs.ChainID), tx.ChainID)
+	require.NotZero(t, tx.GasPrice)
+	require.NotZero(t, tx.Version)
+
+	_, err = chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 10)
+	require.Nil(t, err)
+
+	account, err := chainSimulator.GetAccount(receiver)
+	require.Nil(t, err)
+	require.Equal(t, oneEGLD.String(), account.Balance)
+
+	tx = &transaction.Transaction{
+		SndAddr: sender.Bytes,
+		RcvAddr: receiver.Bytes,
+		Value:   oneEGLD,
+	}
+	err = chainSimulator.AutoFillTransaction(tx, dtos.AutoFillOptions{FillNonce: true})
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), tx.Nonce)
+
+	// an explicit nonce 0 is kept when the nonce is not requested to be filled in
+	txWithExplicitNonce := &transaction.Transaction{
+		SndAddr: sender.Bytes,
+		RcvAddr: receiver.Bytes,
+		Value:   oneEGLD,
+	}
+	err = chainSimulator.AutoFillTransaction(txWithExplicitNonce, dtos.AutoFillOptions{})
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), txWithExplicitNonce.Nonce)
+	require.Equal(t, uint64(50000), txWithExplicitNonce.GasLimit)
+
+	err = chainSimulator.StopImpersonating(sender.Bech32)
+	require.Nil(t, err)
+
+	// the dummy signature is rejected by the interceptors, so the transaction is never executed
+	_, err = chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, 3)
+	require.NotNil(t, err)
+}
+
 func generateTransaction(sender []byte, nonce uint64, receiver []byte, value *big.Int, data string, gasLimit uint64) *transaction.Transaction {
 	minGasPrice := uint64(1000000000)
 	txVersion := uint32(1)
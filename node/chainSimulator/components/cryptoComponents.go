@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing/disabled/singlesig"
 	"github.com/multiversx/mx-chain-go/common"
@@ -24,6 +25,7 @@ type ArgsCryptoComponentsHolder struct {
 	CoreComponentsHolder        factory.CoreComponentsHolder
 	AllValidatorKeysPemFileName string
 	BypassTxSignatureCheck      bool
+	ImpersonatedAddresses       ImpersonatedAddressesHandler
 }
 
 type cryptoComponentsHolder struct {
@@ -114,6 +116,10 @@ func CreateCryptoComponents(args ArgsCryptoComponentsHolder) (*cryptoComponentsH
 		instance.txSingleSigner = managedCryptoComponents.TxSingleSigner()
 	}
 
+	if !check.IfNil(args.ImpersonatedAddresses) {
+		instance.txSingleSigner = newImpersonationSingleSigner(instance.txSingleSigner, args.ImpersonatedAddresses)
+	}
+
 	return instance, nil
 }
 
@@ -0,0 +1,43 @@
+package components
+
+import "sync"
+
+type impersonatedAddresses struct {
+	mutAddresses sync.RWMutex
+	addresses    map[string]struct{}
+}
+
+// NewImpersonatedAddresses creates a new instance of the component holding the impersonated addresses
+func NewImpersonatedAddresses() *impersonatedAddresses {
+	return &impersonatedAddresses{
+		addresses: make(map[string]struct{}),
+	}
+}
+
+// Add will mark the provided address as impersonated
+func (ia *impersonatedAddresses) Add(address []byte) {
+	ia.mutAddresses.Lock()
+	ia.addresses[string(address)] = struct{}{}
+	ia.mutAddresses.Unlock()
+}
+
+// Remove will remove the provided address from the impersonated addresses
+func (ia *impersonatedAddresses) Remove(address []byte) {
+	ia.mutAddresses.Lock()
+	delete(ia.addresses, string(address))
+	ia.mutAddresses.Unlock()
+}
+
+// IsImpersonated returns true if the provided address is impersonated
+func (ia *impersonatedAddresses) IsImpersonated(address []byte) bool {
+	ia.mutAddresses.RLock()
+	_, found := ia.addresses[string(address)]
+	ia.mutAddresses.RUnlock()
+
+	return found
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ia *impersonatedAddresses) IsInterfaceNil() bool {
+	return ia == nil
+}
@@ -0,0 +1,29 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewImpersonatedAddresses(t *testing.T) {
+	t.Parallel()
+
+	addresses := NewImpersonatedAddresses()
+	require.False(t, addresses.IsInterfaceNil())
+}
+
+func TestImpersonatedAddresses_AddRemove(t *testing.T) {
+	t.Parallel()
+
+	providedAddress := []byte("address")
+	addresses := NewImpersonatedAddresses()
+	require.False(t, addresses.IsImpersonated(providedAddress))
+
+	addresses.Add(providedAddress)
+	require.True(t, addresses.IsImpersonated(providedAddress))
+	require.False(t, addresses.IsImpersonated([]byte("other address")))
+
+	addresses.Remove(providedAddress)
+	require.False(t, addresses.IsImpersonated(providedAddress))
+}
@@ -0,0 +1,36 @@
+package components
+
+import (
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
+
+// impersonationSingleSigner skips the signature verification for the impersonated addresses and delegates the
+// verification to the wrapped signer for all the other addresses
+type impersonationSingleSigner struct {
+	crypto.SingleSigner
+	impersonatedAddresses ImpersonatedAddressesHandler
+}
+
+func newImpersonationSingleSigner(singleSigner crypto.SingleSigner, impersonatedAddresses ImpersonatedAddressesHandler) *impersonationSingleSigner {
+	return &impersonationSingleSigner{
+		SingleSigner:          singleSigner,
+		impersonatedAddresses: impersonatedAddresses,
+	}
+}
+
+// Verify returns nil if the public key belongs to an impersonated address, otherwise it verifies the signature
+func (signer *impersonationSingleSigner) Verify(public crypto.PublicKey, msg []byte, sig []byte) error {
+	if public != nil {
+		address, err := public.ToByteArray()
+		if err == nil && signer.impersonatedAddresses.IsImpersonated(address) {
+			return nil
+		}
+	}
+
+	return signer.SingleSigner.Verify(public, msg, sig)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (signer *impersonationSingleSigner) IsInterfaceNil() bool {
+	return signer == nil
+}
@@ -0,0 +1,43 @@
+package components
+
+import (
+	"errors"
+	"testing"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpersonationSingleSigner_Verify(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	impersonatedAddress := []byte("impersonated")
+	impersonatedAddresses := NewImpersonatedAddresses()
+	impersonatedAddresses.Add(impersonatedAddress)
+
+	signer := newImpersonationSingleSigner(&cryptoMocks.SingleSignerStub{
+		VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			return expectedErr
+		},
+	}, impersonatedAddresses)
+	require.False(t, signer.IsInterfaceNil())
+
+	err := signer.Verify(&cryptoMocks.PublicKeyStub{
+		ToByteArrayStub: func() ([]byte, error) {
+			return impersonatedAddress, nil
+		},
+	}, []byte("msg"), []byte("sig"))
+	require.NoError(t, err)
+
+	err = signer.Verify(&cryptoMocks.PublicKeyStub{
+		ToByteArrayStub: func() ([]byte, error) {
+			return []byte("other address"), nil
+		},
+	}, []byte("msg"), []byte("sig"))
+	require.Equal(t, expectedErr, err)
+
+	err = signer.Verify(nil, []byte("msg"), []byte("sig"))
+	require.Equal(t, expectedErr, err)
+}
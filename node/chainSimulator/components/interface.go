@@ -16,3 +16,9 @@ type SyncedBroadcastNetworkHandler interface {
 type APIConfigurator interface {
 	RestApiInterface(shardID uint32) string
 }
+
+// ImpersonatedAddressesHandler defines what a component holding the impersonated addresses should be able to do
+type ImpersonatedAddressesHandler interface {
+	IsImpersonated(address []byte) bool
+	IsInterfaceNil() bool
+}
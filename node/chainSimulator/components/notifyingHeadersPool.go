@@ -0,0 +1,125 @@
+package components
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+)
+
+// notifyingHeadersPool wraps a headers pool, keeping track of the handlers notified about the added headers. The
+// handlers are still called on separate go routines, the same way the wrapped pool does, but their completion can be
+// awaited, so that the components notified (e.g. the block tracker) reached a stable state
+type notifyingHeadersPool struct {
+	dataRetriever.HeadersPool
+
+	mutAdd      sync.Mutex
+	mutHandlers sync.RWMutex
+	handlers    []func(headerHandler data.HeaderHandler, headerHash []byte)
+
+	mutPending      sync.Mutex
+	pendingDone     *sync.Cond
+	numPendingCalls int
+}
+
+// NewNotifyingHeadersPool creates a new instance of type notifyingHeadersPool
+func NewNotifyingHeadersPool(headersPool dataRetriever.HeadersPool) (*notifyingHeadersPool, error) {
+	if check.IfNil(headersPool) {
+		return nil, dataRetriever.ErrNilHeadersDataPool
+	}
+
+	pool := &notifyingHeadersPool{
+		HeadersPool: headersPool,
+		handlers:    make([]func(headerHandler data.HeaderHandler, headerHash []byte), 0),
+	}
+	pool.pendingDone = sync.NewCond(&pool.mutPending)
+
+	return pool, nil
+}
+
+// AddHeader adds the header in the wrapped pool and notifies the registered handlers if the header was not found in pool
+func (pool *notifyingHeadersPool) AddHeader(headerHash []byte, header data.HeaderHandler) {
+	pool.mutAdd.Lock()
+	defer pool.mutAdd.Unlock()
+
+	_, err := pool.HeadersPool.GetHeaderByHash(headerHash)
+	wasInPool := err == nil
+
+	pool.HeadersPool.AddHeader(headerHash, header)
+	if wasInPool {
+		return
+	}
+
+	_, err = pool.HeadersPool.GetHeaderByHash(headerHash)
+	if err != nil {
+		return
+	}
+
+	pool.notifyHandlers(header, headerHash)
+}
+
+func (pool *notifyingHeadersPool) notifyHandlers(header data.HeaderHandler, headerHash []byte) {
+	pool.mutHandlers.RLock()
+	defer pool.mutHandlers.RUnlock()
+
+	for _, handler := range pool.handlers {
+		pool.changeNumPendingCalls(1)
+		go func(handler func(headerHandler data.HeaderHandler, headerHash []byte)) {
+			defer pool.changeNumPendingCalls(-1)
+
+			handler(header, headerHash)
+		}(handler)
+	}
+}
+
+func (pool *notifyingHeadersPool) changeNumPendingCalls(delta int) {
+	pool.mutPending.Lock()
+	pool.numPendingCalls += delta
+	if pool.numPendingCalls == 0 {
+		pool.pendingDone.Broadcast()
+	}
+	pool.mutPending.Unlock()
+}
+
+// RegisterHandler registers a new handler to be called when a new header is added
+func (pool *notifyingHeadersPool) RegisterHandler(handler func(headerHandler data.HeaderHandler, headerHash []byte)) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler to the headers pool")
+		return
+	}
+
+	pool.mutHandlers.Lock()
+	pool.handlers = append(pool.handlers, handler)
+	pool.mutHandlers.Unlock()
+}
+
+// WaitForPendingNotifications blocks until all the handlers notified about the added headers returned
+func (pool *notifyingHeadersPool) WaitForPendingNotifications() {
+	pool.mutPending.Lock()
+	defer pool.mutPending.Unlock()
+
+	for pool.numPendingCalls > 0 {
+		pool.pendingDone.Wait()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pool *notifyingHeadersPool) IsInterfaceNil() bool {
+	return pool == nil
+}
+
+type poolsHolderWithNotifyingHeaders struct {
+	dataRetriever.PoolsHolder
+	headers *notifyingHeadersPool
+}
+
+// Headers returns the headers pool which keeps track of the notified handlers
+func (holder *poolsHolderWithNotifyingHeaders) Headers() dataRetriever.HeadersPool {
+	return holder.headers
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *poolsHolderWithNotifyingHeaders) IsInterfaceNil() bool {
+	return holder == nil
+}
@@ -0,0 +1,124 @@
+package components
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/dataPool/headersCache"
+	"github.com/stretchr/testify/require"
+)
+
+func createHeadersPool(t *testing.T) dataRetriever.HeadersPool {
+	pool, err := headersCache.NewHeadersPool(config.HeadersPoolConfig{
+		MaxHeadersPerShard:            100,
+		NumElementsToRemoveOnEviction: 1,
+	})
+	require.NoError(t, err)
+
+	return pool
+}
+
+func TestNewNotifyingHeadersPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil headers pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := NewNotifyingHeadersPool(nil)
+		require.Equal(t, dataRetriever.ErrNilHeadersDataPool, err)
+		require.Nil(t, pool)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := NewNotifyingHeadersPool(createHeadersPool(t))
+		require.NoError(t, err)
+		require.NotNil(t, pool)
+	})
+}
+
+func TestNotifyingHeadersPool_AddHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should wait for the notified handlers", func(t *testing.T) {
+		t.Parallel()
+
+		pool, _ := NewNotifyingHeadersPool(createHeadersPool(t))
+		release := make(chan struct{})
+		numCalls := uint32(0)
+		pool.RegisterHandler(func(_ data.HeaderHandler, _ []byte) {
+			<-release
+			atomic.AddUint32(&numCalls, 1)
+		})
+		pool.RegisterHandler(nil)
+
+		pool.AddHeader([]byte("hash"), &block.Header{Nonce: 1})
+
+		waitDone := make(chan struct{})
+		go func() {
+			pool.WaitForPendingNotifications()
+			close(waitDone)
+		}()
+
+		select {
+		case <-waitDone:
+			require.Fail(t, "should have waited for the pending handler")
+		default:
+		}
+
+		close(release)
+		<-waitDone
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+
+		header, err := pool.GetHeaderByHash([]byte("hash"))
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), header.GetNonce())
+	})
+	t.Run("header already in pool should not notify", func(t *testing.T) {
+		t.Parallel()
+
+		pool, _ := NewNotifyingHeadersPool(createHeadersPool(t))
+		pool.AddHeader([]byte("hash"), &block.Header{Nonce: 1})
+
+		numCalls := uint32(0)
+		pool.RegisterHandler(func(_ data.HeaderHandler, _ []byte) {
+			atomic.AddUint32(&numCalls, 1)
+		})
+
+		pool.AddHeader([]byte("hash"), &block.Header{Nonce: 1})
+		pool.AddHeader([]byte("other hash"), nil)
+		pool.WaitForPendingNotifications()
+		require.Zero(t, atomic.LoadUint32(&numCalls))
+	})
+	t.Run("no pending notifications should not block", func(t *testing.T) {
+		t.Parallel()
+
+		pool, _ := NewNotifyingHeadersPool(createHeadersPool(t))
+		pool.WaitForPendingNotifications()
+	})
+}
+
+func TestPoolsHolderWithNotifyingHeaders_Headers(t *testing.T) {
+	t.Parallel()
+
+	headersPool, _ := NewNotifyingHeadersPool(createHeadersPool(t))
+	holder := &poolsHolderWithNotifyingHeaders{
+		headers: headersPool,
+	}
+	require.True(t, holder.Headers() == headersPool)
+	require.False(t, holder.IsInterfaceNil())
+}
+
+func TestNotifyingHeadersPool_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var pool *notifyingHeadersPool
+	require.True(t, pool.IsInterfaceNil())
+
+	pool, _ = NewNotifyingHeadersPool(createHeadersPool(t))
+	require.False(t, pool.IsInterfaceNil())
+}
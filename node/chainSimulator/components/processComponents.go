@@ -93,6 +93,7 @@ type processComponentsHolder struct {
 	currentEpochProvider             process.CurrentNetworkEpochProviderHandler
 	scheduledTxsExecutionHandler     process.ScheduledTxsExecutionHandler
 	txsSenderHandler                 process.TxsSenderHandler
+	txPoolJournal                    process.TxPoolJournalHandler
 	hardforkTrigger                  factory.HardforkTrigger
 	processedMiniBlocksTracker       process.ProcessedMiniBlocksTracker
 	esdtDataStorageHandlerForAPI     vmcommon.ESDTNFTStorageHandler
@@ -266,6 +267,7 @@ func CreateProcessComponents(args ArgsProcessComponentsHolder) (*processComponen
 		currentEpochProvider:             managedProcessComponents.CurrentEpochProvider(),
 		scheduledTxsExecutionHandler:     managedProcessComponents.ScheduledTxsExecutionHandler(),
 		txsSenderHandler:                 managedProcessComponents.TxsSenderHandler(),
+		txPoolJournal:                    managedProcessComponents.TxPoolJournal(),
 		hardforkTrigger:                  managedProcessComponents.HardforkTrigger(),
 		processedMiniBlocksTracker:       managedProcessComponents.ProcessedMiniBlocksTracker(),
 		esdtDataStorageHandlerForAPI:     managedProcessComponents.ESDTDataStorageHandlerForAPI(),
@@ -458,6 +460,11 @@ func (p *processComponentsHolder) TxsSenderHandler() process.TxsSenderHandler {
 	return p.txsSenderHandler
 }
 
+// TxPoolJournal will return the journal of the transactions pool
+func (p *processComponentsHolder) TxPoolJournal() process.TxPoolJournalHandler {
+	return p.txPoolJournal
+}
+
 // HardforkTrigger will return the hardfork trigger
 func (p *processComponentsHolder) HardforkTrigger() factory.HardforkTrigger {
 	return p.hardforkTrigger
@@ -23,6 +23,10 @@ func CreateStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.UserAccountsUnit, CreateMemUnitForTries())
 	store.AddStorer(dataRetriever.PeerAccountsUnit, CreateMemUnitForTries())
 	store.AddStorer(dataRetriever.ESDTSuppliesUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.EventsByAddressUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.EventsByIdentifierUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.EventsByTopicUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.AccountsDiffsUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.RoundHdrHashDataUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.MiniblocksMetadataUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.MiniblockHashByTxHashUnit, CreateMemUnit())
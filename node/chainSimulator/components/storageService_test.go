@@ -30,6 +30,10 @@ func TestCreateStore(t *testing.T) {
 		dataRetriever.UserAccountsUnit,
 		dataRetriever.PeerAccountsUnit,
 		dataRetriever.ESDTSuppliesUnit,
+		dataRetriever.EventsByAddressUnit,
+		dataRetriever.EventsByIdentifierUnit,
+		dataRetriever.EventsByTopicUnit,
+		dataRetriever.AccountsDiffsUnit,
 		dataRetriever.RoundHdrHashDataUnit,
 		dataRetriever.MiniblocksMetadataUnit,
 		dataRetriever.MiniblockHashByTxHashUnit,
@@ -8,6 +8,7 @@ import (
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	chainData "github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/endProcess"
 	"github.com/multiversx/mx-chain-go/api/shared"
@@ -43,6 +44,7 @@ type ArgsTestOnlyProcessingNode struct {
 	NumShards              uint32
 	ShardIDStr             string
 	BypassTxSignatureCheck bool
+	ImpersonatedAddresses  ImpersonatedAddressesHandler
 	MinNodesPerShard       uint32
 	MinNodesMeta           uint32
 	RoundDurationInMillis  uint64
@@ -114,6 +116,7 @@ func NewTestOnlyProcessingNode(args ArgsTestOnlyProcessingNode) (*testOnlyProces
 		Preferences:                 *args.Configs.PreferencesConfig,
 		CoreComponentsHolder:        instance.CoreComponentsHolder,
 		BypassTxSignatureCheck:      args.BypassTxSignatureCheck,
+		ImpersonatedAddresses:       args.ImpersonatedAddresses,
 		AllValidatorKeysPemFileName: args.Configs.ConfigurationPathsHolder.AllValidatorKeys,
 	})
 	if err != nil {
@@ -255,8 +258,6 @@ func (node *testOnlyProcessingNode) createBlockChain(selfShardID uint32) error {
 }
 
 func (node *testOnlyProcessingNode) createDataPool(args ArgsTestOnlyProcessingNode) error {
-	var err error
-
 	argsDataPool := dataRetrieverFactory.ArgsDataPool{
 		Config:           args.Configs.GeneralConfig,
 		EconomicsData:    node.CoreComponentsHolder.EconomicsData(),
@@ -265,9 +266,23 @@ func (node *testOnlyProcessingNode) createDataPool(args ArgsTestOnlyProcessingNo
 		PathManager:      node.CoreComponentsHolder.PathHandler(),
 	}
 
-	node.DataPool, err = dataRetrieverFactory.NewDataPoolFromConfig(argsDataPool)
+	dataPool, err := dataRetrieverFactory.NewDataPoolFromConfig(argsDataPool)
+	if err != nil {
+		return err
+	}
+
+	// the notifications of the added headers are tracked, so they can be awaited when the chain simulator is reverted
+	headersPool, err := NewNotifyingHeadersPool(dataPool.Headers())
+	if err != nil {
+		return err
+	}
 
-	return err
+	node.DataPool = &poolsHolderWithNotifyingHeaders{
+		PoolsHolder: dataPool,
+		headers:     headersPool,
+	}
+
+	return nil
 }
 
 func (node *testOnlyProcessingNode) createNodesCoordinator(pref config.PreferencesConfig, generalConfig config.Config) error {
@@ -593,6 +608,18 @@ func (node *testOnlyProcessingNode) getUserAccount(address []byte) (state.UserAc
 	return userAccount, nil
 }
 
+// ForceChangeOfEpoch will force the epoch start trigger to start a new epoch on the next round
+func (node *testOnlyProcessingNode) ForceChangeOfEpoch() error {
+	currentHeader := node.ChainHandler.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		currentHeader = node.ChainHandler.GetGenesisHeader()
+	}
+
+	node.ProcessComponentsHolder.EpochStartTrigger().ForceEpochStart(currentHeader.GetRound() + 1)
+
+	return nil
+}
+
 // Close will call the Close methods on all inner components
 func (node *testOnlyProcessingNode) Close() error {
 	return node.closeHandler.Close()
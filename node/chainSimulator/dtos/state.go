@@ -13,3 +13,10 @@ type AddressState struct {
 	Owner            string            `json:"ownerAddress,omitempty"`
 	Keys             map[string]string `json:"keys,omitempty"`
 }
+
+// AutoFillOptions defines which fields of a transaction will be filled in by the chain simulator
+type AutoFillOptions struct {
+	// FillNonce will compute the nonce from the sender account and the transactions pool. When not set, the nonce of
+	// the provided transaction is used as it is, 0 included
+	FillNonce bool
+}
@@ -3,10 +3,19 @@ package chainSimulator
 import "errors"
 
 var (
-	errNilChainSimulator     = errors.New("nil chain simulator")
-	errNilMetachainNode      = errors.New("nil metachain node")
-	errShardSetupError       = errors.New("shard setup error")
-	errEmptySliceOfTxs       = errors.New("empty slice of transactions to send")
-	errNilTransaction        = errors.New("nil transaction")
-	errInvalidMaxNumOfBlocks = errors.New("invalid max number of blocks to generate")
+	errNilChainSimulator          = errors.New("nil chain simulator")
+	errNilMetachainNode           = errors.New("nil metachain node")
+	errShardSetupError            = errors.New("shard setup error")
+	errEmptySliceOfTxs            = errors.New("empty slice of transactions to send")
+	errNilTransaction             = errors.New("nil transaction")
+	errInvalidMaxNumOfBlocks      = errors.New("invalid max number of blocks to generate")
+	errSnapshotNotFound           = errors.New("snapshot not found")
+	errSnapshotFromDifferentEpoch = errors.New("cannot revert to a snapshot created in a different epoch")
+	errSnapshotHeaderNotReachable = errors.New("snapshot header is not reachable from the current block")
+	errWrongTypeAssertion         = errors.New("wrong type assertion")
+	errCannotComputeGasLimit      = errors.New("cannot compute the gas limit of the transaction")
+	errInvalidTargetRound         = errors.New("the target round should be higher than the current round")
+	errNoStateToFork              = errors.New("no accounts state found to fork")
+	errEmptyRootHash              = errors.New("empty root hash")
+	errValidatorsStateNotForkable = errors.New("the validators state can not be forked, the simulated network keeps its own validators")
 )
@@ -2,6 +2,7 @@ package chainSimulator
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/common"
@@ -52,3 +53,49 @@ func (f *chainSimulatorFacade) GetExistingAccountFromBech32AddressString(address
 
 	return account.(vmcommon.UserAccountHandler), nil
 }
+
+// Snapshot will capture the whole state of the simulated network and return the snapshot identifier
+func (f *chainSimulatorFacade) Snapshot() (string, error) {
+	return f.chainSimulator.Snapshot()
+}
+
+// Revert will restore the simulated network to the state captured by the snapshot with the provided identifier
+func (f *chainSimulatorFacade) Revert(id string) error {
+	return f.chainSimulator.Revert(id)
+}
+
+// JumpToRound will move the simulated network to the provided round and will generate a block in that round
+func (f *chainSimulatorFacade) JumpToRound(targetRound int64) error {
+	return f.chainSimulator.JumpToRound(targetRound)
+}
+
+// AdvanceTime will move the simulated clock forward with the provided duration
+func (f *chainSimulatorFacade) AdvanceTime(duration time.Duration) error {
+	return f.chainSimulator.AdvanceTime(duration)
+}
+
+// ForceChangeOfEpoch will force the simulated network to move into the next epoch
+func (f *chainSimulatorFacade) ForceChangeOfEpoch() error {
+	return f.chainSimulator.ForceChangeOfEpoch()
+}
+
+// Impersonate will disable the transactions signature check for the provided address
+func (f *chainSimulatorFacade) Impersonate(address string) error {
+	return f.chainSimulator.Impersonate(address)
+}
+
+// StopImpersonating will re-enable the transactions signature check for the provided address
+func (f *chainSimulatorFacade) StopImpersonating(address string) error {
+	return f.chainSimulator.StopImpersonating(address)
+}
+
+// ForkStateFromExport will copy the accounts state from the provided hardfork export folder into the simulated network
+func (f *chainSimulatorFacade) ForkStateFromExport(exportFolder string, includeMetachainAccounts bool) error {
+	return f.chainSimulator.ForkStateFromExport(exportFolder, includeMetachainAccounts)
+}
+
+// ForkStateFromDB will copy the accounts state from the accounts trie database found in the provided folder, at the
+// provided root hash, into the simulated network
+func (f *chainSimulatorFacade) ForkStateFromDB(dbFolder string, rootHash []byte, includeMetachainAccounts bool) error {
+	return f.chainSimulator.ForkStateFromDB(dbFolder, rootHash, includeMetachainAccounts)
+}
@@ -3,6 +3,7 @@ package chainSimulator
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/factory"
@@ -191,3 +192,142 @@ func TestChainSimulatorFacade_GetExistingAccountFromBech32AddressString(t *testi
 		require.True(t, handler == providedAccount) // pointer testing
 	})
 }
+
+func TestChainSimulatorFacade_SnapshotAndRevert(t *testing.T) {
+	t.Parallel()
+
+	snapshotCalled := false
+	revertCalled := false
+	facade, err := NewChainSimulatorFacade(&chainSimulator.ChainSimulatorMock{
+		GetNodeHandlerCalled: func(shardID uint32) process.NodeHandler {
+			return &chainSimulator.NodeHandlerMock{}
+		},
+		SnapshotCalled: func() (string, error) {
+			snapshotCalled = true
+			return "1", nil
+		},
+		RevertCalled: func(id string) error {
+			revertCalled = true
+			require.Equal(t, "1", id)
+			return expectedErr
+		},
+	})
+	require.NoError(t, err)
+
+	id, err := facade.Snapshot()
+	require.NoError(t, err)
+	require.Equal(t, "1", id)
+	require.True(t, snapshotCalled)
+
+	err = facade.Revert(id)
+	require.Equal(t, expectedErr, err)
+	require.True(t, revertCalled)
+}
+
+func TestChainSimulatorFacade_TimeTravel(t *testing.T) {
+	t.Parallel()
+
+	jumpToRoundCalled := false
+	advanceTimeCalled := false
+	forceChangeOfEpochCalled := false
+	facade, err := NewChainSimulatorFacade(&chainSimulator.ChainSimulatorMock{
+		GetNodeHandlerCalled: func(shardID uint32) process.NodeHandler {
+			return &chainSimulator.NodeHandlerMock{}
+		},
+		JumpToRoundCalled: func(targetRound int64) error {
+			jumpToRoundCalled = true
+			require.Equal(t, int64(100), targetRound)
+			return nil
+		},
+		AdvanceTimeCalled: func(duration time.Duration) error {
+			advanceTimeCalled = true
+			require.Equal(t, time.Hour, duration)
+			return nil
+		},
+		ForceChangeOfEpochCalled: func() error {
+			forceChangeOfEpochCalled = true
+			return expectedErr
+		},
+	})
+	require.NoError(t, err)
+
+	err = facade.JumpToRound(100)
+	require.NoError(t, err)
+	require.True(t, jumpToRoundCalled)
+
+	err = facade.AdvanceTime(time.Hour)
+	require.NoError(t, err)
+	require.True(t, advanceTimeCalled)
+
+	err = facade.ForceChangeOfEpoch()
+	require.Equal(t, expectedErr, err)
+	require.True(t, forceChangeOfEpochCalled)
+}
+
+func TestChainSimulatorFacade_Impersonation(t *testing.T) {
+	t.Parallel()
+
+	providedAddress := "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	impersonateCalled := false
+	stopImpersonatingCalled := false
+	facade, err := NewChainSimulatorFacade(&chainSimulator.ChainSimulatorMock{
+		GetNodeHandlerCalled: func(shardID uint32) process.NodeHandler {
+			return &chainSimulator.NodeHandlerMock{}
+		},
+		ImpersonateCalled: func(address string) error {
+			impersonateCalled = true
+			require.Equal(t, providedAddress, address)
+			return nil
+		},
+		StopImpersonatingCalled: func(address string) error {
+			stopImpersonatingCalled = true
+			require.Equal(t, providedAddress, address)
+			return expectedErr
+		},
+	})
+	require.NoError(t, err)
+
+	err = facade.Impersonate(providedAddress)
+	require.NoError(t, err)
+	require.True(t, impersonateCalled)
+
+	err = facade.StopImpersonating(providedAddress)
+	require.Equal(t, expectedErr, err)
+	require.True(t, stopImpersonatingCalled)
+}
+
+func TestChainSimulatorFacade_ForkState(t *testing.T) {
+	t.Parallel()
+
+	providedFolder := "export"
+	providedRootHash := []byte("root hash")
+	forkFromExportCalled := false
+	forkFromDBCalled := false
+	facade, err := NewChainSimulatorFacade(&chainSimulator.ChainSimulatorMock{
+		GetNodeHandlerCalled: func(shardID uint32) process.NodeHandler {
+			return &chainSimulator.NodeHandlerMock{}
+		},
+		ForkStateFromExportCalled: func(exportFolder string, includeMetachainAccounts bool) error {
+			forkFromExportCalled = true
+			require.Equal(t, providedFolder, exportFolder)
+			require.True(t, includeMetachainAccounts)
+			return nil
+		},
+		ForkStateFromDBCalled: func(dbFolder string, rootHash []byte, includeMetachainAccounts bool) error {
+			forkFromDBCalled = true
+			require.Equal(t, providedFolder, dbFolder)
+			require.Equal(t, providedRootHash, rootHash)
+			require.False(t, includeMetachainAccounts)
+			return expectedErr
+		},
+	})
+	require.NoError(t, err)
+
+	err = facade.ForkStateFromExport(providedFolder, true)
+	require.NoError(t, err)
+	require.True(t, forkFromExportCalled)
+
+	err = facade.ForkStateFromDB(providedFolder, providedRootHash, false)
+	require.Equal(t, expectedErr, err)
+	require.True(t, forkFromDBCalled)
+}
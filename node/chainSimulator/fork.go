@@ -0,0 +1,447 @@
+package chainSimulator
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"path"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	commonDisabled "github.com/multiversx/mx-chain-go/common/disabled"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/state"
+	disabledState "github.com/multiversx/mx-chain-go/state/disabled"
+	stateFactory "github.com/multiversx/mx-chain-go/state/factory"
+	"github.com/multiversx/mx-chain-go/state/parsers"
+	disabledPruning "github.com/multiversx/mx-chain-go/state/storagePruningManager/disabled"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/update"
+	hardfork "github.com/multiversx/mx-chain-go/update/genesis"
+	"github.com/multiversx/mx-chain-go/update/storing"
+	"github.com/multiversx/mx-chain-go/vm"
+)
+
+const forkedTrieMaxLevelInMemory = uint(5)
+
+// ForkStateFromExport will copy the accounts state found in the provided folder, created by the hardfork state export
+// mechanism, into the simulated network. The accounts are placed in the simulated shards by re-computing the shard of
+// each address, so the export may come from a network with a different number of shards. The metachain accounts (the
+// system smart contracts) are imported only if includeMetachainAccounts is set.
+// Only the user accounts are forked: the simulated network keeps running with its own validators, as the simulator can
+// not sign blocks on behalf of the forked network validators. The exported validators (peer accounts) state is ignored
+// and the staking and validator system smart contracts are never overwritten, as they have to stay consistent with the
+// simulated validators. The forked state can not be used to simulate the validators set, the staking or the rewards
+// of the forked network.
+// Everything is read from the disk, no network connection is required.
+func (s *simulator) ForkStateFromExport(exportFolder string, includeMetachainAccounts bool) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	importer, trieStorageManagers, err := s.createStateImport(exportFolder)
+	if err != nil {
+		return err
+	}
+	defer closeStorageManagers(trieStorageManagers)
+
+	// the hardfork storer is closed by the importer when the import ends
+	err = importer.ImportAll()
+	if err != nil {
+		return fmt.Errorf("%w while importing the state from %s", err, exportFolder)
+	}
+
+	numImported := 0
+	for shardID := uint32(0); shardID < core.MaxNumShards; shardID++ {
+		accountsAdapter := importer.GetAccountsDBForShard(shardID)
+		if check.IfNil(accountsAdapter) {
+			break
+		}
+
+		numImported++
+		err = s.forkAccounts(accountsAdapter, includeMetachainAccounts)
+		if err != nil {
+			return fmt.Errorf("%w for exported shard %d", err, shardID)
+		}
+	}
+
+	if includeMetachainAccounts {
+		accountsAdapter := importer.GetAccountsDBForShard(core.MetachainShardId)
+		if !check.IfNil(accountsAdapter) {
+			numImported++
+			err = s.forkAccounts(accountsAdapter, includeMetachainAccounts)
+			if err != nil {
+				return fmt.Errorf("%w for exported metachain", err)
+			}
+		}
+	}
+
+	if numImported == 0 {
+		return fmt.Errorf("%w in %s", errNoStateToFork, exportFolder)
+	}
+
+	return nil
+}
+
+// ForkStateFromDB will copy the accounts state found in the accounts trie database of a node, at the provided root hash,
+// into the simulated network. The database is opened with the AccountsTrieStorage configuration of the simulator from
+// the AccountsTrieStorage.DB.FilePath directory of the provided dbFolder, so dbFolder should be a copy of the node
+// directory holding the AccountsTrie directory (not the AccountsTrie directory itself) of a node running the same
+// storage setup. The same placement, metachain and validators rules as for ForkStateFromExport apply. A validators
+// (peer accounts) trie is rejected with errValidatorsStateNotForkable.
+func (s *simulator) ForkStateFromDB(dbFolder string, rootHash []byte, includeMetachainAccounts bool) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if common.IsEmptyTrie(rootHash) {
+		return errEmptyRootHash
+	}
+
+	storer, err := createForkStorer(s.generalConfig.AccountsTrieStorage, dbFolder)
+	if err != nil {
+		return fmt.Errorf("%w while opening the accounts trie database from %s", err, dbFolder)
+	}
+
+	trieStorageManager, err := s.createForkTrieStorageManager(storer, dataRetriever.UserAccountsUnit.String())
+	if err != nil {
+		_ = storer.Close()
+		return err
+	}
+	defer func() {
+		_ = trieStorageManager.Close()
+	}()
+
+	accountsAdapter, err := s.createForkAccountsAdapter(trieStorageManager)
+	if err != nil {
+		return err
+	}
+
+	err = accountsAdapter.RecreateTrie(rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while loading root hash %s", err, hex.EncodeToString(rootHash))
+	}
+
+	return s.forkAccounts(accountsAdapter, includeMetachainAccounts)
+}
+
+func (s *simulator) createStateImport(exportFolder string) (update.ImportHandler, map[string]common.StorageManager, error) {
+	metaNode := s.nodes[core.MetachainShardId]
+	coreComponents := metaNode.GetCoreComponents()
+	hardforkConfig := s.generalConfig.Hardfork
+
+	keysStorer, err := createForkStorer(hardforkConfig.ImportKeysStorageConfig, exportFolder)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while creating keys storer", err)
+	}
+	keysValuesStorer, err := createForkStorer(hardforkConfig.ImportStateStorageConfig, exportFolder)
+	if err != nil {
+		_ = keysStorer.Close()
+		return nil, nil, fmt.Errorf("%w while creating keys-values storer", err)
+	}
+
+	hardforkStorer, err := storing.NewHardforkStorer(storing.ArgHardforkStorer{
+		KeysStore:   keysStorer,
+		KeyValue:    keysValuesStorer,
+		Marshalizer: coreComponents.InternalMarshalizer(),
+	})
+	if err != nil {
+		_ = keysStorer.Close()
+		_ = keysValuesStorer.Close()
+		return nil, nil, fmt.Errorf("%w while creating hardfork storer", err)
+	}
+
+	userAccountsStorageManager, err := s.createForkTrieStorageManager(components.CreateMemUnitForTries(), dataRetriever.UserAccountsUnit.String())
+	if err != nil {
+		_ = hardforkStorer.Close()
+		return nil, nil, err
+	}
+	peerAccountsStorageManager, err := s.createForkTrieStorageManager(components.CreateMemUnitForTries(), dataRetriever.PeerAccountsUnit.String())
+	if err != nil {
+		_ = hardforkStorer.Close()
+		_ = userAccountsStorageManager.Close()
+		return nil, nil, err
+	}
+
+	trieStorageManagers := map[string]common.StorageManager{
+		dataRetriever.UserAccountsUnit.String(): userAccountsStorageManager,
+		dataRetriever.PeerAccountsUnit.String(): peerAccountsStorageManager,
+	}
+
+	importHandler, err := hardfork.NewStateImport(hardfork.ArgsNewStateImport{
+		Hasher:              coreComponents.Hasher(),
+		Marshalizer:         coreComponents.InternalMarshalizer(),
+		ShardID:             core.MetachainShardId,
+		StorageConfig:       hardforkConfig.ImportStateStorageConfig,
+		TrieStorageManagers: trieStorageManagers,
+		HardforkStorer:      hardforkStorer,
+		AddressConverter:    coreComponents.AddressPubKeyConverter(),
+		EnableEpochsHandler: coreComponents.EnableEpochsHandler(),
+	})
+	if err != nil {
+		_ = hardforkStorer.Close()
+		closeStorageManagers(trieStorageManagers)
+		return nil, nil, err
+	}
+
+	return importHandler, trieStorageManagers, nil
+}
+
+func closeStorageManagers(trieStorageManagers map[string]common.StorageManager) {
+	for identifier, trieStorageManager := range trieStorageManagers {
+		err := trieStorageManager.Close()
+		if err != nil {
+			log.Warn("error closing the forked trie storage manager", "identifier", identifier, "error", err)
+		}
+	}
+}
+
+func createForkStorer(storageConfig config.StorageConfig, folder string) (storage.Storer, error) {
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = path.Join(folder, storageConfig.DB.FilePath)
+
+	dbConfigHandler := storageFactory.NewDBConfigHandler(storageConfig.DB)
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+}
+
+func (s *simulator) createForkTrieStorageManager(storer storage.Storer, identifier string) (common.StorageManager, error) {
+	metaNode := s.nodes[core.MetachainShardId]
+
+	args := trie.NewTrieStorageManagerArgs{
+		MainStorer:     storer,
+		Marshalizer:    metaNode.GetCoreComponents().InternalMarshalizer(),
+		Hasher:         metaNode.GetCoreComponents().Hasher(),
+		GeneralConfig:  s.generalConfig.TrieStorageManagerConfig,
+		IdleProvider:   commonDisabled.NewProcessStatusHandler(),
+		Identifier:     identifier,
+		StatsCollector: metaNode.GetStatusCoreComponents().StateStatsHandler(),
+	}
+	options := trie.StorageManagerOptions{
+		PruningEnabled:   false,
+		SnapshotsEnabled: false,
+	}
+
+	return trie.CreateTrieStorageManager(args, options)
+}
+
+func (s *simulator) createForkAccountsAdapter(trieStorageManager common.StorageManager) (state.AccountsAdapter, error) {
+	coreComponents := s.nodes[core.MetachainShardId].GetCoreComponents()
+
+	mainTrie, err := trie.NewTrie(
+		trieStorageManager,
+		coreComponents.InternalMarshalizer(),
+		coreComponents.Hasher(),
+		coreComponents.EnableEpochsHandler(),
+		forkedTrieMaxLevelInMemory,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	accountFactory, err := s.createForkAccountFactory()
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDB(state.ArgsAccountsDB{
+		Trie:                  mainTrie,
+		Hasher:                coreComponents.Hasher(),
+		Marshaller:            coreComponents.InternalMarshalizer(),
+		AccountFactory:        accountFactory,
+		StoragePruningManager: disabledPruning.NewDisabledStoragePruningManager(),
+		AddressConverter:      coreComponents.AddressPubKeyConverter(),
+		SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+	})
+}
+
+func (s *simulator) createForkAccountFactory() (state.AccountFactory, error) {
+	coreComponents := s.nodes[core.MetachainShardId].GetCoreComponents()
+
+	return stateFactory.NewAccountCreator(stateFactory.ArgsAccountCreator{
+		Hasher:              coreComponents.Hasher(),
+		Marshaller:          coreComponents.InternalMarshalizer(),
+		EnableEpochsHandler: coreComponents.EnableEpochsHandler(),
+	})
+}
+
+func (s *simulator) forkAccounts(accountsAdapter state.AccountsAdapter, includeMetachainAccounts bool) error {
+	rootHash, err := accountsAdapter.RootHash()
+	if err != nil {
+		return err
+	}
+
+	accountFactory, err := s.createForkAccountFactory()
+	if err != nil {
+		return err
+	}
+
+	// the context is canceled on early returns so the trie iterator will not remain blocked on the leaves channel
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leavesChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err = accountsAdapter.GetAllLeaves(leavesChannels, ctx, rootHash, parsers.NewMainTrieLeafParser())
+	if err != nil {
+		return err
+	}
+
+	metaNode := s.nodes[core.MetachainShardId]
+	marshaller := metaNode.GetCoreComponents().InternalMarshalizer()
+	addressLength := metaNode.GetCoreComponents().AddressPubKeyConverter().Len()
+	shardCoordinator := metaNode.GetShardCoordinator()
+	numForked := 0
+	for leaf := range leavesChannels.LeavesChan {
+		// the validators trie leaves are keyed by the BLS public keys, which are longer than the addresses
+		if len(leaf.Key()) != addressLength {
+			return errValidatorsStateNotForkable
+		}
+		if isValidatorsSystemAccount(leaf.Key()) {
+			continue
+		}
+
+		account, errCreate := accountFactory.CreateAccount(leaf.Key())
+		if errCreate != nil {
+			return errCreate
+		}
+
+		// the main trie also holds the code leaves which can not be unmarshalled as accounts
+		errUnmarshal := marshaller.Unmarshal(account, leaf.Value())
+		if errUnmarshal != nil {
+			continue
+		}
+
+		isSystemAccount := bytes.Equal(leaf.Key(), core.SystemAccountAddress)
+		shardID := shardCoordinator.ComputeId(leaf.Key())
+		if shardID == core.MetachainShardId && !includeMetachainAccounts && !isSystemAccount {
+			continue
+		}
+
+		addressState, errConvert := s.loadAddressState(accountsAdapter, leaf.Key())
+		if errConvert != nil {
+			return errConvert
+		}
+
+		if isSystemAccount {
+			err = s.setStateSystemAccount(addressState)
+		} else {
+			err = s.nodes[shardID].SetStateForAddress(leaf.Key(), addressState)
+		}
+		if err != nil {
+			return fmt.Errorf("%w while forking account %s", err, addressState.Address)
+		}
+
+		numForked++
+	}
+
+	err = leavesChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return err
+	}
+
+	log.Debug("forked accounts into the chain simulator", "root hash", rootHash, "num accounts", numForked)
+
+	return nil
+}
+
+func isValidatorsSystemAccount(address []byte) bool {
+	return bytes.Equal(address, vm.StakingSCAddress) || bytes.Equal(address, vm.ValidatorSCAddress)
+}
+
+func (s *simulator) loadAddressState(accountsAdapter state.AccountsAdapter, address []byte) (*dtos.AddressState, error) {
+	account, err := accountsAdapter.GetExistingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, errWrongTypeAssertion
+	}
+
+	addressConverter := s.nodes[core.MetachainShardId].GetCoreComponents().AddressPubKeyConverter()
+	bech32Address, err := addressConverter.Encode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := getAccountKeys(userAccount)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the data trie of %s", err, bech32Address)
+	}
+
+	nonce := userAccount.GetNonce()
+	addressState := &dtos.AddressState{
+		Address:          bech32Address,
+		Nonce:            &nonce,
+		Balance:          userAccount.GetBalance().String(),
+		CodeMetadata:     base64.StdEncoding.EncodeToString(userAccount.GetCodeMetadata()),
+		DeveloperRewards: userAccount.GetDeveloperReward().String(),
+		Keys:             keys,
+	}
+
+	codeHash := userAccount.GetCodeHash()
+	if len(codeHash) > 0 {
+		addressState.Code = hex.EncodeToString(accountsAdapter.GetCode(codeHash))
+		addressState.CodeHash = base64.StdEncoding.EncodeToString(codeHash)
+	}
+
+	ownerAddress := userAccount.GetOwnerAddress()
+	if len(ownerAddress) > 0 {
+		addressState.Owner, err = addressConverter.Encode(ownerAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addressState, nil
+}
+
+func getAccountKeys(userAccount state.UserAccountHandler) (map[string]string, error) {
+	keys := make(map[string]string)
+	if common.IsEmptyTrie(userAccount.GetRootHash()) {
+		return keys, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leavesChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := userAccount.GetAllLeaves(leavesChannels, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for leaf := range leavesChannels.LeavesChan {
+		keys[hex.EncodeToString(leaf.Key())] = hex.EncodeToString(leaf.Value())
+	}
+
+	err = leavesChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
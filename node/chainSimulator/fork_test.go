@@ -0,0 +1,290 @@
+package chainSimulator
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	coreAPI "github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/trie"
+	hardfork "github.com/multiversx/mx-chain-go/update/genesis"
+	"github.com/multiversx/mx-chain-go/update/mock"
+	"github.com/multiversx/mx-chain-go/update/storing"
+	"github.com/stretchr/testify/require"
+)
+
+type forkedAccounts struct {
+	userAddress dtos.WalletAddress
+	scAddress   dtos.WalletAddress
+	rootHash    []byte
+}
+
+func TestSimulator_ForkState(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: false,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch: core.OptionalUint64{
+			HasValue: true,
+			Value:    20,
+		},
+		ApiInterface:      api.NewNoApiInterface(),
+		MinNodesPerShard:  1,
+		MetaChainMinNodes: 1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	dbFolder := t.TempDir()
+	exportFolder := t.TempDir()
+	accounts := createAndExportAccounts(t, chainSimulator, dbFolder, exportFolder)
+
+	err = chainSimulator.ForkStateFromDB(dbFolder, nil, false)
+	require.Equal(t, errEmptyRootHash, err)
+
+	err = chainSimulator.ForkStateFromDB(dbFolder, accounts.rootHash, false)
+	require.Nil(t, err)
+	checkForkedAccounts(t, chainSimulator, accounts)
+
+	err = chainSimulator.RemoveAccounts([]string{accounts.userAddress.Bech32, accounts.scAddress.Bech32})
+	require.Nil(t, err)
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	account, err := chainSimulator.GetAccount(accounts.userAddress)
+	require.Nil(t, err)
+	require.Equal(t, "0", account.Balance)
+
+	err = chainSimulator.ForkStateFromExport(exportFolder, false)
+	require.Nil(t, err)
+	checkForkedAccounts(t, chainSimulator, accounts)
+}
+
+func TestSimulator_ForkStateFromDBShouldRejectValidatorsTrie(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: false,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            1,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch: core.OptionalUint64{
+			HasValue: true,
+			Value:    20,
+		},
+		ApiInterface:      api.NewNoApiInterface(),
+		MinNodesPerShard:  1,
+		MetaChainMinNodes: 1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	dbFolder := t.TempDir()
+	storer, err := createForkStorer(chainSimulator.generalConfig.AccountsTrieStorage, dbFolder)
+	require.Nil(t, err)
+	trieStorageManager, err := chainSimulator.createForkTrieStorageManager(storer, dataRetriever.PeerAccountsUnit.String())
+	require.Nil(t, err)
+
+	coreComponents := chainSimulator.GetNodeHandler(core.MetachainShardId).GetCoreComponents()
+	validatorsTrie, err := trie.NewTrie(
+		trieStorageManager,
+		coreComponents.InternalMarshalizer(),
+		coreComponents.Hasher(),
+		coreComponents.EnableEpochsHandler(),
+		forkedTrieMaxLevelInMemory,
+	)
+	require.Nil(t, err)
+
+	blsKey := make([]byte, 96)
+	blsKey[0] = 1
+	err = validatorsTrie.Update(blsKey, []byte("validator"))
+	require.Nil(t, err)
+	err = validatorsTrie.Commit()
+	require.Nil(t, err)
+	rootHash, err := validatorsTrie.RootHash()
+	require.Nil(t, err)
+	_ = trieStorageManager.Close()
+
+	err = chainSimulator.ForkStateFromDB(dbFolder, rootHash, true)
+	require.Equal(t, errValidatorsStateNotForkable, err)
+}
+
+func createAndExportAccounts(t *testing.T, chainSimulator *simulator, dbFolder string, exportFolder string) *forkedAccounts {
+	metaNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	addressConverter := metaNode.GetCoreComponents().AddressPubKeyConverter()
+	shardCoordinator := chainSimulator.GetNodeHandler(1).GetShardCoordinator()
+
+	storer, err := createForkStorer(chainSimulator.generalConfig.AccountsTrieStorage, dbFolder)
+	require.Nil(t, err)
+	trieStorageManager, err := chainSimulator.createForkTrieStorageManager(storer, dataRetriever.UserAccountsUnit.String())
+	require.Nil(t, err)
+	defer func() {
+		_ = trieStorageManager.Close()
+	}()
+
+	accountsAdapter, err := chainSimulator.createForkAccountsAdapter(trieStorageManager)
+	require.Nil(t, err)
+
+	userAddress := generateAddressInShard(shardCoordinator, 32)
+	account, err := accountsAdapter.LoadAccount(userAddress)
+	require.Nil(t, err)
+	userAccount := account.(state.UserAccountHandler)
+	userAccount.IncreaseNonce(7)
+	err = userAccount.AddToBalance(big.NewInt(1000))
+	require.Nil(t, err)
+	err = accountsAdapter.SaveAccount(userAccount)
+	require.Nil(t, err)
+
+	scAddress, _ := hex.DecodeString("000000000000000005001111111111111111111111111111111111111111aa02")
+	account, err = accountsAdapter.LoadAccount(scAddress)
+	require.Nil(t, err)
+	scAccount := account.(state.UserAccountHandler)
+	scAccount.SetCode([]byte("forked code"))
+	scAccount.SetCodeMetadata([]byte{1, 0})
+	scAccount.SetOwnerAddress(userAddress)
+	err = scAccount.SaveKeyValue([]byte("forked key"), []byte("forked value"))
+	require.Nil(t, err)
+	err = accountsAdapter.SaveAccount(scAccount)
+	require.Nil(t, err)
+
+	rootHash, err := accountsAdapter.Commit()
+	require.Nil(t, err)
+
+	account, err = accountsAdapter.GetExistingAccount(scAddress)
+	require.Nil(t, err)
+	dataTrieRootHash := account.(state.UserAccountHandler).GetRootHash()
+
+	exportTries(t, chainSimulator, trieStorageManager, exportFolder, rootHash, dataTrieRootHash)
+
+	return &forkedAccounts{
+		userAddress: dtos.WalletAddress{
+			Bech32: addressConverter.SilentEncode(userAddress, log),
+			Bytes:  userAddress,
+		},
+		scAddress: dtos.WalletAddress{
+			Bech32: addressConverter.SilentEncode(scAddress, log),
+			Bytes:  scAddress,
+		},
+		rootHash: rootHash,
+	}
+}
+
+func exportTries(
+	t *testing.T,
+	chainSimulator *simulator,
+	trieStorageManager common.StorageManager,
+	exportFolder string,
+	rootHash []byte,
+	dataTrieRootHash []byte,
+) {
+	metaNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	coreComponents := metaNode.GetCoreComponents()
+
+	tr, err := trie.NewTrie(
+		trieStorageManager,
+		coreComponents.InternalMarshalizer(),
+		coreComponents.Hasher(),
+		coreComponents.EnableEpochsHandler(),
+		forkedTrieMaxLevelInMemory,
+	)
+	require.Nil(t, err)
+	mainTrie, err := tr.Recreate(rootHash)
+	require.Nil(t, err)
+	dataTrie, err := tr.Recreate(dataTrieRootHash)
+	require.Nil(t, err)
+
+	hardforkConfig := chainSimulator.generalConfig.Hardfork
+	keysStorer, err := createForkStorer(hardforkConfig.ImportKeysStorageConfig, exportFolder)
+	require.Nil(t, err)
+	keysValuesStorer, err := createForkStorer(hardforkConfig.ImportStateStorageConfig, exportFolder)
+	require.Nil(t, err)
+	hardforkStorer, err := storing.NewHardforkStorer(storing.ArgHardforkStorer{
+		KeysStore:   keysStorer,
+		KeyValue:    keysValuesStorer,
+		Marshalizer: coreComponents.InternalMarshalizer(),
+	})
+	require.Nil(t, err)
+
+	exportedShardID := uint32(0)
+	dataTrieIdentifier := hardfork.AddRootHashToIdentifier(hardfork.CreateTrieIdentifier(exportedShardID, hardfork.DataTrie), string(dataTrieRootHash))
+	exporter, err := hardfork.NewStateExporter(hardfork.ArgsNewStateExporter{
+		ShardCoordinator: metaNode.GetShardCoordinator(),
+		StateSyncer: &mock.StateSyncStub{
+			GetEpochStartMetaBlockCalled: func() (data.MetaHeaderHandler, error) {
+				return &block.MetaBlock{}, nil
+			},
+			GetAllTriesCalled: func() (map[string]common.Trie, error) {
+				return map[string]common.Trie{
+					hardfork.CreateTrieIdentifier(exportedShardID, hardfork.UserAccount): mainTrie,
+					dataTrieIdentifier: dataTrie,
+				}, nil
+			},
+		},
+		Marshalizer:              coreComponents.InternalMarshalizer(),
+		Hasher:                   coreComponents.Hasher(),
+		HardforkStorer:           hardforkStorer,
+		ExportFolder:             exportFolder,
+		AddressPubKeyConverter:   coreComponents.AddressPubKeyConverter(),
+		ValidatorPubKeyConverter: coreComponents.ValidatorPubKeyConverter(),
+		GenesisNodesSetupHandler: &mock.GenesisNodesSetupHandlerStub{},
+	})
+	require.Nil(t, err)
+
+	err = exporter.ExportAll(0)
+	require.Nil(t, err)
+}
+
+func checkForkedAccounts(t *testing.T, chainSimulator *simulator, accounts *forkedAccounts) {
+	err := chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	account, err := chainSimulator.GetAccount(accounts.userAddress)
+	require.Nil(t, err)
+	require.Equal(t, "1000", account.Balance)
+	require.Equal(t, uint64(7), account.Nonce)
+
+	account, err = chainSimulator.GetAccount(accounts.scAddress)
+	require.Nil(t, err)
+	require.Equal(t, hex.EncodeToString([]byte("forked code")), account.Code)
+	require.Equal(t, accounts.userAddress.Bech32, account.OwnerAddress)
+
+	shardID := chainSimulator.GetNodeHandler(0).GetShardCoordinator().ComputeId(accounts.scAddress.Bytes)
+	value, _, err := chainSimulator.GetNodeHandler(shardID).GetFacadeHandler().GetValueForKey(
+		accounts.scAddress.Bech32,
+		hex.EncodeToString([]byte("forked key")),
+		coreAPI.AccountQueryOptions{},
+	)
+	require.Nil(t, err)
+	require.Equal(t, hex.EncodeToString([]byte("forked value")), value)
+}
@@ -0,0 +1,152 @@
+package chainSimulator
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/sharding"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+)
+
+const dummySignature = "sig"
+
+type impersonatedAddressesHolder interface {
+	Add(address []byte)
+	Remove(address []byte)
+	IsImpersonated(address []byte) bool
+	IsInterfaceNil() bool
+}
+
+// Impersonate will disable the transactions signature check for the provided address, on all nodes
+func (s *simulator) Impersonate(address string) error {
+	addressBytes, err := s.decodeAddress(address)
+	if err != nil {
+		return err
+	}
+
+	s.impersonatedAddresses.Add(addressBytes)
+	log.Debug("impersonating address", "address", address)
+
+	return nil
+}
+
+// StopImpersonating will re-enable the transactions signature check for the provided address, on all nodes
+func (s *simulator) StopImpersonating(address string) error {
+	addressBytes, err := s.decodeAddress(address)
+	if err != nil {
+		return err
+	}
+
+	s.impersonatedAddresses.Remove(addressBytes)
+	log.Debug("stopped impersonating address", "address", address)
+
+	return nil
+}
+
+func (s *simulator) decodeAddress(address string) ([]byte, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	addressConverter := s.nodes[core.MetachainShardId].GetCoreComponents().AddressPubKeyConverter()
+	return addressConverter.Decode(address)
+}
+
+// AutoFillTransaction will fill in the missing fields of the provided transaction: the gas price, chain ID and version are
+// taken from the network configuration and the gas limit is estimated as on the transaction cost endpoint. The nonce is
+// computed from the sender account and the transactions pool only if requested through the options, as 0 is a valid
+// nonce. A dummy signature is set if the sender does not need one
+func (s *simulator) AutoFillTransaction(tx *transaction.Transaction, options dtos.AutoFillOptions) error {
+	if tx == nil {
+		return errNilTransaction
+	}
+
+	shardID := sharding.ComputeShardID(tx.SndAddr, s.numOfShards)
+	node := s.GetNodeHandler(shardID)
+	if check.IfNil(node) {
+		return fmt.Errorf("%w missing node handler for shard %d", errShardSetupError, shardID)
+	}
+
+	if options.FillNonce {
+		nonce, err := getNextNonceForSender(node, tx.SndAddr)
+		if err != nil {
+			return err
+		}
+		tx.Nonce = nonce
+	}
+
+	coreComponents := node.GetCoreComponents()
+	if tx.GasPrice == 0 {
+		tx.GasPrice = coreComponents.EconomicsData().MinGasPrice()
+	}
+	if len(tx.ChainID) == 0 {
+		tx.ChainID = []byte(coreComponents.ChainID())
+	}
+	if tx.Version == 0 {
+		tx.Version = coreComponents.MinTransactionVersion()
+	}
+
+	isSignatureRequired := !s.bypassTxSignatureCheck && !s.impersonatedAddresses.IsImpersonated(tx.SndAddr)
+	if len(tx.Signature) == 0 && !isSignatureRequired {
+		tx.Signature = []byte(dummySignature)
+	}
+
+	if tx.GasLimit != 0 {
+		return nil
+	}
+
+	return computeGasLimit(node, tx)
+}
+
+// SendAutoFilledTxAndGenerateBlockTilTxIsExecuted will fill in the missing fields of the provided transaction, send it
+// and generate blocks until the transaction is executed
+func (s *simulator) SendAutoFilledTxAndGenerateBlockTilTxIsExecuted(
+	txToSend *transaction.Transaction,
+	options dtos.AutoFillOptions,
+	maxNumOfBlocksToGenerateWhenExecutingTx int,
+) (*transaction.ApiTransactionResult, error) {
+	err := s.AutoFillTransaction(txToSend, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.SendTxAndGenerateBlockTilTxIsExecuted(txToSend, maxNumOfBlocksToGenerateWhenExecutingTx)
+}
+
+func getNextNonceForSender(node process.NodeHandler, sender []byte) (uint64, error) {
+	account, err := node.GetStateComponents().AccountsAdapter().GetExistingAccount(sender)
+	if err != nil {
+		return 0, err
+	}
+
+	nextNonce := account.GetNonce()
+	senderBech32, err := node.GetCoreComponents().AddressPubKeyConverter().Encode(sender)
+	if err != nil {
+		return 0, err
+	}
+
+	lastPoolNonce, errNotCritical := node.GetFacadeHandler().GetLastPoolNonceForSender(senderBech32)
+	if errNotCritical == nil && lastPoolNonce >= nextNonce {
+		nextNonce = lastPoolNonce + 1
+	}
+
+	return nextNonce, nil
+}
+
+func computeGasLimit(node process.NodeHandler, tx *transaction.Transaction) error {
+	// the cost estimation alters the provided transaction, so a copy is used
+	txCopy := *tx
+	costResponse, err := node.GetFacadeHandler().ComputeTransactionGasLimit(&txCopy)
+	if err != nil {
+		return err
+	}
+	if len(costResponse.ReturnMessage) > 0 {
+		return fmt.Errorf("%w: %s", errCannotComputeGasLimit, costResponse.ReturnMessage)
+	}
+
+	tx.GasLimit = costResponse.GasUnits
+
+	return nil
+}
@@ -1,10 +1,15 @@
 package chainSimulator
 
-import "github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+)
 
 // ChainHandler defines what a chain handler should be able to do
 type ChainHandler interface {
 	IncrementRound()
+	SetRound(round int64)
 	CreateNewBlock() error
 	IsInterfaceNil() bool
 }
@@ -13,5 +18,14 @@ type ChainHandler interface {
 type ChainSimulator interface {
 	GenerateBlocks(numOfBlocks int) error
 	GetNodeHandler(shardID uint32) process.NodeHandler
+	Snapshot() (string, error)
+	Revert(id string) error
+	JumpToRound(targetRound int64) error
+	AdvanceTime(duration time.Duration) error
+	ForceChangeOfEpoch() error
+	Impersonate(address string) error
+	StopImpersonating(address string) error
+	ForkStateFromExport(exportFolder string, includeMetachainAccounts bool) error
+	ForkStateFromDB(dbFolder string, rootHash []byte, includeMetachainAccounts bool) error
 	IsInterfaceNil() bool
 }
@@ -24,6 +24,7 @@ type NodeHandler interface {
 	SetKeyValueForAddress(addressBytes []byte, state map[string]string) error
 	SetStateForAddress(address []byte, state *dtos.AddressState) error
 	RemoveAccount(address []byte) error
+	ForceChangeOfEpoch() error
 	Close() error
 	IsInterfaceNil() bool
 }
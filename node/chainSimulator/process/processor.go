@@ -13,6 +13,7 @@ var log = logger.GetOrCreate("process-block")
 
 type manualRoundHandler interface {
 	IncrementIndex()
+	SetIndex(index int64)
 }
 
 type blocksCreator struct {
@@ -39,6 +40,15 @@ func (creator *blocksCreator) IncrementRound() {
 	creator.nodeHandler.GetStatusCoreComponents().AppStatusHandler().SetUInt64Value(common.MetricCurrentRound, uint64(roundHandler.Index()))
 }
 
+// SetRound will set the current round to the provided value
+func (creator *blocksCreator) SetRound(round int64) {
+	roundHandler := creator.nodeHandler.GetCoreComponents().RoundHandler()
+	manual := roundHandler.(manualRoundHandler)
+	manual.SetIndex(round)
+
+	creator.nodeHandler.GetStatusCoreComponents().AppStatusHandler().SetUInt64Value(common.MetricCurrentRound, uint64(roundHandler.Index()))
+}
+
 // CreateNewBlock creates and process a new block
 func (creator *blocksCreator) CreateNewBlock() error {
 	bp := creator.nodeHandler.GetProcessComponents().BlockProcessor()
@@ -0,0 +1,521 @@
+package chainSimulator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+	mxChainProcess "github.com/multiversx/mx-chain-go/process"
+	mxChainSharding "github.com/multiversx/mx-chain-go/sharding"
+)
+
+type roundIndexSetter interface {
+	Index() int64
+	SetIndex(index int64)
+}
+
+type headersNotificationsWaiter interface {
+	WaitForPendingNotifications()
+}
+
+type pooledData struct {
+	key     []byte
+	value   data.TransactionHandler
+	size    int
+	cacheID string
+}
+
+type trackedHeader struct {
+	shardID uint32
+	header  data.HeaderHandler
+	hash    []byte
+}
+
+type blockTrackerSnapshot struct {
+	crossNotarizedHeaders []*trackedHeader
+	selfNotarizedHeaders  []*trackedHeader
+}
+
+type nodeSnapshot struct {
+	epoch                uint32
+	roundIndex           int64
+	headerNonce          uint64
+	headerHash           []byte
+	accountsRootHash     []byte
+	peerAccountsRootHash []byte
+	finalNonce           uint64
+	finalHash            []byte
+	finalRootHash        []byte
+	transactions         []*pooledData
+	unsignedTransactions []*pooledData
+	rewardTransactions   []*pooledData
+	blockTracker         *blockTrackerSnapshot
+}
+
+type networkSnapshot struct {
+	nodes map[uint32]*nodeSnapshot
+}
+
+// Snapshot will capture the current state of all the nodes (accounts tries, chain heads, pools and round counters)
+// and will return an identifier that can be later used to revert to this state
+func (s *simulator) Snapshot() (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	snapshot := &networkSnapshot{
+		nodes: make(map[uint32]*nodeSnapshot, len(s.nodes)),
+	}
+	for shardID, node := range s.nodes {
+		snapshotOfNode, err := createNodeSnapshot(node)
+		if err != nil {
+			return "", fmt.Errorf("%w for shard %d", err, shardID)
+		}
+
+		snapshot.nodes[shardID] = snapshotOfNode
+	}
+
+	s.lastSnapshotID++
+	id := fmt.Sprintf("%d", s.lastSnapshotID)
+	s.snapshots[id] = snapshot
+	s.snapshotsOrder = append(s.snapshotsOrder, id)
+
+	log.Info("created chain simulator snapshot", "id", id)
+
+	return id, nil
+}
+
+// Revert will restore all nodes to the state captured by the snapshot with the provided identifier, without
+// restarting them. The snapshot remains available for later reverts, while all the snapshots created after it
+// are discarded. Reverting to a snapshot created in an older epoch is not supported
+func (s *simulator) Revert(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	snapshot, ok := s.snapshots[id]
+	if !ok {
+		return fmt.Errorf("%w, id: %s", errSnapshotNotFound, id)
+	}
+
+	// all the nodes are checked before altering any of them, so that a failed revert does not leave the nodes
+	// reverted only partially
+	for shardID, node := range s.nodes {
+		err := checkNodeCanBeReverted(node, snapshot.nodes[shardID])
+		if err != nil {
+			return fmt.Errorf("%w for shard %d", err, shardID)
+		}
+	}
+
+	highestNonces := make(map[uint32]uint64)
+	for shardID, node := range s.nodes {
+		highestNonces[shardID] = node.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+	}
+
+	for shardID, node := range s.nodes {
+		err := revertNodeToSnapshot(node, snapshot.nodes[shardID])
+		if err != nil {
+			return fmt.Errorf("%w for shard %d", err, shardID)
+		}
+	}
+
+	// the headers restored in pools during the roll back are notified asynchronously to the block trackers, so the
+	// notifications are awaited before removing the headers created after the snapshot
+	for _, node := range s.nodes {
+		node.GetDataComponents().Datapool().Headers().(headersNotificationsWaiter).WaitForPendingNotifications()
+	}
+
+	for _, node := range s.nodes {
+		removeHeadersHigherThanSnapshot(node, snapshot, highestNonces)
+		restoreBlockTracker(node, snapshot)
+	}
+
+	s.removeSnapshotsCreatedAfter(id)
+
+	log.Info("reverted chain simulator to snapshot", "id", id)
+
+	return nil
+}
+
+func (s *simulator) removeSnapshotsCreatedAfter(id string) {
+	for idx, snapshotID := range s.snapshotsOrder {
+		if snapshotID != id {
+			continue
+		}
+
+		for _, newerSnapshotID := range s.snapshotsOrder[idx+1:] {
+			delete(s.snapshots, newerSnapshotID)
+		}
+		s.snapshotsOrder = s.snapshotsOrder[:idx+1]
+
+		return
+	}
+}
+
+func createNodeSnapshot(node process.NodeHandler) (*nodeSnapshot, error) {
+	accountsRootHash, err := node.GetStateComponents().AccountsAdapter().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	peerAccountsRootHash, err := node.GetStateComponents().PeerAccounts().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	chainHandler := node.GetChainHandler()
+	headerNonce := chainHandler.GetGenesisHeader().GetNonce()
+	currentHeader := chainHandler.GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		headerNonce = currentHeader.GetNonce()
+	}
+
+	finalNonce, finalHash, finalRootHash := chainHandler.GetFinalBlockInfo()
+	dataPool := node.GetDataComponents().Datapool()
+	shardCoordinator := node.GetShardCoordinator()
+
+	return &nodeSnapshot{
+		epoch:                node.GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch(),
+		roundIndex:           node.GetCoreComponents().RoundHandler().Index(),
+		headerNonce:          headerNonce,
+		headerHash:           chainHandler.GetCurrentBlockHeaderHash(),
+		accountsRootHash:     accountsRootHash,
+		peerAccountsRootHash: peerAccountsRootHash,
+		finalNonce:           finalNonce,
+		finalHash:            finalHash,
+		finalRootHash:        finalRootHash,
+		transactions:         collectPooledData(dataPool.Transactions(), shardCoordinator, false),
+		unsignedTransactions: collectPooledData(dataPool.UnsignedTransactions(), shardCoordinator, false),
+		rewardTransactions:   collectPooledData(dataPool.RewardTransactions(), shardCoordinator, true),
+		blockTracker:         createBlockTrackerSnapshot(node.GetProcessComponents().BlockTracker(), shardCoordinator),
+	}, nil
+}
+
+func createBlockTrackerSnapshot(blockTracker mxChainProcess.BlockTracker, shardCoordinator mxChainSharding.Coordinator) *blockTrackerSnapshot {
+	snapshot := &blockTrackerSnapshot{
+		crossNotarizedHeaders: make([]*trackedHeader, 0),
+		selfNotarizedHeaders:  make([]*trackedHeader, 0),
+	}
+
+	for _, shardID := range getAllShardIDs(shardCoordinator) {
+		header, hash, err := blockTracker.GetLastCrossNotarizedHeader(shardID)
+		if err == nil {
+			snapshot.crossNotarizedHeaders = append(snapshot.crossNotarizedHeaders, &trackedHeader{shardID: shardID, header: header, hash: hash})
+		}
+
+		header, hash, err = blockTracker.GetLastSelfNotarizedHeader(shardID)
+		if err == nil {
+			snapshot.selfNotarizedHeaders = append(snapshot.selfNotarizedHeaders, &trackedHeader{shardID: shardID, header: header, hash: hash})
+		}
+	}
+
+	return snapshot
+}
+
+func getAllShardIDs(shardCoordinator mxChainSharding.Coordinator) []uint32 {
+	shardIDs := make([]uint32, 0, shardCoordinator.NumberOfShards()+1)
+	for shardID := uint32(0); shardID < shardCoordinator.NumberOfShards(); shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+
+	return append(shardIDs, core.MetachainShardId)
+}
+
+func collectPooledData(pool dataRetriever.ShardedDataCacherNotifier, shardCoordinator mxChainSharding.Coordinator, isRewardsPool bool) []*pooledData {
+	keys := pool.Keys()
+	result := make([]*pooledData, 0, len(keys))
+	for _, key := range keys {
+		value, ok := pool.SearchFirstData(key)
+		if !ok {
+			continue
+		}
+
+		tx, ok := value.(data.TransactionHandler)
+		if !ok {
+			continue
+		}
+
+		senderShardID := shardCoordinator.ComputeId(tx.GetSndAddr())
+		if isRewardsPool {
+			senderShardID = core.MetachainShardId
+		}
+		receiverShardID := shardCoordinator.ComputeId(tx.GetRcvAddr())
+
+		result = append(result, &pooledData{
+			key:     key,
+			value:   tx,
+			size:    tx.Size(),
+			cacheID: mxChainProcess.ShardCacherIdentifier(senderShardID, receiverShardID),
+		})
+	}
+
+	return result
+}
+
+// checkNodeCanBeReverted checks, without altering the node, that it can be reverted to the snapshot: the snapshot
+// was created in the current epoch and its header is reached by walking back the blocks created after it
+func checkNodeCanBeReverted(node process.NodeHandler, snapshot *nodeSnapshot) error {
+	currentEpoch := node.GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch()
+	if currentEpoch != snapshot.epoch {
+		return fmt.Errorf("%w, snapshot epoch: %d, current epoch: %d", errSnapshotFromDifferentEpoch, snapshot.epoch, currentEpoch)
+	}
+
+	_, ok := node.GetCoreComponents().RoundHandler().(roundIndexSetter)
+	if !ok {
+		return errWrongTypeAssertion
+	}
+
+	_, ok = node.GetDataComponents().Datapool().Headers().(headersNotificationsWaiter)
+	if !ok {
+		return errWrongTypeAssertion
+	}
+
+	chainHandler := node.GetChainHandler()
+	shardID := node.GetShardCoordinator().SelfId()
+	marshaller := node.GetCoreComponents().InternalMarshalizer()
+	storageService := node.GetDataComponents().StorageService()
+
+	header := chainHandler.GetCurrentBlockHeader()
+	headerHash := chainHandler.GetCurrentBlockHeaderHash()
+	for !bytes.Equal(headerHash, snapshot.headerHash) {
+		if check.IfNil(header) || header.GetNonce() <= snapshot.headerNonce {
+			return errSnapshotHeaderNotReachable
+		}
+
+		headerHash = header.GetPrevHash()
+		if bytes.Equal(headerHash, snapshot.headerHash) {
+			return nil
+		}
+		if bytes.Equal(headerHash, chainHandler.GetGenesisHeaderHash()) {
+			// the roll back to genesis leaves the chain without a current header
+			header, headerHash = nil, nil
+			continue
+		}
+
+		var err error
+		header, err = mxChainProcess.GetHeaderFromStorage(shardID, headerHash, marshaller, storageService)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func revertNodeToSnapshot(node process.NodeHandler, snapshot *nodeSnapshot) error {
+	chainHandler := node.GetChainHandler()
+	for !bytes.Equal(chainHandler.GetCurrentBlockHeaderHash(), snapshot.headerHash) {
+		currentHeader := chainHandler.GetCurrentBlockHeader()
+		if check.IfNil(currentHeader) || currentHeader.GetNonce() <= snapshot.headerNonce {
+			return errSnapshotHeaderNotReachable
+		}
+
+		err := rollBackOneBlock(node, currentHeader)
+		if err != nil {
+			return err
+		}
+	}
+
+	stateComponents := node.GetStateComponents()
+	err := stateComponents.AccountsAdapter().RecreateTrie(snapshot.accountsRootHash)
+	if err != nil {
+		return err
+	}
+
+	err = stateComponents.PeerAccounts().RecreateTrie(snapshot.peerAccountsRootHash)
+	if err != nil {
+		return err
+	}
+
+	chainHandler.SetFinalBlockInfo(snapshot.finalNonce, snapshot.finalHash, snapshot.finalRootHash)
+
+	dataPool := node.GetDataComponents().Datapool()
+	restorePooledData(dataPool.Transactions(), snapshot.transactions)
+	restorePooledData(dataPool.UnsignedTransactions(), snapshot.unsignedTransactions)
+	restorePooledData(dataPool.RewardTransactions(), snapshot.rewardTransactions)
+
+	roundHandler, ok := node.GetCoreComponents().RoundHandler().(roundIndexSetter)
+	if !ok {
+		return errWrongTypeAssertion
+	}
+	roundHandler.SetIndex(snapshot.roundIndex)
+	node.GetStatusCoreComponents().AppStatusHandler().SetUInt64Value(common.MetricCurrentRound, uint64(snapshot.roundIndex))
+
+	return nil
+}
+
+// rollBackOneBlock mimics the roll back executed by the sync mechanism when a fork is detected, with the difference
+// that the transactions from the reverted block are not restored in pools as the pools are restored from the snapshot
+func rollBackOneBlock(node process.NodeHandler, currentHeader data.HeaderHandler) error {
+	chainHandler := node.GetChainHandler()
+	processComponents := node.GetProcessComponents()
+	coreComponents := node.GetCoreComponents()
+	storageService := node.GetDataComponents().StorageService()
+	shardID := node.GetShardCoordinator().SelfId()
+
+	currentHeaderHash := chainHandler.GetCurrentBlockHeaderHash()
+	prevHeaderHash := currentHeader.GetPrevHash()
+
+	var prevHeader data.HeaderHandler
+	var err error
+	isPrevHeaderGenesis := bytes.Equal(prevHeaderHash, chainHandler.GetGenesisHeaderHash())
+	if isPrevHeaderGenesis {
+		prevHeader = chainHandler.GetGenesisHeader()
+	} else {
+		prevHeader, err = mxChainProcess.GetHeaderFromStorage(shardID, prevHeaderHash, coreComponents.InternalMarshalizer(), storageService)
+		if err != nil {
+			return err
+		}
+	}
+
+	scheduledTxsExecutionHandler := processComponents.ScheduledTxsExecutionHandler()
+	prevHeaderRootHash := prevHeader.GetRootHash()
+	scheduledRootHash, errScheduled := scheduledTxsExecutionHandler.GetScheduledRootHashForHeader(prevHeaderHash)
+	if errScheduled == nil {
+		prevHeaderRootHash = scheduledRootHash
+	}
+
+	if isPrevHeaderGenesis {
+		err = chainHandler.SetCurrentBlockHeaderAndRootHash(nil, nil)
+		chainHandler.SetCurrentBlockHeaderHash(nil)
+	} else {
+		err = chainHandler.SetCurrentBlockHeaderAndRootHash(prevHeader, prevHeaderRootHash)
+		chainHandler.SetCurrentBlockHeaderHash(prevHeaderHash)
+	}
+	if err != nil {
+		return err
+	}
+
+	blockProcessor := processComponents.BlockProcessor()
+	err = blockProcessor.RevertStateToBlock(prevHeader, prevHeaderRootHash)
+	if err != nil {
+		return err
+	}
+
+	err = scheduledTxsExecutionHandler.RollBackToBlock(prevHeaderHash)
+	if err != nil {
+		scheduledTxsExecutionHandler.SetScheduledInfo(&mxChainProcess.ScheduledInfo{
+			RootHash:        prevHeaderRootHash,
+			IntermediateTxs: make(map[block.Type][]data.TransactionHandler),
+			GasAndFees:      mxChainProcess.GetZeroGasAndFees(),
+			MiniBlocks:      make(block.MiniBlockSlice, 0),
+		})
+	}
+
+	currentBody, errNotCritical := getBlockBodyFromStorage(node, currentHeader)
+	if errNotCritical != nil {
+		log.Debug("rollBackOneBlock getBlockBodyFromStorage", "error", errNotCritical)
+	}
+
+	err = processComponents.HistoryRepository().RevertBlock(currentHeader, currentBody)
+	if err != nil {
+		return err
+	}
+
+	err = blockProcessor.RestoreBlockIntoPools(currentHeader, &block.Body{})
+	if err != nil {
+		return err
+	}
+
+	processComponents.ForkDetector().RemoveHeader(currentHeader.GetNonce(), currentHeaderHash)
+	node.GetDataComponents().Datapool().Headers().RemoveHeaderByHash(currentHeaderHash)
+
+	hdrNonceHashStorer, err := storageService.GetStorer(dataRetriever.GetHdrNonceHashDataUnit(shardID))
+	if err != nil {
+		return err
+	}
+	_ = hdrNonceHashStorer.Remove(coreComponents.Uint64ByteSliceConverter().ToByteSlice(currentHeader.GetNonce()))
+
+	return nil
+}
+
+func getBlockBodyFromStorage(node process.NodeHandler, header data.HeaderHandler) (*block.Body, error) {
+	miniBlocksStorer, err := node.GetDataComponents().StorageService().GetStorer(dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	marshaller := node.GetCoreComponents().InternalMarshalizer()
+	body := &block.Body{}
+	for _, miniBlockHeader := range header.GetMiniBlockHeaderHandlers() {
+		buff, errGet := miniBlocksStorer.Get(miniBlockHeader.GetHash())
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = marshaller.Unmarshal(miniBlock, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return body, nil
+}
+
+// restoreBlockTracker removes the headers created after the snapshot from the block tracker and re-applies the last
+// notarized headers captured in the snapshot, as the roll back of each block only removes the last notarized headers
+func restoreBlockTracker(node process.NodeHandler, snapshot *networkSnapshot) {
+	blockTracker := node.GetProcessComponents().BlockTracker()
+	for shardID, snapshotOfNode := range snapshot.nodes {
+		blockTracker.RemoveHeadersHigherThanNonce(shardID, snapshotOfNode.headerNonce)
+	}
+
+	trackerSnapshot := snapshot.nodes[node.GetShardCoordinator().SelfId()].blockTracker
+	for _, crossNotarized := range trackerSnapshot.crossNotarizedHeaders {
+		_, lastHash, err := blockTracker.GetLastCrossNotarizedHeader(crossNotarized.shardID)
+		if err == nil && bytes.Equal(lastHash, crossNotarized.hash) {
+			continue
+		}
+
+		blockTracker.AddCrossNotarizedHeader(crossNotarized.shardID, crossNotarized.header, crossNotarized.hash)
+	}
+
+	for _, selfNotarized := range trackerSnapshot.selfNotarizedHeaders {
+		_, lastHash, err := blockTracker.GetLastSelfNotarizedHeader(selfNotarized.shardID)
+		if err == nil && bytes.Equal(lastHash, selfNotarized.hash) {
+			continue
+		}
+
+		blockTracker.AddSelfNotarizedHeader(selfNotarized.shardID, selfNotarized.header, selfNotarized.hash)
+	}
+}
+
+func restorePooledData(pool dataRetriever.ShardedDataCacherNotifier, snapshotData []*pooledData) {
+	pool.Clear()
+	for _, pooled := range snapshotData {
+		pool.AddData(pooled.key, pooled.value, pooled.size, pooled.cacheID)
+	}
+}
+
+// removeHeadersHigherThanSnapshot removes the headers created after the snapshot from the headers pool and from the
+// nonce to hash storers, so they won't be requested or tracked again by the node
+func removeHeadersHigherThanSnapshot(node process.NodeHandler, snapshot *networkSnapshot, highestNonces map[uint32]uint64) {
+	headersPool := node.GetDataComponents().Datapool().Headers()
+	storageService := node.GetDataComponents().StorageService()
+	uint64Converter := node.GetCoreComponents().Uint64ByteSliceConverter()
+	for shardID, snapshotOfNode := range snapshot.nodes {
+		for _, nonce := range headersPool.Nonces(shardID) {
+			if nonce <= snapshotOfNode.headerNonce {
+				continue
+			}
+
+			headersPool.RemoveHeaderByNonceAndShardId(nonce, shardID)
+		}
+
+		hdrNonceHashStorer, err := storageService.GetStorer(dataRetriever.GetHdrNonceHashDataUnit(shardID))
+		if err != nil {
+			continue
+		}
+
+		for nonce := snapshotOfNode.headerNonce + 1; nonce <= highestNonces[shardID]; nonce++ {
+			_ = hdrNonceHashStorer.Remove(uint64Converter.ToByteSlice(nonce))
+		}
+	}
+}
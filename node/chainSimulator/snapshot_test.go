@@ -0,0 +1,220 @@
+package chainSimulator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/dataPool/headersCache"
+	"github.com/multiversx/mx-chain-go/factory"
+	factoryMock "github.com/multiversx/mx-chain-go/factory/mock"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+	nodeFactoryMock "github.com/multiversx/mx-chain-go/node/mock/factory"
+	mxChainSharding "github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	chainSimulatorMocks "github.com/multiversx/mx-chain-go/testscommon/chainSimulator"
+	testsDataRetriever "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/pool"
+	"github.com/stretchr/testify/require"
+)
+
+type nodeToRevertArgs struct {
+	epoch         uint32
+	header        data.HeaderHandler
+	headerHash    []byte
+	roundHandler  *testscommon.RoundHandlerMock
+	headersPool   dataRetriever.HeadersPool
+	storage       dataRetriever.StorageService
+	onStateAccess func()
+}
+
+func createNodeToRevert(t *testing.T, args nodeToRevertArgs) process.NodeHandler {
+	if args.headersPool == nil {
+		headersPool, err := headersCache.NewHeadersPool(config.HeadersPoolConfig{
+			MaxHeadersPerShard:            10,
+			NumElementsToRemoveOnEviction: 1,
+		})
+		require.NoError(t, err)
+
+		args.headersPool, err = components.NewNotifyingHeadersPool(headersPool)
+		require.NoError(t, err)
+	}
+	if args.storage == nil {
+		args.storage = genericMocks.NewChainStorerMock(0)
+	}
+
+	coreComponents := &factoryMock.CoreComponentsMock{
+		IntMarsh: &marshallerMock.MarshalizerMock{},
+		EnableEpochsHandlerField: &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+			GetCurrentEpochCalled: func() uint32 {
+				return args.epoch
+			},
+		},
+		RoundHandlerField: args.roundHandler,
+	}
+	dataComponents := &nodeFactoryMock.DataComponentsMock{
+		Store: args.storage,
+		DataPool: &testsDataRetriever.PoolsHolderStub{
+			HeadersCalled: func() dataRetriever.HeadersPool {
+				return args.headersPool
+			},
+		},
+	}
+
+	return &chainSimulatorMocks.NodeHandlerMock{
+		GetCoreComponentsCalled: func() factory.CoreComponentsHolder {
+			return coreComponents
+		},
+		GetDataComponentsCalled: func() factory.DataComponentsHandler {
+			return dataComponents
+		},
+		GetShardCoordinatorCalled: func() mxChainSharding.Coordinator {
+			return testscommon.NewMultiShardsCoordinatorMock(1)
+		},
+		GetChainHandlerCalled: func() data.ChainHandler {
+			return &testscommon.ChainHandlerStub{
+				GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+					return args.header
+				},
+				GetCurrentBlockHeaderHashCalled: func() []byte {
+					return args.headerHash
+				},
+				GetGenesisHeaderHashCalled: func() []byte {
+					return []byte("genesis hash")
+				},
+			}
+		},
+		GetStateComponentsCalled: func() factory.StateComponentsHolder {
+			if args.onStateAccess != nil {
+				args.onStateAccess()
+			}
+			return &factoryMock.StateComponentsHolderStub{}
+		},
+	}
+}
+
+func TestCheckNodeCanBeReverted(t *testing.T) {
+	t.Parallel()
+
+	snapshot := &nodeSnapshot{
+		epoch:       1,
+		headerNonce: 5,
+		headerHash:  []byte("snapshot hash"),
+	}
+
+	t.Run("different epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		node := createNodeToRevert(t, nodeToRevertArgs{
+			epoch:        2,
+			headerHash:   snapshot.headerHash,
+			roundHandler: &testscommon.RoundHandlerMock{},
+		})
+		err := checkNodeCanBeReverted(node, snapshot)
+		require.ErrorIs(t, err, errSnapshotFromDifferentEpoch)
+	})
+	t.Run("headers pool without notifications tracking should error", func(t *testing.T) {
+		t.Parallel()
+
+		node := createNodeToRevert(t, nodeToRevertArgs{
+			epoch:        1,
+			headerHash:   snapshot.headerHash,
+			roundHandler: &testscommon.RoundHandlerMock{},
+			headersPool:  &pool.HeadersPoolStub{},
+		})
+		err := checkNodeCanBeReverted(node, snapshot)
+		require.Equal(t, errWrongTypeAssertion, err)
+	})
+	t.Run("header older than the snapshot should error", func(t *testing.T) {
+		t.Parallel()
+
+		node := createNodeToRevert(t, nodeToRevertArgs{
+			epoch:        1,
+			header:       &block.Header{Nonce: 4},
+			headerHash:   []byte("older hash"),
+			roundHandler: &testscommon.RoundHandlerMock{},
+		})
+		err := checkNodeCanBeReverted(node, snapshot)
+		require.Equal(t, errSnapshotHeaderNotReachable, err)
+	})
+	t.Run("previous header missing from storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		node := createNodeToRevert(t, nodeToRevertArgs{
+			epoch:        1,
+			header:       &block.Header{Nonce: 7, PrevHash: []byte("missing hash")},
+			headerHash:   []byte("current hash"),
+			roundHandler: &testscommon.RoundHandlerMock{},
+		})
+		err := checkNodeCanBeReverted(node, snapshot)
+		require.Error(t, err)
+	})
+	t.Run("snapshot header found walking back should work", func(t *testing.T) {
+		t.Parallel()
+
+		storage := genericMocks.NewChainStorerMock(0)
+		marshaller := &marshallerMock.MarshalizerMock{}
+		intermediateHeader := &block.Header{Nonce: 6, PrevHash: snapshot.headerHash}
+		buff, _ := marshaller.Marshal(intermediateHeader)
+		headersStorer, _ := storage.GetStorer(dataRetriever.BlockHeaderUnit)
+		require.NoError(t, headersStorer.Put([]byte("intermediate hash"), buff))
+
+		node := createNodeToRevert(t, nodeToRevertArgs{
+			epoch:        1,
+			header:       &block.Header{Nonce: 7, PrevHash: []byte("intermediate hash")},
+			headerHash:   []byte("current hash"),
+			roundHandler: &testscommon.RoundHandlerMock{},
+			storage:      storage,
+		})
+		err := checkNodeCanBeReverted(node, snapshot)
+		require.NoError(t, err)
+	})
+}
+
+func TestSimulator_RevertShouldNotAlterAnyNodeIfOneCannotBeReverted(t *testing.T) {
+	t.Parallel()
+
+	stateAccessed := false
+	revertableNode := createNodeToRevert(t, nodeToRevertArgs{
+		epoch:         1,
+		header:        &block.Header{Nonce: 5},
+		headerHash:    []byte("snapshot hash 0"),
+		roundHandler:  &testscommon.RoundHandlerMock{},
+		onStateAccess: func() { stateAccessed = true },
+	})
+	unreachableNode := createNodeToRevert(t, nodeToRevertArgs{
+		epoch:         1,
+		header:        &block.Header{Nonce: 3},
+		headerHash:    []byte("older hash 1"),
+		roundHandler:  &testscommon.RoundHandlerMock{},
+		onStateAccess: func() { stateAccessed = true },
+	})
+
+	s := &simulator{
+		nodes: map[uint32]process.NodeHandler{
+			0: revertableNode,
+			1: unreachableNode,
+		},
+		snapshots: map[string]*networkSnapshot{
+			"1": {
+				nodes: map[uint32]*nodeSnapshot{
+					0: {epoch: 1, headerNonce: 5, headerHash: []byte("snapshot hash 0")},
+					1: {epoch: 1, headerNonce: 5, headerHash: []byte("snapshot hash 1")},
+				},
+			},
+		},
+		snapshotsOrder: []string{"1"},
+	}
+
+	err := s.Revert("1")
+	require.True(t, errors.Is(err, errSnapshotHeaderNotReachable))
+	require.False(t, stateAccessed)
+	require.Equal(t, []string{"1"}, s.snapshotsOrder)
+}
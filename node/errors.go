@@ -138,3 +138,6 @@ var ErrNilStatusHandler = errors.New("nil status handler")
 
 // ErrNilCreateTransactionArgs signals that create transaction args is nil
 var ErrNilCreateTransactionArgs = errors.New("nil args for create transaction")
+
+// ErrNilAccountsDiffsHandler signals that a nil accounts diffs handler was provided
+var ErrNilAccountsDiffsHandler = errors.New("nil accounts diffs handler")
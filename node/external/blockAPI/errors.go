@@ -18,3 +18,4 @@ var errCannotUnmarshalTransactions = errors.New("cannot unmarshal transaction(s)
 var errCannotLoadReceipts = errors.New("cannot load receipt(s)")
 var errCannotUnmarshalReceipts = errors.New("cannot unmarshal receipt(s)")
 var errUnknownBlockRequestType = errors.New("unknown block request type")
+var errCannotCastToUserAccountHandler = errors.New("cannot cast account handler to user account handler")
@@ -6,6 +6,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 )
 
@@ -23,6 +24,7 @@ type APIBlockHandler interface {
 	GetBlockByHash(hash []byte, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetAlteredAccountsForBlock(options api.GetAlteredAccountsForBlockOptions) ([]*alteredAccount.AlteredAccount, error)
+	GetTransactionStateDiff(tx *transaction.ApiTransactionResult) ([]*txSimData.AccountStateDiff, error)
 	IsInterfaceNil() bool
 }
 
@@ -0,0 +1,281 @@
+package blockAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts/shared"
+	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+const esdtKeyPrefix = core.ProtectedKeyPrefix + core.ESDTKeyIdentifier
+
+// GetTransactionStateDiff returns the before and after values of the accounts altered by the provided executed
+// transaction. The accounts are computed from the altered accounts data of the transaction and its results, while
+// the values are read at the boundaries of the block holding the transaction, so they also include the effects of
+// the other transactions from the same block touching those accounts. Data trie keys other than ESDT balances are
+// only available for simulations
+func (bap *baseAPIBlockProcessor) GetTransactionStateDiff(tx *transaction.ApiTransactionResult) ([]*txSimData.AccountStateDiff, error) {
+	blockHash, err := hex.DecodeString(tx.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := bap.getHeaderByHash(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	previousHeader, err := bap.getHeaderByHash(header.GetPrevHash())
+	if err != nil {
+		return nil, err
+	}
+
+	alteredAccountsOptions := shared.AlteredAccountsOptions{
+		WithCustomAccountsRepository: true,
+		AccountsRepository:           bap.accountsRepository,
+		AccountQueryOptions: api.AccountQueryOptions{
+			BlockHash:     blockHash,
+			BlockNonce:    core.OptionalUint64{HasValue: true, Value: header.GetNonce()},
+			BlockRootHash: bap.getRootHashAfterHeader(blockHash, header),
+			HintEpoch:     core.OptionalUint32{HasValue: true, Value: header.GetEpoch()},
+		},
+		WithAdditionalOutportData: true,
+	}
+
+	outportPool, err := bap.transactionToOutportPool(tx)
+	if err != nil {
+		return nil, err
+	}
+	alteredAccounts, err := bap.alteredAccountsProvider.ExtractAlteredAccountsFromPool(outportPool, alteredAccountsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	previousQueryOptions := api.AccountQueryOptions{
+		BlockHash:     header.GetPrevHash(),
+		BlockNonce:    core.OptionalUint64{HasValue: true, Value: previousHeader.GetNonce()},
+		BlockRootHash: bap.getRootHashAfterHeader(header.GetPrevHash(), previousHeader),
+		HintEpoch:     core.OptionalUint32{HasValue: true, Value: previousHeader.GetEpoch()},
+	}
+
+	addresses := make([]string, 0, len(alteredAccounts))
+	for address := range alteredAccounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	stateDiff := make([]*txSimData.AccountStateDiff, 0, len(alteredAccounts))
+	for _, address := range addresses {
+		accountDiff, errDiff := bap.createAccountStateDiff(alteredAccounts[address], previousQueryOptions)
+		if errDiff != nil {
+			return nil, errDiff
+		}
+		if accountDiff == nil {
+			continue
+		}
+
+		stateDiff = append(stateDiff, accountDiff)
+	}
+
+	return stateDiff, nil
+}
+
+func (bap *baseAPIBlockProcessor) getHeaderByHash(headerHash []byte) (data.HeaderHandler, error) {
+	unit := dataRetriever.BlockHeaderUnit
+	if bap.selfShardID == core.MetachainShardId {
+		unit = dataRetriever.MetaBlockUnit
+	}
+
+	headerBytes, err := bap.getFromStorer(unit, headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return process.UnmarshalHeader(bap.selfShardID, bap.marshalizer, headerBytes)
+}
+
+func (bap *baseAPIBlockProcessor) getRootHashAfterHeader(headerHash []byte, header data.HeaderHandler) []byte {
+	rootHash, err := bap.scheduledTxsExecutionHandler.GetScheduledRootHashForHeaderWithEpoch(headerHash, header.GetEpoch())
+	if err != nil {
+		return header.GetRootHash()
+	}
+
+	return rootHash
+}
+
+func (bap *baseAPIBlockProcessor) transactionToOutportPool(tx *transaction.ApiTransactionResult) (*outport.TransactionPool, error) {
+	pool := &outport.TransactionPool{
+		Transactions:         make(map[string]*outport.TxInfo),
+		SmartContractResults: make(map[string]*outport.SCRInfo),
+		InvalidTxs:           make(map[string]*outport.TxInfo),
+		Rewards:              make(map[string]*outport.RewardInfo),
+		Logs:                 make([]*outport.LogData, 0),
+	}
+
+	results := make([]*transaction.ApiTransactionResult, 0, len(tx.SmartContractResults)+1)
+	results = append(results, tx)
+	for _, scr := range tx.SmartContractResults {
+		results = append(results, &transaction.ApiTransactionResult{
+			Type:     string(transaction.TxTypeUnsigned),
+			Hash:     scr.Hash,
+			Sender:   scr.SndAddr,
+			Receiver: scr.RcvAddr,
+			Value:    bigIntToStr(scr.Value),
+			Logs:     scr.Logs,
+		})
+	}
+
+	for _, result := range results {
+		err := bap.addTxToPool(result, pool)
+		if err != nil {
+			return nil, err
+		}
+
+		err = bap.addLogsToPool(result, pool)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pool, nil
+}
+
+func (bap *baseAPIBlockProcessor) createAccountStateDiff(
+	alteredAcc *alteredAccount.AlteredAccount,
+	previousQueryOptions api.AccountQueryOptions,
+) (*txSimData.AccountStateDiff, error) {
+	addressBytes, err := bap.addressPubKeyConverter.Decode(alteredAcc.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	previousAccount, err := bap.loadPreviousUserAccount(addressBytes, previousQueryOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	accountDiff := &txSimData.AccountStateDiff{
+		Address:               alteredAcc.Address,
+		NonceAfter:            alteredAcc.Nonce,
+		BalanceBefore:         "0",
+		BalanceAfter:          getStringOrZero(alteredAcc.Balance),
+		DeveloperRewardBefore: "0",
+		DeveloperRewardAfter:  "0",
+	}
+	if alteredAcc.AdditionalData != nil {
+		accountDiff.DeveloperRewardAfter = getStringOrZero(alteredAcc.AdditionalData.DeveloperRewards)
+		accountDiff.CodeHashAfter = hex.EncodeToString(alteredAcc.AdditionalData.CodeHash)
+		accountDiff.UsernameAfter = alteredAcc.AdditionalData.UserName
+	}
+	if !check.IfNil(previousAccount) {
+		accountDiff.NonceBefore = previousAccount.GetNonce()
+		accountDiff.BalanceBefore = bigIntToStr(previousAccount.GetBalance())
+		accountDiff.DeveloperRewardBefore = bigIntToStr(previousAccount.GetDeveloperReward())
+		accountDiff.UsernameBefore = string(previousAccount.GetUserName())
+		if core.IsSmartContractAddress(addressBytes) {
+			accountDiff.CodeHashBefore = hex.EncodeToString(previousAccount.GetCodeHash())
+		}
+	}
+
+	for _, token := range alteredAcc.Tokens {
+		balanceBefore, errBalance := bap.getPreviousESDTBalance(previousAccount, token)
+		if errBalance != nil {
+			return nil, errBalance
+		}
+
+		balanceAfter := getStringOrZero(token.Balance)
+		if balanceBefore == balanceAfter {
+			continue
+		}
+
+		accountDiff.ESDTBalances = append(accountDiff.ESDTBalances, &txSimData.ESDTBalanceDiff{
+			Identifier:    token.Identifier,
+			Nonce:         token.Nonce,
+			BalanceBefore: balanceBefore,
+			BalanceAfter:  balanceAfter,
+		})
+	}
+
+	changed := accountDiff.NonceBefore != accountDiff.NonceAfter ||
+		accountDiff.BalanceBefore != accountDiff.BalanceAfter ||
+		accountDiff.DeveloperRewardBefore != accountDiff.DeveloperRewardAfter ||
+		accountDiff.CodeHashBefore != accountDiff.CodeHashAfter ||
+		accountDiff.UsernameBefore != accountDiff.UsernameAfter ||
+		len(accountDiff.ESDTBalances) > 0
+	if !changed {
+		return nil, nil
+	}
+
+	return accountDiff, nil
+}
+
+func (bap *baseAPIBlockProcessor) loadPreviousUserAccount(addressBytes []byte, options api.AccountQueryOptions) (state.UserAccountHandler, error) {
+	account, _, err := bap.accountsRepository.GetAccountWithBlockInfo(addressBytes, options)
+	if err != nil {
+		var errAccountNotFound *state.ErrAccountNotFoundAtBlock
+		if errors.As(err, &errAccountNotFound) {
+			// the account was created by the transaction's block
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, errCannotCastToUserAccountHandler
+	}
+
+	return userAccount, nil
+}
+
+func (bap *baseAPIBlockProcessor) getPreviousESDTBalance(previousAccount state.UserAccountHandler, token *alteredAccount.AccountTokenData) (string, error) {
+	if check.IfNil(previousAccount) {
+		return "0", nil
+	}
+
+	tokenKey := []byte(esdtKeyPrefix + token.Identifier)
+	if token.Nonce > 0 {
+		tokenKey = append(tokenKey, big.NewInt(0).SetUint64(token.Nonce).Bytes()...)
+	}
+
+	value, _, err := previousAccount.RetrieveValue(tokenKey)
+	if errors.Is(err, state.ErrNilTrie) {
+		return "0", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(value) == 0 {
+		return "0", nil
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err = bap.marshalizer.Unmarshal(esdtToken, value)
+	if err != nil {
+		return "", err
+	}
+
+	return bigIntToStr(esdtToken.Value), nil
+}
+
+func getStringOrZero(value string) string {
+	if len(value) == 0 {
+		return "0"
+	}
+
+	return value
+}
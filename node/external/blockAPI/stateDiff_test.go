@@ -0,0 +1,176 @@
+package blockAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts/shared"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseAPIBlockProcessor_GetTransactionStateDiff(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &mock.MarshalizerFake{}
+	headerHash := []byte("header-hash")
+	previousHeaderHash := []byte("previous-header-hash")
+	header := &block.Header{Nonce: 10, Epoch: 1, PrevHash: previousHeaderHash, RootHash: []byte("root")}
+	previousHeader := &block.Header{Nonce: 9, Epoch: 1, RootHash: []byte("previous-root")}
+	headerBytes, _ := marshaller.Marshal(header)
+	previousHeaderBytes, _ := marshaller.Marshal(previousHeader)
+
+	sender, receiver, contract := []byte("sender"), []byte("receiver"), []byte("contract")
+	apiTx := &transaction.ApiTransactionResult{
+		Type:      string(transaction.TxTypeNormal),
+		Hash:      "tx-hash",
+		Sender:    hex.EncodeToString(sender),
+		Receiver:  hex.EncodeToString(contract),
+		Value:     "5",
+		BlockHash: hex.EncodeToString(headerHash),
+		SmartContractResults: []*transaction.ApiSmartContractResult{
+			{Hash: "scr-hash", SndAddr: hex.EncodeToString(contract), RcvAddr: hex.EncodeToString(receiver), Value: big.NewInt(5)},
+		},
+	}
+
+	t.Run("header not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		storerMock := genericMocks.NewStorerMockWithEpoch(1)
+		blockProc := createMockShardAPIProcessor(0, headerHash, storerMock, true, true)
+
+		stateDiff, err := blockProc.GetTransactionStateDiff(apiTx)
+		require.Error(t, err)
+		require.Nil(t, stateDiff)
+	})
+	t.Run("accounts repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		storerMock := genericMocks.NewStorerMockWithEpoch(1)
+		_ = storerMock.Put(headerHash, headerBytes)
+		_ = storerMock.Put(previousHeaderHash, previousHeaderBytes)
+		blockProc := createMockShardAPIProcessor(0, headerHash, storerMock, true, true)
+
+		expectedErr := errors.New("expected error")
+		blockProc.alteredAccountsProvider = &testscommon.AlteredAccountsProviderStub{
+			ExtractAlteredAccountsFromPoolCalled: func(_ *outportcore.TransactionPool, _ shared.AlteredAccountsOptions) (map[string]*alteredAccount.AlteredAccount, error) {
+				return map[string]*alteredAccount.AlteredAccount{
+					hex.EncodeToString(sender): {Address: hex.EncodeToString(sender)},
+				}, nil
+			},
+		}
+		blockProc.accountsRepository = &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(_ []byte, _ api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				return nil, nil, expectedErr
+			},
+		}
+
+		stateDiff, err := blockProc.GetTransactionStateDiff(apiTx)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, stateDiff)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		storerMock := genericMocks.NewStorerMockWithEpoch(1)
+		_ = storerMock.Put(headerHash, headerBytes)
+		_ = storerMock.Put(previousHeaderHash, previousHeaderBytes)
+		blockProc := createMockShardAPIProcessor(0, headerHash, storerMock, true, true)
+		blockProc.marshalizer = marshaller
+
+		blockProc.alteredAccountsProvider = &testscommon.AlteredAccountsProviderStub{
+			ExtractAlteredAccountsFromPoolCalled: func(txPool *outportcore.TransactionPool, options shared.AlteredAccountsOptions) (map[string]*alteredAccount.AlteredAccount, error) {
+				require.Len(t, txPool.Transactions, 1)
+				require.Len(t, txPool.SmartContractResults, 1)
+				require.True(t, options.WithAdditionalOutportData)
+				require.Equal(t, []byte("root"), options.AccountQueryOptions.BlockRootHash)
+
+				return map[string]*alteredAccount.AlteredAccount{
+					hex.EncodeToString(sender): {
+						Address: hex.EncodeToString(sender),
+						Nonce:   4,
+						Balance: "95",
+						Tokens: []*alteredAccount.AccountTokenData{
+							{Identifier: "TKN-abcdef", Balance: "1"},
+						},
+					},
+					hex.EncodeToString(receiver): {
+						Address: hex.EncodeToString(receiver),
+						Balance: "5",
+					},
+					hex.EncodeToString(contract): {
+						Address: hex.EncodeToString(contract),
+						Balance: "7",
+					},
+				}, nil
+			},
+		}
+
+		tokenBytes, _ := marshaller.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(3)})
+		blockProc.accountsRepository = &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				require.Equal(t, []byte("previous-root"), options.BlockRootHash)
+
+				switch string(address) {
+				case string(receiver):
+					return nil, nil, state.NewErrAccountNotFoundAtBlock(nil)
+				case string(contract):
+					account := stateMock.NewAccountWrapMock(address)
+					account.Balance = big.NewInt(7)
+					return account, nil, nil
+				}
+
+				account := stateMock.NewAccountWrapMock(address)
+				account.IncreaseNonce(3)
+				account.Balance = big.NewInt(100)
+				account.SetTrackableDataTrie(&trieMock.DataTrieTrackerStub{
+					RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+						require.Equal(t, []byte(esdtKeyPrefix+"TKN-abcdef"), key)
+						return tokenBytes, 0, nil
+					},
+				})
+				return account, nil, nil
+			},
+		}
+
+		stateDiff, err := blockProc.GetTransactionStateDiff(apiTx)
+		require.Nil(t, err)
+		require.Equal(t, []*txSimData.AccountStateDiff{
+			{
+				Address:               hex.EncodeToString(receiver),
+				BalanceBefore:         "0",
+				BalanceAfter:          "5",
+				DeveloperRewardBefore: "0",
+				DeveloperRewardAfter:  "0",
+			},
+			{
+				Address:               hex.EncodeToString(sender),
+				NonceBefore:           3,
+				NonceAfter:            4,
+				BalanceBefore:         "100",
+				BalanceAfter:          "95",
+				DeveloperRewardBefore: "0",
+				DeveloperRewardAfter:  "0",
+				ESDTBalances: []*txSimData.ESDTBalanceDiff{
+					{Identifier: "TKN-abcdef", BalanceBefore: "3", BalanceAfter: "1"},
+				},
+			},
+		}, stateDiff)
+	})
+}
@@ -23,6 +23,15 @@ var ErrNilDelegatedListHandler = errors.New("nil delegated list handler")
 // ErrNilAPITransactionHandler signals that a nil api transaction handler has been provided
 var ErrNilAPITransactionHandler = errors.New("nil api transaction handler")
 
+// ErrNilAPIMempoolHandler signals that a nil api mempool handler has been provided
+var ErrNilAPIMempoolHandler = errors.New("nil api mempool handler")
+
+// ErrNilAPITxsQueueHandler signals that a nil api transactions queue handler has been provided
+var ErrNilAPITxsQueueHandler = errors.New("nil api transactions queue handler")
+
+// ErrNilAPITransactionChecker signals that a nil api transaction checker has been provided
+var ErrNilAPITransactionChecker = errors.New("nil api transaction checker")
+
 // ErrNilAPIBlockHandler signals that a nil api block handler has been provided
 var ErrNilAPIBlockHandler = errors.New("nil api block handler")
 
@@ -46,3 +55,11 @@ var ErrNilManagedPeersMonitor = errors.New("nil managed peers monitor")
 
 // ErrNilNodesCoordinator signals a nil nodes coordinator has been provided
 var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrTransactionCannotBeReExecuted signals that the requested transaction is not a user transaction and cannot be
+// re-executed against the historical state
+var ErrTransactionCannotBeReExecuted = errors.New("only user transactions can be re-executed")
+
+// ErrTransactionNotExecuted signals that the block of the requested transaction is unknown. This happens for
+// transactions which were not yet executed or when the database lookup extension is disabled
+var ErrTransactionNotExecuted = errors.New("transaction block is unknown, the transaction was not executed yet or the database lookup extension is disabled")
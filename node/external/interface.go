@@ -35,7 +35,11 @@ type StatusMetricsHandler interface {
 
 // TransactionEvaluator defines the actions which should be handler by a transaction evaluator
 type TransactionEvaluator interface {
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionExecution(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceExecutedTransaction(txHash []byte, blockHash []byte) (*txSimData.TransactionTrace, error)
+	ReplayExecutedTransaction(txHash []byte, blockHash []byte) (*txSimData.ReplayResults, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	IsInterfaceNil() bool
 }
@@ -65,8 +69,30 @@ type APITransactionHandler interface {
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionStatus(hash string) (*common.TransactionStatusApiResponse, error)
 	UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	PopulateComputedFields(tx *transaction.ApiTransactionResult)
 	UnmarshalReceipt(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	IsInterfaceNil() bool
 }
+
+// APIMempoolHandler defines what an API mempool handler should be able to do
+type APIMempoolHandler interface {
+	GetTransactionsPoolSenderScore(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error)
+	GetTransactionsPoolSelectionPreview(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+// APITxsQueueHandler defines what an API transactions queue handler should be able to do
+type APITxsQueueHandler interface {
+	EnqueueTransaction(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error)
+	GetTransactionsQueueState() *common.TransactionsQueueStateApiResponse
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// APITransactionChecker defines what an API transaction checker should be able to do
+type APITransactionChecker interface {
+	CheckTransaction(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error)
+	IsInterfaceNil() bool
+}
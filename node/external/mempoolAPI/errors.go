@@ -0,0 +1,27 @@
+package mempoolAPI
+
+import "errors"
+
+// ErrNilDataPool signals that a nil data pool has been provided
+var ErrNilDataPool = errors.New("nil data pool")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilAddressPubKeyConverter signals that a nil address pubkey converter has been provided
+var ErrNilAddressPubKeyConverter = errors.New("nil address pubkey converter")
+
+// ErrNilEconomicsHandler signals that a nil economics handler has been provided
+var ErrNilEconomicsHandler = errors.New("nil economics handler")
+
+// ErrNilAccountsRepository signals that a nil accounts repository has been provided
+var ErrNilAccountsRepository = errors.New("nil accounts repository")
+
+// ErrInvalidAddress signals that an invalid address has been provided
+var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrSenderNotInSelfShard signals that the provided sender does not belong to the shard of the node
+var ErrSenderNotInSelfShard = errors.New("sender does not belong to the node's shard")
+
+// ErrCannotInspectTransactionsPool signals that the transactions pool of the node cannot be inspected
+var ErrCannotInspectTransactionsPool = errors.New("cannot inspect the transactions pool")
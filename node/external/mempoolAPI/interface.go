@@ -0,0 +1,30 @@
+package mempoolAPI
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+)
+
+// EconomicsHandler defines the economics data needed when inspecting the transactions pool
+type EconomicsHandler interface {
+	ComputeTxFee(tx data.TransactionWithFeeHandler) *big.Int
+	MaxGasLimitPerBlock(shardID uint32) uint64
+	MinGasPrice() uint64
+	MinGasLimit() uint64
+	MinGasPriceForProcessing() uint64
+	IsInterfaceNil() bool
+}
+
+// txCacheHandler defines the read-only operations of the transactions cache used by the inspector
+type txCacheHandler interface {
+	ForEachTransaction(function txcache.ForEachTransaction)
+	GetTransactionsPoolForSender(sender string) []*txcache.WrappedTransaction
+}
+
+// userAccountHandler defines the account fields used by the selection preview
+type userAccountHandler interface {
+	GetNonce() uint64
+	GetBalance() *big.Int
+}
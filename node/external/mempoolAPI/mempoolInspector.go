@@ -0,0 +1,391 @@
+package mempoolAPI
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("node/external/mempoolAPI")
+
+const (
+	skipReasonNonceGap              = "nonce gap"
+	skipReasonNonceTooLow           = "nonce too low"
+	skipReasonInsufficientBalance   = "insufficient balance"
+	skipReasonBlockGasLimitReached  = "block gas limit reached"
+	skipReasonSelectionLimitReached = "selection limit reached"
+)
+
+// ArgsMempoolInspector holds the arguments needed to create a mempool inspector
+type ArgsMempoolInspector struct {
+	DataPool               dataRetriever.PoolsHolder
+	ShardCoordinator       sharding.Coordinator
+	AddressPubKeyConverter core.PubkeyConverter
+	EconomicsHandler       EconomicsHandler
+	AccountsRepository     state.AccountsRepository
+}
+
+type senderScore struct {
+	address []byte
+	score   uint32
+}
+
+type senderAccountState struct {
+	nonce   uint64
+	balance *big.Int
+}
+
+type mempoolInspector struct {
+	dataPool               dataRetriever.PoolsHolder
+	shardCoordinator       sharding.Coordinator
+	addressPubKeyConverter core.PubkeyConverter
+	economicsHandler       EconomicsHandler
+	accountsRepository     state.AccountsRepository
+}
+
+// NewMempoolInspector creates a component able to inspect the transactions pool of the node's own shard
+func NewMempoolInspector(args ArgsMempoolInspector) (*mempoolInspector, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mempoolInspector{
+		dataPool:               args.DataPool,
+		shardCoordinator:       args.ShardCoordinator,
+		addressPubKeyConverter: args.AddressPubKeyConverter,
+		economicsHandler:       args.EconomicsHandler,
+		accountsRepository:     args.AccountsRepository,
+	}, nil
+}
+
+func checkArgs(args ArgsMempoolInspector) error {
+	if check.IfNil(args.DataPool) {
+		return ErrNilDataPool
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return ErrNilAddressPubKeyConverter
+	}
+	if check.IfNil(args.EconomicsHandler) {
+		return ErrNilEconomicsHandler
+	}
+	if check.IfNil(args.AccountsRepository) {
+		return ErrNilAccountsRepository
+	}
+
+	return nil
+}
+
+// GetTransactionsPoolSenderScore returns an approximation of the score of the provided sender, recomputed from the transactions
+// found in pool, along with its rank among all the senders found in the transactions pool. A sender without transactions in pool has
+// score 0 and is not ranked
+func (mi *mempoolInspector) GetTransactionsPoolSenderScore(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error) {
+	senderAddr, err := mi.decodeSelfShardAddress(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	cache, err := mi.getSelfShardTxCache()
+	if err != nil {
+		return nil, err
+	}
+
+	senders := mi.getSendersSortedByScore(cache)
+	response := &common.TransactionsPoolSenderScoreApiResponse{
+		Sender:          sender,
+		NumSenders:      len(senders),
+		IsApproximation: true,
+	}
+
+	for idx, senderData := range senders {
+		if !bytes.Equal(senderData.address, senderAddr) {
+			continue
+		}
+
+		response.Score = senderData.score
+		response.NumTxs = len(cache.GetTransactionsPoolForSender(string(senderAddr)))
+		response.Rank = computeRank(senders, idx)
+		break
+	}
+
+	return response, nil
+}
+
+// computeRank returns 1 + the number of senders having a strictly higher score, so that senders with equal scores
+// share the same rank
+func computeRank(sortedSenders []*senderScore, idx int) int {
+	rank := idx + 1
+	for rank > 1 && sortedSenders[rank-2].score == sortedSenders[idx].score {
+		rank--
+	}
+
+	return rank
+}
+
+// GetTransactionsPoolSelectionPreview returns an approximation of the selection of transactions for the next block, previewed
+// without altering the state of the transactions cache, followed by the checks against the current state of the senders and
+// the block gas limit. If a sender is provided, only its transactions are listed, while the totals refer to the whole
+// selection
+func (mi *mempoolInspector) GetTransactionsPoolSelectionPreview(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error) {
+	var senderFilter []byte
+	var err error
+	if len(sender) > 0 {
+		senderFilter, err = mi.decodeSelfShardAddress(sender)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cache, err := mi.getSelfShardTxCache()
+	if err != nil {
+		return nil, err
+	}
+
+	senders := mi.getSendersSortedByScore(cache)
+	accounts := make(map[string]*senderAccountState, len(senders))
+	for _, senderData := range senders {
+		accounts[string(senderData.address)], err = mi.getSenderAccountState(senderData.address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	selected := previewSelection(
+		cache,
+		senders,
+		accounts,
+		process.MaxNumOfTxsToSelect,
+		process.NumTxPerSenderBatchForFillingMiniblock,
+		process.MaxGasBandwidthPerBatchPerSender,
+	)
+	notSelected := getNotSelectedTxs(cache, senders, accounts, selected)
+
+	response := &common.TransactionsPoolSelectionPreviewApiResponse{
+		Selected:        make([]common.TransactionsPoolSelectionPreviewItem, 0),
+		Skipped:         make([]common.TransactionsPoolSelectionPreviewItem, 0),
+		IsApproximation: true,
+	}
+	shouldInclude := func(tx *txcache.WrappedTransaction) bool {
+		return len(senderFilter) == 0 || bytes.Equal(tx.Tx.GetSndAddr(), senderFilter)
+	}
+
+	maxGasLimitPerBlock := mi.economicsHandler.MaxGasLimitPerBlock(mi.shardCoordinator.SelfId())
+	for _, tx := range selected {
+		skipReason := mi.checkSelectedTx(tx, accounts, response.GasSelected, maxGasLimitPerBlock)
+		if len(skipReason) == 0 {
+			response.NumSelected++
+			response.GasSelected += tx.Tx.GetGasLimit()
+		}
+		if !shouldInclude(tx) {
+			continue
+		}
+
+		item := mi.createPreviewItem(tx, skipReason)
+		if len(skipReason) == 0 {
+			response.Selected = append(response.Selected, item)
+			continue
+		}
+
+		response.Skipped = append(response.Skipped, item)
+	}
+
+	for _, entry := range notSelected {
+		if shouldInclude(entry.tx) {
+			response.Skipped = append(response.Skipped, mi.createPreviewItem(entry.tx, entry.skipReason))
+		}
+	}
+
+	return response, nil
+}
+
+// checkSelectedTx verifies a selected transaction against the state of its sender and the gas already consumed in block.
+// On success, the state of the sender is updated accordingly and an empty skip reason is returned
+func (mi *mempoolInspector) checkSelectedTx(
+	tx *txcache.WrappedTransaction,
+	accounts map[string]*senderAccountState,
+	gasSelected uint64,
+	maxGasLimitPerBlock uint64,
+) string {
+	account := accounts[string(tx.Tx.GetSndAddr())]
+	nonce := tx.Tx.GetNonce()
+	if nonce < account.nonce {
+		return skipReasonNonceTooLow
+	}
+	if nonce > account.nonce {
+		return skipReasonNonceGap
+	}
+
+	gasLimit := tx.Tx.GetGasLimit()
+	if gasSelected+gasLimit > maxGasLimitPerBlock {
+		return skipReasonBlockGasLimitReached
+	}
+
+	cost := big.NewInt(0)
+	if tx.Tx.GetValue() != nil {
+		cost.Set(tx.Tx.GetValue())
+	}
+	txWithFee, ok := tx.Tx.(data.TransactionWithFeeHandler)
+	if ok {
+		cost.Add(cost, mi.economicsHandler.ComputeTxFee(txWithFee))
+	}
+	if account.balance.Cmp(cost) < 0 {
+		return skipReasonInsufficientBalance
+	}
+
+	account.nonce++
+	account.balance.Sub(account.balance, cost)
+
+	return ""
+}
+
+type notSelectedTx struct {
+	tx         *txcache.WrappedTransaction
+	skipReason string
+}
+
+// getNotSelectedTxs returns the transactions from pool left out of the selection. The transactions following a nonce
+// gap can not be selected, while the others were left out because the selection was full. It should be called before
+// the selected transactions are checked, as the checks alter the state of the senders
+func getNotSelectedTxs(
+	cache txCacheHandler,
+	senders []*senderScore,
+	accounts map[string]*senderAccountState,
+	selected []*txcache.WrappedTransaction,
+) []notSelectedTx {
+	selectedHashes := make(map[string]struct{}, len(selected))
+	for _, tx := range selected {
+		selectedHashes[string(tx.TxHash)] = struct{}{}
+	}
+
+	notSelected := make([]notSelectedTx, 0)
+	for _, senderData := range senders {
+		txs := cache.GetTransactionsPoolForSender(string(senderData.address))
+		sort.SliceStable(txs, func(i, j int) bool {
+			return txs[i].Tx.GetNonce() < txs[j].Tx.GetNonce()
+		})
+
+		accountNonce := accounts[string(senderData.address)].nonce
+		expectedNonce := accountNonce
+		detectedGap := false
+		for _, tx := range txs {
+			nonce := tx.Tx.GetNonce()
+			if nonce > expectedNonce {
+				detectedGap = true
+			}
+			if !detectedGap && nonce >= accountNonce {
+				expectedNonce = nonce + 1
+			}
+
+			_, isSelected := selectedHashes[string(tx.TxHash)]
+			if isSelected {
+				continue
+			}
+
+			notSelected = append(notSelected, notSelectedTx{
+				tx:         tx,
+				skipReason: getNotSelectedSkipReason(nonce, accountNonce, detectedGap),
+			})
+		}
+	}
+
+	return notSelected
+}
+
+func getNotSelectedSkipReason(nonce uint64, accountNonce uint64, detectedGap bool) string {
+	switch {
+	case nonce < accountNonce:
+		return skipReasonNonceTooLow
+	case detectedGap:
+		return skipReasonNonceGap
+	default:
+		return skipReasonSelectionLimitReached
+	}
+}
+
+func (mi *mempoolInspector) getSenderAccountState(address []byte) (*senderAccountState, error) {
+	account, _, err := mi.accountsRepository.GetAccountWithBlockInfo(address, api.AccountQueryOptions{})
+	if err != nil {
+		var errAccountNotFound *state.ErrAccountNotFoundAtBlock
+		if errors.As(err, &errAccountNotFound) {
+			return &senderAccountState{
+				nonce:   0,
+				balance: big.NewInt(0),
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	userAccount, ok := account.(userAccountHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w: wrong type assertion for account", ErrCannotInspectTransactionsPool)
+	}
+
+	balance := big.NewInt(0)
+	if userAccount.GetBalance() != nil {
+		balance.Set(userAccount.GetBalance())
+	}
+
+	return &senderAccountState{
+		nonce:   userAccount.GetNonce(),
+		balance: balance,
+	}, nil
+}
+
+func (mi *mempoolInspector) getSelfShardTxCache() (txCacheHandler, error) {
+	selfShardID := mi.shardCoordinator.SelfId()
+	cacheID := process.ShardCacherIdentifier(selfShardID, selfShardID)
+	cache, ok := mi.dataPool.Transactions().ShardDataStore(cacheID).(txCacheHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w: wrong type assertion for the transactions cache", ErrCannotInspectTransactionsPool)
+	}
+
+	return cache, nil
+}
+
+func (mi *mempoolInspector) decodeSelfShardAddress(address string) ([]byte, error) {
+	addressBytes, err := mi.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+	}
+
+	if mi.shardCoordinator.ComputeId(addressBytes) != mi.shardCoordinator.SelfId() {
+		return nil, ErrSenderNotInSelfShard
+	}
+
+	return addressBytes, nil
+}
+
+func (mi *mempoolInspector) createPreviewItem(tx *txcache.WrappedTransaction, skipReason string) common.TransactionsPoolSelectionPreviewItem {
+	return common.TransactionsPoolSelectionPreviewItem{
+		Hash:       hex.EncodeToString(tx.TxHash),
+		Sender:     mi.addressPubKeyConverter.SilentEncode(tx.Tx.GetSndAddr(), log),
+		Nonce:      tx.Tx.GetNonce(),
+		GasLimit:   tx.Tx.GetGasLimit(),
+		GasPrice:   tx.Tx.GetGasPrice(),
+		SkipReason: skipReason,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mi *mempoolInspector) IsInterfaceNil() bool {
+	return mi == nil
+}
@@ -0,0 +1,481 @@
+package mempoolAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	dataRetrieverMock "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
+	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/testscommon/txcachemocks"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	minGasPriceToTest = uint64(1_000_000_000)
+	minGasLimitToTest = uint64(50_000)
+	txFeeToTest       = int64(1000)
+
+	gasProcessingDivisorToTest = uint64(100)
+)
+
+var expectedErr = errors.New("expected error")
+
+type accountMock struct {
+	nonce   uint64
+	balance *big.Int
+}
+
+func (mock *accountMock) AddressBytes() []byte {
+	return nil
+}
+
+func (mock *accountMock) IncreaseNonce(nonce uint64) {
+	mock.nonce += nonce
+}
+
+func (mock *accountMock) GetNonce() uint64 {
+	return mock.nonce
+}
+
+func (mock *accountMock) GetBalance() *big.Int {
+	return mock.balance
+}
+
+func (mock *accountMock) IsInterfaceNil() bool {
+	return mock == nil
+}
+
+func createMockArgsMempoolInspector() ArgsMempoolInspector {
+	return ArgsMempoolInspector{
+		DataPool:               &dataRetrieverMock.PoolsHolderStub{},
+		ShardCoordinator:       testscommon.NewMultiShardsCoordinatorMock(1),
+		AddressPubKeyConverter: &testscommon.PubkeyConverterMock{},
+		EconomicsHandler:       createEconomicsHandlerToTest(math.MaxUint64),
+		AccountsRepository:     &stateMock.AccountsRepositoryStub{},
+	}
+}
+
+func createEconomicsHandlerToTest(maxGasLimitPerBlock uint64) *economicsmocks.EconomicsHandlerStub {
+	return &economicsmocks.EconomicsHandlerStub{
+		ComputeTxFeeCalled: func(tx data.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(txFeeToTest)
+		},
+		MaxGasLimitPerBlockCalled: func(shardID uint32) uint64 {
+			return maxGasLimitPerBlock
+		},
+		MinGasPriceCalled: func() uint64 {
+			return minGasPriceToTest
+		},
+		MinGasLimitCalled: func() uint64 {
+			return minGasLimitToTest
+		},
+		MinGasPriceProcessingCalled: func() uint64 {
+			return minGasPriceToTest / gasProcessingDivisorToTest
+		},
+	}
+}
+
+func createTxCache(t *testing.T, txs ...*txcache.WrappedTransaction) *txcache.TxCache {
+	cache, err := txcache.NewTxCache(txcache.ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  4,
+		NumBytesPerSenderThreshold: 1_048_576, // 1 MB
+		CountPerSenderThreshold:    math.MaxUint32,
+	}, &txcachemocks.TxGasHandlerMock{
+		MinimumGasMove:       minGasLimitToTest,
+		MinimumGasPrice:      minGasPriceToTest,
+		GasProcessingDivisor: gasProcessingDivisorToTest,
+	})
+	require.NoError(t, err)
+
+	for _, tx := range txs {
+		_, added := cache.AddTx(tx)
+		require.True(t, added)
+	}
+
+	return cache
+}
+
+func createTx(hash string, sender string, nonce uint64, gasPrice uint64, value int64) *txcache.WrappedTransaction {
+	tx := &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		GasLimit: minGasLimitToTest,
+		GasPrice: gasPrice,
+		Value:    big.NewInt(value),
+	}
+
+	return &txcache.WrappedTransaction{
+		Tx:     tx,
+		TxHash: []byte(hash),
+		Size:   128,
+	}
+}
+
+func createDataPoolWithCache(cache storage.Cacher) dataRetriever.PoolsHolder {
+	return &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{
+				ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+					return cache
+				},
+			}
+		},
+	}
+}
+
+func createAccountsRepositoryToTest(accounts map[string]*accountMock) state.AccountsRepository {
+	return &stateMock.AccountsRepositoryStub{
+		GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			account, ok := accounts[string(address)]
+			if !ok {
+				return nil, nil, state.NewErrAccountNotFoundAtBlock(holders.NewBlockInfo(nil, 0, nil))
+			}
+
+			return account, nil, nil
+		},
+	}
+}
+
+func toHex(address string) string {
+	return hex.EncodeToString([]byte(address))
+}
+
+func TestNewMempoolInspector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil data pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMempoolInspector()
+		args.DataPool = nil
+		inspector, err := NewMempoolInspector(args)
+		require.Nil(t, inspector)
+		require.Equal(t, ErrNilDataPool, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMempoolInspector()
+		args.ShardCoordinator = nil
+		inspector, err := NewMempoolInspector(args)
+		require.Nil(t, inspector)
+		require.Equal(t, ErrNilShardCoordinator, err)
+	})
+	t.Run("nil address pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMempoolInspector()
+		args.AddressPubKeyConverter = nil
+		inspector, err := NewMempoolInspector(args)
+		require.Nil(t, inspector)
+		require.Equal(t, ErrNilAddressPubKeyConverter, err)
+	})
+	t.Run("nil economics handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMempoolInspector()
+		args.EconomicsHandler = nil
+		inspector, err := NewMempoolInspector(args)
+		require.Nil(t, inspector)
+		require.Equal(t, ErrNilEconomicsHandler, err)
+	})
+	t.Run("nil accounts repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMempoolInspector()
+		args.AccountsRepository = nil
+		inspector, err := NewMempoolInspector(args)
+		require.Nil(t, inspector)
+		require.Equal(t, ErrNilAccountsRepository, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		inspector, err := NewMempoolInspector(createMockArgsMempoolInspector())
+		require.NoError(t, err)
+		require.False(t, inspector.IsInterfaceNil())
+	})
+}
+
+func TestMempoolInspector_GetTransactionsPoolSenderScore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		inspector, _ := NewMempoolInspector(createMockArgsMempoolInspector())
+		response, err := inspector.GetTransactionsPoolSenderScore("not hex")
+		require.Nil(t, response)
+		require.ErrorContains(t, err, ErrInvalidAddress.Error())
+	})
+	t.Run("sender in another shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMempoolInspector()
+		args.ShardCoordinator = &testscommon.ShardsCoordinatorMock{
+			ComputeIdCalled: func(address []byte) uint32 {
+				return 1
+			},
+		}
+		inspector, _ := NewMempoolInspector(args)
+		response, err := inspector.GetTransactionsPoolSenderScore(toHex("alice"))
+		require.Nil(t, response)
+		require.Equal(t, ErrSenderNotInSelfShard, err)
+	})
+	t.Run("cache not providing the transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMempoolInspector()
+		args.DataPool = createDataPoolWithCache(testscommon.NewCacherStub())
+		inspector, _ := NewMempoolInspector(args)
+		response, err := inspector.GetTransactionsPoolSenderScore(toHex("alice"))
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, ErrCannotInspectTransactionsPool))
+	})
+	t.Run("should rank the senders by score", func(t *testing.T) {
+		t.Parallel()
+
+		cache := createTxCache(t,
+			createTx("a0", "alice", 0, 2*minGasPriceToTest, 0),
+			createTx("a1", "alice", 1, 2*minGasPriceToTest, 0),
+			createTx("b0", "bob", 0, minGasPriceToTest, 0),
+			createTx("c0", "carol", 0, minGasPriceToTest, 0),
+		)
+		args := createMockArgsMempoolInspector()
+		args.DataPool = createDataPoolWithCache(cache)
+		inspector, _ := NewMempoolInspector(args)
+
+		alice, err := inspector.GetTransactionsPoolSenderScore(toHex("alice"))
+		require.NoError(t, err)
+		require.Equal(t, toHex("alice"), alice.Sender)
+		require.Equal(t, 1, alice.Rank)
+		require.Equal(t, 3, alice.NumSenders)
+		require.Equal(t, 2, alice.NumTxs)
+		require.True(t, alice.IsApproximation)
+
+		bob, err := inspector.GetTransactionsPoolSenderScore(toHex("bob"))
+		require.NoError(t, err)
+		require.Equal(t, 2, bob.Rank)
+		require.Greater(t, alice.Score, bob.Score)
+
+		carol, err := inspector.GetTransactionsPoolSenderScore(toHex("carol"))
+		require.NoError(t, err)
+		require.Equal(t, 2, carol.Rank)
+		require.Equal(t, bob.Score, carol.Score)
+
+		unknown, err := inspector.GetTransactionsPoolSenderScore(toHex("dave"))
+		require.NoError(t, err)
+		require.Equal(t, &common.TransactionsPoolSenderScoreApiResponse{
+			Sender:          toHex("dave"),
+			NumSenders:      3,
+			IsApproximation: true,
+		}, unknown)
+	})
+}
+
+func TestMempoolInspector_GetTransactionsPoolSelectionPreview(t *testing.T) {
+	t.Parallel()
+
+	t.Run("accounts repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMempoolInspector()
+		args.DataPool = createDataPoolWithCache(createTxCache(t, createTx("a0", "alice", 0, minGasPriceToTest, 0)))
+		args.AccountsRepository = &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		inspector, _ := NewMempoolInspector(args)
+		response, err := inspector.GetTransactionsPoolSelectionPreview("")
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should report the skip reasons", func(t *testing.T) {
+		t.Parallel()
+
+		cache := createTxCache(t,
+			// middle nonce gap
+			createTx("a5", "alice", 5, minGasPriceToTest, 0),
+			createTx("a6", "alice", 6, minGasPriceToTest, 0),
+			createTx("a8", "alice", 8, minGasPriceToTest, 0),
+			// initial nonce gap
+			createTx("b5", "bob", 5, minGasPriceToTest, 0),
+			// insufficient balance, then the next nonce cannot be executed
+			createTx("c0", "carol", 0, minGasPriceToTest, 10),
+			createTx("c1", "carol", 1, minGasPriceToTest, 0),
+			// unknown account
+			createTx("d0", "dave", 0, minGasPriceToTest, 0),
+			// nonce already executed
+			createTx("e1", "eve", 1, minGasPriceToTest, 0),
+			createTx("e2", "eve", 2, minGasPriceToTest, 0),
+		)
+		args := createMockArgsMempoolInspector()
+		args.DataPool = createDataPoolWithCache(cache)
+		args.AccountsRepository = createAccountsRepositoryToTest(map[string]*accountMock{
+			"alice": {nonce: 5, balance: big.NewInt(1_000_000)},
+			"bob":   {nonce: 3, balance: big.NewInt(1_000_000)},
+			"carol": {nonce: 0, balance: big.NewInt(txFeeToTest)},
+			"eve":   {nonce: 2, balance: big.NewInt(1_000_000)},
+		})
+		inspector, _ := NewMempoolInspector(args)
+
+		response, err := inspector.GetTransactionsPoolSelectionPreview("")
+		require.NoError(t, err)
+		require.Equal(t, 3, response.NumSelected)
+		require.Equal(t, 3*minGasLimitToTest, response.GasSelected)
+		require.True(t, response.IsApproximation)
+
+		selected := make(map[string]struct{})
+		for _, item := range response.Selected {
+			selected[item.Hash] = struct{}{}
+		}
+		require.Equal(t, map[string]struct{}{
+			toHex("a5"): {},
+			toHex("a6"): {},
+			toHex("e2"): {},
+		}, selected)
+
+		skipped := make(map[string]string)
+		for _, item := range response.Skipped {
+			skipped[item.Hash] = item.SkipReason
+		}
+		require.Equal(t, map[string]string{
+			toHex("a8"): skipReasonNonceGap,
+			toHex("b5"): skipReasonNonceGap,
+			toHex("c0"): skipReasonInsufficientBalance,
+			toHex("c1"): skipReasonNonceGap,
+			toHex("d0"): skipReasonInsufficientBalance,
+			toHex("e1"): skipReasonNonceTooLow,
+		}, skipped)
+	})
+	t.Run("should stop at the block gas limit and filter by sender", func(t *testing.T) {
+		t.Parallel()
+
+		cache := createTxCache(t,
+			createTx("a0", "alice", 0, minGasPriceToTest, 0),
+			createTx("a1", "alice", 1, minGasPriceToTest, 0),
+			createTx("a2", "alice", 2, minGasPriceToTest, 0),
+			createTx("b0", "bob", 0, 2*minGasPriceToTest, 0),
+		)
+		args := createMockArgsMempoolInspector()
+		args.DataPool = createDataPoolWithCache(cache)
+		args.EconomicsHandler = createEconomicsHandlerToTest(3 * minGasLimitToTest)
+		args.AccountsRepository = createAccountsRepositoryToTest(map[string]*accountMock{
+			"alice": {nonce: 0, balance: big.NewInt(1_000_000)},
+			"bob":   {nonce: 0, balance: big.NewInt(1_000_000)},
+		})
+		inspector, _ := NewMempoolInspector(args)
+
+		response, err := inspector.GetTransactionsPoolSelectionPreview(toHex("alice"))
+		require.NoError(t, err)
+		require.Equal(t, 3, response.NumSelected)
+		require.Equal(t, 3*minGasLimitToTest, response.GasSelected)
+
+		// the transaction of bob is selected first, as its sender has a higher score
+		require.Equal(t, []common.TransactionsPoolSelectionPreviewItem{
+			{Hash: toHex("a0"), Sender: toHex("alice"), Nonce: 0, GasLimit: minGasLimitToTest, GasPrice: minGasPriceToTest},
+			{Hash: toHex("a1"), Sender: toHex("alice"), Nonce: 1, GasLimit: minGasLimitToTest, GasPrice: minGasPriceToTest},
+		}, response.Selected)
+		require.Equal(t, []common.TransactionsPoolSelectionPreviewItem{
+			{Hash: toHex("a2"), Sender: toHex("alice"), Nonce: 2, GasLimit: minGasLimitToTest, GasPrice: minGasPriceToTest, SkipReason: skipReasonBlockGasLimitReached},
+		}, response.Skipped)
+	})
+}
+
+func TestPreviewSelection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should stop when the selection is full", func(t *testing.T) {
+		t.Parallel()
+
+		cache := createTxCache(t,
+			createTx("a0", "alice", 0, minGasPriceToTest, 0),
+			createTx("a1", "alice", 1, minGasPriceToTest, 0),
+			createTx("a2", "alice", 2, minGasPriceToTest, 0),
+			createTx("b0", "bob", 0, minGasPriceToTest, 0),
+		)
+		senders := []*senderScore{{address: []byte("alice")}, {address: []byte("bob")}}
+		accounts := map[string]*senderAccountState{
+			"alice": {nonce: 0, balance: big.NewInt(0)},
+			"bob":   {nonce: 0, balance: big.NewInt(0)},
+		}
+
+		// batches of one transaction per sender, in the order of the senders
+		selected := previewSelection(cache, senders, accounts, 3, 1, math.MaxUint64)
+		require.Equal(t, []string{"a0", "b0", "a1"}, getHashes(selected))
+	})
+	t.Run("should match the selection of the transactions cache", func(t *testing.T) {
+		t.Parallel()
+
+		txs := make([]*txcache.WrappedTransaction, 0)
+		for nonce := uint64(0); nonce < 5; nonce++ {
+			txs = append(txs,
+				createTx(fmt.Sprintf("a%d", nonce), "alice", nonce, 3*minGasPriceToTest, 0),
+				createTx(fmt.Sprintf("b%d", nonce), "bob", nonce, 2*minGasPriceToTest, 0),
+				createTx(fmt.Sprintf("c%d", nonce), "carol", nonce, minGasPriceToTest, 0),
+			)
+		}
+		// middle nonce gap
+		txs = append(txs, createTx("c9", "carol", 9, minGasPriceToTest, 0))
+		cache := createTxCache(t, txs...)
+
+		inspector, _ := NewMempoolInspector(createMockArgsMempoolInspector())
+		senders := inspector.getSendersSortedByScore(cache)
+		require.Equal(t, []byte("alice"), senders[0].address)
+		require.Equal(t, []byte("bob"), senders[1].address)
+		require.Equal(t, []byte("carol"), senders[2].address)
+		require.Greater(t, senders[0].score, senders[1].score)
+		require.Greater(t, senders[1].score, senders[2].score)
+
+		accounts := map[string]*senderAccountState{
+			"alice": {nonce: 0, balance: big.NewInt(0)},
+			"bob":   {nonce: 0, balance: big.NewInt(0)},
+			"carol": {nonce: 0, balance: big.NewInt(0)},
+		}
+		// batches of two transactions per sender, given by the bandwidth
+		bandwidth := 2 * minGasLimitToTest
+		preview := previewSelection(cache, senders, accounts, 20, 1, bandwidth)
+		require.Len(t, preview, 15)
+		// the preview is computed first, as the real selection alters the state of the cache
+		selected := cache.SelectTransactionsWithBandwidth(20, 1, bandwidth)
+		require.Equal(t, getHashes(selected), getHashes(preview))
+	})
+}
+
+func getHashes(txs []*txcache.WrappedTransaction) []string {
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, string(tx.TxHash))
+	}
+
+	return hashes
+}
+
+func TestComputeRank(t *testing.T) {
+	t.Parallel()
+
+	senders := []*senderScore{{score: 50}, {score: 40}, {score: 40}, {score: 40}, {score: 10}}
+	require.Equal(t, 1, computeRank(senders, 0))
+	require.Equal(t, 2, computeRank(senders, 1))
+	require.Equal(t, 2, computeRank(senders, 2))
+	require.Equal(t, 2, computeRank(senders, 3))
+	require.Equal(t, 5, computeRank(senders, 4))
+}
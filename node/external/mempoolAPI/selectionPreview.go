@@ -0,0 +1,208 @@
+package mempoolAPI
+
+import (
+	"bytes"
+	"math"
+	"sort"
+
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+)
+
+// The transactions cache does not expose the senders scores nor a dry run of its selection, so they are approximated here
+// from the transactions found in pool. The approximation follows the formula and the selection loop of the cache version
+// in use, so it should be revised together with the storage library. It does not account for the grace period of the
+// senders with an initial nonce gap and uses the accounts state instead of the senders nonces notified to the cache.
+
+// the following constants mirror the ones used by the transactions cache when computing the senders scores
+const (
+	numberOfScoreChunks   = uint32(100)
+	priceBinaryResolution = 10
+	gasBinaryResolution   = 4
+)
+
+type senderScoreParams struct {
+	count    uint64
+	feeScore uint64
+	gas      uint64
+}
+
+// senderSelectionState holds the progress of a sender while previewing the selection, the same way the transactions
+// cache keeps it for each sender during a real selection
+type senderSelectionState struct {
+	score         uint32
+	txs           []*txcache.WrappedTransaction
+	nextIndex     int
+	previousNonce uint64
+	detectedGap   bool
+}
+
+// computeSendersScores approximates the scores of the senders from pool using the formula of the transactions cache.
+// The scores are recomputed from the transactions it holds, without altering its state
+func (mi *mempoolInspector) computeSendersScores(cache txCacheHandler) map[string]uint32 {
+	paramsPerSender := make(map[string]*senderScoreParams)
+	cache.ForEachTransaction(func(_ []byte, tx *txcache.WrappedTransaction) {
+		sender := string(tx.Tx.GetSndAddr())
+		params, ok := paramsPerSender[sender]
+		if !ok {
+			params = &senderScoreParams{}
+			paramsPerSender[sender] = params
+		}
+
+		params.count++
+		params.feeScore += tx.TxFeeScoreNormalized
+		params.gas += tx.Tx.GetGasLimit()
+	})
+
+	scores := make(map[string]uint32, len(paramsPerSender))
+	for sender, params := range paramsPerSender {
+		scores[sender] = mi.computeScore(params)
+	}
+
+	return scores
+}
+
+// computeScore returns the approximated score of a sender, as an integer 0-100
+func (mi *mempoolInspector) computeScore(params *senderScoreParams) uint32 {
+	allParamsDefined := params.feeScore > 0 && params.gas > 0 && params.count > 0
+	if !allParamsDefined {
+		return 0
+	}
+
+	minPrice := mi.economicsHandler.MinGasPrice()
+	minPriceProcessing := mi.economicsHandler.MinGasPriceForProcessing()
+	if minPriceProcessing == 0 {
+		return 0
+	}
+
+	priceShift := computeShiftMagnitude(minPrice, priceBinaryResolution)
+	for minPriceProcessing>>priceShift == 0 && priceShift > 0 {
+		priceShift--
+	}
+	gasShift := computeShiftMagnitude(mi.economicsHandler.MinGasLimit(), gasBinaryResolution)
+	ppuMin := minPriceProcessing >> priceShift
+	minPriceFactor := minPrice / minPriceProcessing
+	ppuDivider := minPriceFactor * minPriceFactor * minPriceFactor
+	if ppuMin == 0 || ppuDivider == 0 {
+		return 0
+	}
+
+	normalizedGas := params.gas >> gasShift
+	if normalizedGas == 0 {
+		normalizedGas = 1
+	}
+	ppuAvg := params.feeScore / normalizedGas
+	ppuRatio := ppuAvg << 3 / ppuMin
+	ppuScore := ppuRatio * ppuRatio * ppuRatio >> 9
+	ppuScoreAdjusted := float64(ppuScore) / float64(ppuDivider)
+
+	countPow2 := params.count * params.count
+	countScore := math.Log(float64(countPow2)+1) + 1
+
+	rawScore := ppuScoreAdjusted / countScore
+	asymptoticScore := (1/(1+math.Exp(-rawScore)) - 0.5) * 2
+
+	return uint32(asymptoticScore * float64(numberOfScoreChunks))
+}
+
+// computeShiftMagnitude returns the maximum shift magnitude of the number in order to maintain the given binary resolution
+func computeShiftMagnitude(x uint64, resolution uint8) uint64 {
+	m := uint64(0)
+	stopCondition := uint64(1) << resolution
+	for i := x; i > stopCondition; i >>= 1 {
+		m++
+	}
+
+	return m
+}
+
+// previewSelection approximates the selection of the transactions for a block, walking the senders in the descending
+// order of their score, in passes of score-weighted batches. The senders with an initial nonce gap against their
+// current state are left out, while a middle nonce gap stops the selection for that sender
+func previewSelection(
+	cache txCacheHandler,
+	senders []*senderScore,
+	accounts map[string]*senderAccountState,
+	numRequested int,
+	batchSizePerSender int,
+	bandwidthPerSender uint64,
+) []*txcache.WrappedTransaction {
+	states := make([]*senderSelectionState, 0, len(senders))
+	for _, senderData := range senders {
+		txs := cache.GetTransactionsPoolForSender(string(senderData.address))
+		if len(txs) == 0 {
+			continue
+		}
+
+		states = append(states, &senderSelectionState{
+			score:       senderData.score,
+			txs:         txs,
+			detectedGap: txs[0].Tx.GetNonce() > accounts[string(senderData.address)].nonce,
+		})
+	}
+
+	selected := make([]*txcache.WrappedTransaction, 0, numRequested)
+	for len(selected) < numRequested {
+		copiedInThisPass := 0
+		for _, state := range states {
+			batchSize := batchSizePerSender * int(state.score+1)
+			copied := state.selectBatch(numRequested-len(selected), batchSize, bandwidthPerSender)
+			selected = append(selected, copied...)
+			copiedInThisPass += len(copied)
+			if len(selected) == numRequested {
+				break
+			}
+		}
+
+		if copiedInThisPass == 0 {
+			break
+		}
+	}
+
+	return selected
+}
+
+func (state *senderSelectionState) selectBatch(availableSpace int, batchSize int, bandwidth uint64) []*txcache.WrappedTransaction {
+	copied := make([]*txcache.WrappedTransaction, 0)
+	if state.detectedGap {
+		return copied
+	}
+
+	copiedBandwidth := uint64(0)
+	for state.nextIndex < len(state.txs) && len(copied) < batchSize && len(copied) < availableSpace && copiedBandwidth < bandwidth {
+		tx := state.txs[state.nextIndex]
+		txNonce := tx.Tx.GetNonce()
+		if state.previousNonce > 0 && txNonce > state.previousNonce+1 {
+			state.detectedGap = true
+			break
+		}
+
+		copied = append(copied, tx)
+		copiedBandwidth += tx.Tx.GetGasLimit()
+		state.nextIndex++
+		state.previousNonce = txNonce
+	}
+
+	return copied
+}
+
+// getSendersSortedByScore returns the senders from pool along with their scores, in the descending order of their score
+func (mi *mempoolInspector) getSendersSortedByScore(cache txCacheHandler) []*senderScore {
+	scores := mi.computeSendersScores(cache)
+	senders := make([]*senderScore, 0, len(scores))
+	for address, score := range scores {
+		senders = append(senders, &senderScore{
+			address: []byte(address),
+			score:   score,
+		})
+	}
+
+	sort.Slice(senders, func(i, j int) bool {
+		if senders[i].score != senders[j].score {
+			return senders[i].score > senders[j].score
+		}
+
+		return bytes.Compare(senders[i].address, senders[j].address) < 0
+	})
+
+	return senders
+}
@@ -33,6 +33,9 @@ type ArgNodeApiResolver struct {
 	DirectStakedListHandler  DirectStakedListHandler
 	DelegatedListHandler     DelegatedListHandler
 	APITransactionHandler    APITransactionHandler
+	APIMempoolHandler        APIMempoolHandler
+	APITxsQueueHandler       APITxsQueueHandler
+	APITransactionChecker    APITransactionChecker
 	APIBlockHandler          blockAPI.APIBlockHandler
 	APIInternalBlockHandler  blockAPI.APIInternalBlockHandler
 	GenesisNodesSetupHandler sharding.GenesisNodesSetupHandler
@@ -54,6 +57,9 @@ type nodeApiResolver struct {
 	directStakedListHandler  DirectStakedListHandler
 	delegatedListHandler     DelegatedListHandler
 	apiTransactionHandler    APITransactionHandler
+	apiMempoolHandler        APIMempoolHandler
+	apiTxsQueueHandler       APITxsQueueHandler
+	apiTransactionChecker    APITransactionChecker
 	apiBlockHandler          blockAPI.APIBlockHandler
 	apiInternalBlockHandler  blockAPI.APIInternalBlockHandler
 	genesisNodesSetupHandler sharding.GenesisNodesSetupHandler
@@ -89,6 +95,15 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.APITransactionHandler) {
 		return nil, ErrNilAPITransactionHandler
 	}
+	if check.IfNil(arg.APIMempoolHandler) {
+		return nil, ErrNilAPIMempoolHandler
+	}
+	if check.IfNil(arg.APITxsQueueHandler) {
+		return nil, ErrNilAPITxsQueueHandler
+	}
+	if check.IfNil(arg.APITransactionChecker) {
+		return nil, ErrNilAPITransactionChecker
+	}
 	if check.IfNil(arg.APIBlockHandler) {
 		return nil, ErrNilAPIBlockHandler
 	}
@@ -123,6 +138,9 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		delegatedListHandler:     arg.DelegatedListHandler,
 		apiBlockHandler:          arg.APIBlockHandler,
 		apiTransactionHandler:    arg.APITransactionHandler,
+		apiMempoolHandler:        arg.APIMempoolHandler,
+		apiTxsQueueHandler:       arg.APITxsQueueHandler,
+		apiTransactionChecker:    arg.APITransactionChecker,
 		apiInternalBlockHandler:  arg.APIInternalBlockHandler,
 		genesisNodesSetupHandler: arg.GenesisNodesSetupHandler,
 		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
@@ -151,8 +169,97 @@ func (nar *nodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transacti
 }
 
 // SimulateTransactionExecution will simulate the provided transaction and return the simulation results
-func (nar *nodeApiResolver) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
-	return nar.apiTransactionEvaluator.SimulateTransactionExecution(tx)
+func (nar *nodeApiResolver) SimulateTransactionExecution(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nar.apiTransactionEvaluator.SimulateTransactionExecution(tx, withStateDiff)
+}
+
+// SimulateTransactionsBundle will simulate the provided transactions sequentially, on top of the same state, and return
+// the results of each transaction together with the aggregated state changes
+func (nar *nodeApiResolver) SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	return nar.apiTransactionEvaluator.SimulateTransactionsBundle(txs)
+}
+
+// TraceTransactionExecution will simulate the provided transaction and return the simulation results together with
+// the recorded execution frames
+func (nar *nodeApiResolver) TraceTransactionExecution(tx *transaction.Transaction) (*txSimData.TransactionTrace, error) {
+	return nar.apiTransactionEvaluator.TraceTransactionExecution(tx)
+}
+
+// TraceExecutedTransaction will re-execute the already executed transaction against the historical state and return
+// the execution results together with the recorded execution frames
+func (nar *nodeApiResolver) TraceExecutedTransaction(txHash string) (*txSimData.TransactionTrace, error) {
+	_, txHashBytes, blockHash, err := nar.getExecutedTransaction(txHash, false)
+	if err != nil {
+		return nil, err
+	}
+
+	trace, err := nar.apiTransactionEvaluator.TraceExecutedTransaction(txHashBytes, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	trace.Hash = txHash
+
+	return trace, nil
+}
+
+// ReplayTransaction will re-execute the already executed transaction against the historical state and return the
+// execution results together with their differences against the stored results
+func (nar *nodeApiResolver) ReplayTransaction(txHash string) (*txSimData.ReplayResults, error) {
+	apiTx, txHashBytes, blockHash, err := nar.getExecutedTransaction(txHash, true)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := nar.apiTransactionEvaluator.ReplayExecutedTransaction(txHashBytes, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	results.Hash = txHash
+	results.Diff = computeReplayDiff(apiTx, &results.SimulationResults)
+
+	return results, nil
+}
+
+// GetTransactionStateDiff will return the before and after values of the accounts altered by the executed transaction
+func (nar *nodeApiResolver) GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error) {
+	apiTx, err := nar.apiTransactionHandler.GetTransaction(hash, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(apiTx.BlockHash) == 0 {
+		return nil, ErrTransactionNotExecuted
+	}
+
+	return nar.apiBlockHandler.GetTransactionStateDiff(apiTx)
+}
+
+func (nar *nodeApiResolver) getExecutedTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, []byte, []byte, error) {
+	txHashBytes, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	apiTx, err := nar.apiTransactionHandler.GetTransaction(txHash, withResults)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	_, ok := apiTx.Tx.(*transaction.Transaction)
+	if !ok {
+		return nil, nil, nil, ErrTransactionCannotBeReExecuted
+	}
+	if len(apiTx.BlockHash) == 0 {
+		return nil, nil, nil, ErrTransactionNotExecuted
+	}
+
+	blockHash, err := hex.DecodeString(apiTx.BlockHash)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return apiTx, txHashBytes, blockHash, nil
 }
 
 // Close closes all underlying components
@@ -166,6 +273,8 @@ func (nar *nodeApiResolver) Close() error {
 		log.LogIfError(err)
 	}
 
+	log.LogIfError(nar.apiTxsQueueHandler.Close())
+
 	return nar.scQueryService.Close()
 }
 
@@ -204,11 +313,41 @@ func (nar *nodeApiResolver) GetLastPoolNonceForSender(sender string) (uint64, er
 	return nar.apiTransactionHandler.GetLastPoolNonceForSender(sender)
 }
 
+// GetTransactionStatus will return the status of the transaction, reporting the replaced transactions as well
+func (nar *nodeApiResolver) GetTransactionStatus(hash string) (*common.TransactionStatusApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionStatus(hash)
+}
+
 // GetTransactionsPoolNonceGapsForSender will return the nonce gaps from pool for sender, if exists, that is to be returned on API calls
 func (nar *nodeApiResolver) GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error) {
 	return nar.apiTransactionHandler.GetTransactionsPoolNonceGapsForSender(sender, senderAccountNonce)
 }
 
+// GetTransactionsPoolSenderScore will return the score of the sender and its rank among the senders from pool
+func (nar *nodeApiResolver) GetTransactionsPoolSenderScore(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error) {
+	return nar.apiMempoolHandler.GetTransactionsPoolSenderScore(sender)
+}
+
+// GetTransactionsPoolSelectionPreview will return a preview of the transactions to be selected from pool for the next block
+func (nar *nodeApiResolver) GetTransactionsPoolSelectionPreview(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error) {
+	return nar.apiMempoolHandler.GetTransactionsPoolSelectionPreview(sender)
+}
+
+// EnqueueTransaction will add the transaction in the transactions queue, which assigns its nonce, signs and sends it
+func (nar *nodeApiResolver) EnqueueTransaction(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error) {
+	return nar.apiTxsQueueHandler.EnqueueTransaction(request)
+}
+
+// GetTransactionsQueueState will return the state of the transactions queue
+func (nar *nodeApiResolver) GetTransactionsQueueState() *common.TransactionsQueueStateApiResponse {
+	return nar.apiTxsQueueHandler.GetTransactionsQueueState()
+}
+
+// CheckTransaction will run all the validity rules against the provided transaction and return all the violated ones
+func (nar *nodeApiResolver) CheckTransaction(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error) {
+	return nar.apiTransactionChecker.CheckTransaction(tx)
+}
+
 // GetBlockByHash will return the block with the given hash and optionally with transactions
 func (nar *nodeApiResolver) GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error) {
 	decodedHash, err := hex.DecodeString(hash)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/genesis"
@@ -17,6 +18,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
@@ -38,6 +40,9 @@ func createMockArgs() external.ArgNodeApiResolver {
 		DelegatedListHandler:     &mock.DelegatedListProcessorStub{},
 		APIBlockHandler:          &mock.BlockAPIHandlerStub{},
 		APITransactionHandler:    &mock.TransactionAPIHandlerStub{},
+		APIMempoolHandler:        &mock.APIMempoolHandlerStub{},
+		APITxsQueueHandler:       &mock.APITxsQueueHandlerStub{},
+		APITransactionChecker:    &mock.APITransactionCheckerStub{},
 		APIInternalBlockHandler:  &mock.InternalBlockApiHandlerStub{},
 		GenesisNodesSetupHandler: &genesisMocks.NodesSetupStub{},
 		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
@@ -136,6 +141,39 @@ func TestNewNodeApiResolver_NilNodesCoordinator(t *testing.T) {
 	assert.Equal(t, external.ErrNilNodesCoordinator, err)
 }
 
+func TestNewNodeApiResolver_NilAPIMempoolHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.APIMempoolHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilAPIMempoolHandler, err)
+}
+
+func TestNewNodeApiResolver_NilAPITxsQueueHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.APITxsQueueHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilAPITxsQueueHandler, err)
+}
+
+func TestNewNodeApiResolver_NilAPITransactionChecker(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.APITransactionChecker = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilAPITransactionChecker, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -158,11 +196,20 @@ func TestNodeApiResolver_CloseShouldReturnNil(t *testing.T) {
 			return nil
 		},
 	}
+	txsQueueCloseCalled := false
+	args.APITxsQueueHandler = &mock.APITxsQueueHandlerStub{
+		CloseCalled: func() error {
+			txsQueueCloseCalled = true
+
+			return nil
+		},
+	}
 	nar, _ := external.NewNodeApiResolver(args)
 
 	err := nar.Close()
 	assert.Nil(t, err)
 	assert.True(t, closeCalled)
+	assert.True(t, txsQueueCloseCalled)
 }
 
 func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
@@ -373,6 +420,291 @@ func TestNodeApiResolver_APITransactionHandler(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_TraceExecutedTransaction(t *testing.T) {
+	t.Parallel()
+
+	txHash := "0101"
+	blockHash := []byte("block hash")
+	t.Run("get transaction fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		trace, err := nar.TraceExecutedTransaction(txHash)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, trace)
+	})
+	t.Run("not a user transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{
+					Tx:        &rewardTx.RewardTx{},
+					BlockHash: hex.EncodeToString(blockHash),
+				}, nil
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		trace, err := nar.TraceExecutedTransaction(txHash)
+		require.Equal(t, external.ErrTransactionCannotBeReExecuted, err)
+		require.Nil(t, trace)
+	})
+	t.Run("transaction not executed should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{
+					Tx: &transaction.Transaction{},
+				}, nil
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		trace, err := nar.TraceExecutedTransaction(txHash)
+		require.Equal(t, external.ErrTransactionNotExecuted, err)
+		require.Nil(t, trace)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &transaction.Transaction{Nonce: 7}
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				require.Equal(t, txHash, hash)
+				return &transaction.ApiTransactionResult{
+					Tx:        tx,
+					BlockHash: hex.EncodeToString(blockHash),
+				}, nil
+			},
+		}
+		arg.APITransactionEvaluator = &mock.TransactionCostEstimatorMock{
+			TraceExecutedTransactionCalled: func(providedTxHash []byte, providedBlockHash []byte) (*txSimData.TransactionTrace, error) {
+				require.Equal(t, txHash, hex.EncodeToString(providedTxHash))
+				require.Equal(t, blockHash, providedBlockHash)
+				return &txSimData.TransactionTrace{}, nil
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		trace, err := nar.TraceExecutedTransaction(txHash)
+		require.Nil(t, err)
+		require.Equal(t, txHash, trace.Hash)
+	})
+}
+
+func TestNodeApiResolver_GetTransactionStateDiff(t *testing.T) {
+	t.Parallel()
+
+	txHash := "0101"
+	blockHash := hex.EncodeToString([]byte("block hash"))
+	t.Run("get transaction fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		stateDiff, err := nar.GetTransactionStateDiff(txHash)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, stateDiff)
+	})
+	t.Run("transaction not executed should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{}, nil
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		stateDiff, err := nar.GetTransactionStateDiff(txHash)
+		require.Equal(t, external.ErrTransactionNotExecuted, err)
+		require.Nil(t, stateDiff)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		apiTx := &transaction.ApiTransactionResult{BlockHash: blockHash}
+		expectedStateDiff := []*txSimData.AccountStateDiff{{Address: "erd1", NonceBefore: 1, NonceAfter: 2}}
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				require.Equal(t, txHash, hash)
+				require.True(t, withResults)
+				return apiTx, nil
+			},
+		}
+		arg.APIBlockHandler = &mock.BlockAPIHandlerStub{
+			GetTransactionStateDiffCalled: func(tx *transaction.ApiTransactionResult) ([]*txSimData.AccountStateDiff, error) {
+				require.Equal(t, apiTx, tx)
+				return expectedStateDiff, nil
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		stateDiff, err := nar.GetTransactionStateDiff(txHash)
+		require.Nil(t, err)
+		require.Equal(t, expectedStateDiff, stateDiff)
+	})
+}
+
+func TestNodeApiResolver_ReplayTransaction(t *testing.T) {
+	t.Parallel()
+
+	txHash := "0101"
+	blockHash := []byte("block hash")
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		nar, _ := external.NewNodeApiResolver(createMockArgs())
+		results, err := nar.ReplayTransaction("not a hex hash")
+		require.NotNil(t, err)
+		require.Nil(t, results)
+	})
+	t.Run("transaction not executed should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{
+					Tx: &transaction.Transaction{},
+				}, nil
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		results, err := nar.ReplayTransaction(txHash)
+		require.Equal(t, external.ErrTransactionNotExecuted, err)
+		require.Nil(t, results)
+	})
+	t.Run("replay fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{
+					Tx:        &transaction.Transaction{},
+					BlockHash: hex.EncodeToString(blockHash),
+				}, nil
+			},
+		}
+		arg.APITransactionEvaluator = &mock.TransactionCostEstimatorMock{
+			ReplayExecutedTransactionCalled: func(_ []byte, _ []byte) (*txSimData.ReplayResults, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		results, err := nar.ReplayTransaction(txHash)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, results)
+	})
+	t.Run("matching results should work", func(t *testing.T) {
+		t.Parallel()
+
+		events := []*transaction.Events{
+			{Address: "erd1", Identifier: "transfer", Topics: [][]byte{[]byte("topic")}, Data: []byte("data")},
+		}
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				require.True(t, withResults)
+				return &transaction.ApiTransactionResult{
+					Tx:                   &transaction.Transaction{},
+					BlockHash:            hex.EncodeToString(blockHash),
+					Status:               transaction.TxStatusSuccess,
+					SmartContractResults: []*transaction.ApiSmartContractResult{{Hash: "aa"}},
+					Logs:                 &transaction.ApiLogs{Events: events},
+				}, nil
+			},
+		}
+		arg.APITransactionEvaluator = &mock.TransactionCostEstimatorMock{
+			ReplayExecutedTransactionCalled: func(providedTxHash []byte, providedBlockHash []byte) (*txSimData.ReplayResults, error) {
+				require.Equal(t, txHash, hex.EncodeToString(providedTxHash))
+				require.Equal(t, blockHash, providedBlockHash)
+
+				results := &txSimData.ReplayResults{}
+				results.Status = transaction.TxStatusSuccess
+				results.ScResults = map[string]*transaction.ApiSmartContractResult{"aa": {}}
+				results.Logs = &transaction.ApiLogs{Events: events}
+
+				return results, nil
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		results, err := nar.ReplayTransaction(txHash)
+		require.Nil(t, err)
+		require.Equal(t, txHash, results.Hash)
+		require.True(t, results.Diff.Matches)
+		require.Equal(t, 1, results.Diff.StoredLogEvents)
+		require.Equal(t, 1, results.Diff.ReplayedLogEvents)
+	})
+	t.Run("different results should report the differences", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{
+					Tx:                   &transaction.Transaction{},
+					BlockHash:            hex.EncodeToString(blockHash),
+					Status:               transaction.TxStatusSuccess,
+					SmartContractResults: []*transaction.ApiSmartContractResult{{Hash: "aa"}, {Hash: "bb"}},
+					Logs: &transaction.ApiLogs{Events: []*transaction.Events{
+						{Identifier: "transfer"},
+						{Identifier: "completedTxEvent"},
+					}},
+				}, nil
+			},
+		}
+		arg.APITransactionEvaluator = &mock.TransactionCostEstimatorMock{
+			ReplayExecutedTransactionCalled: func(_ []byte, _ []byte) (*txSimData.ReplayResults, error) {
+				results := &txSimData.ReplayResults{}
+				results.Status = transaction.TxStatusFail
+				results.ScResults = map[string]*transaction.ApiSmartContractResult{"aa": {}, "cc": {}}
+				results.Logs = &transaction.ApiLogs{Events: []*transaction.Events{
+					{Identifier: "signalError"},
+				}}
+
+				return results, nil
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		results, err := nar.ReplayTransaction(txHash)
+		require.Nil(t, err)
+
+		diff := results.Diff
+		require.False(t, diff.Matches)
+		require.Equal(t, string(transaction.TxStatusSuccess), diff.StoredStatus)
+		require.Equal(t, string(transaction.TxStatusFail), diff.ReplayedStatus)
+		require.Equal(t, []string{"bb"}, diff.MissingScResults)
+		require.Equal(t, []string{"cc"}, diff.UnexpectedScResults)
+		require.Equal(t, []int{0, 1}, diff.MismatchedLogEvents)
+	})
+}
+
 func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 	t.Parallel()
 
@@ -578,6 +910,113 @@ func TestNodeApiResolver_GetTransactionsPoolNonceGapsForSender(t *testing.T) {
 	})
 }
 
+func TestNodeApiResolver_GetTransactionsPoolSenderScore(t *testing.T) {
+	t.Parallel()
+
+	expectedScore := &common.TransactionsPoolSenderScoreApiResponse{
+		Sender:     "alice",
+		Score:      42,
+		Rank:       1,
+		NumSenders: 3,
+		NumTxs:     2,
+	}
+	arg := createMockArgs()
+	arg.APIMempoolHandler = &mock.APIMempoolHandlerStub{
+		GetTransactionsPoolSenderScoreCalled: func(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error) {
+			require.Equal(t, "alice", sender)
+			return expectedScore, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.GetTransactionsPoolSenderScore("alice")
+	require.NoError(t, err)
+	require.Equal(t, expectedScore, res)
+}
+
+func TestNodeApiResolver_GetTransactionsPoolSelectionPreview(t *testing.T) {
+	t.Parallel()
+
+	expectedPreview := &common.TransactionsPoolSelectionPreviewApiResponse{
+		NumSelected: 1,
+		GasSelected: 50000,
+		Selected:    []common.TransactionsPoolSelectionPreviewItem{{Hash: "aa", Sender: "alice", GasLimit: 50000}},
+		Skipped:     []common.TransactionsPoolSelectionPreviewItem{{Hash: "bb", Sender: "alice", Nonce: 2, SkipReason: "nonce gap"}},
+	}
+	arg := createMockArgs()
+	arg.APIMempoolHandler = &mock.APIMempoolHandlerStub{
+		GetTransactionsPoolSelectionPreviewCalled: func(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error) {
+			return expectedPreview, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.GetTransactionsPoolSelectionPreview("")
+	require.NoError(t, err)
+	require.Equal(t, expectedPreview, res)
+}
+
+func TestNodeApiResolver_EnqueueTransaction(t *testing.T) {
+	t.Parallel()
+
+	expectedRequest := &common.TransactionsQueueEnqueueRequest{Sender: "alice", Receiver: "bob", GasLimit: 50000}
+	expectedResponse := &common.TransactionsQueueEnqueueApiResponse{TxHash: "aa", Nonce: 7}
+	arg := createMockArgs()
+	arg.APITxsQueueHandler = &mock.APITxsQueueHandlerStub{
+		EnqueueTransactionCalled: func(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error) {
+			require.Equal(t, expectedRequest, request)
+			return expectedResponse, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.EnqueueTransaction(expectedRequest)
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, res)
+}
+
+func TestNodeApiResolver_GetTransactionsQueueState(t *testing.T) {
+	t.Parallel()
+
+	expectedState := &common.TransactionsQueueStateApiResponse{
+		Senders:      []common.TransactionsQueueSenderState{{Address: "alice", NextNonce: 8, NumPending: 1}},
+		Transactions: []common.TransactionsQueueTransactionState{{Hash: "aa", Sender: "alice", Nonce: 7, Status: "pending"}},
+	}
+	arg := createMockArgs()
+	arg.APITxsQueueHandler = &mock.APITxsQueueHandlerStub{
+		GetTransactionsQueueStateCalled: func() *common.TransactionsQueueStateApiResponse {
+			return expectedState
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	require.Equal(t, expectedState, nar.GetTransactionsQueueState())
+}
+
+func TestNodeApiResolver_CheckTransaction(t *testing.T) {
+	t.Parallel()
+
+	providedTx := &transaction.Transaction{Nonce: 7}
+	expectedResponse := &common.TransactionCheckApiResponse{
+		Valid: false,
+		Diagnostics: []common.TransactionCheckDiagnostic{
+			{Rule: "account.nonceTooLow", Severity: "error", Field: "nonce", Expected: ">= 8", Actual: "7"},
+		},
+	}
+	arg := createMockArgs()
+	arg.APITransactionChecker = &mock.APITransactionCheckerStub{
+		CheckTransactionCalled: func(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error) {
+			require.Equal(t, providedTx, tx)
+			return expectedResponse, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.CheckTransaction(providedTx)
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, res)
+}
+
 func TestNodeApiResolver_GetGenesisNodesPubKeys(t *testing.T) {
 	t.Parallel()
 
@@ -121,6 +121,42 @@ func (atp *apiTransactionProcessor) doGetTransaction(hash []byte, withResults bo
 	return atp.getTransactionFromStorage(hash)
 }
 
+// GetTransactionStatus will return the status of the transaction with the given hash. A transaction dropped from the pool
+// because another one of the same sender and nonce paid a higher gas price is reported as replaced, along with the hash
+// of the replacing transaction
+func (atp *apiTransactionProcessor) GetTransactionStatus(txHash string) (*common.TransactionStatusApiResponse, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := atp.doGetTransaction(hash, false)
+	if err == nil {
+		return &common.TransactionStatusApiResponse{
+			Status: string(tx.Status),
+		}, nil
+	}
+
+	replacingTxHash, isReplaced := atp.getReplacingTxHash(hash)
+	if !isReplaced {
+		return nil, err
+	}
+
+	return &common.TransactionStatusApiResponse{
+		Status:     string(common.TxStatusReplaced),
+		ReplacedBy: hex.EncodeToString(replacingTxHash),
+	}, nil
+}
+
+func (atp *apiTransactionProcessor) getReplacingTxHash(hash []byte) ([]byte, bool) {
+	txPool, ok := atp.dataPool.Transactions().(replacedTxsHolder)
+	if !ok {
+		return nil, false
+	}
+
+	return txPool.GetReplacingTxHash(hash)
+}
+
 // PopulateComputedFields populates (computes) transaction fields such as processing type(s), initially paid fee etc.
 func (atp *apiTransactionProcessor) PopulateComputedFields(tx *transaction.ApiTransactionResult) {
 	atp.populateComputedFieldsProcessingType(tx)
@@ -22,12 +22,15 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool"
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	"github.com/multiversx/mx-chain-go/process"
 	processMocks "github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	dataRetrieverMock "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
@@ -998,6 +1001,57 @@ func TestApiTransactionProcessor_GetTransactionsPoolNonceGapsForSender(t *testin
 	}, res)
 }
 
+func TestApiTransactionProcessor_GetTransactionStatus(t *testing.T) {
+	t.Parallel()
+
+	atp, _, dataPool, _ := createAPITransactionProc(t, 42, false)
+	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+		Config: storageunit.CacheConfig{
+			Capacity:             100,
+			SizePerSender:        10,
+			SizeInBytes:          409600,
+			SizeInBytesPerSender: 40960,
+			Shards:               1,
+		},
+		ReplacementConfig: config.TxPoolReplacementConfig{
+			Enabled:                   true,
+			MinGasPriceBumpPercentage: 10,
+			ReplacedTxsCacheCapacity:  100,
+		},
+		TxGasHandler: &txcachemocks.TxGasHandlerMock{
+			MinimumGasMove:       1,
+			MinimumGasPrice:      1,
+			GasProcessingDivisor: 1,
+		},
+		NumberOfShards: 3,
+		SelfShardID:    1,
+	})
+	require.Nil(t, err)
+	dataPool.SetTransactions(txPool)
+
+	txPool.AddDataWithReplacement([]byte("replaced"), &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), GasPrice: 100}, 42, "1")
+	txPool.AddDataWithReplacement([]byte("replacing"), &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), GasPrice: 110}, 42, "1")
+
+	status, err := atp.GetTransactionStatus(hex.EncodeToString([]byte("replacing")))
+	require.Nil(t, err)
+	require.Equal(t, &common.TransactionStatusApiResponse{Status: string(transaction.TxStatusPending)}, status)
+
+	status, err = atp.GetTransactionStatus(hex.EncodeToString([]byte("replaced")))
+	require.Nil(t, err)
+	require.Equal(t, &common.TransactionStatusApiResponse{
+		Status:     string(common.TxStatusReplaced),
+		ReplacedBy: hex.EncodeToString([]byte("replacing")),
+	}, status)
+
+	status, err = atp.GetTransactionStatus(hex.EncodeToString([]byte("missing")))
+	require.Equal(t, ErrTransactionNotFound, err)
+	require.Nil(t, status)
+
+	status, err = atp.GetTransactionStatus("not hex")
+	require.NotNil(t, err)
+	require.Nil(t, status)
+}
+
 func createAPITransactionProc(t *testing.T, epoch uint32, withDbLookupExt bool) (*apiTransactionProcessor, *genericMocks.ChainStorerMock, *dataRetrieverMock.PoolsHolderMock, *dblookupextMock.HistoryRepositoryStub) {
 	chainStorer := genericMocks.NewChainStorerMock(epoch)
 	dataPool := dataRetrieverMock.NewPoolsHolderMock()
@@ -20,6 +20,11 @@ type FeesProcessorHandler interface {
 	IsInterfaceNil() bool
 }
 
+// replacedTxsHolder defines the transactions pool able to tell which transaction replaced a pending one
+type replacedTxsHolder interface {
+	GetReplacingTxHash(txHash []byte) ([]byte, bool)
+}
+
 // LogsFacade defines the interface of a logs facade
 type LogsFacade interface {
 	GetLog(logKey []byte, epoch uint32) (*transaction.ApiLogs, error)
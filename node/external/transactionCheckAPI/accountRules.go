@@ -0,0 +1,269 @@
+package transactionCheckAPI
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const (
+	ruleSenderShard          = "account.senderShard"
+	ruleAccountNotFound      = "account.notFound"
+	ruleNonceTooLow          = "account.nonceTooLow"
+	ruleNonceTooHigh         = "account.nonceTooHigh"
+	ruleNonceGap             = "account.nonceGap"
+	ruleInsufficientBalance  = "account.insufficientBalance"
+	ruleGuardedTxNotExpected = "guardian.guardedTransactionNotExpected"
+	ruleAccountGuarded       = "guardian.accountGuarded"
+	rulePendingGuardian      = "guardian.pendingGuardian"
+	ruleSetGuardianUsernames = "guardian.setGuardianUsernames"
+	ruleActiveGuardian       = "guardian.activeGuardian"
+	ruleGuardianMismatch     = "guardian.guardianMismatch"
+)
+
+// checkSenderAccount runs the nonce and balance checks of the transactions validator, along with the guardian rules
+// applied when the transaction is processed. The sender account can only be checked on a node of its shard
+func (tc *transactionChecker) checkSenderAccount(tx *transaction.Transaction) ([]common.TransactionCheckDiagnostic, error) {
+	if !tc.isValidAddress(tx.SndAddr) {
+		return nil, nil
+	}
+
+	selfShardID := tc.shardCoordinator.SelfId()
+	senderShardID := tc.shardCoordinator.ComputeId(tx.SndAddr)
+	if senderShardID != selfShardID {
+		return []common.TransactionCheckDiagnostic{
+			{
+				Rule:       ruleSenderShard,
+				Severity:   severityWarning,
+				Field:      "sender",
+				Expected:   fmt.Sprintf("shard %d", selfShardID),
+				Actual:     fmt.Sprintf("shard %d", senderShardID),
+				Message:    "the sender account can not be checked by a node from a different shard",
+				Suggestion: fmt.Sprintf("check the transaction on a node from shard %d", senderShardID),
+			},
+		}, nil
+	}
+
+	account, err := tc.accounts.GetExistingAccount(tx.SndAddr)
+	if err != nil {
+		if !isAccountNotFoundError(err) {
+			return nil, err
+		}
+
+		return []common.TransactionCheckDiagnostic{
+			{
+				Rule:       ruleAccountNotFound,
+				Severity:   severityError,
+				Field:      "sender",
+				Actual:     tc.encodeAddress(tx.SndAddr),
+				Message:    fmt.Sprintf("%s: %s", process.ErrAccountNotFound.Error(), err.Error()),
+				Suggestion: "fund the sender account before sending transactions from it",
+			},
+		}, nil
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w for the sender account", process.ErrWrongTypeAssertion)
+	}
+
+	diagnostics, err := tc.checkTxValidatorRules(tx, userAccount)
+	if err != nil {
+		return nil, err
+	}
+	diagnostics = append(diagnostics, checkNonceGap(tx, userAccount)...)
+	diagnostics = append(diagnostics, tc.checkGuardianRules(tx, userAccount)...)
+
+	return diagnostics, nil
+}
+
+// checkTxValidatorRules runs the checks of the transactions validator. The validator stops at the wrong nonce, so the
+// nonce is set to the nonce of the account on a copy of the transaction and the balance is checked afterward
+func (tc *transactionChecker) checkTxValidatorRules(
+	tx *transaction.Transaction,
+	account state.UserAccountHandler,
+) ([]common.TransactionCheckDiagnostic, error) {
+	diagnostics := make([]common.TransactionCheckDiagnostic, 0)
+	workingTx := copyTransaction(tx)
+	for {
+		interceptedTx, err := tc.createInterceptedTx(workingTx)
+		if err != nil {
+			return nil, err
+		}
+
+		errValidity := tc.txValidator.CheckTxValidity(interceptedTx)
+		switch {
+		case errValidity == nil:
+			return diagnostics, nil
+		case errors.Is(errValidity, process.ErrWrongTransaction) && workingTx.Nonce != account.GetNonce():
+			diagnostics = append(diagnostics, newNonceDiagnostic(errValidity, workingTx, account))
+			workingTx.Nonce = account.GetNonce()
+		case errors.Is(errValidity, process.ErrInsufficientFunds):
+			return append(diagnostics, common.TransactionCheckDiagnostic{
+				Rule:       ruleInsufficientBalance,
+				Severity:   severityError,
+				Field:      "sender",
+				Expected:   fmt.Sprintf(">= %s", interceptedTx.Fee().String()),
+				Actual:     valueToString(account.GetBalance()),
+				Message:    errValidity.Error(),
+				Suggestion: "top up the sender account or lower the gas limit",
+			}), nil
+		case errors.Is(errValidity, process.ErrAccountNotFound):
+			// the account was removed since it was read, the next check of the transaction will report it
+			return diagnostics, nil
+		default:
+			return nil, errValidity
+		}
+	}
+}
+
+func newNonceDiagnostic(err error, tx *transaction.Transaction, account state.UserAccountHandler) common.TransactionCheckDiagnostic {
+	accountNonce := account.GetNonce()
+	if tx.Nonce < accountNonce {
+		return common.TransactionCheckDiagnostic{
+			Rule:       ruleNonceTooLow,
+			Severity:   severityError,
+			Field:      "nonce",
+			Expected:   fmt.Sprintf(">= %d", accountNonce),
+			Actual:     fmt.Sprintf("%d", tx.Nonce),
+			Message:    err.Error(),
+			Suggestion: fmt.Sprintf("set the nonce to %d", accountNonce),
+		}
+	}
+
+	return common.TransactionCheckDiagnostic{
+		Rule:       ruleNonceTooHigh,
+		Severity:   severityError,
+		Field:      "nonce",
+		Expected:   fmt.Sprintf("<= %d", accountNonce+uint64(common.MaxTxNonceDeltaAllowed)),
+		Actual:     fmt.Sprintf("%d", tx.Nonce),
+		Message:    err.Error(),
+		Suggestion: fmt.Sprintf("set the nonce to %d", accountNonce),
+	}
+}
+
+// checkNonceGap warns about a transaction accepted in pool which can not be executed yet
+func checkNonceGap(tx *transaction.Transaction, account state.UserAccountHandler) []common.TransactionCheckDiagnostic {
+	accountNonce := account.GetNonce()
+	maxNonce := accountNonce + uint64(common.MaxTxNonceDeltaAllowed)
+	if tx.Nonce <= accountNonce || tx.Nonce > maxNonce {
+		return nil
+	}
+
+	return []common.TransactionCheckDiagnostic{
+		{
+			Rule:       ruleNonceGap,
+			Severity:   severityWarning,
+			Field:      "nonce",
+			Expected:   fmt.Sprintf("%d", accountNonce),
+			Actual:     fmt.Sprintf("%d", tx.Nonce),
+			Message:    "the transaction will wait in pool until the transactions with lower nonces are executed",
+			Suggestion: fmt.Sprintf("make sure the transactions with nonces from %d to %d are sent as well", accountNonce, tx.Nonce-1),
+		},
+	}
+}
+
+func isAccountNotFoundError(err error) bool {
+	var errAccountNotFound *state.ErrAccountNotFoundAtBlock
+	return errors.As(err, &errAccountNotFound) || errors.Is(err, state.ErrAccNotFound)
+}
+
+// checkGuardianRules mirrors the guardian checks done by the transaction processor
+func (tc *transactionChecker) checkGuardianRules(tx *transaction.Transaction, account state.UserAccountHandler) []common.TransactionCheckDiagnostic {
+	isTransactionGuarded := tc.txVersionChecker.IsGuardedTransaction(tx)
+	if !account.IsGuarded() {
+		if !isTransactionGuarded {
+			return nil
+		}
+
+		return []common.TransactionCheckDiagnostic{
+			{
+				Rule:       ruleGuardedTxNotExpected,
+				Severity:   severityError,
+				Field:      "options",
+				Message:    process.ErrGuardedTransactionNotExpected.Error(),
+				Suggestion: "unset the guarded option and remove the guardian fields, as the sender account is not guarded",
+			},
+		}
+	}
+
+	if !isTransactionGuarded {
+		return tc.checkGuardedAccountUnguardedTx(tx, account)
+	}
+
+	vmAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil
+	}
+
+	activeGuardian, err := tc.guardianChecker.GetActiveGuardian(vmAccount)
+	if err != nil {
+		return []common.TransactionCheckDiagnostic{
+			{
+				Rule:     ruleActiveGuardian,
+				Severity: severityError,
+				Field:    "guardian",
+				Message:  fmt.Sprintf("cannot get the active guardian of the sender: %s", err.Error()),
+			},
+		}
+	}
+
+	if bytes.Equal(activeGuardian, tx.GuardianAddr) {
+		return nil
+	}
+
+	encodedActiveGuardian := tc.encodeAddress(activeGuardian)
+	return []common.TransactionCheckDiagnostic{
+		{
+			Rule:       ruleGuardianMismatch,
+			Severity:   severityError,
+			Field:      "guardian",
+			Expected:   encodedActiveGuardian,
+			Actual:     tc.encodeAddress(tx.GuardianAddr),
+			Message:    process.ErrTransactionAndAccountGuardianMismatch.Error(),
+			Suggestion: fmt.Sprintf("set the guardian to %s and have it co-sign the transaction", encodedActiveGuardian),
+		},
+	}
+}
+
+func (tc *transactionChecker) checkGuardedAccountUnguardedTx(tx *transaction.Transaction, account state.UserAccountHandler) []common.TransactionCheckDiagnostic {
+	if !process.IsSetGuardianCall(tx.GetData()) {
+		return []common.TransactionCheckDiagnostic{
+			{
+				Rule:       ruleAccountGuarded,
+				Severity:   severityError,
+				Field:      "options",
+				Message:    "the sender account is guarded, so its transactions should be co-signed by the guardian",
+				Suggestion: "set the version to 2, set the guarded option, provide the guardian address and the guardian signature",
+			},
+		}
+	}
+
+	diagnostics := make([]common.TransactionCheckDiagnostic, 0)
+	if tc.guardianChecker.HasPendingGuardian(account) {
+		diagnostics = append(diagnostics, common.TransactionCheckDiagnostic{
+			Rule:       rulePendingGuardian,
+			Severity:   severityError,
+			Field:      "data",
+			Message:    process.ErrCannotReplaceGuardedAccountPendingGuardian.Error(),
+			Suggestion: "wait for the pending guardian to become active or send the SetGuardian call co-signed by the active guardian",
+		})
+	}
+	if len(tx.RcvUserName) > 0 || len(tx.SndUserName) > 0 {
+		diagnostics = append(diagnostics, common.TransactionCheckDiagnostic{
+			Rule:       ruleSetGuardianUsernames,
+			Severity:   severityError,
+			Field:      "senderUsername",
+			Message:    "a not guarded SetGuardian call does not support usernames",
+			Suggestion: "remove the sender and receiver usernames",
+		})
+	}
+
+	return diagnostics
+}
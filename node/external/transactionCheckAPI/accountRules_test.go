@@ -0,0 +1,260 @@
+package transactionCheckAPI
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/guardianMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+func createGuardedAccount(address []byte, balance *big.Int) *stateMock.UserAccountStub {
+	return &stateMock.UserAccountStub{
+		Address: address,
+		Balance: balance,
+		IsGuardedCalled: func() bool {
+			return true
+		},
+	}
+}
+
+func TestTransactionChecker_checkSenderAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid sender should not check the account", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewTransactionChecker(createMockArgsTransactionChecker())
+		tx := createMoveBalanceTx(newTestWallet(), newTestWallet().address)
+		tx.SndAddr = []byte("sender")
+
+		diagnostics, err := checker.checkSenderAccount(tx)
+		require.Nil(t, err)
+		require.Empty(t, diagnostics)
+	})
+	t.Run("sender from a different shard should be reported as warning", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		shardCoordinator := testscommon.NewMultiShardsCoordinatorMock(2)
+		shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+			return 1
+		}
+		args.ShardCoordinator = shardCoordinator
+		checker, _ := NewTransactionChecker(args)
+		tx := createMoveBalanceTx(newTestWallet(), newTestWallet().address)
+
+		diagnostics, err := checker.checkSenderAccount(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleSenderShard, diagnostics[0].Rule)
+		require.Equal(t, severityWarning, diagnostics[0].Severity)
+		require.Equal(t, "shard 0", diagnostics[0].Expected)
+		require.Equal(t, "shard 1", diagnostics[0].Actual)
+	})
+	t.Run("missing sender account should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args)
+		checker, _ := NewTransactionChecker(args)
+		sender := newTestWallet()
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+
+		diagnostics, err := checker.checkSenderAccount(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleAccountNotFound, diagnostics[0].Rule)
+		require.Equal(t, checker.encodeAddress(sender.address), diagnostics[0].Actual)
+	})
+	t.Run("nonce too far ahead should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 10, big.NewInt(1_000_000_000_000_000)))
+		checker, _ := NewTransactionChecker(args)
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.Nonce = 10 + uint64(common.MaxTxNonceDeltaAllowed) + 1
+
+		diagnostics, err := checker.checkSenderAccount(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleNonceTooHigh, diagnostics[0].Rule)
+		require.Equal(t, "<= 110", diagnostics[0].Expected)
+	})
+	t.Run("balance lower than the fee should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, big.NewInt(1000)))
+		checker, _ := NewTransactionChecker(args)
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+
+		diagnostics, err := checker.checkSenderAccount(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleInsufficientBalance, diagnostics[0].Rule)
+		require.Equal(t, ">= 50000000000000", diagnostics[0].Expected)
+		require.Equal(t, "1000", diagnostics[0].Actual)
+	})
+	t.Run("nonce too low should not hide the balance lower than the fee", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 5, big.NewInt(1000)))
+		checker, _ := NewTransactionChecker(args)
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.Nonce = 2
+
+		diagnostics, err := checker.checkSenderAccount(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 2)
+		diagnostic := requireDiagnostic(t, diagnostics, ruleNonceTooLow, "nonce")
+		require.Equal(t, ">= 5", diagnostic.Expected)
+		require.Contains(t, diagnostic.Message, process.ErrWrongTransaction.Error())
+		diagnostic = requireDiagnostic(t, diagnostics, ruleInsufficientBalance, "sender")
+		require.Contains(t, diagnostic.Message, process.ErrInsufficientFunds.Error())
+	})
+}
+
+func TestTransactionChecker_checkGuardianRules(t *testing.T) {
+	t.Parallel()
+
+	balance := big.NewInt(1_000_000_000_000_000)
+
+	t.Run("guarded transaction of a not guarded account should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, balance))
+		checker, _ := NewTransactionChecker(args)
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.Version = 2
+		tx.Options = transaction.MaskGuardedTransaction
+		tx.GuardianAddr = newTestWallet().address
+
+		diagnostics, err := checker.checkSenderAccount(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleGuardedTxNotExpected, diagnostics[0].Rule)
+	})
+	t.Run("not guarded transaction of a guarded account should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createGuardedAccount(sender.address, balance))
+		checker, _ := NewTransactionChecker(args)
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+
+		diagnostics, err := checker.checkSenderAccount(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleAccountGuarded, diagnostics[0].Rule)
+		require.Equal(t, "options", diagnostics[0].Field)
+	})
+	t.Run("not guarded SetGuardian call with a pending guardian and usernames should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createGuardedAccount(sender.address, balance))
+		args.GuardianChecker = &guardianMocks.GuardedAccountHandlerStub{
+			HasPendingGuardianCalled: func(uah state.UserAccountHandler) bool {
+				return true
+			},
+		}
+		checker, _ := NewTransactionChecker(args)
+		tx := createMoveBalanceTx(sender, sender.address)
+		tx.Value = big.NewInt(0)
+		tx.Data = []byte("SetGuardian@" + hexAddress(newTestWallet().address) + "@aa")
+		tx.GasLimit = 1_000_000
+		tx.SndUserName = []byte("sender")
+
+		diagnostics, err := checker.checkSenderAccount(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 2)
+		requireDiagnostic(t, diagnostics, rulePendingGuardian, "data")
+		requireDiagnostic(t, diagnostics, ruleSetGuardianUsernames, "senderUsername")
+	})
+	t.Run("not guarded SetGuardian call without a pending guardian should work", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createGuardedAccount(sender.address, balance))
+		checker, _ := NewTransactionChecker(args)
+		tx := createMoveBalanceTx(sender, sender.address)
+		tx.Value = big.NewInt(0)
+		tx.Data = []byte("SetGuardian@" + hexAddress(newTestWallet().address) + "@aa")
+		tx.GasLimit = 1_000_000
+
+		diagnostics, err := checker.checkSenderAccount(tx)
+		require.Nil(t, err)
+		require.Empty(t, diagnostics)
+	})
+	t.Run("guarded transaction with a different guardian should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		activeGuardian := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createGuardedAccount(sender.address, balance))
+		args.GuardianChecker = &guardianMocks.GuardedAccountHandlerStub{
+			GetActiveGuardianCalled: func(handler vmcommon.UserAccountHandler) ([]byte, error) {
+				return activeGuardian.address, nil
+			},
+		}
+		checker, _ := NewTransactionChecker(args)
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.Version = 2
+		tx.Options = transaction.MaskGuardedTransaction
+		tx.GuardianAddr = newTestWallet().address
+
+		diagnostics, err := checker.checkSenderAccount(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleGuardianMismatch, diagnostics[0].Rule)
+		require.Equal(t, checker.encodeAddress(activeGuardian.address), diagnostics[0].Expected)
+		require.Equal(t, checker.encodeAddress(tx.GuardianAddr), diagnostics[0].Actual)
+
+		tx.GuardianAddr = activeGuardian.address
+		diagnostics, err = checker.checkSenderAccount(tx)
+		require.Nil(t, err)
+		require.Empty(t, diagnostics)
+	})
+	t.Run("active guardian error should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createGuardedAccount(sender.address, balance))
+		args.GuardianChecker = &guardianMocks.GuardedAccountHandlerStub{
+			GetActiveGuardianCalled: func(handler vmcommon.UserAccountHandler) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		checker, _ := NewTransactionChecker(args)
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.Version = 2
+		tx.Options = transaction.MaskGuardedTransaction
+		tx.GuardianAddr = newTestWallet().address
+
+		diagnostics, err := checker.checkSenderAccount(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleActiveGuardian, diagnostics[0].Rule)
+		require.Contains(t, diagnostics[0].Message, expectedErr.Error())
+	})
+}
@@ -0,0 +1,232 @@
+package transactionCheckAPI
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+)
+
+const (
+	ruleDataFieldParse           = "dataField.parse"
+	ruleBuiltInFunctionInactive  = "dataField.builtInFunctionInactive"
+	ruleBuiltInFunctionArguments = "dataField.builtInFunctionArguments"
+	ruleBuiltInFunctionReceiver  = "dataField.builtInFunctionReceiver"
+	ruleBuiltInFunctionValue     = "dataField.builtInFunctionValue"
+	ruleBuiltInFunctionExecution = "dataField.builtInFunctionExecution"
+)
+
+// checkDataField parses the data field of the transaction and, for the built-in function calls, checks the arguments
+// with the ESDT transfer parser and runs the built-in function of the node against copies of the accounts, on the
+// sender side, the same way the transaction processor would. No state is changed by these checks
+func (tc *transactionChecker) checkDataField(tx *transaction.Transaction) ([]common.TransactionCheckDiagnostic, error) {
+	if len(tx.Data) == 0 {
+		return nil, nil
+	}
+
+	function, args, err := tc.argumentsParser.ParseCallData(string(tx.Data))
+	if err != nil {
+		return []common.TransactionCheckDiagnostic{
+			{
+				Rule:       ruleDataFieldParse,
+				Severity:   severityWarning,
+				Field:      "data",
+				Message:    fmt.Sprintf("the data field can not be parsed as a function call: %s", err.Error()),
+				Suggestion: "separate the function and its arguments with @ and encode each argument as an even-length hex string",
+			},
+		}, nil
+	}
+
+	builtInFunction, err := tc.builtInFunctions.Get(function)
+	if err != nil {
+		return nil, nil
+	}
+	if !builtInFunction.IsActive() {
+		return []common.TransactionCheckDiagnostic{
+			{
+				Rule:     ruleBuiltInFunctionInactive,
+				Severity: severityError,
+				Field:    "data",
+				Message:  fmt.Sprintf("the built-in function %s is not active yet", function),
+			},
+		}, nil
+	}
+
+	diagnostics := tc.checkESDTTransferArguments(tx, function, args)
+	if hasErrors(diagnostics) {
+		return diagnostics, nil
+	}
+
+	executionDiagnostics, err := tc.checkBuiltInFunctionExecution(tx, builtInFunction, function, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(diagnostics, executionDiagnostics...), nil
+}
+
+// checkESDTTransferArguments uses the ESDT transfer parser of the node to check the arguments of the ESDTTransfer,
+// ESDTNFTTransfer and MultiESDTNFTTransfer calls, including the destination address found in the arguments
+func (tc *transactionChecker) checkESDTTransferArguments(
+	tx *transaction.Transaction,
+	function string,
+	args [][]byte,
+) []common.TransactionCheckDiagnostic {
+	parsedTransfers, err := tc.esdtTransferParser.ParseESDTTransfers(tx.SndAddr, tx.RcvAddr, function, args)
+	if errors.Is(err, parsers.ErrNotESDTTransferInput) {
+		return nil
+	}
+	if err != nil {
+		return []common.TransactionCheckDiagnostic{
+			{
+				Rule:       ruleBuiltInFunctionArguments,
+				Severity:   severityError,
+				Field:      "data",
+				Message:    fmt.Sprintf("the arguments of %s can not be parsed: %s", function, err.Error()),
+				Suggestion: "provide the token identifier, the nonce and the amount of each transferred token as hex encoded arguments",
+			},
+		}
+	}
+
+	if !tc.isValidAddress(parsedTransfers.RcvAddr) {
+		return []common.TransactionCheckDiagnostic{
+			{
+				Rule:       ruleBuiltInFunctionReceiver,
+				Severity:   severityError,
+				Field:      "data",
+				Expected:   fmt.Sprintf("%d bytes", tc.addressPubKeyConverter.Len()),
+				Actual:     fmt.Sprintf("%d bytes", len(parsedTransfers.RcvAddr)),
+				Message:    fmt.Sprintf("the destination of %s is not a valid address", function),
+				Suggestion: "provide the destination address argument as the hex encoding of the address bytes",
+			},
+		}
+	}
+
+	return nil
+}
+
+// checkBuiltInFunctionExecution runs the built-in function on freshly loaded accounts, which are never saved back.
+// It can only be done on a node from the sender shard and when the gas limit covers the data field
+func (tc *transactionChecker) checkBuiltInFunctionExecution(
+	tx *transaction.Transaction,
+	builtInFunction vmcommon.BuiltinFunction,
+	function string,
+	args [][]byte,
+) ([]common.TransactionCheckDiagnostic, error) {
+	selfShardID := tc.shardCoordinator.SelfId()
+	if !tc.isValidAddress(tx.SndAddr) || tc.shardCoordinator.ComputeId(tx.SndAddr) != selfShardID {
+		return nil, nil
+	}
+
+	gasForTxData := tc.feeHandler.ComputeGasLimit(tx)
+	if tx.GasLimit < gasForTxData {
+		return nil, nil
+	}
+
+	senderAccount, receiverAccount, err := tc.loadBuiltInFunctionAccounts(tx)
+	if isAccountNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	txHash, err := core.CalculateHash(tc.marshaller, tc.hasher, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	callValue := big.NewInt(0)
+	if tx.Value != nil {
+		callValue.Set(tx.Value)
+	}
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:     tx.SndAddr,
+			Arguments:      args,
+			CallValue:      callValue,
+			CallType:       vm.DirectCall,
+			GasPrice:       tx.GasPrice,
+			GasProvided:    tx.GasLimit - gasForTxData,
+			OriginalTxHash: txHash,
+			CurrentTxHash:  txHash,
+			PrevTxHash:     txHash,
+			TxGuardian:     tx.GuardianAddr,
+		},
+		RecipientAddr: tx.RcvAddr,
+		Function:      function,
+	}
+
+	_, err = builtInFunction.ProcessBuiltinFunction(senderAccount, receiverAccount, vmInput)
+	if err == nil {
+		return nil, nil
+	}
+	if errors.Is(err, state.ErrOperationNotPermitted) {
+		// the built-in function tried to save an account through the read only accounts adapter, so its outcome
+		// can not be known without changing the state
+		log.Trace("transactionChecker.checkBuiltInFunctionExecution: can not run built-in function", "function", function, "error", err)
+		return nil, nil
+	}
+	if errors.Is(err, builtInFunctions.ErrBuiltInFunctionCalledWithValue) {
+		return []common.TransactionCheckDiagnostic{
+			{
+				Rule:       ruleBuiltInFunctionValue,
+				Severity:   severityError,
+				Field:      "value",
+				Expected:   "0",
+				Actual:     callValue.String(),
+				Message:    fmt.Sprintf("%s can not be called with value", function),
+				Suggestion: "set the value to 0",
+			},
+		}, nil
+	}
+
+	return []common.TransactionCheckDiagnostic{
+		{
+			Rule:     ruleBuiltInFunctionExecution,
+			Severity: severityError,
+			Field:    "data",
+			Message:  fmt.Sprintf("%s fails on the sender account: %s", function, err.Error()),
+		},
+	}, nil
+}
+
+// loadBuiltInFunctionAccounts loads the accounts the same way the blockchain hook does before running a built-in
+// function: the receiver is only loaded if it is in the self shard
+func (tc *transactionChecker) loadBuiltInFunctionAccounts(tx *transaction.Transaction) (vmcommon.UserAccountHandler, vmcommon.UserAccountHandler, error) {
+	account, err := tc.accounts.GetExistingAccount(tx.SndAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	senderAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, nil, process.ErrWrongTypeAssertion
+	}
+	if bytes.Equal(tx.SndAddr, tx.RcvAddr) {
+		return senderAccount, senderAccount, nil
+	}
+	if !tc.isValidAddress(tx.RcvAddr) || tc.shardCoordinator.ComputeId(tx.RcvAddr) != tc.shardCoordinator.SelfId() {
+		return senderAccount, nil, nil
+	}
+
+	account, err = tc.accounts.LoadAccount(tx.RcvAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	receiverAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, nil, process.ErrWrongTypeAssertion
+	}
+
+	return senderAccount, receiverAccount, nil
+}
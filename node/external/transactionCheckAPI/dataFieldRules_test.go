@@ -0,0 +1,333 @@
+package transactionCheckAPI
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	processBuiltInFunctions "github.com/multiversx/mx-chain-go/process/smartContract/builtInFunctions"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
+	"github.com/multiversx/mx-chain-go/testscommon/guardianMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts/defaults"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
+	"github.com/stretchr/testify/require"
+)
+
+// setBuiltInFunctions adds the built-in functions of the node, all of them active, to the checker arguments
+func setBuiltInFunctions(t *testing.T, args *ArgsTransactionChecker) {
+	gasMap := make(map[string]map[string]uint64)
+	defaults.FillGasMapInternal(gasMap, 1)
+
+	argsBuiltIn := processBuiltInFunctions.ArgsCreateBuiltInFunctionContainer{
+		GasSchedule:       testscommon.NewGasScheduleNotifierMock(gasMap),
+		MapDNSAddresses:   make(map[string]struct{}),
+		MapDNSV2Addresses: make(map[string]struct{}),
+		Marshalizer:       &marshal.GogoProtoMarshalizer{},
+		Accounts:          args.Accounts,
+		ShardCoordinator:  args.ShardCoordinator,
+		EpochNotifier:     &epochNotifier.EpochNotifierStub{},
+		EnableEpochsHandler: &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+			IsFlagEnabledCalled: func(flag core.EnableEpochFlag) bool {
+				return true
+			},
+		},
+		GuardedAccountHandler:     &guardianMocks.GuardedAccountHandlerStub{},
+		AutomaticCrawlerAddresses: [][]byte{newTestWallet().address},
+		MaxNumNodesInTransferRole: 100,
+	}
+	builtInFuncs, err := processBuiltInFunctions.CreateBuiltInFunctionsFactory(argsBuiltIn)
+	require.Nil(t, err)
+
+	args.BuiltInFunctions = builtInFuncs.BuiltInFunctionContainer()
+}
+
+func setBuiltInFunction(args *ArgsTransactionChecker, function string, builtInFunction vmcommon.BuiltinFunction) {
+	container := builtInFunctions.NewBuiltInFunctionContainer()
+	_ = container.Add(function, builtInFunction)
+	args.BuiltInFunctions = container
+}
+
+func createBuiltInFunctionTx(sender []byte, receiver []byte, function string, args ...[]byte) *transaction.Transaction {
+	data := function
+	for _, arg := range args {
+		data += "@" + hex.EncodeToString(arg)
+	}
+
+	return &transaction.Transaction{
+		Value:    big.NewInt(0),
+		RcvAddr:  receiver,
+		SndAddr:  sender,
+		GasPrice: minGasPriceToTest,
+		GasLimit: minGasLimitToTest + gasPerDataByteToTest*uint64(len(data)) + 1_000_000,
+		Data:     []byte(data),
+		ChainID:  []byte(chainIDToTest),
+		Version:  minTxVersionToTest,
+	}
+}
+
+func requireDataFieldDiagnostic(t *testing.T, diagnostics []common.TransactionCheckDiagnostic, rule string, field string) common.TransactionCheckDiagnostic {
+	require.Len(t, diagnostics, 1, "unexpected diagnostics %v", diagnostics)
+	require.Equal(t, severityError, diagnostics[0].Severity)
+
+	return requireDiagnostic(t, diagnostics, rule, field)
+}
+
+func TestTransactionChecker_checkDataField(t *testing.T) {
+	t.Parallel()
+
+	tokenIdentifier := []byte("TKN-abcdef")
+
+	t.Run("not a built-in function call should not be checked", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, big.NewInt(0)))
+		setBuiltInFunctions(t, &args)
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, newTestWallet().address, "claim", []byte{1})
+		tx.Value = big.NewInt(1)
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, err)
+		require.Empty(t, diagnostics)
+	})
+	t.Run("data field which can not be parsed should be reported as warning", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setBuiltInFunctions(t, &args)
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, newTestWallet().address, core.BuiltInFunctionESDTTransfer)
+		tx.Data = []byte("ESDTTransfer@a")
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleDataFieldParse, diagnostics[0].Rule)
+		require.Equal(t, severityWarning, diagnostics[0].Severity)
+	})
+	t.Run("inactive built-in function should error", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setBuiltInFunction(&args, core.BuiltInFunctionSetGuardian, &mock.BuiltInFunctionStub{
+			IsActiveCalled: func() bool {
+				return false
+			},
+		})
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, sender.address, core.BuiltInFunctionSetGuardian, newTestWallet().address, []byte("uid"))
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, err)
+		requireDataFieldDiagnostic(t, diagnostics, ruleBuiltInFunctionInactive, "data")
+	})
+	t.Run("ESDTTransfer without the amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, big.NewInt(0)))
+		setBuiltInFunctions(t, &args)
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, newTestWallet().address, core.BuiltInFunctionESDTTransfer, tokenIdentifier)
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, err)
+		diagnostic := requireDataFieldDiagnostic(t, diagnostics, ruleBuiltInFunctionArguments, "data")
+		require.True(t, strings.Contains(diagnostic.Message, "not enough arguments"))
+	})
+	t.Run("ESDTNFTTransfer to an invalid destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, big.NewInt(0)))
+		setBuiltInFunctions(t, &args)
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, sender.address, core.BuiltInFunctionESDTNFTTransfer, tokenIdentifier, []byte{1}, []byte{1}, []byte("receiver"))
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, err)
+		diagnostic := requireDataFieldDiagnostic(t, diagnostics, ruleBuiltInFunctionReceiver, "data")
+		require.Equal(t, "32 bytes", diagnostic.Expected)
+		require.Equal(t, "8 bytes", diagnostic.Actual)
+	})
+	t.Run("MultiESDTNFTTransfer with missing transfer arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, big.NewInt(0)))
+		setBuiltInFunctions(t, &args)
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, sender.address, core.BuiltInFunctionMultiESDTNFTTransfer, newTestWallet().address, []byte{2}, tokenIdentifier, []byte{0}, []byte{1})
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, err)
+		requireDataFieldDiagnostic(t, diagnostics, ruleBuiltInFunctionArguments, "data")
+	})
+	t.Run("built-in function called with value should error", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, big.NewInt(0)))
+		setBuiltInFunctions(t, &args)
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, newTestWallet().address, core.BuiltInFunctionESDTTransfer, tokenIdentifier, []byte{1})
+		tx.Value = big.NewInt(5)
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, err)
+		diagnostic := requireDataFieldDiagnostic(t, diagnostics, ruleBuiltInFunctionValue, "value")
+		require.Equal(t, "0", diagnostic.Expected)
+		require.Equal(t, "5", diagnostic.Actual)
+	})
+	t.Run("ESDTTransfer without the token balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, big.NewInt(0)))
+		setBuiltInFunctions(t, &args)
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, newTestWallet().address, core.BuiltInFunctionESDTTransfer, tokenIdentifier, []byte{1})
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, err)
+		diagnostic := requireDataFieldDiagnostic(t, diagnostics, ruleBuiltInFunctionExecution, "data")
+		require.True(t, strings.Contains(diagnostic.Message, "insufficient funds"))
+	})
+	t.Run("SetGuardian with an invalid guardian should error", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, big.NewInt(0)))
+		setBuiltInFunctions(t, &args)
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, sender.address, core.BuiltInFunctionSetGuardian, []byte("guardian"), []byte("uid"))
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, err)
+		diagnostic := requireDataFieldDiagnostic(t, diagnostics, ruleBuiltInFunctionExecution, "data")
+		require.True(t, strings.Contains(diagnostic.Message, "invalid address"))
+	})
+	t.Run("SetGuardian sent to another account should error", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, big.NewInt(0)))
+		setBuiltInFunctions(t, &args)
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, newTestWallet().address, core.BuiltInFunctionSetGuardian, newTestWallet().address, []byte("uid"))
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, err)
+		requireDataFieldDiagnostic(t, diagnostics, ruleBuiltInFunctionExecution, "data")
+	})
+	t.Run("valid SetGuardian should not report diagnostics", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, big.NewInt(0)))
+		setBuiltInFunctions(t, &args)
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, sender.address, core.BuiltInFunctionSetGuardian, newTestWallet().address, []byte("uid"))
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, err)
+		require.Empty(t, diagnostics)
+	})
+	t.Run("built-in function saving accounts through the read only adapter should not be reported", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, big.NewInt(0)))
+		setBuiltInFunction(&args, core.BuiltInFunctionESDTNFTCreate, &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return nil, fmt.Errorf("%w when saving the system account", state.ErrOperationNotPermitted)
+			},
+		})
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, sender.address, core.BuiltInFunctionESDTNFTCreate, tokenIdentifier, []byte{1})
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, err)
+		require.Empty(t, diagnostics)
+	})
+	t.Run("sender from a different shard should not run the built-in function", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		shardCoordinator := testscommon.NewMultiShardsCoordinatorMock(2)
+		shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+			return 1
+		}
+		args.ShardCoordinator = shardCoordinator
+		setBuiltInFunction(&args, core.BuiltInFunctionSetGuardian, &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(acntSnd, acntDst vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		})
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, sender.address, core.BuiltInFunctionSetGuardian, newTestWallet().address, []byte("uid"))
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, err)
+		require.Empty(t, diagnostics)
+	})
+	t.Run("accounts adapter error should error", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, big.NewInt(0)))
+		accounts := args.Accounts.(*stateMock.AccountsStub)
+		accounts.LoadAccountCalled = func(address []byte) (vmcommon.AccountHandler, error) {
+			return nil, expectedErr
+		}
+		setBuiltInFunctions(t, &args)
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createBuiltInFunctionTx(sender.address, newTestWallet().address, core.BuiltInFunctionESDTTransfer, tokenIdentifier, []byte{1})
+
+		diagnostics, err := checker.checkDataField(tx)
+		require.Nil(t, diagnostics)
+		require.Equal(t, expectedErr, err)
+	})
+}
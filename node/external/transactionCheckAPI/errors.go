@@ -0,0 +1,57 @@
+package transactionCheckAPI
+
+import "errors"
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilAddressPubKeyConverter signals that a nil address pubkey converter has been provided
+var ErrNilAddressPubKeyConverter = errors.New("nil address pubkey converter")
+
+// ErrNilFeeHandler signals that a nil fee handler has been provided
+var ErrNilFeeHandler = errors.New("nil fee handler")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilGuardianChecker signals that a nil guardian checker has been provided
+var ErrNilGuardianChecker = errors.New("nil guardian checker")
+
+// ErrNilTxVersionChecker signals that a nil transaction version checker has been provided
+var ErrNilTxVersionChecker = errors.New("nil transaction version checker")
+
+// ErrNilEnableEpochsHandler signals that a nil enable epochs handler has been provided
+var ErrNilEnableEpochsHandler = errors.New("nil enable epochs handler")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilTxSignMarshaller signals that a nil transaction sign marshaller has been provided
+var ErrNilTxSignMarshaller = errors.New("nil transaction sign marshaller")
+
+// ErrNilTxSignHasher signals that a nil transaction sign hasher has been provided
+var ErrNilTxSignHasher = errors.New("nil transaction sign hasher")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilArgumentsParser signals that a nil arguments parser has been provided
+var ErrNilArgumentsParser = errors.New("nil arguments parser")
+
+// ErrNilBuiltInFunctionsContainer signals that a nil built-in functions container has been provided
+var ErrNilBuiltInFunctionsContainer = errors.New("nil built-in functions container")
+
+// ErrNilESDTTransferParser signals that a nil ESDT transfer parser has been provided
+var ErrNilESDTTransferParser = errors.New("nil ESDT transfer parser")
+
+// ErrEmptyChainID signals that an empty chain ID has been provided
+var ErrEmptyChainID = errors.New("empty chain ID")
+
+// ErrNilTransaction signals that a nil transaction has been provided
+var ErrNilTransaction = errors.New("nil transaction")
@@ -0,0 +1,346 @@
+package transactionCheckAPI
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node/disabled"
+	"github.com/multiversx/mx-chain-go/process"
+	procTx "github.com/multiversx/mx-chain-go/process/transaction"
+)
+
+const (
+	ruleVersion                 = "integrity.version"
+	ruleOptions                 = "integrity.options"
+	ruleSignatureMissing        = "integrity.signature"
+	ruleValue                   = "integrity.value"
+	ruleUsernameLength          = "integrity.usernameLength"
+	ruleChainID                 = "integrity.chainID"
+	ruleAddressLength           = "integrity.addressLength"
+	ruleMinGasPrice             = "economics.minGasPrice"
+	ruleMinGasLimit             = "economics.minGasLimit"
+	ruleMaxGasLimit             = "economics.maxGasLimit"
+	ruleMaxValue                = "economics.maxValue"
+	ruleMaxGasPriceSetGuardian  = "economics.maxGasPriceSetGuardian"
+	ruleSignedWithHash          = "signature.signedWithHash"
+	ruleSenderSignature         = "signature.sender"
+	ruleGuardianNotExpected     = "guardian.guardianNotExpected"
+	ruleGuardianSignature       = "guardian.signature"
+	ruleRelayedArguments        = "relayed.arguments"
+	ruleRelayedBeneficiary      = "relayed.beneficiary"
+	ruleRelayedRecursive        = "relayed.recursive"
+	ruleRelayedInnerTransaction = "relayed.innerTransaction"
+)
+
+const innerTransactionErrorPrefix = "inner transaction: "
+
+// maxInterceptorChecks bounds the number of times the intercepted transaction is checked, as each run fixes one field
+const maxInterceptorChecks = 20
+
+// interceptorRule maps an error returned by the intercepted transaction to a diagnostic. The fix function sets the
+// faulty field to an accepted value, so the next run of the interceptor reports the next violated rule
+type interceptorRule struct {
+	diagnostic common.TransactionCheckDiagnostic
+	fix        func(tx *transaction.Transaction)
+}
+
+// checkInterceptorRules runs the checks of the intercepted transaction, as done when the transaction is received from
+// the network. The interceptor stops at the first violated rule, so the faulty field is fixed on a copy of the
+// transaction and the checks are run again, until no fixable rule is violated. The signature checks are only
+// reported for the unmodified transaction, as any fix invalidates the signature anyway
+func (tc *transactionChecker) checkInterceptorRules(tx *transaction.Transaction) ([]common.TransactionCheckDiagnostic, error) {
+	diagnostics := make([]common.TransactionCheckDiagnostic, 0)
+	workingTx := copyTransaction(tx)
+	for i := 0; i < maxInterceptorChecks; i++ {
+		interceptedTx, err := tc.createInterceptedTx(workingTx)
+		if err != nil {
+			return nil, err
+		}
+
+		errValidity := interceptedTx.CheckValidity()
+		if errValidity == nil {
+			return diagnostics, nil
+		}
+
+		rule, isFixable := tc.getInterceptorRule(errValidity, workingTx)
+		if isFixable {
+			diagnostics = append(diagnostics, rule.diagnostic)
+			rule.fix(workingTx)
+			continue
+		}
+
+		isTransactionModified := i > 0
+		if isTransactionModified {
+			return diagnostics, nil
+		}
+
+		return append(diagnostics, tc.getSignatureStageDiagnostics(interceptedTx, workingTx, errValidity)...), nil
+	}
+
+	return diagnostics, nil
+}
+
+func (tc *transactionChecker) createInterceptedTx(tx *transaction.Transaction) (*procTx.InterceptedTransaction, error) {
+	txBuff, err := tc.marshaller.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the disabled white list handler never reports the transaction as verified, so all the checks are run
+	whiteListHandler := disabled.NewDisabledWhiteListDataVerifier()
+	return procTx.NewInterceptedTransaction(
+		txBuff,
+		tc.marshaller,
+		tc.txSignMarshaller,
+		tc.hasher,
+		tc.keyGen,
+		tc.singleSigner,
+		tc.addressPubKeyConverter,
+		tc.shardCoordinator,
+		tc.feeHandler,
+		whiteListHandler,
+		tc.argumentsParser,
+		tc.chainID,
+		tc.enableEpochsHandler.IsFlagEnabled(common.TransactionSignedWithTxHashFlag),
+		tc.txSignHasher,
+		tc.txVersionChecker,
+	)
+}
+
+// getInterceptorRule returns the diagnostic of the integrity and economics errors, which can be fixed in order to find
+// the next violated rule
+func (tc *transactionChecker) getInterceptorRule(err error, tx *transaction.Transaction) (interceptorRule, bool) {
+	switch {
+	case errors.Is(err, core.ErrInvalidTransactionVersion):
+		return tc.getVersionRule(tx), true
+	case errors.Is(err, data.ErrNilSignature):
+		return interceptorRule{
+			diagnostic: newDiagnostic(ruleSignatureMissing, "signature", err, "", "",
+				"sign the transaction with the private key of the sender"),
+			fix: func(tx *transaction.Transaction) {
+				tx.Signature = make([]byte, 1)
+			},
+		}, true
+	case errors.Is(err, data.ErrNilValue), errors.Is(err, data.ErrNegativeValue):
+		return interceptorRule{
+			diagnostic: newDiagnostic(ruleValue, "value", err, ">= 0", valueToString(tx.Value),
+				"provide the value as a non-negative integer, denominated in the smallest unit"),
+			fix: setZeroValue,
+		}, true
+	case errors.Is(err, data.ErrInvalidUserNameLength):
+		return getUsernameRule(err, tx), true
+	case errors.Is(err, process.ErrInvalidChainID):
+		return interceptorRule{
+			diagnostic: newDiagnostic(ruleChainID, "chainID", err, string(tc.chainID), string(tx.ChainID),
+				fmt.Sprintf("set the chain ID to %s and sign the transaction again", string(tc.chainID))),
+			fix: func(tx *transaction.Transaction) {
+				tx.ChainID = tc.chainID
+			},
+		}, true
+	case errors.Is(err, process.ErrInvalidRcvAddr):
+		return interceptorRule{
+			diagnostic: tc.newAddressLengthDiagnostic("receiver", err, tx.RcvAddr),
+			fix: func(tx *transaction.Transaction) {
+				tx.RcvAddr = make([]byte, tc.addressPubKeyConverter.Len())
+			},
+		}, true
+	case errors.Is(err, process.ErrInvalidSndAddr):
+		return interceptorRule{
+			diagnostic: tc.newAddressLengthDiagnostic("sender", err, tx.SndAddr),
+			fix: func(tx *transaction.Transaction) {
+				tx.SndAddr = make([]byte, tc.addressPubKeyConverter.Len())
+			},
+		}, true
+	default:
+		return tc.getEconomicsRule(err, tx)
+	}
+}
+
+func (tc *transactionChecker) getVersionRule(tx *transaction.Transaction) interceptorRule {
+	if tx.Version < tc.minTxVersion {
+		return interceptorRule{
+			diagnostic: newDiagnostic(ruleVersion, "version", core.ErrInvalidTransactionVersion,
+				fmt.Sprintf(">= %d", tc.minTxVersion), fmt.Sprintf("%d", tx.Version),
+				fmt.Sprintf("set the version to %d", tc.minTxVersion)),
+			fix: func(tx *transaction.Transaction) {
+				tx.Version = tc.minTxVersion
+			},
+		}
+	}
+
+	return interceptorRule{
+		diagnostic: newDiagnostic(ruleOptions, "options", core.ErrInvalidTransactionVersion, "0", fmt.Sprintf("%d", tx.Options),
+			fmt.Sprintf("set the version to %d or higher in order to use the transaction options", core.InitialVersionOfTransaction+1)),
+		fix: func(tx *transaction.Transaction) {
+			tx.Version = core.InitialVersionOfTransaction + 1
+		},
+	}
+}
+
+func getUsernameRule(err error, tx *transaction.Transaction) interceptorRule {
+	suggestion := "provide the registered username of the account or leave the field empty"
+	if len(tx.RcvUserName) > core.MaxUserNameLength {
+		return interceptorRule{
+			diagnostic: newDiagnostic(ruleUsernameLength, "receiverUsername", err, fmt.Sprintf("<= %d bytes", core.MaxUserNameLength),
+				fmt.Sprintf("%d bytes", len(tx.RcvUserName)), suggestion),
+			fix: func(tx *transaction.Transaction) {
+				tx.RcvUserName = nil
+			},
+		}
+	}
+
+	return interceptorRule{
+		diagnostic: newDiagnostic(ruleUsernameLength, "senderUsername", err, fmt.Sprintf("<= %d bytes", core.MaxUserNameLength),
+			fmt.Sprintf("%d bytes", len(tx.SndUserName)), suggestion),
+		fix: func(tx *transaction.Transaction) {
+			tx.SndUserName = nil
+		},
+	}
+}
+
+func (tc *transactionChecker) getEconomicsRule(err error, tx *transaction.Transaction) (interceptorRule, bool) {
+	switch {
+	case errors.Is(err, process.ErrGasPriceTooHigh):
+		maxGasPrice := tc.feeHandler.MaxGasPriceSetGuardian()
+		return interceptorRule{
+			diagnostic: newDiagnostic(ruleMaxGasPriceSetGuardian, "gasPrice", err, fmt.Sprintf("<= %d", maxGasPrice),
+				fmt.Sprintf("%d", tx.GasPrice), fmt.Sprintf("set the gas price to at most %d", maxGasPrice)),
+			fix: func(tx *transaction.Transaction) {
+				tx.GasPrice = maxGasPrice
+			},
+		}, true
+	case errors.Is(err, process.ErrInsufficientGasPriceInTx):
+		minGasPrice := tc.feeHandler.MinGasPrice()
+		return interceptorRule{
+			diagnostic: newDiagnostic(ruleMinGasPrice, "gasPrice", err, fmt.Sprintf(">= %d", minGasPrice),
+				fmt.Sprintf("%d", tx.GasPrice), fmt.Sprintf("set the gas price to at least %d", minGasPrice)),
+			fix: func(tx *transaction.Transaction) {
+				tx.GasPrice = minGasPrice
+			},
+		}, true
+	case errors.Is(err, process.ErrInsufficientGasLimitInTx):
+		requiredGasLimit := tc.feeHandler.ComputeGasLimit(tx)
+		return interceptorRule{
+			diagnostic: newDiagnostic(ruleMinGasLimit, "gasLimit", err, fmt.Sprintf(">= %d", requiredGasLimit),
+				fmt.Sprintf("%d", tx.GasLimit),
+				fmt.Sprintf("set the gas limit to at least %d, or use /transaction/cost to estimate it", requiredGasLimit)),
+			fix: func(tx *transaction.Transaction) {
+				tx.GasLimit = requiredGasLimit
+			},
+		}, true
+	case errors.Is(err, process.ErrMoreGasThanGasLimitPerBlock), errors.Is(err, process.ErrMoreGasThanGasLimitPerMiniBlockForSafeCrossShard):
+		maxGasLimit := tc.feeHandler.MaxGasLimitPerTx()
+		return interceptorRule{
+			diagnostic: newDiagnostic(ruleMaxGasLimit, "gasLimit", err, fmt.Sprintf("<= %d", maxGasLimit),
+				fmt.Sprintf("%d", tx.GasLimit), fmt.Sprintf("set the gas limit to at most %d", maxGasLimit)),
+			fix: func(tx *transaction.Transaction) {
+				tx.GasLimit = maxGasLimit
+			},
+		}, true
+	case errors.Is(err, process.ErrTxValueOutOfBounds), errors.Is(err, process.ErrTxValueTooBig):
+		return interceptorRule{
+			diagnostic: newDiagnostic(ruleMaxValue, "value", err, fmt.Sprintf("<= %s", tc.feeHandler.GenesisTotalSupply().String()),
+				valueToString(tx.Value), "make sure the value is denominated in the smallest unit"),
+			fix: setZeroValue,
+		}, true
+	default:
+		return interceptorRule{}, false
+	}
+}
+
+// getSignatureStageDiagnostics maps the errors of the signature and relayed transaction checks, which can not be fixed.
+// The guardian signature is verified separately, so it is reported even if the signature of the sender is invalid
+func (tc *transactionChecker) getSignatureStageDiagnostics(
+	interceptedTx *procTx.InterceptedTransaction,
+	tx *transaction.Transaction,
+	err error,
+) []common.TransactionCheckDiagnostic {
+	diagnostics := make([]common.TransactionCheckDiagnostic, 0)
+
+	errGuardian := interceptedTx.VerifyGuardianSig(tx)
+	isGuardianError := errGuardian != nil && errGuardian.Error() == err.Error()
+	if !isGuardianError {
+		diagnostics = append(diagnostics, getSignatureStageDiagnostic(err, false))
+	}
+	if errGuardian != nil {
+		diagnostics = append(diagnostics, getSignatureStageDiagnostic(errGuardian, true))
+	}
+
+	return diagnostics
+}
+
+func getSignatureStageDiagnostic(err error, isGuardianError bool) common.TransactionCheckDiagnostic {
+	switch {
+	case strings.HasPrefix(err.Error(), innerTransactionErrorPrefix):
+		return newDiagnostic(ruleRelayedInnerTransaction, "data", err, "", "",
+			"fix the inner transaction and have its sender sign it again")
+	case errors.Is(err, process.ErrTransactionSignedWithHashIsNotEnabled):
+		return newDiagnostic(ruleSignedWithHash, "options", err, "", "",
+			"unset the sign with hash option and sign the transaction again")
+	case errors.Is(err, process.ErrGuardianAddressNotExpected):
+		return newDiagnostic(ruleGuardianNotExpected, "guardian", err, "", "",
+			"either remove the guardian or set the guarded option (requires version 2 or higher)")
+	case errors.Is(err, process.ErrGuardianSignatureNotExpected):
+		return newDiagnostic(ruleGuardianNotExpected, "guardianSignature", err, "", "",
+			"either remove the guardian signature or set the guarded option (requires version 2 or higher)")
+	case errors.Is(err, process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver):
+		return newDiagnostic(ruleRelayedBeneficiary, "receiver", err, "", "",
+			"set the receiver of the relayed transaction to the sender of the inner transaction")
+	case errors.Is(err, process.ErrRecursiveRelayedTxIsNotAllowed):
+		return newDiagnostic(ruleRelayedRecursive, "data", err, "", "",
+			"relay the innermost transaction directly")
+	case errors.Is(err, process.ErrInvalidArguments):
+		return newDiagnostic(ruleRelayedArguments, "data", err, "", "",
+			"provide the arguments of the relayed transaction as described in the documentation")
+	case isGuardianError:
+		return newDiagnostic(ruleGuardianSignature, "guardianSignature", err, "", "",
+			"have the guardian co-sign the transaction after setting all its fields")
+	default:
+		return newDiagnostic(ruleSenderSignature, "signature", err, "", "",
+			"sign the transaction again, after setting all its fields, with the private key of the sender")
+	}
+}
+
+func (tc *transactionChecker) newAddressLengthDiagnostic(field string, err error, address []byte) common.TransactionCheckDiagnostic {
+	return newDiagnostic(ruleAddressLength, field, err, fmt.Sprintf("%d bytes", tc.addressPubKeyConverter.Len()),
+		fmt.Sprintf("%d bytes", len(address)), "provide a valid bech32 address")
+}
+
+func newDiagnostic(rule string, field string, err error, expected string, actual string, suggestion string) common.TransactionCheckDiagnostic {
+	return common.TransactionCheckDiagnostic{
+		Rule:       rule,
+		Severity:   severityError,
+		Field:      field,
+		Expected:   expected,
+		Actual:     actual,
+		Message:    err.Error(),
+		Suggestion: suggestion,
+	}
+}
+
+func setZeroValue(tx *transaction.Transaction) {
+	tx.Value = big.NewInt(0)
+}
+
+func copyTransaction(tx *transaction.Transaction) *transaction.Transaction {
+	txCopy := *tx
+	if tx.Value != nil {
+		txCopy.Value = big.NewInt(0).Set(tx.Value)
+	}
+
+	return &txCopy
+}
+
+func valueToString(value *big.Int) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.String()
+}
@@ -0,0 +1,356 @@
+package transactionCheckAPI
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/versioning"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/stretchr/testify/require"
+)
+
+func hexAddress(address []byte) string {
+	return hex.EncodeToString(address)
+}
+
+func createRelayedTx(t *testing.T, relayer *testWallet, innerTx *transaction.Transaction) *transaction.Transaction {
+	innerTxBuff, err := (&marshal.JsonMarshalizer{}).Marshal(innerTx)
+	require.Nil(t, err)
+
+	tx := createMoveBalanceTx(relayer, innerTx.SndAddr)
+	tx.Value = big.NewInt(0)
+	tx.Data = []byte(core.RelayedTransaction + "@" + hex.EncodeToString(innerTxBuff))
+	tx.GasLimit = minGasLimitToTest + gasPerDataByteToTest*uint64(len(tx.Data)) + innerTx.GasLimit
+
+	return tx
+}
+
+func TestTransactionChecker_checkInterceptorRulesIntegrity(t *testing.T) {
+	t.Parallel()
+
+	checker, _ := NewTransactionChecker(createMockArgsTransactionChecker())
+
+	t.Run("valid transaction should not report diagnostics", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		signTx(t, tx, sender)
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Empty(t, diagnostics)
+	})
+	t.Run("options on the initial version should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.Options = transaction.MaskGuardedTransaction
+		signTx(t, tx, sender)
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleOptions, diagnostics[0].Rule)
+		require.Equal(t, "0", diagnostics[0].Expected)
+		require.Equal(t, "2", diagnostics[0].Actual)
+		require.Equal(t, core.ErrInvalidTransactionVersion.Error(), diagnostics[0].Message)
+	})
+	t.Run("version lower than the minimum version should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.MinTxVersion = 2
+		args.TxVersionChecker = versioning.NewTxVersionChecker(2)
+		checkerWithMinVersion, _ := NewTransactionChecker(args)
+
+		sender := newTestWallet()
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		signTx(t, tx, sender)
+
+		diagnostics, err := checkerWithMinVersion.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleVersion, diagnostics[0].Rule)
+		require.Equal(t, ">= 2", diagnostics[0].Expected)
+		require.Equal(t, "1", diagnostics[0].Actual)
+	})
+	t.Run("all the malformed fields should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tx := createMoveBalanceTx(newTestWallet(), []byte("short"))
+		tx.Value = big.NewInt(-1)
+		tx.SndAddr = nil
+		tx.SndUserName = make([]byte, core.MaxUserNameLength+1)
+		tx.RcvUserName = make([]byte, core.MaxUserNameLength+1)
+		tx.ChainID = []byte("D")
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 7)
+		requireDiagnostic(t, diagnostics, ruleSignatureMissing, "signature")
+		diagnostic := requireDiagnostic(t, diagnostics, ruleValue, "value")
+		require.Equal(t, "-1", diagnostic.Actual)
+		diagnostic = requireDiagnostic(t, diagnostics, ruleUsernameLength, "senderUsername")
+		require.Equal(t, "<= 32 bytes", diagnostic.Expected)
+		require.Equal(t, "33 bytes", diagnostic.Actual)
+		requireDiagnostic(t, diagnostics, ruleUsernameLength, "receiverUsername")
+		diagnostic = requireDiagnostic(t, diagnostics, ruleChainID, "chainID")
+		require.Equal(t, chainIDToTest, diagnostic.Expected)
+		require.Equal(t, "D", diagnostic.Actual)
+		diagnostic = requireDiagnostic(t, diagnostics, ruleAddressLength, "sender")
+		require.Equal(t, "32 bytes", diagnostic.Expected)
+		require.Equal(t, "0 bytes", diagnostic.Actual)
+		diagnostic = requireDiagnostic(t, diagnostics, ruleAddressLength, "receiver")
+		require.Equal(t, "5 bytes", diagnostic.Actual)
+	})
+}
+
+func TestTransactionChecker_checkInterceptorRulesEconomics(t *testing.T) {
+	t.Parallel()
+
+	checker, _ := NewTransactionChecker(createMockArgsTransactionChecker())
+
+	t.Run("gas limit higher than the maximum should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.GasLimit = maxGasLimitPerBlockToTest
+		signTx(t, tx, sender)
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleMaxGasLimit, diagnostics[0].Rule)
+		require.Equal(t, "gasLimit", diagnostics[0].Field)
+		require.Equal(t, "<= 600000000", diagnostics[0].Expected)
+		require.Equal(t, "1500000000", diagnostics[0].Actual)
+	})
+	t.Run("value higher than the total supply should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.Value, _ = big.NewInt(0).SetString(genesisTotalSupplyToTest+"0", 10)
+		signTx(t, tx, sender)
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleMaxValue, diagnostics[0].Rule)
+		require.Equal(t, "<= "+genesisTotalSupplyToTest, diagnostics[0].Expected)
+	})
+	t.Run("gas price and gas limit lower than the minimum should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.GasPrice = 0
+		tx.Data = []byte("data")
+		signTx(t, tx, sender)
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 2)
+		diagnostic := requireDiagnostic(t, diagnostics, ruleMinGasPrice, "gasPrice")
+		require.Equal(t, "0", diagnostic.Actual)
+		diagnostic = requireDiagnostic(t, diagnostics, ruleMinGasLimit, "gasLimit")
+		require.Equal(t, ">= 56000", diagnostic.Expected)
+		require.Equal(t, "50000", diagnostic.Actual)
+	})
+	t.Run("gas price of a not guarded SetGuardian call higher than the maximum should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		tx := createMoveBalanceTx(sender, sender.address)
+		tx.Data = []byte("SetGuardian@" + hexAddress(newTestWallet().address) + "@aa")
+		tx.GasLimit = 1_000_000
+		tx.GasPrice = maxGasPriceSetGuardianToTest + 1
+		signTx(t, tx, sender)
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleMaxGasPriceSetGuardian, diagnostics[0].Rule)
+		require.Equal(t, "<= 2000000000", diagnostics[0].Expected)
+
+		tx.GasPrice = maxGasPriceSetGuardianToTest
+		signTx(t, tx, sender)
+		diagnostics, err = checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Empty(t, diagnostics)
+	})
+}
+
+func TestTransactionChecker_checkInterceptorRulesSignatures(t *testing.T) {
+	t.Parallel()
+
+	t.Run("signature of a different account should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewTransactionChecker(createMockArgsTransactionChecker())
+		tx := createMoveBalanceTx(newTestWallet(), newTestWallet().address)
+		signTx(t, tx, newTestWallet())
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleSenderSignature, diagnostics[0].Rule)
+		require.Equal(t, severityError, diagnostics[0].Severity)
+	})
+	t.Run("signature should not be reported if another rule is violated", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewTransactionChecker(createMockArgsTransactionChecker())
+		tx := createMoveBalanceTx(newTestWallet(), newTestWallet().address)
+		signTx(t, tx, newTestWallet())
+		tx.GasPrice = 0
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleMinGasPrice, diagnostics[0].Rule)
+	})
+	t.Run("signed with hash while not enabled should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewTransactionChecker(createMockArgsTransactionChecker())
+		sender := newTestWallet()
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.Version = 2
+		tx.Options = transaction.MaskSignedWithHash
+		signTx(t, tx, sender)
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleSignedWithHash, diagnostics[0].Rule)
+		require.Equal(t, "options", diagnostics[0].Field)
+	})
+	t.Run("signed with hash while enabled should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.EnableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.TransactionSignedWithTxHashFlag)
+		checker, _ := NewTransactionChecker(args)
+		sender := newTestWallet()
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.Version = 2
+		tx.Options = transaction.MaskSignedWithHash
+		signTx(t, tx, sender)
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Empty(t, diagnostics)
+	})
+	t.Run("guardian on a not guarded transaction should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewTransactionChecker(createMockArgsTransactionChecker())
+		sender := newTestWallet()
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.GuardianAddr = newTestWallet().address
+		signTx(t, tx, sender)
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleGuardianNotExpected, diagnostics[0].Rule)
+		require.Equal(t, "guardian", diagnostics[0].Field)
+	})
+	t.Run("invalid sender and guardian signatures should both be reported", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewTransactionChecker(createMockArgsTransactionChecker())
+		tx := createMoveBalanceTx(newTestWallet(), newTestWallet().address)
+		tx.Version = 2
+		tx.Options = transaction.MaskGuardedTransaction
+		tx.GuardianAddr = newTestWallet().address
+		tx.GuardianSignature = computeSignature(t, tx, newTestWallet())
+		signTx(t, tx, newTestWallet())
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 2)
+		requireDiagnostic(t, diagnostics, ruleSenderSignature, "signature")
+		requireDiagnostic(t, diagnostics, ruleGuardianSignature, "guardianSignature")
+	})
+	t.Run("guarded transaction co-signed by the guardian should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewTransactionChecker(createMockArgsTransactionChecker())
+		sender := newTestWallet()
+		guardian := newTestWallet()
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.Version = 2
+		tx.Options = transaction.MaskGuardedTransaction
+		tx.GuardianAddr = guardian.address
+		tx.GuardianSignature = computeSignature(t, tx, guardian)
+		signTx(t, tx, sender)
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Empty(t, diagnostics)
+	})
+}
+
+func TestTransactionChecker_checkInterceptorRulesRelayed(t *testing.T) {
+	t.Parallel()
+
+	checker, _ := NewTransactionChecker(createMockArgsTransactionChecker())
+
+	t.Run("valid relayed transaction should work", func(t *testing.T) {
+		t.Parallel()
+
+		relayer := newTestWallet()
+		innerSender := newTestWallet()
+		innerTx := createMoveBalanceTx(innerSender, newTestWallet().address)
+		signTx(t, innerTx, innerSender)
+		tx := createRelayedTx(t, relayer, innerTx)
+		signTx(t, tx, relayer)
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Empty(t, diagnostics)
+	})
+	t.Run("invalid inner transaction should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		relayer := newTestWallet()
+		innerSender := newTestWallet()
+		innerTx := createMoveBalanceTx(innerSender, newTestWallet().address)
+		signTx(t, innerTx, newTestWallet())
+		tx := createRelayedTx(t, relayer, innerTx)
+		signTx(t, tx, relayer)
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleRelayedInnerTransaction, diagnostics[0].Rule)
+		require.Contains(t, diagnostics[0].Message, innerTransactionErrorPrefix)
+	})
+	t.Run("receiver different than the inner sender should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		relayer := newTestWallet()
+		innerSender := newTestWallet()
+		innerTx := createMoveBalanceTx(innerSender, newTestWallet().address)
+		signTx(t, innerTx, innerSender)
+		tx := createRelayedTx(t, relayer, innerTx)
+		tx.RcvAddr = newTestWallet().address
+		signTx(t, tx, relayer)
+
+		diagnostics, err := checker.checkInterceptorRules(tx)
+		require.Nil(t, err)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, ruleRelayedBeneficiary, diagnostics[0].Rule)
+		require.Equal(t, "receiver", diagnostics[0].Field)
+	})
+}
@@ -0,0 +1,228 @@
+package transactionCheckAPI
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node/disabled"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/dataValidators"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+var log = logger.GetOrCreate("node/external/transactionCheckAPI")
+
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+)
+
+// ArgsTransactionChecker holds the arguments needed to create a transaction checker
+type ArgsTransactionChecker struct {
+	ShardCoordinator       sharding.Coordinator
+	AddressPubKeyConverter core.PubkeyConverter
+	FeeHandler             process.FeeHandler
+	Accounts               state.AccountsAdapter
+	GuardianChecker        process.GuardianChecker
+	TxVersionChecker       process.TxVersionCheckerHandler
+	EnableEpochsHandler    common.EnableEpochsHandler
+	Marshaller             marshal.Marshalizer
+	Hasher                 hashing.Hasher
+	TxSignMarshaller       marshal.Marshalizer
+	TxSignHasher           hashing.Hasher
+	KeyGen                 crypto.KeyGenerator
+	SingleSigner           crypto.SingleSigner
+	ArgumentsParser        process.ArgumentsParser
+	BuiltInFunctions       vmcommon.BuiltInFunctionContainer
+	ESDTTransferParser     vmcommon.ESDTTransferParser
+	ChainID                []byte
+	MinTxVersion           uint32
+}
+
+type transactionChecker struct {
+	shardCoordinator       sharding.Coordinator
+	addressPubKeyConverter core.PubkeyConverter
+	feeHandler             process.FeeHandler
+	accounts               state.AccountsAdapter
+	txValidator            process.TxValidator
+	guardianChecker        process.GuardianChecker
+	txVersionChecker       process.TxVersionCheckerHandler
+	enableEpochsHandler    common.EnableEpochsHandler
+	marshaller             marshal.Marshalizer
+	hasher                 hashing.Hasher
+	txSignMarshaller       marshal.Marshalizer
+	txSignHasher           hashing.Hasher
+	keyGen                 crypto.KeyGenerator
+	singleSigner           crypto.SingleSigner
+	argumentsParser        process.ArgumentsParser
+	builtInFunctions       vmcommon.BuiltInFunctionContainer
+	esdtTransferParser     vmcommon.ESDTTransferParser
+	chainID                []byte
+	minTxVersion           uint32
+}
+
+// NewTransactionChecker creates a component able to lint a transaction before it is sent. The transaction is checked
+// by the same intercepted transaction and transactions validator used for the transactions received from the network,
+// but all the violated rules are reported instead of stopping at the first one
+func NewTransactionChecker(args ArgsTransactionChecker) (*transactionChecker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// the disabled white list handler never reports the transaction as verified, so all the checks are run
+	txValidator, err := dataValidators.NewTxValidator(
+		args.Accounts,
+		args.ShardCoordinator,
+		disabled.NewDisabledWhiteListDataVerifier(),
+		args.AddressPubKeyConverter,
+		args.TxVersionChecker,
+		common.MaxTxNonceDeltaAllowed,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w when creating the transactions validator", err)
+	}
+
+	return &transactionChecker{
+		shardCoordinator:       args.ShardCoordinator,
+		addressPubKeyConverter: args.AddressPubKeyConverter,
+		feeHandler:             args.FeeHandler,
+		accounts:               args.Accounts,
+		txValidator:            txValidator,
+		guardianChecker:        args.GuardianChecker,
+		txVersionChecker:       args.TxVersionChecker,
+		enableEpochsHandler:    args.EnableEpochsHandler,
+		marshaller:             args.Marshaller,
+		hasher:                 args.Hasher,
+		txSignMarshaller:       args.TxSignMarshaller,
+		txSignHasher:           args.TxSignHasher,
+		keyGen:                 args.KeyGen,
+		singleSigner:           args.SingleSigner,
+		argumentsParser:        args.ArgumentsParser,
+		builtInFunctions:       args.BuiltInFunctions,
+		esdtTransferParser:     args.ESDTTransferParser,
+		chainID:                args.ChainID,
+		minTxVersion:           args.MinTxVersion,
+	}, nil
+}
+
+func checkArgs(args ArgsTransactionChecker) error {
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return ErrNilAddressPubKeyConverter
+	}
+	if check.IfNil(args.FeeHandler) {
+		return ErrNilFeeHandler
+	}
+	if check.IfNil(args.Accounts) {
+		return ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.GuardianChecker) {
+		return ErrNilGuardianChecker
+	}
+	if check.IfNil(args.TxVersionChecker) {
+		return ErrNilTxVersionChecker
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return ErrNilEnableEpochsHandler
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.TxSignMarshaller) {
+		return ErrNilTxSignMarshaller
+	}
+	if check.IfNil(args.TxSignHasher) {
+		return ErrNilTxSignHasher
+	}
+	if check.IfNil(args.KeyGen) {
+		return ErrNilKeyGenerator
+	}
+	if check.IfNil(args.SingleSigner) {
+		return ErrNilSingleSigner
+	}
+	if check.IfNil(args.ArgumentsParser) {
+		return ErrNilArgumentsParser
+	}
+	if check.IfNil(args.BuiltInFunctions) {
+		return ErrNilBuiltInFunctionsContainer
+	}
+	if check.IfNil(args.ESDTTransferParser) {
+		return ErrNilESDTTransferParser
+	}
+	if len(args.ChainID) == 0 {
+		return ErrEmptyChainID
+	}
+
+	return nil
+}
+
+// CheckTransaction runs the interceptor checks, the transactions validator checks, the guardian rules of the
+// transaction processor and the built-in function checks against the provided transaction. Each violated rule is
+// reported as a separate diagnostic
+func (tc *transactionChecker) CheckTransaction(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	diagnostics, err := tc.checkInterceptorRules(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountDiagnostics, err := tc.checkSenderAccount(tx)
+	if err != nil {
+		return nil, err
+	}
+	diagnostics = append(diagnostics, accountDiagnostics...)
+
+	dataFieldDiagnostics, err := tc.checkDataField(tx)
+	if err != nil {
+		return nil, err
+	}
+	diagnostics = append(diagnostics, dataFieldDiagnostics...)
+
+	log.Trace("transactionChecker.CheckTransaction", "sender", tx.SndAddr, "nonce", tx.Nonce, "num diagnostics", len(diagnostics))
+
+	return &common.TransactionCheckApiResponse{
+		Valid:       !hasErrors(diagnostics),
+		Diagnostics: diagnostics,
+	}, nil
+}
+
+func hasErrors(diagnostics []common.TransactionCheckDiagnostic) bool {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == severityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (tc *transactionChecker) isValidAddress(address []byte) bool {
+	return len(address) == tc.addressPubKeyConverter.Len()
+}
+
+func (tc *transactionChecker) encodeAddress(address []byte) string {
+	return tc.addressPubKeyConverter.SilentEncode(address, log)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tc *transactionChecker) IsInterfaceNil() bool {
+	return tc == nil
+}
@@ -0,0 +1,482 @@
+package transactionCheckAPI
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/versioning"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/smartContract"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/guardianMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/builtInFunctions"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	minGasPriceToTest            = uint64(1_000_000_000)
+	minGasLimitToTest            = uint64(50_000)
+	gasPerDataByteToTest         = uint64(1_500)
+	maxGasLimitPerTxToTest       = uint64(600_000_000)
+	maxGasLimitPerBlockToTest    = uint64(1_500_000_000)
+	maxGasPriceSetGuardianToTest = uint64(2_000_000_000)
+	chainIDToTest                = "T"
+	minTxVersionToTest           = uint32(1)
+	genesisTotalSupplyToTest     = "20000000000000000000000000"
+)
+
+var expectedErr = errors.New("expected error")
+
+var testKeyGen = signing.NewKeyGenerator(ed25519.NewEd25519())
+
+var testSigner = &singlesig.Ed25519Signer{}
+
+type testWallet struct {
+	privateKey crypto.PrivateKey
+	address    []byte
+}
+
+func newTestWallet() *testWallet {
+	privateKey, publicKey := testKeyGen.GeneratePair()
+	address, _ := publicKey.ToByteArray()
+
+	return &testWallet{
+		privateKey: privateKey,
+		address:    address,
+	}
+}
+
+func createMockArgsTransactionChecker() ArgsTransactionChecker {
+	genesisTotalSupply, _ := big.NewInt(0).SetString(genesisTotalSupplyToTest, 10)
+	esdtTransferParser, _ := parsers.NewESDTTransferParser(&marshal.GogoProtoMarshalizer{})
+
+	return ArgsTransactionChecker{
+		ShardCoordinator:       testscommon.NewMultiShardsCoordinatorMock(1),
+		AddressPubKeyConverter: testscommon.RealWorldBech32PubkeyConverter,
+		FeeHandler:             createFeeHandler(genesisTotalSupply),
+		Accounts:               &stateMock.AccountsStub{},
+		GuardianChecker:        &guardianMocks.GuardedAccountHandlerStub{},
+		TxVersionChecker:       versioning.NewTxVersionChecker(minTxVersionToTest),
+		EnableEpochsHandler:    enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+		Marshaller:             &marshal.GogoProtoMarshalizer{},
+		Hasher:                 &hashingMocks.HasherMock{},
+		TxSignMarshaller:       &marshal.JsonMarshalizer{},
+		TxSignHasher:           &hashingMocks.HasherMock{},
+		KeyGen:                 testKeyGen,
+		SingleSigner:           testSigner,
+		ArgumentsParser:        smartContract.NewArgumentParser(),
+		BuiltInFunctions:       builtInFunctions.NewBuiltInFunctionContainer(),
+		ESDTTransferParser:     esdtTransferParser,
+		ChainID:                []byte(chainIDToTest),
+		MinTxVersion:           minTxVersionToTest,
+	}
+}
+
+// createFeeHandler returns a fee handler which checks the transaction values as the economics data does
+func createFeeHandler(genesisTotalSupply *big.Int) *economicsmocks.EconomicsHandlerStub {
+	feeHandler := &economicsmocks.EconomicsHandlerStub{
+		MinGasPriceCalled: func() uint64 {
+			return minGasPriceToTest
+		},
+		MaxGasLimitPerTxCalled: func() uint64 {
+			return maxGasLimitPerTxToTest
+		},
+		MaxGasLimitPerBlockCalled: func(shardID uint32) uint64 {
+			return maxGasLimitPerBlockToTest
+		},
+		MaxGasPriceSetGuardianCalled: func() uint64 {
+			return maxGasPriceSetGuardianToTest
+		},
+		GenesisTotalSupplyCalled: func() *big.Int {
+			return genesisTotalSupply
+		},
+		ComputeGasLimitCalled: func(tx data.TransactionWithFeeHandler) uint64 {
+			return minGasLimitToTest + gasPerDataByteToTest*uint64(len(tx.GetData()))
+		},
+		ComputeTxFeeCalled: func(tx data.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(0).SetUint64(tx.GetGasLimit() * tx.GetGasPrice())
+		},
+	}
+	feeHandler.CheckValidityTxValuesCalled = func(tx data.TransactionWithFeeHandler) error {
+		switch {
+		case tx.GetGasPrice() < minGasPriceToTest:
+			return process.ErrInsufficientGasPriceInTx
+		case tx.GetGasLimit() < feeHandler.ComputeGasLimit(tx):
+			return process.ErrInsufficientGasLimitInTx
+		case tx.GetGasLimit() >= maxGasLimitPerBlockToTest:
+			return process.ErrMoreGasThanGasLimitPerBlock
+		case tx.GetValue().Cmp(genesisTotalSupply) > 0:
+			return process.ErrTxValueTooBig
+		default:
+			return nil
+		}
+	}
+
+	return feeHandler
+}
+
+func setAccounts(args *ArgsTransactionChecker, accounts ...vmcommon.AccountHandler) {
+	args.Accounts = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			for _, account := range accounts {
+				if bytes.Equal(account.AddressBytes(), address) {
+					return account, nil
+				}
+			}
+
+			return nil, state.ErrAccNotFound
+		},
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			for _, account := range accounts {
+				if bytes.Equal(account.AddressBytes(), address) {
+					return account, nil
+				}
+			}
+
+			return stateMock.NewAccountWrapMock(address), nil
+		},
+	}
+}
+
+func createAccount(address []byte, nonce uint64, balance *big.Int) *stateMock.AccountWrapMock {
+	account := stateMock.NewAccountWrapMock(address)
+	account.IncreaseNonce(nonce)
+	account.Balance = balance
+
+	return account
+}
+
+func createMoveBalanceTx(sender *testWallet, receiver []byte) *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:    0,
+		Value:    big.NewInt(1),
+		RcvAddr:  receiver,
+		SndAddr:  sender.address,
+		GasPrice: minGasPriceToTest,
+		GasLimit: minGasLimitToTest,
+		ChainID:  []byte(chainIDToTest),
+		Version:  minTxVersionToTest,
+	}
+}
+
+func signTx(t *testing.T, tx *transaction.Transaction, wallet *testWallet) {
+	tx.Signature = nil
+	tx.Signature = computeSignature(t, tx, wallet)
+}
+
+func computeSignature(t *testing.T, tx *transaction.Transaction, wallet *testWallet) []byte {
+	txSigningData, err := tx.GetDataForSigning(testscommon.RealWorldBech32PubkeyConverter, &marshal.JsonMarshalizer{}, &hashingMocks.HasherMock{})
+	require.Nil(t, err)
+
+	signature, err := testSigner.Sign(wallet.privateKey, txSigningData)
+	require.Nil(t, err)
+
+	return signature
+}
+
+func findDiagnostic(diagnostics []common.TransactionCheckDiagnostic, rule string, field string) (common.TransactionCheckDiagnostic, bool) {
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Rule == rule && diagnostic.Field == field {
+			return diagnostic, true
+		}
+	}
+
+	return common.TransactionCheckDiagnostic{}, false
+}
+
+func requireDiagnostic(t *testing.T, diagnostics []common.TransactionCheckDiagnostic, rule string, field string) common.TransactionCheckDiagnostic {
+	diagnostic, found := findDiagnostic(diagnostics, rule, field)
+	require.True(t, found, "diagnostic %s for field %s not found in %v", rule, field, diagnostics)
+
+	return diagnostic
+}
+
+func TestNewTransactionChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.ShardCoordinator = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilShardCoordinator, err)
+	})
+	t.Run("nil address pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.AddressPubKeyConverter = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilAddressPubKeyConverter, err)
+	})
+	t.Run("nil fee handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.FeeHandler = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilFeeHandler, err)
+	})
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.Accounts = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil guardian checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.GuardianChecker = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilGuardianChecker, err)
+	})
+	t.Run("nil tx version checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.TxVersionChecker = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilTxVersionChecker, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.EnableEpochsHandler = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.Marshaller = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.Hasher = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil tx sign marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.TxSignMarshaller = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilTxSignMarshaller, err)
+	})
+	t.Run("nil tx sign hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.TxSignHasher = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilTxSignHasher, err)
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.KeyGen = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilKeyGenerator, err)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.SingleSigner = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilSingleSigner, err)
+	})
+	t.Run("nil arguments parser should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.ArgumentsParser = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilArgumentsParser, err)
+	})
+	t.Run("nil built-in functions container should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.BuiltInFunctions = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilBuiltInFunctionsContainer, err)
+	})
+	t.Run("nil ESDT transfer parser should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.ESDTTransferParser = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrNilESDTTransferParser, err)
+	})
+	t.Run("empty chain ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.ChainID = nil
+		checker, err := NewTransactionChecker(args)
+		require.Nil(t, checker)
+		require.Equal(t, ErrEmptyChainID, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewTransactionChecker(createMockArgsTransactionChecker())
+		require.Nil(t, err)
+		require.False(t, checker.IsInterfaceNil())
+	})
+}
+
+func TestTransactionChecker_CheckTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		checker, _ := NewTransactionChecker(createMockArgsTransactionChecker())
+		response, err := checker.CheckTransaction(nil)
+		require.Nil(t, response)
+		require.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("accounts adapter error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionChecker()
+		args.Accounts = &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				return nil, expectedErr
+			},
+		}
+		checker, _ := NewTransactionChecker(args)
+
+		sender := newTestWallet()
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		signTx(t, tx, sender)
+
+		response, err := checker.CheckTransaction(tx)
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("valid transaction should not report diagnostics", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 0, big.NewInt(1_000_000_000_000_000)))
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		signTx(t, tx, sender)
+
+		response, err := checker.CheckTransaction(tx)
+		require.Nil(t, err)
+		require.True(t, response.Valid)
+		require.Empty(t, response.Diagnostics)
+	})
+	t.Run("warnings should not invalidate the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 3, big.NewInt(1_000_000_000_000_000)))
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.Nonce = 5
+		signTx(t, tx, sender)
+
+		response, err := checker.CheckTransaction(tx)
+		require.Nil(t, err)
+		require.True(t, response.Valid)
+		require.Len(t, response.Diagnostics, 1)
+
+		diagnostic := response.Diagnostics[0]
+		require.Equal(t, ruleNonceGap, diagnostic.Rule)
+		require.Equal(t, severityWarning, diagnostic.Severity)
+		require.Equal(t, "3", diagnostic.Expected)
+		require.Equal(t, "5", diagnostic.Actual)
+	})
+	t.Run("should report all the violated rules", func(t *testing.T) {
+		t.Parallel()
+
+		sender := newTestWallet()
+		args := createMockArgsTransactionChecker()
+		setAccounts(&args, createAccount(sender.address, 7, big.NewInt(1_000_000_000_000_000)))
+		checker, _ := NewTransactionChecker(args)
+
+		tx := createMoveBalanceTx(sender, newTestWallet().address)
+		tx.ChainID = []byte("D")
+		tx.GasPrice = minGasPriceToTest - 1
+		tx.GasLimit = minGasLimitToTest - 1
+		tx.Nonce = 2
+		tx.Data = []byte("ESDTTransfer@a")
+
+		response, err := checker.CheckTransaction(tx)
+		require.Nil(t, err)
+		require.False(t, response.Valid)
+
+		diagnostic := requireDiagnostic(t, response.Diagnostics, ruleChainID, "chainID")
+		require.Equal(t, chainIDToTest, diagnostic.Expected)
+		require.Equal(t, "D", diagnostic.Actual)
+		requireDiagnostic(t, response.Diagnostics, ruleSignatureMissing, "signature")
+		diagnostic = requireDiagnostic(t, response.Diagnostics, ruleMinGasPrice, "gasPrice")
+		require.Equal(t, ">= 1000000000", diagnostic.Expected)
+		require.Equal(t, "999999999", diagnostic.Actual)
+		diagnostic = requireDiagnostic(t, response.Diagnostics, ruleMinGasLimit, "gasLimit")
+		require.Equal(t, ">= 71000", diagnostic.Expected)
+		diagnostic = requireDiagnostic(t, response.Diagnostics, ruleNonceTooLow, "nonce")
+		require.Equal(t, ">= 7", diagnostic.Expected)
+		require.Equal(t, "2", diagnostic.Actual)
+		requireDiagnostic(t, response.Diagnostics, ruleDataFieldParse, "data")
+
+		for _, diagnostic = range response.Diagnostics {
+			require.NotEmpty(t, diagnostic.Message)
+		}
+	})
+}
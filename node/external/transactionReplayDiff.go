@@ -0,0 +1,86 @@
+package external
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+)
+
+// computeReplayDiff compares the results of a re-executed transaction with the stored ones. The smart contract results
+// are matched by their hashes, while the log events are compared one by one, in their emitting order
+func computeReplayDiff(stored *transaction.ApiTransactionResult, replayed *transaction.SimulationResults) *txSimData.ReplayDiff {
+	diff := &txSimData.ReplayDiff{
+		StoredStatus:   string(stored.Status),
+		ReplayedStatus: string(replayed.Status),
+	}
+
+	storedScResults := make(map[string]struct{}, len(stored.SmartContractResults))
+	for _, scr := range stored.SmartContractResults {
+		if scr == nil {
+			continue
+		}
+
+		storedScResults[scr.Hash] = struct{}{}
+		_, found := replayed.ScResults[scr.Hash]
+		if !found {
+			diff.MissingScResults = append(diff.MissingScResults, scr.Hash)
+		}
+	}
+	for hash := range replayed.ScResults {
+		_, found := storedScResults[hash]
+		if !found {
+			diff.UnexpectedScResults = append(diff.UnexpectedScResults, hash)
+		}
+	}
+	sort.Strings(diff.MissingScResults)
+	sort.Strings(diff.UnexpectedScResults)
+
+	storedEvents := getLogEvents(stored.Logs)
+	replayedEvents := getLogEvents(replayed.Logs)
+	diff.StoredLogEvents = len(storedEvents)
+	diff.ReplayedLogEvents = len(replayedEvents)
+
+	maxNumEvents := len(storedEvents)
+	if len(replayedEvents) > maxNumEvents {
+		maxNumEvents = len(replayedEvents)
+	}
+	for index := 0; index < maxNumEvents; index++ {
+		if index >= len(storedEvents) || index >= len(replayedEvents) || !areLogEventsEqual(storedEvents[index], replayedEvents[index]) {
+			diff.MismatchedLogEvents = append(diff.MismatchedLogEvents, index)
+		}
+	}
+
+	diff.Matches = len(diff.MissingScResults) == 0 && len(diff.UnexpectedScResults) == 0 && len(diff.MismatchedLogEvents) == 0
+
+	return diff
+}
+
+func getLogEvents(logs *transaction.ApiLogs) []*transaction.Events {
+	if logs == nil {
+		return nil
+	}
+
+	return logs.Events
+}
+
+func areLogEventsEqual(first *transaction.Events, second *transaction.Events) bool {
+	if first == nil || second == nil {
+		return first == second
+	}
+	if first.Address != second.Address || first.Identifier != second.Identifier {
+		return false
+	}
+	if !bytes.Equal(first.Data, second.Data) || len(first.Topics) != len(second.Topics) {
+		return false
+	}
+
+	for index := range first.Topics {
+		if !bytes.Equal(first.Topics[index], second.Topics[index]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -5,6 +5,10 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-go/api/subscriptions"
+	"github.com/multiversx/mx-chain-go/dblookupext/accountsDiffs"
+	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/update"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -62,3 +66,22 @@ type accountHandlerWithDataTrieMigrationStatus interface {
 	vmcommon.AccountHandler
 	IsDataTrieMigrated() (bool, error)
 }
+
+// SubscriptionsHubHandler defines the component feeding the web socket subscribers with the data received as an outport driver
+type SubscriptionsHubHandler interface {
+	outport.Driver
+	HandleConnection(conn subscriptions.WsConn) error
+}
+
+// AccountsDiffsHandler defines the component able to provide the state of an account at a past block, from the
+// recorded per-block accounts diffs and the retained root of the block's epoch
+type AccountsDiffsHandler interface {
+	GetAccountAtBlock(address []byte, blockNonce uint64, epoch uint32) (*alteredAccount.AlteredAccount, *accountsDiffs.RetainedRoot, error)
+	IsInterfaceNil() bool
+}
+
+// AccountsDiffsRecorderHandler defines the component recording the per-block accounts diffs as an outport driver
+type AccountsDiffsRecorderHandler interface {
+	outport.Driver
+	AccountsDiffsHandler
+}
@@ -65,6 +65,8 @@ func TestInitBaseMetrics(t *testing.T) {
 		common.MetricAccountsSnapshotNumNodes,
 		common.MetricTrieSyncNumProcessedNodes,
 		common.MetricTrieSyncNumReceivedBytes,
+		common.MetricTrieSyncProgressPercent,
+		common.MetricTrieSyncEstimatedTimeLeft,
 		common.MetricRoundAtEpochStart,
 		common.MetricNonceAtEpochStart,
 	}
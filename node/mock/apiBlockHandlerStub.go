@@ -3,6 +3,8 @@ package mock
 import (
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 )
 
 // BlockAPIHandlerStub -
@@ -11,6 +13,7 @@ type BlockAPIHandlerStub struct {
 	GetBlockByHashCalled             func(hash []byte, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRoundCalled            func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetAlteredAccountsForBlockCalled func(options api.GetAlteredAccountsForBlockOptions) ([]*alteredAccount.AlteredAccount, error)
+	GetTransactionStateDiffCalled    func(tx *transaction.ApiTransactionResult) ([]*txSimData.AccountStateDiff, error)
 }
 
 // GetBlockByNonce -
@@ -49,6 +52,15 @@ func (bah *BlockAPIHandlerStub) GetAlteredAccountsForBlock(options api.GetAltere
 	return nil, nil
 }
 
+// GetTransactionStateDiff -
+func (bah *BlockAPIHandlerStub) GetTransactionStateDiff(tx *transaction.ApiTransactionResult) ([]*txSimData.AccountStateDiff, error) {
+	if bah.GetTransactionStateDiffCalled != nil {
+		return bah.GetTransactionStateDiffCalled(tx)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (bah *BlockAPIHandlerStub) IsInterfaceNil() bool {
 	return bah == nil
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// APIMempoolHandlerStub -
+type APIMempoolHandlerStub struct {
+	GetTransactionsPoolSenderScoreCalled      func(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error)
+	GetTransactionsPoolSelectionPreviewCalled func(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error)
+}
+
+// GetTransactionsPoolSenderScore -
+func (stub *APIMempoolHandlerStub) GetTransactionsPoolSenderScore(sender string) (*common.TransactionsPoolSenderScoreApiResponse, error) {
+	if stub.GetTransactionsPoolSenderScoreCalled != nil {
+		return stub.GetTransactionsPoolSenderScoreCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolSelectionPreview -
+func (stub *APIMempoolHandlerStub) GetTransactionsPoolSelectionPreview(sender string) (*common.TransactionsPoolSelectionPreviewApiResponse, error) {
+	if stub.GetTransactionsPoolSelectionPreviewCalled != nil {
+		return stub.GetTransactionsPoolSelectionPreviewCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *APIMempoolHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,25 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// APITransactionCheckerStub -
+type APITransactionCheckerStub struct {
+	CheckTransactionCalled func(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error)
+}
+
+// CheckTransaction -
+func (stub *APITransactionCheckerStub) CheckTransaction(tx *transaction.Transaction) (*common.TransactionCheckApiResponse, error) {
+	if stub.CheckTransactionCalled != nil {
+		return stub.CheckTransactionCalled(tx)
+	}
+
+	return &common.TransactionCheckApiResponse{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *APITransactionCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
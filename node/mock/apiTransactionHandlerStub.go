@@ -12,6 +12,7 @@ type TransactionAPIHandlerStub struct {
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionStatusCalled                  func(hash string) (*common.TransactionStatusApiResponse, error)
 	UnmarshalTransactionCalled                  func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	UnmarshalReceiptCalled                      func(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	PopulateComputedFieldsCalled                func(tx *transaction.ApiTransactionResult)
@@ -62,6 +63,15 @@ func (tas *TransactionAPIHandlerStub) GetTransactionsPoolNonceGapsForSender(send
 	return nil, nil
 }
 
+// GetTransactionStatus -
+func (tas *TransactionAPIHandlerStub) GetTransactionStatus(hash string) (*common.TransactionStatusApiResponse, error) {
+	if tas.GetTransactionStatusCalled != nil {
+		return tas.GetTransactionStatusCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // UnmarshalTransaction -
 func (tas *TransactionAPIHandlerStub) UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error) {
 	if tas.UnmarshalTransactionCalled != nil {
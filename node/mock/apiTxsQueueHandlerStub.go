@@ -0,0 +1,44 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// APITxsQueueHandlerStub -
+type APITxsQueueHandlerStub struct {
+	EnqueueTransactionCalled        func(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error)
+	GetTransactionsQueueStateCalled func() *common.TransactionsQueueStateApiResponse
+	CloseCalled                     func() error
+}
+
+// EnqueueTransaction -
+func (stub *APITxsQueueHandlerStub) EnqueueTransaction(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error) {
+	if stub.EnqueueTransactionCalled != nil {
+		return stub.EnqueueTransactionCalled(request)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsQueueState -
+func (stub *APITxsQueueHandlerStub) GetTransactionsQueueState() *common.TransactionsQueueStateApiResponse {
+	if stub.GetTransactionsQueueStateCalled != nil {
+		return stub.GetTransactionsQueueStateCalled()
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *APITxsQueueHandlerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *APITxsQueueHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -8,7 +8,11 @@ import (
 // TransactionCostEstimatorMock  -
 type TransactionCostEstimatorMock struct {
 	ComputeTransactionGasLimitCalled   func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecutionCalled func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecutionCalled func(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundleCalled   func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionExecutionCalled    func(tx *transaction.Transaction) (*txSimData.TransactionTrace, error)
+	TraceExecutedTransactionCalled     func(txHash []byte, blockHash []byte) (*txSimData.TransactionTrace, error)
+	ReplayExecutedTransactionCalled    func(txHash []byte, blockHash []byte) (*txSimData.ReplayResults, error)
 }
 
 // ComputeTransactionGasLimit -
@@ -20,14 +24,50 @@ func (tcem *TransactionCostEstimatorMock) ComputeTransactionGasLimit(tx *transac
 }
 
 // SimulateTransactionExecution -
-func (tcem *TransactionCostEstimatorMock) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (tcem *TransactionCostEstimatorMock) SimulateTransactionExecution(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if tcem.SimulateTransactionExecutionCalled != nil {
-		return tcem.SimulateTransactionExecutionCalled(tx)
+		return tcem.SimulateTransactionExecutionCalled(tx, withStateDiff)
 	}
 
 	return &txSimData.SimulationResultsWithVMOutput{}, nil
 }
 
+// SimulateTransactionsBundle -
+func (tcem *TransactionCostEstimatorMock) SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if tcem.SimulateTransactionsBundleCalled != nil {
+		return tcem.SimulateTransactionsBundleCalled(txs)
+	}
+
+	return &txSimData.BundleSimulationResults{}, nil
+}
+
+// TraceTransactionExecution -
+func (tcem *TransactionCostEstimatorMock) TraceTransactionExecution(tx *transaction.Transaction) (*txSimData.TransactionTrace, error) {
+	if tcem.TraceTransactionExecutionCalled != nil {
+		return tcem.TraceTransactionExecutionCalled(tx)
+	}
+
+	return &txSimData.TransactionTrace{}, nil
+}
+
+// TraceExecutedTransaction -
+func (tcem *TransactionCostEstimatorMock) TraceExecutedTransaction(txHash []byte, blockHash []byte) (*txSimData.TransactionTrace, error) {
+	if tcem.TraceExecutedTransactionCalled != nil {
+		return tcem.TraceExecutedTransactionCalled(txHash, blockHash)
+	}
+
+	return &txSimData.TransactionTrace{}, nil
+}
+
+// ReplayExecutedTransaction -
+func (tcem *TransactionCostEstimatorMock) ReplayExecutedTransaction(txHash []byte, blockHash []byte) (*txSimData.ReplayResults, error) {
+	if tcem.ReplayExecutedTransactionCalled != nil {
+		return tcem.ReplayExecutedTransactionCalled(txHash, blockHash)
+	}
+
+	return &txSimData.ReplayResults{}, nil
+}
+
 // IsInterfaceNil -
 func (tcem *TransactionCostEstimatorMock) IsInterfaceNil() bool {
 	return tcem == nil
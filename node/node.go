@@ -14,6 +14,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/keyValStorage"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/block"
@@ -26,6 +27,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/errChan"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/facade"
 	mainFactory "github.com/multiversx/mx-chain-go/factory"
@@ -38,6 +40,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	procTx "github.com/multiversx/mx-chain-go/process/transaction"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
 	"github.com/multiversx/mx-chain-go/trie"
 	"github.com/multiversx/mx-chain-go/vm"
 	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts"
@@ -106,6 +109,7 @@ type Node struct {
 	closableComponents        []mainFactory.Closer
 	enableSignTxWithHashEpoch uint32
 	isInImportMode            bool
+	accountsDiffsHandler      AccountsDiffsHandler
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -571,6 +575,68 @@ func (n *Node) GetTokenSupply(token string) (*api.ESDTSupply, error) {
 	}, nil
 }
 
+// QueryEvents returns a page of the indexed events matching the provided filters, along with the cursor of the next
+// page. If the upper nonce is not provided, the nonce of the current block is used
+func (n *Node) QueryEvents(options common.EventsQueryOptions) (*common.EventsQueryAPIResponse, error) {
+	var addressBytes []byte
+	if len(options.Address) > 0 {
+		var err error
+		addressBytes, err = n.coreComponents.AddressPubKeyConverter().Decode(options.Address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	query := eventsIndex.EventsQuery{
+		Address:    addressBytes,
+		Identifier: options.Identifier,
+		Topics:     options.Topics,
+		FromNonce:  options.FromNonce,
+		ToNonce:    options.ToNonce.Value,
+		After:      options.After,
+		Limit:      options.Limit,
+	}
+	if !options.ToNonce.HasValue {
+		query.ToNonce = n.getCurrentBlockNonce()
+	}
+
+	indexedEvents, nextCursor, err := n.processComponents.HistoryRepository().QueryEvents(query)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeyConverter := n.coreComponents.AddressPubKeyConverter()
+	events := make([]*common.IndexedEventAPIResponse, 0, len(indexedEvents))
+	for _, indexedEvent := range indexedEvents {
+		events = append(events, &common.IndexedEventAPIResponse{
+			TxHash:         hex.EncodeToString(indexedEvent.TxHash),
+			LogAddress:     pubKeyConverter.SilentEncode(indexedEvent.LogAddress, log),
+			BlockNonce:     indexedEvent.BlockNonce,
+			Epoch:          indexedEvent.Epoch,
+			EventIndex:     indexedEvent.EventIndex,
+			Address:        pubKeyConverter.SilentEncode(indexedEvent.Event.Address, log),
+			Identifier:     string(indexedEvent.Event.Identifier),
+			Topics:         indexedEvent.Event.Topics,
+			Data:           indexedEvent.Event.Data,
+			AdditionalData: indexedEvent.Event.AdditionalData,
+		})
+	}
+
+	return &common.EventsQueryAPIResponse{
+		Events:     events,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (n *Node) getCurrentBlockNonce() uint64 {
+	currentHeader := n.dataComponents.Blockchain().GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return 0
+	}
+
+	return currentHeader.GetNonce()
+}
+
 func bigToString(bigValue *big.Int) string {
 	if bigValue == nil {
 		return "0"
@@ -991,7 +1057,15 @@ func extractBlockInfoIfNewAccount(err error) (api.BlockInfo, bool) {
 }
 
 func (n *Node) getAccountInfo(address string, options api.AccountQueryOptions) (accountInfo, error) {
+	if n.shouldLoadAccountFromDiffs(options) {
+		return n.getAccountInfoFromDiffs(address, options)
+	}
+
 	account, blockInfo, err := n.loadUserAccountHandlerByAddress(address, options)
+	return n.createAccountInfo(address, account, blockInfo, err)
+}
+
+func (n *Node) createAccountInfo(address string, account state.UserAccountHandler, blockInfo api.BlockInfo, err error) (accountInfo, error) {
 	if err != nil {
 		adaptedBlockInfo, isEmptyAccount := extractBlockInfoIfNewAccount(err)
 		if isEmptyAccount {
@@ -1442,6 +1516,368 @@ func (n *Node) VerifyProof(rootHash string, address string, proof [][]byte) (boo
 	return mpv.VerifyProof(rootHashBytes, key, proof)
 }
 
+// GetMultiProof returns a single Merkle proof for all the given addresses and root hash
+func (n *Node) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := n.getKeysBytes(addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHashBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, values, err := tr.GetMultiProof(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.GetMultiProofResponse{
+		Proof:    proof,
+		Values:   values,
+		RootHash: rootHash,
+	}, nil
+}
+
+// VerifyMultiProof verifies the given Merkle proof for all the given addresses, and that the accounts hold the given
+// hex encoded values, in the same order as the addresses
+func (n *Node) VerifyMultiProof(rootHash string, addresses []string, values []string, proof [][]byte) (bool, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return false, err
+	}
+
+	keys, err := n.getKeysBytes(addresses)
+	if err != nil {
+		return false, err
+	}
+
+	valuesBytes, err := decodeHexSlice(values)
+	if err != nil {
+		return false, err
+	}
+
+	mpv, err := trie.NewMerkleProofVerifier(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher())
+	if err != nil {
+		return false, err
+	}
+
+	return mpv.VerifyMultiProof(rootHashBytes, keys, valuesBytes, proof)
+}
+
+// GetRangeProofDataTrie returns the Merkle proof for the given address, and a Merkle range proof for the keys
+// of its data trie between startKey and endKey. The keys are hex encoded trie keys. The returned values are decoded,
+// without the key and the address saved along with them. The leaves migrated to the auto balanced version are saved
+// under hashed keys, so the range proof fails if it touches any of them
+func (n *Node) GetRangeProofDataTrie(
+	rootHash string,
+	address string,
+	startKey string,
+	endKey string,
+	maxLeaves int,
+) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	rootHashBytes, addressBytes, err := n.getRootHashAndAddressAsBytes(rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startKeyBytes, endKeyBytes, err := decodeRangeBounds(startKey, endKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mainProofResponse, err := n.getProof(rootHashBytes, addressBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userAccount, err := n.getUserAccountFromBytes(addressBytes, mainProofResponse.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataTrieRootHash := userAccount.GetRootHash()
+	if len(dataTrieRootHash) == 0 {
+		return nil, nil, fmt.Errorf("empty dataTrie rootHash")
+	}
+
+	dataTrie, err := n.stateComponents.AccountsAdapterAPI().GetTrie(dataTrieRootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rangeProof, err := dataTrie.GetRangeProof(startKeyBytes, endKeyBytes, maxLeaves)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i, key := range rangeProof.Keys {
+		rangeProof.Values[i], err = decodeDataTrieValue(key, rangeProof.Values[i], addressBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return mainProofResponse, &common.GetRangeProofResponse{
+		TrieRangeProof: rangeProof,
+		RootHash:       hex.EncodeToString(dataTrieRootHash),
+	}, nil
+}
+
+// VerifyRangeProof verifies the given Merkle range proof and returns the leaves between startKey and endKey. If the
+// address is provided, the root hash is the root hash of the data trie of the address and the values of the returned
+// leaves are decoded, the same way GetRangeProofDataTrie does
+func (n *Node) VerifyRangeProof(rootHash string, address string, startKey string, endKey string, proof [][]byte) (bool, []core.KeyValueHolder, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return false, nil, err
+	}
+
+	startKeyBytes, endKeyBytes, err := decodeRangeBounds(startKey, endKey)
+	if err != nil {
+		return false, nil, err
+	}
+
+	mpv, err := trie.NewMerkleProofVerifier(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher())
+	if err != nil {
+		return false, nil, err
+	}
+
+	ok, leaves, err := mpv.VerifyRangeProof(rootHashBytes, startKeyBytes, endKeyBytes, proof)
+	if err != nil || !ok || len(address) == 0 {
+		return ok, leaves, err
+	}
+
+	addressBytes, err := n.DecodeAddressPubkey(address)
+	if err != nil {
+		return false, nil, err
+	}
+
+	decodedLeaves := make([]core.KeyValueHolder, 0, len(leaves))
+	for _, leaf := range leaves {
+		value, errDecode := decodeDataTrieValue(leaf.Key(), leaf.Value(), addressBytes)
+		if errDecode != nil {
+			return false, nil, errDecode
+		}
+
+		decodedLeaves = append(decodedLeaves, keyValStorage.NewKeyValStorage(leaf.Key(), value))
+	}
+
+	return true, decodedLeaves, nil
+}
+
+// decodeDataTrieValue removes the key and the address saved along with the value in a data trie leaf that was not
+// migrated to the auto balanced version
+func decodeDataTrieValue(key []byte, value []byte, address []byte) ([]byte, error) {
+	suffix := append(append(make([]byte, 0, len(key)+len(address)), key...), address...)
+	if !bytes.HasSuffix(value, suffix) {
+		return nil, core.ErrSuffixNotPresentOrInIncorrectPosition
+	}
+
+	return value[:len(value)-len(suffix)], nil
+}
+
+func decodeHexSlice(values []string) ([][]byte, error) {
+	decodedValues := make([][]byte, 0, len(values))
+	for _, value := range values {
+		decodedValue, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, err
+		}
+
+		decodedValues = append(decodedValues, decodedValue)
+	}
+
+	return decodedValues, nil
+}
+
+func decodeRangeBounds(startKey string, endKey string) ([]byte, []byte, error) {
+	startKeyBytes, err := hex.DecodeString(startKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	endKeyBytes, err := hex.DecodeString(endKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return startKeyBytes, endKeyBytes, nil
+}
+
+// GetAbsenceProof returns the Merkle proof that the given address is not present in the trie with the given root hash
+func (n *Node) GetAbsenceProof(rootHash string, address string) (*common.GetProofResponse, error) {
+	rootHashBytes, addressBytes, err := n.getRootHashAndAddressAsBytes(rootHash, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getAbsenceProof(rootHashBytes, [][]byte{addressBytes})
+}
+
+// GetAbsenceProofDataTrie returns the Merkle proof for the given address, and a Merkle proof that the given key
+// is not present in the data trie of the address
+func (n *Node) GetAbsenceProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+	rootHashBytes, addressBytes, err := n.getRootHashAndAddressAsBytes(rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mainProofResponse, err := n.getProof(rootHashBytes, addressBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userAccount, err := n.getUserAccountFromBytes(addressBytes, mainProofResponse.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataTrieRootHash := userAccount.GetRootHash()
+	if len(dataTrieRootHash) == 0 {
+		return mainProofResponse, &common.GetProofResponse{Proof: make([][]byte, 0)}, nil
+	}
+
+	dataTrieProofResponse, err := n.getAbsenceProof(dataTrieRootHash, n.getDataTrieKeys(keyBytes))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mainProofResponse, dataTrieProofResponse, nil
+}
+
+// VerifyAbsenceProof verifies that the given Merkle proof shows that the address is not present in the trie
+func (n *Node) VerifyAbsenceProof(rootHash string, address string, proof [][]byte) (bool, error) {
+	rootHashBytes, addressBytes, err := n.getRootHashAndAddressAsBytes(rootHash, address)
+	if err != nil {
+		return false, err
+	}
+
+	return n.verifyAbsenceProof(rootHashBytes, [][]byte{addressBytes}, proof)
+}
+
+// VerifyAbsenceProofDataTrie verifies that the given Merkle proof shows that the key is not present in the data trie
+// with the given root hash. The data trie root hash is taken as it is: the result proves nothing about the on-chain
+// state unless the root hash is also checked against the account proof returned by GetAbsenceProofDataTrie, verified
+// against a trusted main trie root hash
+func (n *Node) VerifyAbsenceProofDataTrie(dataTrieRootHash string, key string, proof [][]byte) (bool, error) {
+	rootHashBytes, err := hex.DecodeString(dataTrieRootHash)
+	if err != nil {
+		return false, err
+	}
+
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return false, err
+	}
+
+	return n.verifyAbsenceProof(rootHashBytes, n.getDataTrieKeys(keyBytes), proof)
+}
+
+// getDataTrieKeys returns the keys under which the given key might be saved in a data trie, as the migrated
+// data tries save the hashed keys
+func (n *Node) getDataTrieKeys(key []byte) [][]byte {
+	return [][]byte{n.coreComponents.Hasher().Compute(string(key)), key}
+}
+
+func (n *Node) getAbsenceProof(rootHash []byte, keys [][]byte) (*common.GetProofResponse, error) {
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := make([][]byte, 0)
+	addedNodes := make(map[string]struct{})
+	for _, key := range keys {
+		keyProof, errGet := tr.GetAbsenceProof(key)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		for _, encodedNode := range keyProof {
+			_, isAdded := addedNodes[string(encodedNode)]
+			if isAdded {
+				continue
+			}
+
+			addedNodes[string(encodedNode)] = struct{}{}
+			proof = append(proof, encodedNode)
+		}
+	}
+
+	return &common.GetProofResponse{
+		Proof:    proof,
+		RootHash: hex.EncodeToString(rootHash),
+	}, nil
+}
+
+func (n *Node) verifyAbsenceProof(rootHash []byte, keys [][]byte, proof [][]byte) (bool, error) {
+	mpv, err := trie.NewMerkleProofVerifier(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher())
+	if err != nil {
+		return false, err
+	}
+
+	for _, key := range keys {
+		isAbsent, errVerify := mpv.VerifyAbsenceProof(rootHash, key, proof)
+		if errVerify != nil || !isAbsent {
+			return false, errVerify
+		}
+	}
+
+	return true, nil
+}
+
+// GetTrieStorageReport walks the state trie with the given root hash, together with the data tries of its accounts,
+// and returns the statistics of the storage used by them. The walk stops with ErrTrieOperationsTimeout once the
+// provided context is done
+func (n *Node) GetTrieStorageReport(rootHash string, numLargestDataTries int, ctx context.Context) (*common.TrieStorageReport, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHashBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	accountRootHashParser, err := accounts.NewAccountRootHashParser(n.coreComponents.InternalMarshalizer())
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := trie.AnalyzeStorage(trie.ArgsStorageAnalysis{
+		Ctx:                   ctx,
+		RootHash:              rootHashBytes,
+		DB:                    tr.GetStorageManager(),
+		Marshaller:            n.coreComponents.InternalMarshalizer(),
+		Hasher:                n.coreComponents.Hasher(),
+		AccountRootHashParser: accountRootHashParser,
+		NumLargestDataTries:   numLargestDataTries,
+	})
+	if errors.Is(err, core.ErrContextClosing) {
+		return nil, ErrTrieOperationsTimeout
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return report.ToAPIReport(rootHashBytes, func(address []byte) string {
+		return n.coreComponents.AddressPubKeyConverter().SilentEncode(address, log)
+	}), nil
+}
+
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
 func (n *Node) IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error) {
 	accountHandler, _, err := n.loadUserAccountHandlerByAddress(address, options)
@@ -1472,16 +1908,11 @@ func (n *Node) getRootHashAndAddressAsBytes(rootHash string, address string) ([]
 }
 
 func (n *Node) getAccountRootHashAndVal(address []byte, accBytes []byte, key []byte) ([]byte, []byte, error) {
-	account, err := n.stateComponents.AccountsAdapterAPI().GetAccountFromBytes(address, accBytes)
+	userAccount, err := n.getUserAccountFromBytes(address, accBytes)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	userAccount, ok := account.(state.UserAccountHandler)
-	if !ok {
-		return nil, nil, fmt.Errorf("the address does not belong to a user account")
-	}
-
 	dataTrieRootHash := userAccount.GetRootHash()
 	if len(dataTrieRootHash) == 0 {
 		return nil, nil, fmt.Errorf("empty dataTrie rootHash")
@@ -1495,6 +1926,20 @@ func (n *Node) getAccountRootHashAndVal(address []byte, accBytes []byte, key []b
 	return dataTrieRootHash, retrievedVal, nil
 }
 
+func (n *Node) getUserAccountFromBytes(address []byte, accBytes []byte) (state.UserAccountHandler, error) {
+	account, err := n.stateComponents.AccountsAdapterAPI().GetAccountFromBytes(address, accBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, fmt.Errorf("the address does not belong to a user account")
+	}
+
+	return userAccount, nil
+}
+
 func (n *Node) getProof(rootHash []byte, key []byte) (*common.GetProofResponse, error) {
 	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHash)
 	if err != nil {
@@ -1513,6 +1958,20 @@ func (n *Node) getProof(rootHash []byte, key []byte) (*common.GetProofResponse,
 	}, nil
 }
 
+func (n *Node) getKeysBytes(keys []string) ([][]byte, error) {
+	keysBytes := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		keyBytes, err := n.getKeyBytes(key)
+		if err != nil {
+			return nil, err
+		}
+
+		keysBytes = append(keysBytes, keyBytes)
+	}
+
+	return keysBytes, nil
+}
+
 func (n *Node) getKeyBytes(key string) ([]byte, error) {
 	addressBytes, err := n.DecodeAddressPubkey(key)
 	if err == nil {
@@ -0,0 +1,114 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/dblookupext/accountsDiffs"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// the data trie of an account can not be rebuilt from the diffs, so the queries requesting the keys use the trie of
+// the requested block
+func (n *Node) shouldLoadAccountFromDiffs(options api.AccountQueryOptions) bool {
+	if check.IfNil(n.accountsDiffsHandler) || options.WithKeys {
+		return false
+	}
+
+	return options.BlockNonce.HasValue || len(options.BlockHash) > 0
+}
+
+// getAccountInfoFromDiffs rebuilds the state of the account at the requested block from the most recent accounts diff
+// which altered it or, if it was not altered since the start of the block's epoch, from the retained epoch start root
+func (n *Node) getAccountInfoFromDiffs(address string, options api.AccountQueryOptions) (accountInfo, error) {
+	pubKey, err := n.decodeAddressToPubKey(address)
+	if err != nil {
+		return accountInfo{}, err
+	}
+
+	options, err = n.addBlockCoordinatesToAccountQueryOptions(options)
+	if err != nil {
+		return accountInfo{}, err
+	}
+
+	accountDiff, retainedRoot, err := n.accountsDiffsHandler.GetAccountAtBlock(pubKey, options.BlockNonce.Value, options.HintEpoch.Value)
+	if errors.Is(err, accountsDiffs.ErrNoRetainedRoot) || errors.Is(err, accountsDiffs.ErrUnusableAccountsDiffs) {
+		// the diffs were not recorded from the start of the epoch or are incomplete, but the trie of the block might
+		// still be available
+		log.Debug("getAccountInfoFromDiffs: loading the account from the block's trie", "reason", err)
+		account, blockInfo, errLoad := n.loadUserAccountHandlerByPubKey(pubKey, options)
+		return n.createAccountInfo(address, account, blockInfo, errLoad)
+	}
+	if err != nil {
+		return accountInfo{}, err
+	}
+
+	blockInfo := api.BlockInfo{
+		Nonce:    options.BlockNonce.Value,
+		Hash:     hex.EncodeToString(options.BlockHash),
+		RootHash: hex.EncodeToString(options.BlockRootHash),
+	}
+	if accountDiff != nil {
+		return accountInfo{
+			accountResponse: accountDiffToAccountResponse(address, accountDiff),
+			block:           blockInfo,
+		}, nil
+	}
+
+	account, err := n.loadUserAccountAtRetainedRoot(pubKey, retainedRoot, options.HintEpoch)
+	accInfo, err := n.createAccountInfo(address, account, blockInfo, err)
+	if err != nil {
+		return accountInfo{}, err
+	}
+
+	accInfo.block = blockInfo
+
+	return accInfo, nil
+}
+
+// the block coordinates are not resolved again, as the hint epoch would be lost for a query by root hash
+func (n *Node) loadUserAccountAtRetainedRoot(
+	pubKey []byte,
+	retainedRoot *accountsDiffs.RetainedRoot,
+	hintEpoch core.OptionalUint32,
+) (state.UserAccountHandler, error) {
+	retainedRootOptions := api.AccountQueryOptions{
+		BlockRootHash: retainedRoot.RootHash,
+		HintEpoch:     hintEpoch,
+	}
+	account, _, err := n.stateComponents.AccountsRepository().GetAccountWithBlockInfo(pubKey, retainedRootOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.castAccountToUserAccount(account)
+}
+
+func accountDiffToAccountResponse(address string, accountDiff *alteredAccount.AlteredAccount) api.AccountResponse {
+	response := api.AccountResponse{
+		Address:         address,
+		Nonce:           accountDiff.Nonce,
+		Balance:         accountDiff.Balance,
+		DeveloperReward: "0",
+	}
+
+	additionalData := accountDiff.AdditionalData
+	if additionalData == nil {
+		return response
+	}
+
+	response.Username = additionalData.UserName
+	response.CodeHash = additionalData.CodeHash
+	response.RootHash = additionalData.RootHash
+	response.CodeMetadata = additionalData.CodeMetadata
+	response.OwnerAddress = additionalData.CurrentOwner
+	if len(additionalData.DeveloperRewards) > 0 {
+		response.DeveloperReward = additionalData.DeveloperRewards
+	}
+
+	return response
+}
@@ -0,0 +1,241 @@
+package node_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/dblookupext/accountsDiffs"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/dblookupext"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	mockState "github.com/multiversx/mx-chain-go/testscommon/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type accountsDiffsHandlerStub struct {
+	getAccountAtBlockCalled func(address []byte, blockNonce uint64, epoch uint32) (*alteredAccount.AlteredAccount, *accountsDiffs.RetainedRoot, error)
+}
+
+func (stub *accountsDiffsHandlerStub) GetAccountAtBlock(address []byte, blockNonce uint64, epoch uint32) (*alteredAccount.AlteredAccount, *accountsDiffs.RetainedRoot, error) {
+	return stub.getAccountAtBlockCalled(address, blockNonce, epoch)
+}
+
+func (stub *accountsDiffsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createNodeWithAccountsDiffs(
+	t *testing.T,
+	accountsDiffsHandler node.AccountsDiffsHandler,
+	accountsRepository *mockState.AccountsRepositoryStub,
+) *node.Node {
+	coreComponents := getDefaultCoreComponents()
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsRepo = accountsRepository
+	dataComponents := getDefaultDataComponents()
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+			return 7, nil
+		},
+	}
+	processComponents.ScheduledTxsExecutionHandlerInternal = &testscommon.ScheduledTxsExecutionStub{
+		GetScheduledRootHashForHeaderWithEpochCalled: func(headerHash []byte, epoch uint32) ([]byte, error) {
+			return nil, errors.New("missing")
+		},
+	}
+
+	blockHeader := &block.Header{
+		Nonce:    42,
+		Epoch:    7,
+		RootHash: []byte("block root hash"),
+	}
+	blockHeaderBytes, _ := coreComponents.InternalMarshalizer().Marshal(blockHeader)
+	chainStorerMock := genericMocks.NewChainStorerMock(7)
+	_ = chainStorerMock.BlockHeaders.PutInEpoch([]byte("block hash"), blockHeaderBytes, 7)
+	nonceAsStorerKey := coreComponents.Uint64ByteSliceConverter().ToByteSlice(42)
+	_ = chainStorerMock.ShardHdrNonce.PutInEpoch(nonceAsStorerKey, []byte("block hash"), 7)
+	dataComponents.Store = chainStorerMock
+
+	n, err := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithProcessComponents(processComponents),
+		node.WithAccountsDiffsHandler(accountsDiffsHandler),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetAccountFromAccountsDiffs(t *testing.T) {
+	t.Parallel()
+
+	blockNonceOptions := api.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: 42, HasValue: true}}
+	retainedRoot := &accountsDiffs.RetainedRoot{BlockNonce: 40, RootHash: []byte("retained root hash")}
+
+	t.Run("nil accounts diffs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, err := node.NewNode(node.WithAccountsDiffsHandler(nil))
+		assert.Equal(t, "error applying option: "+node.ErrNilAccountsDiffsHandler.Error(), err.Error())
+		assert.Nil(t, n)
+	})
+	t.Run("account altered after the retained root should be returned from the diffs", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &accountsDiffsHandlerStub{
+			getAccountAtBlockCalled: func(address []byte, blockNonce uint64, epoch uint32) (*alteredAccount.AlteredAccount, *accountsDiffs.RetainedRoot, error) {
+				assert.Equal(t, testscommon.TestPubKeyAlice, address)
+				assert.Equal(t, uint64(42), blockNonce)
+				assert.Equal(t, uint32(7), epoch)
+
+				return &alteredAccount.AlteredAccount{
+					Nonce:   3,
+					Balance: "1000",
+					AdditionalData: &alteredAccount.AdditionalAccountData{
+						UserName:         "alice.elrond",
+						DeveloperRewards: "5",
+					},
+				}, retainedRoot, nil
+			},
+		}
+		repository := &mockState.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				assert.Fail(t, "should have not loaded the account from the trie")
+				return nil, nil, nil
+			},
+		}
+		n := createNodeWithAccountsDiffs(t, handler, repository)
+
+		account, blockInfo, err := n.GetAccount(testscommon.TestAddressAlice, blockNonceOptions)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(3), account.Nonce)
+		assert.Equal(t, "1000", account.Balance)
+		assert.Equal(t, "alice.elrond", account.Username)
+		assert.Equal(t, "5", account.DeveloperReward)
+		assert.Equal(t, api.BlockInfo{Nonce: 42, Hash: "626c6f636b2068617368", RootHash: "626c6f636b20726f6f742068617368"}, blockInfo)
+	})
+	t.Run("account not altered after the retained root should be loaded from the retained root", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &accountsDiffsHandlerStub{
+			getAccountAtBlockCalled: func(address []byte, blockNonce uint64, epoch uint32) (*alteredAccount.AlteredAccount, *accountsDiffs.RetainedRoot, error) {
+				return nil, retainedRoot, nil
+			},
+		}
+		alice := createAcc(testscommon.TestPubKeyAlice)
+		_ = alice.AddToBalance(big.NewInt(100))
+		repository := &mockState.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				assert.Equal(t, retainedRoot.RootHash, options.BlockRootHash)
+				assert.Equal(t, core.OptionalUint32{Value: 7, HasValue: true}, options.HintEpoch)
+
+				return alice, holders.NewBlockInfo(nil, 0, retainedRoot.RootHash), nil
+			},
+		}
+		n := createNodeWithAccountsDiffs(t, handler, repository)
+
+		account, blockInfo, err := n.GetAccount(testscommon.TestAddressAlice, blockNonceOptions)
+		require.Nil(t, err)
+		assert.Equal(t, "100", account.Balance)
+		assert.Equal(t, uint64(42), blockInfo.Nonce)
+		assert.Equal(t, "626c6f636b20726f6f742068617368", blockInfo.RootHash)
+	})
+	t.Run("account missing at the retained root should return empty account", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &accountsDiffsHandlerStub{
+			getAccountAtBlockCalled: func(address []byte, blockNonce uint64, epoch uint32) (*alteredAccount.AlteredAccount, *accountsDiffs.RetainedRoot, error) {
+				return nil, retainedRoot, nil
+			},
+		}
+		repository := &mockState.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				return nil, nil, state.NewErrAccountNotFoundAtBlock(holders.NewBlockInfo(nil, 0, retainedRoot.RootHash))
+			},
+		}
+		n := createNodeWithAccountsDiffs(t, handler, repository)
+
+		account, blockInfo, err := n.GetAccount(testscommon.TestAddressAlice, blockNonceOptions)
+		require.Nil(t, err)
+		assert.Equal(t, "0", account.Balance)
+		assert.Equal(t, uint64(42), blockInfo.Nonce)
+	})
+	t.Run("no retained root should load the account from the block's trie", testLoadAccountFromBlockTrie(accountsDiffs.ErrNoRetainedRoot))
+	t.Run("unusable accounts diffs should load the account from the block's trie", testLoadAccountFromBlockTrie(accountsDiffs.ErrUnusableAccountsDiffs))
+	t.Run("missing accounts diff should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &accountsDiffsHandlerStub{
+			getAccountAtBlockCalled: func(address []byte, blockNonce uint64, epoch uint32) (*alteredAccount.AlteredAccount, *accountsDiffs.RetainedRoot, error) {
+				return nil, nil, accountsDiffs.ErrMissingAccountsDiff
+			},
+		}
+		n := createNodeWithAccountsDiffs(t, handler, &mockState.AccountsRepositoryStub{})
+
+		_, _, err := n.GetAccount(testscommon.TestAddressAlice, blockNonceOptions)
+		assert.Equal(t, accountsDiffs.ErrMissingAccountsDiff, err)
+	})
+	t.Run("query without block coordinates should not use the diffs", func(t *testing.T) {
+		t.Parallel()
+
+		handler := &accountsDiffsHandlerStub{
+			getAccountAtBlockCalled: func(address []byte, blockNonce uint64, epoch uint32) (*alteredAccount.AlteredAccount, *accountsDiffs.RetainedRoot, error) {
+				assert.Fail(t, "should have not used the diffs")
+				return nil, nil, nil
+			},
+		}
+		alice := createAcc(testscommon.TestPubKeyAlice)
+		repository := &mockState.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				return alice, holders.NewBlockInfo(nil, 43, nil), nil
+			},
+		}
+		n := createNodeWithAccountsDiffs(t, handler, repository)
+
+		_, blockInfo, err := n.GetAccount(testscommon.TestAddressAlice, api.AccountQueryOptions{})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(43), blockInfo.Nonce)
+	})
+}
+
+func testLoadAccountFromBlockTrie(getAccountAtBlockErr error) func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		handler := &accountsDiffsHandlerStub{
+			getAccountAtBlockCalled: func(address []byte, blockNonce uint64, epoch uint32) (*alteredAccount.AlteredAccount, *accountsDiffs.RetainedRoot, error) {
+				return nil, nil, getAccountAtBlockErr
+			},
+		}
+		alice := createAcc(testscommon.TestPubKeyAlice)
+		_ = alice.AddToBalance(big.NewInt(7))
+		repository := &mockState.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				assert.Equal(t, []byte("block root hash"), options.BlockRootHash)
+				return alice, holders.NewBlockInfo([]byte("block hash"), 42, options.BlockRootHash), nil
+			},
+		}
+		n := createNodeWithAccountsDiffs(t, handler, repository)
+
+		account, _, err := n.GetAccount(testscommon.TestAddressAlice, api.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: 42, HasValue: true}})
+		require.Nil(t, err)
+		assert.Equal(t, "7", account.Balance)
+	}
+}
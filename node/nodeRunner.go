@@ -1,6 +1,8 @@
 package node
 
 import (
+	"bufio"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"math/big"
@@ -22,6 +24,7 @@ import (
 	outportCore "github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-go/api/gin"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/api/subscriptions"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/disabled"
 	"github.com/multiversx/mx-chain-go/common/forking"
@@ -31,6 +34,7 @@ import (
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dblookupext/accountsDiffs"
 	dbLookupFactory "github.com/multiversx/mx-chain-go/dblookupext/factory"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/facade/initial"
@@ -55,10 +59,12 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/state/snapshotArchive"
 	"github.com/multiversx/mx-chain-go/state/syncer"
 	"github.com/multiversx/mx-chain-go/storage/cache"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/trie"
 	trieStatistics "github.com/multiversx/mx-chain-go/trie/statistics"
 	"github.com/multiversx/mx-chain-go/update/trigger"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -314,8 +320,18 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	subscriptionsHub, err := nr.createSubscriptionsHub(managedCoreComponents)
+	if err != nil {
+		return true, err
+	}
+
 	log.Debug("creating disabled API services")
-	webServerHandler, err := nr.createHttpServer(managedStatusCoreComponents)
+	webServerHandler, err := nr.createHttpServer(managedStatusCoreComponents, subscriptionsHub)
+	if err != nil {
+		return true, err
+	}
+
+	err = nr.importStateSnapshotIfNeeded(managedCoreComponents)
 	if err != nil {
 		return true, err
 	}
@@ -408,6 +424,24 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	if !check.IfNil(subscriptionsHub) {
+		err = managedStatusComponents.OutportHandler().SubscribeDriver(subscriptionsHub)
+		if err != nil {
+			return true, err
+		}
+	}
+
+	accountsDiffsRecorder, err := nr.createAccountsDiffsRecorder(managedCoreComponents, managedDataComponents)
+	if err != nil {
+		return true, err
+	}
+	if !check.IfNil(accountsDiffsRecorder) {
+		err = managedStatusComponents.OutportHandler().SubscribeDriver(accountsDiffsRecorder)
+		if err != nil {
+			return true, err
+		}
+	}
+
 	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
 		GasScheduleConfig:  configs.EpochConfig.GasSchedule,
 		ConfigDir:          configurationPaths.GasScheduleDirectoryName,
@@ -480,6 +514,12 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	// the journaled transactions are validated against the state loaded from storage by the bootstrapper
+	err = managedProcessComponents.TxPoolJournal().Replay()
+	if err != nil {
+		log.Warn("cannot replay the transactions pool journal", "error", err)
+	}
+
 	managedHeartbeatV2Components, err := nr.CreateManagedHeartbeatV2Components(
 		managedBootstrapComponents,
 		managedCoreComponents,
@@ -515,6 +555,13 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	if !check.IfNil(accountsDiffsRecorder) {
+		err = currentNode.ApplyOptions(WithAccountsDiffsHandler(accountsDiffsRecorder))
+		if err != nil {
+			return true, err
+		}
+	}
+
 	if managedBootstrapComponents.ShardCoordinator().SelfId() == core.MetachainShardId {
 		log.Debug("activating nodesCoordinator's validators indexing")
 		indexValidatorsListIfNeeded(
@@ -696,6 +743,7 @@ func getBaseAccountSyncerArgs(
 		UserAccountsSyncStatisticsHandler: trieStatistics.NewTrieSyncStatistics(),
 		AppStatusHandler:                  disabled.NewAppStatusHandler(),
 		EnableEpochsHandler:               coreComponents.EnableEpochsHandler(),
+		SyncCheckpointHandler:             trie.NewDisabledSyncCheckpointer(),
 	}
 }
 
@@ -769,7 +817,46 @@ func (nr *nodeRunner) createApiFacade(
 	return ef, nil
 }
 
-func (nr *nodeRunner) createHttpServer(managedStatusCoreComponents mainFactory.StatusCoreComponentsHolder) (shared.UpgradeableHttpServerHandler, error) {
+func (nr *nodeRunner) createSubscriptionsHub(managedCoreComponents mainFactory.CoreComponentsHolder) (SubscriptionsHubHandler, error) {
+	subscriptionsConfig := nr.configs.GeneralConfig.WebSocketSubscriptions
+	if !subscriptionsConfig.Enabled {
+		return nil, nil
+	}
+
+	log.Debug("creating web socket subscriptions hub")
+	return subscriptions.NewSubscriptionsHub(subscriptions.ArgsSubscriptionsHub{
+		Config:          subscriptionsConfig,
+		Marshaller:      managedCoreComponents.InternalMarshalizer(),
+		PubkeyConverter: managedCoreComponents.AddressPubKeyConverter(),
+	})
+}
+
+func (nr *nodeRunner) createAccountsDiffsRecorder(
+	managedCoreComponents mainFactory.CoreComponentsHolder,
+	managedDataComponents mainFactory.DataComponentsHolder,
+) (AccountsDiffsRecorderHandler, error) {
+	dbLookupExtensionsConfig := nr.configs.GeneralConfig.DbLookupExtensions
+	if !dbLookupExtensionsConfig.Enabled || !dbLookupExtensionsConfig.AccountsDiffs.Enabled {
+		return nil, nil
+	}
+
+	storer, err := managedDataComponents.StorageService().GetStorer(dataRetriever.AccountsDiffsUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("creating accounts diffs recorder")
+	return accountsDiffs.NewAccountsDiffsRecorder(accountsDiffs.ArgsAccountsDiffsRecorder{
+		Storer:           storer,
+		Marshaller:       managedCoreComponents.InternalMarshalizer(),
+		AddressConverter: managedCoreComponents.AddressPubKeyConverter(),
+	})
+}
+
+func (nr *nodeRunner) createHttpServer(
+	managedStatusCoreComponents mainFactory.StatusCoreComponentsHolder,
+	subscriptionsHub SubscriptionsHubHandler,
+) (shared.UpgradeableHttpServerHandler, error) {
 	if check.IfNil(managedStatusCoreComponents) {
 		return nil, ErrNilStatusHandler
 	}
@@ -786,9 +873,10 @@ func (nr *nodeRunner) createHttpServer(managedStatusCoreComponents mainFactory.S
 	}
 
 	httpServerArgs := gin.ArgsNewWebServer{
-		Facade:          initialFacade,
-		ApiConfig:       *nr.configs.ApiRoutesConfig,
-		AntiFloodConfig: nr.configs.GeneralConfig.WebServerAntiflood,
+		Facade:           initialFacade,
+		ApiConfig:        *nr.configs.ApiRoutesConfig,
+		AntiFloodConfig:  nr.configs.GeneralConfig.WebServerAntiflood,
+		SubscriptionsHub: subscriptionsHub,
 	}
 
 	httpServerWrapper, err := gin.NewGinWebServerHandler(httpServerArgs)
@@ -1370,6 +1458,64 @@ func (nr *nodeRunner) CreateManagedStateComponents(
 	return managedStateComponents, nil
 }
 
+// importStateSnapshotIfNeeded writes the content of the provided state snapshot archive in the storers of its epoch,
+// so the start in epoch process will find the trie nodes on disk. The archive is only accepted if its epoch start meta
+// block has the provided trusted hash. The archive is imported only once per node run
+func (nr *nodeRunner) importStateSnapshotIfNeeded(coreComponents mainFactory.CoreComponentsHolder) error {
+	flagsConfig := nr.configs.FlagsConfig
+	if len(flagsConfig.ImportStateSnapshotFile) == 0 {
+		return nil
+	}
+
+	log.Info("importing state snapshot", "archive", flagsConfig.ImportStateSnapshotFile)
+	storersCreator, err := snapshotArchive.NewStorersCreator(snapshotArchive.ArgsStorersCreator{
+		GeneralConfig: *nr.configs.GeneralConfig,
+		PathManager:   coreComponents.PathHandler(),
+		NumTrieEpochs: 1,
+	})
+	if err != nil {
+		return err
+	}
+
+	trustedMetaBlockHash, err := hex.DecodeString(flagsConfig.ImportStateSnapshotMetaHash)
+	if err != nil {
+		return err
+	}
+
+	importer, err := snapshotArchive.NewStateImporter(snapshotArchive.ArgsStateImporter{
+		Marshaller:                     coreComponents.InternalMarshalizer(),
+		Hasher:                         coreComponents.Hasher(),
+		StorersCreator:                 storersCreator,
+		TrustedEpochStartMetaBlockHash: trustedMetaBlockHash,
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(flagsConfig.ImportStateSnapshotFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	summary, err := importer.Import(bufio.NewReader(file))
+	if err != nil {
+		return fmt.Errorf("%w while importing the state snapshot %s", err, flagsConfig.ImportStateSnapshotFile)
+	}
+
+	log.Info("imported state snapshot",
+		"shard", summary.Header.ShardID,
+		"epoch", summary.Header.Epoch,
+		"root hash", summary.Header.RootHash,
+		"num chunks", summary.Trailer.NumChunks,
+	)
+	flagsConfig.ImportStateSnapshotFile = ""
+
+	return nil
+}
+
 // CreateManagedBootstrapComponents is the managed bootstrap components factory
 func (nr *nodeRunner) CreateManagedBootstrapComponents(
 	statusCoreComponents mainFactory.StatusCoreComponentsHolder,
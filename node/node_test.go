@@ -34,6 +34,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 	"github.com/multiversx/mx-chain-go/factory"
 	factoryMock "github.com/multiversx/mx-chain-go/factory/mock"
 	heartbeatData "github.com/multiversx/mx-chain-go/heartbeat/data"
@@ -4328,6 +4329,344 @@ func TestNode_VerifyProof(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestNode_GetAndVerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.Hash = sha256.NewSha256()
+	coreComponents.IntMarsh = &marshal.GogoProtoMarshalizer{}
+	proof := [][]byte{[]byte("proof")}
+	values := [][]byte{[]byte("value1"), []byte("value2")}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return &trieMock.TrieStub{
+				GetMultiProofCalled: func(keys [][]byte) ([][]byte, [][]byte, error) {
+					assert.Equal(t, [][]byte{{0x01, 0x23}, {0x45, 0x67}}, keys)
+					return proof, values, nil
+				},
+			}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(coreComponents),
+	)
+
+	response, err := n.GetMultiProof("deadbeef", []string{"0123", "4567"})
+	require.Nil(t, err)
+	assert.Equal(t, proof, response.Proof)
+	assert.Equal(t, values, response.Values)
+	assert.Equal(t, "deadbeef", response.RootHash)
+
+	_, err = n.GetMultiProof("deadbeef", []string{"0123", "address"})
+	assert.NotNil(t, err)
+
+	rootHash := "bc2e549d98c31ffe6e9419b933d03b37e84f74c42601412302799d277651a6d8"
+	address := "bf42213747697e9dec4211ef50ba6061b54729b53ba0c4994948cab478af8854"
+	p, _ := hex.DecodeString("0a41040508080f0a0807040b0a0c080409040909040c000a0b03050b09020704050b010600060a0b00050f0e010102040c0e0d090e07090607040703010202040f0b10124c1202000022206182d14320be95434f5508acad9478d3b6cf837bfce7ebfe47c2e860d1b98ca72a20bf42213747697e9dec4211ef50ba6061b54729b53ba0c4994948cab478af88543202000001")
+	value := "1202000022206182d14320be95434f5508acad9478d3b6cf837bfce7ebfe47c2e860d1b98ca72a20bf42213747697e9dec4211ef50ba6061b54729b53ba0c4994948cab478af885432020000"
+	ok, err := n.VerifyMultiProof(rootHash, []string{address}, []string{value}, [][]byte{p})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = n.VerifyMultiProof(rootHash, []string{address}, []string{"aa"}, [][]byte{p})
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	ok, err = n.VerifyMultiProof(rootHash, []string{address}, []string{"not hex"}, [][]byte{p})
+	assert.NotNil(t, err)
+	assert.False(t, ok)
+}
+
+func TestNode_GetRangeProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid start key should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		mainTrieResponse, rangeProofResponse, err := n.GetRangeProofDataTrie("deadbeef", "0123", "key", "", 10)
+		assert.Nil(t, mainTrieResponse)
+		assert.Nil(t, rangeProofResponse)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		mainTrieProof := [][]byte{[]byte("main"), []byte("proof")}
+		dataTrieRootHash := []byte("dataTrieRoot")
+		rangeProof := &common.TrieRangeProof{
+			Proof:    [][]byte{[]byte("range proof")},
+			Keys:     [][]byte{[]byte("key")},
+			Values:   [][]byte{append([]byte("valuekey"), 0x01, 0x23)},
+			LastKey:  []byte{0xbb},
+			Complete: true,
+		}
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+				if bytes.Equal(rootHash, dataTrieRootHash) {
+					return &trieMock.TrieStub{
+						GetRangeProofCalled: func(startKey []byte, endKey []byte, maxLeaves int) (*common.TrieRangeProof, error) {
+							assert.Equal(t, []byte{0xaa}, startKey)
+							assert.Equal(t, []byte{0xbb}, endKey)
+							assert.Equal(t, 10, maxLeaves)
+							return rangeProof, nil
+						},
+					}, nil
+				}
+
+				return &trieMock.TrieStub{
+					GetProofCalled: func(key []byte) ([][]byte, []byte, error) {
+						return mainTrieProof, []byte("account"), nil
+					},
+				}, nil
+			},
+			GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+				acc := &stateMock.AccountWrapMock{}
+				acc.SetRootHash(dataTrieRootHash)
+				return acc, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		mainTrieResponse, rangeProofResponse, err := n.GetRangeProofDataTrie("deadbeef", "0123", "aa", "bb", 10)
+		require.Nil(t, err)
+		assert.Equal(t, mainTrieProof, mainTrieResponse.Proof)
+		assert.Equal(t, rangeProof, rangeProofResponse.TrieRangeProof)
+		assert.Equal(t, [][]byte{[]byte("value")}, rangeProofResponse.Values)
+		assert.Equal(t, hex.EncodeToString(dataTrieRootHash), rangeProofResponse.RootHash)
+	})
+	t.Run("value without the key and address suffix should error", func(t *testing.T) {
+		t.Parallel()
+
+		dataTrieRootHash := []byte("dataTrieRoot")
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetProofCalled: func(key []byte) ([][]byte, []byte, error) {
+						return [][]byte{[]byte("proof")}, []byte("account"), nil
+					},
+					GetRangeProofCalled: func(startKey []byte, endKey []byte, maxLeaves int) (*common.TrieRangeProof, error) {
+						return &common.TrieRangeProof{
+							Keys:   [][]byte{[]byte("key")},
+							Values: [][]byte{[]byte("value")},
+						}, nil
+					},
+				}, nil
+			},
+			GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+				acc := &stateMock.AccountWrapMock{}
+				acc.SetRootHash(dataTrieRootHash)
+				return acc, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		mainTrieResponse, rangeProofResponse, err := n.GetRangeProofDataTrie("deadbeef", "0123", "aa", "bb", 10)
+		assert.Equal(t, core.ErrSuffixNotPresentOrInIncorrectPosition, err)
+		assert.Nil(t, mainTrieResponse)
+		assert.Nil(t, rangeProofResponse)
+	})
+}
+
+func TestNode_VerifyRangeProofInvalidEndKey(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithStateComponents(getDefaultStateComponents()),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	ok, leaves, err := n.VerifyRangeProof("deadbeef", "", "", "end key", [][]byte{})
+	assert.False(t, ok)
+	assert.Nil(t, leaves)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetAndVerifyAbsenceProof(t *testing.T) {
+	t.Parallel()
+
+	expectedProof := [][]byte{[]byte("root"), []byte("branch")}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return &trieMock.TrieStub{
+				GetAbsenceProofCalled: func(key []byte) ([][]byte, error) {
+					assert.Equal(t, []byte{0x89, 0x01}, key)
+					return expectedProof, nil
+				},
+			}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	response, err := n.GetAbsenceProof("dead", "8901")
+	require.Nil(t, err)
+	assert.Equal(t, expectedProof, response.Proof)
+	assert.Equal(t, "dead", response.RootHash)
+
+	// the proof nodes are not valid encoded trie nodes
+	isAbsent, _ := n.VerifyAbsenceProof("dead", "8901", expectedProof)
+	assert.False(t, isAbsent)
+
+	_, err = n.GetAbsenceProof("dead", "invalid address")
+	assert.NotNil(t, err)
+
+	isAbsent, err = n.VerifyAbsenceProofDataTrie("dead", "invalid key", expectedProof)
+	assert.NotNil(t, err)
+	assert.False(t, isAbsent)
+}
+
+func TestNode_GetAbsenceProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getDefaultCoreComponents()
+	dataTrieRootHash := []byte("dataTrieRoot")
+	mainTrieProof := [][]byte{[]byte("main")}
+	requestedKeys := make([][]byte, 0)
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+			return &trieMock.TrieStub{
+				GetProofCalled: func(key []byte) ([][]byte, []byte, error) {
+					return mainTrieProof, []byte("account"), nil
+				},
+				GetAbsenceProofCalled: func(key []byte) ([][]byte, error) {
+					assert.Equal(t, dataTrieRootHash, rootHash)
+					requestedKeys = append(requestedKeys, key)
+					return [][]byte{[]byte("root"), key}, nil
+				},
+			}, nil
+		},
+		GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+			acc := &stateMock.AccountWrapMock{}
+			acc.SetRootHash(dataTrieRootHash)
+			return acc, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(coreComponents),
+	)
+
+	key := []byte{0x45, 0x67}
+	hashedKey := coreComponents.Hasher().Compute(string(key))
+	mainTrieResponse, dataTrieResponse, err := n.GetAbsenceProofDataTrie("deadbeef", "0123", "4567")
+	require.Nil(t, err)
+	assert.Equal(t, mainTrieProof, mainTrieResponse.Proof)
+	assert.Equal(t, [][]byte{hashedKey, key}, requestedKeys)
+	assert.Equal(t, [][]byte{[]byte("root"), hashedKey, key}, dataTrieResponse.Proof)
+	assert.Equal(t, hex.EncodeToString(dataTrieRootHash), dataTrieResponse.RootHash)
+}
+
+func TestNode_GetTrieStorageReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		report, err := n.GetTrieStorageReport("invalid root hash", 10, context.Background())
+		assert.Nil(t, report)
+		assert.NotNil(t, err)
+	})
+	t.Run("get trie error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return nil, expectedErr
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		report, err := n.GetTrieStorageReport("deadbeef", 10, context.Background())
+		assert.Nil(t, report)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should analyze the trie from the storage manager", func(t *testing.T) {
+		t.Parallel()
+
+		requestedHashes := make([][]byte, 0)
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetStorageManagerCalled: func() common.StorageManager {
+						return &storageManager.StorageManagerStub{
+							GetCalled: func(key []byte) ([]byte, error) {
+								requestedHashes = append(requestedHashes, key)
+								return nil, errors.New("missing node")
+							},
+						}
+					},
+				}, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		report, err := n.GetTrieStorageReport("deadbeef", 10, context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{{0xde, 0xad, 0xbe, 0xef}}, requestedHashes)
+		assert.Equal(t, "deadbeef", report.RootHash)
+		assert.Equal(t, uint64(1), report.NumMissingNodes)
+		assert.Equal(t, uint64(0), report.MainTrie.NumNodes)
+		assert.Empty(t, report.LargestDataTries)
+	})
+	t.Run("done context should error", func(t *testing.T) {
+		t.Parallel()
+
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetStorageManagerCalled: func() common.StorageManager {
+						return &storageManager.StorageManagerStub{}
+					},
+				}, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		report, err := n.GetTrieStorageReport("deadbeef", 10, ctx)
+		assert.Nil(t, report)
+		assert.Equal(t, node.ErrTrieOperationsTimeout, err)
+	})
+}
+
 func TestNode_IsDataTrieMigrated(t *testing.T) {
 	t.Parallel()
 
@@ -4488,6 +4827,110 @@ func TestGetESDTSupply(t *testing.T) {
 	}, supply)
 }
 
+func TestNode_QueryEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithProcessComponents(getDefaultProcessComponents()),
+		)
+
+		events, err := n.QueryEvents(common.EventsQueryOptions{Address: "invalid address"})
+		require.Error(t, err)
+		require.Nil(t, events)
+	})
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		localErr := errors.New("local error")
+		processComponentsMock := getDefaultProcessComponents()
+		processComponentsMock.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+			QueryEventsCalled: func(query eventsIndex.EventsQuery) ([]*eventsIndex.IndexedEvent, *common.EventsQueryCursor, error) {
+				return nil, nil, localErr
+			},
+		}
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithDataComponents(getDefaultDataComponents()),
+			node.WithProcessComponents(processComponentsMock),
+		)
+
+		events, err := n.QueryEvents(common.EventsQueryOptions{Identifier: "ESDTTransfer"})
+		require.Equal(t, localErr, err)
+		require.Nil(t, events)
+	})
+	t.Run("should default the upper nonce to the current block and convert the results", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := getDefaultCoreComponents()
+		address := bytes.Repeat([]byte{1}, 32)
+		bech32Address, _ := coreComponents.AddrPubKeyConv.Encode(address)
+		providedCursor := &common.EventsQueryCursor{BlockNonce: 10, EntryIndex: 3}
+		nextCursor := &common.EventsQueryCursor{BlockNonce: 11, EntryIndex: 0}
+
+		processComponentsMock := getDefaultProcessComponents()
+		processComponentsMock.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+			QueryEventsCalled: func(query eventsIndex.EventsQuery) ([]*eventsIndex.IndexedEvent, *common.EventsQueryCursor, error) {
+				require.Equal(t, address, query.Address)
+				require.Equal(t, "ESDTTransfer", query.Identifier)
+				require.Equal(t, uint64(10), query.FromNonce)
+				require.Equal(t, uint64(42), query.ToNonce)
+				require.Equal(t, providedCursor, query.After)
+				require.Equal(t, uint32(5), query.Limit)
+
+				return []*eventsIndex.IndexedEvent{
+					{
+						TxHash:     []byte("hash"),
+						LogAddress: address,
+						BlockNonce: 11,
+						Epoch:      1,
+						EventIndex: 2,
+						Event: &transaction.Event{
+							Address:    address,
+							Identifier: []byte("ESDTTransfer"),
+							Topics:     [][]byte{[]byte("topic")},
+							Data:       []byte("data"),
+						},
+					},
+				}, nextCursor, nil
+			},
+		}
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponents),
+			node.WithDataComponents(getDefaultDataComponents()),
+			node.WithProcessComponents(processComponentsMock),
+		)
+
+		events, err := n.QueryEvents(common.EventsQueryOptions{
+			Address:    bech32Address,
+			Identifier: "ESDTTransfer",
+			FromNonce:  10,
+			After:      providedCursor,
+			Limit:      5,
+		})
+		require.Nil(t, err)
+		require.Equal(t, nextCursor, events.NextCursor)
+		require.Equal(t, []*common.IndexedEventAPIResponse{
+			{
+				TxHash:     hex.EncodeToString([]byte("hash")),
+				LogAddress: bech32Address,
+				BlockNonce: 11,
+				Epoch:      1,
+				EventIndex: 2,
+				Address:    bech32Address,
+				Identifier: "ESDTTransfer",
+				Topics:     [][]byte{[]byte("topic")},
+				Data:       []byte("data"),
+			},
+		}, events.Events)
+	})
+}
+
 func TestNode_SendBulkTransactions(t *testing.T) {
 	t.Parallel()
 
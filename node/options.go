@@ -324,6 +324,18 @@ func WithImportMode(importMode bool) Option {
 	}
 }
 
+// WithAccountsDiffsHandler sets up the accounts diffs handler used for the historical account queries
+func WithAccountsDiffsHandler(accountsDiffsHandler AccountsDiffsHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(accountsDiffsHandler) {
+			return ErrNilAccountsDiffsHandler
+		}
+
+		n.accountsDiffsHandler = accountsDiffsHandler
+		return nil
+	}
+}
+
 // WithESDTNFTStorageHandler sets the esdt nft storage handler
 func WithESDTNFTStorageHandler(storageHandler vmcommon.ESDTNFTStorageHandler) Option {
 	return func(node *Node) error {
@@ -1226,3 +1226,6 @@ var ErrInvalidAsyncArguments = errors.New("invalid arguments to process async/ca
 
 // ErrNilSentSignatureTracker defines the error for setting a nil SentSignatureTracker
 var ErrNilSentSignatureTracker = errors.New("nil sent signature tracker")
+
+// ErrNilExecutionTracer signals that a nil execution tracer has been provided
+var ErrNilExecutionTracer = errors.New("nil execution tracer")
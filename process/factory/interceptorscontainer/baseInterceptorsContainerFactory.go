@@ -274,6 +274,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneTxInterceptor(topic strin
 	argProcessor := &processor.ArgTxInterceptorProcessor{
 		ShardedDataCache: bicf.dataPool.Transactions(),
 		TxValidator:      txValidator,
+		WhiteListRequest: bicf.whiteListHandler,
 	}
 	txProcessor, err := processor.NewTxInterceptorProcessor(argProcessor)
 	if err != nil {
@@ -317,6 +318,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneUnsignedTxInterceptor(top
 	argProcessor := &processor.ArgTxInterceptorProcessor{
 		ShardedDataCache: bicf.dataPool.UnsignedTransactions(),
 		TxValidator:      dataValidators.NewDisabledTxValidator(),
+		WhiteListRequest: bicf.whiteListHandler,
 	}
 	txProcessor, err := processor.NewTxInterceptorProcessor(argProcessor)
 	if err != nil {
@@ -360,6 +362,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneRewardTxInterceptor(topic
 	argProcessor := &processor.ArgTxInterceptorProcessor{
 		ShardedDataCache: bicf.dataPool.RewardTransactions(),
 		TxValidator:      dataValidators.NewDisabledTxValidator(),
+		WhiteListRequest: bicf.whiteListHandler,
 	}
 	txProcessor, err := processor.NewTxInterceptorProcessor(argProcessor)
 	if err != nil {
@@ -10,4 +10,5 @@ import (
 type ArgTxInterceptorProcessor struct {
 	ShardedDataCache dataRetriever.ShardedDataCacherNotifier
 	TxValidator      process.TxValidator
+	WhiteListRequest process.WhiteListHandler
 }
@@ -20,6 +20,11 @@ type interceptedPeerAuthenticationMessageHandler interface {
 	Pubkey() []byte
 }
 
+// txPoolWithReplacement defines the transactions pool which applies the replace-by-fee policy on the received transactions
+type txPoolWithReplacement interface {
+	AddDataWithReplacement(key []byte, data interface{}, sizeInBytes int, cacheID string)
+}
+
 type interceptedValidatorInfo interface {
 	Hash() []byte
 	ValidatorInfo() *state.ShardValidatorInfo
@@ -13,8 +13,10 @@ var txLog = logger.GetOrCreate("process/interceptors/processor/txlog")
 // TxInterceptorProcessor is the processor used when intercepting transactions
 // (smart contract results, receipts, transaction) structs which satisfy TransactionHandler interface.
 type TxInterceptorProcessor struct {
-	shardedPool process.ShardedPool
-	txValidator process.TxValidator
+	shardedPool      process.ShardedPool
+	replacementPool  txPoolWithReplacement
+	txValidator      process.TxValidator
+	whiteListRequest process.WhiteListHandler
 }
 
 // NewTxInterceptorProcessor creates a new TxInterceptorProcessor instance
@@ -28,10 +30,18 @@ func NewTxInterceptorProcessor(argument *ArgTxInterceptorProcessor) (*TxIntercep
 	if check.IfNil(argument.TxValidator) {
 		return nil, process.ErrNilTxValidator
 	}
+	if check.IfNil(argument.WhiteListRequest) {
+		return nil, process.ErrNilWhiteListHandler
+	}
+
+	// only the pool of the transactions applies the replace-by-fee policy, the other ones just add the data
+	replacementPool, _ := argument.ShardedDataCache.(txPoolWithReplacement)
 
 	return &TxInterceptorProcessor{
-		shardedPool: argument.ShardedDataCache,
-		txValidator: argument.TxValidator,
+		shardedPool:      argument.ShardedDataCache,
+		replacementPool:  replacementPool,
+		txValidator:      argument.TxValidator,
+		whiteListRequest: argument.WhiteListRequest,
 	}, nil
 }
 
@@ -66,6 +76,16 @@ func (txip *TxInterceptorProcessor) Save(data process.InterceptedData, peerOrigi
 
 	txLog.Trace("received transaction", "pid", peerOriginator.Pretty(), "hash", data.Hash())
 	cacherIdentifier := process.ShardCacherIdentifier(interceptedTx.SenderShardId(), interceptedTx.ReceiverShardId())
+	if txip.shouldApplyReplacement(data) {
+		txip.replacementPool.AddDataWithReplacement(
+			data.Hash(),
+			interceptedTx.Transaction(),
+			interceptedTx.Transaction().Size(),
+			cacherIdentifier,
+		)
+		return nil
+	}
+
 	txip.shardedPool.AddData(
 		data.Hash(),
 		interceptedTx.Transaction(),
@@ -76,6 +96,12 @@ func (txip *TxInterceptorProcessor) Save(data process.InterceptedData, peerOrigi
 	return nil
 }
 
+// the requested transactions are needed for processing a block, so they are never subject to replacement, otherwise
+// the block processing would wait for a transaction dropped by the pool
+func (txip *TxInterceptorProcessor) shouldApplyReplacement(data process.InterceptedData) bool {
+	return txip.replacementPool != nil && !txip.whiteListRequest.IsWhiteListed(data)
+}
+
 // RegisterHandler registers a callback function to be notified of incoming transactions
 func (txip *TxInterceptorProcessor) RegisterHandler(_ func(topic string, hash []byte, data interface{})) {
 	log.Error("txInterceptorProcessor.RegisterHandler", "error", "not implemented")
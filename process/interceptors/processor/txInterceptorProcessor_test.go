@@ -19,6 +19,7 @@ func createMockTxArgument() *processor.ArgTxInterceptorProcessor {
 	return &processor.ArgTxInterceptorProcessor{
 		ShardedDataCache: testscommon.NewShardedDataStub(),
 		TxValidator:      &mock.TxValidatorStub{},
+		WhiteListRequest: &testscommon.WhiteListHandlerStub{},
 	}
 }
 
@@ -53,6 +54,17 @@ func TestNewTxInterceptorProcessor_NilTxValidatorShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilTxValidator, err)
 }
 
+func TestNewTxInterceptorProcessor_NilWhiteListRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockTxArgument()
+	arg.WhiteListRequest = nil
+	txip, err := processor.NewTxInterceptorProcessor(arg)
+
+	assert.Nil(t, txip)
+	assert.Equal(t, process.ErrNilWhiteListHandler, err)
+}
+
 func TestNewTxInterceptorProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -167,6 +179,74 @@ func TestTxInterceptorProcessor_SaveShouldWork(t *testing.T) {
 	assert.True(t, addedWasCalled)
 }
 
+type shardedTxPoolStub struct {
+	*testscommon.ShardedDataStub
+	addDataWithReplacementCalled func(key []byte, data interface{}, sizeInBytes int, cacheId string)
+}
+
+// AddDataWithReplacement -
+func (stub *shardedTxPoolStub) AddDataWithReplacement(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+	stub.addDataWithReplacementCalled(key, data, sizeInBytes, cacheId)
+}
+
+func TestTxInterceptorProcessor_SaveShouldApplyReplacementOnlyForNotRequestedTxs(t *testing.T) {
+	t.Parallel()
+
+	txInterceptedData := &struct {
+		testscommon.InterceptedDataStub
+		mock.InterceptedTxHandlerStub
+	}{
+		InterceptedDataStub: testscommon.InterceptedDataStub{
+			HashCalled: func() []byte {
+				return []byte("hash")
+			},
+		},
+		InterceptedTxHandlerStub: mock.InterceptedTxHandlerStub{
+			SenderShardIdCalled: func() uint32 {
+				return 0
+			},
+			ReceiverShardIdCalled: func() uint32 {
+				return 0
+			},
+			TransactionCalled: func() data.TransactionHandler {
+				return &transaction.Transaction{}
+			},
+		},
+	}
+
+	numAdded := 0
+	numAddedWithReplacement := 0
+	isRequested := false
+	arg := createMockTxArgument()
+	arg.ShardedDataCache = &shardedTxPoolStub{
+		ShardedDataStub: &testscommon.ShardedDataStub{
+			AddDataCalled: func(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+				numAdded++
+			},
+		},
+		addDataWithReplacementCalled: func(key []byte, data interface{}, sizeInBytes int, cacheId string) {
+			numAddedWithReplacement++
+		},
+	}
+	arg.WhiteListRequest = &testscommon.WhiteListHandlerStub{
+		IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+			return isRequested
+		},
+	}
+	txip, _ := processor.NewTxInterceptorProcessor(arg)
+
+	err := txip.Save(txInterceptedData, "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, numAdded)
+	assert.Equal(t, 1, numAddedWithReplacement)
+
+	isRequested = true
+	err = txip.Save(txInterceptedData, "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numAdded)
+	assert.Equal(t, 1, numAddedWithReplacement)
+}
+
 //------- IsInterfaceNil
 
 func TestTxInterceptorProcessor_IsInterfaceNil(t *testing.T) {
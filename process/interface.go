@@ -886,6 +886,7 @@ type BlockTracker interface {
 	RegisterSelfNotarizedHeadersHandler(func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
 	RegisterFinalMetachainHeadersHandler(func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
 	RemoveLastNotarizedHeaders()
+	RemoveHeadersHigherThanNonce(shardID uint32, nonce uint64)
 	RestoreToGenesis()
 	ShouldAddHeader(headerHandler data.HeaderHandler) bool
 	IsInterfaceNil() bool
@@ -1316,6 +1317,13 @@ type TxsSenderHandler interface {
 	IsInterfaceNil() bool
 }
 
+// TxPoolJournalHandler persists the transactions pool, so that it can be restored after a restart
+type TxPoolJournalHandler interface {
+	Replay() error
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // PreProcessorExecutionInfoHandler handles pre processor execution info needed by the transactions preprocessors
 type PreProcessorExecutionInfoHandler interface {
 	GetNumOfCrossInterMbsAndTxs() (int, int)
@@ -1358,3 +1366,14 @@ type SentSignaturesTracker interface {
 	ResetCountersForManagedBlockSigner(signerPk []byte)
 	IsInterfaceNil() bool
 }
+
+// ExecutionTracer defines a component able to record the execution frames of smart contract calls and built-in functions,
+// along with the storage reads requested by the VM through the blockchain hook
+type ExecutionTracer interface {
+	EnterContractCall(input *vmcommon.ContractCallInput)
+	EnterContractDeploy(input *vmcommon.ContractCreateInput)
+	EnterBuiltInFunction(input *vmcommon.ContractCallInput)
+	RecordStorageRead(address []byte, key []byte, value []byte)
+	ExitFrame(vmOutput *vmcommon.VMOutput, err error)
+	IsInterfaceNil() bool
+}
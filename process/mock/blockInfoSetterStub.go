@@ -0,0 +1,20 @@
+package mock
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// BlockInfoSetterStub -
+type BlockInfoSetterStub struct {
+	SetBlockInfoCalled func(blockInfo common.BlockInfo)
+}
+
+// SetBlockInfo -
+func (stub *BlockInfoSetterStub) SetBlockInfo(blockInfo common.BlockInfo) {
+	if stub.SetBlockInfoCalled != nil {
+		stub.SetBlockInfoCalled(blockInfo)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *BlockInfoSetterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -6,16 +6,17 @@ import (
 
 // BlockNotarizerHandlerMock -
 type BlockNotarizerHandlerMock struct {
-	AddNotarizedHeaderCalled                 func(shardID uint32, notarizedHeader data.HeaderHandler, notarizedHeaderHash []byte)
-	CleanupNotarizedHeadersBehindNonceCalled func(shardID uint32, nonce uint64)
-	DisplayNotarizedHeadersCalled            func(shardID uint32, message string)
-	GetFirstNotarizedHeaderCalled            func(shardID uint32) (data.HeaderHandler, []byte, error)
-	GetLastNotarizedHeaderCalled             func(shardID uint32) (data.HeaderHandler, []byte, error)
-	GetLastNotarizedHeaderNonceCalled        func(shardID uint32) uint64
-	GetNotarizedHeaderCalled                 func(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error)
-	InitNotarizedHeadersCalled               func(startHeaders map[uint32]data.HeaderHandler) error
-	RemoveLastNotarizedHeaderCalled          func()
-	RestoreNotarizedHeadersToGenesisCalled   func()
+	AddNotarizedHeaderCalled                    func(shardID uint32, notarizedHeader data.HeaderHandler, notarizedHeaderHash []byte)
+	CleanupNotarizedHeadersBehindNonceCalled    func(shardID uint32, nonce uint64)
+	DisplayNotarizedHeadersCalled               func(shardID uint32, message string)
+	GetFirstNotarizedHeaderCalled               func(shardID uint32) (data.HeaderHandler, []byte, error)
+	GetLastNotarizedHeaderCalled                func(shardID uint32) (data.HeaderHandler, []byte, error)
+	GetLastNotarizedHeaderNonceCalled           func(shardID uint32) uint64
+	GetNotarizedHeaderCalled                    func(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error)
+	InitNotarizedHeadersCalled                  func(startHeaders map[uint32]data.HeaderHandler) error
+	RemoveLastNotarizedHeaderCalled             func()
+	RemoveNotarizedHeadersHigherThanNonceCalled func(shardID uint32, nonce uint64)
+	RestoreNotarizedHeadersToGenesisCalled      func()
 }
 
 // AddNotarizedHeader -
@@ -91,6 +92,13 @@ func (bngm *BlockNotarizerHandlerMock) RemoveLastNotarizedHeader() {
 	}
 }
 
+// RemoveNotarizedHeadersHigherThanNonce -
+func (bngm *BlockNotarizerHandlerMock) RemoveNotarizedHeadersHigherThanNonce(shardID uint32, nonce uint64) {
+	if bngm.RemoveNotarizedHeadersHigherThanNonceCalled != nil {
+		bngm.RemoveNotarizedHeadersHigherThanNonceCalled(shardID, nonce)
+	}
+}
+
 // RestoreNotarizedHeadersToGenesis -
 func (bngm *BlockNotarizerHandlerMock) RestoreNotarizedHeadersToGenesis() {
 	if bngm.RestoreNotarizedHeadersToGenesisCalled != nil {
@@ -47,6 +47,7 @@ type BlockTrackerMock struct {
 	RegisterSelfNotarizedHeadersHandlerCalled          func(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
 	RegisterFinalMetachainHeadersHandlerCalled         func(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
 	RemoveLastNotarizedHeadersCalled                   func()
+	RemoveHeadersHigherThanNonceCalled                 func(shardID uint32, nonce uint64)
 	RestoreToGenesisCalled                             func()
 	ShouldAddHeaderCalled                              func(headerHandler data.HeaderHandler) bool
 
@@ -498,6 +499,13 @@ func (btm *BlockTrackerMock) RemoveLastNotarizedHeaders() {
 	}
 }
 
+// RemoveHeadersHigherThanNonce -
+func (btm *BlockTrackerMock) RemoveHeadersHigherThanNonce(shardID uint32, nonce uint64) {
+	if btm.RemoveHeadersHigherThanNonceCalled != nil {
+		btm.RemoveHeadersHigherThanNonceCalled(shardID, nonce)
+	}
+}
+
 // RestoreToGenesis -
 func (btm *BlockTrackerMock) RestoreToGenesis() {
 	if btm.RestoreToGenesisCalled != nil {
@@ -0,0 +1,30 @@
+package mock
+
+import txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+
+// ExecutionTracingHandlerStub -
+type ExecutionTracingHandlerStub struct {
+	StartTracingCalled func()
+	StopTracingCalled  func() []*txSimData.TraceFrame
+}
+
+// StartTracing -
+func (stub *ExecutionTracingHandlerStub) StartTracing() {
+	if stub.StartTracingCalled != nil {
+		stub.StartTracingCalled()
+	}
+}
+
+// StopTracing -
+func (stub *ExecutionTracingHandlerStub) StopTracing() []*txSimData.TraceFrame {
+	if stub.StopTracingCalled != nil {
+		return stub.StopTracingCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ExecutionTracingHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -9,6 +9,7 @@ import (
 // TransactionSimulatorStub -
 type TransactionSimulatorStub struct {
 	ProcessTxCalled func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error)
+	TraceTxCalled   func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.TransactionTrace, error)
 }
 
 // ProcessTx -
@@ -20,6 +21,15 @@ func (tss *TransactionSimulatorStub) ProcessTx(tx *transaction.Transaction, curr
 	return nil, nil
 }
 
+// TraceTx -
+func (tss *TransactionSimulatorStub) TraceTx(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.TransactionTrace, error) {
+	if tss.TraceTxCalled != nil {
+		return tss.TraceTxCalled(tx, currentHeader)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (tss *TransactionSimulatorStub) IsInterfaceNil() bool {
 	return tss == nil
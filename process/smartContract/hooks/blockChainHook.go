@@ -64,6 +64,7 @@ type ArgBlockChainHook struct {
 	GasSchedule              core.GasScheduleNotifier
 	Counter                  BlockChainHookCounter
 	MissingTrieNodesNotifier common.MissingTrieNodesNotifier
+	ExecutionTracer          process.ExecutionTracer
 }
 
 // BlockChainHookImpl is a wrapper over AccountsAdapter that satisfy vmcommon.BlockchainHook interface
@@ -81,6 +82,7 @@ type BlockChainHookImpl struct {
 	globalSettingsHandler vmcommon.ESDTGlobalSettingsHandler
 	enableEpochsHandler   common.EnableEpochsHandler
 	counter               BlockChainHookCounter
+	executionTracer       process.ExecutionTracer
 
 	mutCurrentHdr sync.RWMutex
 	currentHdr    data.HeaderHandler
@@ -126,6 +128,7 @@ func NewBlockChainHookImpl(
 		gasSchedule:              args.GasSchedule,
 		counter:                  args.Counter,
 		missingTrieNodesNotifier: args.MissingTrieNodesNotifier,
+		executionTracer:          args.ExecutionTracer,
 	}
 
 	err = blockChainHookImpl.makeCompiledSCStorage()
@@ -217,6 +220,9 @@ func checkForNil(args ArgBlockChainHook) error {
 	if check.IfNil(args.MissingTrieNodesNotifier) {
 		return ErrNilMissingTrieNodesNotifier
 	}
+	if check.IfNil(args.ExecutionTracer) {
+		return process.ErrNilExecutionTracer
+	}
 	return nil
 }
 
@@ -266,6 +272,7 @@ func (bh *BlockChainHookImpl) GetStorageData(accountAddress []byte, index []byte
 
 	userAcc, err := bh.GetUserAccount(accountAddress)
 	if err == state.ErrAccNotFound {
+		bh.executionTracer.RecordStorageRead(accountAddress, index, make([]byte, 0))
 		return make([]byte, 0), 0, nil
 	}
 	if err != nil {
@@ -284,6 +291,8 @@ func (bh *BlockChainHookImpl) GetStorageData(accountAddress []byte, index []byte
 		messages = append(messages, err)
 
 		bh.syncIfMissingDataTrieNode(err)
+	} else {
+		bh.executionTracer.RecordStorageRead(accountAddress, index, value)
 	}
 	log.Trace("GetStorageData ", messages...)
 
@@ -475,6 +484,14 @@ func (bh *BlockChainHookImpl) ProcessBuiltInFunction(input *vmcommon.ContractCal
 		return nil, process.ErrNilVmInput
 	}
 
+	bh.executionTracer.EnterBuiltInFunction(input)
+	vmOutput, err := bh.processBuiltInFunction(input)
+	bh.executionTracer.ExitFrame(vmOutput, err)
+
+	return vmOutput, err
+}
+
+func (bh *BlockChainHookImpl) processBuiltInFunction(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
 	function, err := bh.builtInFunctions.Get(input.Function)
 	if err != nil {
 		return nil, err
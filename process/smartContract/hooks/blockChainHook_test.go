@@ -69,6 +69,7 @@ func createMockBlockChainHookArgs() hooks.ArgBlockChainHook {
 		GasSchedule:              testscommon.NewGasScheduleNotifierMock(make(map[string]map[string]uint64)),
 		Counter:                  &testscommon.BlockChainHookCounterStub{},
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
+		ExecutionTracer:          &testscommon.ExecutionTracerStub{},
 	}
 	return arguments
 }
@@ -449,6 +450,37 @@ func TestBlockChainHookImpl_GetStorageData(t *testing.T) {
 		assert.Equal(t, expectedErr, err)
 		assert.Nil(t, value)
 	})
+	t.Run("should record the storage read in the execution tracer", func(t *testing.T) {
+		t.Parallel()
+
+		address := []byte("address")
+		variableIdentifier := []byte("variable")
+		variableValue := []byte("value")
+		accnt := stateMock.NewAccountWrapMock(address)
+		_ = accnt.SaveKeyValue(variableIdentifier, variableValue)
+
+		recorded := false
+		args := createMockBlockChainHookArgs()
+		args.ExecutionTracer = &testscommon.ExecutionTracerStub{
+			RecordStorageReadCalled: func(addr []byte, key []byte, value []byte) {
+				require.Equal(t, address, addr)
+				require.Equal(t, variableIdentifier, key)
+				require.Equal(t, variableValue, value)
+				recorded = true
+			},
+		}
+		args.Accounts = &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(address []byte) (handler vmcommon.AccountHandler, e error) {
+				return accnt, nil
+			},
+		}
+		bh, _ := hooks.NewBlockChainHookImpl(args)
+
+		value, _, err := bh.GetStorageData(address, variableIdentifier)
+		assert.Nil(t, err)
+		assert.Equal(t, variableValue, value)
+		assert.True(t, recorded)
+	})
 	t.Run("should work before counters activation", func(t *testing.T) {
 		t.Parallel()
 
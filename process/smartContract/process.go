@@ -79,6 +79,7 @@ type scProcessor struct {
 	mutGasLock          sync.RWMutex
 	txLogsProcessor     process.TransactionLogProcessor
 	vmOutputCacher      storage.Cacher
+	executionTracer     process.ExecutionTracer
 	isGenesisProcessing bool
 
 	executableCheckers    map[string]scrCommon.ExecutableChecker
@@ -196,6 +197,9 @@ func NewSmartContractProcessor(args scrCommon.ArgsNewSmartContractProcessor) (*s
 	if check.IfNil(args.VMOutputCacher) {
 		return nil, process.ErrNilCacher
 	}
+	if check.IfNil(args.ExecutionTracer) {
+		return nil, process.ErrNilExecutionTracer
+	}
 	if check.IfNil(args.BuiltInFunctions) {
 		return nil, process.ErrNilBuiltInFunction
 	}
@@ -225,6 +229,7 @@ func NewSmartContractProcessor(args scrCommon.ArgsNewSmartContractProcessor) (*s
 		isGenesisProcessing: args.IsGenesisProcessing,
 		wasmVMChangeLocker:  args.WasmVMChangeLocker,
 		vmOutputCacher:      args.VMOutputCacher,
+		executionTracer:     args.ExecutionTracer,
 		storePerByte:        baseOperationCost["StorePerByte"],
 		persistPerByte:      baseOperationCost["PersistPerByte"],
 		executableCheckers:  scrCommon.CreateExecutableCheckersMap(args.BuiltInFunctions),
@@ -410,7 +415,9 @@ func (sc *scProcessor) executeSmartContractCall(
 	defer sc.printBlockchainHookCounters(tx)
 
 	var vmOutput *vmcommon.VMOutput
+	sc.executionTracer.EnterContractCall(vmInput)
 	vmOutput, err = vmExec.RunSmartContractCall(vmInput)
+	sc.executionTracer.ExitFrame(vmOutput, err)
 
 	sc.wasmVMChangeLocker.RUnlock()
 	if err != nil {
@@ -1764,7 +1771,9 @@ func (sc *scProcessor) doDeploySmartContract(
 		return vmcommon.UserError, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
 	}
 
+	sc.executionTracer.EnterContractDeploy(vmInput)
 	vmOutput, err = vmExec.RunSmartContractCreate(vmInput)
+	sc.executionTracer.ExitFrame(vmOutput, err)
 	sc.wasmVMChangeLocker.RUnlock()
 	if err != nil {
 		log.Debug("VM error", "error", err.Error())
@@ -71,6 +71,7 @@ func NewSmartContractProcessorProxy(args scrCommon.ArgsNewSmartContractProcessor
 			EnableEpochsHandler: args.EnableEpochsHandler,
 			EnableEpochs:        args.EnableEpochs,
 			VMOutputCacher:      args.VMOutputCacher,
+			ExecutionTracer:     args.ExecutionTracer,
 			WasmVMChangeLocker:  args.WasmVMChangeLocker,
 			IsGenesisProcessing: args.IsGenesisProcessing,
 		},
@@ -79,6 +79,7 @@ func createMockSmartContractProcessorArguments() scrCommon.ArgsNewSmartContractP
 		EnableRoundsHandler: &testscommon.EnableRoundsHandlerStub{},
 		WasmVMChangeLocker:  &sync.RWMutex{},
 		VMOutputCacher:      txcache.NewDisabledCache(),
+		ExecutionTracer:     &testscommon.ExecutionTracerStub{},
 	}
 }
 
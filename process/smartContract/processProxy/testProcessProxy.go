@@ -49,6 +49,7 @@ func NewTestSmartContractProcessorProxy(args scrCommon.ArgsNewSmartContractProce
 			EnableEpochsHandler: args.EnableEpochsHandler,
 			EnableEpochs:        args.EnableEpochs,
 			VMOutputCacher:      args.VMOutputCacher,
+			ExecutionTracer:     args.ExecutionTracer,
 			WasmVMChangeLocker:  args.WasmVMChangeLocker,
 			IsGenesisProcessing: args.IsGenesisProcessing,
 		},
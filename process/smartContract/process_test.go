@@ -119,6 +119,7 @@ func createMockSmartContractProcessorArguments() scrCommon.ArgsNewSmartContractP
 		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.SCDeployFlag),
 		WasmVMChangeLocker:  &sync.RWMutex{},
 		VMOutputCacher:      txcache.NewDisabledCache(),
+		ExecutionTracer:     &testscommon.ExecutionTracerStub{},
 	}
 }
 
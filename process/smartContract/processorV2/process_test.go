@@ -132,6 +132,7 @@ func createMockSmartContractProcessorArguments() scrCommon.ArgsNewSmartContractP
 		GasSchedule:        testscommon.NewGasScheduleNotifierMock(gasSchedule),
 		WasmVMChangeLocker: &sync.RWMutex{},
 		VMOutputCacher:     txcache.NewDisabledCache(),
+		ExecutionTracer:    &testscommon.ExecutionTracerStub{},
 	}
 }
 
@@ -52,6 +52,7 @@ type ArgsNewSmartContractProcessor struct {
 	EnableEpochsHandler common.EnableEpochsHandler
 	EnableEpochs        config.EnableEpochs
 	VMOutputCacher      storage.Cacher
+	ExecutionTracer     process.ExecutionTracer
 	WasmVMChangeLocker  common.Locker
 	IsGenesisProcessing bool
 }
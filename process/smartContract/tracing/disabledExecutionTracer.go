@@ -0,0 +1,31 @@
+package tracing
+
+import vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+
+type disabledExecutionTracer struct {
+}
+
+// NewDisabledExecutionTracer will create a new instance of type disabledExecutionTracer
+func NewDisabledExecutionTracer() *disabledExecutionTracer {
+	return &disabledExecutionTracer{}
+}
+
+// EnterContractCall does nothing
+func (tracer *disabledExecutionTracer) EnterContractCall(_ *vmcommon.ContractCallInput) {}
+
+// EnterContractDeploy does nothing
+func (tracer *disabledExecutionTracer) EnterContractDeploy(_ *vmcommon.ContractCreateInput) {}
+
+// EnterBuiltInFunction does nothing
+func (tracer *disabledExecutionTracer) EnterBuiltInFunction(_ *vmcommon.ContractCallInput) {}
+
+// RecordStorageRead does nothing
+func (tracer *disabledExecutionTracer) RecordStorageRead(_ []byte, _ []byte, _ []byte) {}
+
+// ExitFrame does nothing
+func (tracer *disabledExecutionTracer) ExitFrame(_ *vmcommon.VMOutput, _ error) {}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracer *disabledExecutionTracer) IsInterfaceNil() bool {
+	return tracer == nil
+}
@@ -0,0 +1,28 @@
+package tracing
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledExecutionTracer_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	tracer := NewDisabledExecutionTracer()
+	assert.False(t, check.IfNil(tracer))
+	tracer.EnterContractCall(nil)
+	tracer.EnterContractDeploy(nil)
+	tracer.EnterBuiltInFunction(nil)
+	tracer.RecordStorageRead(nil, nil, nil)
+	tracer.ExitFrame(nil, nil)
+}
@@ -0,0 +1,244 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+var log = logger.GetOrCreate("process/smartcontract/tracing")
+
+const (
+	contractCallFrame    = "contractCall"
+	contractDeployFrame  = "contractDeploy"
+	builtInFunctionFrame = "builtInFunction"
+	outputTransferFrame  = "outputTransfer"
+)
+
+type frame struct {
+	traceFrame *data.TraceFrame
+	parent     *frame
+}
+
+// executionTracer records a frame for each top-level smart contract call or deploy and for each built-in function call,
+// together with the storage reads the VM requests from the blockchain hook. When a frame ends, the nested calls the VM
+// executed (synchronous calls to other contracts, intra-shard asynchronous calls and their callbacks) are rebuilt as
+// child frames from the VM output, the calls sent to other shards are added from the output transfers, and each frame
+// receives its own storage accesses and gas.
+type executionTracer struct {
+	mutTracing      sync.Mutex
+	pubkeyConverter core.PubkeyConverter
+	isTracing       bool
+	frames          []*data.TraceFrame
+	currentFrame    *frame
+}
+
+// NewExecutionTracer creates a new instance of type executionTracer. The tracer will only record frames
+// between the StartTracing and StopTracing calls
+func NewExecutionTracer(pubkeyConverter core.PubkeyConverter) (*executionTracer, error) {
+	if check.IfNil(pubkeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+
+	return &executionTracer{
+		pubkeyConverter: pubkeyConverter,
+	}, nil
+}
+
+// StartTracing drops the previously recorded frames and starts recording the new ones
+func (tracer *executionTracer) StartTracing() {
+	tracer.mutTracing.Lock()
+	defer tracer.mutTracing.Unlock()
+
+	tracer.isTracing = true
+	tracer.frames = make([]*data.TraceFrame, 0)
+	tracer.currentFrame = nil
+}
+
+// StopTracing stops the recording and returns the recorded root frames
+func (tracer *executionTracer) StopTracing() []*data.TraceFrame {
+	tracer.mutTracing.Lock()
+	defer tracer.mutTracing.Unlock()
+
+	frames := tracer.frames
+	tracer.isTracing = false
+	tracer.frames = nil
+	tracer.currentFrame = nil
+
+	return frames
+}
+
+// EnterContractCall opens a new frame for the provided smart contract call
+func (tracer *executionTracer) EnterContractCall(input *vmcommon.ContractCallInput) {
+	if input == nil {
+		return
+	}
+
+	tracer.enterFrame(contractCallFrame, &input.VMInput, input.RecipientAddr, input.Function)
+}
+
+// EnterContractDeploy opens a new frame for the provided smart contract deploy
+func (tracer *executionTracer) EnterContractDeploy(input *vmcommon.ContractCreateInput) {
+	if input == nil {
+		return
+	}
+
+	tracer.enterFrame(contractDeployFrame, &input.VMInput, nil, "")
+}
+
+// EnterBuiltInFunction opens a new frame for the provided built-in function call
+func (tracer *executionTracer) EnterBuiltInFunction(input *vmcommon.ContractCallInput) {
+	if input == nil {
+		return
+	}
+
+	tracer.enterFrame(builtInFunctionFrame, &input.VMInput, input.RecipientAddr, input.Function)
+}
+
+func (tracer *executionTracer) enterFrame(frameType string, input *vmcommon.VMInput, recipient []byte, function string) {
+	tracer.mutTracing.Lock()
+	defer tracer.mutTracing.Unlock()
+
+	if !tracer.isTracing {
+		return
+	}
+
+	traceFrame := &data.TraceFrame{
+		Type:        frameType,
+		CallType:    input.CallType.ToString(),
+		Caller:      tracer.encodeAddress(input.CallerAddr),
+		Recipient:   tracer.encodeAddress(recipient),
+		Function:    function,
+		Arguments:   encodeToHexSlice(input.Arguments),
+		Value:       bigIntToString(input.CallValue),
+		GasProvided: input.GasProvided,
+	}
+
+	if tracer.currentFrame == nil {
+		tracer.frames = append(tracer.frames, traceFrame)
+	} else {
+		parentFrame := tracer.currentFrame.traceFrame
+		parentFrame.Calls = append(parentFrame.Calls, traceFrame)
+	}
+
+	tracer.currentFrame = &frame{
+		traceFrame: traceFrame,
+		parent:     tracer.currentFrame,
+	}
+}
+
+// RecordStorageRead adds the provided storage read to the current frame. Reads done outside of any frame are ignored
+func (tracer *executionTracer) RecordStorageRead(address []byte, key []byte, value []byte) {
+	tracer.mutTracing.Lock()
+	defer tracer.mutTracing.Unlock()
+
+	if !tracer.isTracing || tracer.currentFrame == nil {
+		return
+	}
+
+	traceFrame := tracer.currentFrame.traceFrame
+	traceFrame.StorageAccesses = append(traceFrame.StorageAccesses, &data.TraceStorageAccess{
+		Address: tracer.encodeAddress(address),
+		Key:     hex.EncodeToString(key),
+		Value:   hex.EncodeToString(value),
+		Written: false,
+	})
+}
+
+// ExitFrame closes the current frame, filling in the results of its execution
+func (tracer *executionTracer) ExitFrame(vmOutput *vmcommon.VMOutput, err error) {
+	tracer.mutTracing.Lock()
+	defer tracer.mutTracing.Unlock()
+
+	if !tracer.isTracing || tracer.currentFrame == nil {
+		return
+	}
+
+	traceFrame := tracer.currentFrame.traceFrame
+	tracer.currentFrame = tracer.currentFrame.parent
+
+	if err != nil {
+		traceFrame.Error = err.Error()
+	}
+	if vmOutput == nil {
+		traceFrame.GasUsed = traceFrame.GasProvided
+		return
+	}
+
+	traceFrame.GasRemaining = vmOutput.GasRemaining
+	if traceFrame.GasProvided > vmOutput.GasRemaining {
+		traceFrame.GasUsed = traceFrame.GasProvided - vmOutput.GasRemaining
+	}
+	traceFrame.ReturnCode = vmOutput.ReturnCode.String()
+	traceFrame.ReturnMessage = vmOutput.ReturnMessage
+	traceFrame.ReturnData = encodeToHexSlice(vmOutput.ReturnData)
+
+	tracer.addVMOutputToFrame(traceFrame, vmOutput)
+}
+
+func createStorageAccesses(encodedAddress string, storageUpdates map[string]*vmcommon.StorageUpdate) []*data.TraceStorageAccess {
+	keys := make([]string, 0, len(storageUpdates))
+	for key := range storageUpdates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	storageAccesses := make([]*data.TraceStorageAccess, 0, len(keys))
+	for _, key := range keys {
+		storageUpdate := storageUpdates[key]
+		if storageUpdate == nil {
+			continue
+		}
+
+		storageAccesses = append(storageAccesses, &data.TraceStorageAccess{
+			Address: encodedAddress,
+			Key:     hex.EncodeToString(storageUpdate.Offset),
+			Value:   hex.EncodeToString(storageUpdate.Data),
+			Written: storageUpdate.Written,
+		})
+	}
+
+	return storageAccesses
+}
+
+func (tracer *executionTracer) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return tracer.pubkeyConverter.SilentEncode(address, log)
+}
+
+func encodeToHexSlice(values [][]byte) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	encodedValues := make([]string, 0, len(values))
+	for _, value := range values {
+		encodedValues = append(encodedValues, hex.EncodeToString(value))
+	}
+
+	return encodedValues
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracer *executionTracer) IsInterfaceNil() bool {
+	return tracer == nil
+}
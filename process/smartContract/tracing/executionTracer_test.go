@@ -0,0 +1,357 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExecutionTracer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := NewExecutionTracer(nil)
+		require.Equal(t, process.ErrNilPubkeyConverter, err)
+		require.True(t, check.IfNil(tracer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := NewExecutionTracer(testscommon.RealWorldBech32PubkeyConverter)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(tracer))
+	})
+}
+
+func TestExecutionTracer_ShouldNotRecordWhenNotTracing(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(testscommon.RealWorldBech32PubkeyConverter)
+	tracer.EnterContractCall(&vmcommon.ContractCallInput{Function: "call"})
+	tracer.ExitFrame(&vmcommon.VMOutput{}, nil)
+
+	tracer.StartTracing()
+	frames := tracer.StopTracing()
+	require.Empty(t, frames)
+
+	tracer.EnterBuiltInFunction(&vmcommon.ContractCallInput{Function: "builtIn"})
+	tracer.ExitFrame(nil, nil)
+	require.Nil(t, tracer.StopTracing())
+}
+
+func TestExecutionTracer_NestedFrames(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(testscommon.RealWorldBech32PubkeyConverter)
+	tracer.StartTracing()
+
+	tracer.EnterContractCall(&vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  testscommon.TestPubKeyAlice,
+			Arguments:   [][]byte{[]byte("arg")},
+			CallValue:   big.NewInt(10),
+			CallType:    vm.DirectCall,
+			GasProvided: 1000,
+		},
+		RecipientAddr: testscommon.TestPubKeyBob,
+		Function:      "call",
+	})
+	tracer.EnterBuiltInFunction(&vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  testscommon.TestPubKeyBob,
+			CallType:    vm.DirectCall,
+			GasProvided: 300,
+		},
+		RecipientAddr: testscommon.TestPubKeyAlice,
+		Function:      "ESDTTransfer",
+	})
+	tracer.ExitFrame(nil, errors.New("built-in error"))
+	tracer.ExitFrame(&vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		ReturnData:   [][]byte{[]byte("result")},
+		GasRemaining: 400,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(testscommon.TestPubKeyBob): {
+				Address: testscommon.TestPubKeyBob,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"b": {Offset: []byte("b"), Data: []byte("written"), Written: true},
+					"a": {Offset: []byte("a"), Data: []byte("read")},
+				},
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						Value:         big.NewInt(5),
+						GasLimit:      50,
+						Data:          []byte("callBack"),
+						CallType:      vm.AsynchronousCallBack,
+						SenderAddress: testscommon.TestPubKeyAlice,
+					},
+				},
+			},
+		},
+	}, nil)
+	tracer.EnterContractDeploy(&vmcommon.ContractCreateInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  testscommon.TestPubKeyAlice,
+			GasProvided: 100,
+		},
+	})
+	tracer.ExitFrame(&vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: "failed"}, nil)
+
+	frames := tracer.StopTracing()
+	require.Len(t, frames, 2)
+
+	callFrame := frames[0]
+	require.Equal(t, contractCallFrame, callFrame.Type)
+	require.Equal(t, vm.DirectCallStr, callFrame.CallType)
+	require.Equal(t, testscommon.TestAddressAlice, callFrame.Caller)
+	require.Equal(t, testscommon.TestAddressBob, callFrame.Recipient)
+	require.Equal(t, "call", callFrame.Function)
+	require.Equal(t, []string{hex.EncodeToString([]byte("arg"))}, callFrame.Arguments)
+	require.Equal(t, "10", callFrame.Value)
+	require.Equal(t, uint64(1000), callFrame.GasProvided)
+	require.Equal(t, uint64(400), callFrame.GasRemaining)
+	require.Equal(t, uint64(600), callFrame.GasUsed)
+	require.Equal(t, vmcommon.Ok.String(), callFrame.ReturnCode)
+	require.Equal(t, []string{hex.EncodeToString([]byte("result"))}, callFrame.ReturnData)
+	require.Len(t, callFrame.StorageAccesses, 2)
+	require.Equal(t, hex.EncodeToString([]byte("a")), callFrame.StorageAccesses[0].Key)
+	require.False(t, callFrame.StorageAccesses[0].Written)
+	require.Equal(t, hex.EncodeToString([]byte("written")), callFrame.StorageAccesses[1].Value)
+	require.True(t, callFrame.StorageAccesses[1].Written)
+	require.Equal(t, testscommon.TestAddressBob, callFrame.StorageAccesses[1].Address)
+
+	require.Len(t, callFrame.Calls, 2)
+	builtInFrame := callFrame.Calls[0]
+	require.Equal(t, builtInFunctionFrame, builtInFrame.Type)
+	require.Equal(t, "ESDTTransfer", builtInFrame.Function)
+	require.Equal(t, "built-in error", builtInFrame.Error)
+	require.Equal(t, uint64(300), builtInFrame.GasUsed)
+
+	transferFrame := callFrame.Calls[1]
+	require.Equal(t, outputTransferFrame, transferFrame.Type)
+	require.Equal(t, vm.AsynchronousCallBackStr, transferFrame.CallType)
+	require.Equal(t, testscommon.TestAddressAlice, transferFrame.Caller)
+	require.Equal(t, testscommon.TestAddressBob, transferFrame.Recipient)
+	require.Equal(t, "callBack", transferFrame.Data)
+	require.Equal(t, "5", transferFrame.Value)
+	require.Equal(t, uint64(50), transferFrame.GasProvided)
+
+	deployFrame := frames[1]
+	require.Equal(t, contractDeployFrame, deployFrame.Type)
+	require.Empty(t, deployFrame.Recipient)
+	require.Equal(t, vmcommon.UserError.String(), deployFrame.ReturnCode)
+	require.Equal(t, "failed", deployFrame.ReturnMessage)
+	require.Equal(t, uint64(100), deployFrame.GasUsed)
+}
+
+func TestExecutionTracer_RecordStorageRead(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(testscommon.RealWorldBech32PubkeyConverter)
+	tracer.RecordStorageRead(testscommon.TestPubKeyBob, []byte("key"), []byte("value"))
+
+	tracer.StartTracing()
+	tracer.RecordStorageRead(testscommon.TestPubKeyBob, []byte("outside"), []byte("frame"))
+	tracer.EnterContractCall(&vmcommon.ContractCallInput{RecipientAddr: testscommon.TestPubKeyBob, Function: "call"})
+	tracer.RecordStorageRead(testscommon.TestPubKeyBob, []byte("key"), []byte("value"))
+	tracer.ExitFrame(&vmcommon.VMOutput{}, nil)
+
+	frames := tracer.StopTracing()
+	require.Len(t, frames, 1)
+	require.Len(t, frames[0].StorageAccesses, 1)
+	storageAccess := frames[0].StorageAccesses[0]
+	require.Equal(t, testscommon.TestAddressBob, storageAccess.Address)
+	require.Equal(t, hex.EncodeToString([]byte("key")), storageAccess.Key)
+	require.Equal(t, hex.EncodeToString([]byte("value")), storageAccess.Value)
+	require.False(t, storageAccess.Written)
+}
+
+func TestExecutionTracer_NestedCallsFromVMOutput(t *testing.T) {
+	t.Parallel()
+
+	sc1 := bytes.Repeat([]byte{1}, 32)
+	sc2 := bytes.Repeat([]byte{2}, 32)
+	sc3 := bytes.Repeat([]byte{3}, 32)
+	sc4 := bytes.Repeat([]byte{4}, 32)
+	encode := func(address []byte) string {
+		return testscommon.RealWorldBech32PubkeyConverter.SilentEncode(address, log)
+	}
+	transferValueOnlyLog := func(caller []byte, recipient []byte, value int64, data ...string) *vmcommon.LogEntry {
+		logData := make([][]byte, 0, len(data))
+		for _, field := range data {
+			logData = append(logData, []byte(field))
+		}
+
+		return &vmcommon.LogEntry{
+			Identifier: []byte(transferValueOnlyIdentifier),
+			Address:    caller,
+			Topics:     [][]byte{big.NewInt(value).Bytes(), recipient},
+			Data:       logData,
+		}
+	}
+
+	tracer, _ := NewExecutionTracer(testscommon.RealWorldBech32PubkeyConverter)
+	tracer.StartTracing()
+
+	tracer.EnterContractCall(&vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  testscommon.TestPubKeyAlice,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+		},
+		RecipientAddr: sc1,
+		Function:      "call",
+	})
+	tracer.RecordStorageRead(sc1, []byte("k1"), []byte("v1"))
+	tracer.RecordStorageRead(sc2, []byte("k2"), []byte("v2"))
+	tracer.EnterBuiltInFunction(&vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  sc2,
+			GasProvided: 30,
+		},
+		RecipientAddr: sc3,
+		Function:      "ESDTTransfer",
+	})
+	tracer.ExitFrame(&vmcommon.VMOutput{GasRemaining: 10}, nil)
+	tracer.ExitFrame(&vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 100,
+		Logs: []*vmcommon.LogEntry{
+			transferValueOnlyLog(sc1, sc2, 0, executeOnDestContextLabel, "f2", "a"),
+			transferValueOnlyLog(sc2, sc3, 5, asyncCallLabel, "f3"),
+			{Identifier: []byte("event"), Address: sc3},
+			transferValueOnlyLog(sc3, sc2, 0, asyncCallbackLabel, "cb"),
+			transferValueOnlyLog(sc1, sc4, 7, asyncCallLabel, "f4"),
+			transferValueOnlyLog(sc1, testscommon.TestPubKeyBob, 1, "DirectCall", ""),
+		},
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(sc2): {
+				Address: sc2,
+				GasUsed: 200,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"w": {Offset: []byte("w"), Data: []byte("written"), Written: true},
+				},
+			},
+			string(sc3): {
+				Address: sc3,
+				GasUsed: 150,
+			},
+			string(sc4): {
+				Address: sc4,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						Value:         big.NewInt(7),
+						GasLimit:      300,
+						Data:          []byte("f4"),
+						CallType:      vm.AsynchronousCall,
+						SenderAddress: sc1,
+					},
+				},
+			},
+			string(testscommon.TestPubKeyBob): {
+				Address: testscommon.TestPubKeyBob,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						Value:         big.NewInt(1),
+						CallType:      vm.DirectCall,
+						SenderAddress: sc1,
+					},
+				},
+			},
+		},
+	}, nil)
+
+	frames := tracer.StopTracing()
+	require.Len(t, frames, 1)
+
+	rootFrame := frames[0]
+	require.Equal(t, uint64(900), rootFrame.GasUsed)
+	require.Len(t, rootFrame.StorageAccesses, 1)
+	require.Equal(t, hex.EncodeToString([]byte("k1")), rootFrame.StorageAccesses[0].Key)
+	require.Len(t, rootFrame.Calls, 3)
+
+	syncCallFrame := rootFrame.Calls[0]
+	require.Equal(t, contractCallFrame, syncCallFrame.Type)
+	require.Equal(t, vm.DirectCallStr, syncCallFrame.CallType)
+	require.Equal(t, encode(sc1), syncCallFrame.Caller)
+	require.Equal(t, encode(sc2), syncCallFrame.Recipient)
+	require.Equal(t, "f2", syncCallFrame.Function)
+	require.Equal(t, []string{hex.EncodeToString([]byte("a"))}, syncCallFrame.Arguments)
+	require.Equal(t, uint64(200), syncCallFrame.GasUsed)
+	require.Len(t, syncCallFrame.StorageAccesses, 2)
+	require.Equal(t, hex.EncodeToString([]byte("k2")), syncCallFrame.StorageAccesses[0].Key)
+	require.True(t, syncCallFrame.StorageAccesses[1].Written)
+	require.Len(t, syncCallFrame.Calls, 3)
+
+	builtInFrame := syncCallFrame.Calls[0]
+	require.Equal(t, builtInFunctionFrame, builtInFrame.Type)
+	require.Equal(t, uint64(20), builtInFrame.GasUsed)
+
+	asyncCallFrame := syncCallFrame.Calls[1]
+	require.Equal(t, contractCallFrame, asyncCallFrame.Type)
+	require.Equal(t, vm.AsynchronousCallStr, asyncCallFrame.CallType)
+	require.Equal(t, encode(sc3), asyncCallFrame.Recipient)
+	require.Equal(t, "5", asyncCallFrame.Value)
+	require.Equal(t, uint64(150), asyncCallFrame.GasUsed)
+
+	callbackFrame := syncCallFrame.Calls[2]
+	require.Equal(t, vm.AsynchronousCallBackStr, callbackFrame.CallType)
+	require.Equal(t, encode(sc3), callbackFrame.Caller)
+	require.Equal(t, encode(sc2), callbackFrame.Recipient)
+	require.Equal(t, "cb", callbackFrame.Function)
+
+	sentCallFrame := rootFrame.Calls[1]
+	require.Equal(t, outputTransferFrame, sentCallFrame.Type)
+	require.Equal(t, vm.AsynchronousCallStr, sentCallFrame.CallType)
+	require.Equal(t, encode(sc4), sentCallFrame.Recipient)
+	require.Equal(t, "f4", sentCallFrame.Function)
+	require.Equal(t, uint64(300), sentCallFrame.GasProvided)
+
+	transferFrame := rootFrame.Calls[2]
+	require.Equal(t, outputTransferFrame, transferFrame.Type)
+	require.Equal(t, testscommon.TestAddressBob, transferFrame.Recipient)
+	require.Equal(t, "1", transferFrame.Value)
+}
+
+func TestExecutionTracer_DeployShouldTakeTheAddressFromTheVMOutput(t *testing.T) {
+	t.Parallel()
+
+	newAddress := bytes.Repeat([]byte{1}, 32)
+
+	tracer, _ := NewExecutionTracer(testscommon.RealWorldBech32PubkeyConverter)
+	tracer.StartTracing()
+	tracer.EnterContractDeploy(&vmcommon.ContractCreateInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  testscommon.TestPubKeyAlice,
+			GasProvided: 100,
+		},
+	})
+	tracer.RecordStorageRead(newAddress, []byte("key"), []byte("value"))
+	tracer.ExitFrame(&vmcommon.VMOutput{
+		Logs: []*vmcommon.LogEntry{
+			{
+				Identifier: []byte(transferValueOnlyIdentifier),
+				Address:    testscommon.TestPubKeyAlice,
+				Topics:     [][]byte{{}, newAddress},
+				Data:       [][]byte{[]byte(deploySmartContractLabel), []byte("init")},
+			},
+		},
+	}, nil)
+
+	frames := tracer.StopTracing()
+	require.Len(t, frames, 1)
+	require.Equal(t, testscommon.RealWorldBech32PubkeyConverter.SilentEncode(newAddress, log), frames[0].Recipient)
+	require.Empty(t, frames[0].Calls)
+	require.Len(t, frames[0].StorageAccesses, 1)
+}
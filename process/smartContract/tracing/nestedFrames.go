@@ -0,0 +1,254 @@
+package tracing
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const (
+	transferValueOnlyIdentifier = "transferValueOnly"
+
+	executeOnDestContextLabel = "ExecuteOnDestContext"
+	executeOnSameContextLabel = "ExecuteOnSameContext"
+	asyncCallLabel            = "AsyncCall"
+	asyncCallbackLabel        = "AsyncCallback"
+	transferAndExecuteLabel   = "TransferAndExecute"
+	upgradeFromSourceLabel    = "UpgradeFromSource"
+	deploySmartContractLabel  = "DeploySmartContract"
+	deployFromSourceLabel     = "DeployFromSource"
+)
+
+// callTypesByLabel holds the labels the VM writes as the first data field of the transferValueOnly log entry of each
+// call it executes or sends. The other labels (simple transfers and back transfers) are not calls
+var callTypesByLabel = map[string]vm.CallType{
+	executeOnDestContextLabel: vm.DirectCall,
+	executeOnSameContextLabel: vm.DirectCall,
+	asyncCallLabel:            vm.AsynchronousCall,
+	asyncCallbackLabel:        vm.AsynchronousCallBack,
+	transferAndExecuteLabel:   vm.ESDTTransferAndExecute,
+	upgradeFromSourceLabel:    vm.DirectCall,
+	deploySmartContractLabel:  vm.DirectCall,
+	deployFromSourceLabel:     vm.DirectCall,
+}
+
+// callTree is used to attach the built-in function calls, the storage accesses and the output transfers to the frame
+// which produced them, before writing them in the trace frames
+type callTree struct {
+	traceFrame      *data.TraceFrame
+	builtInCalls    []*data.TraceFrame
+	nestedCalls     []*callTree
+	outputTransfers []*data.TraceFrame
+	storageAccesses []*data.TraceStorageAccess
+	isSent          bool
+}
+
+// addVMOutputToFrame splits the execution reported by the VM output into frames. The calls the VM executed itself
+// (synchronous calls to other contracts, intra-shard asynchronous calls and their callbacks) are rebuilt from the
+// transferValueOnly log entries, in execution order. The calls sent to other shards are matched with their output
+// transfers, which hold the gas provided to them. The built-in function calls and the storage reads received by the
+// blockchain hook while the frame was open, together with the storage updates and the gas used reported for each
+// account, are then moved to the frame of the contract which produced them.
+func (tracer *executionTracer) addVMOutputToFrame(traceFrame *data.TraceFrame, vmOutput *vmcommon.VMOutput) {
+	root := &callTree{
+		traceFrame:      traceFrame,
+		builtInCalls:    traceFrame.Calls,
+		storageAccesses: traceFrame.StorageAccesses,
+	}
+	traceFrame.Calls = nil
+	traceFrame.StorageAccesses = nil
+
+	nestedCalls := tracer.createNestedCalls(root, vmOutput.Logs)
+	builtInCalls := root.builtInCalls
+	storageAccesses := root.storageAccesses
+	root.builtInCalls = nil
+	root.storageAccesses = nil
+
+	addresses := getSortedOutputAccountsAddresses(vmOutput.OutputAccounts)
+	for _, address := range addresses {
+		tracer.addOutputTransfers(root, nestedCalls, vmOutput.OutputAccounts[address])
+	}
+	for _, builtInCall := range builtInCalls {
+		owner := findCallOwner(root, nestedCalls, builtInCall.Caller)
+		owner.builtInCalls = append(owner.builtInCalls, builtInCall)
+	}
+	for _, storageAccess := range storageAccesses {
+		owner := findCallOwner(root, nestedCalls, storageAccess.Address)
+		owner.storageAccesses = append(owner.storageAccesses, storageAccess)
+	}
+	for _, address := range addresses {
+		outputAccount := vmOutput.OutputAccounts[address]
+		encodedAddress := tracer.encodeAddress(outputAccount.Address)
+		owner := findCallOwner(root, nestedCalls, encodedAddress)
+		owner.storageAccesses = append(owner.storageAccesses, createStorageAccesses(encodedAddress, outputAccount.StorageUpdates)...)
+		if owner != root {
+			owner.traceFrame.GasUsed = outputAccount.GasUsed
+		}
+	}
+
+	root.writeInTraceFrames()
+}
+
+// createNestedCalls returns the calls found in the transferValueOnly log entries, in execution order, after linking
+// each one to its caller. A call is started by the last open call executing its caller, while a callback is a sibling
+// of the asynchronous call it answers
+func (tracer *executionTracer) createNestedCalls(root *callTree, logs []*vmcommon.LogEntry) []*callTree {
+	nestedCalls := make([]*callTree, 0)
+	openCalls := []*callTree{root}
+	for _, logEntry := range logs {
+		if logEntry == nil || string(logEntry.Identifier) != transferValueOnlyIdentifier {
+			continue
+		}
+		if len(logEntry.Topics) < 2 || len(logEntry.Data) < 2 {
+			continue
+		}
+
+		label := string(logEntry.Data[0])
+		callType, isCall := callTypesByLabel[label]
+		if !isCall {
+			continue
+		}
+
+		caller := tracer.encodeAddress(logEntry.Address)
+		recipient := tracer.encodeAddress(logEntry.Topics[1])
+		isDeploy := label == deploySmartContractLabel || label == deployFromSourceLabel
+		isRootDeploy := isDeploy && root.traceFrame.Type == contractDeployFrame &&
+			len(root.traceFrame.Recipient) == 0 && root.traceFrame.Caller == caller
+		if isRootDeploy {
+			root.traceFrame.Recipient = recipient
+			continue
+		}
+
+		frameType := contractCallFrame
+		if isDeploy {
+			frameType = contractDeployFrame
+		}
+		nestedCall := &callTree{
+			traceFrame: &data.TraceFrame{
+				Type:      frameType,
+				CallType:  callType.ToString(),
+				Caller:    caller,
+				Recipient: recipient,
+				Function:  string(logEntry.Data[1]),
+				Arguments: encodeToHexSlice(logEntry.Data[2:]),
+				Value:     big.NewInt(0).SetBytes(logEntry.Topics[0]).String(),
+			},
+		}
+
+		parentIndex := findParentIndex(openCalls, nestedCall.traceFrame)
+		parent := openCalls[parentIndex]
+		parent.nestedCalls = append(parent.nestedCalls, nestedCall)
+		openCalls = append(openCalls[:parentIndex+1], nestedCall)
+		nestedCalls = append(nestedCalls, nestedCall)
+	}
+
+	return nestedCalls
+}
+
+func findParentIndex(openCalls []*callTree, traceFrame *data.TraceFrame) int {
+	if traceFrame.CallType == vm.AsynchronousCallBackStr {
+		for i := len(openCalls) - 1; i > 0; i-- {
+			asyncCall := openCalls[i].traceFrame
+			isAnsweredAsyncCall := asyncCall.CallType == vm.AsynchronousCallStr &&
+				asyncCall.Recipient == traceFrame.Caller && asyncCall.Caller == traceFrame.Recipient
+			if isAnsweredAsyncCall {
+				return i - 1
+			}
+		}
+	}
+
+	for i := len(openCalls) - 1; i > 0; i-- {
+		if openCalls[i].traceFrame.Recipient == traceFrame.Caller {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// addOutputTransfers matches the output transfers of the provided account with the calls sent by the VM, which were
+// not executed in this shard. The transfers without a matching call are added as output transfer frames
+func (tracer *executionTracer) addOutputTransfers(root *callTree, nestedCalls []*callTree, outputAccount *vmcommon.OutputAccount) {
+	encodedAddress := tracer.encodeAddress(outputAccount.Address)
+	for _, outputTransfer := range outputAccount.OutputTransfers {
+		caller := tracer.encodeAddress(outputTransfer.SenderAddress)
+		value := bigIntToString(outputTransfer.Value)
+
+		sentCall := findSentCall(nestedCalls, caller, encodedAddress, value)
+		if sentCall != nil {
+			sentCall.isSent = true
+			sentCall.traceFrame.Type = outputTransferFrame
+			sentCall.traceFrame.Data = string(outputTransfer.Data)
+			sentCall.traceFrame.GasProvided = outputTransfer.GasLimit
+			continue
+		}
+
+		owner := findCallOwner(root, nestedCalls, caller)
+		owner.outputTransfers = append(owner.outputTransfers, &data.TraceFrame{
+			Type:        outputTransferFrame,
+			CallType:    outputTransfer.CallType.ToString(),
+			Caller:      caller,
+			Recipient:   encodedAddress,
+			Data:        string(outputTransfer.Data),
+			Value:       value,
+			GasProvided: outputTransfer.GasLimit,
+		})
+	}
+}
+
+func findSentCall(nestedCalls []*callTree, caller string, recipient string, value string) *callTree {
+	for _, nestedCall := range nestedCalls {
+		traceFrame := nestedCall.traceFrame
+		if nestedCall.isSent || traceFrame.Type != contractCallFrame {
+			continue
+		}
+		if traceFrame.Caller == caller && traceFrame.Recipient == recipient && traceFrame.Value == value {
+			return nestedCall
+		}
+	}
+
+	return nil
+}
+
+// findCallOwner returns the frame in which the provided contract was executed. A contract executed in several nested
+// frames is represented by the first of them, as the blockchain hook and the VM output do not tell them apart
+func findCallOwner(root *callTree, nestedCalls []*callTree, address string) *callTree {
+	if len(address) == 0 || root.traceFrame.Recipient == address {
+		return root
+	}
+
+	for _, nestedCall := range nestedCalls {
+		if !nestedCall.isSent && nestedCall.traceFrame.Recipient == address {
+			return nestedCall
+		}
+	}
+
+	return root
+}
+
+func (tree *callTree) writeInTraceFrames() {
+	tree.traceFrame.StorageAccesses = tree.storageAccesses
+	tree.traceFrame.Calls = append(tree.traceFrame.Calls, tree.builtInCalls...)
+	for _, nestedCall := range tree.nestedCalls {
+		nestedCall.writeInTraceFrames()
+		tree.traceFrame.Calls = append(tree.traceFrame.Calls, nestedCall.traceFrame)
+	}
+	tree.traceFrame.Calls = append(tree.traceFrame.Calls, tree.outputTransfers...)
+}
+
+func getSortedOutputAccountsAddresses(outputAccounts map[string]*vmcommon.OutputAccount) []string {
+	addresses := make([]string, 0, len(outputAccounts))
+	for address, outputAccount := range outputAccounts {
+		if outputAccount == nil {
+			continue
+		}
+
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	return addresses
+}
@@ -738,6 +738,27 @@ func (bbt *baseBlockTrack) RemoveLastNotarizedHeaders() {
 	bbt.selfNotarizer.RemoveLastNotarizedHeader()
 }
 
+// RemoveHeadersHigherThanNonce removes from the notarized and tracked lists the headers which belong to the given shard
+// and have the nonce higher than the given one
+func (bbt *baseBlockTrack) RemoveHeadersHigherThanNonce(shardID uint32, nonce uint64) {
+	bbt.crossNotarizer.RemoveNotarizedHeadersHigherThanNonce(shardID, nonce)
+	bbt.selfNotarizer.RemoveNotarizedHeadersHigherThanNonce(shardID, nonce)
+
+	bbt.mutHeaders.Lock()
+	defer bbt.mutHeaders.Unlock()
+
+	headersForShard, ok := bbt.headers[shardID]
+	if !ok {
+		return
+	}
+
+	for headersNonce := range headersForShard {
+		if headersNonce > nonce {
+			delete(headersForShard, headersNonce)
+		}
+	}
+}
+
 // RestoreToGenesis sets class variables to theirs initial values
 func (bbt *baseBlockTrack) RestoreToGenesis() {
 	bbt.crossNotarizer.RestoreNotarizedHeadersToGenesis()
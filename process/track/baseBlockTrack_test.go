@@ -2047,6 +2047,42 @@ func TestRemoveLastNotarizedHeaders_ShouldWork(t *testing.T) {
 	assert.Equal(t, shardArguments.StartHeaders[header.GetShardID()], lastSelfNotarizedHeader)
 }
 
+func TestRemoveHeadersHigherThanNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	shardArguments := CreateShardTrackerMockArguments()
+	sbt, _ := track.NewShardBlockTrack(shardArguments)
+
+	metaBlock1 := &block.MetaBlock{Nonce: 1}
+	metaBlock1Hash := []byte("hash1")
+	metaBlock2 := &block.MetaBlock{Nonce: 2}
+	metaBlock2Hash := []byte("hash2")
+	sbt.AddCrossNotarizedHeader(core.MetachainShardId, metaBlock1, metaBlock1Hash)
+	sbt.AddCrossNotarizedHeader(core.MetachainShardId, metaBlock2, metaBlock2Hash)
+	sbt.AddTrackedHeader(metaBlock1, metaBlock1Hash)
+	sbt.AddTrackedHeader(metaBlock2, metaBlock2Hash)
+
+	header := &block.Header{
+		ShardID: shardArguments.ShardCoordinator.SelfId(),
+		Nonce:   2,
+	}
+	headerHash := []byte("hash3")
+	sbt.AddSelfNotarizedHeader(header.GetShardID(), header, headerHash)
+
+	sbt.RemoveHeadersHigherThanNonce(core.MetachainShardId, 1)
+
+	lastCrossNotarizedHeader, _, _ := sbt.GetLastCrossNotarizedHeader(core.MetachainShardId)
+	lastSelfNotarizedHeader, _, _ := sbt.GetLastSelfNotarizedHeader(header.GetShardID())
+	trackedHeaders, _ := sbt.GetTrackedHeadersWithNonce(core.MetachainShardId, metaBlock2.GetNonce())
+
+	assert.Equal(t, metaBlock1, lastCrossNotarizedHeader)
+	assert.Equal(t, header, lastSelfNotarizedHeader)
+	assert.Equal(t, 0, len(trackedHeaders))
+
+	trackedHeaders, _ = sbt.GetTrackedHeadersWithNonce(core.MetachainShardId, metaBlock1.GetNonce())
+	assert.Equal(t, 1, len(trackedHeaders))
+}
+
 func TestRestoreToGenesis_ShouldWork(t *testing.T) {
 	t.Parallel()
 
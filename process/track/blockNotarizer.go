@@ -248,6 +248,27 @@ func (bn *blockNotarizer) RemoveLastNotarizedHeader() {
 	bn.mutNotarizedHeaders.Unlock()
 }
 
+// RemoveNotarizedHeadersHigherThanNonce removes from all the notarized lists the headers which belong to the given shard
+// and have the nonce higher than the given one. The caller should re-add a notarized header if a list remains empty
+func (bn *blockNotarizer) RemoveNotarizedHeadersHigherThanNonce(shardID uint32, nonce uint64) {
+	bn.mutNotarizedHeaders.Lock()
+	defer bn.mutNotarizedHeaders.Unlock()
+
+	for notarizedShardID, notarizedHeaders := range bn.notarizedHeaders {
+		headersInfo := make([]*HeaderInfo, 0, len(notarizedHeaders))
+		for _, hdrInfo := range notarizedHeaders {
+			shouldRemove := hdrInfo.Header.GetShardID() == shardID && hdrInfo.Header.GetNonce() > nonce
+			if shouldRemove {
+				continue
+			}
+
+			headersInfo = append(headersInfo, hdrInfo)
+		}
+
+		bn.notarizedHeaders[notarizedShardID] = headersInfo
+	}
+}
+
 // RestoreNotarizedHeadersToGenesis restores all notarized headers from each shard to the genesis value (nonce 0)
 func (bn *blockNotarizer) RestoreNotarizedHeadersToGenesis() {
 	bn.mutNotarizedHeaders.Lock()
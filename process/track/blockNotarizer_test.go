@@ -388,6 +388,34 @@ func TestRemoveLastNotarizedHeader_ShouldWork(t *testing.T) {
 	assert.Equal(t, &hdr2, lastNotarizedHeader)
 }
 
+func TestRemoveNotarizedHeadersHigherThanNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	bn, _ := track.NewBlockNotarizer(&hashingMocks.HasherMock{}, &mock.MarshalizerMock{}, mock.NewMultipleShardsCoordinatorMock())
+
+	hdr1 := block.Header{Nonce: 1}
+	hdr2 := block.Header{Nonce: 2}
+	hdr3 := block.Header{Nonce: 3}
+	bn.AddNotarizedHeader(0, &hdr1, nil)
+	bn.AddNotarizedHeader(0, &hdr2, nil)
+	bn.AddNotarizedHeader(0, &hdr3, nil)
+
+	otherShardHdr := block.Header{ShardID: 1, Nonce: 3}
+	bn.AddNotarizedHeader(1, &otherShardHdr, nil)
+
+	bn.RemoveNotarizedHeadersHigherThanNonce(0, 1)
+	lastNotarizedHeader, _, _ := bn.GetLastNotarizedHeader(0)
+
+	assert.Equal(t, 1, len(bn.GetNotarizedHeaders()[0]))
+	assert.Equal(t, &hdr1, lastNotarizedHeader)
+	assert.Equal(t, 1, len(bn.GetNotarizedHeaders()[1]))
+
+	bn.RemoveNotarizedHeadersHigherThanNonce(0, 0)
+
+	assert.Equal(t, 0, len(bn.GetNotarizedHeaders()[0]))
+	assert.Equal(t, 1, len(bn.GetNotarizedHeaders()[1]))
+}
+
 func TestRestoreNotarizedHeadersToGenesis_ShouldNotRestoreIfExistOnlyOneNotarizedHeader(t *testing.T) {
 	t.Parallel()
 
@@ -15,6 +15,7 @@ type blockNotarizerHandler interface {
 	GetNotarizedHeader(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error)
 	InitNotarizedHeaders(startHeaders map[uint32]data.HeaderHandler) error
 	RemoveLastNotarizedHeader()
+	RemoveNotarizedHeadersHigherThanNonce(shardID uint32, nonce uint64)
 	RestoreNotarizedHeadersToGenesis()
 	IsInterfaceNil() bool
 }
@@ -2,11 +2,117 @@ package data
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
 // SimulationResultsWithVMOutput is the data transfer object which will hold results for simulation a transaction's execution
 type SimulationResultsWithVMOutput struct {
 	transaction.SimulationResults
-	VMOutput *vmcommon.VMOutput `json:"-"`
+	VMOutput  *vmcommon.VMOutput  `json:"-"`
+	StateDiff []*AccountStateDiff `json:"stateDiff,omitempty"`
+}
+
+// TransactionTrace is the data transfer object which will hold the results of a transaction's execution together with
+// the execution frames recorded while processing it
+type TransactionTrace struct {
+	transaction.SimulationResults
+	Frames []*TraceFrame `json:"frames"`
+}
+
+// TraceFrame holds the details of a single execution frame: a smart contract call or deploy (including the nested
+// synchronous calls, the asynchronous calls and their callbacks executed by the VM), a built-in function invocation or
+// an output transfer produced by the parent frame
+type TraceFrame struct {
+	Type            string                `json:"type"`
+	CallType        string                `json:"callType,omitempty"`
+	Caller          string                `json:"caller,omitempty"`
+	Recipient       string                `json:"recipient,omitempty"`
+	Function        string                `json:"function,omitempty"`
+	Arguments       []string              `json:"arguments,omitempty"`
+	Data            string                `json:"data,omitempty"`
+	Value           string                `json:"value,omitempty"`
+	GasProvided     uint64                `json:"gasProvided"`
+	GasRemaining    uint64                `json:"gasRemaining"`
+	GasUsed         uint64                `json:"gasUsed"`
+	ReturnCode      string                `json:"returnCode,omitempty"`
+	ReturnMessage   string                `json:"returnMessage,omitempty"`
+	ReturnData      []string              `json:"returnData,omitempty"`
+	Error           string                `json:"error,omitempty"`
+	StorageAccesses []*TraceStorageAccess `json:"storageAccesses,omitempty"`
+	Calls           []*TraceFrame         `json:"calls,omitempty"`
+}
+
+// TraceStorageAccess holds a storage read or write recorded in an execution frame
+type TraceStorageAccess struct {
+	Address string `json:"address"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Written bool   `json:"written"`
+}
+
+// ReplayResults is the data transfer object which will hold the results of re-executing an already executed
+// transaction on top of the state its block was built on
+type ReplayResults struct {
+	SimulationResultsWithVMOutput
+	VMOutputApi             *vm.VMOutputApi `json:"vmOutput,omitempty"`
+	BlockHash               string          `json:"blockHash"`
+	BlockNonce              uint64          `json:"blockNonce"`
+	PreBlockRootHash        string          `json:"preBlockRootHash"`
+	NumReplayedTransactions int             `json:"numReplayedTransactions"`
+	Diff                    *ReplayDiff     `json:"diff,omitempty"`
+}
+
+// ReplayDiff holds the differences between the results of a re-executed transaction and the stored ones. The statuses
+// are informative only, as the simulation does not compute the final status of a transaction
+type ReplayDiff struct {
+	Matches             bool     `json:"matches"`
+	StoredStatus        string   `json:"storedStatus"`
+	ReplayedStatus      string   `json:"replayedStatus"`
+	MissingScResults    []string `json:"missingScResults,omitempty"`
+	UnexpectedScResults []string `json:"unexpectedScResults,omitempty"`
+	StoredLogEvents     int      `json:"storedLogEvents"`
+	ReplayedLogEvents   int      `json:"replayedLogEvents"`
+	MismatchedLogEvents []int    `json:"mismatchedLogEvents,omitempty"`
+}
+
+// BundleSimulationResults is the data transfer object which will hold the results of simulating an ordered list of
+// transactions on top of the same state
+type BundleSimulationResults struct {
+	Results      []*SimulationResultsWithVMOutput `json:"results"`
+	StateChanges []*AccountStateDiff              `json:"stateChanges"`
+}
+
+// AccountStateDiff holds the values of an account's fields, ESDT balances and data trie keys before and after the
+// execution of one or more transactions
+type AccountStateDiff struct {
+	Address               string             `json:"address"`
+	NonceBefore           uint64             `json:"nonceBefore"`
+	NonceAfter            uint64             `json:"nonceAfter"`
+	BalanceBefore         string             `json:"balanceBefore"`
+	BalanceAfter          string             `json:"balanceAfter"`
+	DeveloperRewardBefore string             `json:"developerRewardBefore"`
+	DeveloperRewardAfter  string             `json:"developerRewardAfter"`
+	CodeHashBefore        string             `json:"codeHashBefore,omitempty"`
+	CodeHashAfter         string             `json:"codeHashAfter,omitempty"`
+	UsernameBefore        string             `json:"usernameBefore,omitempty"`
+	UsernameAfter         string             `json:"usernameAfter,omitempty"`
+	ESDTBalances          []*ESDTBalanceDiff `json:"esdtBalances,omitempty"`
+	Storage               []*StorageDiff     `json:"storage,omitempty"`
+}
+
+// ESDTBalanceDiff holds the balance of an ESDT, SFT or NFT before and after the execution of one or more transactions
+type ESDTBalanceDiff struct {
+	Identifier    string `json:"identifier"`
+	Nonce         uint64 `json:"nonce,omitempty"`
+	BalanceBefore string `json:"balanceBefore"`
+	BalanceAfter  string `json:"balanceAfter"`
+}
+
+// StorageDiff holds the hex encoded value of a data trie key before and after the execution of one or more
+// transactions
+type StorageDiff struct {
+	Key         string `json:"key"`
+	ValueBefore string `json:"valueBefore"`
+	ValueAfter  string `json:"valueAfter"`
 }
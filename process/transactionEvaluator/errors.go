@@ -28,3 +28,21 @@ var ErrNilHasher = errors.New("nil hasher provided")
 
 // ErrNilDataFieldParser signals that a nil data field parser has been provided
 var ErrNilDataFieldParser = errors.New("nil data field parser")
+
+// ErrNilBlockInfoSetter signals that a nil block info setter has been provided
+var ErrNilBlockInfoSetter = errors.New("nil block info setter")
+
+// ErrNilAccountsRepository signals that a nil accounts repository has been provided
+var ErrNilAccountsRepository = errors.New("nil accounts repository")
+
+// ErrTransactionNotFoundInBlock signals that the transaction was not found in the miniblocks of the provided block
+var ErrTransactionNotFoundInBlock = errors.New("transaction not found in block")
+
+// ErrPreBlockStateNotAvailable signals that the state the block was built on is no longer available
+var ErrPreBlockStateNotAvailable = errors.New("the state the block was built on is not available")
+
+// ErrEmptyTransactionsBundle signals that an empty bundle of transactions has been provided
+var ErrEmptyTransactionsBundle = errors.New("empty transactions bundle")
+
+// ErrTooManyTransactionsInBundle signals that the bundle of transactions exceeds the maximum allowed size
+var ErrTooManyTransactionsInBundle = errors.New("too many transactions in bundle")
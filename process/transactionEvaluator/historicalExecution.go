@@ -0,0 +1,192 @@
+package transactionEvaluator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// historicalExecutionContext holds the data needed to re-execute a transaction of an already committed block
+type historicalExecutionContext struct {
+	header       data.HeaderHandler
+	preBlockInfo common.BlockInfo
+	precedingTxs []*transaction.Transaction
+	targetTx     *transaction.Transaction
+}
+
+// prepareHistoricalExecution moves the simulation state on the state the provided block was built on and replays the
+// block's transactions preceding the target one. The smart contract results received from other shards are not
+// replayed, so the state might differ if the block also executed such results before the target transaction
+func (ate *apiTransactionEvaluator) prepareHistoricalExecution(txHash []byte, blockHash []byte) (*historicalExecutionContext, error) {
+	header, err := ate.getHeaderFromStorage(blockHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the transaction's block", err)
+	}
+
+	previousHeader, err := ate.getHeaderFromStorage(header.GetPrevHash())
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the block preceding the transaction's block", err)
+	}
+
+	precedingTxs, targetTx, err := ate.getBlockTransactionsUntil(header, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	preBlockInfo := holders.NewBlockInfo(header.GetPrevHash(), previousHeader.GetNonce(), getPreBlockRootHash(previousHeader))
+	err = ate.checkPreBlockStateIsAvailable(targetTx, preBlockInfo, previousHeader.GetEpoch())
+	if err != nil {
+		return nil, err
+	}
+
+	ate.accounts.CleanCache()
+	ate.blockInfoSetter.SetBlockInfo(preBlockInfo)
+
+	for _, tx := range precedingTxs {
+		_, err = ate.txSimulator.ProcessTx(tx, header)
+		if err != nil {
+			return nil, fmt.Errorf("%w while replaying the transactions preceding the requested one", err)
+		}
+	}
+
+	return &historicalExecutionContext{
+		header:       header,
+		preBlockInfo: preBlockInfo,
+		precedingTxs: precedingTxs,
+		targetTx:     targetTx,
+	}, nil
+}
+
+func (ate *apiTransactionEvaluator) resetHistoricalExecution() {
+	ate.blockInfoSetter.SetBlockInfo(nil)
+	ate.accounts.CleanCache()
+}
+
+// getPreBlockRootHash returns the root hash of the state the block following the provided one was built on. When
+// scheduled transactions were executed in the provided block, the next block starts from the scheduled root hash
+func getPreBlockRootHash(previousHeader data.HeaderHandler) []byte {
+	additionalData := previousHeader.GetAdditionalData()
+	if !check.IfNil(additionalData) && len(additionalData.GetScheduledRootHash()) > 0 {
+		return additionalData.GetScheduledRootHash()
+	}
+
+	return previousHeader.GetRootHash()
+}
+
+func (ate *apiTransactionEvaluator) checkPreBlockStateIsAvailable(tx *transaction.Transaction, preBlockInfo common.BlockInfo, epoch uint32) error {
+	address := tx.GetSndAddr()
+	if ate.shardCoordinator.ComputeId(address) != ate.shardCoordinator.SelfId() {
+		address = tx.GetRcvAddr()
+	}
+
+	options := api.AccountQueryOptions{
+		BlockRootHash: preBlockInfo.GetRootHash(),
+		HintEpoch:     core.OptionalUint32{Value: epoch, HasValue: true},
+	}
+	_, _, err := ate.accountsRepository.GetAccountWithBlockInfo(address, options)
+	if err == nil {
+		return nil
+	}
+
+	var accountNotFoundErr *state.ErrAccountNotFoundAtBlock
+	if errors.As(err, &accountNotFoundErr) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrPreBlockStateNotAvailable, err.Error())
+}
+
+func (ate *apiTransactionEvaluator) getBlockTransactionsUntil(header data.HeaderHandler, txHash []byte) ([]*transaction.Transaction, *transaction.Transaction, error) {
+	precedingTxs := make([]*transaction.Transaction, 0)
+	for _, miniBlockHeader := range header.GetMiniBlockHeaderHandlers() {
+		if !shouldReplayMiniBlock(miniBlockHeader) {
+			continue
+		}
+
+		miniBlock, err := ate.getMiniBlockFromStorage(miniBlockHeader.GetHash())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		firstIndex := int(miniBlockHeader.GetIndexOfFirstTxProcessed())
+		if firstIndex < 0 {
+			firstIndex = 0
+		}
+		lastIndex := int(miniBlockHeader.GetIndexOfLastTxProcessed())
+		if lastIndex >= len(miniBlock.TxHashes) {
+			lastIndex = len(miniBlock.TxHashes) - 1
+		}
+
+		for index := firstIndex; index <= lastIndex; index++ {
+			tx, err := ate.getTransactionFromStorage(miniBlock.TxHashes[index])
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if bytes.Equal(miniBlock.TxHashes[index], txHash) {
+				return precedingTxs, tx, nil
+			}
+
+			precedingTxs = append(precedingTxs, tx)
+		}
+	}
+
+	return nil, nil, ErrTransactionNotFoundInBlock
+}
+
+// shouldReplayMiniBlock returns true for the transactions miniblocks executed in the block. The miniblocks marked as
+// processed were already executed, as scheduled, in the previous block
+func shouldReplayMiniBlock(miniBlockHeader data.MiniBlockHeaderHandler) bool {
+	if miniBlockHeader.GetProcessingType() == int32(block.Processed) {
+		return false
+	}
+
+	miniBlockType := block.Type(miniBlockHeader.GetTypeInt32())
+
+	return miniBlockType == block.TxBlock || miniBlockType == block.InvalidBlock
+}
+
+func (ate *apiTransactionEvaluator) getHeaderFromStorage(hash []byte) (data.HeaderHandler, error) {
+	return process.GetHeaderFromStorage(ate.shardCoordinator.SelfId(), hash, ate.marshaller, ate.storageService)
+}
+
+func (ate *apiTransactionEvaluator) getMiniBlockFromStorage(hash []byte) (*block.MiniBlock, error) {
+	miniBlockBytes, err := ate.storageService.Get(dataRetriever.MiniBlockUnit, hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading miniblock %x", err, hash)
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = ate.marshaller.Unmarshal(miniBlock, miniBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return miniBlock, nil
+}
+
+func (ate *apiTransactionEvaluator) getTransactionFromStorage(hash []byte) (*transaction.Transaction, error) {
+	txHandler, err := process.GetTransactionHandlerFromStorage(hash, ate.storageService, ate.marshaller)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading transaction %x", err, hash)
+	}
+
+	tx, ok := txHandler.(*transaction.Transaction)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return tx, nil
+}
@@ -2,6 +2,9 @@ package transactionEvaluator
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
@@ -17,3 +20,23 @@ type TransactionProcessor interface {
 type DataFieldParser interface {
 	Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData
 }
+
+// ExecutionTracingHandler defines what an execution tracer should be able to do when used by the transaction simulator
+type ExecutionTracingHandler interface {
+	StartTracing()
+	StopTracing() []*txSimData.TraceFrame
+	IsInterfaceNil() bool
+}
+
+// SimulationAccountsAdapter defines the accounts adapter used by the simulations, which keeps the touched accounts in
+// a cache until it is cleaned
+type SimulationAccountsAdapter interface {
+	state.AccountsAdapterWithClean
+	GetTouchedAddresses() [][]byte
+}
+
+// BlockInfoSetter defines a component able to pin the simulation state on a given block
+type BlockInfoSetter interface {
+	SetBlockInfo(blockInfo common.BlockInfo)
+	IsInterfaceNil() bool
+}
@@ -1,7 +1,9 @@
 package transactionEvaluator
 
 import (
+	"bytes"
 	"context"
+	"sort"
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -189,6 +191,22 @@ func (r *simulationAccountsDB) CleanCache() {
 	r.mutex.Unlock()
 }
 
+// GetTouchedAddresses returns the sorted addresses of the accounts loaded or saved since the cache was last cleaned
+func (r *simulationAccountsDB) GetTouchedAddresses() [][]byte {
+	r.mutex.RLock()
+	addresses := make([][]byte, 0, len(r.cachedAccounts))
+	for address := range r.cachedAccounts {
+		addresses = append(addresses, []byte(address))
+	}
+	r.mutex.RUnlock()
+
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i], addresses[j]) < 0
+	})
+
+	return addresses
+}
+
 func (r *simulationAccountsDB) addToCache(account vmcommon.AccountHandler) {
 	r.mutex.Lock()
 	r.cachedAccounts[string(account.AddressBytes())] = account
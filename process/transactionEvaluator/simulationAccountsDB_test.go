@@ -155,3 +155,23 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	err = allLeaves.ErrChan.ReadFromChanNonBlocking()
 	require.NoError(t, err)
 }
+
+func TestSimulationAccountsDB_GetTouchedAddresses(t *testing.T) {
+	t.Parallel()
+
+	accDb := &stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return stateMock.NewAccountWrapMock(address), nil
+		},
+	}
+	simAccountsDB, _ := NewSimulationAccountsDB(accDb)
+	require.Empty(t, simAccountsDB.GetTouchedAddresses())
+
+	_, _ = simAccountsDB.LoadAccount([]byte("address2"))
+	_ = simAccountsDB.SaveAccount(stateMock.NewAccountWrapMock([]byte("address1")))
+	_, _ = simAccountsDB.LoadAccount([]byte("address2"))
+	require.Equal(t, [][]byte{[]byte("address1"), []byte("address2")}, simAccountsDB.GetTouchedAddresses())
+
+	simAccountsDB.CleanCache()
+	require.Empty(t, simAccountsDB.GetTouchedAddresses())
+}
@@ -0,0 +1,209 @@
+package transactionEvaluator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+const esdtKeyPrefix = core.ProtectedKeyPrefix + core.ESDTKeyIdentifier
+
+type accountSnapshot struct {
+	nonce           uint64
+	balance         *big.Int
+	developerReward *big.Int
+	codeHash        []byte
+	userName        []byte
+	dataKeys        [][]byte
+	dataValues      map[string][]byte
+}
+
+// computeStateDiff compares the accounts touched since the simulation accounts' cache was last cleaned against their
+// state before the simulation. The values after the simulation are read from the cached accounts, the data trie keys
+// being the ones found in their dirty data. The values before the simulation are read once the cache is dropped, so
+// the method should be called only after all the transactions were processed
+func (ate *apiTransactionEvaluator) computeStateDiff() ([]*txSimData.AccountStateDiff, error) {
+	addresses := ate.accounts.GetTouchedAddresses()
+
+	snapshotsAfter := make(map[string]*accountSnapshot, len(addresses))
+	for _, address := range addresses {
+		account, err := ate.loadUserAccount(address)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshotsAfter[string(address)], err = createAccountSnapshot(account, account.GetDirtyDataKeys())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ate.accounts.CleanCache()
+
+	stateDiff := make([]*txSimData.AccountStateDiff, 0, len(addresses))
+	for _, address := range addresses {
+		account, err := ate.loadUserAccount(address)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshotAfter := snapshotsAfter[string(address)]
+		snapshotBefore, err := createAccountSnapshot(account, snapshotAfter.dataKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		accountDiff, changed := ate.createAccountStateDiff(address, snapshotBefore, snapshotAfter)
+		if !changed {
+			continue
+		}
+
+		stateDiff = append(stateDiff, accountDiff)
+	}
+
+	return stateDiff, nil
+}
+
+func (ate *apiTransactionEvaluator) loadUserAccount(address []byte) (state.UserAccountHandler, error) {
+	accountHandler, err := ate.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	account, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return account, nil
+}
+
+func createAccountSnapshot(account state.UserAccountHandler, dataKeys [][]byte) (*accountSnapshot, error) {
+	snapshot := &accountSnapshot{
+		nonce:           account.GetNonce(),
+		balance:         getBigIntOrZero(account.GetBalance()),
+		developerReward: getBigIntOrZero(account.GetDeveloperReward()),
+		codeHash:        account.GetCodeHash(),
+		userName:        account.GetUserName(),
+		dataKeys:        dataKeys,
+		dataValues:      make(map[string][]byte, len(dataKeys)),
+	}
+
+	for _, key := range dataKeys {
+		value, _, err := account.RetrieveValue(key)
+		if errors.Is(err, state.ErrNilTrie) {
+			// the account had no data trie before the simulation
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot.dataValues[string(key)] = value
+	}
+
+	return snapshot, nil
+}
+
+func (ate *apiTransactionEvaluator) createAccountStateDiff(
+	address []byte,
+	snapshotBefore *accountSnapshot,
+	snapshotAfter *accountSnapshot,
+) (*txSimData.AccountStateDiff, bool) {
+	accountDiff := &txSimData.AccountStateDiff{
+		Address:               ate.pubkeyConverter.SilentEncode(address, log),
+		NonceBefore:           snapshotBefore.nonce,
+		NonceAfter:            snapshotAfter.nonce,
+		BalanceBefore:         snapshotBefore.balance.String(),
+		BalanceAfter:          snapshotAfter.balance.String(),
+		DeveloperRewardBefore: snapshotBefore.developerReward.String(),
+		DeveloperRewardAfter:  snapshotAfter.developerReward.String(),
+		CodeHashBefore:        hex.EncodeToString(snapshotBefore.codeHash),
+		CodeHashAfter:         hex.EncodeToString(snapshotAfter.codeHash),
+		UsernameBefore:        string(snapshotBefore.userName),
+		UsernameAfter:         string(snapshotAfter.userName),
+	}
+
+	for _, key := range snapshotAfter.dataKeys {
+		valueBefore := snapshotBefore.dataValues[string(key)]
+		valueAfter := snapshotAfter.dataValues[string(key)]
+		if bytes.Equal(valueBefore, valueAfter) {
+			continue
+		}
+
+		esdtBalanceDiff, isESDTKey := ate.createESDTBalanceDiff(key, valueBefore, valueAfter)
+		if isESDTKey {
+			accountDiff.ESDTBalances = append(accountDiff.ESDTBalances, esdtBalanceDiff)
+			continue
+		}
+
+		accountDiff.Storage = append(accountDiff.Storage, &txSimData.StorageDiff{
+			Key:         hex.EncodeToString(key),
+			ValueBefore: hex.EncodeToString(valueBefore),
+			ValueAfter:  hex.EncodeToString(valueAfter),
+		})
+	}
+
+	accountFieldsChanged := snapshotBefore.nonce != snapshotAfter.nonce ||
+		snapshotBefore.balance.Cmp(snapshotAfter.balance) != 0 ||
+		snapshotBefore.developerReward.Cmp(snapshotAfter.developerReward) != 0 ||
+		!bytes.Equal(snapshotBefore.codeHash, snapshotAfter.codeHash) ||
+		!bytes.Equal(snapshotBefore.userName, snapshotAfter.userName)
+	changed := accountFieldsChanged || len(accountDiff.ESDTBalances) > 0 || len(accountDiff.Storage) > 0
+
+	return accountDiff, changed
+}
+
+func (ate *apiTransactionEvaluator) createESDTBalanceDiff(key []byte, valueBefore []byte, valueAfter []byte) (*txSimData.ESDTBalanceDiff, bool) {
+	if !bytes.HasPrefix(key, []byte(esdtKeyPrefix)) {
+		return nil, false
+	}
+
+	balanceBefore, err := ate.getESDTBalance(valueBefore)
+	if err != nil {
+		return nil, false
+	}
+	balanceAfter, err := ate.getESDTBalance(valueAfter)
+	if err != nil {
+		return nil, false
+	}
+
+	tokenID, nonce := common.ExtractTokenIDAndNonceFromTokenStorageKey(key[len(esdtKeyPrefix):])
+
+	return &txSimData.ESDTBalanceDiff{
+		Identifier:    string(tokenID),
+		Nonce:         nonce,
+		BalanceBefore: balanceBefore.String(),
+		BalanceAfter:  balanceAfter.String(),
+	}, true
+}
+
+func (ate *apiTransactionEvaluator) getESDTBalance(value []byte) (*big.Int, error) {
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err := ate.marshaller.Unmarshal(esdtToken, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return getBigIntOrZero(esdtToken.Value), nil
+}
+
+func getBigIntOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(value)
+}
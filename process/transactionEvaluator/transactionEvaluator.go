@@ -1,6 +1,7 @@
 package transactionEvaluator
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -11,7 +12,9 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
@@ -24,26 +27,37 @@ import (
 const dummySignature = "01010101"
 const gasRemainedSplitString = "gas remained = "
 const gasUsedSlitString = "gas used = "
+const maxTransactionsInBundle = 100
 
 // ArgsApiTransactionEvaluator holds the arguments required for creating a new transaction evaluator
 type ArgsApiTransactionEvaluator struct {
 	TxTypeHandler       process.TxTypeHandler
 	FeeHandler          process.FeeHandler
 	TxSimulator         facade.TransactionSimulatorProcessor
-	Accounts            state.AccountsAdapterWithClean
+	Accounts            SimulationAccountsAdapter
 	ShardCoordinator    sharding.Coordinator
 	EnableEpochsHandler common.EnableEpochsHandler
 	BlockChain          data.ChainHandler
+	BlockInfoSetter     BlockInfoSetter
+	StorageService      dataRetriever.StorageService
+	Marshaller          marshal.Marshalizer
+	AccountsRepository  state.AccountsRepository
+	PubkeyConverter     core.PubkeyConverter
 }
 
 type apiTransactionEvaluator struct {
-	accounts            state.AccountsAdapterWithClean
+	accounts            SimulationAccountsAdapter
 	shardCoordinator    sharding.Coordinator
 	txTypeHandler       process.TxTypeHandler
 	feeHandler          process.FeeHandler
 	txSimulator         facade.TransactionSimulatorProcessor
 	enableEpochsHandler common.EnableEpochsHandler
 	blockChain          data.ChainHandler
+	blockInfoSetter     BlockInfoSetter
+	storageService      dataRetriever.StorageService
+	marshaller          marshal.Marshalizer
+	accountsRepository  state.AccountsRepository
+	pubkeyConverter     core.PubkeyConverter
 	mutExecution        sync.RWMutex
 }
 
@@ -70,6 +84,21 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 	if check.IfNil(args.BlockChain) {
 		return nil, process.ErrNilBlockChain
 	}
+	if check.IfNil(args.BlockInfoSetter) {
+		return nil, ErrNilBlockInfoSetter
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, process.ErrNilStorageService
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.AccountsRepository) {
+		return nil, ErrNilAccountsRepository
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
 	err := core.CheckHandlerCompatibility(args.EnableEpochsHandler, []core.EnableEpochFlag{
 		common.CleanUpInformativeSCRsFlag,
 	})
@@ -85,13 +114,83 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 		shardCoordinator:    args.ShardCoordinator,
 		enableEpochsHandler: args.EnableEpochsHandler,
 		blockChain:          args.BlockChain,
+		blockInfoSetter:     args.BlockInfoSetter,
+		storageService:      args.StorageService,
+		marshaller:          args.Marshaller,
+		accountsRepository:  args.AccountsRepository,
+		pubkeyConverter:     args.PubkeyConverter,
 	}
 
 	return tce, nil
 }
 
-// SimulateTransactionExecution will simulate a transaction's execution and will return the results
-func (ate *apiTransactionEvaluator) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+// SimulateTransactionExecution will simulate a transaction's execution and will return the results. If withStateDiff
+// is set, the results will also contain the before and after values of every account field and data trie key touched
+// by the simulation
+func (ate *apiTransactionEvaluator) SimulateTransactionExecution(tx *transaction.Transaction, withStateDiff bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+	ate.mutExecution.Lock()
+	defer func() {
+		ate.accounts.CleanCache()
+		ate.mutExecution.Unlock()
+	}()
+
+	currentHeader := ate.getCurrentBlockHeader()
+
+	results, err := ate.txSimulator.ProcessTx(tx, currentHeader)
+	if err != nil || !withStateDiff {
+		return results, err
+	}
+
+	results.StateDiff, err = ate.computeStateDiff()
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// SimulateTransactionsBundle will simulate the execution of the provided transactions, in the given order, on top of
+// the same simulation state, so that each transaction sees the changes produced by the previous ones. It will return
+// the results of each transaction together with the aggregated state changes
+func (ate *apiTransactionEvaluator) SimulateTransactionsBundle(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if len(txs) == 0 {
+		return nil, ErrEmptyTransactionsBundle
+	}
+	if len(txs) > maxTransactionsInBundle {
+		return nil, fmt.Errorf("%w, provided %d, maximum %d", ErrTooManyTransactionsInBundle, len(txs), maxTransactionsInBundle)
+	}
+
+	ate.mutExecution.Lock()
+	defer func() {
+		ate.accounts.CleanCache()
+		ate.mutExecution.Unlock()
+	}()
+
+	currentHeader := ate.getCurrentBlockHeader()
+	results := make([]*txSimData.SimulationResultsWithVMOutput, 0, len(txs))
+	for idx, tx := range txs {
+		result, err := ate.txSimulator.ProcessTx(tx, currentHeader)
+		if err != nil {
+			return nil, fmt.Errorf("%w for transaction at index %d", err, idx)
+		}
+
+		results = append(results, result)
+	}
+
+	stateChanges, err := ate.computeStateDiff()
+	if err != nil {
+		return nil, err
+	}
+
+	return &txSimData.BundleSimulationResults{
+		Results:      results,
+		StateChanges: stateChanges,
+	}, nil
+}
+
+// TraceTransactionExecution will simulate a transaction's execution and will return the results together with the
+// recorded execution frames
+func (ate *apiTransactionEvaluator) TraceTransactionExecution(tx *transaction.Transaction) (*txSimData.TransactionTrace, error) {
 	ate.mutExecution.Lock()
 	defer func() {
 		ate.accounts.CleanCache()
@@ -100,7 +199,53 @@ func (ate *apiTransactionEvaluator) SimulateTransactionExecution(tx *transaction
 
 	currentHeader := ate.getCurrentBlockHeader()
 
-	return ate.txSimulator.ProcessTx(tx, currentHeader)
+	return ate.txSimulator.TraceTx(tx, currentHeader)
+}
+
+// TraceExecutedTransaction will re-execute an already executed transaction against the state its block was built on,
+// after replaying the transactions preceding it in the same block, and will return the results together with the
+// recorded execution frames
+func (ate *apiTransactionEvaluator) TraceExecutedTransaction(txHash []byte, blockHash []byte) (*txSimData.TransactionTrace, error) {
+	ate.mutExecution.Lock()
+	defer func() {
+		ate.resetHistoricalExecution()
+		ate.mutExecution.Unlock()
+	}()
+
+	execContext, err := ate.prepareHistoricalExecution(txHash, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return ate.txSimulator.TraceTx(execContext.targetTx, execContext.header)
+}
+
+// ReplayExecutedTransaction will re-execute an already executed transaction against the state its block was built on,
+// after replaying the transactions preceding it in the same block, and will return the results of its execution
+func (ate *apiTransactionEvaluator) ReplayExecutedTransaction(txHash []byte, blockHash []byte) (*txSimData.ReplayResults, error) {
+	ate.mutExecution.Lock()
+	defer func() {
+		ate.resetHistoricalExecution()
+		ate.mutExecution.Unlock()
+	}()
+
+	execContext, err := ate.prepareHistoricalExecution(txHash, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := ate.txSimulator.ProcessTx(execContext.targetTx, execContext.header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txSimData.ReplayResults{
+		SimulationResultsWithVMOutput: *results,
+		BlockHash:                     hex.EncodeToString(blockHash),
+		BlockNonce:                    execContext.header.GetNonce(),
+		PreBlockRootHash:              hex.EncodeToString(execContext.preBlockInfo.GetRootHash()),
+		NumReplayedTransactions:       len(execContext.precedingTxs),
+	}, nil
 }
 
 // ComputeTransactionGasLimit will calculate how many gas units a transaction will consume
@@ -1,24 +1,37 @@
 package transactionEvaluator
 
 import (
+	"bytes"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"strings"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/require"
 )
@@ -28,13 +41,23 @@ func createArgs() ArgsApiTransactionEvaluator {
 		TxTypeHandler:       &testscommon.TxTypeHandlerMock{},
 		FeeHandler:          &economicsmocks.EconomicsHandlerStub{},
 		TxSimulator:         &mock.TransactionSimulatorStub{},
-		Accounts:            &stateMock.AccountsStub{},
+		Accounts:            createSimulationAccounts(&stateMock.AccountsStub{}),
 		ShardCoordinator:    &mock.ShardCoordinatorStub{},
 		EnableEpochsHandler: &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
 		BlockChain:          &testscommon.ChainHandlerMock{},
+		BlockInfoSetter:     &mock.BlockInfoSetterStub{},
+		StorageService:      genericMocks.NewChainStorerMock(0),
+		Marshaller:          &marshal.GogoProtoMarshalizer{},
+		AccountsRepository:  &stateMock.AccountsRepositoryStub{},
+		PubkeyConverter:     testscommon.RealWorldBech32PubkeyConverter,
 	}
 }
 
+func createSimulationAccounts(accountsAdapter state.AccountsAdapter) SimulationAccountsAdapter {
+	simulationAccounts, _ := NewSimulationAccountsDB(accountsAdapter)
+	return simulationAccounts
+}
+
 func TestTransactionEvaluator_NilTxTypeHandler(t *testing.T) {
 	t.Parallel()
 	args := createArgs()
@@ -97,6 +120,56 @@ func TestTransactionEvaluator_InvalidEnableEpochsHandlerShouldErr(t *testing.T)
 	require.True(t, errors.Is(err, core.ErrInvalidEnableEpochsHandler))
 }
 
+func TestTransactionEvaluator_NilBlockInfoSetterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.BlockInfoSetter = nil
+	tce, err := NewAPITransactionEvaluator(args)
+	require.Nil(t, tce)
+	require.Equal(t, ErrNilBlockInfoSetter, err)
+}
+
+func TestTransactionEvaluator_NilStorageServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.StorageService = nil
+	tce, err := NewAPITransactionEvaluator(args)
+	require.Nil(t, tce)
+	require.Equal(t, process.ErrNilStorageService, err)
+}
+
+func TestTransactionEvaluator_NilMarshallerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.Marshaller = nil
+	tce, err := NewAPITransactionEvaluator(args)
+	require.Nil(t, tce)
+	require.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestTransactionEvaluator_NilAccountsRepositoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.AccountsRepository = nil
+	tce, err := NewAPITransactionEvaluator(args)
+	require.Nil(t, tce)
+	require.Equal(t, ErrNilAccountsRepository, err)
+}
+
+func TestTransactionEvaluator_NilPubkeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.PubkeyConverter = nil
+	tce, err := NewAPITransactionEvaluator(args)
+	require.Nil(t, tce)
+	require.Equal(t, ErrNilPubkeyConverter, err)
+}
+
 func TestTransactionEvaluator_Ok(t *testing.T) {
 	t.Parallel()
 
@@ -131,11 +204,11 @@ func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 			return &txSimData.SimulationResultsWithVMOutput{}, nil
 		},
 	}
-	args.Accounts = &stateMock.AccountsStub{
+	args.Accounts = createSimulationAccounts(&stateMock.AccountsStub{
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
 		},
-	}
+	})
 	tce, err := NewAPITransactionEvaluator(args)
 	require.Nil(t, err)
 
@@ -170,11 +243,11 @@ func TestComputeTransactionGasLimit_MoveBalanceInvalidNonceShouldStillComputeCos
 			return nil, simulationErr
 		},
 	}
-	args.Accounts = &stateMock.AccountsStub{
+	args.Accounts = createSimulationAccounts(&stateMock.AccountsStub{
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
 		},
-	}
+	})
 	tce, _ := NewAPITransactionEvaluator(args)
 
 	tx := &transaction.Transaction{}
@@ -206,11 +279,11 @@ func TestComputeTransactionGasLimit_BuiltInFunction(t *testing.T) {
 			}, nil
 		},
 	}
-	args.Accounts = &stateMock.AccountsStub{
+	args.Accounts = createSimulationAccounts(&stateMock.AccountsStub{
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
 		},
-	}
+	})
 	tce, _ := NewAPITransactionEvaluator(args)
 
 	tx := &transaction.Transaction{}
@@ -237,11 +310,11 @@ func TestComputeTransactionGasLimit_BuiltInFunctionShouldErr(t *testing.T) {
 			return nil, localErr
 		},
 	}
-	args.Accounts = &stateMock.AccountsStub{
+	args.Accounts = createSimulationAccounts(&stateMock.AccountsStub{
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
 		},
-	}
+	})
 	tce, _ := NewAPITransactionEvaluator(args)
 
 	tx := &transaction.Transaction{}
@@ -267,11 +340,11 @@ func TestComputeTransactionGasLimit_NilVMOutput(t *testing.T) {
 			return &txSimData.SimulationResultsWithVMOutput{}, nil
 		},
 	}
-	args.Accounts = &stateMock.AccountsStub{
+	args.Accounts = createSimulationAccounts(&stateMock.AccountsStub{
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
 		},
-	}
+	})
 	tce, err := NewAPITransactionEvaluator(args)
 	require.Nil(t, err)
 
@@ -302,11 +375,11 @@ func TestComputeTransactionGasLimit_RetCodeNotOk(t *testing.T) {
 			}, nil
 		},
 	}
-	args.Accounts = &stateMock.AccountsStub{
+	args.Accounts = createSimulationAccounts(&stateMock.AccountsStub{
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return &stateMock.UserAccountStub{Balance: big.NewInt(100000)}, nil
 		},
-	}
+	})
 
 	tce, _ := NewAPITransactionEvaluator(args)
 
@@ -371,11 +444,120 @@ func TestApiTransactionEvaluator_SimulateTransactionExecution(t *testing.T) {
 
 	tx := &transaction.Transaction{}
 
-	_, err = tce.SimulateTransactionExecution(tx)
+	_, err = tce.SimulateTransactionExecution(tx, false)
 	require.Nil(t, err)
 	require.True(t, called)
 }
 
+func TestApiTransactionEvaluator_SimulateTransactionExecutionWithStateDiff(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	fungibleKey := []byte(esdtKeyPrefix + "TKN-abcdef")
+	nftKey := append([]byte(esdtKeyPrefix+"NFT-abcdef"), big.NewInt(1).Bytes()...)
+	fungibleBefore, _ := marshaller.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(5)})
+	fungibleAfter, _ := marshaller.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(15)})
+	nftAfter, _ := marshaller.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(1)})
+	originalData := map[string][]byte{
+		string(fungibleKey): fungibleBefore,
+		"counter":           []byte("01"),
+		"same":              []byte("x"),
+	}
+
+	accountsAdapter := &stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			account := stateMock.NewAccountWrapMock(address)
+			account.Balance = big.NewInt(100)
+			if !bytes.Equal(address, testscommon.TestPubKeyBob) {
+				return account, nil
+			}
+
+			dirtyData := make(map[string][]byte)
+			account.SetTrackableDataTrie(&trieMock.DataTrieTrackerStub{
+				RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+					value, found := dirtyData[string(key)]
+					if found {
+						return value, 0, nil
+					}
+					return originalData[string(key)], 0, nil
+				},
+				SaveKeyValueCalled: func(key []byte, value []byte) error {
+					dirtyData[string(key)] = value
+					return nil
+				},
+				GetDirtyDataKeysCalled: func() [][]byte {
+					keys := make([][]byte, 0, len(dirtyData))
+					for key := range dirtyData {
+						keys = append(keys, []byte(key))
+					}
+					sort.Slice(keys, func(i, j int) bool {
+						return bytes.Compare(keys[i], keys[j]) < 0
+					})
+					return keys
+				},
+			})
+			return account, nil
+		},
+	}
+	simulationAccounts, _ := NewSimulationAccountsDB(accountsAdapter)
+
+	args := createArgs()
+	args.Marshaller = marshaller
+	args.Accounts = simulationAccounts
+	args.TxSimulator = &mock.TransactionSimulatorStub{
+		ProcessTxCalled: func(tx *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+			// the sender is only loaded, it should not appear in the state diff
+			_, _ = simulationAccounts.LoadAccount(tx.SndAddr)
+
+			receiverHandler, _ := simulationAccounts.LoadAccount(tx.RcvAddr)
+			receiver := receiverHandler.(*stateMock.AccountWrapMock)
+			_ = receiver.AddToBalance(big.NewInt(1))
+			_ = receiver.SaveKeyValue(fungibleKey, fungibleAfter)
+			_ = receiver.SaveKeyValue(nftKey, nftAfter)
+			_ = receiver.SaveKeyValue([]byte("counter"), []byte("02"))
+			_ = receiver.SaveKeyValue([]byte("same"), []byte("x"))
+			_ = simulationAccounts.SaveAccount(receiver)
+
+			return &txSimData.SimulationResultsWithVMOutput{
+				SimulationResults: transaction.SimulationResults{Status: transaction.TxStatusSuccess},
+			}, nil
+		},
+	}
+	tce, _ := NewAPITransactionEvaluator(args)
+
+	tx := &transaction.Transaction{SndAddr: testscommon.TestPubKeyAlice, RcvAddr: testscommon.TestPubKeyBob}
+
+	t.Run("without state diff", func(t *testing.T) {
+		results, err := tce.SimulateTransactionExecution(tx, false)
+		require.Nil(t, err)
+		require.Nil(t, results.StateDiff)
+	})
+	t.Run("with state diff", func(t *testing.T) {
+		results, err := tce.SimulateTransactionExecution(tx, true)
+		require.Nil(t, err)
+		require.Equal(t, []*txSimData.AccountStateDiff{
+			{
+				Address:               testscommon.TestAddressBob,
+				BalanceBefore:         "100",
+				BalanceAfter:          "101",
+				DeveloperRewardBefore: "0",
+				DeveloperRewardAfter:  "0",
+				ESDTBalances: []*txSimData.ESDTBalanceDiff{
+					{Identifier: "NFT-abcdef", Nonce: 1, BalanceBefore: "0", BalanceAfter: "1"},
+					{Identifier: "TKN-abcdef", BalanceBefore: "5", BalanceAfter: "15"},
+				},
+				Storage: []*txSimData.StorageDiff{
+					{
+						Key:         hex.EncodeToString([]byte("counter")),
+						ValueBefore: hex.EncodeToString([]byte("01")),
+						ValueAfter:  hex.EncodeToString([]byte("02")),
+					},
+				},
+			},
+		}, results.StateDiff)
+	})
+}
+
 func TestApiTransactionEvaluator_ComputeTransactionGasLimit(t *testing.T) {
 	t.Parallel()
 
@@ -427,3 +609,415 @@ func TestApiTransactionEvaluator_GetCurrentHeader(t *testing.T) {
 	currentHeader = tce.getCurrentBlockHeader()
 	require.Equal(t, expectedNonce, currentHeader.GetNonce())
 }
+
+func TestApiTransactionEvaluator_TraceTransactionExecution(t *testing.T) {
+	t.Parallel()
+
+	expectedNonce := uint64(1000)
+	expectedTrace := &txSimData.TransactionTrace{}
+	args := createArgs()
+	args.BlockChain = &testscommon.ChainHandlerMock{}
+	_ = args.BlockChain.SetCurrentBlockHeaderAndRootHash(&block.Header{Nonce: expectedNonce}, []byte("test"))
+	args.TxSimulator = &mock.TransactionSimulatorStub{
+		TraceTxCalled: func(_ *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.TransactionTrace, error) {
+			require.Equal(t, expectedNonce, currentHeader.GetNonce())
+			return expectedTrace, nil
+		},
+	}
+
+	tce, err := NewAPITransactionEvaluator(args)
+	require.Nil(t, err)
+
+	trace, err := tce.TraceTransactionExecution(&transaction.Transaction{})
+	require.Nil(t, err)
+	require.True(t, trace == expectedTrace)
+}
+
+type historicalBlockData struct {
+	storageService    dataRetriever.StorageService
+	previousHash      []byte
+	previousHeader    *block.Header
+	blockHash         []byte
+	header            *block.Header
+	txHashes          [][]byte
+	txs               []*transaction.Transaction
+	invalidTxHash     []byte
+	processedTxHash   []byte
+	scheduledRootHash []byte
+}
+
+func createHistoricalBlockData(marshaller marshal.Marshalizer) *historicalBlockData {
+	blockData := &historicalBlockData{
+		storageService:    genericMocks.NewChainStorerMock(0),
+		previousHash:      []byte("previous hash"),
+		blockHash:         []byte("block hash"),
+		txHashes:          [][]byte{[]byte("tx hash 0"), []byte("tx hash 1"), []byte("tx hash 2")},
+		invalidTxHash:     []byte("invalid tx hash"),
+		processedTxHash:   []byte("processed tx hash"),
+		scheduledRootHash: []byte("scheduled root hash"),
+	}
+
+	blockData.previousHeader = &block.Header{
+		Nonce:    9,
+		RootHash: []byte("previous root hash"),
+	}
+	blockData.txs = make([]*transaction.Transaction, 0, len(blockData.txHashes))
+	for i, hash := range blockData.txHashes {
+		tx := &transaction.Transaction{Nonce: uint64(i)}
+		blockData.txs = append(blockData.txs, tx)
+		putMarshalledInStorage(blockData.storageService, marshaller, dataRetriever.TransactionUnit, hash, tx)
+	}
+	putMarshalledInStorage(blockData.storageService, marshaller, dataRetriever.TransactionUnit, blockData.invalidTxHash, &transaction.Transaction{Nonce: 100})
+
+	processedMiniBlock := &block.MiniBlock{TxHashes: [][]byte{blockData.processedTxHash}, Type: block.TxBlock}
+	firstMiniBlock := &block.MiniBlock{TxHashes: blockData.txHashes[:2], Type: block.TxBlock}
+	secondMiniBlock := &block.MiniBlock{TxHashes: blockData.txHashes[2:], Type: block.TxBlock}
+	invalidMiniBlock := &block.MiniBlock{TxHashes: [][]byte{blockData.invalidTxHash}, Type: block.InvalidBlock}
+	miniBlockHeaders := make([]block.MiniBlockHeader, 0)
+	for i, miniBlock := range []*block.MiniBlock{processedMiniBlock, firstMiniBlock, secondMiniBlock, invalidMiniBlock} {
+		miniBlockHash := []byte(fmt.Sprintf("miniblock hash %d", i))
+		putMarshalledInStorage(blockData.storageService, marshaller, dataRetriever.MiniBlockUnit, miniBlockHash, miniBlock)
+
+		miniBlockHeader := block.MiniBlockHeader{
+			Hash:    miniBlockHash,
+			TxCount: uint32(len(miniBlock.TxHashes)),
+			Type:    miniBlock.Type,
+		}
+		_ = miniBlockHeader.SetIndexOfLastTxProcessed(int32(len(miniBlock.TxHashes) - 1))
+		if i == 0 {
+			_ = miniBlockHeader.SetProcessingType(int32(block.Processed))
+		}
+		miniBlockHeaders = append(miniBlockHeaders, miniBlockHeader)
+	}
+
+	blockData.header = &block.Header{
+		Nonce:            10,
+		PrevHash:         blockData.previousHash,
+		MiniBlockHeaders: miniBlockHeaders,
+	}
+	putMarshalledInStorage(blockData.storageService, marshaller, dataRetriever.BlockHeaderUnit, blockData.blockHash, blockData.header)
+
+	return blockData
+}
+
+func putMarshalledInStorage(storageService dataRetriever.StorageService, marshaller marshal.Marshalizer, unit dataRetriever.UnitType, key []byte, obj interface{}) {
+	buff, _ := marshaller.Marshal(obj)
+	_ = storageService.Put(unit, key, buff)
+}
+
+func (blockData *historicalBlockData) putPreviousHeader(marshaller marshal.Marshalizer, withScheduledRootHash bool) {
+	previousHeader := &block.HeaderV2{
+		Header: blockData.previousHeader,
+	}
+	if withScheduledRootHash {
+		previousHeader.ScheduledRootHash = blockData.scheduledRootHash
+	}
+
+	putMarshalledInStorage(blockData.storageService, marshaller, dataRetriever.BlockHeaderUnit, blockData.previousHash, previousHeader)
+}
+
+func TestApiTransactionEvaluator_TraceExecutedTransaction(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+
+	t.Run("missing block should error", func(t *testing.T) {
+		t.Parallel()
+
+		blockData := createHistoricalBlockData(marshaller)
+		blockData.putPreviousHeader(marshaller, false)
+		args := createArgs()
+		args.StorageService = blockData.storageService
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			TraceTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.TransactionTrace, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		tce, _ := NewAPITransactionEvaluator(args)
+		trace, err := tce.TraceExecutedTransaction(blockData.txHashes[0], []byte("missing hash"))
+		require.NotNil(t, err)
+		require.Nil(t, trace)
+	})
+	t.Run("should replay the preceding transactions on the previous block state", func(t *testing.T) {
+		t.Parallel()
+
+		blockData := createHistoricalBlockData(marshaller)
+		blockData.putPreviousHeader(marshaller, false)
+
+		setBlockInfos := make([]common.BlockInfo, 0)
+		processedTxs := make([]*transaction.Transaction, 0)
+		expectedTrace := &txSimData.TransactionTrace{}
+		args := createArgs()
+		args.StorageService = blockData.storageService
+		args.BlockInfoSetter = &mock.BlockInfoSetterStub{
+			SetBlockInfoCalled: func(blockInfo common.BlockInfo) {
+				setBlockInfos = append(setBlockInfos, blockInfo)
+			},
+		}
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, blockData.header.Nonce, currentHeader.GetNonce())
+				processedTxs = append(processedTxs, tx)
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+			TraceTxCalled: func(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.TransactionTrace, error) {
+				require.Equal(t, blockData.header.Nonce, currentHeader.GetNonce())
+				require.Equal(t, blockData.txs[2], tx)
+				require.Len(t, setBlockInfos, 1)
+				return expectedTrace, nil
+			},
+		}
+
+		tce, _ := NewAPITransactionEvaluator(args)
+		trace, err := tce.TraceExecutedTransaction(blockData.txHashes[2], blockData.blockHash)
+		require.Nil(t, err)
+		require.True(t, trace == expectedTrace)
+		require.Equal(t, blockData.txs[:2], processedTxs)
+		require.Len(t, setBlockInfos, 2)
+		expectedBlockInfo := holders.NewBlockInfo(blockData.previousHash, blockData.previousHeader.Nonce, blockData.previousHeader.RootHash)
+		require.True(t, setBlockInfos[0].Equal(expectedBlockInfo))
+		require.Nil(t, setBlockInfos[1])
+	})
+}
+
+func TestApiTransactionEvaluator_ReplayExecutedTransaction(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+
+	t.Run("transaction not in block should error", func(t *testing.T) {
+		t.Parallel()
+
+		blockData := createHistoricalBlockData(marshaller)
+		blockData.putPreviousHeader(marshaller, false)
+		args := createArgs()
+		args.StorageService = blockData.storageService
+
+		tce, _ := NewAPITransactionEvaluator(args)
+		results, err := tce.ReplayExecutedTransaction(blockData.processedTxHash, blockData.blockHash)
+		require.Equal(t, ErrTransactionNotFoundInBlock, err)
+		require.Nil(t, results)
+	})
+	t.Run("pre-block state not available should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		blockData := createHistoricalBlockData(marshaller)
+		blockData.putPreviousHeader(marshaller, false)
+		args := createArgs()
+		args.StorageService = blockData.storageService
+		args.AccountsRepository = &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(_ []byte, _ api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		args.BlockInfoSetter = &mock.BlockInfoSetterStub{
+			SetBlockInfoCalled: func(blockInfo common.BlockInfo) {
+				require.Nil(t, blockInfo)
+			},
+		}
+
+		tce, _ := NewAPITransactionEvaluator(args)
+		results, err := tce.ReplayExecutedTransaction(blockData.txHashes[0], blockData.blockHash)
+		require.True(t, errors.Is(err, ErrPreBlockStateNotAvailable))
+		require.Nil(t, results)
+	})
+	t.Run("account not found on the pre-block state should work", func(t *testing.T) {
+		t.Parallel()
+
+		blockData := createHistoricalBlockData(marshaller)
+		blockData.putPreviousHeader(marshaller, true)
+		args := createArgs()
+		args.StorageService = blockData.storageService
+		args.AccountsRepository = &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(_ []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				require.Equal(t, blockData.scheduledRootHash, options.BlockRootHash)
+				return nil, nil, state.NewErrAccountNotFoundAtBlock(nil)
+			},
+		}
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+
+		tce, _ := NewAPITransactionEvaluator(args)
+		results, err := tce.ReplayExecutedTransaction(blockData.txHashes[0], blockData.blockHash)
+		require.Nil(t, err)
+		require.Equal(t, 0, results.NumReplayedTransactions)
+		require.Equal(t, hex.EncodeToString(blockData.scheduledRootHash), results.PreBlockRootHash)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		blockData := createHistoricalBlockData(marshaller)
+		blockData.putPreviousHeader(marshaller, false)
+
+		processedTxs := make([]*transaction.Transaction, 0)
+		expectedVMOutput := &vmcommon.VMOutput{ReturnMessage: "replayed"}
+		args := createArgs()
+		args.StorageService = blockData.storageService
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				processedTxs = append(processedTxs, tx)
+				return &txSimData.SimulationResultsWithVMOutput{
+					VMOutput: expectedVMOutput,
+				}, nil
+			},
+		}
+
+		tce, _ := NewAPITransactionEvaluator(args)
+		results, err := tce.ReplayExecutedTransaction(blockData.invalidTxHash, blockData.blockHash)
+		require.Nil(t, err)
+		require.Len(t, processedTxs, len(blockData.txs)+1)
+		require.Equal(t, blockData.txs, processedTxs[:len(blockData.txs)])
+		require.Equal(t, len(blockData.txs), results.NumReplayedTransactions)
+		require.Equal(t, expectedVMOutput, results.VMOutput)
+		require.Equal(t, hex.EncodeToString(blockData.blockHash), results.BlockHash)
+		require.Equal(t, blockData.header.Nonce, results.BlockNonce)
+		require.Equal(t, hex.EncodeToString(blockData.previousHeader.RootHash), results.PreBlockRootHash)
+	})
+}
+
+func TestApiTransactionEvaluator_SimulateTransactionsBundle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty bundle should error", func(t *testing.T) {
+		t.Parallel()
+
+		tce, _ := NewAPITransactionEvaluator(createArgs())
+		results, err := tce.SimulateTransactionsBundle(nil)
+		require.Nil(t, results)
+		require.Equal(t, ErrEmptyTransactionsBundle, err)
+	})
+	t.Run("too many transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		tce, _ := NewAPITransactionEvaluator(createArgs())
+		txs := make([]*transaction.Transaction, maxTransactionsInBundle+1)
+		results, err := tce.SimulateTransactionsBundle(txs)
+		require.Nil(t, results)
+		require.ErrorIs(t, err, ErrTooManyTransactionsInBundle)
+	})
+	t.Run("simulation error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				if tx.Nonce == 1 {
+					return nil, expectedErr
+				}
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		results, err := tce.SimulateTransactionsBundle([]*transaction.Transaction{{Nonce: 0}, {Nonce: 1}})
+		require.Nil(t, results)
+		require.ErrorIs(t, err, expectedErr)
+		require.Contains(t, err.Error(), "index 1")
+	})
+	t.Run("should carry the state between transactions", func(t *testing.T) {
+		t.Parallel()
+
+		contractAddress := bytes.Repeat([]byte{1}, 32)
+		type accountData struct {
+			nonce   uint64
+			balance *big.Int
+		}
+		originalAccounts := map[string]accountData{
+			string(testscommon.TestPubKeyAlice): {nonce: 5, balance: big.NewInt(100)},
+			string(testscommon.TestPubKeyBob):   {balance: big.NewInt(0)},
+			string(contractAddress):             {balance: big.NewInt(50)},
+		}
+		accountsAdapter := &stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				account := stateMock.NewAccountWrapMock(address)
+				original := originalAccounts[string(address)]
+				account.IncreaseNonce(original.nonce)
+				account.Balance = big.NewInt(0).Set(original.balance)
+				return account, nil
+			},
+		}
+		simulationAccounts, _ := NewSimulationAccountsDB(accountsAdapter)
+
+		args := createArgs()
+		args.Accounts = simulationAccounts
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				senderHandler, _ := simulationAccounts.LoadAccount(tx.SndAddr)
+				sender := senderHandler.(*stateMock.AccountWrapMock)
+				if sender.GetNonce() != tx.Nonce {
+					return &txSimData.SimulationResultsWithVMOutput{
+						SimulationResults: transaction.SimulationResults{Status: transaction.TxStatusFail},
+					}, nil
+				}
+
+				receiverHandler, _ := simulationAccounts.LoadAccount(tx.RcvAddr)
+				receiver := receiverHandler.(*stateMock.AccountWrapMock)
+				sender.IncreaseNonce(1)
+				_ = sender.SubFromBalance(tx.Value)
+				_ = receiver.AddToBalance(tx.Value)
+				_ = simulationAccounts.SaveAccount(sender)
+				_ = simulationAccounts.SaveAccount(receiver)
+
+				if bytes.Equal(tx.RcvAddr, contractAddress) {
+					_ = receiver.SaveKeyValue([]byte("approved"), []byte("10"))
+					_ = simulationAccounts.SaveAccount(receiver)
+				}
+
+				return &txSimData.SimulationResultsWithVMOutput{
+					SimulationResults: transaction.SimulationResults{Status: transaction.TxStatusSuccess},
+				}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		txs := []*transaction.Transaction{
+			{Nonce: 5, SndAddr: testscommon.TestPubKeyAlice, RcvAddr: testscommon.TestPubKeyBob, Value: big.NewInt(10)},
+			{Nonce: 6, SndAddr: testscommon.TestPubKeyAlice, RcvAddr: contractAddress, Value: big.NewInt(0)},
+		}
+		bundleResults, err := tce.SimulateTransactionsBundle(txs)
+		require.Nil(t, err)
+		require.Len(t, bundleResults.Results, 2)
+		require.Equal(t, transaction.TxStatusSuccess, bundleResults.Results[0].Status)
+		require.Equal(t, transaction.TxStatusSuccess, bundleResults.Results[1].Status)
+
+		require.Equal(t, []*txSimData.AccountStateDiff{
+			{
+				Address:               testscommon.RealWorldBech32PubkeyConverter.SilentEncode(contractAddress, log),
+				BalanceBefore:         "50",
+				BalanceAfter:          "50",
+				DeveloperRewardBefore: "0",
+				DeveloperRewardAfter:  "0",
+				Storage: []*txSimData.StorageDiff{
+					{Key: hex.EncodeToString([]byte("approved")), ValueAfter: hex.EncodeToString([]byte("10"))},
+				},
+			},
+			{
+				Address:               testscommon.TestAddressAlice,
+				NonceBefore:           5,
+				NonceAfter:            7,
+				BalanceBefore:         "100",
+				BalanceAfter:          "90",
+				DeveloperRewardBefore: "0",
+				DeveloperRewardAfter:  "0",
+			},
+			{
+				Address:               testscommon.TestAddressBob,
+				BalanceBefore:         "0",
+				BalanceAfter:          "10",
+				DeveloperRewardBefore: "0",
+				DeveloperRewardAfter:  "0",
+			},
+		}, bundleResults.StateChanges)
+
+		// the simulation state should have been dropped after the bundle
+		account, _ := simulationAccounts.LoadAccount(testscommon.TestPubKeyAlice)
+		require.Equal(t, uint64(5), account.GetNonce())
+	})
+}
@@ -35,6 +35,7 @@ type ArgsTxSimulator struct {
 	Marshalizer               marshal.Marshalizer
 	DataFieldParser           DataFieldParser
 	BlockChainHook            process.BlockChainHookHandler
+	ExecutionTracer           ExecutionTracingHandler
 }
 
 type refundHandler interface {
@@ -53,6 +54,7 @@ type transactionSimulator struct {
 	refundDetector         refundHandler
 	dataFieldParser        DataFieldParser
 	blockChainHook         process.BlockChainHookHandler
+	executionTracer        ExecutionTracingHandler
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -84,6 +86,9 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.BlockChainHook) {
 		return nil, process.ErrNilBlockChainHook
 	}
+	if check.IfNil(args.ExecutionTracer) {
+		return nil, process.ErrNilExecutionTracer
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
@@ -96,6 +101,7 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 		refundDetector:         transactionAPI.NewRefundDetector(),
 		dataFieldParser:        args.DataFieldParser,
 		blockChainHook:         args.BlockChainHook,
+		executionTracer:        args.ExecutionTracer,
 	}, nil
 }
 
@@ -104,6 +110,28 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, currentHe
 	ts.mutOperation.Lock()
 	defer ts.mutOperation.Unlock()
 
+	return ts.processTx(tx, currentHeader)
+}
+
+// TraceTx will process the transaction in the same special environment as ProcessTx, recording the execution frames
+func (ts *transactionSimulator) TraceTx(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.TransactionTrace, error) {
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	ts.executionTracer.StartTracing()
+	results, err := ts.processTx(tx, currentHeader)
+	frames := ts.executionTracer.StopTracing()
+	if err != nil {
+		return nil, err
+	}
+
+	return &txSimData.TransactionTrace{
+		SimulationResults: results.SimulationResults,
+		Frames:            frames,
+	}, nil
+}
+
+func (ts *transactionSimulator) processTx(tx *transaction.Transaction, currentHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
 	txStatus := transaction.TxStatusPending
 	failReason := ""
 
@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -85,6 +86,15 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: process.ErrNilBlockChainHook,
 		},
+		{
+			name: "NilExecutionTracer",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.ExecutionTracer = nil
+				return args
+			},
+			exError: process.ErrNilExecutionTracer,
+		},
 		{
 			name: "NilMarshalizer",
 			argsFunc: func() ArgsTxSimulator {
@@ -139,6 +149,41 @@ func TestTransactionSimulator_ProcessTxProcessingErrShouldSignal(t *testing.T) {
 	require.Equal(t, expErr.Error(), results.FailReason)
 }
 
+func TestTransactionSimulator_TraceTx(t *testing.T) {
+	t.Parallel()
+
+	expectedFrames := []*txSimData.TraceFrame{{Type: "contractCall"}}
+	tracingStarted := false
+	args := getTxSimulatorArgs()
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(transaction *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			require.True(t, tracingStarted)
+			return vmcommon.Ok, nil
+		},
+	}
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerMock{}, nil
+		},
+	}
+	args.ExecutionTracer = &mock.ExecutionTracingHandlerStub{
+		StartTracingCalled: func() {
+			tracingStarted = true
+		},
+		StopTracingCalled: func() []*txSimData.TraceFrame {
+			tracingStarted = false
+			return expectedFrames
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	trace, err := ts.TraceTx(&transaction.Transaction{Nonce: 37}, &block.Header{})
+	require.NoError(t, err)
+	require.False(t, tracingStarted)
+	require.Equal(t, transaction.TxStatusSuccess, trace.Status)
+	require.Equal(t, expectedFrames, trace.Frames)
+}
+
 func TestTransactionSimulator_getVMOutputComputeHashFails(t *testing.T) {
 	t.Parallel()
 
@@ -246,6 +291,7 @@ func getTxSimulatorArgs() ArgsTxSimulator {
 		Hasher:                    &hashingMocks.HasherMock{},
 		DataFieldParser:           dataFieldParser,
 		BlockChainHook:            &testscommon.BlockChainHookStub{},
+		ExecutionTracer:           &mock.ExecutionTracingHandlerStub{},
 	}
 }
 
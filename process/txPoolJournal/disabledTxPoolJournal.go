@@ -0,0 +1,24 @@
+package txPoolJournal
+
+type disabledTxPoolJournal struct {
+}
+
+// NewDisabledTxPoolJournal creates a journal which does nothing, used when the journal is not enabled
+func NewDisabledTxPoolJournal() *disabledTxPoolJournal {
+	return &disabledTxPoolJournal{}
+}
+
+// Replay returns nil
+func (journal *disabledTxPoolJournal) Replay() error {
+	return nil
+}
+
+// Close returns nil
+func (journal *disabledTxPoolJournal) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *disabledTxPoolJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
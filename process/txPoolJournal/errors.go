@@ -0,0 +1,15 @@
+package txPoolJournal
+
+import "errors"
+
+// ErrNilTxReplayer signals that a nil transactions replayer has been provided
+var ErrNilTxReplayer = errors.New("nil transactions replayer")
+
+// ErrInvalidCompactionInterval signals that an invalid compaction interval has been provided
+var ErrInvalidCompactionInterval = errors.New("invalid compaction interval")
+
+// ErrEmptyDirectoryName signals that an empty directory name has been provided
+var ErrEmptyDirectoryName = errors.New("empty directory name")
+
+// ErrJournalClosed signals that the journal has already been closed
+var ErrJournalClosed = errors.New("journal is closed")
@@ -0,0 +1,55 @@
+package txPoolJournal
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+type interceptedTxReplayer struct {
+	dataFactory process.InterceptedDataFactory
+	processor   process.InterceptorProcessor
+}
+
+// NewInterceptedTxReplayer creates a replayer which passes the journaled transactions through the same validation
+// steps as the transactions received from the network
+func NewInterceptedTxReplayer(
+	dataFactory process.InterceptedDataFactory,
+	processor process.InterceptorProcessor,
+) (*interceptedTxReplayer, error) {
+	if check.IfNil(dataFactory) {
+		return nil, process.ErrNilInterceptedDataFactory
+	}
+	if check.IfNil(processor) {
+		return nil, process.ErrNilInterceptedDataProcessor
+	}
+
+	return &interceptedTxReplayer{
+		dataFactory: dataFactory,
+		processor:   processor,
+	}, nil
+}
+
+// ReplayTransaction validates the transaction and, if it is still valid, adds it back in pool
+func (replayer *interceptedTxReplayer) ReplayTransaction(txBuff []byte) error {
+	interceptedData, err := replayer.dataFactory.Create(txBuff)
+	if err != nil {
+		return err
+	}
+
+	err = interceptedData.CheckValidity()
+	if err != nil {
+		return err
+	}
+
+	err = replayer.processor.Validate(interceptedData, "")
+	if err != nil {
+		return err
+	}
+
+	return replayer.processor.Save(interceptedData, "", "")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (replayer *interceptedTxReplayer) IsInterfaceNil() bool {
+	return replayer == nil
+}
@@ -0,0 +1,117 @@
+package txPoolJournal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInterceptedTxReplayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil data factory should error", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, err := NewInterceptedTxReplayer(nil, &mock.InterceptorProcessorStub{})
+		require.Nil(t, replayer)
+		require.Equal(t, process.ErrNilInterceptedDataFactory, err)
+	})
+	t.Run("nil processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, err := NewInterceptedTxReplayer(&mock.InterceptedDataFactoryStub{}, nil)
+		require.Nil(t, replayer)
+		require.Equal(t, process.ErrNilInterceptedDataProcessor, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, err := NewInterceptedTxReplayer(&mock.InterceptedDataFactoryStub{}, &mock.InterceptorProcessorStub{})
+		require.Nil(t, err)
+		require.False(t, replayer.IsInterfaceNil())
+	})
+}
+
+func TestInterceptedTxReplayer_ReplayTransaction(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("create fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		dataFactory := &mock.InterceptedDataFactoryStub{
+			CreateCalled: func(buff []byte) (process.InterceptedData, error) {
+				return nil, expectedErr
+			},
+		}
+		replayer, _ := NewInterceptedTxReplayer(dataFactory, &mock.InterceptorProcessorStub{})
+
+		err := replayer.ReplayTransaction([]byte("tx"))
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("invalid data should error", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, _ := NewInterceptedTxReplayer(
+			createDataFactoryStub(&testscommon.InterceptedDataStub{
+				CheckValidityCalled: func() error {
+					return expectedErr
+				},
+			}),
+			&mock.InterceptorProcessorStub{},
+		)
+
+		err := replayer.ReplayTransaction([]byte("tx"))
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("validation fails should not save", func(t *testing.T) {
+		t.Parallel()
+
+		processor := &mock.InterceptorProcessorStub{
+			ValidateCalled: func(data process.InterceptedData) error {
+				return expectedErr
+			},
+			SaveCalled: func(data process.InterceptedData) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		replayer, _ := NewInterceptedTxReplayer(createDataFactoryStub(&testscommon.InterceptedDataStub{}), processor)
+
+		err := replayer.ReplayTransaction([]byte("tx"))
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should save", func(t *testing.T) {
+		t.Parallel()
+
+		interceptedData := &testscommon.InterceptedDataStub{}
+		saveCalled := false
+		processor := &mock.InterceptorProcessorStub{
+			ValidateCalled: func(data process.InterceptedData) error {
+				return nil
+			},
+			SaveCalled: func(data process.InterceptedData) error {
+				require.True(t, data == interceptedData)
+				saveCalled = true
+				return nil
+			},
+		}
+		replayer, _ := NewInterceptedTxReplayer(createDataFactoryStub(interceptedData), processor)
+
+		err := replayer.ReplayTransaction([]byte("tx"))
+		require.Nil(t, err)
+		require.True(t, saveCalled)
+	})
+}
+
+func createDataFactoryStub(interceptedData process.InterceptedData) *mock.InterceptedDataFactoryStub {
+	return &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (process.InterceptedData, error) {
+			return interceptedData, nil
+		},
+	}
+}
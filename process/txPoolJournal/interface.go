@@ -0,0 +1,16 @@
+package txPoolJournal
+
+import (
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+)
+
+// TxReplayer defines the component able to put back in pool a journaled transaction, after validating it again
+type TxReplayer interface {
+	ReplayTransaction(txBuff []byte) error
+	IsInterfaceNil() bool
+}
+
+// txCacheHandler defines the read-only operations of the transactions cache used on compaction
+type txCacheHandler interface {
+	ForEachTransaction(function txcache.ForEachTransaction)
+}
@@ -0,0 +1,77 @@
+package txPoolJournal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+)
+
+// each record of the journal is made of the length of the payload, as a big endian uint32, followed by the payload
+const recordLengthSize = 4
+const maxRecordSize = 10 * 1024 * 1024
+
+var errCorruptedRecord = errors.New("corrupted record")
+
+func writeRecord(writer io.Writer, payload []byte) error {
+	buff := make([]byte, recordLengthSize+len(payload))
+	binary.BigEndian.PutUint32(buff, uint32(len(payload)))
+	copy(buff[recordLengthSize:], payload)
+
+	_, err := writer.Write(buff)
+	return err
+}
+
+// readRecords returns all the complete records found in the file. A truncated record at the end of the file (which
+// happens if the node is stopped while appending) is ignored
+func readRecords(filePath string) ([][]byte, error) {
+	file, err := os.Open(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return make([][]byte, 0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reader := bufio.NewReader(file)
+	records := make([][]byte, 0)
+	lengthBuff := make([]byte, recordLengthSize)
+	for {
+		_, err = io.ReadFull(reader, lengthBuff)
+		if err != nil {
+			break
+		}
+
+		payloadLength := binary.BigEndian.Uint32(lengthBuff)
+		if payloadLength > maxRecordSize {
+			err = errCorruptedRecord
+			break
+		}
+
+		payload := make([]byte, payloadLength)
+		_, err = io.ReadFull(reader, payload)
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			break
+		}
+
+		records = append(records, payload)
+	}
+
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, errCorruptedRecord) {
+		log.Warn("txPoolJournal: ignoring the unreadable records found at the end of the journal",
+			"file", filePath, "num records read", len(records), "error", err)
+		return records, nil
+	}
+	if errors.Is(err, io.EOF) {
+		return records, nil
+	}
+
+	return nil, err
+}
@@ -0,0 +1,85 @@
+package txPoolJournal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRecords(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should return no records", func(t *testing.T) {
+		t.Parallel()
+
+		records, err := readRecords(filepath.Join(t.TempDir(), "missing.bin"))
+		require.Nil(t, err)
+		require.Empty(t, records)
+	})
+	t.Run("should read the written records", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "journal.bin")
+		writeRecordsToFile(t, filePath, []byte("a"), []byte{}, []byte("bbb"))
+
+		records, err := readRecords(filePath)
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{[]byte("a"), {}, []byte("bbb")}, records)
+	})
+	t.Run("truncated last record should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "journal.bin")
+		writeRecordsToFile(t, filePath, []byte("aaa"), []byte("bbbb"))
+		truncateFile(t, filePath, 2)
+
+		records, err := readRecords(filePath)
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{[]byte("aaa")}, records)
+	})
+	t.Run("truncated length of the last record should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "journal.bin")
+		writeRecordsToFile(t, filePath, []byte("aaa"), []byte("bbbb"))
+		truncateFile(t, filePath, 6)
+
+		records, err := readRecords(filePath)
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{[]byte("aaa")}, records)
+	})
+	t.Run("corrupted record length should stop reading", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "journal.bin")
+		writeRecordsToFile(t, filePath, []byte("aaa"))
+		file, err := os.OpenFile(filePath, os.O_APPEND|os.O_WRONLY, 0644)
+		require.Nil(t, err)
+		_, err = file.Write([]byte{0xff, 0xff, 0xff, 0xff, 1, 2, 3})
+		require.Nil(t, err)
+		require.Nil(t, file.Close())
+
+		records, err := readRecords(filePath)
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{[]byte("aaa")}, records)
+	})
+}
+
+func writeRecordsToFile(t *testing.T, filePath string, payloads ...[]byte) {
+	file, err := os.Create(filePath)
+	require.Nil(t, err)
+
+	for _, payload := range payloads {
+		require.Nil(t, writeRecord(file, payload))
+	}
+
+	require.Nil(t, file.Close())
+}
+
+func truncateFile(t *testing.T, filePath string, numBytesToRemove int64) {
+	info, err := os.Stat(filePath)
+	require.Nil(t, err)
+	require.Nil(t, os.Truncate(filePath, info.Size()-numBytesToRemove))
+}
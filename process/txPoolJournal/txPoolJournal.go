@@ -0,0 +1,335 @@
+package txPoolJournal
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("process/txPoolJournal")
+
+const journalFilePermissions = 0644
+const journalDirectoryPermissions = 0755
+const compactionFileSuffix = ".compacting"
+
+// ArgsTxPoolJournal is the DTO used to create a new instance of the transactions pool journal
+type ArgsTxPoolJournal struct {
+	Config           config.TxPoolJournalConfig
+	WorkingDir       string
+	Marshaller       marshal.Marshalizer
+	ShardCoordinator sharding.Coordinator
+	TxPool           dataRetriever.ShardedDataCacherNotifier
+	Replayer         TxReplayer
+}
+
+type txPoolJournal struct {
+	marshaller       marshal.Marshalizer
+	txPool           dataRetriever.ShardedDataCacherNotifier
+	replayer         TxReplayer
+	shardCoordinator sharding.Coordinator
+	selfShardID      uint32
+	filePath         string
+
+	mutFile    sync.Mutex
+	file       *os.File
+	isReplayed bool
+	cancelFunc context.CancelFunc
+}
+
+// NewTxPoolJournal creates the journal of the transactions pool. The transactions of the senders from the node's shard
+// are appended to the journal file as soon as they are accepted in pool. The journal is not compacted before being
+// replayed, as the pool does not hold the journaled transactions until then
+func NewTxPoolJournal(args ArgsTxPoolJournal) (*txPoolJournal, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	selfShardID := args.ShardCoordinator.SelfId()
+	directory := filepath.Join(args.WorkingDir, args.Config.DirectoryName)
+	err = os.MkdirAll(directory, journalDirectoryPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	journal := &txPoolJournal{
+		marshaller:       args.Marshaller,
+		txPool:           args.TxPool,
+		replayer:         args.Replayer,
+		shardCoordinator: args.ShardCoordinator,
+		selfShardID:      selfShardID,
+		filePath:         filepath.Join(directory, fmt.Sprintf("journal_%s.bin", core.GetShardIDString(selfShardID))),
+	}
+
+	journal.file, err = openForAppend(journal.filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	args.TxPool.RegisterOnAdded(journal.onTxAdded)
+
+	var ctx context.Context
+	ctx, journal.cancelFunc = context.WithCancel(context.Background())
+	compactionInterval := time.Duration(args.Config.CompactionIntervalInSeconds) * time.Second
+	go journal.compactPeriodically(ctx, compactionInterval)
+
+	return journal, nil
+}
+
+func checkArgs(args ArgsTxPoolJournal) error {
+	if len(args.Config.DirectoryName) == 0 {
+		return ErrEmptyDirectoryName
+	}
+	if args.Config.CompactionIntervalInSeconds == 0 {
+		return fmt.Errorf("%w: CompactionIntervalInSeconds should be greater than 0", ErrInvalidCompactionInterval)
+	}
+	if check.IfNil(args.Marshaller) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.TxPool) {
+		return process.ErrNilTransactionPool
+	}
+	if check.IfNil(args.Replayer) {
+		return ErrNilTxReplayer
+	}
+
+	return nil
+}
+
+func openForAppend(filePath string) (*os.File, error) {
+	return os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, journalFilePermissions)
+}
+
+func (journal *txPoolJournal) onTxAdded(_ []byte, value interface{}) {
+	wrappedTx, ok := value.(*txcache.WrappedTransaction)
+	if !ok || wrappedTx.SenderShardID != journal.selfShardID {
+		return
+	}
+
+	txBuff, err := journal.marshaller.Marshal(wrappedTx.Tx)
+	if err != nil {
+		log.Warn("txPoolJournal.onTxAdded: cannot marshal transaction", "hash", wrappedTx.TxHash, "error", err)
+		return
+	}
+
+	journal.mutFile.Lock()
+	defer journal.mutFile.Unlock()
+
+	if journal.file == nil {
+		return
+	}
+
+	err = writeRecord(journal.file, txBuff)
+	if err != nil {
+		log.Warn("txPoolJournal.onTxAdded: cannot append transaction", "hash", wrappedTx.TxHash, "error", err)
+	}
+}
+
+// Replay validates again the journaled transactions, putting back in pool the ones that are still valid.
+// The journal is compacted afterward, so that it only holds the transactions found in pool
+func (journal *txPoolJournal) Replay() error {
+	journal.mutFile.Lock()
+	records, err := readRecords(journal.filePath)
+	journal.mutFile.Unlock()
+	if err != nil {
+		return err
+	}
+
+	numReplayed := 0
+	numDropped := 0
+	for _, record := range records {
+		err = journal.replayer.ReplayTransaction(record)
+		if err != nil {
+			log.Trace("txPoolJournal.Replay: dropping transaction", "error", err)
+			numDropped++
+			continue
+		}
+
+		numReplayed++
+	}
+
+	log.Info("txPoolJournal.Replay", "num replayed", numReplayed, "num dropped", numDropped)
+
+	journal.mutFile.Lock()
+	journal.isReplayed = true
+	journal.mutFile.Unlock()
+
+	return journal.compact()
+}
+
+func (journal *txPoolJournal) compactPeriodically(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-time.After(interval):
+			err := journal.compact()
+			if err != nil {
+				log.Warn("txPoolJournal: compaction failed", "error", err)
+			}
+		case <-ctx.Done():
+			log.Debug("txPoolJournal: closing the compaction go routine")
+			return
+		}
+	}
+}
+
+// compact rewrites the journal so that it only holds the transactions of the senders from the node's shard currently
+// found in pool. The new content is written in a separate file, which then replaces the journal. Until the journal
+// is replayed, the compaction is skipped, so that the journaled transactions are not lost
+func (journal *txPoolJournal) compact() error {
+	journal.mutFile.Lock()
+	defer journal.mutFile.Unlock()
+
+	if journal.file == nil {
+		return ErrJournalClosed
+	}
+	if !journal.isReplayed {
+		log.Debug("txPoolJournal: skipping compaction, as the journal was not replayed yet")
+		return nil
+	}
+
+	caches, err := journal.getSelfShardSendersCaches()
+	if err != nil {
+		return err
+	}
+
+	compactionFilePath := journal.filePath + compactionFileSuffix
+	err = journal.writeCompactedFile(compactionFilePath, caches)
+	if err != nil {
+		_ = os.Remove(compactionFilePath)
+		return err
+	}
+
+	err = journal.file.Close()
+	if err != nil {
+		log.Warn("txPoolJournal.compact: cannot close the journal file", "error", err)
+	}
+
+	errRename := os.Rename(compactionFilePath, journal.filePath)
+	if errRename != nil {
+		_ = os.Remove(compactionFilePath)
+	}
+
+	// on rename failure, the appending continues on the previous journal
+	journal.file, err = openForAppend(journal.filePath)
+	if errRename != nil {
+		return errRename
+	}
+
+	return err
+}
+
+// getSelfShardSendersCaches returns the caches holding the transactions of the senders from the node's shard, towards
+// each destination shard
+func (journal *txPoolJournal) getSelfShardSendersCaches() ([]txCacheHandler, error) {
+	numShards := journal.shardCoordinator.NumberOfShards()
+	destShardIDs := make([]uint32, 0, numShards+1)
+	for shardID := uint32(0); shardID < numShards; shardID++ {
+		destShardIDs = append(destShardIDs, shardID)
+	}
+	destShardIDs = append(destShardIDs, core.MetachainShardId)
+
+	caches := make([]txCacheHandler, 0, len(destShardIDs))
+	for _, destShardID := range destShardIDs {
+		cacheID := process.ShardCacherIdentifier(journal.selfShardID, destShardID)
+		cache, ok := journal.txPool.ShardDataStore(cacheID).(txCacheHandler)
+		if !ok {
+			return nil, fmt.Errorf("%w for the transactions cache %s", process.ErrWrongTypeAssertion, cacheID)
+		}
+
+		caches = append(caches, cache)
+	}
+
+	return caches, nil
+}
+
+// writeCompactedFile writes the transactions of the provided caches in a new file. As the pool can route all the
+// transactions of the senders from the node's shard to the same cache, a transaction is written only once
+func (journal *txPoolJournal) writeCompactedFile(filePath string, caches []txCacheHandler) error {
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, journalFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	writtenTxs := make(map[string]struct{})
+	var errWrite error
+	for _, cache := range caches {
+		cache.ForEachTransaction(func(txHash []byte, wrappedTx *txcache.WrappedTransaction) {
+			_, isWritten := writtenTxs[string(txHash)]
+			if errWrite != nil || isWritten {
+				return
+			}
+
+			txBuff, errMarshal := journal.marshaller.Marshal(wrappedTx.Tx)
+			if errMarshal != nil {
+				log.Warn("txPoolJournal.compact: cannot marshal transaction", "hash", txHash, "error", errMarshal)
+				return
+			}
+
+			errWrite = writeRecord(writer, txBuff)
+			writtenTxs[string(txHash)] = struct{}{}
+		})
+	}
+
+	if errWrite == nil {
+		errWrite = writer.Flush()
+	}
+	if errWrite == nil {
+		errWrite = file.Sync()
+	}
+	errClose := file.Close()
+	if errWrite != nil {
+		return errWrite
+	}
+	if errClose != nil {
+		return errClose
+	}
+
+	log.Debug("txPoolJournal: compacted", "num txs", len(writtenTxs))
+
+	return nil
+}
+
+// Close compacts the journal one last time and closes the journal file
+func (journal *txPoolJournal) Close() error {
+	journal.cancelFunc()
+
+	err := journal.compact()
+	if err != nil {
+		log.Warn("txPoolJournal.Close: compaction failed", "error", err)
+	}
+
+	journal.mutFile.Lock()
+	defer journal.mutFile.Unlock()
+
+	if journal.file == nil {
+		return nil
+	}
+
+	err = journal.file.Close()
+	journal.file = nil
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *txPoolJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
@@ -0,0 +1,288 @@
+package txPoolJournal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	dataRetrieverTests "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
+	"github.com/stretchr/testify/require"
+)
+
+type txReplayerStub struct {
+	ReplayTransactionCalled func(txBuff []byte) error
+}
+
+// ReplayTransaction -
+func (stub *txReplayerStub) ReplayTransaction(txBuff []byte) error {
+	if stub.ReplayTransactionCalled != nil {
+		return stub.ReplayTransactionCalled(txBuff)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *txReplayerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createMockArgsTxPoolJournal(t *testing.T) ArgsTxPoolJournal {
+	txPool, err := dataRetrieverTests.CreateTxPool(2, 0)
+	require.Nil(t, err)
+
+	return ArgsTxPoolJournal{
+		Config: config.TxPoolJournalConfig{
+			Enabled:                     true,
+			DirectoryName:               "txpool",
+			CompactionIntervalInSeconds: 3600,
+		},
+		WorkingDir:       t.TempDir(),
+		Marshaller:       &marshal.GogoProtoMarshalizer{},
+		ShardCoordinator: testscommon.NewMultiShardsCoordinatorMock(2),
+		TxPool:           txPool,
+		Replayer:         &txReplayerStub{},
+	}
+}
+
+func addTxToPool(txPool dataRetriever.ShardedDataCacherNotifier, nonce uint64, cacheID string) *transaction.Transaction {
+	tx := &transaction.Transaction{
+		Nonce:    nonce,
+		SndAddr:  []byte("sender"),
+		RcvAddr:  []byte("receiver"),
+		GasPrice: 1_000_000_000,
+		GasLimit: 50_000,
+	}
+	txPool.AddData([]byte(fmt.Sprintf("hash-%s-%d", cacheID, nonce)), tx, tx.Size(), cacheID)
+
+	return tx
+}
+
+func unmarshalRecords(t *testing.T, filePath string) []*transaction.Transaction {
+	records, err := readRecords(filePath)
+	require.Nil(t, err)
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	txs := make([]*transaction.Transaction, 0, len(records))
+	for _, record := range records {
+		tx := &transaction.Transaction{}
+		require.Nil(t, marshaller.Unmarshal(tx, record))
+		txs = append(txs, tx)
+	}
+
+	return txs
+}
+
+func TestNewTxPoolJournal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolJournal(t)
+		args.Config.DirectoryName = ""
+		journal, err := NewTxPoolJournal(args)
+		require.True(t, check.IfNil(journal))
+		require.Equal(t, ErrEmptyDirectoryName, err)
+	})
+	t.Run("zero compaction interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolJournal(t)
+		args.Config.CompactionIntervalInSeconds = 0
+		journal, err := NewTxPoolJournal(args)
+		require.True(t, check.IfNil(journal))
+		require.True(t, errors.Is(err, ErrInvalidCompactionInterval))
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolJournal(t)
+		args.Marshaller = nil
+		journal, err := NewTxPoolJournal(args)
+		require.True(t, check.IfNil(journal))
+		require.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolJournal(t)
+		args.ShardCoordinator = nil
+		journal, err := NewTxPoolJournal(args)
+		require.True(t, check.IfNil(journal))
+		require.Equal(t, process.ErrNilShardCoordinator, err)
+	})
+	t.Run("nil tx pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolJournal(t)
+		args.TxPool = nil
+		journal, err := NewTxPoolJournal(args)
+		require.True(t, check.IfNil(journal))
+		require.Equal(t, process.ErrNilTransactionPool, err)
+	})
+	t.Run("nil replayer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolJournal(t)
+		args.Replayer = nil
+		journal, err := NewTxPoolJournal(args)
+		require.True(t, check.IfNil(journal))
+		require.Equal(t, ErrNilTxReplayer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolJournal(t)
+		journal, err := NewTxPoolJournal(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(journal))
+
+		_, err = os.Stat(filepath.Join(args.WorkingDir, "txpool", "journal_0.bin"))
+		require.Nil(t, err)
+		require.Nil(t, journal.Close())
+	})
+}
+
+func TestTxPoolJournal_ShouldAppendOnlyTheTransactionsOfSelfShardSenders(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxPoolJournal(t)
+	journal, _ := NewTxPoolJournal(args)
+
+	tx0 := addTxToPool(args.TxPool, 0, "0")
+	tx1 := addTxToPool(args.TxPool, 1, "0_1")
+	_ = addTxToPool(args.TxPool, 2, "1_0")
+
+	txs := unmarshalRecords(t, journal.filePath)
+	require.Equal(t, []*transaction.Transaction{tx0, tx1}, txs)
+
+	require.Nil(t, journal.Close())
+}
+
+func TestTxPoolJournal_CompactShouldKeepOnlyTheTransactionsFoundInPool(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxPoolJournal(t)
+	journal, _ := NewTxPoolJournal(args)
+	require.Nil(t, journal.Replay())
+
+	_ = addTxToPool(args.TxPool, 0, "0")
+	tx1 := addTxToPool(args.TxPool, 1, "0")
+	args.TxPool.RemoveData([]byte("hash-0-0"), "0")
+	require.Len(t, unmarshalRecords(t, journal.filePath), 2)
+
+	err := journal.compact()
+	require.Nil(t, err)
+	require.Equal(t, []*transaction.Transaction{tx1}, unmarshalRecords(t, journal.filePath))
+
+	// appending should continue on the compacted journal
+	tx2 := addTxToPool(args.TxPool, 2, "0")
+	require.Equal(t, []*transaction.Transaction{tx1, tx2}, unmarshalRecords(t, journal.filePath))
+
+	require.Nil(t, journal.Close())
+	_, err = os.Stat(journal.filePath + compactionFileSuffix)
+	require.True(t, errors.Is(err, os.ErrNotExist))
+
+	err = journal.compact()
+	require.Equal(t, ErrJournalClosed, err)
+}
+
+func TestTxPoolJournal_CompactShouldKeepTheCrossShardTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxPoolJournal(t)
+	journal, _ := NewTxPoolJournal(args)
+	require.Nil(t, journal.Replay())
+
+	tx0 := addTxToPool(args.TxPool, 0, "0")
+	tx1 := addTxToPool(args.TxPool, 1, "0_1")
+	tx2 := addTxToPool(args.TxPool, 2, process.ShardCacherIdentifier(0, core.MetachainShardId))
+
+	err := journal.compact()
+	require.Nil(t, err)
+	require.ElementsMatch(t, []*transaction.Transaction{tx0, tx1, tx2}, unmarshalRecords(t, journal.filePath))
+
+	require.Nil(t, journal.Close())
+}
+
+func TestTxPoolJournal_ShouldNotCompactBeforeReplay(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxPoolJournal(t)
+	journal, _ := NewTxPoolJournal(args)
+	tx0 := addTxToPool(args.TxPool, 0, "0")
+	require.Nil(t, journal.Close())
+
+	// restart with an empty pool, the journal being closed before it is replayed
+	newArgs := createMockArgsTxPoolJournal(t)
+	newArgs.WorkingDir = args.WorkingDir
+	newJournal, err := NewTxPoolJournal(newArgs)
+	require.Nil(t, err)
+
+	err = newJournal.compact()
+	require.Nil(t, err)
+	require.Nil(t, newJournal.Close())
+	require.Equal(t, []*transaction.Transaction{tx0}, unmarshalRecords(t, newJournal.filePath))
+}
+
+func TestTxPoolJournal_ReplayShouldDropInvalidTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxPoolJournal(t)
+	journal, _ := NewTxPoolJournal(args)
+	_ = addTxToPool(args.TxPool, 0, "0")
+	tx1 := addTxToPool(args.TxPool, 1, "0")
+	_ = addTxToPool(args.TxPool, 2, "0")
+	require.Nil(t, journal.Close())
+
+	// restart with an empty pool
+	newArgs := createMockArgsTxPoolJournal(t)
+	newArgs.WorkingDir = args.WorkingDir
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	numReplayCalls := 0
+	newArgs.Replayer = &txReplayerStub{
+		ReplayTransactionCalled: func(txBuff []byte) error {
+			numReplayCalls++
+
+			tx := &transaction.Transaction{}
+			err := marshaller.Unmarshal(tx, txBuff)
+			require.Nil(t, err)
+			if tx.Nonce != 1 {
+				return process.ErrWrongTransaction
+			}
+
+			_ = addTxToPool(newArgs.TxPool, tx.Nonce, "0")
+			return nil
+		},
+	}
+	newJournal, err := NewTxPoolJournal(newArgs)
+	require.Nil(t, err)
+
+	err = newJournal.Replay()
+	require.Nil(t, err)
+	require.Equal(t, 3, numReplayCalls)
+	require.Equal(t, 1, newArgs.TxPool.ShardDataStore("0").Len())
+	require.Equal(t, []*transaction.Transaction{tx1}, unmarshalRecords(t, newJournal.filePath))
+
+	require.Nil(t, newJournal.Close())
+}
+
+func TestDisabledTxPoolJournal(t *testing.T) {
+	t.Parallel()
+
+	journal := NewDisabledTxPoolJournal()
+	require.False(t, check.IfNil(journal))
+	require.Nil(t, journal.Replay())
+	require.Nil(t, journal.Close())
+}
@@ -0,0 +1,34 @@
+package txsSender
+
+import "github.com/multiversx/mx-chain-go/common"
+
+type disabledTxsQueue struct {
+}
+
+// NewDisabledTxsQueue creates a transactions queue which rejects all the transactions, used when the queue is not enabled
+func NewDisabledTxsQueue() *disabledTxsQueue {
+	return &disabledTxsQueue{}
+}
+
+// EnqueueTransaction returns ErrTxsQueueDisabled
+func (queue *disabledTxsQueue) EnqueueTransaction(_ *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error) {
+	return nil, ErrTxsQueueDisabled
+}
+
+// GetTransactionsQueueState returns an empty state
+func (queue *disabledTxsQueue) GetTransactionsQueueState() *common.TransactionsQueueStateApiResponse {
+	return &common.TransactionsQueueStateApiResponse{
+		Senders:      make([]common.TransactionsQueueSenderState, 0),
+		Transactions: make([]common.TransactionsQueueTransactionState, 0),
+	}
+}
+
+// Close returns nil
+func (queue *disabledTxsQueue) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (queue *disabledTxsQueue) IsInterfaceNil() bool {
+	return queue == nil
+}
@@ -0,0 +1,36 @@
+package txsSender
+
+import "errors"
+
+// ErrNilTxsSender signals that a nil transactions sender has been provided
+var ErrNilTxsSender = errors.New("nil transactions sender")
+
+// ErrNilTxStatusProvider signals that a nil transaction status provider has been provided
+var ErrNilTxStatusProvider = errors.New("nil transaction status provider")
+
+// ErrNilAccountsRepository signals that a nil accounts repository has been provided
+var ErrNilAccountsRepository = errors.New("nil accounts repository")
+
+// ErrInvalidTxsQueueConfig signals that an invalid transactions queue config has been provided
+var ErrInvalidTxsQueueConfig = errors.New("invalid transactions queue config")
+
+// ErrNoWalletKeys signals that no wallet key was found in the wallet keys file
+var ErrNoWalletKeys = errors.New("no wallet keys")
+
+// ErrWalletKeyNotInSelfShard signals that a wallet key belongs to a sender from another shard
+var ErrWalletKeyNotInSelfShard = errors.New("wallet key does not belong to a sender from the node's shard")
+
+// ErrSenderNotRegistered signals that the sender of the transaction has no wallet key registered on the node
+var ErrSenderNotRegistered = errors.New("sender is not registered")
+
+// ErrInvalidGasLimit signals that an invalid gas limit has been provided
+var ErrInvalidGasLimit = errors.New("invalid gas limit")
+
+// ErrGasPriceTooLow signals that the provided gas price is lower than the minimum one
+var ErrGasPriceTooLow = errors.New("gas price is lower than the minimum gas price")
+
+// ErrTxsQueueDisabled signals that the transactions queue is not enabled
+var ErrTxsQueueDisabled = errors.New("transactions queue is disabled")
+
+// ErrGasPriceOverflow signals that the bumped gas price does not fit the transaction's gas price field
+var ErrGasPriceOverflow = errors.New("bumped gas price overflows")
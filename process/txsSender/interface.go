@@ -2,6 +2,8 @@ package txsSender
 
 import (
 	"io"
+
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 // NetworkMessenger defines the basic functionality of a network messenger
@@ -12,3 +14,9 @@ type NetworkMessenger interface {
 	BroadcastOnChannel(channel string, topic string, buff []byte)
 	IsInterfaceNil() bool
 }
+
+// TxStatusProvider defines the component able to compute the status of a transaction
+type TxStatusProvider interface {
+	GetTransactionStatus(txHash string) (*common.TransactionStatusApiResponse, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,730 @@
+package txsSender
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const (
+	queuedTxStatusPending  = "pending"
+	queuedTxStatusExecuted = "executed"
+	queuedTxStatusFailed   = "failed"
+)
+
+const (
+	reasonMaxResubmissionsReached = "max resubmissions reached"
+	reasonNonceConsumed           = "nonce consumed by another transaction"
+	reasonGapFilled               = "max resubmissions reached, the nonce was consumed by a gap filling transaction"
+)
+
+// ArgsTxsQueue is a holder struct for all necessary arguments to create a NewTxsQueue
+type ArgsTxsQueue struct {
+	Config                 config.TxsQueueConfig
+	TxsSender              process.TxsSenderHandler
+	TxStatusProvider       TxStatusProvider
+	AccountsRepository     state.AccountsRepository
+	ShardCoordinator       storage.ShardCoordinator
+	AddressPubKeyConverter core.PubkeyConverter
+	Marshaller             marshal.Marshalizer
+	Hasher                 hashing.Hasher
+	TxSignMarshaller       marshal.Marshalizer
+	TxSignHasher           hashing.Hasher
+	KeyGen                 crypto.KeyGenerator
+	SingleSigner           crypto.SingleSigner
+	ChainID                []byte
+	MinTxVersion           uint32
+	MinGasPrice            uint64
+	MinGasLimit            uint64
+}
+
+// queuedTransaction tracks all the submitted versions of a transaction. If it is replaced by a gap filler, the last
+// version is a transfer of 0 to the sender itself, which only consumes the nonce
+type queuedTransaction struct {
+	tx             *transaction.Transaction
+	hash           []byte
+	previousHashes [][]byte
+	sender         string
+	status         string
+	reason         string
+	resubmissions  uint32
+	lastSubmitTime time.Time
+	isGapFiller    bool
+}
+
+type queueSender struct {
+	key         *walletKey
+	nextNonce   uint64
+	pending     []*queuedTransaction
+	numExecuted int
+	numFailed   int
+}
+
+type txsQueue struct {
+	txsSender              process.TxsSenderHandler
+	txStatusProvider       TxStatusProvider
+	accountsRepository     state.AccountsRepository
+	addressPubKeyConverter core.PubkeyConverter
+	marshaller             marshal.Marshalizer
+	hasher                 hashing.Hasher
+	txSignMarshaller       marshal.Marshalizer
+	txSignHasher           hashing.Hasher
+	singleSigner           crypto.SingleSigner
+	chainID                []byte
+	minTxVersion           uint32
+	minGasPrice            uint64
+	minGasLimit            uint64
+
+	resubmitAfter           time.Duration
+	maxResubmissions        uint32
+	gasPriceBumpPercentage  uint32
+	maxFinishedTransactions int
+	getTimeHandler          func() time.Time
+
+	mutQueue      sync.Mutex
+	senders       map[string]*queueSender
+	sortedSenders []string
+	finished      []*queuedTransaction
+
+	cancelFunc context.CancelFunc
+}
+
+// NewTxsQueue creates the service which sends transactions on behalf of the wallet keys registered on the node. The
+// service assigns the nonces, signs the transactions and tracks each of them until it is executed or it fails,
+// submitting again the ones dropped from the network
+func NewTxsQueue(args ArgsTxsQueue) (*txsQueue, error) {
+	err := checkTxsQueueArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := loadWalletKeys(args.Config.WalletKeysFile, args.KeyGen, args.AddressPubKeyConverter, args.ShardCoordinator)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := &txsQueue{
+		txsSender:               args.TxsSender,
+		txStatusProvider:        args.TxStatusProvider,
+		accountsRepository:      args.AccountsRepository,
+		addressPubKeyConverter:  args.AddressPubKeyConverter,
+		marshaller:              args.Marshaller,
+		hasher:                  args.Hasher,
+		txSignMarshaller:        args.TxSignMarshaller,
+		txSignHasher:            args.TxSignHasher,
+		singleSigner:            args.SingleSigner,
+		chainID:                 args.ChainID,
+		minTxVersion:            args.MinTxVersion,
+		minGasPrice:             args.MinGasPrice,
+		minGasLimit:             args.MinGasLimit,
+		resubmitAfter:           time.Duration(args.Config.ResubmitAfterInSeconds) * time.Second,
+		maxResubmissions:        args.Config.MaxResubmissions,
+		gasPriceBumpPercentage:  args.Config.GasPriceBumpPercentage,
+		maxFinishedTransactions: int(args.Config.MaxFinishedTransactions),
+		getTimeHandler:          time.Now,
+		senders:                 make(map[string]*queueSender, len(keys)),
+		sortedSenders:           make([]string, 0, len(keys)),
+		finished:                make([]*queuedTransaction, 0),
+	}
+
+	for encodedAddress, key := range keys {
+		queue.senders[encodedAddress] = &queueSender{
+			key:     key,
+			pending: make([]*queuedTransaction, 0),
+		}
+		queue.sortedSenders = append(queue.sortedSenders, encodedAddress)
+	}
+	sort.Strings(queue.sortedSenders)
+
+	log.Info("txsQueue: registered wallets", "num", len(queue.sortedSenders))
+
+	var ctx context.Context
+	ctx, queue.cancelFunc = context.WithCancel(context.Background())
+	monitorInterval := time.Duration(args.Config.MonitorIntervalInMilliseconds) * time.Millisecond
+	go queue.monitorPeriodically(ctx, monitorInterval)
+
+	return queue, nil
+}
+
+func checkTxsQueueArgs(args ArgsTxsQueue) error {
+	if args.Config.MonitorIntervalInMilliseconds == 0 {
+		return fmt.Errorf("%w: MonitorIntervalInMilliseconds should be greater than 0", ErrInvalidTxsQueueConfig)
+	}
+	if args.Config.ResubmitAfterInSeconds == 0 {
+		return fmt.Errorf("%w: ResubmitAfterInSeconds should be greater than 0", ErrInvalidTxsQueueConfig)
+	}
+	if check.IfNil(args.TxsSender) {
+		return ErrNilTxsSender
+	}
+	if check.IfNil(args.TxStatusProvider) {
+		return ErrNilTxStatusProvider
+	}
+	if check.IfNil(args.AccountsRepository) {
+		return ErrNilAccountsRepository
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.Marshaller) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return process.ErrNilHasher
+	}
+	if check.IfNil(args.TxSignMarshaller) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.TxSignHasher) {
+		return process.ErrNilHasher
+	}
+	if check.IfNil(args.KeyGen) {
+		return process.ErrNilKeyGen
+	}
+	if check.IfNil(args.SingleSigner) {
+		return process.ErrNilSingleSigner
+	}
+	if len(args.ChainID) == 0 {
+		return process.ErrInvalidChainID
+	}
+	if args.MinGasLimit == 0 {
+		return fmt.Errorf("%w: the minimum gas limit should be greater than 0", ErrInvalidGasLimit)
+	}
+
+	return nil
+}
+
+// EnqueueTransaction assigns the next nonce of the sender to the provided transaction, signs it and sends it, tracking
+// it until it is executed or it fails
+func (queue *txsQueue) EnqueueTransaction(request *common.TransactionsQueueEnqueueRequest) (*common.TransactionsQueueEnqueueApiResponse, error) {
+	tx, err := queue.createTransaction(request)
+	if err != nil {
+		return nil, err
+	}
+
+	// the registered senders are only set on construction, so they can be read without the lock
+	sender, ok := queue.senders[request.Sender]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrSenderNotRegistered, request.Sender)
+	}
+
+	accountNonce, err := queue.getAccountNonce(sender.key.address)
+	if err != nil {
+		return nil, err
+	}
+
+	queuedTx, txHash, err := queue.addPendingTransaction(tx, sender, request.Sender, accountNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = queue.txsSender.SendBulkTransactions([]*transaction.Transaction{tx})
+	if err != nil {
+		isNonceReleased := queue.removePendingTransaction(queuedTx, sender)
+		if isNonceReleased {
+			return nil, err
+		}
+
+		// a following nonce was already assigned, so the transaction is kept and submitted again by the monitoring
+		log.Warn("txsQueue.EnqueueTransaction: cannot send transaction, it will be submitted again",
+			"sender", request.Sender, "nonce", tx.Nonce, "error", err)
+	}
+
+	return &common.TransactionsQueueEnqueueApiResponse{
+		TxHash: hex.EncodeToString(txHash),
+		Nonce:  tx.Nonce,
+	}, nil
+}
+
+// addPendingTransaction assigns the next nonce of the sender to the transaction, signs it and starts tracking it. The
+// hash of the signed transaction is returned separately, as the tracked one changes when the transaction is resubmitted
+func (queue *txsQueue) addPendingTransaction(
+	tx *transaction.Transaction,
+	sender *queueSender,
+	encodedAddress string,
+	accountNonce uint64,
+) (*queuedTransaction, []byte, error) {
+	queue.mutQueue.Lock()
+	defer queue.mutQueue.Unlock()
+
+	if sender.nextNonce < accountNonce {
+		sender.nextNonce = accountNonce
+	}
+
+	tx.Nonce = sender.nextNonce
+	tx.SndAddr = sender.key.address
+	txHash, err := queue.signTransaction(tx, sender.key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queuedTx := &queuedTransaction{
+		tx:             tx,
+		hash:           txHash,
+		previousHashes: make([][]byte, 0),
+		sender:         encodedAddress,
+		status:         queuedTxStatusPending,
+		lastSubmitTime: queue.getTimeHandler(),
+	}
+	sender.nextNonce++
+	sender.pending = append(sender.pending, queuedTx)
+
+	return queuedTx, txHash, nil
+}
+
+// removePendingTransaction stops tracking a transaction which could not be sent, releasing its nonce, unless a
+// following nonce was already assigned in the meantime. It returns true if the transaction was removed
+func (queue *txsQueue) removePendingTransaction(queuedTx *queuedTransaction, sender *queueSender) bool {
+	queue.mutQueue.Lock()
+	defer queue.mutQueue.Unlock()
+
+	isLastAssignedNonce := sender.nextNonce == queuedTx.tx.Nonce+1
+	if !isLastAssignedNonce {
+		return false
+	}
+
+	for i, pendingTx := range sender.pending {
+		if pendingTx == queuedTx {
+			sender.pending = append(sender.pending[:i], sender.pending[i+1:]...)
+			sender.nextNonce = queuedTx.tx.Nonce
+			return true
+		}
+	}
+
+	return false
+}
+
+func (queue *txsQueue) createTransaction(request *common.TransactionsQueueEnqueueRequest) (*transaction.Transaction, error) {
+	receiver, err := queue.addressPubKeyConverter.Decode(request.Receiver)
+	if err != nil {
+		return nil, fmt.Errorf("%w for receiver %s", err, request.Receiver)
+	}
+
+	value := big.NewInt(0)
+	if len(request.Value) > 0 {
+		var ok bool
+		value, ok = big.NewInt(0).SetString(request.Value, 10)
+		if !ok || value.Sign() < 0 {
+			return nil, fmt.Errorf("%w: %s", process.ErrInvalidValue, request.Value)
+		}
+	}
+
+	if request.GasLimit == 0 {
+		return nil, ErrInvalidGasLimit
+	}
+
+	gasPrice := request.GasPrice
+	if gasPrice == 0 {
+		gasPrice = queue.minGasPrice
+	}
+	if gasPrice < queue.minGasPrice {
+		return nil, fmt.Errorf("%w: provided %d, minimum %d", ErrGasPriceTooLow, gasPrice, queue.minGasPrice)
+	}
+
+	return &transaction.Transaction{
+		Value:    value,
+		RcvAddr:  receiver,
+		GasPrice: gasPrice,
+		GasLimit: request.GasLimit,
+		Data:     request.Data,
+		ChainID:  queue.chainID,
+		Version:  queue.minTxVersion,
+	}, nil
+}
+
+func (queue *txsQueue) signTransaction(tx *transaction.Transaction, key *walletKey) ([]byte, error) {
+	tx.Signature = nil
+	dataToSign, err := tx.GetDataForSigning(queue.addressPubKeyConverter, queue.txSignMarshaller, queue.txSignHasher)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Signature, err = queue.singleSigner.Sign(key.privateKey, dataToSign)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.CalculateHash(queue.marshaller, queue.hasher, tx)
+}
+
+func (queue *txsQueue) getAccountNonce(address []byte) (uint64, error) {
+	account, _, err := queue.accountsRepository.GetAccountWithBlockInfo(address, api.AccountQueryOptions{})
+	if err != nil {
+		var errAccountNotFound *state.ErrAccountNotFoundAtBlock
+		if errors.As(err, &errAccountNotFound) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return account.GetNonce(), nil
+}
+
+func (queue *txsQueue) monitorPeriodically(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-time.After(interval):
+			queue.monitorTransactions()
+		case <-ctx.Done():
+			log.Debug("txsQueue: closing the monitoring go routine")
+			return
+		}
+	}
+}
+
+// monitorTransactions checks the status of all the pending transactions, submitting again the ones dropped from the
+// network or stuck in the pools for too long
+func (queue *txsQueue) monitorTransactions() {
+	accountNonces := queue.getPendingSendersNonces()
+	txsToResend := queue.checkPendingTransactions(accountNonces)
+	if len(txsToResend) == 0 {
+		return
+	}
+
+	_, err := queue.txsSender.SendBulkTransactions(txsToResend)
+	if err != nil {
+		log.Warn("txsQueue.monitorTransactions: cannot resend transactions", "num", len(txsToResend), "error", err)
+	}
+}
+
+// getPendingSendersNonces returns the account nonces of the senders having pending transactions. The accounts are
+// loaded without holding the lock, so that the enqueueing is not blocked meanwhile
+func (queue *txsQueue) getPendingSendersNonces() map[string]uint64 {
+	queue.mutQueue.Lock()
+	pendingSenders := make([]string, 0, len(queue.sortedSenders))
+	for _, encodedAddress := range queue.sortedSenders {
+		if len(queue.senders[encodedAddress].pending) > 0 {
+			pendingSenders = append(pendingSenders, encodedAddress)
+		}
+	}
+	queue.mutQueue.Unlock()
+
+	accountNonces := make(map[string]uint64, len(pendingSenders))
+	for _, encodedAddress := range pendingSenders {
+		accountNonce, err := queue.getAccountNonce(queue.senders[encodedAddress].key.address)
+		if err != nil {
+			log.Debug("txsQueue.monitorTransactions: cannot get the sender's nonce", "sender", encodedAddress, "error", err)
+			continue
+		}
+
+		accountNonces[encodedAddress] = accountNonce
+	}
+
+	return accountNonces
+}
+
+// checkPendingTransactions updates the status of the pending transactions of the provided senders and returns the
+// new versions of the ones which should be submitted again
+func (queue *txsQueue) checkPendingTransactions(accountNonces map[string]uint64) []*transaction.Transaction {
+	queue.mutQueue.Lock()
+	defer queue.mutQueue.Unlock()
+
+	txsToResend := make([]*transaction.Transaction, 0)
+	for _, encodedAddress := range queue.sortedSenders {
+		sender := queue.senders[encodedAddress]
+		accountNonce, ok := accountNonces[encodedAddress]
+		if !ok {
+			continue
+		}
+
+		stillPending := make([]*queuedTransaction, 0, len(sender.pending))
+		for i, queuedTx := range sender.pending {
+			shouldResend, isAnyVersionFound := queue.checkQueuedTransaction(queuedTx, accountNonce)
+			if shouldResend {
+				isLastPending := i == len(sender.pending)-1
+				queue.resubmitTransaction(queuedTx, sender, isLastPending && !isAnyVersionFound)
+				if queuedTx.status == queuedTxStatusPending {
+					txsToResend = append(txsToResend, queuedTx.tx)
+				}
+			}
+
+			switch queuedTx.status {
+			case queuedTxStatusPending:
+				stillPending = append(stillPending, queuedTx)
+			case queuedTxStatusExecuted:
+				sender.numExecuted++
+				queue.addFinishedTransaction(queuedTx)
+			default:
+				sender.numFailed++
+				queue.addFinishedTransaction(queuedTx)
+			}
+		}
+		sender.pending = stillPending
+	}
+
+	return txsToResend
+}
+
+// checkQueuedTransaction updates the status of the queued transaction and returns true if it should be submitted again,
+// along with true if any of its versions is still found, so it might still be executed
+func (queue *txsQueue) checkQueuedTransaction(queuedTx *queuedTransaction, accountNonce uint64) (bool, bool) {
+	txStatus, isLastVersion, found := queue.getStatus(queuedTx)
+	isNonceConsumed := accountNonce > queuedTx.tx.Nonce
+	isResubmitTimeReached := queue.getTimeHandler().Sub(queuedTx.lastSubmitTime) >= queue.resubmitAfter
+
+	if !found {
+		if !isResubmitTimeReached {
+			return false, false
+		}
+		if isNonceConsumed {
+			// none of the versions was executed and none of them can be executed anymore. As the nonce was consumed,
+			// the following transactions of the sender are not blocked
+			queuedTx.markFinished(queuedTxStatusFailed, reasonNonceConsumed)
+			return false, false
+		}
+
+		return true, queue.isAnyPreviousVersionFound(queuedTx)
+	}
+
+	isGapFillerExecuted := queuedTx.isGapFiller && isLastVersion
+	switch transaction.TxStatus(txStatus.Status) {
+	case transaction.TxStatusSuccess:
+		if isGapFillerExecuted {
+			queuedTx.markFinished(queuedTxStatusFailed, reasonGapFilled)
+			return false, false
+		}
+
+		queuedTx.markFinished(queuedTxStatusExecuted, "")
+		return false, false
+	case transaction.TxStatusFail, transaction.TxStatusInvalid, transaction.TxStatusRewardReverted:
+		if isGapFillerExecuted {
+			queuedTx.markFinished(queuedTxStatusFailed, reasonGapFilled)
+			return false, false
+		}
+
+		queuedTx.markFinished(queuedTxStatusFailed, fmt.Sprintf("transaction status: %s", txStatus.Status))
+		return false, false
+	case common.TxStatusReplaced:
+		queuedTx.markFinished(queuedTxStatusFailed, fmt.Sprintf("replaced by transaction %s", txStatus.ReplacedBy))
+		return false, false
+	default:
+		// a pending transaction with a consumed nonce was executed on the source shard and waits for the destination
+		// shard, while one with a higher nonce than the sender's waits for its predecessors. Only the transaction
+		// blocking the sender is stuck in the pools, so only that one is worth being submitted again with a higher gas price
+		isBlockingTheSender := accountNonce == queuedTx.tx.Nonce
+		return isBlockingTheSender && isResubmitTimeReached, true
+	}
+}
+
+// getStatus returns the status of the last submitted version of the transaction, unless one of its previous versions
+// was already executed. The second returned value is true if the status belongs to the last version
+func (queue *txsQueue) getStatus(queuedTx *queuedTransaction) (*common.TransactionStatusApiResponse, bool, bool) {
+	for i := len(queuedTx.previousHashes) - 1; i >= 0; i-- {
+		txStatus, err := queue.txStatusProvider.GetTransactionStatus(hex.EncodeToString(queuedTx.previousHashes[i]))
+		if err == nil && isExecutedStatus(txStatus.Status) {
+			return txStatus, false, true
+		}
+	}
+
+	txStatus, err := queue.txStatusProvider.GetTransactionStatus(hex.EncodeToString(queuedTx.hash))
+	if err != nil {
+		return nil, false, false
+	}
+
+	return txStatus, true, true
+}
+
+// isAnyPreviousVersionFound returns true if any of the previous versions of the transaction is still known, as it might
+// be pending on other nodes and later be executed with the same nonce
+func (queue *txsQueue) isAnyPreviousVersionFound(queuedTx *queuedTransaction) bool {
+	for _, hash := range queuedTx.previousHashes {
+		_, err := queue.txStatusProvider.GetTransactionStatus(hex.EncodeToString(hash))
+		if err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isExecutedStatus(status string) bool {
+	switch transaction.TxStatus(status) {
+	case transaction.TxStatusSuccess, transaction.TxStatusFail, transaction.TxStatusInvalid, transaction.TxStatusRewardReverted:
+		return true
+	default:
+		return false
+	}
+}
+
+// resubmitTransaction signs a new version of the transaction, paying a higher gas price, so that it replaces the
+// previous version if the latter is still found in the pools. Once the max number of resubmissions is reached, the
+// transaction can only be dropped if it is the last one of the sender and none of its versions is found anymore, so
+// its nonce can be assigned again. Otherwise, the nonce is filled by a transfer of 0 to the sender itself, so the
+// following transactions are not blocked. Their nonces are never assigned again, as their already submitted versions
+// might still be executed
+func (queue *txsQueue) resubmitTransaction(queuedTx *queuedTransaction, sender *queueSender, canReleaseNonce bool) {
+	if queuedTx.isGapFiller {
+		// the gap filler already pays more than all the previous versions, so it is only submitted again
+		queuedTx.lastSubmitTime = queue.getTimeHandler()
+		return
+	}
+
+	newTx := *queuedTx.tx
+	isMaxResubmissionsReached := queuedTx.resubmissions >= queue.maxResubmissions
+	if isMaxResubmissionsReached {
+		if canReleaseNonce {
+			queuedTx.markFinished(queuedTxStatusFailed, reasonMaxResubmissionsReached)
+			sender.nextNonce = queuedTx.tx.Nonce
+			return
+		}
+
+		newTx = queue.createGapFiller(queuedTx.tx)
+	}
+
+	newGasPrice, err := queue.computeBumpedGasPrice(queuedTx.tx.GasPrice)
+	if err != nil {
+		log.Warn("txsQueue.resubmitTransaction: cannot bump the gas price", "sender", queuedTx.sender,
+			"nonce", newTx.Nonce, "gas price", queuedTx.tx.GasPrice, "error", err)
+		return
+	}
+
+	newTx.GasPrice = newGasPrice
+	newHash, err := queue.signTransaction(&newTx, sender.key)
+	if err != nil {
+		log.Warn("txsQueue.resubmitTransaction: cannot sign transaction", "sender", queuedTx.sender,
+			"nonce", newTx.Nonce, "error", err)
+		return
+	}
+
+	log.Debug("txsQueue: resubmitting transaction", "sender", queuedTx.sender, "nonce", newTx.Nonce,
+		"previous hash", queuedTx.hash, "new hash", newHash, "gas price", newTx.GasPrice,
+		"is gap filler", isMaxResubmissionsReached)
+
+	queuedTx.previousHashes = append(queuedTx.previousHashes, queuedTx.hash)
+	queuedTx.tx = &newTx
+	queuedTx.hash = newHash
+	queuedTx.isGapFiller = isMaxResubmissionsReached
+	if !isMaxResubmissionsReached {
+		queuedTx.resubmissions++
+	}
+	queuedTx.lastSubmitTime = queue.getTimeHandler()
+}
+
+func (queue *txsQueue) createGapFiller(tx *transaction.Transaction) transaction.Transaction {
+	return transaction.Transaction{
+		Nonce:    tx.Nonce,
+		Value:    big.NewInt(0),
+		RcvAddr:  tx.SndAddr,
+		SndAddr:  tx.SndAddr,
+		GasPrice: tx.GasPrice,
+		GasLimit: queue.minGasLimit,
+		ChainID:  tx.ChainID,
+		Version:  tx.Version,
+	}
+}
+
+// computeBumpedGasPrice returns gasPrice * (100 + bump percentage) / 100, rounded up, so that the new version is
+// accepted by the pools as a replacement of the previous one
+func (queue *txsQueue) computeBumpedGasPrice(gasPrice uint64) (uint64, error) {
+	oldGasPrice := big.NewInt(0).SetUint64(gasPrice)
+	bumped := big.NewInt(0).Mul(oldGasPrice, big.NewInt(0).SetUint64(100+uint64(queue.gasPriceBumpPercentage)))
+	bumped.Add(bumped, big.NewInt(99))
+	bumped.Div(bumped, big.NewInt(100))
+	if bumped.Cmp(oldGasPrice) <= 0 {
+		bumped.Add(oldGasPrice, big.NewInt(1))
+	}
+	if !bumped.IsUint64() {
+		return 0, fmt.Errorf("%w: gas price %d, bump percentage %d", ErrGasPriceOverflow, gasPrice, queue.gasPriceBumpPercentage)
+	}
+
+	return bumped.Uint64(), nil
+}
+
+func (queue *txsQueue) addFinishedTransaction(queuedTx *queuedTransaction) {
+	queue.finished = append(queue.finished, queuedTx)
+	if len(queue.finished) <= queue.maxFinishedTransactions {
+		return
+	}
+
+	numToRemove := len(queue.finished) - queue.maxFinishedTransactions
+	queue.finished = queue.finished[numToRemove:]
+}
+
+func (queuedTx *queuedTransaction) markFinished(status string, reason string) {
+	queuedTx.status = status
+	queuedTx.reason = reason
+}
+
+// GetTransactionsQueueState returns the nonces of the registered senders, along with the pending transactions and the
+// most recent finished ones
+func (queue *txsQueue) GetTransactionsQueueState() *common.TransactionsQueueStateApiResponse {
+	queue.mutQueue.Lock()
+	defer queue.mutQueue.Unlock()
+
+	response := &common.TransactionsQueueStateApiResponse{
+		Senders:      make([]common.TransactionsQueueSenderState, 0, len(queue.sortedSenders)),
+		Transactions: make([]common.TransactionsQueueTransactionState, 0),
+	}
+
+	for _, encodedAddress := range queue.sortedSenders {
+		sender := queue.senders[encodedAddress]
+		response.Senders = append(response.Senders, common.TransactionsQueueSenderState{
+			Address:     encodedAddress,
+			NextNonce:   sender.nextNonce,
+			NumPending:  len(sender.pending),
+			NumExecuted: sender.numExecuted,
+			NumFailed:   sender.numFailed,
+		})
+
+		for _, queuedTx := range sender.pending {
+			response.Transactions = append(response.Transactions, queuedTx.toApiState())
+		}
+	}
+
+	for _, queuedTx := range queue.finished {
+		response.Transactions = append(response.Transactions, queuedTx.toApiState())
+	}
+
+	return response
+}
+
+func (queuedTx *queuedTransaction) toApiState() common.TransactionsQueueTransactionState {
+	previousHashes := make([]string, 0, len(queuedTx.previousHashes))
+	for _, hash := range queuedTx.previousHashes {
+		previousHashes = append(previousHashes, hex.EncodeToString(hash))
+	}
+
+	return common.TransactionsQueueTransactionState{
+		Hash:           hex.EncodeToString(queuedTx.hash),
+		Sender:         queuedTx.sender,
+		Nonce:          queuedTx.tx.Nonce,
+		GasPrice:       queuedTx.tx.GasPrice,
+		Status:         queuedTx.status,
+		Reason:         queuedTx.reason,
+		Resubmissions:  queuedTx.resubmissions,
+		PreviousHashes: previousHashes,
+	}
+}
+
+// Close stops the monitoring of the queued transactions
+func (queue *txsQueue) Close() error {
+	queue.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (queue *txsQueue) IsInterfaceNil() bool {
+	return queue == nil
+}
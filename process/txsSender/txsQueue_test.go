@@ -0,0 +1,822 @@
+package txsSender
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/testscommon/txsSenderMock"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+)
+
+const testMinGasPrice = uint64(1_000_000_000)
+const testMinGasLimit = uint64(50_000)
+
+var testKeyGen = signing.NewKeyGenerator(ed25519.NewEd25519())
+
+type txsQueueTestContext struct {
+	args        ArgsTxsQueue
+	addresses   []string
+	mutNonces   sync.Mutex
+	nonces      map[string]uint64
+	mutSentTxs  sync.Mutex
+	sentTxs     []*transaction.Transaction
+	mutStatuses sync.Mutex
+	statuses    map[string]*common.TransactionStatusApiResponse
+}
+
+func createTxsQueueTestContext(t *testing.T, numKeys int) *txsQueueTestContext {
+	pubKeyConverter := testscommon.RealWorldBech32PubkeyConverter
+	walletKeysFile := filepath.Join(t.TempDir(), "walletKeys.pem")
+	file, err := os.Create(walletKeysFile)
+	require.Nil(t, err)
+
+	addresses := make([]string, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		privateKey, publicKey := testKeyGen.GeneratePair()
+		privateKeyBytes, _ := privateKey.ToByteArray()
+		publicKeyBytes, _ := publicKey.ToByteArray()
+		address, _ := pubKeyConverter.Encode(publicKeyBytes)
+		addresses = append(addresses, address)
+
+		err = core.SaveSkToPemFile(file, address, []byte(hex.EncodeToString(privateKeyBytes)))
+		require.Nil(t, err)
+	}
+	require.Nil(t, file.Close())
+
+	testContext := &txsQueueTestContext{
+		addresses: addresses,
+		nonces:    make(map[string]uint64),
+		sentTxs:   make([]*transaction.Transaction, 0),
+		statuses:  make(map[string]*common.TransactionStatusApiResponse),
+	}
+
+	testContext.args = ArgsTxsQueue{
+		Config: config.TxsQueueConfig{
+			Enabled:                       true,
+			WalletKeysFile:                walletKeysFile,
+			MonitorIntervalInMilliseconds: 3_600_000,
+			ResubmitAfterInSeconds:        60,
+			MaxResubmissions:              2,
+			GasPriceBumpPercentage:        10,
+			MaxFinishedTransactions:       10,
+		},
+		TxsSender: &txsSenderMock.TxsSenderHandlerMock{
+			SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+				testContext.mutSentTxs.Lock()
+				testContext.sentTxs = append(testContext.sentTxs, txs...)
+				testContext.mutSentTxs.Unlock()
+
+				return uint64(len(txs)), nil
+			},
+		},
+		TxStatusProvider: &txsSenderMock.TxStatusProviderStub{
+			GetTransactionStatusCalled: func(txHash string) (*common.TransactionStatusApiResponse, error) {
+				testContext.mutStatuses.Lock()
+				defer testContext.mutStatuses.Unlock()
+
+				txStatus, ok := testContext.statuses[txHash]
+				if !ok {
+					return nil, errors.New("transaction not found")
+				}
+
+				return txStatus, nil
+			},
+		},
+		AccountsRepository: &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				testContext.mutNonces.Lock()
+				defer testContext.mutNonces.Unlock()
+
+				nonce, ok := testContext.nonces[string(address)]
+				if !ok {
+					return nil, nil, state.NewErrAccountNotFoundAtBlock(holders.NewBlockInfo(nil, 0, nil))
+				}
+
+				account := stateMock.NewAccountWrapMock(address)
+				account.IncreaseNonce(nonce)
+				return account, nil, nil
+			},
+		},
+		ShardCoordinator:       testscommon.NewMultiShardsCoordinatorMock(1),
+		AddressPubKeyConverter: pubKeyConverter,
+		Marshaller:             &marshal.GogoProtoMarshalizer{},
+		Hasher:                 &hashingMocks.HasherMock{},
+		TxSignMarshaller:       &marshal.JsonMarshalizer{},
+		TxSignHasher:           &hashingMocks.HasherMock{},
+		KeyGen:                 testKeyGen,
+		SingleSigner:           &singlesig.Ed25519Signer{},
+		ChainID:                []byte("T"),
+		MinTxVersion:           1,
+		MinGasPrice:            testMinGasPrice,
+		MinGasLimit:            testMinGasLimit,
+	}
+
+	return testContext
+}
+
+func (testContext *txsQueueTestContext) setAccountNonce(t *testing.T, address string, nonce uint64) {
+	addressBytes, err := testContext.args.AddressPubKeyConverter.Decode(address)
+	require.Nil(t, err)
+
+	testContext.mutNonces.Lock()
+	testContext.nonces[string(addressBytes)] = nonce
+	testContext.mutNonces.Unlock()
+}
+
+func (testContext *txsQueueTestContext) setTxStatus(txHash string, status transaction.TxStatus) {
+	testContext.mutStatuses.Lock()
+	testContext.statuses[txHash] = &common.TransactionStatusApiResponse{Status: string(status)}
+	testContext.mutStatuses.Unlock()
+}
+
+func (testContext *txsQueueTestContext) getSentTxs() []*transaction.Transaction {
+	testContext.mutSentTxs.Lock()
+	defer testContext.mutSentTxs.Unlock()
+
+	return append(make([]*transaction.Transaction, 0, len(testContext.sentTxs)), testContext.sentTxs...)
+}
+
+func createEnqueueRequest(sender string) *common.TransactionsQueueEnqueueRequest {
+	return &common.TransactionsQueueEnqueueRequest{
+		Sender:   sender,
+		Receiver: testscommon.TestAddressBob,
+		Value:    "1000",
+		Data:     []byte("data"),
+		GasLimit: 100_000,
+	}
+}
+
+func createQueueWithControlledTime(t *testing.T, testContext *txsQueueTestContext) (*txsQueue, *time.Time) {
+	queue, err := NewTxsQueue(testContext.args)
+	require.Nil(t, err)
+
+	currentTime := time.Now()
+	queue.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	return queue, &currentTime
+}
+
+func TestNewTxsQueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid monitor interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		testContext.args.Config.MonitorIntervalInMilliseconds = 0
+		queue, err := NewTxsQueue(testContext.args)
+		require.Nil(t, queue)
+		require.True(t, errors.Is(err, ErrInvalidTxsQueueConfig))
+	})
+	t.Run("invalid resubmit interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		testContext.args.Config.ResubmitAfterInSeconds = 0
+		queue, err := NewTxsQueue(testContext.args)
+		require.Nil(t, queue)
+		require.True(t, errors.Is(err, ErrInvalidTxsQueueConfig))
+	})
+	t.Run("nil txs sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		testContext.args.TxsSender = nil
+		queue, err := NewTxsQueue(testContext.args)
+		require.Nil(t, queue)
+		require.Equal(t, ErrNilTxsSender, err)
+	})
+	t.Run("nil tx status provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		testContext.args.TxStatusProvider = nil
+		queue, err := NewTxsQueue(testContext.args)
+		require.Nil(t, queue)
+		require.Equal(t, ErrNilTxStatusProvider, err)
+	})
+	t.Run("nil accounts repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		testContext.args.AccountsRepository = nil
+		queue, err := NewTxsQueue(testContext.args)
+		require.Nil(t, queue)
+		require.Equal(t, ErrNilAccountsRepository, err)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		testContext.args.SingleSigner = nil
+		queue, err := NewTxsQueue(testContext.args)
+		require.Nil(t, queue)
+		require.Equal(t, process.ErrNilSingleSigner, err)
+	})
+	t.Run("empty chain ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		testContext.args.ChainID = nil
+		queue, err := NewTxsQueue(testContext.args)
+		require.Nil(t, queue)
+		require.Equal(t, process.ErrInvalidChainID, err)
+	})
+	t.Run("zero min gas limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		testContext.args.MinGasLimit = 0
+		queue, err := NewTxsQueue(testContext.args)
+		require.Nil(t, queue)
+		require.True(t, errors.Is(err, ErrInvalidGasLimit))
+	})
+	t.Run("missing wallet keys file should error", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		testContext.args.Config.WalletKeysFile = filepath.Join(t.TempDir(), "missing.pem")
+		queue, err := NewTxsQueue(testContext.args)
+		require.Nil(t, queue)
+		require.NotNil(t, err)
+	})
+	t.Run("wallet key from another shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		testContext.args.ShardCoordinator = &testscommon.ShardsCoordinatorMock{
+			NoShards: 2,
+			ComputeIdCalled: func(address []byte) uint32 {
+				return 1
+			},
+		}
+		queue, err := NewTxsQueue(testContext.args)
+		require.Nil(t, queue)
+		require.True(t, errors.Is(err, ErrWalletKeyNotInSelfShard))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 2)
+		queue, err := NewTxsQueue(testContext.args)
+		require.Nil(t, err)
+		require.False(t, queue.IsInterfaceNil())
+
+		queueState := queue.GetTransactionsQueueState()
+		require.Len(t, queueState.Senders, 2)
+		require.Empty(t, queueState.Transactions)
+		require.Nil(t, queue.Close())
+	})
+}
+
+func TestTxsQueue_EnqueueTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unregistered sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		queue, _ := NewTxsQueue(testContext.args)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		response, err := queue.EnqueueTransaction(createEnqueueRequest(testscommon.TestAddressAlice))
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, ErrSenderNotRegistered))
+	})
+	t.Run("invalid fields should error", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		queue, _ := NewTxsQueue(testContext.args)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		request := createEnqueueRequest(testContext.addresses[0])
+		request.Receiver = "invalid"
+		_, err := queue.EnqueueTransaction(request)
+		require.NotNil(t, err)
+
+		request = createEnqueueRequest(testContext.addresses[0])
+		request.Value = "-1"
+		_, err = queue.EnqueueTransaction(request)
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+
+		request = createEnqueueRequest(testContext.addresses[0])
+		request.GasLimit = 0
+		_, err = queue.EnqueueTransaction(request)
+		require.Equal(t, ErrInvalidGasLimit, err)
+
+		request = createEnqueueRequest(testContext.addresses[0])
+		request.GasPrice = testMinGasPrice - 1
+		_, err = queue.EnqueueTransaction(request)
+		require.True(t, errors.Is(err, ErrGasPriceTooLow))
+
+		require.Empty(t, testContext.getSentTxs())
+	})
+	t.Run("should assign consecutive nonces, starting from the account nonce", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 2)
+		sender := testContext.addresses[0]
+		testContext.setAccountNonce(t, sender, 7)
+		queue, _ := NewTxsQueue(testContext.args)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		for i := 0; i < 3; i++ {
+			response, err := queue.EnqueueTransaction(createEnqueueRequest(sender))
+			require.Nil(t, err)
+			require.Equal(t, uint64(7+i), response.Nonce)
+		}
+
+		// the sender without account starts from 0
+		response, err := queue.EnqueueTransaction(createEnqueueRequest(testContext.addresses[1]))
+		require.Nil(t, err)
+		require.Equal(t, uint64(0), response.Nonce)
+
+		sentTxs := testContext.getSentTxs()
+		require.Len(t, sentTxs, 4)
+		tx := sentTxs[0]
+		require.Equal(t, testMinGasPrice, tx.GasPrice)
+		require.Equal(t, []byte("T"), tx.ChainID)
+		require.Equal(t, uint32(1), tx.Version)
+		require.Equal(t, "1000", tx.Value.String())
+
+		// the signature should be verifiable with the sender's public key
+		publicKey, err := testKeyGen.PublicKeyFromByteArray(tx.SndAddr)
+		require.Nil(t, err)
+		dataToSign, _ := tx.GetDataForSigning(testContext.args.AddressPubKeyConverter, testContext.args.TxSignMarshaller, testContext.args.TxSignHasher)
+		err = testContext.args.SingleSigner.Verify(publicKey, dataToSign, tx.Signature)
+		require.Nil(t, err)
+
+		queueState := queue.GetTransactionsQueueState()
+		require.Len(t, queueState.Transactions, 4)
+		for _, senderState := range queueState.Senders {
+			if senderState.Address == sender {
+				require.Equal(t, uint64(10), senderState.NextNonce)
+				require.Equal(t, 3, senderState.NumPending)
+			}
+		}
+	})
+	t.Run("account nonce ahead of the local one should be used", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		sender := testContext.addresses[0]
+		queue, _ := NewTxsQueue(testContext.args)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		response, _ := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		require.Equal(t, uint64(0), response.Nonce)
+
+		// the sender's wallet was also used from outside the queue
+		testContext.setAccountNonce(t, sender, 5)
+		response, _ = queue.EnqueueTransaction(createEnqueueRequest(sender))
+		require.Equal(t, uint64(5), response.Nonce)
+	})
+	t.Run("sending fails should not consume the nonce", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		sender := testContext.addresses[0]
+		expectedErr := errors.New("expected error")
+		testContext.args.TxsSender = &txsSenderMock.TxsSenderHandlerMock{
+			SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+		queue, _ := NewTxsQueue(testContext.args)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		response, err := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		require.Nil(t, response)
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, uint64(0), queue.GetTransactionsQueueState().Senders[0].NextNonce)
+	})
+	t.Run("sending should not block the queue", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		sender := testContext.addresses[0]
+		expectedErr := errors.New("expected error")
+		chanSendStarted := make(chan struct{})
+		chanReleaseSend := make(chan struct{})
+		numSendCalls := 0
+		testContext.args.TxsSender = &txsSenderMock.TxsSenderHandlerMock{
+			SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+				numSendCalls++
+				if numSendCalls > 1 {
+					return uint64(len(txs)), nil
+				}
+
+				close(chanSendStarted)
+				<-chanReleaseSend
+				return 0, expectedErr
+			},
+		}
+		queue, _ := NewTxsQueue(testContext.args)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		chanFirstResponse := make(chan *common.TransactionsQueueEnqueueApiResponse)
+		go func() {
+			response, err := queue.EnqueueTransaction(createEnqueueRequest(sender))
+			require.Nil(t, err)
+			chanFirstResponse <- response
+		}()
+
+		// the second transaction is enqueued while the first one is still being sent
+		<-chanSendStarted
+		response1, err := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		require.Nil(t, err)
+		require.Equal(t, uint64(1), response1.Nonce)
+		queueState := queue.GetTransactionsQueueState()
+		require.Equal(t, 2, queueState.Senders[0].NumPending)
+
+		// sending the first transaction fails, but its nonce is not released, as the following one was already assigned
+		close(chanReleaseSend)
+		response0 := <-chanFirstResponse
+		require.Equal(t, uint64(0), response0.Nonce)
+		queueState = queue.GetTransactionsQueueState()
+		require.Equal(t, uint64(2), queueState.Senders[0].NextNonce)
+		require.Equal(t, 2, queueState.Senders[0].NumPending)
+		require.Equal(t, response0.TxHash, queueState.Transactions[0].Hash)
+	})
+}
+
+func TestTxsQueue_MonitorTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("executed and failed transactions should be finished", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		sender := testContext.addresses[0]
+		queue, _ := createQueueWithControlledTime(t, testContext)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		response0, _ := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		response1, _ := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		response2, _ := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		testContext.setTxStatus(response0.TxHash, transaction.TxStatusSuccess)
+		testContext.setTxStatus(response1.TxHash, transaction.TxStatusFail)
+		testContext.setTxStatus(response2.TxHash, transaction.TxStatusPending)
+		testContext.setAccountNonce(t, sender, 2)
+
+		queue.monitorTransactions()
+
+		queueState := queue.GetTransactionsQueueState()
+		require.Equal(t, 1, queueState.Senders[0].NumPending)
+		require.Equal(t, 1, queueState.Senders[0].NumExecuted)
+		require.Equal(t, 1, queueState.Senders[0].NumFailed)
+		require.Len(t, queueState.Transactions, 3)
+		require.Equal(t, response2.TxHash, queueState.Transactions[0].Hash)
+		require.Equal(t, queuedTxStatusPending, queueState.Transactions[0].Status)
+		require.Equal(t, queuedTxStatusExecuted, queueState.Transactions[1].Status)
+		require.Equal(t, queuedTxStatusFailed, queueState.Transactions[2].Status)
+		require.Equal(t, "transaction status: fail", queueState.Transactions[2].Reason)
+		require.Len(t, testContext.getSentTxs(), 3)
+	})
+	t.Run("dropped transaction should be resubmitted with a higher gas price", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		sender := testContext.addresses[0]
+		queue, currentTime := createQueueWithControlledTime(t, testContext)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		response, _ := queue.EnqueueTransaction(createEnqueueRequest(sender))
+
+		// not yet the time to resubmit
+		queue.monitorTransactions()
+		require.Len(t, testContext.getSentTxs(), 1)
+
+		*currentTime = currentTime.Add(time.Minute)
+		queue.monitorTransactions()
+
+		sentTxs := testContext.getSentTxs()
+		require.Len(t, sentTxs, 2)
+		require.Equal(t, sentTxs[0].Nonce, sentTxs[1].Nonce)
+		require.Equal(t, testMinGasPrice*110/100, sentTxs[1].GasPrice)
+
+		queueState := queue.GetTransactionsQueueState()
+		require.Len(t, queueState.Transactions, 1)
+		require.Equal(t, uint32(1), queueState.Transactions[0].Resubmissions)
+		require.Equal(t, []string{response.TxHash}, queueState.Transactions[0].PreviousHashes)
+		require.NotEqual(t, response.TxHash, queueState.Transactions[0].Hash)
+
+		// the previous version got executed after all
+		testContext.setTxStatus(response.TxHash, transaction.TxStatusSuccess)
+		queue.monitorTransactions()
+
+		queueState = queue.GetTransactionsQueueState()
+		require.Equal(t, 1, queueState.Senders[0].NumExecuted)
+		require.Equal(t, queuedTxStatusExecuted, queueState.Transactions[0].Status)
+	})
+	t.Run("max resubmissions reached should fail the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		sender := testContext.addresses[0]
+		queue, currentTime := createQueueWithControlledTime(t, testContext)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		_, _ = queue.EnqueueTransaction(createEnqueueRequest(sender))
+		for i := 0; i < 3; i++ {
+			*currentTime = currentTime.Add(time.Minute)
+			queue.monitorTransactions()
+		}
+
+		require.Len(t, testContext.getSentTxs(), 3)
+		queueState := queue.GetTransactionsQueueState()
+		require.Equal(t, queuedTxStatusFailed, queueState.Transactions[0].Status)
+		require.Equal(t, reasonMaxResubmissionsReached, queueState.Transactions[0].Reason)
+
+		// the nonce of the last transaction, not found in the pools, should be assigned again
+		require.Equal(t, uint64(0), queueState.Senders[0].NextNonce)
+		response, err := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		require.Nil(t, err)
+		require.Equal(t, uint64(0), response.Nonce)
+	})
+	t.Run("max resubmissions reached with a previous version still found should fill the gap", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		sender := testContext.addresses[0]
+		queue, currentTime := createQueueWithControlledTime(t, testContext)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		response, _ := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		*currentTime = currentTime.Add(time.Minute)
+		queue.monitorTransactions()
+
+		// the first version is still pending on other nodes, while the following ones are not found
+		testContext.setTxStatus(response.TxHash, transaction.TxStatusPending)
+		for i := 0; i < 2; i++ {
+			*currentTime = currentTime.Add(time.Minute)
+			queue.monitorTransactions()
+		}
+
+		sentTxs := testContext.getSentTxs()
+		require.Len(t, sentTxs, 4)
+		gapFiller := sentTxs[3]
+		require.Equal(t, uint64(0), gapFiller.Nonce)
+		require.Equal(t, gapFiller.SndAddr, gapFiller.RcvAddr)
+
+		queueState := queue.GetTransactionsQueueState()
+		require.Equal(t, uint64(1), queueState.Senders[0].NextNonce)
+		require.Equal(t, queuedTxStatusPending, queueState.Transactions[0].Status)
+	})
+	t.Run("max resubmissions reached on a blocking transaction should fill the gap", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		sender := testContext.addresses[0]
+		queue, currentTime := createQueueWithControlledTime(t, testContext)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		response0, _ := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		response1, _ := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		testContext.setTxStatus(response0.TxHash, transaction.TxStatusPending)
+		testContext.setTxStatus(response1.TxHash, transaction.TxStatusPending)
+		for i := 0; i < 3; i++ {
+			*currentTime = currentTime.Add(time.Minute)
+			queue.monitorTransactions()
+		}
+
+		sentTxs := testContext.getSentTxs()
+		require.Len(t, sentTxs, 5)
+		gapFiller := sentTxs[4]
+		require.Equal(t, uint64(0), gapFiller.Nonce)
+		require.Equal(t, gapFiller.SndAddr, gapFiller.RcvAddr)
+		require.Equal(t, "0", gapFiller.Value.String())
+		require.Empty(t, gapFiller.Data)
+		require.Equal(t, testMinGasLimit, gapFiller.GasLimit)
+		require.Greater(t, gapFiller.GasPrice, sentTxs[3].GasPrice)
+
+		// the following transaction keeps its nonce and the gap filler is not tracked as a final state
+		queueState := queue.GetTransactionsQueueState()
+		require.Equal(t, uint64(2), queueState.Senders[0].NextNonce)
+		require.Equal(t, 2, queueState.Senders[0].NumPending)
+		require.Equal(t, queuedTxStatusPending, queueState.Transactions[0].Status)
+		require.Len(t, queueState.Transactions[0].PreviousHashes, 3)
+		require.Equal(t, uint64(1), queueState.Transactions[1].Nonce)
+		require.Equal(t, response1.TxHash, queueState.Transactions[1].Hash)
+
+		// the gap filler is only submitted again, without a higher gas price
+		*currentTime = currentTime.Add(time.Minute)
+		queue.monitorTransactions()
+		sentTxs = testContext.getSentTxs()
+		require.Len(t, sentTxs, 6)
+		require.Equal(t, gapFiller, sentTxs[5])
+
+		testContext.setTxStatus(queueState.Transactions[0].Hash, transaction.TxStatusSuccess)
+		testContext.setAccountNonce(t, sender, 1)
+		queue.monitorTransactions()
+
+		queueState = queue.GetTransactionsQueueState()
+		require.Equal(t, 1, queueState.Senders[0].NumPending)
+		require.Equal(t, 1, queueState.Senders[0].NumFailed)
+		require.Equal(t, queuedTxStatusFailed, queueState.Transactions[1].Status)
+		require.Equal(t, reasonGapFilled, queueState.Transactions[1].Reason)
+	})
+	t.Run("version executed after the gap was filled should mark the transaction as executed", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		testContext.args.Config.MaxResubmissions = 0
+		sender := testContext.addresses[0]
+		queue, currentTime := createQueueWithControlledTime(t, testContext)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		response, _ := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		testContext.setTxStatus(response.TxHash, transaction.TxStatusPending)
+		*currentTime = currentTime.Add(time.Minute)
+		queue.monitorTransactions()
+
+		// the last transaction is still in the pools, so its nonce is not assigned again
+		require.Len(t, testContext.getSentTxs(), 2)
+		queueState := queue.GetTransactionsQueueState()
+		require.Equal(t, uint64(1), queueState.Senders[0].NextNonce)
+		require.Equal(t, queuedTxStatusPending, queueState.Transactions[0].Status)
+
+		testContext.setTxStatus(response.TxHash, transaction.TxStatusSuccess)
+		testContext.setAccountNonce(t, sender, 1)
+		queue.monitorTransactions()
+
+		queueState = queue.GetTransactionsQueueState()
+		require.Equal(t, 1, queueState.Senders[0].NumExecuted)
+		require.Equal(t, queuedTxStatusExecuted, queueState.Transactions[0].Status)
+	})
+	t.Run("transaction not found with consumed nonce should fail", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		sender := testContext.addresses[0]
+		queue, currentTime := createQueueWithControlledTime(t, testContext)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		_, _ = queue.EnqueueTransaction(createEnqueueRequest(sender))
+		testContext.setAccountNonce(t, sender, 1)
+		*currentTime = currentTime.Add(time.Minute)
+		queue.monitorTransactions()
+
+		require.Len(t, testContext.getSentTxs(), 1)
+		queueState := queue.GetTransactionsQueueState()
+		require.Equal(t, queuedTxStatusFailed, queueState.Transactions[0].Status)
+		require.Equal(t, reasonNonceConsumed, queueState.Transactions[0].Reason)
+	})
+	t.Run("only the pending transaction blocking the sender should be resubmitted", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		sender := testContext.addresses[0]
+		queue, currentTime := createQueueWithControlledTime(t, testContext)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		response0, _ := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		response1, _ := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		testContext.setTxStatus(response0.TxHash, transaction.TxStatusPending)
+		testContext.setTxStatus(response1.TxHash, transaction.TxStatusPending)
+		*currentTime = currentTime.Add(time.Minute)
+		queue.monitorTransactions()
+
+		sentTxs := testContext.getSentTxs()
+		require.Len(t, sentTxs, 3)
+		require.Equal(t, uint64(0), sentTxs[2].Nonce)
+	})
+	t.Run("transaction replaced by a foreign one should fail", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		sender := testContext.addresses[0]
+		queue, _ := createQueueWithControlledTime(t, testContext)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		response, _ := queue.EnqueueTransaction(createEnqueueRequest(sender))
+		testContext.mutStatuses.Lock()
+		testContext.statuses[response.TxHash] = &common.TransactionStatusApiResponse{
+			Status:     string(common.TxStatusReplaced),
+			ReplacedBy: "aabb",
+		}
+		testContext.mutStatuses.Unlock()
+		queue.monitorTransactions()
+
+		queueState := queue.GetTransactionsQueueState()
+		require.Equal(t, queuedTxStatusFailed, queueState.Transactions[0].Status)
+		require.Equal(t, "replaced by transaction aabb", queueState.Transactions[0].Reason)
+	})
+	t.Run("finished transactions should be capped", func(t *testing.T) {
+		t.Parallel()
+
+		testContext := createTxsQueueTestContext(t, 1)
+		testContext.args.Config.MaxFinishedTransactions = 2
+		sender := testContext.addresses[0]
+		queue, _ := createQueueWithControlledTime(t, testContext)
+		defer func() {
+			_ = queue.Close()
+		}()
+
+		for i := 0; i < 5; i++ {
+			response, _ := queue.EnqueueTransaction(createEnqueueRequest(sender))
+			testContext.setTxStatus(response.TxHash, transaction.TxStatusSuccess)
+		}
+		queue.monitorTransactions()
+
+		queueState := queue.GetTransactionsQueueState()
+		require.Equal(t, 5, queueState.Senders[0].NumExecuted)
+		require.Len(t, queueState.Transactions, 2)
+		require.Equal(t, uint64(3), queueState.Transactions[0].Nonce)
+		require.Equal(t, uint64(4), queueState.Transactions[1].Nonce)
+	})
+}
+
+func TestTxsQueue_ComputeBumpedGasPrice(t *testing.T) {
+	t.Parallel()
+
+	queue := &txsQueue{gasPriceBumpPercentage: 10}
+	bumped, err := queue.computeBumpedGasPrice(testMinGasPrice)
+	require.Nil(t, err)
+	require.Equal(t, testMinGasPrice*110/100, bumped)
+
+	// rounded up, so that the new version passes the replacement check: new * 100 >= old * 110
+	bumped, err = queue.computeBumpedGasPrice(1_000_000_005)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1_100_000_006), bumped)
+
+	bumped, err = queue.computeBumpedGasPrice(math.MaxUint64 - 1)
+	require.True(t, errors.Is(err, ErrGasPriceOverflow))
+	require.Zero(t, bumped)
+
+	queue.gasPriceBumpPercentage = 0
+	bumped, err = queue.computeBumpedGasPrice(testMinGasPrice)
+	require.Nil(t, err)
+	require.Equal(t, testMinGasPrice+1, bumped)
+}
+
+func TestDisabledTxsQueue(t *testing.T) {
+	t.Parallel()
+
+	queue := NewDisabledTxsQueue()
+	require.False(t, queue.IsInterfaceNil())
+
+	response, err := queue.EnqueueTransaction(&common.TransactionsQueueEnqueueRequest{})
+	require.Nil(t, response)
+	require.Equal(t, ErrTxsQueueDisabled, err)
+
+	queueState := queue.GetTransactionsQueueState()
+	require.Empty(t, queueState.Senders)
+	require.Empty(t, queueState.Transactions)
+	require.Nil(t, queue.Close())
+}
@@ -0,0 +1,68 @@
+package txsSender
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+type walletKey struct {
+	privateKey     crypto.PrivateKey
+	address        []byte
+	encodedAddress string
+}
+
+// loadWalletKeys loads all the private keys found in the provided PEM file, returning them indexed by the encoded
+// address of their wallets. All the wallets should belong to the node's shard
+func loadWalletKeys(
+	filename string,
+	keyGen crypto.KeyGenerator,
+	pubKeyConverter core.PubkeyConverter,
+	shardCoordinator storage.ShardCoordinator,
+) (map[string]*walletKey, error) {
+	encodedPrivateKeys, _, err := core.LoadAllKeysFromPemFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	if len(encodedPrivateKeys) == 0 {
+		return nil, fmt.Errorf("%w in %s", ErrNoWalletKeys, filename)
+	}
+
+	keys := make(map[string]*walletKey, len(encodedPrivateKeys))
+	for index, encodedPrivateKey := range encodedPrivateKeys {
+		privateKeyBytes, errDecode := hex.DecodeString(string(encodedPrivateKey))
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for the wallet key with index %d", errDecode, index)
+		}
+
+		privateKey, errKey := keyGen.PrivateKeyFromByteArray(privateKeyBytes)
+		if errKey != nil {
+			return nil, fmt.Errorf("%w for the wallet key with index %d", errKey, index)
+		}
+
+		address, errKey := privateKey.GeneratePublic().ToByteArray()
+		if errKey != nil {
+			return nil, fmt.Errorf("%w for the wallet key with index %d", errKey, index)
+		}
+
+		encodedAddress, errKey := pubKeyConverter.Encode(address)
+		if errKey != nil {
+			return nil, fmt.Errorf("%w for the wallet key with index %d", errKey, index)
+		}
+
+		if shardCoordinator.ComputeId(address) != shardCoordinator.SelfId() {
+			return nil, fmt.Errorf("%w: %s", ErrWalletKeyNotInSelfShard, encodedAddress)
+		}
+
+		keys[encodedAddress] = &walletKey{
+			privateKey:     privateKey,
+			address:        address,
+			encodedAddress: encodedAddress,
+		}
+	}
+
+	return keys, nil
+}
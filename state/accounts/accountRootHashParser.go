@@ -0,0 +1,38 @@
+package accounts
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/errors"
+)
+
+type accountRootHashParser struct {
+	marshaller marshal.Marshalizer
+}
+
+// NewAccountRootHashParser creates a new instance of accountRootHashParser
+func NewAccountRootHashParser(marshaller marshal.Marshalizer) (*accountRootHashParser, error) {
+	if check.IfNil(marshaller) {
+		return nil, errors.ErrNilMarshalizer
+	}
+
+	return &accountRootHashParser{
+		marshaller: marshaller,
+	}, nil
+}
+
+// ParseRootHash returns the data trie root hash of the user account saved as the value of a main trie leaf
+func (arhp *accountRootHashParser) ParseRootHash(leafValue []byte) ([]byte, error) {
+	accountData := &UserAccountData{}
+	err := arhp.marshaller.Unmarshal(accountData, leafValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountData.RootHash, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (arhp *accountRootHashParser) IsInterfaceNil() bool {
+	return arhp == nil
+}
@@ -0,0 +1,59 @@
+package accounts_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountRootHashParser(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		arhp, err := accounts.NewAccountRootHashParser(nil)
+		assert.True(t, check.IfNil(arhp))
+		assert.Equal(t, errors.ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arhp, err := accounts.NewAccountRootHashParser(&marshal.GogoProtoMarshalizer{})
+		assert.False(t, check.IfNil(arhp))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAccountRootHashParser_ParseRootHash(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	arhp, _ := accounts.NewAccountRootHashParser(marshaller)
+
+	t.Run("invalid account should error", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash, err := arhp.ParseRootHash([]byte("invalid account"))
+		assert.Nil(t, rootHash)
+		assert.NotNil(t, err)
+	})
+	t.Run("should return the data trie root hash", func(t *testing.T) {
+		t.Parallel()
+
+		accountBytes, err := marshaller.Marshal(&accounts.UserAccountData{
+			Nonce:    5,
+			RootHash: []byte("data trie root hash"),
+		})
+		require.Nil(t, err)
+
+		rootHash, err := arhp.ParseRootHash(accountBytes)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("data trie root hash"), rootHash)
+	})
+}
@@ -111,7 +111,13 @@ func NewAccountsDB(args ArgsAccountsDB) (*AccountsDB, error) {
 		return nil, err
 	}
 
-	return createAccountsDb(args), nil
+	adb := createAccountsDb(args)
+	err = args.SnapshotsManager.SetLastCommittedRootHashHandler(adb.getLastCommittedRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return adb, nil
 }
 
 func createAccountsDb(args ArgsAccountsDB) *AccountsDB {
@@ -911,6 +917,13 @@ func (adb *AccountsDB) RootHash() ([]byte, error) {
 	return rootHash, err
 }
 
+func (adb *AccountsDB) getLastCommittedRootHash() []byte {
+	adb.mutOp.RLock()
+	defer adb.mutOp.RUnlock()
+
+	return adb.lastRootHash
+}
+
 // RecreateTrie is used to reload the trie based on an existing rootHash
 func (adb *AccountsDB) RecreateTrie(rootHash []byte) error {
 	return adb.RecreateTrieFromEpoch(holders.NewRootHashHolder(rootHash, core.OptionalUint32{}))
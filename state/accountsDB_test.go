@@ -247,6 +247,48 @@ func TestNewAccountsDB(t *testing.T) {
 		assert.True(t, check.IfNil(adb))
 		assert.Equal(t, state.ErrNilSnapshotsManager, err)
 	})
+	t.Run("should provide the last committed root hash to the snapshots manager", func(t *testing.T) {
+		t.Parallel()
+
+		var lastCommittedRootHashHandler func() []byte
+		args := createMockAccountsDBArgs()
+		args.SnapshotsManager = &stateMock.SnapshotsManagerStub{
+			SetLastCommittedRootHashHandlerCalled: func(handler func() []byte) error {
+				lastCommittedRootHashHandler = handler
+				return nil
+			},
+		}
+
+		args.Trie = &trieMock.TrieStub{
+			RecreateFromEpochCalled: func(_ common.RootHashHolder) (common.Trie, error) {
+				return &trieMock.TrieStub{}, nil
+			},
+		}
+
+		adb, err := state.NewAccountsDB(args)
+		require.Nil(t, err)
+		require.NotNil(t, lastCommittedRootHashHandler)
+		assert.Nil(t, lastCommittedRootHashHandler())
+
+		rootHash := []byte("root hash")
+		require.Nil(t, adb.RecreateTrie(rootHash))
+		assert.Equal(t, rootHash, lastCommittedRootHashHandler())
+	})
+	t.Run("snapshots manager error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockAccountsDBArgs()
+		args.SnapshotsManager = &stateMock.SnapshotsManagerStub{
+			SetLastCommittedRootHashHandlerCalled: func(_ func() []byte) error {
+				return expectedErr
+			},
+		}
+
+		adb, err := state.NewAccountsDB(args)
+		assert.True(t, check.IfNil(adb))
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,54 @@
+package blockInfoProviders
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	chainData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type settableBlockInfo struct {
+	*currentBlockInfo
+	mutBlockInfo sync.RWMutex
+	blockInfo    common.BlockInfo
+}
+
+// NewSettableBlockInfo creates a new instance of type settableBlockInfo. The provider tracks the current block
+// unless a block info is explicitly set
+func NewSettableBlockInfo(chainHandler chainData.ChainHandler) (*settableBlockInfo, error) {
+	provider, err := NewCurrentBlockInfo(chainHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return &settableBlockInfo{
+		currentBlockInfo: provider,
+	}, nil
+}
+
+// SetBlockInfo sets the block info to be returned by the provider. Providing a nil block info will make the provider
+// track the current block again
+func (provider *settableBlockInfo) SetBlockInfo(blockInfo common.BlockInfo) {
+	provider.mutBlockInfo.Lock()
+	provider.blockInfo = blockInfo
+	provider.mutBlockInfo.Unlock()
+}
+
+// GetBlockInfo returns the set block info, if any, otherwise the current block info
+func (provider *settableBlockInfo) GetBlockInfo() common.BlockInfo {
+	provider.mutBlockInfo.RLock()
+	blockInfo := provider.blockInfo
+	provider.mutBlockInfo.RUnlock()
+
+	if !check.IfNil(blockInfo) {
+		return blockInfo
+	}
+
+	return provider.currentBlockInfo.GetBlockInfo()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *settableBlockInfo) IsInterfaceNil() bool {
+	return provider == nil
+}
@@ -0,0 +1,63 @@
+package blockInfoProviders
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSettableBlockInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil chain handler", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := NewSettableBlockInfo(nil)
+		assert.Equal(t, ErrNilChainHandler, err)
+		assert.True(t, check.IfNil(provider))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := NewSettableBlockInfo(&testscommon.ChainHandlerStub{})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(provider))
+	})
+}
+
+func TestSettableBlockInfo_GetBlockInfo(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(8837)
+	hash := []byte("hash")
+	rootHash := []byte("root hash")
+	chainHandler := &testscommon.ChainHandlerStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{
+				Nonce: nonce,
+			}
+		},
+		GetCurrentBlockRootHashCalled: func() []byte {
+			return rootHash
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return hash
+		},
+	}
+
+	provider, _ := NewSettableBlockInfo(chainHandler)
+	currentBi := holders.NewBlockInfo(hash, nonce, rootHash)
+	assert.True(t, provider.GetBlockInfo().Equal(currentBi))
+
+	setBi := holders.NewBlockInfo([]byte("past hash"), nonce-10, []byte("past root hash"))
+	provider.SetBlockInfo(setBi)
+	assert.True(t, provider.GetBlockInfo().Equal(setBi))
+
+	provider.SetBlockInfo(nil)
+	assert.True(t, provider.GetBlockInfo().Equal(currentBi))
+}
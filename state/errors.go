@@ -154,6 +154,9 @@ var ErrNilStatsHandler = errors.New("nil stats handler")
 // ErrNilLastSnapshotMarker signals that a nil last snapshot marker has been given
 var ErrNilLastSnapshotMarker = errors.New("nil last snapshot marker")
 
+// ErrNilLastCommittedRootHashHandler signals that a nil last committed root hash handler has been given
+var ErrNilLastCommittedRootHashHandler = errors.New("nil last committed root hash handler")
+
 // ErrNilSnapshotsManager signals that a nil snapshots manager has been given
 var ErrNilSnapshotsManager = errors.New("nil snapshots manager")
 
@@ -101,6 +101,7 @@ type SnapshotsManager interface {
 	StartSnapshotAfterRestartIfNeeded(trieStorageManager common.StorageManager) error
 	IsSnapshotInProgress() bool
 	SetSyncer(syncer AccountsDBSyncer) error
+	SetLastCommittedRootHashHandler(handler func() []byte) error
 	IsInterfaceNil() bool
 }
 
@@ -249,6 +250,7 @@ type UserAccountHandler interface {
 	GetUserName() []byte
 	IsGuarded() bool
 	GetAllLeaves(leavesChannels *common.TrieIteratorChannels, ctx context.Context) error
+	GetDirtyDataKeys() [][]byte
 	vmcommon.AccountHandler
 }
 
@@ -260,6 +262,7 @@ type DataTrieTracker interface {
 	DataTrie() common.DataTrieHandler
 	SaveDirtyData(common.Trie) ([]core.TrieData, error)
 	MigrateDataTrieLeaves(args vmcommon.ArgsMigrateDataTrieLeaves) error
+	GetDirtyDataKeys() [][]byte
 	IsInterfaceNil() bool
 }
 
@@ -284,6 +287,13 @@ type LastSnapshotMarker interface {
 	IsInterfaceNil() bool
 }
 
+// TrieGarbageCollector removes the trie nodes which are no longer reachable from the kept root hashes
+type TrieGarbageCollector interface {
+	CollectGarbage(rootHashesToKeep [][]byte, trieStorageManager common.StorageManager)
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // ShardValidatorsInfoMapHandler shall be used to manage operations inside
 // a <shardID, []ValidatorInfoHandler> map in a concurrent-safe way.
 type ShardValidatorsInfoMapHandler interface {
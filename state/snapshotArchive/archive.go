@@ -0,0 +1,379 @@
+package snapshotArchive
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+// The archive starts with the magic bytes and the format version, followed by a sequence of chunks:
+//
+//	| section (1 byte) | payload length (4 bytes) | payload | sha256(section + payload) |
+//
+// The first chunk holds the JSON encoded header, the last one holds the JSON encoded trailer, and all the others hold
+// marshalled batches of alternating keys and values. The trailer contains the sha256 of all the previous chunks
+// checksums, so a reordered, removed or truncated chunk is detected as well.
+const (
+	archiveMagic   = "MXSTSNAP"
+	archiveVersion = uint32(1)
+	checksumSize   = sha256.Size
+
+	chunkPrefixSize     = 5
+	maxChunkPayloadSize = 1 << 30
+)
+
+// Section identifies the content of an archive chunk
+type Section uint8
+
+const (
+	// SectionHeader holds the archive header
+	SectionHeader Section = iota
+	// SectionEpochStartMetaBlock holds the epoch start meta block
+	SectionEpochStartMetaBlock
+	// SectionNodesCoordinatorRegistry holds the nodes coordinator registry of the epoch
+	SectionNodesCoordinatorRegistry
+	// SectionUserAccountsTrie holds the nodes of the user accounts trie, including the smart contracts code
+	SectionUserAccountsTrie
+	// SectionDataTries holds the nodes of the accounts data tries
+	SectionDataTries
+	// SectionPeerAccountsTrie holds the nodes of the peer accounts trie
+	SectionPeerAccountsTrie
+	// SectionTrailer holds the archive trailer
+	SectionTrailer
+)
+
+// String returns the section name
+func (s Section) String() string {
+	switch s {
+	case SectionHeader:
+		return "header"
+	case SectionEpochStartMetaBlock:
+		return "epochStartMetaBlock"
+	case SectionNodesCoordinatorRegistry:
+		return "nodesCoordinatorRegistry"
+	case SectionUserAccountsTrie:
+		return "userAccountsTrie"
+	case SectionDataTries:
+		return "dataTries"
+	case SectionPeerAccountsTrie:
+		return "peerAccountsTrie"
+	case SectionTrailer:
+		return "trailer"
+	default:
+		return fmt.Sprintf("unknown section %d", uint8(s))
+	}
+}
+
+// ArchiveHeader describes the epoch start state held by an archive
+type ArchiveHeader struct {
+	ShardID                 uint32 `json:"shardId"`
+	Epoch                   uint32 `json:"epoch"`
+	EpochStartMetaBlockHash []byte `json:"epochStartMetaBlockHash"`
+	RootHash                []byte `json:"rootHash"`
+	ValidatorStatsRootHash  []byte `json:"validatorStatsRootHash,omitempty"`
+}
+
+// ArchiveTrailer closes an archive, holding the number of written entries and the archive checksum
+type ArchiveTrailer struct {
+	NumChunks  uint64            `json:"numChunks"`
+	NumEntries map[string]uint64 `json:"numEntries"`
+	Checksum   []byte            `json:"checksum"`
+}
+
+// ArchiveSummary holds the header and the trailer of an exported or imported archive
+type ArchiveSummary struct {
+	Header  *ArchiveHeader  `json:"header"`
+	Trailer *ArchiveTrailer `json:"trailer"`
+}
+
+type archiveWriter struct {
+	writer         io.Writer
+	marshaller     marshal.Marshalizer
+	maxChunkSize   int
+	archiveHasher  hash.Hash
+	currentSection Section
+	pendingEntries [][]byte
+	pendingSize    int
+	trailer        *ArchiveTrailer
+}
+
+func newArchiveWriter(
+	writer io.Writer,
+	marshaller marshal.Marshalizer,
+	maxChunkSize int,
+	header *ArchiveHeader,
+) (*archiveWriter, error) {
+	aw := &archiveWriter{
+		writer:        writer,
+		marshaller:    marshaller,
+		maxChunkSize:  maxChunkSize,
+		archiveHasher: sha256.New(),
+		trailer: &ArchiveTrailer{
+			NumEntries: make(map[string]uint64),
+		},
+	}
+
+	prefix := make([]byte, len(archiveMagic)+4)
+	copy(prefix, archiveMagic)
+	binary.BigEndian.PutUint32(prefix[len(archiveMagic):], archiveVersion)
+	_, err := writer.Write(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	headerBytes, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	err = aw.writeChunk(SectionHeader, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return aw, nil
+}
+
+// addEntry buffers the provided entry, writing a chunk when the section changes or the chunk size is reached
+func (aw *archiveWriter) addEntry(section Section, key []byte, value []byte) error {
+	if section != aw.currentSection {
+		err := aw.flush()
+		if err != nil {
+			return err
+		}
+		aw.currentSection = section
+	}
+
+	aw.pendingEntries = append(aw.pendingEntries, key, value)
+	aw.pendingSize += len(key) + len(value)
+	aw.trailer.NumEntries[section.String()]++
+	if aw.pendingSize < aw.maxChunkSize {
+		return nil
+	}
+
+	return aw.flush()
+}
+
+func (aw *archiveWriter) flush() error {
+	if len(aw.pendingEntries) == 0 {
+		return nil
+	}
+
+	payload, err := aw.marshaller.Marshal(&batch.Batch{Data: aw.pendingEntries})
+	if err != nil {
+		return err
+	}
+
+	aw.pendingEntries = nil
+	aw.pendingSize = 0
+
+	return aw.writeChunk(aw.currentSection, payload)
+}
+
+func (aw *archiveWriter) writeChunk(section Section, payload []byte) error {
+	if len(payload) > maxChunkPayloadSize {
+		return fmt.Errorf("%w, section %s, size %d", ErrChunkTooLarge, section, len(payload))
+	}
+
+	prefix := make([]byte, chunkPrefixSize)
+	prefix[0] = byte(section)
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(payload)))
+	checksum := computeChunkChecksum(section, payload)
+
+	for _, buff := range [][]byte{prefix, payload, checksum} {
+		_, err := aw.writer.Write(buff)
+		if err != nil {
+			return err
+		}
+	}
+
+	if section != SectionTrailer {
+		aw.archiveHasher.Write(checksum)
+		aw.trailer.NumChunks++
+	}
+
+	return nil
+}
+
+// close writes the pending entries and the archive trailer
+func (aw *archiveWriter) close() (*ArchiveTrailer, error) {
+	err := aw.flush()
+	if err != nil {
+		return nil, err
+	}
+
+	aw.trailer.Checksum = aw.archiveHasher.Sum(nil)
+	trailerBytes, err := json.Marshal(aw.trailer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = aw.writeChunk(SectionTrailer, trailerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return aw.trailer, nil
+}
+
+type archiveReader struct {
+	reader        *bufio.Reader
+	marshaller    marshal.Marshalizer
+	archiveHasher hash.Hash
+	header        *ArchiveHeader
+	trailer       *ArchiveTrailer
+	numChunks     uint64
+	numEntries    map[string]uint64
+}
+
+func newArchiveReader(reader io.Reader, marshaller marshal.Marshalizer) (*archiveReader, error) {
+	ar := &archiveReader{
+		reader:        bufio.NewReader(reader),
+		marshaller:    marshaller,
+		archiveHasher: sha256.New(),
+		numEntries:    make(map[string]uint64),
+	}
+
+	prefix := make([]byte, len(archiveMagic)+4)
+	_, err := io.ReadFull(ar.reader, prefix)
+	if err != nil || string(prefix[:len(archiveMagic)]) != archiveMagic {
+		return nil, ErrInvalidArchive
+	}
+
+	version := binary.BigEndian.Uint32(prefix[len(archiveMagic):])
+	if version != archiveVersion {
+		return nil, fmt.Errorf("%w, version %d", ErrUnsupportedArchiveVersion, version)
+	}
+
+	section, payload, err := ar.readRawChunk()
+	if err != nil {
+		return nil, err
+	}
+	if section != SectionHeader {
+		return nil, fmt.Errorf("%w, expected %s, got %s", ErrUnexpectedSection, SectionHeader, section)
+	}
+
+	ar.header = &ArchiveHeader{}
+	err = json.Unmarshal(payload, ar.header)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+
+	return ar, nil
+}
+
+// readChunk returns the entries of the next chunk as alternating keys and values. After the trailer was read and
+// verified, io.EOF is returned
+func (ar *archiveReader) readChunk() (Section, [][]byte, error) {
+	if ar.trailer != nil {
+		return 0, nil, io.EOF
+	}
+
+	section, payload, err := ar.readRawChunk()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	switch section {
+	case SectionHeader:
+		return 0, nil, fmt.Errorf("%w, duplicated %s", ErrUnexpectedSection, section)
+	case SectionTrailer:
+		err = ar.verifyTrailer(payload)
+		if err != nil {
+			return 0, nil, err
+		}
+		return 0, nil, io.EOF
+	}
+
+	entries := &batch.Batch{}
+	err = ar.marshaller.Unmarshal(entries, payload)
+	if err != nil || len(entries.Data)%2 != 0 {
+		return 0, nil, fmt.Errorf("%w, section %s", ErrInvalidArchive, section)
+	}
+	ar.numEntries[section.String()] += uint64(len(entries.Data) / 2)
+
+	return section, entries.Data, nil
+}
+
+func (ar *archiveReader) readRawChunk() (Section, []byte, error) {
+	prefix := make([]byte, chunkPrefixSize)
+	_, err := io.ReadFull(ar.reader, prefix)
+	if err != nil {
+		return 0, nil, ErrTruncatedArchive
+	}
+
+	section := Section(prefix[0])
+	if section > SectionTrailer {
+		return 0, nil, fmt.Errorf("%w, %s", ErrUnexpectedSection, section)
+	}
+
+	payloadSize := binary.BigEndian.Uint32(prefix[1:])
+	if payloadSize > maxChunkPayloadSize {
+		return 0, nil, fmt.Errorf("%w, section %s, size %d", ErrChunkTooLarge, section, payloadSize)
+	}
+
+	payloadWithChecksum := make([]byte, int(payloadSize)+checksumSize)
+	_, err = io.ReadFull(ar.reader, payloadWithChecksum)
+	if err != nil {
+		return 0, nil, ErrTruncatedArchive
+	}
+
+	payload := payloadWithChecksum[:payloadSize]
+	checksum := payloadWithChecksum[payloadSize:]
+	if !bytes.Equal(checksum, computeChunkChecksum(section, payload)) {
+		return 0, nil, fmt.Errorf("%w, chunk %d, section %s", ErrChecksumMismatch, ar.numChunks, section)
+	}
+
+	if section != SectionTrailer {
+		ar.archiveHasher.Write(checksum)
+		ar.numChunks++
+	}
+
+	return section, payload, nil
+}
+
+func (ar *archiveReader) verifyTrailer(payload []byte) error {
+	trailer := &ArchiveTrailer{}
+	err := json.Unmarshal(payload, trailer)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+
+	if !bytes.Equal(trailer.Checksum, ar.archiveHasher.Sum(nil)) || trailer.NumChunks != ar.numChunks {
+		return fmt.Errorf("%w, archive checksum", ErrChecksumMismatch)
+	}
+	for section, numEntries := range ar.numEntries {
+		if trailer.NumEntries[section] != numEntries {
+			return fmt.Errorf("%w, number of entries for section %s", ErrChecksumMismatch, section)
+		}
+	}
+	if len(trailer.NumEntries) != len(ar.numEntries) {
+		return fmt.Errorf("%w, number of sections", ErrChecksumMismatch)
+	}
+
+	_, err = ar.reader.ReadByte()
+	if !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w, data found after the trailer", ErrInvalidArchive)
+	}
+
+	ar.trailer = trailer
+
+	return nil
+}
+
+func computeChunkChecksum(section Section, payload []byte) []byte {
+	hasher := sha256.New()
+	hasher.Write([]byte{byte(section)})
+	hasher.Write(payload)
+
+	return hasher.Sum(nil)
+}
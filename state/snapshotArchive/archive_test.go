@@ -0,0 +1,141 @@
+package snapshotArchive
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeArchive(t *testing.T, numEntries int) ([]byte, *ArchiveTrailer) {
+	buff := &bytes.Buffer{}
+	aw, err := newArchiveWriter(buff, testMarshaller, 64, &ArchiveHeader{ShardID: 2, Epoch: 7})
+	require.Nil(t, err)
+
+	for i := 0; i < numEntries; i++ {
+		section := SectionUserAccountsTrie
+		if i >= numEntries/2 {
+			section = SectionDataTries
+		}
+		require.Nil(t, aw.addEntry(section, []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+
+	trailer, err := aw.close()
+	require.Nil(t, err)
+
+	return buff.Bytes(), trailer
+}
+
+func readArchive(archive []byte) (map[string][]byte, error) {
+	ar, err := newArchiveReader(bytes.NewReader(archive), testMarshaller)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string][]byte)
+	for {
+		_, chunkEntries, errRead := ar.readChunk()
+		if errors.Is(errRead, io.EOF) {
+			return entries, nil
+		}
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		for i := 0; i < len(chunkEntries); i += 2 {
+			entries[string(chunkEntries[i])] = chunkEntries[i+1]
+		}
+	}
+}
+
+func TestArchive_WriteRead(t *testing.T) {
+	t.Parallel()
+
+	archive, trailer := writeArchive(t, 20)
+	assert.Equal(t, uint64(10), trailer.NumEntries[SectionUserAccountsTrie.String()])
+	assert.Equal(t, uint64(10), trailer.NumEntries[SectionDataTries.String()])
+	assert.True(t, trailer.NumChunks > 3)
+
+	ar, err := newArchiveReader(bytes.NewReader(archive), testMarshaller)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), ar.header.ShardID)
+	assert.Equal(t, uint32(7), ar.header.Epoch)
+
+	entries, err := readArchive(archive)
+	require.Nil(t, err)
+	require.Equal(t, 20, len(entries))
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, []byte(fmt.Sprintf("value%d", i)), entries[fmt.Sprintf("key%d", i)])
+	}
+}
+
+func TestArchive_InvalidArchivesShouldError(t *testing.T) {
+	t.Parallel()
+
+	archive, _ := writeArchive(t, 20)
+	firstChunkEnd := len(archiveMagic) + 4 + chunkPrefixSize
+
+	t.Run("invalid magic", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := readArchive([]byte("not an archive"))
+		assert.Equal(t, ErrInvalidArchive, err)
+	})
+	t.Run("unsupported version", func(t *testing.T) {
+		t.Parallel()
+
+		modified := append([]byte{}, archive...)
+		modified[len(archiveMagic)+3]++
+		_, err := readArchive(modified)
+		assert.True(t, errors.Is(err, ErrUnsupportedArchiveVersion))
+	})
+	t.Run("corrupted chunk", func(t *testing.T) {
+		t.Parallel()
+
+		modified := append([]byte{}, archive...)
+		modified[len(modified)/2]++
+		_, err := readArchive(modified)
+		assert.NotNil(t, err)
+	})
+	t.Run("corrupted header", func(t *testing.T) {
+		t.Parallel()
+
+		modified := append([]byte{}, archive...)
+		modified[firstChunkEnd]++
+		_, err := readArchive(modified)
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	})
+	t.Run("truncated archive", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := readArchive(archive[:len(archive)-1])
+		assert.Equal(t, ErrTruncatedArchive, err)
+	})
+	t.Run("data after trailer", func(t *testing.T) {
+		t.Parallel()
+
+		modified := append(append([]byte{}, archive...), 0)
+		_, err := readArchive(modified)
+		assert.True(t, errors.Is(err, ErrInvalidArchive))
+	})
+	t.Run("removed chunk", func(t *testing.T) {
+		t.Parallel()
+
+		ar, err := newArchiveReader(bytes.NewReader(archive), testMarshaller)
+		require.Nil(t, err)
+		headerSize := len(archive) - ar.reader.Buffered()
+
+		// the second chunk is skipped by reading its raw bytes
+		_, payload, err := ar.readRawChunk()
+		require.Nil(t, err)
+		secondChunkSize := chunkPrefixSize + len(payload) + checksumSize
+
+		modified := append(append([]byte{}, archive[:headerSize]...), archive[headerSize+secondChunkSize:]...)
+		_, err = readArchive(modified)
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	})
+}
@@ -0,0 +1,104 @@
+package snapshotArchive
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/trie"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/snapshotArchive")
+
+// getEpochStartRootHashes returns the root hashes of the shard's tries at the start of the epoch, as notarized in the
+// epoch start meta block. The validator statistics root hash is returned only for the metachain
+func getEpochStartRootHashes(metaBlock *block.MetaBlock, shardID uint32) ([]byte, []byte, error) {
+	if shardID == core.MetachainShardId {
+		return metaBlock.GetRootHash(), metaBlock.GetValidatorStatsRootHash(), nil
+	}
+
+	for _, shardData := range metaBlock.EpochStart.LastFinalizedHeaders {
+		if shardData.ShardID == shardID {
+			return shardData.RootHash, nil, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("%w, shard %d", ErrEpochStartDataForShardNotFound, shardID)
+}
+
+func nodesCoordinatorRegistryKey(metaBlock *block.MetaBlock) []byte {
+	return append([]byte(common.NodesCoordinatorRegistryKeyPrefix), metaBlock.GetPrevRandSeed()...)
+}
+
+func unmarshalEpochStartMetaBlock(marshaller marshal.Marshalizer, buff []byte, epoch uint32) (*block.MetaBlock, error) {
+	metaBlock := &block.MetaBlock{}
+	err := marshaller.Unmarshal(metaBlock, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	if !metaBlock.IsStartOfEpochBlock() || metaBlock.GetEpoch() != epoch {
+		return nil, fmt.Errorf("%w, nonce %d, epoch %d", ErrNotEpochStartMetaBlock, metaBlock.GetNonce(), metaBlock.GetEpoch())
+	}
+
+	return metaBlock, nil
+}
+
+// walkAccountsTries walks the user accounts trie and then all the data tries referenced by its accounts. The data
+// tries are walked in the order in which their accounts were found, each distinct root hash only once
+func walkAccountsTries(
+	rootHash []byte,
+	db common.BaseStorer,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+	mainTrieHandler trie.NodeHandler,
+	dataTriesHandler trie.NodeHandler,
+) error {
+	if common.IsEmptyTrie(rootHash) {
+		return nil
+	}
+
+	dataTriesRootHashes := make([][]byte, 0)
+	seenRootHashes := make(map[string]struct{})
+	err := trie.WalkNodes(rootHash, db, marshaller, hasher, func(hash []byte, encodedNode []byte, leafValue []byte) error {
+		if len(leafValue) > 0 {
+			dataTrieRootHash := getDataTrieRootHash(marshaller, leafValue)
+			_, seen := seenRootHashes[string(dataTrieRootHash)]
+			if !common.IsEmptyTrie(dataTrieRootHash) && !seen {
+				seenRootHashes[string(dataTrieRootHash)] = struct{}{}
+				dataTriesRootHashes = append(dataTriesRootHashes, dataTrieRootHash)
+			}
+		}
+
+		return mainTrieHandler(hash, encodedNode, leafValue)
+	})
+	if err != nil {
+		return fmt.Errorf("user accounts trie: %w", err)
+	}
+
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		err = trie.WalkNodes(dataTrieRootHash, db, marshaller, hasher, dataTriesHandler)
+		if err != nil {
+			return fmt.Errorf("data trie %x: %w", dataTrieRootHash, err)
+		}
+	}
+
+	return nil
+}
+
+// getDataTrieRootHash returns the data trie root hash of an account leaf. The leaves which do not hold accounts, such
+// as the code entries, are ignored
+func getDataTrieRootHash(marshaller marshal.Marshalizer, leafValue []byte) []byte {
+	accountData := &accounts.UserAccountData{}
+	err := marshaller.Unmarshal(accountData, leafValue)
+	if err != nil {
+		return nil
+	}
+
+	return accountData.RootHash
+}
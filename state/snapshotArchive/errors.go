@@ -0,0 +1,66 @@
+package snapshotArchive
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller was provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilStorersCreator signals that a nil storers creator was provided
+var ErrNilStorersCreator = errors.New("nil storers creator")
+
+// ErrNilPathManager signals that a nil path manager was provided
+var ErrNilPathManager = errors.New("nil path manager")
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrInvalidMaxChunkSize signals that an invalid maximum chunk size was provided
+var ErrInvalidMaxChunkSize = errors.New("invalid maximum chunk size")
+
+// ErrInvalidNumEpochs signals that an invalid number of epochs was provided
+var ErrInvalidNumEpochs = errors.New("invalid number of epochs")
+
+// ErrInvalidArchive signals that the provided file is not a state snapshot archive
+var ErrInvalidArchive = errors.New("invalid state snapshot archive")
+
+// ErrUnsupportedArchiveVersion signals that the archive was written with an unsupported format version
+var ErrUnsupportedArchiveVersion = errors.New("unsupported state snapshot archive version")
+
+// ErrChunkTooLarge signals that an archive chunk exceeds the maximum accepted size
+var ErrChunkTooLarge = errors.New("archive chunk too large")
+
+// ErrChecksumMismatch signals that the content of the archive does not match its checksum
+var ErrChecksumMismatch = errors.New("archive checksum mismatch")
+
+// ErrTruncatedArchive signals that the archive ended before its trailer
+var ErrTruncatedArchive = errors.New("truncated state snapshot archive")
+
+// ErrUnexpectedSection signals that an archive section was found in an unexpected place
+var ErrUnexpectedSection = errors.New("unexpected archive section")
+
+// ErrInvalidEntry signals that an archive entry does not match its key
+var ErrInvalidEntry = errors.New("invalid archive entry")
+
+// ErrNotEpochStartMetaBlock signals that the exported meta block is not an epoch start block
+var ErrNotEpochStartMetaBlock = errors.New("not an epoch start meta block")
+
+// ErrMissingEpochStartMetaBlock signals that the archive does not contain the epoch start meta block
+var ErrMissingEpochStartMetaBlock = errors.New("missing epoch start meta block")
+
+// ErrMissingNodesCoordinatorRegistry signals that the archive does not contain the nodes coordinator registry
+var ErrMissingNodesCoordinatorRegistry = errors.New("missing nodes coordinator registry")
+
+// ErrEpochStartDataForShardNotFound signals that the epoch start meta block does not contain the shard's data
+var ErrEpochStartDataForShardNotFound = errors.New("epoch start data for shard not found")
+
+// ErrMissingTrustedMetaBlockHash signals that no trusted epoch start meta block hash was provided
+var ErrMissingTrustedMetaBlockHash = errors.New("missing trusted epoch start meta block hash")
+
+// ErrUntrustedEpochStartMetaBlock signals that the archive's epoch start meta block is not the trusted one
+var ErrUntrustedEpochStartMetaBlock = errors.New("untrusted epoch start meta block")
+
+// ErrRootHashMismatch signals that a root hash does not match the one from the epoch start meta block
+var ErrRootHashMismatch = errors.New("root hash mismatch")
@@ -0,0 +1,21 @@
+package snapshotArchive
+
+import "io"
+
+// StorersCreator defines the component able to open the storers of a shard for a given epoch
+type StorersCreator interface {
+	Create(shardID uint32, epoch uint32) (*Storers, error)
+	IsInterfaceNil() bool
+}
+
+// StateExporter defines the component able to export an epoch start state into an archive
+type StateExporter interface {
+	Export(shardID uint32, epoch uint32, writer io.Writer) (*ArchiveSummary, error)
+	IsInterfaceNil() bool
+}
+
+// StateImporter defines the component able to import an epoch start state from an archive
+type StateImporter interface {
+	Import(reader io.Reader) (*ArchiveSummary, error)
+	IsInterfaceNil() bool
+}
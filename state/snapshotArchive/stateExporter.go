@@ -0,0 +1,152 @@
+package snapshotArchive
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// ArgsStateExporter holds the arguments needed to create a state exporter
+type ArgsStateExporter struct {
+	Marshaller     marshal.Marshalizer
+	Hasher         hashing.Hasher
+	StorersCreator StorersCreator
+	MaxChunkSize   uint32
+}
+
+type stateExporter struct {
+	marshaller     marshal.Marshalizer
+	hasher         hashing.Hasher
+	storersCreator StorersCreator
+	maxChunkSize   int
+}
+
+// NewStateExporter creates a component able to export the epoch start state of a shard from its storage
+func NewStateExporter(args ArgsStateExporter) (*stateExporter, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.StorersCreator) {
+		return nil, ErrNilStorersCreator
+	}
+	if args.MaxChunkSize == 0 || args.MaxChunkSize > maxChunkPayloadSize/2 {
+		return nil, fmt.Errorf("%w, provided %d", ErrInvalidMaxChunkSize, args.MaxChunkSize)
+	}
+
+	return &stateExporter{
+		marshaller:     args.Marshaller,
+		hasher:         args.Hasher,
+		storersCreator: args.StorersCreator,
+		maxChunkSize:   int(args.MaxChunkSize),
+	}, nil
+}
+
+// Export writes the epoch start meta block, the nodes coordinator registry and all the tries of the shard, as they
+// were at the start of the provided epoch, into an archive
+func (se *stateExporter) Export(shardID uint32, epoch uint32, writer io.Writer) (*ArchiveSummary, error) {
+	storers, err := se.storersCreator.Create(shardID, epoch)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = storers.Close()
+	}()
+
+	metaBlockBytes, err := storers.MetaBlock.Get([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w, epoch %d: %v", ErrMissingEpochStartMetaBlock, epoch, err)
+	}
+
+	metaBlock, err := unmarshalEpochStartMetaBlock(se.marshaller, metaBlockBytes, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash, validatorStatsRootHash, err := getEpochStartRootHashes(metaBlock, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	registryKey := nodesCoordinatorRegistryKey(metaBlock)
+	registryBytes, err := storers.Bootstrap.Get(registryKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w, epoch %d: %v", ErrMissingNodesCoordinatorRegistry, epoch, err)
+	}
+
+	header := &ArchiveHeader{
+		ShardID:                 shardID,
+		Epoch:                   epoch,
+		EpochStartMetaBlockHash: se.hasher.Compute(string(metaBlockBytes)),
+		RootHash:                rootHash,
+		ValidatorStatsRootHash:  validatorStatsRootHash,
+	}
+	log.Info("exporting epoch start state", "shard", shardID, "epoch", epoch,
+		"meta block hash", header.EpochStartMetaBlockHash, "root hash", rootHash,
+		"validator stats root hash", validatorStatsRootHash)
+
+	aw, err := newArchiveWriter(writer, se.marshaller, se.maxChunkSize, header)
+	if err != nil {
+		return nil, err
+	}
+
+	err = aw.addEntry(SectionEpochStartMetaBlock, header.EpochStartMetaBlockHash, metaBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = aw.addEntry(SectionNodesCoordinatorRegistry, registryKey, registryBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = walkAccountsTries(
+		rootHash,
+		storers.AccountsTrie,
+		se.marshaller,
+		se.hasher,
+		createExportHandler(aw, SectionUserAccountsTrie),
+		createExportHandler(aw, SectionDataTries),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if shardID == core.MetachainShardId && !common.IsEmptyTrie(validatorStatsRootHash) {
+		err = trie.WalkNodes(validatorStatsRootHash, storers.PeerAccountsTrie, se.marshaller, se.hasher, createExportHandler(aw, SectionPeerAccountsTrie))
+		if err != nil {
+			return nil, fmt.Errorf("peer accounts trie: %w", err)
+		}
+	}
+
+	trailer, err := aw.close()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("exported epoch start state", "num chunks", trailer.NumChunks, "checksum", trailer.Checksum)
+
+	return &ArchiveSummary{
+		Header:  header,
+		Trailer: trailer,
+	}, nil
+}
+
+func createExportHandler(aw *archiveWriter, section Section) trie.NodeHandler {
+	return func(hash []byte, encodedNode []byte, _ []byte) error {
+		return aw.addEntry(section, hash, encodedNode)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (se *stateExporter) IsInterfaceNil() bool {
+	return se == nil
+}
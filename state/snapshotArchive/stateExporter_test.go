@@ -0,0 +1,288 @@
+package snapshotArchive
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEpoch = uint32(3)
+
+var (
+	testMarshaller = &marshal.GogoProtoMarshalizer{}
+	testHasher     = &testscommon.KeccakMock{}
+)
+
+type testState struct {
+	storersCreator         StorersCreator
+	metaBlock              *block.MetaBlock
+	rootHash               []byte
+	dataTrieRootHash       []byte
+	validatorStatsRootHash []byte
+	codeHash               []byte
+}
+
+func createTestStorersCreator(t *testing.T, numTrieEpochs uint32) *storersCreator {
+	generalConfig := testscommon.GetGeneralConfig()
+	for _, storageConfig := range []*config.StorageConfig{
+		&generalConfig.AccountsTrieStorage,
+		&generalConfig.PeerAccountsTrieStorage,
+		&generalConfig.MetaBlockStorage,
+		&generalConfig.BootstrapStorage,
+	} {
+		storageConfig.DB.Type = string(storageunit.LvlDBSerial)
+	}
+
+	pathManager, err := factory.CreatePathManagerFromSinglePathString(t.TempDir())
+	require.Nil(t, err)
+
+	sc, err := NewStorersCreator(ArgsStorersCreator{
+		GeneralConfig: generalConfig,
+		PathManager:   pathManager,
+		NumTrieEpochs: numTrieEpochs,
+	})
+	require.Nil(t, err)
+
+	return sc
+}
+
+func commitTestTrie(t *testing.T, storer common.BaseStorer, entries map[string][]byte) []byte {
+	tsm := &storageManager.StorageManagerStub{
+		PutCalled: func(key []byte, val []byte) error {
+			return storer.Put(key, val)
+		},
+	}
+
+	tr, err := trie.NewTrie(tsm, testMarshaller, testHasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	for key, value := range entries {
+		require.Nil(t, tr.Update([]byte(key), value))
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func createTestState(t *testing.T, shardID uint32) *testState {
+	ts := &testState{
+		storersCreator: createTestStorersCreator(t, 1),
+	}
+
+	storers, err := ts.storersCreator.Create(shardID, testEpoch)
+	require.Nil(t, err)
+	defer func() {
+		require.Nil(t, storers.Close())
+	}()
+
+	ts.dataTrieRootHash = commitTestTrie(t, storers.AccountsTrie, map[string][]byte{
+		string(testHasher.Compute("data key 1")): []byte("data value 1"),
+		string(testHasher.Compute("data key 2")): []byte("data value 2"),
+		string(testHasher.Compute("data key 3")): []byte("data value 3"),
+	})
+
+	code := []byte("smart contract code")
+	ts.codeHash = testHasher.Compute(string(code))
+	codeEntry, err := testMarshaller.Marshal(&state.CodeEntry{Code: code, NumReferences: 1})
+	require.Nil(t, err)
+
+	mainTrieEntries := map[string][]byte{
+		string(ts.codeHash): codeEntry,
+	}
+	for i, dataTrieRootHash := range [][]byte{ts.dataTrieRootHash, nil, nil} {
+		address := testHasher.Compute(string(rune('a' + i)))
+		accountData := &accounts.UserAccountData{
+			Nonce:    uint64(i),
+			Balance:  big.NewInt(int64(i * 10)),
+			Address:  address,
+			RootHash: dataTrieRootHash,
+		}
+		if len(dataTrieRootHash) > 0 {
+			accountData.CodeHash = ts.codeHash
+		}
+
+		mainTrieEntries[string(address)], err = testMarshaller.Marshal(accountData)
+		require.Nil(t, err)
+	}
+	ts.rootHash = commitTestTrie(t, storers.AccountsTrie, mainTrieEntries)
+
+	ts.metaBlock = &block.MetaBlock{
+		Nonce:        100,
+		Epoch:        testEpoch,
+		PrevRandSeed: []byte("prev rand seed"),
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{ShardID: 0, RootHash: []byte("other root hash")},
+			},
+		},
+	}
+	if shardID == core.MetachainShardId {
+		ts.validatorStatsRootHash = commitTestTrie(t, storers.PeerAccountsTrie, map[string][]byte{
+			string(testHasher.Compute("validator 1")): []byte("peer account 1"),
+			string(testHasher.Compute("validator 2")): []byte("peer account 2"),
+		})
+		ts.metaBlock.RootHash = ts.rootHash
+		ts.metaBlock.ValidatorStatsRootHash = ts.validatorStatsRootHash
+	} else {
+		ts.metaBlock.EpochStart.LastFinalizedHeaders = append(ts.metaBlock.EpochStart.LastFinalizedHeaders,
+			block.EpochStartShardData{ShardID: shardID, RootHash: ts.rootHash})
+	}
+
+	metaBlockBytes, err := testMarshaller.Marshal(ts.metaBlock)
+	require.Nil(t, err)
+	require.Nil(t, storers.MetaBlock.Put([]byte(core.EpochStartIdentifier(testEpoch)), metaBlockBytes))
+	require.Nil(t, storers.Bootstrap.Put(nodesCoordinatorRegistryKey(ts.metaBlock), []byte("nodes coordinator registry")))
+
+	return ts
+}
+
+func createMockArgsStateExporter(storersCreator StorersCreator) ArgsStateExporter {
+	return ArgsStateExporter{
+		Marshaller:     testMarshaller,
+		Hasher:         testHasher,
+		StorersCreator: storersCreator,
+		MaxChunkSize:   128,
+	}
+}
+
+func TestNewStateExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter(&storersCreator{})
+		args.Marshaller = nil
+		exporter, err := NewStateExporter(args)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.Nil(t, exporter)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter(&storersCreator{})
+		args.Hasher = nil
+		exporter, err := NewStateExporter(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.Nil(t, exporter)
+	})
+	t.Run("nil storers creator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter(nil)
+		exporter, err := NewStateExporter(args)
+		assert.Equal(t, ErrNilStorersCreator, err)
+		assert.Nil(t, exporter)
+	})
+	t.Run("invalid max chunk size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateExporter(&storersCreator{})
+		args.MaxChunkSize = 0
+		exporter, err := NewStateExporter(args)
+		assert.True(t, errors.Is(err, ErrInvalidMaxChunkSize))
+		assert.Nil(t, exporter)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, err := NewStateExporter(createMockArgsStateExporter(&storersCreator{}))
+		assert.Nil(t, err)
+		assert.False(t, exporter.IsInterfaceNil())
+	})
+}
+
+func TestStateExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing epoch start meta block should error", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, _ := NewStateExporter(createMockArgsStateExporter(createTestStorersCreator(t, 1)))
+		summary, err := exporter.Export(0, testEpoch, &bytes.Buffer{})
+		assert.True(t, errors.Is(err, ErrMissingEpochStartMetaBlock))
+		assert.Nil(t, summary)
+	})
+	t.Run("shard not notarized in the epoch start meta block should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		exporter, _ := NewStateExporter(createMockArgsStateExporter(ts.storersCreator))
+		storers, err := ts.storersCreator.Create(2, testEpoch)
+		require.Nil(t, err)
+		metaBlockBytes, _ := testMarshaller.Marshal(ts.metaBlock)
+		require.Nil(t, storers.MetaBlock.Put([]byte(core.EpochStartIdentifier(testEpoch)), metaBlockBytes))
+		require.Nil(t, storers.Close())
+
+		summary, err := exporter.Export(2, testEpoch, &bytes.Buffer{})
+		assert.True(t, errors.Is(err, ErrEpochStartDataForShardNotFound))
+		assert.Nil(t, summary)
+	})
+	t.Run("missing trie node should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		storers, err := ts.storersCreator.Create(1, testEpoch)
+		require.Nil(t, err)
+		require.Nil(t, storers.AccountsTrie.Remove(ts.dataTrieRootHash))
+		require.Nil(t, storers.Close())
+
+		exporter, _ := NewStateExporter(createMockArgsStateExporter(ts.storersCreator))
+		summary, err := exporter.Export(1, testEpoch, &bytes.Buffer{})
+		assert.True(t, errors.Is(err, trie.ErrNodeNotFound))
+		assert.Nil(t, summary)
+	})
+	t.Run("shard state should work", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		exporter, _ := NewStateExporter(createMockArgsStateExporter(ts.storersCreator))
+		buff := &bytes.Buffer{}
+		summary, err := exporter.Export(1, testEpoch, buff)
+		require.Nil(t, err)
+
+		assert.Equal(t, uint32(1), summary.Header.ShardID)
+		assert.Equal(t, testEpoch, summary.Header.Epoch)
+		assert.Equal(t, ts.rootHash, summary.Header.RootHash)
+		assert.Empty(t, summary.Header.ValidatorStatsRootHash)
+		assert.Equal(t, uint64(1), summary.Trailer.NumEntries[SectionEpochStartMetaBlock.String()])
+		assert.Equal(t, uint64(1), summary.Trailer.NumEntries[SectionNodesCoordinatorRegistry.String()])
+		assert.True(t, summary.Trailer.NumEntries[SectionUserAccountsTrie.String()] > 4)
+		assert.True(t, summary.Trailer.NumEntries[SectionDataTries.String()] > 3)
+		assert.Zero(t, summary.Trailer.NumEntries[SectionPeerAccountsTrie.String()])
+		assert.True(t, summary.Trailer.NumChunks > 4)
+		assert.True(t, buff.Len() > 0)
+	})
+	t.Run("metachain state should work", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, core.MetachainShardId)
+		exporter, _ := NewStateExporter(createMockArgsStateExporter(ts.storersCreator))
+		summary, err := exporter.Export(core.MetachainShardId, testEpoch, &bytes.Buffer{})
+		require.Nil(t, err)
+
+		assert.Equal(t, ts.rootHash, summary.Header.RootHash)
+		assert.Equal(t, ts.validatorStatsRootHash, summary.Header.ValidatorStatsRootHash)
+		assert.True(t, summary.Trailer.NumEntries[SectionPeerAccountsTrie.String()] > 2)
+	})
+}
@@ -0,0 +1,254 @@
+package snapshotArchive
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+const numTrieNodesBetweenProgressLogs = 1000000
+
+// ArgsStateImporter holds the arguments needed to create a state importer. TrustedEpochStartMetaBlockHash is the hash
+// of the epoch start meta block, obtained from a source other than the archive, the imported state is anchored to
+type ArgsStateImporter struct {
+	Marshaller                     marshal.Marshalizer
+	Hasher                         hashing.Hasher
+	StorersCreator                 StorersCreator
+	TrustedEpochStartMetaBlockHash []byte
+}
+
+type stateImporter struct {
+	marshaller                     marshal.Marshalizer
+	hasher                         hashing.Hasher
+	storersCreator                 StorersCreator
+	trustedEpochStartMetaBlockHash []byte
+}
+
+// NewStateImporter creates a component able to import an epoch start state archive into the node's storage
+func NewStateImporter(args ArgsStateImporter) (*stateImporter, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.StorersCreator) {
+		return nil, ErrNilStorersCreator
+	}
+	if len(args.TrustedEpochStartMetaBlockHash) == 0 {
+		return nil, ErrMissingTrustedMetaBlockHash
+	}
+
+	return &stateImporter{
+		marshaller:                     args.Marshaller,
+		hasher:                         args.Hasher,
+		storersCreator:                 args.StorersCreator,
+		trustedEpochStartMetaBlockHash: args.TrustedEpochStartMetaBlockHash,
+	}, nil
+}
+
+type importSession struct {
+	*stateImporter
+	header          *ArchiveHeader
+	storers         *Storers
+	metaBlock       *block.MetaBlock
+	hasRegistry     bool
+	numTrieEntries  uint64
+	lastLoggedCount uint64
+}
+
+// Import reads the archive, verifying the checksum of each chunk before writing its entries into the storers of the
+// archive's shard and epoch. The archive is rejected before writing anything if its epoch start meta block is not the
+// trusted one, as the checksums and the meta block hash found in the archive only prove its integrity, not its origin.
+// Once the whole archive was read and its checksum verified, the imported tries are walked starting from the root
+// hashes notarized in the trusted epoch start meta block, so an incomplete or unrelated state is rejected. The trie
+// nodes are stored under their own hashes, so an archive rejected late leaves nothing but unused nodes behind
+func (si *stateImporter) Import(reader io.Reader) (*ArchiveSummary, error) {
+	ar, err := newArchiveReader(reader, si.marshaller)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(ar.header.EpochStartMetaBlockHash, si.trustedEpochStartMetaBlockHash) {
+		return nil, fmt.Errorf("%w, archive %x, trusted %x", ErrUntrustedEpochStartMetaBlock,
+			ar.header.EpochStartMetaBlockHash, si.trustedEpochStartMetaBlockHash)
+	}
+
+	storers, err := si.storersCreator.Create(ar.header.ShardID, ar.header.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = storers.Close()
+	}()
+
+	session := &importSession{
+		stateImporter: si,
+		header:        ar.header,
+		storers:       storers,
+	}
+	log.Info("importing epoch start state", "shard", ar.header.ShardID, "epoch", ar.header.Epoch,
+		"meta block hash", ar.header.EpochStartMetaBlockHash, "root hash", ar.header.RootHash,
+		"validator stats root hash", ar.header.ValidatorStatsRootHash)
+
+	for {
+		section, entries, errRead := ar.readChunk()
+		if errors.Is(errRead, io.EOF) {
+			break
+		}
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		for i := 0; i < len(entries); i += 2 {
+			err = session.importEntry(section, entries[i], entries[i+1])
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err = session.verify()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("imported epoch start state", "num chunks", ar.trailer.NumChunks, "checksum", ar.trailer.Checksum)
+
+	return &ArchiveSummary{
+		Header:  ar.header,
+		Trailer: ar.trailer,
+	}, nil
+}
+
+func (session *importSession) importEntry(section Section, key []byte, value []byte) error {
+	switch section {
+	case SectionEpochStartMetaBlock:
+		return session.importMetaBlock(key, value)
+	case SectionNodesCoordinatorRegistry:
+		return session.importNodesCoordinatorRegistry(key, value)
+	case SectionUserAccountsTrie, SectionDataTries:
+		return session.importTrieNode(section, session.storers.AccountsTrie, key, value)
+	case SectionPeerAccountsTrie:
+		return session.importTrieNode(section, session.storers.PeerAccountsTrie, key, value)
+	default:
+		return fmt.Errorf("%w, %s", ErrUnexpectedSection, section)
+	}
+}
+
+func (session *importSession) importMetaBlock(key []byte, value []byte) error {
+	if session.metaBlock != nil {
+		return fmt.Errorf("%w, duplicated %s", ErrUnexpectedSection, SectionEpochStartMetaBlock)
+	}
+	isTrustedMetaBlock := bytes.Equal(key, session.trustedEpochStartMetaBlockHash) && bytes.Equal(key, session.hasher.Compute(string(value)))
+	if !isTrustedMetaBlock {
+		return fmt.Errorf("%w, section %s, key %x", ErrInvalidEntry, SectionEpochStartMetaBlock, key)
+	}
+
+	metaBlock, err := unmarshalEpochStartMetaBlock(session.marshaller, value, session.header.Epoch)
+	if err != nil {
+		return err
+	}
+
+	err = session.storers.MetaBlock.Put(key, value)
+	if err != nil {
+		return err
+	}
+
+	err = session.storers.MetaBlock.Put([]byte(core.EpochStartIdentifier(session.header.Epoch)), value)
+	if err != nil {
+		return err
+	}
+
+	session.metaBlock = metaBlock
+
+	return nil
+}
+
+func (session *importSession) importNodesCoordinatorRegistry(key []byte, value []byte) error {
+	if session.metaBlock == nil || session.hasRegistry {
+		return fmt.Errorf("%w, %s", ErrUnexpectedSection, SectionNodesCoordinatorRegistry)
+	}
+	if !bytes.Equal(key, nodesCoordinatorRegistryKey(session.metaBlock)) || len(value) == 0 {
+		return fmt.Errorf("%w, section %s, key %x", ErrInvalidEntry, SectionNodesCoordinatorRegistry, key)
+	}
+
+	err := session.storers.Bootstrap.Put(key, value)
+	if err != nil {
+		return err
+	}
+
+	session.hasRegistry = true
+
+	return nil
+}
+
+func (session *importSession) importTrieNode(section Section, storer common.BaseStorer, key []byte, value []byte) error {
+	if check.IfNil(storer) {
+		return fmt.Errorf("%w, %s for shard %d", ErrUnexpectedSection, section, session.header.ShardID)
+	}
+	if !bytes.Equal(key, session.hasher.Compute(string(value))) {
+		return fmt.Errorf("%w, section %s, key %x", ErrInvalidEntry, section, key)
+	}
+
+	session.numTrieEntries++
+	if session.numTrieEntries-session.lastLoggedCount >= numTrieNodesBetweenProgressLogs {
+		session.lastLoggedCount = session.numTrieEntries
+		log.Info("importing epoch start state", "num imported trie nodes", session.numTrieEntries)
+	}
+
+	return storer.Put(key, value)
+}
+
+func (session *importSession) verify() error {
+	if session.metaBlock == nil {
+		return ErrMissingEpochStartMetaBlock
+	}
+	if !session.hasRegistry {
+		return ErrMissingNodesCoordinatorRegistry
+	}
+
+	rootHash, validatorStatsRootHash, err := getEpochStartRootHashes(session.metaBlock, session.header.ShardID)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(rootHash, session.header.RootHash) {
+		return fmt.Errorf("%w, user accounts trie, archive %x, epoch start meta block %x", ErrRootHashMismatch, session.header.RootHash, rootHash)
+	}
+	if !bytes.Equal(validatorStatsRootHash, session.header.ValidatorStatsRootHash) {
+		return fmt.Errorf("%w, peer accounts trie, archive %x, epoch start meta block %x", ErrRootHashMismatch, session.header.ValidatorStatsRootHash, validatorStatsRootHash)
+	}
+
+	log.Info("verifying the imported tries against the epoch start meta block", "root hash", rootHash,
+		"validator stats root hash", validatorStatsRootHash)
+
+	noOpHandler := func(_ []byte, _ []byte, _ []byte) error { return nil }
+	err = walkAccountsTries(rootHash, session.storers.AccountsTrie, session.marshaller, session.hasher, noOpHandler, noOpHandler)
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrRootHashMismatch, err)
+	}
+
+	if common.IsEmptyTrie(validatorStatsRootHash) {
+		return nil
+	}
+
+	err = trie.WalkNodes(validatorStatsRootHash, session.storers.PeerAccountsTrie, session.marshaller, session.hasher, noOpHandler)
+	if err != nil {
+		return fmt.Errorf("%w, peer accounts trie: %v", ErrRootHashMismatch, err)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (si *stateImporter) IsInterfaceNil() bool {
+	return si == nil
+}
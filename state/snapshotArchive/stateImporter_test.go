@@ -0,0 +1,295 @@
+package snapshotArchive
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsStateImporter(storersCreator StorersCreator, trustedMetaBlockHash []byte) ArgsStateImporter {
+	return ArgsStateImporter{
+		Marshaller:                     testMarshaller,
+		Hasher:                         testHasher,
+		StorersCreator:                 storersCreator,
+		TrustedEpochStartMetaBlockHash: trustedMetaBlockHash,
+	}
+}
+
+func computeMetaBlockHash(t *testing.T, ts *testState) []byte {
+	metaBlockBytes, err := testMarshaller.Marshal(ts.metaBlock)
+	require.Nil(t, err)
+
+	return testHasher.Compute(string(metaBlockBytes))
+}
+
+func exportTestState(t *testing.T, ts *testState, shardID uint32) (*bytes.Buffer, *ArchiveSummary) {
+	exporter, err := NewStateExporter(createMockArgsStateExporter(ts.storersCreator))
+	require.Nil(t, err)
+
+	buff := &bytes.Buffer{}
+	summary, err := exporter.Export(shardID, testEpoch, buff)
+	require.Nil(t, err)
+
+	return buff, summary
+}
+
+// writeTestArchive writes an archive holding the meta block and the registry of the test state, followed by the
+// entries added by the provided handler
+func writeTestArchive(t *testing.T, ts *testState, header *ArchiveHeader, addEntries func(aw *archiveWriter)) *bytes.Buffer {
+	metaBlockBytes, err := testMarshaller.Marshal(ts.metaBlock)
+	require.Nil(t, err)
+	header.EpochStartMetaBlockHash = testHasher.Compute(string(metaBlockBytes))
+
+	buff := &bytes.Buffer{}
+	aw, err := newArchiveWriter(buff, testMarshaller, 128, header)
+	require.Nil(t, err)
+	require.Nil(t, aw.addEntry(SectionEpochStartMetaBlock, header.EpochStartMetaBlockHash, metaBlockBytes))
+	require.Nil(t, aw.addEntry(SectionNodesCoordinatorRegistry, nodesCoordinatorRegistryKey(ts.metaBlock), []byte("registry")))
+	addEntries(aw)
+	_, err = aw.close()
+	require.Nil(t, err)
+
+	return buff
+}
+
+func TestNewStateImporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateImporter(&storersCreator{}, []byte("meta block hash"))
+		args.Marshaller = nil
+		importer, err := NewStateImporter(args)
+		assert.Equal(t, ErrNilMarshaller, err)
+		assert.Nil(t, importer)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateImporter(&storersCreator{}, []byte("meta block hash"))
+		args.Hasher = nil
+		importer, err := NewStateImporter(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.Nil(t, importer)
+	})
+	t.Run("nil storers creator should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, err := NewStateImporter(createMockArgsStateImporter(nil, []byte("meta block hash")))
+		assert.Equal(t, ErrNilStorersCreator, err)
+		assert.Nil(t, importer)
+	})
+	t.Run("missing trusted meta block hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, err := NewStateImporter(createMockArgsStateImporter(&storersCreator{}, nil))
+		assert.Equal(t, ErrMissingTrustedMetaBlockHash, err)
+		assert.Nil(t, importer)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		importer, err := NewStateImporter(createMockArgsStateImporter(&storersCreator{}, []byte("meta block hash")))
+		assert.Nil(t, err)
+		assert.False(t, importer.IsInterfaceNil())
+	})
+}
+
+func TestStateImporter_Import(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shard state should work", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		buff, exportSummary := exportTestState(t, ts, 1)
+
+		destination := createTestStorersCreator(t, 1)
+		importer, _ := NewStateImporter(createMockArgsStateImporter(destination, computeMetaBlockHash(t, ts)))
+		importSummary, err := importer.Import(buff)
+		require.Nil(t, err)
+		assert.Equal(t, exportSummary, importSummary)
+
+		storers, err := destination.Create(1, testEpoch)
+		require.Nil(t, err)
+		defer func() {
+			_ = storers.Close()
+		}()
+
+		for _, rootHash := range [][]byte{ts.rootHash, ts.dataTrieRootHash} {
+			result, errCheck := trie.CheckIntegrity(rootHash, storers.AccountsTrie, testMarshaller, testHasher)
+			require.Nil(t, errCheck)
+			assert.True(t, result.IsValid())
+		}
+
+		metaBlockBytes, err := storers.MetaBlock.Get([]byte(core.EpochStartIdentifier(testEpoch)))
+		require.Nil(t, err)
+		assert.Equal(t, exportSummary.Header.EpochStartMetaBlockHash, testHasher.Compute(string(metaBlockBytes)))
+
+		registry, err := storers.Bootstrap.Get(nodesCoordinatorRegistryKey(ts.metaBlock))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("nodes coordinator registry"), registry)
+	})
+	t.Run("metachain state should work", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, core.MetachainShardId)
+		buff, _ := exportTestState(t, ts, core.MetachainShardId)
+
+		destination := createTestStorersCreator(t, 1)
+		importer, _ := NewStateImporter(createMockArgsStateImporter(destination, computeMetaBlockHash(t, ts)))
+		_, err := importer.Import(buff)
+		require.Nil(t, err)
+
+		storers, err := destination.Create(core.MetachainShardId, testEpoch)
+		require.Nil(t, err)
+		defer func() {
+			_ = storers.Close()
+		}()
+
+		result, err := trie.CheckIntegrity(ts.validatorStatsRootHash, storers.PeerAccountsTrie, testMarshaller, testHasher)
+		require.Nil(t, err)
+		assert.True(t, result.IsValid())
+	})
+	t.Run("corrupted archive should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		buff, _ := exportTestState(t, ts, 1)
+		archive := buff.Bytes()
+		archive[len(archiveMagic)+4+chunkPrefixSize]++
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter(createTestStorersCreator(t, 1), computeMetaBlockHash(t, ts)))
+		summary, err := importer.Import(bytes.NewReader(archive))
+		assert.True(t, errors.Is(err, ErrChecksumMismatch))
+		assert.Nil(t, summary)
+	})
+	t.Run("truncated archive should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		buff, _ := exportTestState(t, ts, 1)
+		archive := buff.Bytes()
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter(createTestStorersCreator(t, 1), computeMetaBlockHash(t, ts)))
+		summary, err := importer.Import(bytes.NewReader(archive[:len(archive)-10]))
+		assert.Equal(t, ErrTruncatedArchive, err)
+		assert.Nil(t, summary)
+	})
+	t.Run("root hash not matching the epoch start meta block should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		header := &ArchiveHeader{
+			ShardID:  1,
+			Epoch:    testEpoch,
+			RootHash: ts.dataTrieRootHash,
+		}
+		buff := writeTestArchive(t, ts, header, func(_ *archiveWriter) {})
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter(createTestStorersCreator(t, 1), computeMetaBlockHash(t, ts)))
+		summary, err := importer.Import(buff)
+		assert.True(t, errors.Is(err, ErrRootHashMismatch))
+		assert.Nil(t, summary)
+	})
+	t.Run("incomplete state should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		storers, err := ts.storersCreator.Create(1, testEpoch)
+		require.Nil(t, err)
+		defer func() {
+			_ = storers.Close()
+		}()
+
+		header := &ArchiveHeader{
+			ShardID:  1,
+			Epoch:    testEpoch,
+			RootHash: ts.rootHash,
+		}
+		buff := writeTestArchive(t, ts, header, func(aw *archiveWriter) {
+			errWalk := trie.WalkNodes(ts.rootHash, storers.AccountsTrie, testMarshaller, testHasher, createExportHandler(aw, SectionUserAccountsTrie))
+			require.Nil(t, errWalk)
+		})
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter(createTestStorersCreator(t, 1), computeMetaBlockHash(t, ts)))
+		summary, err := importer.Import(buff)
+		assert.True(t, errors.Is(err, ErrRootHashMismatch))
+		assert.Nil(t, summary)
+	})
+	t.Run("trie node not matching its key should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		header := &ArchiveHeader{
+			ShardID:  1,
+			Epoch:    testEpoch,
+			RootHash: ts.rootHash,
+		}
+		buff := writeTestArchive(t, ts, header, func(aw *archiveWriter) {
+			require.Nil(t, aw.addEntry(SectionUserAccountsTrie, ts.rootHash, []byte("not the root node")))
+		})
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter(createTestStorersCreator(t, 1), computeMetaBlockHash(t, ts)))
+		summary, err := importer.Import(buff)
+		assert.True(t, errors.Is(err, ErrInvalidEntry))
+		assert.Nil(t, summary)
+	})
+	t.Run("peer accounts trie for a shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		header := &ArchiveHeader{
+			ShardID:  1,
+			Epoch:    testEpoch,
+			RootHash: ts.rootHash,
+		}
+		node := []byte("peer trie node")
+		buff := writeTestArchive(t, ts, header, func(aw *archiveWriter) {
+			require.Nil(t, aw.addEntry(SectionPeerAccountsTrie, testHasher.Compute(string(node)), node))
+		})
+
+		importer, _ := NewStateImporter(createMockArgsStateImporter(createTestStorersCreator(t, 1), computeMetaBlockHash(t, ts)))
+		summary, err := importer.Import(buff)
+		assert.True(t, errors.Is(err, ErrUnexpectedSection))
+		assert.Nil(t, summary)
+	})
+	t.Run("crafted archive with an untrusted meta block should error before writing anything", func(t *testing.T) {
+		t.Parallel()
+
+		ts := createTestState(t, 1)
+		trustedMetaBlockHash := computeMetaBlockHash(t, ts)
+
+		// a self consistent archive: its meta block notarizes the archived root hash and matches the hash in the header
+		craftedMetaBlock := *ts.metaBlock
+		craftedMetaBlock.Nonce++
+		craftedState := *ts
+		craftedState.metaBlock = &craftedMetaBlock
+		header := &ArchiveHeader{
+			ShardID:  1,
+			Epoch:    testEpoch,
+			RootHash: ts.rootHash,
+		}
+		buff := writeTestArchive(t, &craftedState, header, func(_ *archiveWriter) {})
+
+		destination := createTestStorersCreator(t, 1)
+		importer, _ := NewStateImporter(createMockArgsStateImporter(destination, trustedMetaBlockHash))
+		summary, err := importer.Import(buff)
+		assert.True(t, errors.Is(err, ErrUntrustedEpochStartMetaBlock))
+		assert.Nil(t, summary)
+
+		storers, err := destination.Create(1, testEpoch)
+		require.Nil(t, err)
+		defer func() {
+			_ = storers.Close()
+		}()
+		_, err = storers.MetaBlock.Get([]byte(core.EpochStartIdentifier(testEpoch)))
+		assert.NotNil(t, err)
+	})
+}
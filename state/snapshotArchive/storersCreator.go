@@ -0,0 +1,206 @@
+package snapshotArchive
+
+import (
+	"errors"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+)
+
+// Storers holds the storage units of a shard read by the exporter or written by the importer. The peer accounts trie
+// storer is set only for the metachain
+type Storers struct {
+	AccountsTrie     common.BaseStorer
+	PeerAccountsTrie common.BaseStorer
+	MetaBlock        common.BaseStorer
+	Bootstrap        common.BaseStorer
+}
+
+// Close closes all the opened storers
+func (s *Storers) Close() error {
+	var lastErr error
+	for _, storer := range []common.BaseStorer{s.AccountsTrie, s.PeerAccountsTrie, s.MetaBlock, s.Bootstrap} {
+		if check.IfNil(storer) {
+			continue
+		}
+
+		err := storer.Close()
+		if err != nil {
+			log.Warn("snapshotArchive: could not close storer", "error", err)
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// ArgsStorersCreator holds the arguments needed to create a storers creator
+type ArgsStorersCreator struct {
+	GeneralConfig config.Config
+	PathManager   storage.PathManagerHandler
+	// NumTrieEpochs is the number of epochs, going back from the requested one, in which the trie nodes are searched
+	NumTrieEpochs uint32
+}
+
+type storersCreator struct {
+	generalConfig config.Config
+	pathManager   storage.PathManagerHandler
+	numTrieEpochs uint32
+}
+
+// NewStorersCreator creates a component able to open the epoch storers of a shard directly from the disk
+func NewStorersCreator(args ArgsStorersCreator) (*storersCreator, error) {
+	if check.IfNil(args.PathManager) {
+		return nil, ErrNilPathManager
+	}
+	if args.NumTrieEpochs == 0 {
+		return nil, ErrInvalidNumEpochs
+	}
+
+	return &storersCreator{
+		generalConfig: args.GeneralConfig,
+		pathManager:   args.PathManager,
+		numTrieEpochs: args.NumTrieEpochs,
+	}, nil
+}
+
+// Create opens the storers of the provided shard and epoch. The trie storers also search the nodes in the previous
+// epochs, as a trie node is stored in the epoch it was committed in until a snapshot copies it
+func (sc *storersCreator) Create(shardID uint32, epoch uint32) (*Storers, error) {
+	shardIDStr := core.GetShardIDString(shardID)
+	storers := &Storers{}
+
+	var err error
+	storers.MetaBlock, err = sc.openEpochPersister(shardIDStr, epoch, sc.generalConfig.MetaBlockStorage.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	storers.Bootstrap, err = sc.openEpochPersister(shardIDStr, epoch, sc.generalConfig.BootstrapStorage.DB)
+	if err != nil {
+		_ = storers.Close()
+		return nil, err
+	}
+
+	storers.AccountsTrie, err = sc.openTrieStorer(shardIDStr, epoch, sc.generalConfig.AccountsTrieStorage.DB)
+	if err != nil {
+		_ = storers.Close()
+		return nil, err
+	}
+
+	if shardID != core.MetachainShardId {
+		return storers, nil
+	}
+
+	storers.PeerAccountsTrie, err = sc.openTrieStorer(shardIDStr, epoch, sc.generalConfig.PeerAccountsTrieStorage.DB)
+	if err != nil {
+		_ = storers.Close()
+		return nil, err
+	}
+
+	return storers, nil
+}
+
+func (sc *storersCreator) openTrieStorer(shardIDStr string, epoch uint32, dbConfig config.DBConfig) (common.BaseStorer, error) {
+	persister, err := sc.openEpochPersister(shardIDStr, epoch, dbConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	storer := &epochsStorer{
+		persisters: []storage.Persister{persister},
+	}
+	for i := uint32(1); i < sc.numTrieEpochs && i <= epoch; i++ {
+		path := sc.pathManager.PathForEpoch(shardIDStr, epoch-i, dbConfig.FilePath)
+		if !dirExists(path) {
+			continue
+		}
+
+		persister, err = sc.openPersister(path, dbConfig)
+		if err != nil {
+			_ = storer.Close()
+			return nil, err
+		}
+
+		storer.persisters = append(storer.persisters, persister)
+	}
+
+	return storer, nil
+}
+
+func (sc *storersCreator) openEpochPersister(shardIDStr string, epoch uint32, dbConfig config.DBConfig) (storage.Persister, error) {
+	return sc.openPersister(sc.pathManager.PathForEpoch(shardIDStr, epoch, dbConfig.FilePath), dbConfig)
+}
+
+func (sc *storersCreator) openPersister(path string, dbConfig config.DBConfig) (storage.Persister, error) {
+	persisterFactory, err := factory.NewPersisterFactory(factory.NewDBConfigHandler(dbConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	return persisterFactory.Create(path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sc *storersCreator) IsInterfaceNil() bool {
+	return sc == nil
+}
+
+// epochsStorer writes in the first persister and reads from all the persisters, in order
+type epochsStorer struct {
+	persisters []storage.Persister
+}
+
+// Put adds the value in the first persister
+func (es *epochsStorer) Put(key, val []byte) error {
+	return es.persisters[0].Put(key, val)
+}
+
+// Get returns the value from the first persister that holds the key
+func (es *epochsStorer) Get(key []byte) ([]byte, error) {
+	for _, persister := range es.persisters {
+		val, err := persister.Get(key)
+		if err == nil {
+			return val, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Remove removes the key from the first persister
+func (es *epochsStorer) Remove(key []byte) error {
+	return es.persisters[0].Remove(key)
+}
+
+// Close closes all the persisters
+func (es *epochsStorer) Close() error {
+	var lastErr error
+	for _, persister := range es.persisters {
+		err := persister.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (es *epochsStorer) IsInterfaceNil() bool {
+	return es == nil
+}
+
+func dirExists(path string) bool {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false
+	}
+
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,86 @@
+package snapshotArchive
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStorersCreator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil path manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		sc, err := NewStorersCreator(ArgsStorersCreator{NumTrieEpochs: 1})
+		assert.Equal(t, ErrNilPathManager, err)
+		assert.Nil(t, sc)
+	})
+	t.Run("invalid number of epochs should error", func(t *testing.T) {
+		t.Parallel()
+
+		sc, err := NewStorersCreator(ArgsStorersCreator{PathManager: &testscommon.PathManagerStub{}})
+		assert.Equal(t, ErrInvalidNumEpochs, err)
+		assert.Nil(t, sc)
+	})
+}
+
+func TestStorersCreator_Create(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shard storers should not contain the peer accounts trie", func(t *testing.T) {
+		t.Parallel()
+
+		sc := createTestStorersCreator(t, 1)
+		storers, err := sc.Create(0, 1)
+		require.Nil(t, err)
+		defer func() {
+			require.Nil(t, storers.Close())
+		}()
+
+		assert.NotNil(t, storers.AccountsTrie)
+		assert.NotNil(t, storers.MetaBlock)
+		assert.NotNil(t, storers.Bootstrap)
+		assert.Nil(t, storers.PeerAccountsTrie)
+	})
+	t.Run("metachain storers should contain the peer accounts trie", func(t *testing.T) {
+		t.Parallel()
+
+		sc := createTestStorersCreator(t, 1)
+		storers, err := sc.Create(core.MetachainShardId, 1)
+		require.Nil(t, err)
+		defer func() {
+			require.Nil(t, storers.Close())
+		}()
+
+		assert.NotNil(t, storers.PeerAccountsTrie)
+	})
+	t.Run("trie storers should read from the previous epochs", func(t *testing.T) {
+		t.Parallel()
+
+		sc := createTestStorersCreator(t, 3)
+		for epoch := uint32(0); epoch < 3; epoch++ {
+			storers, err := sc.Create(0, epoch)
+			require.Nil(t, err)
+			require.Nil(t, storers.AccountsTrie.Put([]byte{byte(epoch)}, []byte("node")))
+			require.Nil(t, storers.Close())
+		}
+
+		storers, err := sc.Create(0, 3)
+		require.Nil(t, err)
+		defer func() {
+			require.Nil(t, storers.Close())
+		}()
+
+		_, err = storers.AccountsTrie.Get([]byte{0})
+		assert.NotNil(t, err)
+		for epoch := uint32(1); epoch < 3; epoch++ {
+			val, errGet := storers.AccountsTrie.Get([]byte{byte(epoch)})
+			require.Nil(t, errGet)
+			assert.Equal(t, []byte("node"), val)
+		}
+	})
+}
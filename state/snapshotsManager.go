@@ -26,6 +26,8 @@ type ArgsNewSnapshotsManager struct {
 	ChannelsProvider         IteratorChannelsProvider
 	StateStatsHandler        StateStatsHandler
 	LastSnapshotMarker       LastSnapshotMarker
+	// TrieGarbageCollector is optional. If set, the unreachable trie nodes are collected after each successful snapshot
+	TrieGarbageCollector TrieGarbageCollector
 }
 
 type snapshotsManager struct {
@@ -43,7 +45,10 @@ type snapshotsManager struct {
 	channelsProvider     IteratorChannelsProvider
 	accountFactory       AccountFactory
 	stateStatsHandler    StateStatsHandler
-	mutex                sync.RWMutex
+	trieGarbageCollector TrieGarbageCollector
+	// lastCommittedRootHashHandler is set later, by the accounts adapter
+	lastCommittedRootHashHandler func() []byte
+	mutex                        sync.RWMutex
 }
 
 // NewSnapshotsManager creates a new snapshots manager
@@ -88,6 +93,7 @@ func NewSnapshotsManager(args ArgsNewSnapshotsManager) (*snapshotsManager, error
 		accountFactory:           args.AccountFactory,
 		stateStatsHandler:        args.StateStatsHandler,
 		lastSnapshotMarker:       args.LastSnapshotMarker,
+		trieGarbageCollector:     args.TrieGarbageCollector,
 	}, nil
 }
 
@@ -104,6 +110,20 @@ func (sm *snapshotsManager) SetSyncer(syncer AccountsDBSyncer) error {
 	return nil
 }
 
+// SetLastCommittedRootHashHandler sets the handler providing the root hash of the last committed state, which is kept
+// by the trie garbage collection together with the last snapshot
+func (sm *snapshotsManager) SetLastCommittedRootHashHandler(handler func() []byte) error {
+	if handler == nil {
+		return ErrNilLastCommittedRootHashHandler
+	}
+
+	sm.mutex.Lock()
+	sm.lastCommittedRootHashHandler = handler
+	sm.mutex.Unlock()
+
+	return nil
+}
+
 // IsSnapshotInProgress returns true if a snapshot is in progress
 func (sm *snapshotsManager) IsSnapshotInProgress() bool {
 	return sm.isSnapshotInProgress.IsSet()
@@ -243,6 +263,9 @@ func (sm *snapshotsManager) snapshotState(
 		return
 	}
 
+	isIncremental := trieStorageManager.PrepareSnapshot(epoch)
+	log.Debug("snapshot type", "rootHash", rootHash, "epoch", epoch, "incremental", isIncremental)
+
 	missingNodesChannel := make(chan []byte, missingNodesChannelSize)
 	iteratorChannels := sm.channelsProvider.GetIteratorChannels()
 
@@ -259,7 +282,7 @@ func (sm *snapshotsManager) snapshotState(
 
 	go sm.syncMissingNodes(missingNodesChannel, iteratorChannels.ErrChan, stats, sm.getTrieSyncer())
 
-	go sm.processSnapshotCompletion(stats, trieStorageManager, missingNodesChannel, iteratorChannels.ErrChan, rootHash, epoch)
+	go sm.processSnapshotCompletion(stats, trieStorageManager, missingNodesChannel, iteratorChannels.ErrChan, rootHash, epoch, isIncremental)
 }
 
 func (sm *snapshotsManager) earlySnapshotCompletion(stats *snapshotStatistics, trieStorageManager common.StorageManager) {
@@ -340,6 +363,7 @@ func (sm *snapshotsManager) processSnapshotCompletion(
 	errChan common.BufferedErrChan,
 	rootHash []byte,
 	epoch uint32,
+	isIncremental bool,
 ) {
 	sm.finishSnapshotOperation(rootHash, stats, missingNodesCh, sm.stateMetrics.GetSnapshotMessage(), trieStorageManager)
 
@@ -352,6 +376,7 @@ func (sm *snapshotsManager) processSnapshotCompletion(
 
 	errorDuringSnapshot := errChan.ReadFromChanNonBlocking()
 	shouldNotMarkActive := trieStorageManager.IsClosed() || errorDuringSnapshot != nil
+	trieStorageManager.FinalizeSnapshot(!shouldNotMarkActive)
 	if shouldNotMarkActive {
 		log.Debug("will not set activeDB in epoch as the snapshot might be incomplete",
 			"epoch", epoch, "trie storage manager closed", trieStorageManager.IsClosed(),
@@ -361,11 +386,86 @@ func (sm *snapshotsManager) processSnapshotCompletion(
 
 	sm.lastSnapshotMarker.RemoveMarker(trieStorageManager, epoch, rootHash)
 
+	isCompacted := isIncremental && sm.compactIncrementalSnapshots(rootHash, epoch, trieStorageManager)
+
+	if !check.IfNil(sm.trieGarbageCollector) {
+		sm.trieGarbageCollector.CollectGarbage(sm.getRootHashesToKeep(rootHash), trieStorageManager)
+	}
+
+	if isIncremental && !isCompacted {
+		// the epoch only holds the nodes committed since the previous snapshot, so the older epochs are still needed
+		log.Debug("will not set activeDB in epoch as the incremental snapshot was not compacted", "epoch", epoch)
+		return
+	}
+
 	log.Debug("set activeDB in epoch", "epoch", epoch)
 	errPut := trieStorageManager.PutInEpochWithoutCache([]byte(common.ActiveDBKey), []byte(common.ActiveDBVal), epoch)
 	handleLoggingWhenError("error while putting active DB value into main storer", errPut)
 }
 
+func (sm *snapshotsManager) getRootHashesToKeep(snapshotRootHash []byte) [][]byte {
+	rootHashes := [][]byte{snapshotRootHash}
+
+	sm.mutex.RLock()
+	lastCommittedRootHashHandler := sm.lastCommittedRootHashHandler
+	sm.mutex.RUnlock()
+	if lastCommittedRootHashHandler == nil {
+		return rootHashes
+	}
+
+	currentRootHash := lastCommittedRootHashHandler()
+	if len(currentRootHash) == 0 || bytes.Equal(currentRootHash, snapshotRootHash) {
+		return rootHashes
+	}
+
+	return append(rootHashes, currentRootHash)
+}
+
+// compactIncrementalSnapshots copies into the epoch of the incremental snapshot all the nodes of the provided root which
+// were not saved by the last full snapshot, so that the delta epochs between them can be released
+func (sm *snapshotsManager) compactIncrementalSnapshots(rootHash []byte, epoch uint32, trieStorageManager common.StorageManager) bool {
+	if !trieStorageManager.PrepareSnapshotsCompaction(epoch) {
+		return false
+	}
+
+	log.Debug("starting the incremental snapshots compaction", "rootHash", rootHash, "epoch", epoch)
+
+	trieStorageManager.EnterPruningBufferingMode()
+	stats := newSnapshotStatistics(1, 1)
+	missingNodesChannel := make(chan []byte, missingNodesChannelSize)
+	iteratorChannels := sm.channelsProvider.GetIteratorChannels()
+	defer iteratorChannels.ErrChan.Close()
+
+	go func() {
+		stats.NewSnapshotStarted()
+
+		trieStorageManager.TakeSnapshot("", rootHash, rootHash, iteratorChannels, missingNodesChannel, stats, epoch)
+		sm.snapshotUserAccountDataTrie(rootHash, iteratorChannels, missingNodesChannel, stats, epoch, trieStorageManager)
+
+		stats.SnapshotFinished()
+	}()
+
+	go sm.syncMissingNodes(missingNodesChannel, iteratorChannels.ErrChan, stats, sm.getTrieSyncer())
+
+	sm.finishSnapshotOperation(rootHash, stats, missingNodesChannel, "incremental snapshots compaction", trieStorageManager)
+
+	errorDuringCompaction := iteratorChannels.ErrChan.ReadFromChanNonBlocking()
+	isSuccessful := !trieStorageManager.IsClosed() && errorDuringCompaction == nil
+	err := trieStorageManager.FinalizeSnapshotsCompaction(isSuccessful)
+	if err != nil {
+		log.Warn("can not release the incremental snapshot epochs", "epoch", epoch, "error", err)
+		return false
+	}
+	if !isSuccessful {
+		log.Debug("the incremental snapshots compaction might be incomplete",
+			"epoch", epoch, "trie storage manager closed", trieStorageManager.IsClosed(),
+			"errors during compaction found", errorDuringCompaction)
+		return false
+	}
+
+	return true
+}
+
 func (sm *snapshotsManager) printStorageStatistics() {
 	stats := sm.stateStatsHandler.SnapshotStats()
 	if stats != nil {
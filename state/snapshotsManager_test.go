@@ -487,7 +487,13 @@ func TestSnapshotsManager_SnapshotState(t *testing.T) {
 
 		expectedErr := errors.New("some error")
 
-		sm, _ := state.NewSnapshotsManager(getDefaultSnapshotManagerArgs())
+		args := getDefaultSnapshotManagerArgs()
+		args.TrieGarbageCollector = &stateTest.TrieGarbageCollectorStub{
+			CollectGarbageCalled: func(_ [][]byte, _ common.StorageManager) {
+				assert.Fail(t, "should not collect the garbage after an incomplete snapshot")
+			},
+		}
+		sm, _ := state.NewSnapshotsManager(args)
 		tsm := &storageManager.StorageManagerStub{
 			GetLatestStorageEpochCalled: func() (uint32, error) {
 				return 5, nil
@@ -551,4 +557,264 @@ func TestSnapshotsManager_SnapshotState(t *testing.T) {
 		assert.True(t, putInEpochWithoutCacheCalled)
 		assert.True(t, removeFromAllActiveEpochsCalled)
 	})
+	t.Run("snapshot ok should start the trie garbage collection", func(t *testing.T) {
+		t.Parallel()
+
+		collectGarbageCalled := atomic.Flag{}
+
+		args := getDefaultSnapshotManagerArgs()
+		args.ChannelsProvider = iteratorChannelsProvider.NewUserStateIteratorChannelsProvider()
+		tsm := &storageManager.StorageManagerStub{
+			GetLatestStorageEpochCalled: func() (uint32, error) {
+				return 5, nil
+			},
+			ShouldTakeSnapshotCalled: func() bool {
+				return true
+			},
+			TakeSnapshotCalled: func(_ string, _ []byte, _ []byte, channels *common.TrieIteratorChannels, _ chan []byte, stats common.SnapshotStatisticsHandler, u uint32) {
+				stats.SnapshotFinished()
+				close(channels.LeavesChan)
+			},
+		}
+		args.TrieGarbageCollector = &stateTest.TrieGarbageCollectorStub{
+			CollectGarbageCalled: func(rootHashesToKeep [][]byte, trieStorageManager common.StorageManager) {
+				assert.Equal(t, [][]byte{rootHash}, rootHashesToKeep)
+				assert.True(t, trieStorageManager == tsm)
+				collectGarbageCalled.SetValue(true)
+			},
+		}
+		sm, _ := state.NewSnapshotsManager(args)
+		_ = sm.SetSyncer(&mock.AccountsDBSyncerStub{})
+
+		sm.SnapshotState(rootHash, epoch, tsm)
+		for sm.IsSnapshotInProgress() {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		assert.True(t, collectGarbageCalled.IsSet())
+	})
+	t.Run("snapshot ok should keep the last committed state in the trie garbage collection", func(t *testing.T) {
+		t.Parallel()
+
+		collectGarbageCalled := atomic.Flag{}
+		currentRootHash := []byte("current root hash")
+
+		args := getDefaultSnapshotManagerArgs()
+		args.ChannelsProvider = iteratorChannelsProvider.NewUserStateIteratorChannelsProvider()
+		tsm := &storageManager.StorageManagerStub{
+			GetLatestStorageEpochCalled: func() (uint32, error) {
+				return 5, nil
+			},
+			ShouldTakeSnapshotCalled: func() bool {
+				return true
+			},
+			TakeSnapshotCalled: func(_ string, _ []byte, _ []byte, channels *common.TrieIteratorChannels, _ chan []byte, stats common.SnapshotStatisticsHandler, u uint32) {
+				stats.SnapshotFinished()
+				close(channels.LeavesChan)
+			},
+		}
+		args.TrieGarbageCollector = &stateTest.TrieGarbageCollectorStub{
+			CollectGarbageCalled: func(rootHashesToKeep [][]byte, _ common.StorageManager) {
+				assert.Equal(t, [][]byte{rootHash, currentRootHash}, rootHashesToKeep)
+				collectGarbageCalled.SetValue(true)
+			},
+		}
+		sm, _ := state.NewSnapshotsManager(args)
+		_ = sm.SetSyncer(&mock.AccountsDBSyncerStub{})
+		assert.Equal(t, state.ErrNilLastCommittedRootHashHandler, sm.SetLastCommittedRootHashHandler(nil))
+		err := sm.SetLastCommittedRootHashHandler(func() []byte {
+			return currentRootHash
+		})
+		assert.Nil(t, err)
+
+		sm.SnapshotState(rootHash, epoch, tsm)
+		for sm.IsSnapshotInProgress() {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		assert.True(t, collectGarbageCalled.IsSet())
+	})
+	t.Run("incremental snapshot not compacted should remove lastSnapshot and should not mark db as complete", func(t *testing.T) {
+		t.Parallel()
+
+		removeFromAllActiveEpochsCalled := atomic.Flag{}
+		finalizeSnapshotCalled := atomic.Flag{}
+
+		args := getDefaultSnapshotManagerArgs()
+		args.ChannelsProvider = iteratorChannelsProvider.NewUserStateIteratorChannelsProvider()
+		sm, _ := state.NewSnapshotsManager(args)
+		_ = sm.SetSyncer(&mock.AccountsDBSyncerStub{})
+		tsm := &storageManager.StorageManagerStub{
+			GetLatestStorageEpochCalled: func() (uint32, error) {
+				return 5, nil
+			},
+			ShouldTakeSnapshotCalled: func() bool {
+				return true
+			},
+			PrepareSnapshotCalled: func(_ uint32) bool {
+				return true
+			},
+			FinalizeSnapshotCalled: func(isSuccessful bool) {
+				assert.True(t, isSuccessful)
+				finalizeSnapshotCalled.SetValue(true)
+			},
+			TakeSnapshotCalled: func(_ string, _ []byte, _ []byte, channels *common.TrieIteratorChannels, _ chan []byte, stats common.SnapshotStatisticsHandler, u uint32) {
+				stats.SnapshotFinished()
+				close(channels.LeavesChan)
+			},
+			RemoveFromAllActiveEpochsCalled: func(hash []byte) error {
+				removeFromAllActiveEpochsCalled.SetValue(true)
+				return nil
+			},
+			PutInEpochWithoutCacheCalled: func(key []byte, val []byte, e uint32) error {
+				assert.Fail(t, "should not mark db as complete")
+				return nil
+			},
+		}
+
+		sm.SnapshotState(rootHash, epoch, tsm)
+		for sm.IsSnapshotInProgress() {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		assert.True(t, finalizeSnapshotCalled.IsSet())
+		assert.True(t, removeFromAllActiveEpochsCalled.IsSet())
+	})
+	t.Run("incremental snapshot should be compacted and should mark db as complete", func(t *testing.T) {
+		t.Parallel()
+
+		numTakeSnapshotCalls := 0
+		finalizeCompactionCalled := atomic.Flag{}
+		putInEpochCalled := atomic.Flag{}
+
+		args := getDefaultSnapshotManagerArgs()
+		args.ChannelsProvider = iteratorChannelsProvider.NewUserStateIteratorChannelsProvider()
+		sm, _ := state.NewSnapshotsManager(args)
+		_ = sm.SetSyncer(&mock.AccountsDBSyncerStub{})
+		tsm := &storageManager.StorageManagerStub{
+			GetLatestStorageEpochCalled: func() (uint32, error) {
+				return 5, nil
+			},
+			ShouldTakeSnapshotCalled: func() bool {
+				return true
+			},
+			PrepareSnapshotCalled: func(_ uint32) bool {
+				return true
+			},
+			PrepareSnapshotsCompactionCalled: func(e uint32) bool {
+				assert.Equal(t, epoch, e)
+				return true
+			},
+			FinalizeSnapshotsCompactionCalled: func(isSuccessful bool) error {
+				assert.True(t, isSuccessful)
+				assert.Equal(t, 2, numTakeSnapshotCalls)
+				finalizeCompactionCalled.SetValue(true)
+				return nil
+			},
+			TakeSnapshotCalled: func(_ string, _ []byte, _ []byte, channels *common.TrieIteratorChannels, _ chan []byte, stats common.SnapshotStatisticsHandler, e uint32) {
+				assert.Equal(t, epoch, e)
+				numTakeSnapshotCalls++
+				stats.SnapshotFinished()
+				close(channels.LeavesChan)
+			},
+			PutInEpochWithoutCacheCalled: func(key []byte, val []byte, e uint32) error {
+				assert.True(t, finalizeCompactionCalled.IsSet())
+				assert.Equal(t, []byte(common.ActiveDBKey), key)
+				assert.Equal(t, epoch, e)
+				putInEpochCalled.SetValue(true)
+				return nil
+			},
+		}
+
+		sm.SnapshotState(rootHash, epoch, tsm)
+		for sm.IsSnapshotInProgress() {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		assert.True(t, finalizeCompactionCalled.IsSet())
+		assert.True(t, putInEpochCalled.IsSet())
+	})
+	t.Run("failed compaction should not mark db as complete", func(t *testing.T) {
+		t.Parallel()
+
+		numTakeSnapshotCalls := 0
+		finalizeCompactionCalled := atomic.Flag{}
+
+		args := getDefaultSnapshotManagerArgs()
+		args.ChannelsProvider = iteratorChannelsProvider.NewUserStateIteratorChannelsProvider()
+		sm, _ := state.NewSnapshotsManager(args)
+		_ = sm.SetSyncer(&mock.AccountsDBSyncerStub{})
+		tsm := &storageManager.StorageManagerStub{
+			GetLatestStorageEpochCalled: func() (uint32, error) {
+				return 5, nil
+			},
+			ShouldTakeSnapshotCalled: func() bool {
+				return true
+			},
+			PrepareSnapshotCalled: func(_ uint32) bool {
+				return true
+			},
+			PrepareSnapshotsCompactionCalled: func(_ uint32) bool {
+				return true
+			},
+			FinalizeSnapshotsCompactionCalled: func(isSuccessful bool) error {
+				assert.False(t, isSuccessful)
+				finalizeCompactionCalled.SetValue(true)
+				return nil
+			},
+			TakeSnapshotCalled: func(_ string, _ []byte, _ []byte, channels *common.TrieIteratorChannels, _ chan []byte, stats common.SnapshotStatisticsHandler, _ uint32) {
+				numTakeSnapshotCalls++
+				stats.SnapshotFinished()
+				close(channels.LeavesChan)
+				if numTakeSnapshotCalls == 2 {
+					channels.ErrChan.WriteInChanNonBlocking(errors.New("some error"))
+				}
+			},
+			PutInEpochWithoutCacheCalled: func(key []byte, val []byte, e uint32) error {
+				assert.Fail(t, "should not mark db as complete")
+				return nil
+			},
+		}
+
+		sm.SnapshotState(rootHash, epoch, tsm)
+		for sm.IsSnapshotInProgress() {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		assert.True(t, finalizeCompactionCalled.IsSet())
+	})
+	t.Run("snapshot with errors should finalize the snapshot as unsuccessful", func(t *testing.T) {
+		t.Parallel()
+
+		finalizeSnapshotCalled := atomic.Flag{}
+
+		sm, _ := state.NewSnapshotsManager(getDefaultSnapshotManagerArgs())
+		tsm := &storageManager.StorageManagerStub{
+			GetLatestStorageEpochCalled: func() (uint32, error) {
+				return 5, nil
+			},
+			ShouldTakeSnapshotCalled: func() bool {
+				return true
+			},
+			PrepareSnapshotCalled: func(_ uint32) bool {
+				return true
+			},
+			FinalizeSnapshotCalled: func(isSuccessful bool) {
+				assert.False(t, isSuccessful)
+				finalizeSnapshotCalled.SetValue(true)
+			},
+			TakeSnapshotCalled: func(_ string, _ []byte, _ []byte, channels *common.TrieIteratorChannels, _ chan []byte, stats common.SnapshotStatisticsHandler, _ uint32) {
+				stats.SnapshotFinished()
+				close(channels.LeavesChan)
+				channels.ErrChan.WriteInChanNonBlocking(errors.New("some error"))
+			},
+		}
+
+		sm.SnapshotState(rootHash, epoch, tsm)
+		for sm.IsSnapshotInProgress() {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		assert.True(t, finalizeSnapshotCalled.IsSet())
+	})
 }
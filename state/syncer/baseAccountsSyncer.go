@@ -34,6 +34,10 @@ type baseAccountsSyncer struct {
 	userAccountsSyncStatisticsHandler common.SizeSyncStatisticsHandler
 	appStatusHandler                  core.AppStatusHandler
 	enableEpochsHandler               common.EnableEpochsHandler
+	syncCheckpointHandler             trie.SyncCheckpointHandler
+
+	mutMainTrieSyncer sync.RWMutex
+	mainTrieSyncer    trie.TrieSyncer
 
 	trieSyncerVersion int
 	numTriesSynced    int32
@@ -53,6 +57,7 @@ type ArgsNewBaseAccountsSyncer struct {
 	UserAccountsSyncStatisticsHandler common.SizeSyncStatisticsHandler
 	AppStatusHandler                  core.AppStatusHandler
 	EnableEpochsHandler               common.EnableEpochsHandler
+	SyncCheckpointHandler             trie.SyncCheckpointHandler
 	MaxTrieLevelInMemory              uint
 	MaxHardCapForMissingNodes         int
 	TrieSyncerVersion                 int
@@ -84,6 +89,9 @@ func checkArgs(args ArgsNewBaseAccountsSyncer) error {
 	if check.IfNil(args.EnableEpochsHandler) {
 		return state.ErrNilEnableEpochsHandler
 	}
+	if check.IfNil(args.SyncCheckpointHandler) {
+		return trie.ErrNilSyncCheckpointHandler
+	}
 	if args.MaxHardCapForMissingNodes < 1 {
 		return state.ErrInvalidMaxHardCapForMissingNodes
 	}
@@ -96,6 +104,7 @@ func (b *baseAccountsSyncer) syncMainTrie(
 	trieTopic string,
 	ctx context.Context,
 	leavesChan chan core.KeyValueHolder,
+	checkpointHandler trie.SyncCheckpointHandler,
 ) error {
 	atomic.AddInt32(&b.numMaxTries, 1)
 
@@ -115,12 +124,17 @@ func (b *baseAccountsSyncer) syncMainTrie(
 		MaxHardCapForMissingNodes: b.maxHardCapForMissingNodes,
 		CheckNodesOnDisk:          b.checkNodesOnDisk,
 		LeavesChan:                leavesChan,
+		SyncCheckpointHandler:     checkpointHandler,
 	}
 	trieSyncer, err := trie.CreateTrieSyncer(arg, b.trieSyncerVersion)
 	if err != nil {
 		return err
 	}
 
+	b.mutMainTrieSyncer.Lock()
+	b.mainTrieSyncer = trieSyncer
+	b.mutMainTrieSyncer.Unlock()
+
 	err = trieSyncer.StartSyncing(rootHash, ctx)
 	if err != nil {
 		return err
@@ -137,6 +151,7 @@ func (b *baseAccountsSyncer) printStatisticsAndUpdateMetrics(ctx context.Context
 	lastDataReceived := uint64(0)
 	peakDataReceived := uint64(0)
 	startedSync := time.Now()
+	eta := newSyncTimeEstimator()
 	for {
 		select {
 		case <-ctx.Done():
@@ -158,7 +173,7 @@ func (b *baseAccountsSyncer) printStatisticsAndUpdateMetrics(ctx context.Context
 				"average processing speed", averageSpeed,
 			)
 			b.userAccountsSyncStatisticsHandler.Reset()
-			b.updateMetrics()
+			b.updateMetrics(b.getMainTrieProgress(), 0)
 			return
 		case <-time.After(timeBetweenStatisticsPrints):
 			bytesReceivedDelta := b.userAccountsSyncStatisticsHandler.NumBytesReceived() - lastDataReceived
@@ -172,6 +187,9 @@ func (b *baseAccountsSyncer) printStatisticsAndUpdateMetrics(ctx context.Context
 				peakDataReceived = bytesReceivedDelta
 			}
 
+			progress := b.getMainTrieProgress()
+			timeLeft := eta.estimateTimeLeft(progress)
+
 			log.Info("trie sync in progress",
 				"name", b.name,
 				"time elapsed", time.Since(startedSync).Truncate(time.Second),
@@ -185,19 +203,34 @@ func (b *baseAccountsSyncer) printStatisticsAndUpdateMetrics(ctx context.Context
 				"state data size", core.ConvertBytes(b.userAccountsSyncStatisticsHandler.NumBytesReceived()),
 				"iterations", b.userAccountsSyncStatisticsHandler.NumIterations(),
 				"CPU time", b.userAccountsSyncStatisticsHandler.ProcessingTime(),
-				"processing speed", speed)
+				"processing speed", speed,
+				"main trie progress", fmt.Sprintf("%.2f%%", progress*100),
+				"estimated time left", timeLeft.Truncate(time.Second))
 
-			b.updateMetrics()
+			b.updateMetrics(progress, timeLeft)
 		}
 	}
 }
 
-func (b *baseAccountsSyncer) updateMetrics() {
+func (b *baseAccountsSyncer) updateMetrics(progress float64, timeLeft time.Duration) {
 	b.appStatusHandler.SetUInt64Value(common.MetricTrieSyncNumProcessedNodes, uint64(b.userAccountsSyncStatisticsHandler.NumProcessed()))
 	b.appStatusHandler.SetUInt64Value(common.MetricTrieSyncNumReceivedBytes, b.userAccountsSyncStatisticsHandler.NumBytesReceived())
+	b.appStatusHandler.SetUInt64Value(common.MetricTrieSyncProgressPercent, uint64(progress*100))
+	b.appStatusHandler.SetUInt64Value(common.MetricTrieSyncEstimatedTimeLeft, uint64(timeLeft.Seconds()))
 	b.appStatusHandler.SetUInt64Value(common.MetricShardId, uint64(b.shardId))
 }
 
+func (b *baseAccountsSyncer) getMainTrieProgress() float64 {
+	b.mutMainTrieSyncer.RLock()
+	defer b.mutMainTrieSyncer.RUnlock()
+
+	if check.IfNil(b.mainTrieSyncer) {
+		return 0
+	}
+
+	return b.mainTrieSyncer.Progress()
+}
+
 func convertBytesPerIntervalToSpeed(bytes uint64, interval time.Duration) string {
 	if interval < time.Millisecond {
 		// con not compute precisely, highly likely to get an overflow
@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
+	"github.com/multiversx/mx-chain-go/trie"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,6 +31,7 @@ func getDefaultBaseAccSyncerArgs() syncer.ArgsNewBaseAccountsSyncer {
 		MaxHardCapForMissingNodes:         100,
 		TrieSyncerVersion:                 3,
 		CheckNodesOnDisk:                  false,
+		SyncCheckpointHandler:             trie.NewDisabledSyncCheckpointer(),
 	}
 }
 
@@ -0,0 +1,119 @@
+package syncer
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+const pendingDataTriesKeyPrefix = "pendingDataTries_"
+
+// mainTrieCheckpointHandler saves the checkpoints of the main accounts trie together with the data tries that were
+// discovered, but were not synced yet. The leaves written by the main trie syncer before a checkpoint was created must
+// be processed before the checkpoint is saved, otherwise the data tries of those leaves would be lost on resume. The
+// data tries synced recently are kept in the checkpoint as well, as their last nodes might not be persisted yet.
+type mainTrieCheckpointHandler struct {
+	trie.SyncCheckpointHandler
+	numLeavesProcessed uint64
+
+	mutDataTries      sync.Mutex
+	pendingDataTries  map[string]struct{}
+	finishedDataTries map[string]time.Time
+}
+
+func newMainTrieCheckpointHandler(handler trie.SyncCheckpointHandler) *mainTrieCheckpointHandler {
+	return &mainTrieCheckpointHandler{
+		SyncCheckpointHandler: handler,
+		pendingDataTries:      make(map[string]struct{}),
+		finishedDataTries:     make(map[string]time.Time),
+	}
+}
+
+// SaveCheckpoint saves the provided main trie checkpoint and the pending data tries, if all the leaves sent before
+// the checkpoint was created were processed
+func (h *mainTrieCheckpointHandler) SaveCheckpoint(rootHash []byte, checkpoint *trie.SyncCheckpoint) error {
+	if atomic.LoadUint64(&h.numLeavesProcessed) < checkpoint.NumLeavesSent {
+		log.Trace("main trie checkpoint skipped, not all the leaves were processed", "root hash", rootHash)
+		return nil
+	}
+
+	dataTries := h.getPendingDataTries()
+	err := h.SyncCheckpointHandler.SaveCheckpoint(pendingDataTriesKey(rootHash), &trie.SyncCheckpoint{
+		PendingHashes: dataTries,
+		Weights:       make([]float64, len(dataTries)),
+	})
+	if err != nil {
+		return err
+	}
+
+	return h.SyncCheckpointHandler.SaveCheckpoint(rootHash, checkpoint)
+}
+
+// RemoveCheckpoint does nothing, the main trie checkpoint is removed after all the data tries were synced
+func (h *mainTrieCheckpointHandler) RemoveCheckpoint(_ []byte) error {
+	return nil
+}
+
+func (h *mainTrieCheckpointHandler) getResumedDataTries(rootHash []byte) [][]byte {
+	checkpoint, err := h.SyncCheckpointHandler.GetCheckpoint(pendingDataTriesKey(rootHash))
+	if err != nil {
+		return nil
+	}
+
+	return checkpoint.PendingHashes
+}
+
+func (h *mainTrieCheckpointHandler) removeCheckpoints(rootHash []byte) {
+	for _, key := range [][]byte{pendingDataTriesKey(rootHash), rootHash} {
+		err := h.SyncCheckpointHandler.RemoveCheckpoint(key)
+		if err != nil {
+			log.Debug("could not remove the main trie checkpoint", "root hash", rootHash, "error", err)
+		}
+	}
+}
+
+// leafProcessed should be called for each leaf received from the main trie syncer, after the data trie of the
+// leaf, if any, was marked as pending
+func (h *mainTrieCheckpointHandler) leafProcessed() {
+	atomic.AddUint64(&h.numLeavesProcessed, 1)
+}
+
+func (h *mainTrieCheckpointHandler) dataTrieDiscovered(rootHash []byte) {
+	h.mutDataTries.Lock()
+	h.pendingDataTries[string(rootHash)] = struct{}{}
+	h.mutDataTries.Unlock()
+}
+
+func (h *mainTrieCheckpointHandler) dataTrieSynced(rootHash []byte) {
+	h.mutDataTries.Lock()
+	delete(h.pendingDataTries, string(rootHash))
+	h.finishedDataTries[string(rootHash)] = time.Now()
+	h.mutDataTries.Unlock()
+}
+
+func (h *mainTrieCheckpointHandler) getPendingDataTries() [][]byte {
+	h.mutDataTries.Lock()
+	defer h.mutDataTries.Unlock()
+
+	retention := h.CheckpointInterval()
+	dataTries := make([][]byte, 0, len(h.pendingDataTries)+len(h.finishedDataTries))
+	for rootHash := range h.pendingDataTries {
+		dataTries = append(dataTries, []byte(rootHash))
+	}
+	for rootHash, finishTime := range h.finishedDataTries {
+		if time.Since(finishTime) > retention {
+			delete(h.finishedDataTries, rootHash)
+			continue
+		}
+
+		dataTries = append(dataTries, []byte(rootHash))
+	}
+
+	return dataTries
+}
+
+func pendingDataTriesKey(rootHash []byte) []byte {
+	return append([]byte(pendingDataTriesKeyPrefix), rootHash...)
+}
@@ -0,0 +1,83 @@
+package syncer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMainTrieCheckpointHandler(t *testing.T, interval time.Duration) *mainTrieCheckpointHandler {
+	checkpointer, err := trie.NewSyncCheckpointer(trie.ArgsSyncCheckpointer{
+		Storer:             testscommon.NewMemDbMock(),
+		Marshaller:         &marshallerMock.MarshalizerMock{},
+		CheckpointInterval: interval,
+	})
+	require.Nil(t, err)
+
+	return newMainTrieCheckpointHandler(checkpointer)
+}
+
+func TestMainTrieCheckpointHandler_SaveCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	checkpoint := &trie.SyncCheckpoint{
+		PendingHashes: [][]byte{[]byte("hash")},
+		Weights:       []float64{0.5},
+		NumLeavesSent: 2,
+	}
+
+	t.Run("not all the leaves were processed should not save", func(t *testing.T) {
+		t.Parallel()
+
+		handler := createMainTrieCheckpointHandler(t, time.Minute)
+		handler.leafProcessed()
+		require.Nil(t, handler.SaveCheckpoint(rootHash, checkpoint))
+
+		_, err := handler.GetCheckpoint(rootHash)
+		assert.Equal(t, trie.ErrSyncCheckpointNotFound, err)
+	})
+	t.Run("should save the checkpoint and the pending data tries", func(t *testing.T) {
+		t.Parallel()
+
+		handler := createMainTrieCheckpointHandler(t, time.Minute)
+		handler.dataTrieDiscovered([]byte("data trie 1"))
+		handler.leafProcessed()
+		handler.dataTrieDiscovered([]byte("data trie 2"))
+		handler.leafProcessed()
+		handler.dataTrieSynced([]byte("data trie 2"))
+		require.Nil(t, handler.SaveCheckpoint(rootHash, checkpoint))
+
+		saved, err := handler.GetCheckpoint(rootHash)
+		require.Nil(t, err)
+		assert.Equal(t, checkpoint.PendingHashes, saved.PendingHashes)
+		assert.ElementsMatch(t, [][]byte{[]byte("data trie 1"), []byte("data trie 2")}, handler.getResumedDataTries(rootHash))
+
+		require.Nil(t, handler.RemoveCheckpoint(rootHash))
+		_, err = handler.GetCheckpoint(rootHash)
+		assert.Nil(t, err)
+
+		handler.removeCheckpoints(rootHash)
+		_, err = handler.GetCheckpoint(rootHash)
+		assert.Equal(t, trie.ErrSyncCheckpointNotFound, err)
+		assert.Empty(t, handler.getResumedDataTries(rootHash))
+	})
+}
+
+func TestMainTrieCheckpointHandler_GetPendingDataTriesShouldDropOldSyncedDataTries(t *testing.T) {
+	t.Parallel()
+
+	handler := createMainTrieCheckpointHandler(t, time.Nanosecond)
+	handler.dataTrieDiscovered([]byte("data trie 1"))
+	handler.dataTrieDiscovered([]byte("data trie 2"))
+	handler.dataTrieSynced([]byte("data trie 2"))
+	time.Sleep(time.Millisecond)
+
+	assert.Equal(t, [][]byte{[]byte("data trie 1")}, handler.getPendingDataTries())
+	assert.Empty(t, handler.finishedDataTries)
+}
@@ -0,0 +1,33 @@
+package syncer
+
+import "time"
+
+// syncTimeEstimator estimates the time left until a trie sync completes, from the progress made since its first call
+type syncTimeEstimator struct {
+	isStarted     bool
+	startTime     time.Time
+	startProgress float64
+}
+
+func newSyncTimeEstimator() *syncTimeEstimator {
+	return &syncTimeEstimator{}
+}
+
+// estimateTimeLeft returns 0 while there is not enough information for an estimation
+func (ste *syncTimeEstimator) estimateTimeLeft(progress float64) time.Duration {
+	if !ste.isStarted {
+		ste.isStarted = true
+		ste.startTime = time.Now()
+		ste.startProgress = progress
+		return 0
+	}
+
+	progressDelta := progress - ste.startProgress
+	if progressDelta <= 0 || progress >= 1 {
+		return 0
+	}
+
+	elapsed := time.Since(ste.startTime)
+
+	return time.Duration(float64(elapsed) * (1 - progress) / progressDelta)
+}
@@ -0,0 +1,22 @@
+package syncer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncTimeEstimator_EstimateTimeLeft(t *testing.T) {
+	t.Parallel()
+
+	ste := newSyncTimeEstimator()
+	assert.Zero(t, ste.estimateTimeLeft(0.2))
+	assert.Zero(t, ste.estimateTimeLeft(0.2))
+
+	ste.startTime = time.Now().Add(-time.Minute)
+	timeLeft := ste.estimateTimeLeft(0.4)
+	assert.True(t, timeLeft > time.Minute*3-time.Second && timeLeft <= time.Minute*3+time.Second)
+
+	assert.Zero(t, ste.estimateTimeLeft(1))
+}
@@ -44,6 +44,8 @@ type userAccountsSyncer struct {
 	mutStatistics sync.RWMutex
 	largeTries    []*stats
 	numSmallTries int
+
+	mainTrieCheckpoints *mainTrieCheckpointHandler
 }
 
 // ArgsNewUserAccountsSyncer defines the arguments needed for the new account syncer
@@ -93,6 +95,7 @@ func NewUserAccountsSyncer(args ArgsNewUserAccountsSyncer) (*userAccountsSyncer,
 		userAccountsSyncStatisticsHandler: args.UserAccountsSyncStatisticsHandler,
 		appStatusHandler:                  args.AppStatusHandler,
 		enableEpochsHandler:               args.EnableEpochsHandler,
+		syncCheckpointHandler:             args.SyncCheckpointHandler,
 	}
 
 	u := &userAccountsSyncer{
@@ -100,6 +103,8 @@ func NewUserAccountsSyncer(args ArgsNewUserAccountsSyncer) (*userAccountsSyncer,
 		throttler:          args.Throttler,
 		pubkeyCoverter:     args.AddressPubKeyConverter,
 		largeTries:         make([]*stats, 0),
+		// replaced at each SyncAccounts call
+		mainTrieCheckpoints: newMainTrieCheckpointHandler(args.SyncCheckpointHandler),
 	}
 
 	return u, nil
@@ -115,6 +120,7 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker common.
 	defer u.mutex.Unlock()
 
 	u.timeoutHandler.ResetWatchdog()
+	u.mainTrieCheckpoints = newMainTrieCheckpointHandler(u.syncCheckpointHandler)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer func() {
@@ -133,7 +139,7 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker common.
 	wgSyncMainTrie.Add(1)
 
 	go func() {
-		err := u.syncMainTrie(rootHash, factory.AccountTrieNodesTopic, ctx, leavesChannels.LeavesChan)
+		err := u.syncMainTrie(rootHash, factory.AccountTrieNodesTopic, ctx, leavesChannels.LeavesChan, u.mainTrieCheckpoints)
 		if err != nil {
 			leavesChannels.ErrChan.WriteInChanNonBlocking(err)
 		}
@@ -143,7 +149,7 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker common.
 		wgSyncMainTrie.Done()
 	}()
 
-	err := u.syncAccountDataTries(leavesChannels, ctx)
+	err := u.syncAccountDataTries(u.mainTrieCheckpoints.getResumedDataTries(rootHash), leavesChannels, ctx)
 	if err != nil {
 		return err
 	}
@@ -155,6 +161,8 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker common.
 		return err
 	}
 
+	u.mainTrieCheckpoints.removeCheckpoints(rootHash)
+
 	storageMarker.MarkStorerAsSyncedAndActive(u.trieStorageManager)
 
 	log.Debug("main trie and data tries synced", "main trie root hash", rootHash, "num data tries", len(u.dataTries))
@@ -178,6 +186,7 @@ func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, address []byte, ctx c
 		return err
 	}
 
+	u.mainTrieCheckpoints.dataTrieSynced(rootHash)
 	u.updateDataTrieStatistics(trieSyncer, address)
 
 	return nil
@@ -201,6 +210,7 @@ func (u *userAccountsSyncer) createAndStartSyncer(
 		MaxHardCapForMissingNodes: u.maxHardCapForMissingNodes,
 		CheckNodesOnDisk:          checkNodesOnDisk,
 		LeavesChan:                nil, // not used for data tries
+		SyncCheckpointHandler:     u.syncCheckpointHandler,
 	}
 	trieSyncer, err := trie.CreateTrieSyncer(arg, u.trieSyncerVersion)
 	if err != nil {
@@ -236,7 +246,10 @@ func (u *userAccountsSyncer) updateDataTrieStatistics(trieSyncer trie.TrieSyncer
 	u.largeTries = append(u.largeTries, trieStats)
 }
 
+// syncAccountDataTries syncs the data tries pending from a previous, interrupted sync and the data tries of the
+// accounts received on the leaves channel
 func (u *userAccountsSyncer) syncAccountDataTries(
+	resumedDataTries [][]byte,
 	leavesChannels *common.TrieIteratorChannels,
 	ctx context.Context,
 ) error {
@@ -246,42 +259,33 @@ func (u *userAccountsSyncer) syncAccountDataTries(
 
 	defer u.printDataTrieStatistics()
 
-	wg := sync.WaitGroup{}
-	for leaf := range leavesChannels.LeavesChan {
-		u.resetTimeoutHandlerWatchdog()
+	wg := &sync.WaitGroup{}
+	for _, rootHash := range resumedDataTries {
+		u.mainTrieCheckpoints.dataTrieDiscovered(rootHash)
 
-		accountData := &accounts.UserAccountData{}
-		err := u.marshalizer.Unmarshal(accountData, leaf.Value())
+		// the address is not saved in the checkpoint, the root hash is used instead for statistics
+		err := u.startDataTrieSync(rootHash, rootHash, leavesChannels, wg, ctx)
 		if err != nil {
-			log.Trace("this must be a leaf with code", "leaf key", leaf.Key(), "err", err)
-			continue
+			return err
 		}
+	}
 
-		if common.IsEmptyTrie(accountData.RootHash) {
+	for leaf := range leavesChannels.LeavesChan {
+		u.resetTimeoutHandlerWatchdog()
+
+		accountData, hasDataTrie := u.getAccountDataWithDataTrie(leaf)
+		if hasDataTrie {
+			u.mainTrieCheckpoints.dataTrieDiscovered(accountData.RootHash)
+		}
+		u.mainTrieCheckpoints.leafProcessed()
+		if !hasDataTrie {
 			continue
 		}
 
-		err = u.checkGoRoutinesThrottler(ctx)
+		err := u.startDataTrieSync(accountData.RootHash, accountData.Address, leavesChannels, wg, ctx)
 		if err != nil {
 			return err
 		}
-
-		u.throttler.StartProcessing()
-		wg.Add(1)
-		atomic.AddInt32(&u.numMaxTries, 1)
-
-		go func(trieRootHash []byte, address []byte) {
-			defer u.throttler.EndProcessing()
-
-			log.Trace("sync data trie", "roothash", trieRootHash)
-			err := u.syncDataTrie(trieRootHash, address, ctx)
-			if err != nil {
-				leavesChannels.ErrChan.WriteInChanNonBlocking(err)
-			}
-			atomic.AddInt32(&u.numTriesSynced, 1)
-			log.Trace("finished sync data trie", "roothash", trieRootHash)
-			wg.Done()
-		}(accountData.RootHash, accountData.Address)
 	}
 
 	wg.Wait()
@@ -289,6 +293,49 @@ func (u *userAccountsSyncer) syncAccountDataTries(
 	return nil
 }
 
+func (u *userAccountsSyncer) getAccountDataWithDataTrie(leaf core.KeyValueHolder) (*accounts.UserAccountData, bool) {
+	accountData := &accounts.UserAccountData{}
+	err := u.marshalizer.Unmarshal(accountData, leaf.Value())
+	if err != nil {
+		log.Trace("this must be a leaf with code", "leaf key", leaf.Key(), "err", err)
+		return nil, false
+	}
+
+	return accountData, !common.IsEmptyTrie(accountData.RootHash)
+}
+
+func (u *userAccountsSyncer) startDataTrieSync(
+	rootHash []byte,
+	address []byte,
+	leavesChannels *common.TrieIteratorChannels,
+	wg *sync.WaitGroup,
+	ctx context.Context,
+) error {
+	err := u.checkGoRoutinesThrottler(ctx)
+	if err != nil {
+		return err
+	}
+
+	u.throttler.StartProcessing()
+	wg.Add(1)
+	atomic.AddInt32(&u.numMaxTries, 1)
+
+	go func(trieRootHash []byte, address []byte) {
+		defer u.throttler.EndProcessing()
+
+		log.Trace("sync data trie", "roothash", trieRootHash)
+		err := u.syncDataTrie(trieRootHash, address, ctx)
+		if err != nil {
+			leavesChannels.ErrChan.WriteInChanNonBlocking(err)
+		}
+		atomic.AddInt32(&u.numTriesSynced, 1)
+		log.Trace("finished sync data trie", "roothash", trieRootHash)
+		wg.Done()
+	}(rootHash, address)
+
+	return nil
+}
+
 func (u *userAccountsSyncer) printDataTrieStatistics() {
 	u.mutStatistics.Lock()
 	defer u.mutStatistics.Unlock()
@@ -51,6 +51,7 @@ func NewValidatorAccountsSyncer(args ArgsNewValidatorAccountsSyncer) (*validator
 		userAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 		appStatusHandler:                  args.AppStatusHandler,
 		enableEpochsHandler:               args.EnableEpochsHandler,
+		syncCheckpointHandler:             args.SyncCheckpointHandler,
 	}
 
 	u := &validatorAccountsSyncer{
@@ -85,6 +86,7 @@ func (v *validatorAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker co
 		factory.ValidatorTrieNodesTopic,
 		ctx,
 		nil, // not used for validator accounts syncer
+		v.syncCheckpointHandler,
 	)
 	if err != nil {
 		return err
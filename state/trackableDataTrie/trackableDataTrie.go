@@ -1,7 +1,9 @@
 package trackableDataTrie
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -207,6 +209,20 @@ func (tdt *trackableDataTrie) DataTrie() common.DataTrieHandler {
 	return tdt.tr
 }
 
+// GetDirtyDataKeys returns the sorted keys modified since the dirty data was last saved to the trie
+func (tdt *trackableDataTrie) GetDirtyDataKeys() [][]byte {
+	keys := make([][]byte, 0, len(tdt.dirtyData))
+	for key := range tdt.dirtyData {
+		keys = append(keys, []byte(key))
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	return keys
+}
+
 // SaveDirtyData saved the dirty data to the trie
 func (tdt *trackableDataTrie) SaveDirtyData(mainTrie common.Trie) ([]core.TrieData, error) {
 	if len(tdt.dirtyData) == 0 {
@@ -927,3 +927,16 @@ func TestTrackableDataTrie_SetAndGetDataTrie(t *testing.T) {
 	tdt.SetDataTrie(newTrie)
 	assert.Equal(t, newTrie, tdt.DataTrie())
 }
+
+func TestTrackableDataTrie_GetDirtyDataKeys(t *testing.T) {
+	t.Parallel()
+
+	tdt, _ := trackableDataTrie.NewTrackableDataTrie([]byte("identifier"), &hashingMocks.HasherMock{}, &marshallerMock.MarshalizerMock{}, &enableEpochsHandlerMock.EnableEpochsHandlerStub{})
+	assert.Empty(t, tdt.GetDirtyDataKeys())
+
+	_ = tdt.SaveKeyValue([]byte("key2"), []byte("value2"))
+	_ = tdt.SaveKeyValue([]byte("key1"), []byte("value1"))
+	_ = tdt.SaveKeyValue([]byte("key2"), []byte("value3"))
+
+	assert.Equal(t, [][]byte{[]byte("key1"), []byte("key2")}, tdt.GetDirtyDataKeys())
+}
@@ -485,12 +485,16 @@ func TestStatusMetrics_BootstrapMetrics(t *testing.T) {
 
 	sm.SetUInt64Value(common.MetricTrieSyncNumReceivedBytes, uint64(5001))
 	sm.SetUInt64Value(common.MetricTrieSyncNumProcessedNodes, uint64(10000))
+	sm.SetUInt64Value(common.MetricTrieSyncProgressPercent, uint64(42))
+	sm.SetUInt64Value(common.MetricTrieSyncEstimatedTimeLeft, uint64(360))
 	sm.SetUInt64Value(common.MetricShardId, uint64(2))
 	sm.SetStringValue(common.MetricGatewayMetricsEndpoint, "http://localhost:8080")
 
 	expectedMetrics := map[string]interface{}{
 		common.MetricTrieSyncNumReceivedBytes:  uint64(5001),
 		common.MetricTrieSyncNumProcessedNodes: uint64(10000),
+		common.MetricTrieSyncProgressPercent:   uint64(42),
+		common.MetricTrieSyncEstimatedTimeLeft: uint64(360),
 		common.MetricShardId:                   uint64(2),
 		common.MetricGatewayMetricsEndpoint:    "http://localhost:8080",
 	}
@@ -0,0 +1,227 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const rwxOwner = 0755
+
+var log = logger.GetOrCreate("storage/database")
+
+var _ storage.Persister = (*PebbleDB)(nil)
+
+// PebbleDB holds a pointer to the pebble database and the path to where it is stored.
+// Pebble keeps its own memtable and write-ahead log so, unlike the leveldb persisters, the writes are not
+// batched in memory before being committed
+type PebbleDB struct {
+	mutDb sync.RWMutex
+	path  string
+	db    *pebble.DB
+}
+
+// NewPebbleDB is a constructor for the pebble persister
+// It creates the files in the location given as parameter
+func NewPebbleDB(path string, maxOpenFiles int) (*PebbleDB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	err := os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &pebble.Options{
+		MaxOpenFiles: maxOpenFiles,
+		Logger:       &pebbleLogger{path: path},
+	}
+
+	db, err := pebble.Open(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	log.Debug("opened pebble db persister", "path", path)
+
+	return &PebbleDB{
+		path: path,
+		db:   db,
+	}, nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (p *PebbleDB) Put(key, val []byte) error {
+	p.mutDb.RLock()
+	defer p.mutDb.RUnlock()
+
+	if p.db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	return p.db.Set(key, val, pebble.NoSync)
+}
+
+// Get returns the value associated to the key
+func (p *PebbleDB) Get(key []byte) ([]byte, error) {
+	p.mutDb.RLock()
+	defer p.mutDb.RUnlock()
+
+	if p.db == nil {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	val, closer, err := p.db.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the returned slice is only valid until the closer is called
+	clonedVal := make([]byte, len(val))
+	copy(clonedVal, val)
+	_ = closer.Close()
+
+	return clonedVal, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (p *PebbleDB) Has(key []byte) error {
+	p.mutDb.RLock()
+	defer p.mutDb.RUnlock()
+
+	if p.db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	_, closer, err := p.db.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	return closer.Close()
+}
+
+// Remove removes the data associated to the given key
+func (p *PebbleDB) Remove(key []byte) error {
+	p.mutDb.RLock()
+	defer p.mutDb.RUnlock()
+
+	if p.db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	return p.db.Delete(key, pebble.NoSync)
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (p *PebbleDB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	p.mutDb.RLock()
+	defer p.mutDb.RUnlock()
+
+	if p.db == nil {
+		return
+	}
+
+	iterator, err := p.db.NewIter(nil)
+	if err != nil {
+		log.Warn("PebbleDB.RangeKeys: cannot create iterator", "path", p.path, "error", err)
+		return
+	}
+
+	for valid := iterator.First(); valid; valid = iterator.Next() {
+		key := iterator.Key()
+		clonedKey := make([]byte, len(key))
+		copy(clonedKey, key)
+
+		val := iterator.Value()
+		clonedVal := make([]byte, len(val))
+		copy(clonedVal, val)
+
+		shouldContinue := handler(clonedKey, clonedVal)
+		if !shouldContinue {
+			break
+		}
+	}
+
+	err = iterator.Close()
+	if err != nil {
+		log.Warn("PebbleDB.RangeKeys: cannot close iterator", "path", p.path, "error", err)
+	}
+}
+
+// Close closes the files/resources associated to the storage medium
+func (p *PebbleDB) Close() error {
+	p.mutDb.Lock()
+	defer p.mutDb.Unlock()
+
+	if p.db == nil {
+		return nil
+	}
+
+	db := p.db
+	p.db = nil
+
+	return db.Close()
+}
+
+// Destroy removes the storage medium stored data
+func (p *PebbleDB) Destroy() error {
+	log.Debug("PebbleDB.Destroy", "path", p.path)
+
+	err := p.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(p.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (p *PebbleDB) DestroyClosed() error {
+	err := os.RemoveAll(p.path)
+	if err != nil {
+		log.Error("error destroy closed", "error", err, "path", p.path)
+	}
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (p *PebbleDB) IsInterfaceNil() bool {
+	return p == nil
+}
+
+// pebbleLogger redirects the pebble internal messages towards the node's logger
+type pebbleLogger struct {
+	path string
+}
+
+// Infof logs the pebble informational messages (flushes, compactions and so on)
+func (pl *pebbleLogger) Infof(format string, args ...interface{}) {
+	log.Trace("pebble", "path", pl.path, "message", fmt.Sprintf(format, args...))
+}
+
+// Fatalf logs the pebble unrecoverable errors. Pebble expects this call not to return
+func (pl *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	log.Error("pebble fatal error", "path", pl.path, "message", message)
+
+	panic(message)
+}
@@ -0,0 +1,155 @@
+package database
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPebbleDB(t *testing.T) *PebbleDB {
+	db, err := NewPebbleDB(t.TempDir(), 10)
+	require.Nil(t, err)
+
+	return db
+}
+
+func TestPebbleDB_PutGetHasRemove(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDB(t)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	key, val := []byte("key"), []byte("value")
+
+	_, err := db.Get(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+
+	err = db.Put(key, val)
+	require.Nil(t, err)
+
+	recovered, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	assert.Nil(t, db.Has(key))
+
+	err = db.Remove(key)
+	require.Nil(t, err)
+
+	_, err = db.Get(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+}
+
+func TestPebbleDB_RangeKeys(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDB(t)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	numKeys := 10
+	expected := make(map[string][]byte)
+	for i := 0; i < numKeys; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		val := []byte(fmt.Sprintf("value%d", i))
+		expected[string(key)] = val
+		_ = db.Put(key, val)
+	}
+
+	t.Run("nil handler should not panic", func(t *testing.T) {
+		db.RangeKeys(nil)
+	})
+	t.Run("should iterate all keys", func(t *testing.T) {
+		recovered := make(map[string][]byte)
+		db.RangeKeys(func(key []byte, value []byte) bool {
+			recovered[string(key)] = value
+			return true
+		})
+
+		assert.Equal(t, expected, recovered)
+	})
+	t.Run("should stop when the handler returns false", func(t *testing.T) {
+		numCalls := 0
+		db.RangeKeys(func(key []byte, value []byte) bool {
+			numCalls++
+			return numCalls < 3
+		})
+
+		assert.Equal(t, 3, numCalls)
+	})
+}
+
+func TestPebbleDB_ReopenShouldKeepData(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	db, err := NewPebbleDB(dir, 10)
+	require.Nil(t, err)
+
+	_ = db.Put([]byte("key"), []byte("value"))
+	err = db.Close()
+	require.Nil(t, err)
+
+	db, err = NewPebbleDB(dir, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	recovered, err := db.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), recovered)
+}
+
+func TestPebbleDB_ClosedShouldError(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDB(t)
+	require.Nil(t, db.Close())
+	require.Nil(t, db.Close())
+
+	assert.Equal(t, storage.ErrDBIsClosed, db.Put([]byte("key"), []byte("value")))
+	assert.Equal(t, storage.ErrDBIsClosed, db.Has([]byte("key")))
+	assert.Equal(t, storage.ErrDBIsClosed, db.Remove([]byte("key")))
+	_, err := db.Get([]byte("key"))
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		assert.Fail(t, "should not have been called")
+		return true
+	})
+}
+
+func TestPebbleDB_Destroy(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	db, err := NewPebbleDB(dir, 10)
+	require.Nil(t, err)
+
+	err = db.Destroy()
+	assert.Nil(t, err)
+
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestPebbleDB_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var db *PebbleDB
+	assert.True(t, check.IfNil(db))
+
+	db = createPebbleDB(t)
+	assert.False(t, check.IfNil(db))
+	_ = db.Close()
+}
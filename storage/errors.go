@@ -82,6 +82,9 @@ var ErrNotSupportedCacheType = storageErrors.ErrNotSupportedCacheType
 // ErrDBIsClosed is raised when the DB is closed
 var ErrDBIsClosed = storageErrors.ErrDBIsClosed
 
+// ErrInvalidNumOpenFiles is raised when the max num of open files is less than 1
+var ErrInvalidNumOpenFiles = storageErrors.ErrInvalidNumOpenFiles
+
 // ErrEpochKeepIsLowerThanNumActive signals that num epochs to keep is lower than num active epochs
 var ErrEpochKeepIsLowerThanNumActive = errors.New("num epochs to keep is lower than num active epochs")
 
@@ -125,3 +128,6 @@ func IsNotFoundInStorageErr(err error) bool {
 
 	return strings.Contains(err.Error(), "not found")
 }
+
+// ErrCanNotClearCurrentEpoch signals that the persister of the current epoch can not be cleared
+var ErrCanNotClearCurrentEpoch = errors.New("can not clear the current epoch")
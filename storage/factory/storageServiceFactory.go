@@ -596,7 +596,79 @@ func (psf *StorageServiceFactory) setUpDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
-	return psf.setUpEsdtSuppliesStorer(chainStorer, shardID)
+	err = psf.setUpEsdtSuppliesStorer(chainStorer, shardID)
+	if err != nil {
+		return err
+	}
+
+	err = psf.setUpEventsIndexStorers(chainStorer, shardID)
+	if err != nil {
+		return err
+	}
+
+	return psf.setUpAccountsDiffsStorer(chainStorer, shardID)
+}
+
+func (psf *StorageServiceFactory) setUpAccountsDiffsStorer(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
+	accountsDiffsConfig := psf.generalConfig.DbLookupExtensions.AccountsDiffs
+	if !accountsDiffsConfig.Enabled {
+		return nil
+	}
+
+	// Create the accounts diffs (STATIC) storer
+	accountsDiffsUnit, err := psf.createStaticStorageUnit(accountsDiffsConfig.StorageConfig, shardIDStr)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.AccountsDiffs.StorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.AccountsDiffsUnit, accountsDiffsUnit)
+
+	return nil
+}
+
+func (psf *StorageServiceFactory) setUpEventsIndexStorers(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
+	eventsIndexConfig := psf.generalConfig.DbLookupExtensions.EventsIndex
+	if !eventsIndexConfig.Enabled {
+		return nil
+	}
+
+	// Create the events index (STATIC) storers
+	eventsByAddressUnit, err := psf.createStaticStorageUnit(eventsIndexConfig.ByAddressStorageConfig, shardIDStr)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.EventsIndex.ByAddressStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.EventsByAddressUnit, eventsByAddressUnit)
+
+	eventsByIdentifierUnit, err := psf.createStaticStorageUnit(eventsIndexConfig.ByIdentifierStorageConfig, shardIDStr)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.EventsIndex.ByIdentifierStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.EventsByIdentifierUnit, eventsByIdentifierUnit)
+
+	eventsByTopicUnit, err := psf.createStaticStorageUnit(eventsIndexConfig.ByTopicStorageConfig, shardIDStr)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.EventsIndex.ByTopicStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.EventsByTopicUnit, eventsByTopicUnit)
+
+	return nil
+}
+
+func (psf *StorageServiceFactory) createStaticStorageUnit(storageConfig config.StorageConfig, shardIDStr string) (storage.Storer, error) {
+	dbConfig := GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = psf.pathManager.PathForStatic(shardIDStr, storageConfig.DB.FilePath)
+	cacherConfig := GetCacherFromConfig(storageConfig.Cache)
+
+	dbConfigHandlerInstance := NewDBConfigHandler(storageConfig.DB)
+	persisterCreator, err := NewPersisterFactory(dbConfigHandlerInstance)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageunit.NewStorageUnitFromConf(cacherConfig, dbConfig, persisterCreator)
 }
 
 func (psf *StorageServiceFactory) setUpEsdtSuppliesStorer(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
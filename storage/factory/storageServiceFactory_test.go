@@ -379,6 +379,36 @@ func TestStorageServiceFactory_CreateForShard(t *testing.T) {
 		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.ESDTSuppliesStorageConfig", err.Error())
 		assert.True(t, check.IfNil(storageService))
 	})
+	t.Run("wrong config for DbLookupExtensions.EventsIndex.ByTopicStorageConfig should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.EventsIndex = config.EventsIndexConfig{
+			Enabled:                   true,
+			ByAddressStorageConfig:    createMockStorageConfig("EventsByAddressStorage"),
+			ByIdentifierStorageConfig: createMockStorageConfig("EventsByIdentifierStorage"),
+			ByTopicStorageConfig:      createMockStorageConfig("EventsByTopicStorage"),
+		}
+		args.Config.DbLookupExtensions.EventsIndex.ByTopicStorageConfig.Cache.Type = ""
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.EventsIndex.ByTopicStorageConfig", err.Error())
+		assert.True(t, check.IfNil(storageService))
+	})
+	t.Run("wrong config for DbLookupExtensions.AccountsDiffs.StorageConfig should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.DbLookupExtensions.AccountsDiffs = config.AccountsDiffsConfig{
+			Enabled:       true,
+			StorageConfig: createMockStorageConfig("AccountsDiffsStorage"),
+		}
+		args.Config.DbLookupExtensions.AccountsDiffs.StorageConfig.Cache.Type = ""
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		assert.Equal(t, expectedErrForCacheString+" for DbLookupExtensions.AccountsDiffs.StorageConfig", err.Error())
+		assert.True(t, check.IfNil(storageService))
+	})
 	t.Run("wrong config for DbLookupExtensions.RoundHashStorageConfig should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,24 @@
+package migration
+
+import "errors"
+
+// ErrEmptySourcePath signals that an empty source path has been provided
+var ErrEmptySourcePath = errors.New("empty source path")
+
+// ErrEmptyDestinationPath signals that an empty destination path has been provided
+var ErrEmptyDestinationPath = errors.New("empty destination path")
+
+// ErrSameSourceAndDestination signals that the source and the destination paths point to the same directory
+var ErrSameSourceAndDestination = errors.New("the source and the destination paths should differ")
+
+// ErrDestinationNotEmpty signals that the destination directory already contains files
+var ErrDestinationNotEmpty = errors.New("the destination directory is not empty")
+
+// ErrNoDatabaseFound signals that no leveldb database was found under the source path
+var ErrNoDatabaseFound = errors.New("no leveldb database found")
+
+// ErrVerificationFailed signals that the migrated data differs from the source data
+var ErrVerificationFailed = errors.New("migrated data verification failed")
+
+// ErrInvalidNumOpenFiles signals that an invalid number of open files has been provided
+var ErrInvalidNumOpenFiles = errors.New("invalid number of open files")
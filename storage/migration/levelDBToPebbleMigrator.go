@@ -0,0 +1,372 @@
+package migration
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	levelDBMarkerFileName = "CURRENT"
+	dbConfigFileName      = "config.toml"
+	rwxOwner              = 0755
+	progressLogInterval   = 100000
+)
+
+var log = logger.GetOrCreate("storage/migration")
+
+// ArgsLevelDBToPebbleMigrator holds the arguments needed to create a leveldb to pebble migrator
+type ArgsLevelDBToPebbleMigrator struct {
+	SourcePath      string
+	DestinationPath string
+	// DefaultDBConfig is written for the databases that do not have their own config file
+	DefaultDBConfig config.DBConfig
+	Verify          bool
+}
+
+// MigrationStats holds the statistics of a finished migration
+type MigrationStats struct {
+	NumDatabases int
+	NumKeys      uint64
+	NumBytes     uint64
+}
+
+type levelDBToPebbleMigrator struct {
+	sourcePath      string
+	destinationPath string
+	defaultDBConfig config.DBConfig
+	verify          bool
+}
+
+// NewLevelDBToPebbleMigrator creates a migrator able to convert all the leveldb databases found under a directory
+// (usually an epoch directory) into pebble databases written in a mirrored directory structure
+func NewLevelDBToPebbleMigrator(args ArgsLevelDBToPebbleMigrator) (*levelDBToPebbleMigrator, error) {
+	if len(args.SourcePath) == 0 {
+		return nil, ErrEmptySourcePath
+	}
+	if len(args.DestinationPath) == 0 {
+		return nil, ErrEmptyDestinationPath
+	}
+	if args.DefaultDBConfig.MaxOpenFiles < 1 {
+		return nil, ErrInvalidNumOpenFiles
+	}
+
+	sourcePath, err := filepath.Abs(args.SourcePath)
+	if err != nil {
+		return nil, err
+	}
+	destinationPath, err := filepath.Abs(args.DestinationPath)
+	if err != nil {
+		return nil, err
+	}
+	if sourcePath == destinationPath {
+		return nil, ErrSameSourceAndDestination
+	}
+
+	return &levelDBToPebbleMigrator{
+		sourcePath:      sourcePath,
+		destinationPath: destinationPath,
+		defaultDBConfig: args.DefaultDBConfig,
+		verify:          args.Verify,
+	}, nil
+}
+
+// Migrate converts the leveldb databases from the source path into pebble databases under the destination path.
+// The database config files are rewritten so the node will open the new databases with the pebble persister,
+// while any other file is copied as it is. The source databases are only read, so the node can be rolled back
+// to the old directory if needed
+func (m *levelDBToPebbleMigrator) Migrate() (*MigrationStats, error) {
+	err := m.checkDestination()
+	if err != nil {
+		return nil, err
+	}
+
+	dbPaths, configFiles, otherFiles, err := m.scanSource()
+	if err != nil {
+		return nil, err
+	}
+	if len(dbPaths) == 0 {
+		return nil, fmt.Errorf("%w under %s", ErrNoDatabaseFound, m.sourcePath)
+	}
+
+	for _, relativePath := range otherFiles {
+		err = m.copyFile(relativePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stats := &MigrationStats{}
+	for _, relativePath := range dbPaths {
+		err = m.migrateDatabase(relativePath, stats)
+		if err != nil {
+			return nil, fmt.Errorf("%w while migrating %s", err, relativePath)
+		}
+	}
+
+	err = m.writeConfigFiles(dbPaths, configFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (m *levelDBToPebbleMigrator) checkDestination() error {
+	entries, err := os.ReadDir(m.destinationPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("%w: %s", ErrDestinationNotEmpty, m.destinationPath)
+	}
+
+	return nil
+}
+
+// scanSource returns the relative paths of the leveldb directories, of the config files and of the files that
+// do not belong to any database
+func (m *levelDBToPebbleMigrator) scanSource() ([]string, []string, []string, error) {
+	dbPaths := make([]string, 0)
+	configFiles := make([]string, 0)
+	otherFiles := make([]string, 0)
+
+	err := filepath.WalkDir(m.sourcePath, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(m.sourcePath, path)
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if isLevelDBDirectory(path) {
+				dbPaths = append(dbPaths, relativePath)
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if entry.Name() == dbConfigFileName {
+			configFiles = append(configFiles, relativePath)
+			return nil
+		}
+
+		otherFiles = append(otherFiles, relativePath)
+		return nil
+	})
+
+	return dbPaths, configFiles, otherFiles, err
+}
+
+func isLevelDBDirectory(path string) bool {
+	info, err := os.Stat(filepath.Join(path, levelDBMarkerFileName))
+	if err != nil {
+		return false
+	}
+
+	return info.Mode().IsRegular()
+}
+
+func (m *levelDBToPebbleMigrator) copyFile(relativePath string) error {
+	destinationFile := filepath.Join(m.destinationPath, relativePath)
+	err := os.MkdirAll(filepath.Dir(destinationFile), rwxOwner)
+	if err != nil {
+		return err
+	}
+
+	source, err := os.Open(filepath.Join(m.sourcePath, relativePath))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = source.Close()
+	}()
+
+	destination, err := os.Create(destinationFile)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destination, source)
+	if err != nil {
+		_ = destination.Close()
+		return err
+	}
+
+	return destination.Close()
+}
+
+func (m *levelDBToPebbleMigrator) migrateDatabase(relativePath string, stats *MigrationStats) error {
+	log.Info("migrating database", "path", relativePath)
+
+	maxOpenFiles := m.defaultDBConfig.MaxOpenFiles
+	source, err := database.NewLevelDB(filepath.Join(m.sourcePath, relativePath), 1, 1, maxOpenFiles)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = source.Close()
+	}()
+
+	destination, err := database.NewPebbleDB(filepath.Join(m.destinationPath, relativePath), maxOpenFiles)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = destination.Close()
+	}()
+
+	numKeys, numBytes, err := copyKeys(source, destination)
+	if err != nil {
+		return err
+	}
+
+	if m.verify {
+		err = verifyKeys(source, destination)
+		if err != nil {
+			return err
+		}
+	}
+
+	stats.NumDatabases++
+	stats.NumKeys += numKeys
+	stats.NumBytes += numBytes
+
+	log.Info("migrated database", "path", relativePath, "num keys", numKeys, "size", core.ConvertBytes(numBytes))
+
+	return nil
+}
+
+func copyKeys(source storage.Persister, destination storage.Persister) (uint64, uint64, error) {
+	var errPut error
+	numKeys := uint64(0)
+	numBytes := uint64(0)
+	source.RangeKeys(func(key []byte, value []byte) bool {
+		errPut = destination.Put(key, value)
+		if errPut != nil {
+			return false
+		}
+
+		numKeys++
+		numBytes += uint64(len(key) + len(value))
+		if numKeys%progressLogInterval == 0 {
+			log.Debug("migration in progress", "num keys", numKeys)
+		}
+
+		return true
+	})
+
+	return numKeys, numBytes, errPut
+}
+
+func verifyKeys(source storage.Persister, destination storage.Persister) error {
+	var errVerify error
+	source.RangeKeys(func(key []byte, value []byte) bool {
+		migratedValue, err := destination.Get(key)
+		if err != nil {
+			errVerify = fmt.Errorf("%w, key %x: %v", ErrVerificationFailed, key, err)
+			return false
+		}
+		if !bytes.Equal(value, migratedValue) {
+			errVerify = fmt.Errorf("%w, different value for key %x", ErrVerificationFailed, key)
+			return false
+		}
+
+		return true
+	})
+
+	return errVerify
+}
+
+// writeConfigFiles writes the pebble config files for the migrated databases. Sharded persisters keep a single
+// config file in the unit directory, while their shards are stored in numbered subdirectories. The config files
+// not belonging to a migrated database are copied as they are
+func (m *levelDBToPebbleMigrator) writeConfigFiles(dbPaths []string, configFiles []string) error {
+	unitPaths := make(map[string]struct{})
+	for _, relativePath := range dbPaths {
+		unitPaths[m.getUnitPath(relativePath)] = struct{}{}
+	}
+
+	for _, configFile := range configFiles {
+		_, isUnitConfig := unitPaths[filepath.Dir(configFile)]
+		if isUnitConfig {
+			continue
+		}
+
+		err := m.copyFile(configFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	for unitPath := range unitPaths {
+		dbConfig, err := m.loadSourceConfig(unitPath)
+		if err != nil {
+			return err
+		}
+
+		dbConfig.Type = string(storageunit.PebbleDB)
+		err = core.SaveTomlFile(dbConfig, filepath.Join(m.destinationPath, unitPath, dbConfigFileName))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *levelDBToPebbleMigrator) getUnitPath(relativePath string) string {
+	_, err := strconv.ParseUint(filepath.Base(relativePath), 10, 32)
+	isShardDirectory := err == nil
+	if !isShardDirectory {
+		return relativePath
+	}
+
+	parentPath := filepath.Dir(relativePath)
+	_, err = os.Stat(filepath.Join(m.sourcePath, parentPath, dbConfigFileName))
+	if err != nil {
+		return relativePath
+	}
+
+	return parentPath
+}
+
+func (m *levelDBToPebbleMigrator) loadSourceConfig(unitPath string) (*config.DBConfig, error) {
+	configFile := filepath.Join(m.sourcePath, unitPath, dbConfigFileName)
+	_, err := os.Stat(configFile)
+	if os.IsNotExist(err) {
+		dbConfig := m.defaultDBConfig
+		return &dbConfig, nil
+	}
+
+	dbConfig := &config.DBConfig{}
+	err = core.LoadTomlFile(dbConfig, configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbConfig, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (m *levelDBToPebbleMigrator) IsInterfaceNil() bool {
+	return m == nil
+}
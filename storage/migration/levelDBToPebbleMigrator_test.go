@@ -0,0 +1,216 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs(t *testing.T) ArgsLevelDBToPebbleMigrator {
+	return ArgsLevelDBToPebbleMigrator{
+		SourcePath:      t.TempDir(),
+		DestinationPath: filepath.Join(t.TempDir(), "migrated"),
+		DefaultDBConfig: config.DBConfig{
+			Type:              string(storageunit.LvlDBSerial),
+			BatchDelaySeconds: 2,
+			MaxBatchSize:      100,
+			MaxOpenFiles:      10,
+		},
+		Verify: true,
+	}
+}
+
+func createLevelDB(t *testing.T, path string, numKeys int) map[string][]byte {
+	db, err := database.NewLevelDB(path, 1, 1, 10)
+	require.Nil(t, err)
+
+	data := make(map[string][]byte)
+	for i := 0; i < numKeys; i++ {
+		key := []byte(fmt.Sprintf("%s-key%d", filepath.Base(path), i))
+		val := []byte(fmt.Sprintf("value%d", i))
+		data[string(key)] = val
+		require.Nil(t, db.Put(key, val))
+	}
+	require.Nil(t, db.Close())
+
+	return data
+}
+
+func saveDBConfig(t *testing.T, path string, dbConfig config.DBConfig) {
+	require.Nil(t, core.SaveTomlFile(&dbConfig, filepath.Join(path, dbConfigFileName)))
+}
+
+func loadDBConfig(t *testing.T, path string) config.DBConfig {
+	dbConfig := config.DBConfig{}
+	require.Nil(t, core.LoadTomlFile(&dbConfig, filepath.Join(path, dbConfigFileName)))
+
+	return dbConfig
+}
+
+func requirePebbleDBContent(t *testing.T, path string, expected map[string][]byte) {
+	db, err := database.NewPebbleDB(path, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	recovered := make(map[string][]byte)
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		recovered[string(key)] = value
+		return true
+	})
+	require.Equal(t, expected, recovered)
+}
+
+func TestNewLevelDBToPebbleMigrator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty source path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.SourcePath = ""
+		migrator, err := NewLevelDBToPebbleMigrator(args)
+		assert.Equal(t, ErrEmptySourcePath, err)
+		assert.True(t, check.IfNil(migrator))
+	})
+	t.Run("empty destination path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.DestinationPath = ""
+		migrator, err := NewLevelDBToPebbleMigrator(args)
+		assert.Equal(t, ErrEmptyDestinationPath, err)
+		assert.True(t, check.IfNil(migrator))
+	})
+	t.Run("invalid max open files should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.DefaultDBConfig.MaxOpenFiles = 0
+		migrator, err := NewLevelDBToPebbleMigrator(args)
+		assert.Equal(t, ErrInvalidNumOpenFiles, err)
+		assert.True(t, check.IfNil(migrator))
+	})
+	t.Run("same source and destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.DestinationPath = args.SourcePath + string(filepath.Separator)
+		migrator, err := NewLevelDBToPebbleMigrator(args)
+		assert.Equal(t, ErrSameSourceAndDestination, err)
+		assert.True(t, check.IfNil(migrator))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		migrator, err := NewLevelDBToPebbleMigrator(createMockArgs(t))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(migrator))
+	})
+}
+
+func TestLevelDBToPebbleMigrator_Migrate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("destination not empty should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		createLevelDB(t, filepath.Join(args.SourcePath, "Transactions"), 1)
+		require.Nil(t, os.MkdirAll(filepath.Join(args.DestinationPath, "something"), rwxOwner))
+
+		migrator, _ := NewLevelDBToPebbleMigrator(args)
+		stats, err := migrator.Migrate()
+		assert.True(t, errors.Is(err, ErrDestinationNotEmpty))
+		assert.Nil(t, stats)
+	})
+	t.Run("no database found should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		migrator, _ := NewLevelDBToPebbleMigrator(args)
+		stats, err := migrator.Migrate()
+		assert.True(t, errors.Is(err, ErrNoDatabaseFound))
+		assert.Nil(t, stats)
+	})
+	t.Run("should migrate simple and sharded units", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		shardPath := filepath.Join(args.SourcePath, "Shard_0")
+
+		transactionsPath := filepath.Join(shardPath, "Transactions")
+		transactionsData := createLevelDB(t, transactionsPath, 10)
+		saveDBConfig(t, transactionsPath, config.DBConfig{
+			Type:              string(storageunit.LvlDBSerial),
+			BatchDelaySeconds: 3,
+			MaxBatchSize:      45000,
+			MaxOpenFiles:      15,
+		})
+
+		trieEpochPath := filepath.Join(shardPath, "AccountsTrie")
+		trieShard0Data := createLevelDB(t, filepath.Join(trieEpochPath, "0"), 5)
+		trieShard1Data := createLevelDB(t, filepath.Join(trieEpochPath, "1"), 7)
+		saveDBConfig(t, trieEpochPath, config.DBConfig{
+			Type:                string(storageunit.LvlDBSerial),
+			BatchDelaySeconds:   2,
+			MaxBatchSize:        100,
+			MaxOpenFiles:        10,
+			ShardIDProviderType: string(storageunit.BinarySplit),
+			NumShards:           2,
+		})
+
+		receiptsPath := filepath.Join(shardPath, "Receipts")
+		receiptsData := createLevelDB(t, receiptsPath, 3)
+
+		otherFile := filepath.Join("Static", "marker.txt")
+		require.Nil(t, os.MkdirAll(filepath.Join(args.SourcePath, "Static"), rwxOwner))
+		require.Nil(t, os.WriteFile(filepath.Join(args.SourcePath, otherFile), []byte("marker"), 0644))
+
+		migrator, _ := NewLevelDBToPebbleMigrator(args)
+		stats, err := migrator.Migrate()
+		require.Nil(t, err)
+		assert.Equal(t, 4, stats.NumDatabases)
+		assert.Equal(t, uint64(25), stats.NumKeys)
+
+		destinationShardPath := filepath.Join(args.DestinationPath, "Shard_0")
+		requirePebbleDBContent(t, filepath.Join(destinationShardPath, "Transactions"), transactionsData)
+		requirePebbleDBContent(t, filepath.Join(destinationShardPath, "AccountsTrie", "0"), trieShard0Data)
+		requirePebbleDBContent(t, filepath.Join(destinationShardPath, "AccountsTrie", "1"), trieShard1Data)
+		requirePebbleDBContent(t, filepath.Join(destinationShardPath, "Receipts"), receiptsData)
+
+		transactionsConfig := loadDBConfig(t, filepath.Join(destinationShardPath, "Transactions"))
+		assert.Equal(t, string(storageunit.PebbleDB), transactionsConfig.Type)
+		assert.Equal(t, 45000, transactionsConfig.MaxBatchSize)
+		assert.Equal(t, 15, transactionsConfig.MaxOpenFiles)
+
+		trieConfig := loadDBConfig(t, filepath.Join(destinationShardPath, "AccountsTrie"))
+		assert.Equal(t, string(storageunit.PebbleDB), trieConfig.Type)
+		assert.Equal(t, int32(2), trieConfig.NumShards)
+		_, err = os.Stat(filepath.Join(destinationShardPath, "AccountsTrie", "0", dbConfigFileName))
+		assert.True(t, os.IsNotExist(err))
+
+		receiptsConfig := loadDBConfig(t, filepath.Join(destinationShardPath, "Receipts"))
+		expectedReceiptsConfig := args.DefaultDBConfig
+		expectedReceiptsConfig.Type = string(storageunit.PebbleDB)
+		assert.Equal(t, expectedReceiptsConfig, receiptsConfig)
+
+		content, err := os.ReadFile(filepath.Join(args.DestinationPath, otherFile))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("marker"), content)
+
+		sourceConfig := loadDBConfig(t, transactionsPath)
+		assert.Equal(t, string(storageunit.LvlDBSerial), sourceConfig.Type)
+	})
+}
@@ -186,6 +186,162 @@ func (ps *triePruningStorer) RemoveFromAllActiveEpochs(key []byte) error {
 	return nil
 }
 
+// GetFromEpochWithoutCache searches the key only in the persister of the provided epoch, without checking the cache
+func (ps *triePruningStorer) GetFromEpochWithoutCache(key []byte, epoch uint32) ([]byte, error) {
+	ps.lock.RLock()
+	pd, exists := ps.persistersMapByEpoch[epoch]
+	ps.lock.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("get from epoch: persister for epoch %d not found", epoch)
+	}
+
+	persister, closePersister, err := ps.createAndInitPersisterIfClosedProtected(pd)
+	if err != nil {
+		return nil, err
+	}
+	defer closePersister()
+
+	return persister.Get(key)
+}
+
+// RemoveFromEpoch removes the data associated to the given key only from the persister of the provided epoch. The key
+// is not removed from the cache
+func (ps *triePruningStorer) RemoveFromEpoch(key []byte, epoch uint32) error {
+	ps.lock.RLock()
+	pd, exists := ps.persistersMapByEpoch[epoch]
+	ps.lock.RUnlock()
+	if !exists {
+		return fmt.Errorf("remove from epoch: persister for epoch %d not found", epoch)
+	}
+
+	persister, closePersister, err := ps.createAndInitPersisterIfClosedProtected(pd)
+	if err != nil {
+		return err
+	}
+	defer closePersister()
+
+	return persister.Remove(key)
+}
+
+// ClearEpoch destroys the persister of the provided epoch and replaces it with an empty one. The current epoch can not
+// be cleared
+func (ps *triePruningStorer) ClearEpoch(epoch uint32) error {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	pd, exists := ps.persistersMapByEpoch[epoch]
+	if !exists {
+		return nil
+	}
+	if len(ps.activePersisters) > 0 && ps.activePersisters[currentEpochIndex].epoch == epoch {
+		return fmt.Errorf("%w, epoch %d", storage.ErrCanNotClearCurrentEpoch, epoch)
+	}
+
+	wasClosed := pd.getIsClosed()
+	if !wasClosed {
+		err := pd.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	err := pd.getPersister().DestroyClosed()
+	if err != nil {
+		return err
+	}
+	if wasClosed {
+		return nil
+	}
+
+	persister, err := ps.persisterFactory.Create(pd.path)
+	if err != nil {
+		return err
+	}
+	pd.setPersisterAndIsClosed(persister, false)
+
+	log.Debug("cleared trie storer epoch", "identifier", ps.identifier, "epoch", epoch)
+
+	return nil
+}
+
+// RangeKeysInEpochsBefore iterates the entries of all the active persisters of the epochs older than the provided one.
+// The cache is not iterated, as it only holds copies of the persisted entries. The storer lock is not held while
+// iterating, so that the epoch change is not blocked by a long iteration. Instead, the iteration of a persister stops
+// as soon as it is closed
+func (ps *triePruningStorer) RangeKeysInEpochsBefore(epoch uint32, handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	shouldContinue := true
+	for _, pd := range ps.getActivePersistersBefore(epoch) {
+		persister, isActive := ps.getPersisterIfActive(pd)
+		if !isActive {
+			continue
+		}
+
+		persister.RangeKeys(func(key []byte, val []byte) bool {
+			if pd.getIsClosed() {
+				return false
+			}
+
+			shouldContinue = handler(key, val)
+			return shouldContinue
+		})
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+// RemoveFromEpochsBefore removes the data associated to the given key from the active persisters of the epochs older
+// than the provided one. The key is not removed from the cache nor from the newer epochs
+func (ps *triePruningStorer) RemoveFromEpochsBefore(epoch uint32, key []byte) error {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	for _, pd := range ps.activePersisters {
+		if pd.epoch >= epoch || pd.getIsClosed() {
+			continue
+		}
+
+		err := pd.persister.Remove(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getPersisterIfActive re-checks the persister, as it might have been closed by an epoch change since the active
+// persisters were listed
+func (ps *triePruningStorer) getPersisterIfActive(pd *persisterData) (storage.Persister, bool) {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	persister := pd.getPersister()
+	if persister == nil || pd.getIsClosed() {
+		return nil, false
+	}
+
+	return persister, true
+}
+
+func (ps *triePruningStorer) getActivePersistersBefore(epoch uint32) []*persisterData {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	persisters := make([]*persisterData, 0, len(ps.activePersisters))
+	for _, pd := range ps.activePersisters {
+		if pd.epoch < epoch {
+			persisters = append(persisters, pd)
+		}
+	}
+
+	return persisters
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ps *triePruningStorer) IsInterfaceNil() bool {
 	return ps == nil
@@ -1,6 +1,8 @@
 package pruning_test
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -467,6 +469,159 @@ func TestTriePruningStorer_RemoveFromAllActiveEpochs(t *testing.T) {
 	})
 }
 
+func TestTriePruningStorer_RangeKeysAndRemoveFromEpochsBefore(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	ps, _ := pruning.NewTriePruningStorer(args)
+
+	oldKey := []byte("key1")
+	oldVal := []byte("value1")
+	currentKey := []byte("key2")
+	currentVal := []byte("value2")
+	sharedKey := []byte("key3")
+	sharedVal := []byte("value3")
+
+	assert.Nil(t, ps.PutInEpochWithoutCache(oldKey, oldVal, 0))
+	assert.Nil(t, ps.PutInEpochWithoutCache(sharedKey, sharedVal, 0))
+	assert.Nil(t, ps.ChangeEpochSimple(1))
+	ps.SetEpochForPutOperation(1)
+	assert.Nil(t, ps.PutInEpochWithoutCache(currentKey, currentVal, 1))
+	assert.Nil(t, ps.PutInEpochWithoutCache(sharedKey, sharedVal, 1))
+
+	ps.RangeKeysInEpochsBefore(1, nil)
+
+	iteratedEntries := make(map[string][]byte)
+	ps.RangeKeysInEpochsBefore(1, func(key []byte, val []byte) bool {
+		iteratedEntries[string(key)] = val
+		return true
+	})
+	assert.Equal(t, map[string][]byte{string(oldKey): oldVal, string(sharedKey): sharedVal}, iteratedEntries)
+
+	numIterated := 0
+	ps.RangeKeysInEpochsBefore(2, func(key []byte, val []byte) bool {
+		numIterated++
+		return false
+	})
+	assert.Equal(t, 1, numIterated)
+
+	assert.Nil(t, ps.RemoveFromEpochsBefore(1, oldKey))
+	assert.Nil(t, ps.RemoveFromEpochsBefore(1, sharedKey))
+	assert.Nil(t, ps.RemoveFromEpochsBefore(1, currentKey))
+
+	_, err := ps.GetFromEpoch(oldKey, 0)
+	assert.NotNil(t, err)
+	_, err = ps.GetFromEpoch(sharedKey, 0)
+	assert.NotNil(t, err)
+
+	val, err := ps.GetFromEpoch(sharedKey, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, sharedVal, val)
+	val, err = ps.GetFromEpoch(currentKey, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, currentVal, val)
+}
+
+func TestTriePruningStorer_RangeKeysInEpochsBeforeShouldStopWhenThePersisterIsClosed(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	ps, _ := pruning.NewTriePruningStorer(args)
+
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, ps.PutInEpochWithoutCache([]byte(fmt.Sprintf("key%d", i)), []byte("value"), 0))
+	}
+	assert.Nil(t, ps.ChangeEpochSimple(1))
+
+	numIterated := 0
+	ps.RangeKeysInEpochsBefore(1, func(key []byte, val []byte) bool {
+		numIterated++
+		assert.Nil(t, ps.Close())
+		return true
+	})
+	assert.Equal(t, 1, numIterated)
+
+	ps.RangeKeysInEpochsBefore(1, func(key []byte, val []byte) bool {
+		assert.Fail(t, "should not iterate a closed persister")
+		return true
+	})
+}
+
+func TestTriePruningStorer_GetAndRemoveFromEpoch(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	ps, _ := pruning.NewTriePruningStorer(args)
+
+	key := []byte("key")
+	val := []byte("value")
+	assert.Nil(t, ps.PutInEpochWithoutCache(key, val, 0))
+	assert.Nil(t, ps.ChangeEpochSimple(1))
+	ps.SetEpochForPutOperation(1)
+
+	recovered, err := ps.GetFromEpochWithoutCache(key, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	_, err = ps.GetFromEpochWithoutCache(key, 1)
+	assert.NotNil(t, err)
+	_, err = ps.GetFromEpochWithoutCache(key, 7)
+	assert.NotNil(t, err)
+
+	assert.Nil(t, ps.RemoveFromEpoch(key, 0))
+	_, err = ps.GetFromEpochWithoutCache(key, 0)
+	assert.NotNil(t, err)
+	assert.NotNil(t, ps.RemoveFromEpoch(key, 7))
+}
+
+func TestTriePruningStorer_ClearEpoch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("current epoch can not be cleared", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultArgs()
+		ps, _ := pruning.NewTriePruningStorer(args)
+		assert.Nil(t, ps.ChangeEpochSimple(1))
+
+		err := ps.ClearEpoch(1)
+		assert.True(t, errors.Is(err, storage.ErrCanNotClearCurrentEpoch))
+	})
+	t.Run("unknown epoch should do nothing", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultArgs()
+		ps, _ := pruning.NewTriePruningStorer(args)
+
+		assert.Nil(t, ps.ClearEpoch(7))
+	})
+	t.Run("should replace the persister with an empty one", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultArgs()
+		ps, _ := pruning.NewTriePruningStorer(args)
+
+		key := []byte("key")
+		val := []byte("value")
+		assert.Nil(t, ps.PutInEpochWithoutCache(key, val, 0))
+		assert.Nil(t, ps.ChangeEpochSimple(1))
+		ps.SetEpochForPutOperation(1)
+		assert.Nil(t, ps.PutInEpochWithoutCache(key, val, 1))
+
+		assert.Nil(t, ps.ClearEpoch(0))
+
+		_, err := ps.GetFromEpochWithoutCache(key, 0)
+		assert.NotNil(t, err)
+		recovered, err := ps.GetFromEpochWithoutCache(key, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, val, recovered)
+
+		assert.Nil(t, ps.PutInEpochWithoutCache(key, val, 0))
+		recovered, err = ps.GetFromEpochWithoutCache(key, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, val, recovered)
+	})
+}
+
 func TestTriePruningStorer_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -17,6 +17,8 @@ const (
 	LvlDBSerial = storageUnit.LvlDBSerial
 	// MemoryDB represents an in memory storage identifier
 	MemoryDB = storageUnit.MemoryDB
+	// PebbleDB represents a pebble storage identifier
+	PebbleDB DBType = "PebbleDB"
 )
 
 // Shard id provider types that are currently supported
@@ -1,11 +1,24 @@
 package chainSimulator
 
-import "github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+)
 
 // ChainSimulatorMock -
 type ChainSimulatorMock struct {
-	GenerateBlocksCalled func(numOfBlocks int) error
-	GetNodeHandlerCalled func(shardID uint32) process.NodeHandler
+	GenerateBlocksCalled      func(numOfBlocks int) error
+	GetNodeHandlerCalled      func(shardID uint32) process.NodeHandler
+	SnapshotCalled            func() (string, error)
+	RevertCalled              func(id string) error
+	JumpToRoundCalled         func(targetRound int64) error
+	AdvanceTimeCalled         func(duration time.Duration) error
+	ForceChangeOfEpochCalled  func() error
+	ImpersonateCalled         func(address string) error
+	StopImpersonatingCalled   func(address string) error
+	ForkStateFromExportCalled func(exportFolder string, includeMetachainAccounts bool) error
+	ForkStateFromDBCalled     func(dbFolder string, rootHash []byte, includeMetachainAccounts bool) error
 }
 
 // GenerateBlocks -
@@ -25,6 +38,87 @@ func (mock *ChainSimulatorMock) GetNodeHandler(shardID uint32) process.NodeHandl
 	return nil
 }
 
+// Snapshot -
+func (mock *ChainSimulatorMock) Snapshot() (string, error) {
+	if mock.SnapshotCalled != nil {
+		return mock.SnapshotCalled()
+	}
+
+	return "", nil
+}
+
+// Revert -
+func (mock *ChainSimulatorMock) Revert(id string) error {
+	if mock.RevertCalled != nil {
+		return mock.RevertCalled(id)
+	}
+
+	return nil
+}
+
+// JumpToRound -
+func (mock *ChainSimulatorMock) JumpToRound(targetRound int64) error {
+	if mock.JumpToRoundCalled != nil {
+		return mock.JumpToRoundCalled(targetRound)
+	}
+
+	return nil
+}
+
+// AdvanceTime -
+func (mock *ChainSimulatorMock) AdvanceTime(duration time.Duration) error {
+	if mock.AdvanceTimeCalled != nil {
+		return mock.AdvanceTimeCalled(duration)
+	}
+
+	return nil
+}
+
+// ForceChangeOfEpoch -
+func (mock *ChainSimulatorMock) ForceChangeOfEpoch() error {
+	if mock.ForceChangeOfEpochCalled != nil {
+		return mock.ForceChangeOfEpochCalled()
+	}
+
+	return nil
+}
+
+// Impersonate -
+func (mock *ChainSimulatorMock) Impersonate(address string) error {
+	if mock.ImpersonateCalled != nil {
+		return mock.ImpersonateCalled(address)
+	}
+
+	return nil
+}
+
+// StopImpersonating -
+func (mock *ChainSimulatorMock) StopImpersonating(address string) error {
+	if mock.StopImpersonatingCalled != nil {
+		return mock.StopImpersonatingCalled(address)
+	}
+
+	return nil
+}
+
+// ForkStateFromExport -
+func (mock *ChainSimulatorMock) ForkStateFromExport(exportFolder string, includeMetachainAccounts bool) error {
+	if mock.ForkStateFromExportCalled != nil {
+		return mock.ForkStateFromExportCalled(exportFolder, includeMetachainAccounts)
+	}
+
+	return nil
+}
+
+// ForkStateFromDB -
+func (mock *ChainSimulatorMock) ForkStateFromDB(dbFolder string, rootHash []byte, includeMetachainAccounts bool) error {
+	if mock.ForkStateFromDBCalled != nil {
+		return mock.ForkStateFromDBCalled(dbFolder, rootHash, includeMetachainAccounts)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (mock *ChainSimulatorMock) IsInterfaceNil() bool {
 	return mock == nil
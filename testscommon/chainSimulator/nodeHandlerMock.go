@@ -24,6 +24,7 @@ type NodeHandlerMock struct {
 	SetKeyValueForAddressCalled   func(addressBytes []byte, state map[string]string) error
 	SetStateForAddressCalled      func(address []byte, state *dtos.AddressState) error
 	RemoveAccountCalled           func(address []byte) error
+	ForceChangeOfEpochCalled      func() error
 	CloseCalled                   func() error
 }
 
@@ -132,6 +133,15 @@ func (mock *NodeHandlerMock) RemoveAccount(address []byte) error {
 	return nil
 }
 
+// ForceChangeOfEpoch -
+func (mock *NodeHandlerMock) ForceChangeOfEpoch() error {
+	if mock.ForceChangeOfEpochCalled != nil {
+		return mock.ForceChangeOfEpochCalled()
+	}
+
+	return nil
+}
+
 // Close -
 func (mock *NodeHandlerMock) Close() error {
 	if mock.CloseCalled != nil {
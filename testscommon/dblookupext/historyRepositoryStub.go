@@ -5,8 +5,10 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/dblookupext/eventsIndex"
 )
 
 // HistoryRepositoryStub -
@@ -17,6 +19,7 @@ type HistoryRepositoryStub struct {
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
+	QueryEventsCalled                  func(query eventsIndex.EventsQuery) ([]*eventsIndex.IndexedEvent, *common.EventsQueryCursor, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -89,6 +92,15 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// QueryEvents -
+func (hp *HistoryRepositoryStub) QueryEvents(query eventsIndex.EventsQuery) ([]*eventsIndex.IndexedEvent, *common.EventsQueryCursor, error) {
+	if hp.QueryEventsCalled != nil {
+		return hp.QueryEventsCalled(query)
+	}
+
+	return nil, nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
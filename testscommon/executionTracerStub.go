@@ -0,0 +1,52 @@
+package testscommon
+
+import vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+
+// ExecutionTracerStub -
+type ExecutionTracerStub struct {
+	EnterContractCallCalled    func(input *vmcommon.ContractCallInput)
+	EnterContractDeployCalled  func(input *vmcommon.ContractCreateInput)
+	EnterBuiltInFunctionCalled func(input *vmcommon.ContractCallInput)
+	RecordStorageReadCalled    func(address []byte, key []byte, value []byte)
+	ExitFrameCalled            func(vmOutput *vmcommon.VMOutput, err error)
+}
+
+// EnterContractCall -
+func (stub *ExecutionTracerStub) EnterContractCall(input *vmcommon.ContractCallInput) {
+	if stub.EnterContractCallCalled != nil {
+		stub.EnterContractCallCalled(input)
+	}
+}
+
+// EnterContractDeploy -
+func (stub *ExecutionTracerStub) EnterContractDeploy(input *vmcommon.ContractCreateInput) {
+	if stub.EnterContractDeployCalled != nil {
+		stub.EnterContractDeployCalled(input)
+	}
+}
+
+// EnterBuiltInFunction -
+func (stub *ExecutionTracerStub) EnterBuiltInFunction(input *vmcommon.ContractCallInput) {
+	if stub.EnterBuiltInFunctionCalled != nil {
+		stub.EnterBuiltInFunctionCalled(input)
+	}
+}
+
+// RecordStorageRead -
+func (stub *ExecutionTracerStub) RecordStorageRead(address []byte, key []byte, value []byte) {
+	if stub.RecordStorageReadCalled != nil {
+		stub.RecordStorageReadCalled(address, key, value)
+	}
+}
+
+// ExitFrame -
+func (stub *ExecutionTracerStub) ExitFrame(vmOutput *vmcommon.VMOutput, err error) {
+	if stub.ExitFrameCalled != nil {
+		stub.ExitFrameCalled(vmOutput, err)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ExecutionTracerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -17,6 +17,7 @@ type RoundHandlerMock struct {
 	RemainingTimeCalled  func(startTime time.Time, maxTime time.Duration) time.Duration
 	BeforeGenesisCalled  func() bool
 	IncrementIndexCalled func()
+	SetIndexCalled       func(index int64)
 }
 
 // BeforeGenesis -
@@ -85,6 +86,13 @@ func (rndm *RoundHandlerMock) IncrementIndex() {
 	}
 }
 
+// SetIndex -
+func (rndm *RoundHandlerMock) SetIndex(index int64) {
+	if rndm.SetIndexCalled != nil {
+		rndm.SetIndexCalled(index)
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rndm *RoundHandlerMock) IsInterfaceNil() bool {
 	return rndm == nil
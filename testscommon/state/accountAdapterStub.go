@@ -36,6 +36,7 @@ type StateUserAccountHandlerStub struct {
 	GetUserNameCalled           func() []byte
 	IsGuardedCalled             func() bool
 	GetAllLeavesCalled          func(leavesChannels *common.TrieIteratorChannels, ctx context.Context) error
+	GetDirtyDataKeysCalled      func() [][]byte
 }
 
 // AddressBytes -
@@ -238,6 +239,14 @@ func (aas *StateUserAccountHandlerStub) IsGuarded() bool {
 	return false
 }
 
+// GetDirtyDataKeys -
+func (aas *StateUserAccountHandlerStub) GetDirtyDataKeys() [][]byte {
+	if aas.GetDirtyDataKeysCalled != nil {
+		return aas.GetDirtyDataKeysCalled()
+	}
+	return nil
+}
+
 // GetAllLeaves -
 func (aas *StateUserAccountHandlerStub) GetAllLeaves(leavesChannels *common.TrieIteratorChannels, ctx context.Context) error {
 	if aas.GetAllLeavesCalled != nil {
@@ -160,6 +160,11 @@ func (awm *AccountWrapMock) SaveKeyValue(key []byte, value []byte) error {
 	return awm.trackableDataTrie.SaveKeyValue(key, value)
 }
 
+// GetDirtyDataKeys -
+func (awm *AccountWrapMock) GetDirtyDataKeys() [][]byte {
+	return awm.trackableDataTrie.GetDirtyDataKeys()
+}
+
 // HasNewCode -
 func (awm *AccountWrapMock) HasNewCode() bool {
 	return len(awm.code) > 0
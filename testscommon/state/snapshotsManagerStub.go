@@ -11,6 +11,7 @@ type SnapshotsManagerStub struct {
 	StartSnapshotAfterRestartIfNeededCalled func(trieStorageManager common.StorageManager) error
 	IsSnapshotInProgressCalled              func() bool
 	SetSyncerCalled                         func(syncer state.AccountsDBSyncer) error
+	SetLastCommittedRootHashHandlerCalled   func(handler func() []byte) error
 }
 
 // SnapshotState -
@@ -44,6 +45,14 @@ func (s *SnapshotsManagerStub) SetSyncer(syncer state.AccountsDBSyncer) error {
 	return nil
 }
 
+// SetLastCommittedRootHashHandler -
+func (s *SnapshotsManagerStub) SetLastCommittedRootHashHandler(handler func() []byte) error {
+	if s.SetLastCommittedRootHashHandlerCalled != nil {
+		return s.SetLastCommittedRootHashHandlerCalled(handler)
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (s *SnapshotsManagerStub) IsInterfaceNil() bool {
 	return s == nil
@@ -0,0 +1,32 @@
+package state
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// TrieGarbageCollectorStub -
+type TrieGarbageCollectorStub struct {
+	CollectGarbageCalled func(rootHashesToKeep [][]byte, trieStorageManager common.StorageManager)
+	CloseCalled          func() error
+}
+
+// CollectGarbage -
+func (stub *TrieGarbageCollectorStub) CollectGarbage(rootHashesToKeep [][]byte, trieStorageManager common.StorageManager) {
+	if stub.CollectGarbageCalled != nil {
+		stub.CollectGarbageCalled(rootHashesToKeep, trieStorageManager)
+	}
+}
+
+// Close -
+func (stub *TrieGarbageCollectorStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *TrieGarbageCollectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
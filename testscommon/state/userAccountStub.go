@@ -31,6 +31,7 @@ type UserAccountStub struct {
 	SetDataTrieCalled        func(dataTrie common.Trie)
 	GetRootHashCalled        func() []byte
 	SaveKeyValueCalled       func(key []byte, value []byte) error
+	GetDirtyDataKeysCalled   func() [][]byte
 }
 
 // HasNewCode -
@@ -188,6 +189,14 @@ func (u *UserAccountStub) IsGuarded() bool {
 	return false
 }
 
+// GetDirtyDataKeys -
+func (u *UserAccountStub) GetDirtyDataKeys() [][]byte {
+	if u.GetDirtyDataKeysCalled != nil {
+		return u.GetDirtyDataKeysCalled()
+	}
+	return nil
+}
+
 // SaveDirtyData -
 func (u *UserAccountStub) SaveDirtyData(_ common.Trie) ([]core.TrieData, error) {
 	return nil, nil
@@ -7,30 +7,34 @@ import (
 
 // StorageManagerStub -
 type StorageManagerStub struct {
-	PutCalled                       func([]byte, []byte) error
-	PutInEpochCalled                func([]byte, []byte, uint32) error
-	PutInEpochWithoutCacheCalled    func([]byte, []byte, uint32) error
-	GetCalled                       func([]byte) ([]byte, error)
-	GetFromCurrentEpochCalled       func([]byte) ([]byte, error)
-	TakeSnapshotCalled              func(string, []byte, []byte, *common.TrieIteratorChannels, chan []byte, common.SnapshotStatisticsHandler, uint32)
-	GetDbThatContainsHashCalled     func([]byte) common.BaseStorer
-	IsPruningEnabledCalled          func() bool
-	IsPruningBlockedCalled          func() bool
-	EnterPruningBufferingModeCalled func()
-	ExitPruningBufferingModeCalled  func()
-	RemoveFromCurrentEpochCalled    func([]byte) error
-	RemoveCalled                    func([]byte) error
-	IsInterfaceNilCalled            func() bool
-	SetEpochForPutOperationCalled   func(uint32)
-	ShouldTakeSnapshotCalled        func() bool
-	GetLatestStorageEpochCalled     func() (uint32, error)
-	IsClosedCalled                  func() bool
-	GetBaseTrieStorageManagerCalled func() common.StorageManager
-	GetIdentifierCalled             func() string
-	CloseCalled                     func() error
-	RemoveFromAllActiveEpochsCalled func(hash []byte) error
-	IsSnapshotSupportedCalled       func() bool
-	GetStateStatsHandlerCalled      func() common.StateStatisticsHandler
+	PutCalled                         func([]byte, []byte) error
+	PutInEpochCalled                  func([]byte, []byte, uint32) error
+	PutInEpochWithoutCacheCalled      func([]byte, []byte, uint32) error
+	GetCalled                         func([]byte) ([]byte, error)
+	GetFromCurrentEpochCalled         func([]byte) ([]byte, error)
+	TakeSnapshotCalled                func(string, []byte, []byte, *common.TrieIteratorChannels, chan []byte, common.SnapshotStatisticsHandler, uint32)
+	GetDbThatContainsHashCalled       func([]byte) common.BaseStorer
+	IsPruningEnabledCalled            func() bool
+	IsPruningBlockedCalled            func() bool
+	EnterPruningBufferingModeCalled   func()
+	ExitPruningBufferingModeCalled    func()
+	RemoveFromCurrentEpochCalled      func([]byte) error
+	RemoveCalled                      func([]byte) error
+	IsInterfaceNilCalled              func() bool
+	SetEpochForPutOperationCalled     func(uint32)
+	ShouldTakeSnapshotCalled          func() bool
+	PrepareSnapshotCalled             func(epoch uint32) bool
+	FinalizeSnapshotCalled            func(isSuccessful bool)
+	PrepareSnapshotsCompactionCalled  func(epoch uint32) bool
+	FinalizeSnapshotsCompactionCalled func(isSuccessful bool) error
+	GetLatestStorageEpochCalled       func() (uint32, error)
+	IsClosedCalled                    func() bool
+	GetBaseTrieStorageManagerCalled   func() common.StorageManager
+	GetIdentifierCalled               func() string
+	CloseCalled                       func() error
+	RemoveFromAllActiveEpochsCalled   func(hash []byte) error
+	IsSnapshotSupportedCalled         func() bool
+	GetStateStatsHandlerCalled        func() common.StateStatisticsHandler
 }
 
 // Put -
@@ -156,6 +160,40 @@ func (sms *StorageManagerStub) ShouldTakeSnapshot() bool {
 	return true
 }
 
+// PrepareSnapshot -
+func (sms *StorageManagerStub) PrepareSnapshot(epoch uint32) bool {
+	if sms.PrepareSnapshotCalled != nil {
+		return sms.PrepareSnapshotCalled(epoch)
+	}
+
+	return false
+}
+
+// FinalizeSnapshot -
+func (sms *StorageManagerStub) FinalizeSnapshot(isSuccessful bool) {
+	if sms.FinalizeSnapshotCalled != nil {
+		sms.FinalizeSnapshotCalled(isSuccessful)
+	}
+}
+
+// PrepareSnapshotsCompaction -
+func (sms *StorageManagerStub) PrepareSnapshotsCompaction(epoch uint32) bool {
+	if sms.PrepareSnapshotsCompactionCalled != nil {
+		return sms.PrepareSnapshotsCompactionCalled(epoch)
+	}
+
+	return false
+}
+
+// FinalizeSnapshotsCompaction -
+func (sms *StorageManagerStub) FinalizeSnapshotsCompaction(isSuccessful bool) error {
+	if sms.FinalizeSnapshotsCompactionCalled != nil {
+		return sms.FinalizeSnapshotsCompactionCalled(isSuccessful)
+	}
+
+	return nil
+}
+
 // GetLatestStorageEpoch -
 func (sms *StorageManagerStub) GetLatestStorageEpoch() (uint32, error) {
 	if sms.GetLatestStorageEpochCalled != nil {
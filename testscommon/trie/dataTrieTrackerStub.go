@@ -18,6 +18,7 @@ type DataTrieTrackerStub struct {
 	SaveDirtyDataCalled         func(trie common.Trie) ([]core.TrieData, error)
 	SaveTrieDataCalled          func(trieData core.TrieData) error
 	MigrateDataTrieLeavesCalled func(args vmcommon.ArgsMigrateDataTrieLeaves) error
+	GetDirtyDataKeysCalled      func() [][]byte
 }
 
 // RetrieveValue -
@@ -69,6 +70,15 @@ func (dtts *DataTrieTrackerStub) SaveDirtyData(mainTrie common.Trie) ([]core.Tri
 	return make([]core.TrieData, 0), nil
 }
 
+// GetDirtyDataKeys -
+func (dtts *DataTrieTrackerStub) GetDirtyDataKeys() [][]byte {
+	if dtts.GetDirtyDataKeysCalled != nil {
+		return dtts.GetDirtyDataKeysCalled()
+	}
+
+	return nil
+}
+
 // MigrateDataTrieLeaves -
 func (dtts *DataTrieTrackerStub) MigrateDataTrieLeaves(args vmcommon.ArgsMigrateDataTrieLeaves) error {
 	if dtts.MigrateDataTrieLeavesCalled != nil {
@@ -18,6 +18,9 @@ type SnapshotPruningStorerStub struct {
 	RemoveFromCurrentEpochCalled               func(key []byte) error
 	CloseCalled                                func() error
 	RemoveFromAllActiveEpochsCalled            func(key []byte) error
+	GetFromEpochWithoutCacheCalled             func(key []byte, epoch uint32) ([]byte, error)
+	RemoveFromEpochCalled                      func(key []byte, epoch uint32) error
+	ClearEpochCalled                           func(epoch uint32) error
 }
 
 // GetFromOldEpochsWithoutAddingToCache -
@@ -107,3 +110,30 @@ func (spss *SnapshotPruningStorerStub) RemoveFromAllActiveEpochs(key []byte) err
 
 	return spss.Remove(key)
 }
+
+// GetFromEpochWithoutCache -
+func (spss *SnapshotPruningStorerStub) GetFromEpochWithoutCache(key []byte, epoch uint32) ([]byte, error) {
+	if spss.GetFromEpochWithoutCacheCalled != nil {
+		return spss.GetFromEpochWithoutCacheCalled(key, epoch)
+	}
+
+	return nil, nil
+}
+
+// RemoveFromEpoch -
+func (spss *SnapshotPruningStorerStub) RemoveFromEpoch(key []byte, epoch uint32) error {
+	if spss.RemoveFromEpochCalled != nil {
+		return spss.RemoveFromEpochCalled(key, epoch)
+	}
+
+	return nil
+}
+
+// ClearEpoch -
+func (spss *SnapshotPruningStorerStub) ClearEpoch(epoch uint32) error {
+	if spss.ClearEpochCalled != nil {
+		return spss.ClearEpochCalled(epoch)
+	}
+
+	return nil
+}
@@ -28,6 +28,12 @@ type TrieStub struct {
 	GetAllLeavesOnChannelCalled     func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, keyBuilder common.KeyBuilder, trieLeafParser common.TrieLeafParser) error
 	GetProofCalled                  func(key []byte) ([][]byte, []byte, error)
 	VerifyProofCalled               func(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetMultiProofCalled             func(keys [][]byte) ([][]byte, [][]byte, error)
+	VerifyMultiProofCalled          func(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error)
+	GetRangeProofCalled             func(startKey []byte, endKey []byte, maxLeaves int) (*common.TrieRangeProof, error)
+	VerifyRangeProofCalled          func(rootHash []byte, startKey []byte, endKey []byte, proof [][]byte) (bool, []core.KeyValueHolder, error)
+	GetAbsenceProofCalled           func(key []byte) ([][]byte, error)
+	VerifyAbsenceProofCalled        func(rootHash []byte, key []byte, proof [][]byte) (bool, error)
 	GetStorageManagerCalled         func() common.StorageManager
 	GetSerializedNodeCalled         func(bytes []byte) ([]byte, error)
 	GetOldRootCalled                func() []byte
@@ -63,6 +69,60 @@ func (ts *TrieStub) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bo
 	return false, nil
 }
 
+// GetMultiProof -
+func (ts *TrieStub) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	if ts.GetMultiProofCalled != nil {
+		return ts.GetMultiProofCalled(keys)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyMultiProof -
+func (ts *TrieStub) VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error) {
+	if ts.VerifyMultiProofCalled != nil {
+		return ts.VerifyMultiProofCalled(rootHash, keys, values, proof)
+	}
+
+	return false, nil
+}
+
+// GetRangeProof -
+func (ts *TrieStub) GetRangeProof(startKey []byte, endKey []byte, maxLeaves int) (*common.TrieRangeProof, error) {
+	if ts.GetRangeProofCalled != nil {
+		return ts.GetRangeProofCalled(startKey, endKey, maxLeaves)
+	}
+
+	return nil, nil
+}
+
+// VerifyRangeProof -
+func (ts *TrieStub) VerifyRangeProof(rootHash []byte, startKey []byte, endKey []byte, proof [][]byte) (bool, []core.KeyValueHolder, error) {
+	if ts.VerifyRangeProofCalled != nil {
+		return ts.VerifyRangeProofCalled(rootHash, startKey, endKey, proof)
+	}
+
+	return false, nil, nil
+}
+
+// GetAbsenceProof -
+func (ts *TrieStub) GetAbsenceProof(key []byte) ([][]byte, error) {
+	if ts.GetAbsenceProofCalled != nil {
+		return ts.GetAbsenceProofCalled(key)
+	}
+
+	return nil, nil
+}
+
+// VerifyAbsenceProof -
+func (ts *TrieStub) VerifyAbsenceProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
+	if ts.VerifyAbsenceProofCalled != nil {
+		return ts.VerifyAbsenceProofCalled(rootHash, key, proof)
+	}
+
+	return false, nil
+}
+
 // GetAllLeavesOnChannel -
 func (ts *TrieStub) GetAllLeavesOnChannel(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, keyBuilder common.KeyBuilder, trieLeafParser common.TrieLeafParser) error {
 	if ts.GetAllLeavesOnChannelCalled != nil {
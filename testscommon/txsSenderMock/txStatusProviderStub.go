@@ -0,0 +1,24 @@
+package txsSenderMock
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// TxStatusProviderStub -
+type TxStatusProviderStub struct {
+	GetTransactionStatusCalled func(txHash string) (*common.TransactionStatusApiResponse, error)
+}
+
+// GetTransactionStatus -
+func (stub *TxStatusProviderStub) GetTransactionStatus(txHash string) (*common.TransactionStatusApiResponse, error) {
+	if stub.GetTransactionStatusCalled != nil {
+		return stub.GetTransactionStatusCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *TxStatusProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
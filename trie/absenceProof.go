@@ -0,0 +1,22 @@
+package trie
+
+import (
+	"errors"
+)
+
+// isKeyMissingError returns true if the error signals that the path of a key diverges from the trie paths
+func isKeyMissingError(err error) bool {
+	return errors.Is(err, ErrNodeNotFound) || errors.Is(err, ErrChildPosOutOfRange)
+}
+
+// proofKeyStatus defines what a Merkle proof shows about a key
+type proofKeyStatus int
+
+const (
+	// keyNotProven signals that the proof is missing nodes on the path of the key
+	keyNotProven proofKeyStatus = iota
+	// keyPresentInProof signals that the proof holds the leaf of the key
+	keyPresentInProof
+	// keyAbsentFromProof signals that the path of the key diverges from the trie paths held by the proof
+	keyAbsentFromProof
+)
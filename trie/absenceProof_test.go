@@ -0,0 +1,82 @@
+package trie_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatriciaMerkleTrie_GetAndVerifyAbsenceProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty trie", func(t *testing.T) {
+		t.Parallel()
+
+		proof, err := emptyTrie().GetAbsenceProof([]byte("key"))
+		require.Nil(t, err)
+		assert.Empty(t, proof)
+
+		ok, err := emptyTrie().VerifyAbsenceProof(common.EmptyTrieHash, []byte("key"), proof)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+	})
+	t.Run("present key should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, keys := initTrieWithSortedKeys(t, 50)
+		proof, err := tr.GetAbsenceProof(keys[10])
+		assert.Nil(t, proof)
+		assert.Equal(t, trie.ErrKeyPresentInTrie, err)
+	})
+	t.Run("absent keys", func(t *testing.T) {
+		t.Parallel()
+
+		tr, keys := initTrieWithSortedKeys(t, 200)
+		rootHash, _ := tr.RootHash()
+		hasher := keccak.NewKeccak()
+
+		for i := 0; i < 100; i++ {
+			absentKey := hasher.Compute(string(rune(i)) + "absent")
+			proof, err := tr.GetAbsenceProof(absentKey)
+			require.Nil(t, err)
+			require.NotEmpty(t, proof)
+
+			ok, err := tr.VerifyAbsenceProof(rootHash, absentKey, proof)
+			require.Nil(t, err)
+			require.True(t, ok)
+
+			ok, err = tr.VerifyAbsenceProof(rootHash, absentKey, proof[:len(proof)-1])
+			require.Nil(t, err)
+			require.False(t, ok)
+		}
+
+		absentKey := hasher.Compute("absent key")
+		proof, _ := tr.GetAbsenceProof(absentKey)
+		ok, err := tr.VerifyAbsenceProof([]byte("another root hash"), absentKey, proof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+
+		inclusionProof, _, _ := tr.GetProof(keys[7])
+		ok, err = tr.VerifyAbsenceProof(rootHash, keys[7], inclusionProof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("key with a different length", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieWithSortedKeys(t, 20)
+		rootHash, _ := tr.RootHash()
+		absentKey := []byte("short")
+
+		proof, err := tr.GetAbsenceProof(absentKey)
+		require.Nil(t, err)
+
+		ok, err := tr.VerifyAbsenceProof(rootHash, absentKey, proof)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+	})
+}
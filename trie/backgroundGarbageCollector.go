@@ -0,0 +1,163 @@
+package trie
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/atomic"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+// the nodes committed in the last two epochs might still be needed by the roots of the not yet final blocks, or by the
+// roots created while the storage epoch was changing, so they are never collected while the node is running
+const numRecentEpochsNotCollected = 2
+
+// ArgsBackgroundGarbageCollector is the DTO used to create a new background garbage collector
+type ArgsBackgroundGarbageCollector struct {
+	Config                config.TrieGarbageCollectionConfig
+	Marshaller            marshal.Marshalizer
+	Hasher                hashing.Hasher
+	AccountRootHashParser AccountRootHashParser
+	Storer                OldEpochsStorer
+}
+
+type backgroundGarbageCollector struct {
+	collector            *garbageCollector
+	storer               OldEpochsStorer
+	historicalRootHashes [][]byte
+	isRunning            atomic.Flag
+	ctx                  context.Context
+	cancelFunc           context.CancelFunc
+}
+
+// NewBackgroundGarbageCollector creates a garbage collector which removes, in background, the unreachable trie nodes
+// of the old epochs of a running node
+func NewBackgroundGarbageCollector(args ArgsBackgroundGarbageCollector) (*backgroundGarbageCollector, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilSweepableStorer
+	}
+
+	historicalRootHashes := make([][]byte, 0, len(args.Config.HistoricalRootHashes))
+	for _, encodedRootHash := range args.Config.HistoricalRootHashes {
+		rootHash, err := hex.DecodeString(encodedRootHash)
+		if err != nil {
+			return nil, fmt.Errorf("%w for historical root hash %s", err, encodedRootHash)
+		}
+
+		historicalRootHashes = append(historicalRootHashes, rootHash)
+	}
+
+	collector, err := NewGarbageCollector(ArgsGarbageCollector{
+		Marshaller:            args.Marshaller,
+		Hasher:                args.Hasher,
+		AccountRootHashParser: args.AccountRootHashParser,
+		MarkSetSizeInBytes:    uint64(args.Config.MarkSetSizeInMB) * core.MegabyteSize,
+		MaxRemovalsPerSecond:  args.Config.MaxRemovalsPerSecond,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	return &backgroundGarbageCollector{
+		collector:            collector,
+		storer:               args.Storer,
+		historicalRootHashes: historicalRootHashes,
+		ctx:                  ctx,
+		cancelFunc:           cancelFunc,
+	}, nil
+}
+
+// CollectGarbage starts a garbage collection in background, keeping the nodes reachable from the provided root hashes
+// and from the configured historical ones. The provided root hashes should include the most recent final state, as
+// the nodes of the older states are removed. Only the epochs older than the last two are swept. The call is ignored
+// if a collection is already running
+func (bgc *backgroundGarbageCollector) CollectGarbage(rootHashesToKeep [][]byte, trieStorageManager common.StorageManager) {
+	if check.IfNil(trieStorageManager) {
+		log.Warn("can not collect the trie garbage", "error", ErrNilTrieStorage)
+		return
+	}
+
+	isRunning := bgc.isRunning.SetReturningPrevious()
+	if isRunning {
+		log.Debug("trie garbage collection already in progress")
+		return
+	}
+
+	go func() {
+		defer bgc.isRunning.Reset()
+
+		bgc.collectGarbage(rootHashesToKeep, trieStorageManager)
+	}()
+}
+
+func (bgc *backgroundGarbageCollector) collectGarbage(rootHashesToKeep [][]byte, trieStorageManager common.StorageManager) {
+	latestEpoch, err := bgc.storer.GetLatestStorageEpoch()
+	if err != nil {
+		log.Warn("can not collect the trie garbage", "error", err)
+		return
+	}
+	if latestEpoch < numRecentEpochsNotCollected {
+		return
+	}
+
+	sweptStorer := &epochsBeforeStorer{
+		storer: bgc.storer,
+		epoch:  latestEpoch + 1 - numRecentEpochsNotCollected,
+	}
+	allRootHashesToKeep := append(append(make([][]byte, 0), rootHashesToKeep...), bgc.historicalRootHashes...)
+
+	log.Debug("starting trie garbage collection", "num root hashes to keep", len(allRootHashesToKeep), "swept epochs before", sweptStorer.epoch)
+	report, err := bgc.collector.CollectGarbage(bgc.ctx, allRootHashesToKeep, trieStorageManager, []SweepableStorer{sweptStorer})
+	if err != nil {
+		log.Warn("trie garbage collection failed", "error", err)
+		return
+	}
+
+	log.Info("trie garbage collection finished",
+		"reachable nodes", report.NumReachableNodes,
+		"scanned nodes", report.NumScannedNodes,
+		"removed nodes", report.NumRemovedNodes,
+		"removed size", core.ConvertBytes(report.RemovedNodesSize),
+		"duration", report.Duration,
+	)
+}
+
+// Close stops the running garbage collection, if any
+func (bgc *backgroundGarbageCollector) Close() error {
+	bgc.cancelFunc()
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bgc *backgroundGarbageCollector) IsInterfaceNil() bool {
+	return bgc == nil
+}
+
+// epochsBeforeStorer sweeps only the epochs older than the provided one
+type epochsBeforeStorer struct {
+	storer OldEpochsStorer
+	epoch  uint32
+}
+
+// RangeKeys iterates the entries of the epochs older than the provided one
+func (ebs *epochsBeforeStorer) RangeKeys(handler func(key []byte, val []byte) bool) {
+	ebs.storer.RangeKeysInEpochsBefore(ebs.epoch, handler)
+}
+
+// Remove removes the key from the epochs older than the provided one
+func (ebs *epochsBeforeStorer) Remove(key []byte) error {
+	return ebs.storer.RemoveFromEpochsBefore(ebs.epoch, key)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ebs *epochsBeforeStorer) IsInterfaceNil() bool {
+	return ebs == nil
+}
@@ -0,0 +1,202 @@
+package trie_test
+
+import (
+	"encoding/hex"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// epochsStorerStub keeps the entries of each epoch in a separate persister
+type epochsStorerStub struct {
+	mut         sync.Mutex
+	persisters  map[uint32]*testscommon.MemDbMock
+	latestEpoch uint32
+	sweptEpochs []uint32
+}
+
+func newEpochsStorerStub(latestEpoch uint32) *epochsStorerStub {
+	persisters := make(map[uint32]*testscommon.MemDbMock)
+	for epoch := uint32(0); epoch <= latestEpoch; epoch++ {
+		persisters[epoch] = testscommon.NewMemDbMock()
+	}
+
+	return &epochsStorerStub{
+		persisters:  persisters,
+		latestEpoch: latestEpoch,
+	}
+}
+
+func (stub *epochsStorerStub) GetLatestStorageEpoch() (uint32, error) {
+	return stub.latestEpoch, nil
+}
+
+func (stub *epochsStorerStub) RangeKeysInEpochsBefore(epoch uint32, handler func(key []byte, val []byte) bool) {
+	stub.mut.Lock()
+	stub.sweptEpochs = append(stub.sweptEpochs, epoch)
+	stub.mut.Unlock()
+
+	for e := uint32(0); e < epoch; e++ {
+		stub.persisters[e].RangeKeys(handler)
+	}
+}
+
+func (stub *epochsStorerStub) RemoveFromEpochsBefore(epoch uint32, key []byte) error {
+	for e := uint32(0); e < epoch; e++ {
+		_ = stub.persisters[e].Remove(key)
+	}
+
+	return nil
+}
+
+func (stub *epochsStorerStub) getSweptEpochs() []uint32 {
+	stub.mut.Lock()
+	defer stub.mut.Unlock()
+
+	return append([]uint32{}, stub.sweptEpochs...)
+}
+
+func (stub *epochsStorerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createArgsBackgroundGarbageCollector(storer trie.OldEpochsStorer) trie.ArgsBackgroundGarbageCollector {
+	return trie.ArgsBackgroundGarbageCollector{
+		Config: config.TrieGarbageCollectionConfig{
+			Enabled:         true,
+			MarkSetSizeInMB: 1,
+		},
+		Marshaller: &marshal.GogoProtoMarshalizer{},
+		Hasher:     &testscommon.KeccakMock{},
+		Storer:     storer,
+	}
+}
+
+func TestNewBackgroundGarbageCollector(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsBackgroundGarbageCollector(nil)
+	bgc, err := trie.NewBackgroundGarbageCollector(args)
+	assert.Equal(t, trie.ErrNilSweepableStorer, err)
+	assert.Nil(t, bgc)
+
+	args = createArgsBackgroundGarbageCollector(newEpochsStorerStub(0))
+	args.Config.HistoricalRootHashes = []string{"not hex"}
+	bgc, err = trie.NewBackgroundGarbageCollector(args)
+	assert.NotNil(t, err)
+	assert.Nil(t, bgc)
+
+	args = createArgsBackgroundGarbageCollector(newEpochsStorerStub(0))
+	args.Marshaller = nil
+	bgc, err = trie.NewBackgroundGarbageCollector(args)
+	assert.Equal(t, trie.ErrNilMarshalizer, err)
+	assert.Nil(t, bgc)
+
+	bgc, err = trie.NewBackgroundGarbageCollector(createArgsBackgroundGarbageCollector(newEpochsStorerStub(0)))
+	assert.Nil(t, err)
+	assert.False(t, bgc.IsInterfaceNil())
+	assert.Nil(t, bgc.Close())
+}
+
+func TestBackgroundGarbageCollector_CollectGarbage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("recent epochs should not be swept", func(t *testing.T) {
+		t.Parallel()
+
+		storer := newEpochsStorerStub(1)
+		bgc, _ := trie.NewBackgroundGarbageCollector(createArgsBackgroundGarbageCollector(storer))
+
+		args := trie.GetDefaultTrieStorageManagerParameters()
+		storageManager, _ := trie.NewTrieStorageManager(args)
+		bgc.CollectGarbage([][]byte{[]byte("root hash")}, storageManager)
+
+		time.Sleep(100 * time.Millisecond)
+		assert.Empty(t, storer.getSweptEpochs())
+	})
+	t.Run("should remove the unreachable nodes of the old epochs", func(t *testing.T) {
+		t.Parallel()
+
+		args := trie.GetDefaultTrieStorageManagerParameters()
+		storageManager, _ := trie.NewTrieStorageManager(args)
+		tr, _ := createTrieInStorage(t, storageManager, args, 100)
+		oldHashes, _ := tr.GetAllHashes()
+
+		storer := newEpochsStorerStub(3)
+		db := args.MainStorer.(*testscommon.SnapshotPruningStorerMock)
+		db.RangeKeys(func(key []byte, val []byte) bool {
+			_ = storer.persisters[0].Put(key, val)
+			_ = storer.persisters[2].Put(key, val)
+			return true
+		})
+
+		require.Nil(t, tr.Update(args.Hasher.Compute("key0"), []byte("new value")))
+		require.Nil(t, tr.Commit())
+		newRootHash, _ := tr.RootHash()
+		newHashes, _ := tr.GetAllHashes()
+
+		bgcArgs := createArgsBackgroundGarbageCollector(storer)
+		bgcArgs.Config.HistoricalRootHashes = []string{hex.EncodeToString(newRootHash)}
+		bgc, _ := trie.NewBackgroundGarbageCollector(bgcArgs)
+		bgc.CollectGarbage([][]byte{newRootHash}, storageManager)
+
+		countEntries := func(persister *testscommon.MemDbMock) int {
+			numEntries := 0
+			persister.RangeKeys(func(_ []byte, _ []byte) bool {
+				numEntries++
+				return true
+			})
+			return numEntries
+		}
+		newHashesMap := make(map[string]struct{})
+		for _, hash := range newHashes {
+			newHashesMap[string(hash)] = struct{}{}
+		}
+		numCommonHashes := 0
+		for _, hash := range oldHashes {
+			if _, found := newHashesMap[string(hash)]; found {
+				numCommonHashes++
+			}
+		}
+		require.Eventually(t, func() bool {
+			return countEntries(storer.persisters[0]) == numCommonHashes
+		}, time.Second, 10*time.Millisecond)
+
+		assert.Equal(t, []uint32{2}, storer.getSweptEpochs())
+		assert.Equal(t, len(oldHashes), countEntries(storer.persisters[2]))
+	})
+	t.Run("missing nodes should not remove anything", func(t *testing.T) {
+		t.Parallel()
+
+		storer := newEpochsStorerStub(2)
+		require.Nil(t, storer.persisters[0].Put(make([]byte, 32), []byte("value")))
+		bgc, _ := trie.NewBackgroundGarbageCollector(createArgsBackgroundGarbageCollector(storer))
+
+		args := trie.GetDefaultTrieStorageManagerParameters()
+		storageManager, _ := trie.NewTrieStorageManager(args)
+		bgc.CollectGarbage([][]byte{[]byte("missing root hash")}, storageManager)
+
+		time.Sleep(100 * time.Millisecond)
+		assert.Empty(t, storer.getSweptEpochs())
+	})
+	t.Run("nil trie storage manager should not panic", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() {
+			r := recover()
+			if r != nil {
+				assert.Fail(t, "should not panic")
+			}
+		}()
+
+		bgc, _ := trie.NewBackgroundGarbageCollector(createArgsBackgroundGarbageCollector(newEpochsStorerStub(2)))
+		bgc.CollectGarbage([][]byte{[]byte("root hash")}, nil)
+	})
+}
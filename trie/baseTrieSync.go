@@ -11,6 +11,7 @@ type baseSyncTrie struct {
 	numLeaves     uint64
 	numBytes      uint64
 	duration      time.Duration
+	progress      float64
 }
 
 func (bst *baseSyncTrie) updateStats(bytesToAdd uint64, element node) {
@@ -31,6 +32,12 @@ func (bst *baseSyncTrie) setSyncDuration(duration time.Duration) {
 	bst.mutStatistics.Unlock()
 }
 
+func (bst *baseSyncTrie) setProgress(progress float64) {
+	bst.mutStatistics.Lock()
+	bst.progress = progress
+	bst.mutStatistics.Unlock()
+}
+
 // NumLeaves return the total number of leaves for the provided trie
 func (bst *baseSyncTrie) NumLeaves() uint64 {
 	bst.mutStatistics.RLock()
@@ -62,3 +69,11 @@ func (bst *baseSyncTrie) Duration() time.Duration {
 
 	return bst.duration
 }
+
+// Progress returns the estimated fraction of the trie already synced, between 0 and 1
+func (bst *baseSyncTrie) Progress() float64 {
+	bst.mutStatistics.RLock()
+	defer bst.mutStatistics.RUnlock()
+
+	return bst.progress
+}
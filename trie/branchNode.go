@@ -309,6 +309,10 @@ func (bn *branchNode) commitSnapshot(
 	}
 
 	for i := range bn.children {
+		if shouldSkipNodeInSnapshot(db, bn.EncodedChildren[i]) {
+			continue
+		}
+
 		err = resolveIfCollapsed(bn, byte(i), db)
 		childIsMissing, err := treatCommitSnapshotError(err, bn.EncodedChildren[i], missingNodesChan)
 		if err != nil {
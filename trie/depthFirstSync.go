@@ -32,6 +32,9 @@ type depthFirstTrieSyncer struct {
 	nodes                     *trieNodesHandler
 	requestedHashes           map[string]*request
 	leavesChan                chan core.KeyValueHolder
+	syncCheckpointHandler     SyncCheckpointHandler
+	progressTracker           *syncProgressTracker
+	checkpointSaver           *syncCheckpointSaver
 }
 
 // NewDepthFirstTrieSyncer creates a new instance of trieSyncer that uses the depth-first algorithm
@@ -60,6 +63,7 @@ func NewDepthFirstTrieSyncer(arg ArgTrieSyncer) (*depthFirstTrieSyncer, error) {
 		maxHardCapForMissingNodes: arg.MaxHardCapForMissingNodes,
 		checkNodesOnDisk:          arg.CheckNodesOnDisk,
 		leavesChan:                arg.LeavesChan,
+		syncCheckpointHandler:     arg.SyncCheckpointHandler,
 	}
 
 	return d, nil
@@ -80,10 +84,12 @@ func (d *depthFirstTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 	defer d.mutOperation.Unlock()
 
 	d.nodes = newTrieNodesHandler()
+	d.progressTracker = newSyncProgressTracker()
+	d.checkpointSaver = newSyncCheckpointSaver(d.syncCheckpointHandler, rootHash)
 
 	d.rootHash = rootHash
 
-	d.nodes.addInitialRootHash(string(rootHash))
+	d.addInitialHashes()
 	d.requestedHashes = make(map[string]*request)
 
 	timeStart := time.Now()
@@ -98,9 +104,14 @@ func (d *depthFirstTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 		}
 		if isSynced {
 			d.trieSyncStatistics.SetNumMissing(d.rootHash, 0)
+			d.checkpointSaver.remove()
+			d.setProgress(1)
 			return nil
 		}
 
+		d.setProgress(d.progressTracker.progress())
+		d.checkpointSaver.saveIfNeeded(d.createCheckpoint)
+
 		select {
 		case <-time.After(d.waitTimeBetweenChecks):
 			continue
@@ -110,6 +121,47 @@ func (d *depthFirstTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 	}
 }
 
+// addInitialHashes adds the pending hashes of the saved checkpoint, if any, or the root hash otherwise
+func (d *depthFirstTrieSyncer) addInitialHashes() {
+	checkpoint, found := d.checkpointSaver.getCheckpoint()
+	if !found {
+		d.nodes.addInitialRootHash(string(d.rootHash))
+		d.progressTracker.addPendingHash(string(d.rootHash), 1)
+		return
+	}
+
+	for i, hash := range checkpoint.PendingHashes {
+		d.nodes.addInitialRootHash(string(hash))
+		d.progressTracker.addPendingHash(string(hash), checkpoint.Weights[i])
+	}
+	d.progressTracker.setResumedProgress(checkpoint.Weights)
+	d.setProgress(d.progressTracker.progress())
+}
+
+func (d *depthFirstTrieSyncer) createCheckpoint() *SyncCheckpoint {
+	checkpoint := &SyncCheckpoint{
+		PendingHashes: make([][]byte, 0, len(d.nodes.hashesOrder)),
+		Weights:       make([]float64, 0, len(d.nodes.hashesOrder)),
+	}
+	if d.leavesChan != nil {
+		checkpoint.NumLeavesSent = d.NumLeaves()
+	}
+
+	addedHashes := make(map[string]struct{}, len(d.nodes.hashesOrder))
+	for _, hash := range d.nodes.hashesOrder {
+		_, alreadyAdded := addedHashes[hash]
+		if alreadyAdded {
+			continue
+		}
+		addedHashes[hash] = struct{}{}
+
+		checkpoint.PendingHashes = append(checkpoint.PendingHashes, []byte(hash))
+		checkpoint.Weights = append(checkpoint.Weights, d.progressTracker.weight(hash))
+	}
+
+	return checkpoint
+}
+
 func (d *depthFirstTrieSyncer) checkIsSyncedWhileProcessingMissingAndExisting() (bool, error) {
 	if d.timeoutHandler.IsTimeout() {
 		return false, ErrTrieSyncTimeout
@@ -225,6 +277,7 @@ func (d *depthFirstTrieSyncer) processFirstExistingNode() (bool, error) {
 		if err != nil {
 			return false, err
 		}
+		d.progressTracker.replaceWithChildren(hash, hashesOfNodes(children, missingChildrenHashes))
 
 		childrenNotLeaves, err := d.storeLeaves(children)
 		if err != nil {
@@ -271,6 +324,7 @@ func (d *depthFirstTrieSyncer) storeLeaves(children []node) ([]node, error) {
 		if err != nil {
 			return nil, err
 		}
+		d.progressTracker.replaceWithChildren(string(element.getHash()), nil)
 	}
 
 	return childrenNotLeaves, nil
@@ -0,0 +1,36 @@
+package trie
+
+import "time"
+
+type disabledSyncCheckpointer struct {
+}
+
+// NewDisabledSyncCheckpointer creates a sync checkpoint handler that does not save any checkpoint
+func NewDisabledSyncCheckpointer() *disabledSyncCheckpointer {
+	return &disabledSyncCheckpointer{}
+}
+
+// SaveCheckpoint does nothing
+func (dsc *disabledSyncCheckpointer) SaveCheckpoint(_ []byte, _ *SyncCheckpoint) error {
+	return nil
+}
+
+// GetCheckpoint returns ErrSyncCheckpointNotFound
+func (dsc *disabledSyncCheckpointer) GetCheckpoint(_ []byte) (*SyncCheckpoint, error) {
+	return nil, ErrSyncCheckpointNotFound
+}
+
+// RemoveCheckpoint does nothing
+func (dsc *disabledSyncCheckpointer) RemoveCheckpoint(_ []byte) error {
+	return nil
+}
+
+// CheckpointInterval returns 0, so the trie syncers will never create checkpoints
+func (dsc *disabledSyncCheckpointer) CheckpointInterval() time.Duration {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsc *disabledSyncCheckpointer) IsInterfaceNil() bool {
+	return dsc == nil
+}
@@ -44,6 +44,9 @@ type doubleListTrieSyncer struct {
 	missingHashes             map[string]struct{}
 	requestedHashes           map[string]*request
 	leavesChan                chan core.KeyValueHolder
+	syncCheckpointHandler     SyncCheckpointHandler
+	progressTracker           *syncProgressTracker
+	checkpointSaver           *syncCheckpointSaver
 }
 
 // NewDoubleListTrieSyncer creates a new instance of trieSyncer that uses 2 list for keeping the "margin" nodes.
@@ -75,6 +78,7 @@ func NewDoubleListTrieSyncer(arg ArgTrieSyncer) (*doubleListTrieSyncer, error) {
 		maxHardCapForMissingNodes: arg.MaxHardCapForMissingNodes,
 		checkNodesOnDisk:          arg.CheckNodesOnDisk,
 		leavesChan:                arg.LeavesChan,
+		syncCheckpointHandler:     arg.SyncCheckpointHandler,
 	}
 
 	return d, nil
@@ -98,10 +102,13 @@ func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 	d.missingHashes = make(map[string]struct{})
 	d.requestedHashes = make(map[string]*request)
 
+	d.progressTracker = newSyncProgressTracker()
+	d.checkpointSaver = newSyncCheckpointSaver(d.syncCheckpointHandler, rootHash)
+
 	d.rootFound = false
 	d.rootHash = rootHash
 
-	d.missingHashes[string(rootHash)] = struct{}{}
+	d.addInitialHashes()
 
 	timeStart := time.Now()
 	defer func() {
@@ -115,9 +122,14 @@ func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 		}
 		if isSynced {
 			d.trieSyncStatistics.SetNumMissing(d.rootHash, 0)
+			d.checkpointSaver.remove()
+			d.setProgress(1)
 			return nil
 		}
 
+		d.setProgress(d.progressTracker.progress())
+		d.checkpointSaver.saveIfNeeded(d.createCheckpoint)
+
 		select {
 		case <-time.After(d.waitTimeBetweenChecks):
 			continue
@@ -127,6 +139,45 @@ func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 	}
 }
 
+// addInitialHashes adds the pending hashes of the saved checkpoint, if any, or the root hash otherwise
+func (d *doubleListTrieSyncer) addInitialHashes() {
+	checkpoint, found := d.checkpointSaver.getCheckpoint()
+	if !found {
+		d.missingHashes[string(d.rootHash)] = struct{}{}
+		d.progressTracker.addPendingHash(string(d.rootHash), 1)
+		return
+	}
+
+	for i, hash := range checkpoint.PendingHashes {
+		d.missingHashes[string(hash)] = struct{}{}
+		d.progressTracker.addPendingHash(string(hash), checkpoint.Weights[i])
+	}
+	d.progressTracker.setResumedProgress(checkpoint.Weights)
+	d.setProgress(d.progressTracker.progress())
+}
+
+func (d *doubleListTrieSyncer) createCheckpoint() *SyncCheckpoint {
+	numPending := len(d.missingHashes) + len(d.existingNodes)
+	checkpoint := &SyncCheckpoint{
+		PendingHashes: make([][]byte, 0, numPending),
+		Weights:       make([]float64, 0, numPending),
+	}
+	if d.leavesChan != nil {
+		checkpoint.NumLeavesSent = d.NumLeaves()
+	}
+
+	for hash := range d.missingHashes {
+		checkpoint.PendingHashes = append(checkpoint.PendingHashes, []byte(hash))
+		checkpoint.Weights = append(checkpoint.Weights, d.progressTracker.weight(hash))
+	}
+	for hash := range d.existingNodes {
+		checkpoint.PendingHashes = append(checkpoint.PendingHashes, []byte(hash))
+		checkpoint.Weights = append(checkpoint.Weights, d.progressTracker.weight(hash))
+	}
+
+	return checkpoint
+}
+
 func (d *doubleListTrieSyncer) checkIsSyncedWhileProcessingMissingAndExisting() (bool, error) {
 	if d.timeoutHandler.IsTimeout() {
 		return false, ErrTrieSyncTimeout
@@ -219,6 +270,7 @@ func (d *doubleListTrieSyncer) processExistingNodes() error {
 		if err != nil {
 			return err
 		}
+		d.progressTracker.replaceWithChildren(hash, hashesOfNodes(children, missingChildrenHashes))
 
 		d.trieSyncStatistics.AddNumProcessed(1)
 		if numBytes > core.MaxBufferSizeToSendTrieNodes {
@@ -123,3 +123,52 @@ var ErrNilTrieLeafParser = errors.New("nil trie leaf parser")
 
 // ErrInvalidNodeVersion signals that an invalid node version has been provided
 var ErrInvalidNodeVersion = errors.New("invalid node version provided")
+
+// ErrNilRootHash signals that a nil root hash was provided
+var ErrNilRootHash = errors.New("nil root hash")
+
+// ErrInvalidIncrementalSnapshotsConfig signals that an invalid incremental snapshots configuration has been provided
+var ErrInvalidIncrementalSnapshotsConfig = errors.New("invalid incremental snapshots config")
+
+// ErrNilNodeHandler signals that a nil node handler was provided
+var ErrNilNodeHandler = errors.New("nil node handler")
+
+// ErrNilSyncCheckpointHandler signals that a nil sync checkpoint handler was provided
+var ErrNilSyncCheckpointHandler = errors.New("nil sync checkpoint handler")
+
+// ErrSyncCheckpointNotFound signals that no sync checkpoint was saved for the provided root hash
+var ErrSyncCheckpointNotFound = errors.New("sync checkpoint not found")
+
+// ErrInvalidSyncCheckpoint signals that a saved sync checkpoint could not be decoded
+var ErrInvalidSyncCheckpoint = errors.New("invalid sync checkpoint")
+
+// ErrInvalidMaxLeaves signals that an invalid maximum number of leaves was provided
+var ErrInvalidMaxLeaves = errors.New("invalid maximum number of leaves")
+
+// ErrKeysAndValuesLengthMismatch signals that the number of the provided values differs from the number of keys
+var ErrKeysAndValuesLengthMismatch = errors.New("the number of keys and values does not match")
+
+// ErrRangeProofOnAutoBalancedLeaves signals that a range proof touched leaves saved under hashed keys, which are
+// not ordered by their original keys
+var ErrRangeProofOnAutoBalancedLeaves = errors.New("range proofs are not supported for auto balanced leaves")
+
+// ErrKeyPresentInTrie signals that an absence proof was requested for a key that is present in the trie
+var ErrKeyPresentInTrie = errors.New("key is present in the trie")
+
+// ErrInvalidNumLargestDataTries signals that an invalid number of reported data tries was provided
+var ErrInvalidNumLargestDataTries = errors.New("invalid number of largest data tries")
+
+// ErrReachableNodesNotTracked signals that the reachable nodes were not tracked during the storage analysis
+var ErrReachableNodesNotTracked = errors.New("reachable nodes were not tracked")
+
+// ErrNoRootHashToKeep signals that no root hash to keep was provided to the garbage collector
+var ErrNoRootHashToKeep = errors.New("no root hash to keep")
+
+// ErrInvalidMarkSetSize signals that an invalid mark set size was provided to the garbage collector
+var ErrInvalidMarkSetSize = errors.New("invalid mark set size")
+
+// ErrNodeNotRemoved signals that a swept trie node was still found in the storer after its removal
+var ErrNodeNotRemoved = errors.New("trie node not removed")
+
+// ErrNilSweepableStorer signals that a nil sweepable storer was provided
+var ErrNilSweepableStorer = errors.New("nil sweepable storer")
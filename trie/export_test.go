@@ -111,3 +111,8 @@ func GetDefaultTrieStorageManagerParameters() NewTrieStorageManagerArgs {
 		StatsCollector: statistics.NewStateStatistics(),
 	}
 }
+
+// SetSweepBatchSize -
+func SetSweepBatchSize(gc *garbageCollector, sweepBatchSize int) {
+	gc.sweepBatchSize = sweepBatchSize
+}
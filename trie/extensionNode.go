@@ -227,6 +227,10 @@ func (en *extensionNode) commitSnapshot(
 		return fmt.Errorf("commit snapshot error %w", err)
 	}
 
+	if shouldSkipNodeInSnapshot(db, en.EncodedChild) {
+		return en.saveToStorage(db, stats, depthLevel)
+	}
+
 	err = resolveIfCollapsed(en, 0, db)
 	childIsMissing, err := treatCommitSnapshotError(err, en.EncodedChild, missingNodesChan)
 	if err != nil {
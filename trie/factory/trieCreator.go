@@ -14,14 +14,15 @@ import (
 
 // TrieCreateArgs holds arguments for calling the Create method on the TrieFactory
 type TrieCreateArgs struct {
-	MainStorer          storage.Storer
-	PruningEnabled      bool
-	SnapshotsEnabled    bool
-	MaxTrieLevelInMem   uint
-	IdleProvider        trie.IdleNodeProvider
-	Identifier          string
-	EnableEpochsHandler common.EnableEpochsHandler
-	StatsCollector      common.StateStatisticsHandler
+	MainStorer                  storage.Storer
+	PruningEnabled              bool
+	SnapshotsEnabled            bool
+	IncrementalSnapshotsEnabled bool
+	MaxTrieLevelInMem           uint
+	IdleProvider                trie.IdleNodeProvider
+	Identifier                  string
+	EnableEpochsHandler         common.EnableEpochsHandler
+	StatsCollector              common.StateStatisticsHandler
 }
 
 type trieCreator struct {
@@ -56,13 +57,14 @@ func NewTrieFactory(
 // Create creates a new trie
 func (tc *trieCreator) Create(args TrieCreateArgs) (common.StorageManager, common.Trie, error) {
 	storageManagerArgs := trie.NewTrieStorageManagerArgs{
-		MainStorer:     args.MainStorer,
-		Marshalizer:    tc.marshalizer,
-		Hasher:         tc.hasher,
-		GeneralConfig:  tc.trieStorageManagerConfig,
-		IdleProvider:   args.IdleProvider,
-		Identifier:     args.Identifier,
-		StatsCollector: args.StatsCollector,
+		MainStorer:                  args.MainStorer,
+		Marshalizer:                 tc.marshalizer,
+		Hasher:                      tc.hasher,
+		GeneralConfig:               tc.trieStorageManagerConfig,
+		IdleProvider:                args.IdleProvider,
+		Identifier:                  args.Identifier,
+		StatsCollector:              args.StatsCollector,
+		IncrementalSnapshotsEnabled: args.IncrementalSnapshotsEnabled,
 	}
 
 	options := trie.StorageManagerOptions{
@@ -115,14 +117,15 @@ func CreateTriesComponentsForShardId(
 	}
 
 	args := TrieCreateArgs{
-		MainStorer:          mainStorer,
-		PruningEnabled:      generalConfig.StateTriesConfig.AccountsStatePruningEnabled,
-		MaxTrieLevelInMem:   generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
-		SnapshotsEnabled:    generalConfig.StateTriesConfig.SnapshotsEnabled,
-		IdleProvider:        coreComponentsHolder.ProcessStatusHandler(),
-		Identifier:          dataRetriever.UserAccountsUnit.String(),
-		EnableEpochsHandler: coreComponentsHolder.EnableEpochsHandler(),
-		StatsCollector:      stateStatsHandler,
+		MainStorer:                  mainStorer,
+		PruningEnabled:              generalConfig.StateTriesConfig.AccountsStatePruningEnabled,
+		MaxTrieLevelInMem:           generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+		SnapshotsEnabled:            generalConfig.StateTriesConfig.SnapshotsEnabled,
+		IncrementalSnapshotsEnabled: generalConfig.StateTriesConfig.AccountsIncrementalSnapshotsEnabled,
+		IdleProvider:                coreComponentsHolder.ProcessStatusHandler(),
+		Identifier:                  dataRetriever.UserAccountsUnit.String(),
+		EnableEpochsHandler:         coreComponentsHolder.EnableEpochsHandler(),
+		StatsCollector:              stateStatsHandler,
 	}
 	userStorageManager, userAccountTrie, err := trFactory.Create(args)
 	if err != nil {
@@ -0,0 +1,329 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// ArgsGarbageCollector is the DTO used to create a new trie nodes garbage collector
+type ArgsGarbageCollector struct {
+	Marshaller marshal.Marshalizer
+	Hasher     hashing.Hasher
+	// AccountRootHashParser is optional. If set, the kept root hashes are considered main tries and the data tries
+	// of their accounts are kept as well
+	AccountRootHashParser AccountRootHashParser
+	// MarkSetSizeInBytes is the memory used to mark the reachable nodes. A too small mark set only keeps more garbage
+	MarkSetSizeInBytes uint64
+	// MaxRemovalsPerSecond throttles the sweep phase. If set to 0, the nodes are removed as fast as possible
+	MaxRemovalsPerSecond uint32
+	// DryRun only reports the unreachable nodes, without removing them
+	DryRun bool
+}
+
+// the maximum number of unreachable hashes held in memory before removing them
+const sweepBatchSize = 100000
+
+// GarbageCollectionReport holds the outcome of a garbage collection. NumReachableNodes is approximate, as it counts
+// the nodes added to the mark set
+type GarbageCollectionReport struct {
+	NumReachableNodes uint64
+	NumScannedNodes   uint64
+	NumRemovedNodes   uint64
+	RemovedNodesSize  uint64
+	Duration          time.Duration
+}
+
+type garbageCollector struct {
+	marshaller            marshal.Marshalizer
+	hasher                hashing.Hasher
+	accountRootHashParser AccountRootHashParser
+	markSetSizeInBytes    uint64
+	maxRemovalsPerSecond  uint32
+	sweepBatchSize        int
+	dryRun                bool
+}
+
+// NewGarbageCollector creates a mark and sweep garbage collector for the trie nodes which are no longer reachable
+// from any of the kept root hashes
+func NewGarbageCollector(args ArgsGarbageCollector) (*garbageCollector, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if args.MarkSetSizeInBytes == 0 {
+		return nil, ErrInvalidMarkSetSize
+	}
+
+	return &garbageCollector{
+		marshaller:            args.Marshaller,
+		hasher:                args.Hasher,
+		accountRootHashParser: args.AccountRootHashParser,
+		markSetSizeInBytes:    args.MarkSetSizeInBytes,
+		maxRemovalsPerSecond:  args.MaxRemovalsPerSecond,
+		sweepBatchSize:        sweepBatchSize,
+		dryRun:                args.DryRun,
+	}, nil
+}
+
+// CollectGarbage marks all the nodes reachable from the provided root hashes, loading them from db, then removes
+// from the sweepable storers all the trie nodes that were not marked. The marking is conservative: if a node of a
+// kept trie is missing or corrupted, the collection is aborted without removing anything, and the false positives
+// of the bounded mark set only keep some unreachable nodes. Only the entries which are genuine trie nodes, meaning
+// that their key is the hash of their value, are considered for removal
+func (gc *garbageCollector) CollectGarbage(
+	ctx context.Context,
+	rootHashesToKeep [][]byte,
+	db common.BaseStorer,
+	sweepableStorers []SweepableStorer,
+) (*GarbageCollectionReport, error) {
+	if len(rootHashesToKeep) == 0 {
+		return nil, ErrNoRootHashToKeep
+	}
+	if check.IfNil(db) {
+		return nil, ErrNilDatabase
+	}
+	for _, storer := range sweepableStorers {
+		if check.IfNil(storer) {
+			return nil, ErrNilSweepableStorer
+		}
+	}
+
+	startTime := time.Now()
+	report := &GarbageCollectionReport{}
+	reachableHashes, err := gc.markReachableNodes(ctx, rootHashesToKeep, db, report)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, storer := range sweepableStorers {
+		err = gc.sweep(ctx, reachableHashes, storer, report)
+		if err != nil {
+			return nil, err
+		}
+	}
+	report.Duration = time.Since(startTime)
+
+	return report, nil
+}
+
+func (gc *garbageCollector) markReachableNodes(
+	ctx context.Context,
+	rootHashes [][]byte,
+	db common.BaseStorer,
+	report *GarbageCollectionReport,
+) (*markSet, error) {
+	reachableHashes := newMarkSet(gc.markSetSizeInBytes)
+	for _, rootHash := range rootHashes {
+		if common.IsEmptyTrie(rootHash) {
+			continue
+		}
+
+		err := gc.markTrie(ctx, rootHash, db, reachableHashes, report, !check.IfNil(gc.accountRootHashParser))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return reachableHashes, nil
+}
+
+func (gc *garbageCollector) markTrie(
+	ctx context.Context,
+	rootHash []byte,
+	db common.BaseStorer,
+	reachableHashes *markSet,
+	report *GarbageCollectionReport,
+	isMainTrie bool,
+) error {
+	// the subtries shared with the already marked tries are walked again, as a hit in the mark set might be a
+	// false positive
+	stack := [][]byte{rootHash}
+	for len(stack) > 0 {
+		if common.IsContextDone(ctx) {
+			return core.ErrContextClosing
+		}
+
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		encodedNode, err := db.Get(hash)
+		if err != nil || len(encodedNode) == 0 {
+			return fmt.Errorf("%w, hash: %x", ErrNodeNotFound, hash)
+		}
+
+		decodedNode, err := decodeNode(encodedNode, gc.marshaller, gc.hasher)
+		if err != nil || !bytes.Equal(gc.hasher.Compute(string(encodedNode)), hash) {
+			return fmt.Errorf("%w, hash: %x", ErrInvalidNode, hash)
+		}
+		if reachableHashes.add(hash) {
+			report.NumReachableNodes++
+		}
+
+		switch n := decodedNode.(type) {
+		case *branchNode:
+			for _, childHash := range n.EncodedChildren {
+				if len(childHash) > 0 {
+					stack = append(stack, childHash)
+				}
+			}
+		case *extensionNode:
+			stack = append(stack, n.EncodedChild)
+		case *leafNode:
+			if !isMainTrie {
+				continue
+			}
+
+			dataTrieRootHash, errParse := gc.accountRootHashParser.ParseRootHash(n.Value)
+			if errParse != nil {
+				return fmt.Errorf("%w while parsing the account of leaf %x", errParse, hash)
+			}
+			if common.IsEmptyTrie(dataTrieRootHash) {
+				continue
+			}
+
+			err = gc.markTrie(ctx, dataTrieRootHash, db, reachableHashes, report, false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// sweep removes the unmarked trie nodes in batches. The storer is iterated until a batch is full, then the batch is
+// removed outside of the iteration and the storer is iterated again, until no unmarked node is left. The removed
+// nodes are not found again, while the kept ones are counted only in the last iteration
+func (gc *garbageCollector) sweep(
+	ctx context.Context,
+	reachableHashes *markSet,
+	storer SweepableStorer,
+	report *GarbageCollectionReport,
+) error {
+	numRemovedBefore := report.NumRemovedNodes
+	removedHashes := make(map[string]struct{})
+	for {
+		deadHashes, numScannedNodes, isBatchFull := gc.collectDeadHashes(ctx, reachableHashes, storer, report)
+		if common.IsContextDone(ctx) {
+			return core.ErrContextClosing
+		}
+		for _, hash := range deadHashes {
+			_, wasRemoved := removedHashes[string(hash)]
+			if wasRemoved {
+				// the storer did not remove the previous batch, iterating it again would never end
+				return fmt.Errorf("%w, hash: %x", ErrNodeNotRemoved, hash)
+			}
+		}
+
+		if gc.dryRun {
+			// nothing is removed, so a single iteration counts all the nodes
+			report.NumScannedNodes += numScannedNodes
+			return nil
+		}
+
+		err := gc.removeThrottled(ctx, deadHashes, storer, report)
+		if err != nil {
+			return err
+		}
+		if !isBatchFull {
+			report.NumScannedNodes += numScannedNodes + numRemovedBefore
+			return nil
+		}
+
+		numRemovedBefore += uint64(len(deadHashes))
+		removedHashes = make(map[string]struct{}, len(deadHashes))
+		for _, hash := range deadHashes {
+			removedHashes[string(hash)] = struct{}{}
+		}
+	}
+}
+
+func (gc *garbageCollector) collectDeadHashes(
+	ctx context.Context,
+	reachableHashes *markSet,
+	storer SweepableStorer,
+	report *GarbageCollectionReport,
+) ([][]byte, uint64, bool) {
+	hashSize := gc.hasher.Size()
+	deadHashes := make([][]byte, 0)
+	numScannedNodes := uint64(0)
+	isBatchFull := false
+	storer.RangeKeys(func(key []byte, val []byte) bool {
+		if len(key) != hashSize {
+			return true
+		}
+		if !bytes.Equal(gc.hasher.Compute(string(val)), key) {
+			return true
+		}
+
+		numScannedNodes++
+		if reachableHashes.has(key) {
+			return !common.IsContextDone(ctx)
+		}
+
+		report.RemovedNodesSize += uint64(len(val))
+		if gc.dryRun {
+			report.NumRemovedNodes++
+			return !common.IsContextDone(ctx)
+		}
+
+		// the iterators might reuse the key buffer
+		deadHashes = append(deadHashes, bytes.Clone(key))
+		isBatchFull = len(deadHashes) >= gc.sweepBatchSize
+
+		return !isBatchFull && !common.IsContextDone(ctx)
+	})
+
+	return deadHashes, numScannedNodes, isBatchFull
+}
+
+func (gc *garbageCollector) removeThrottled(
+	ctx context.Context,
+	deadHashes [][]byte,
+	storer SweepableStorer,
+	report *GarbageCollectionReport,
+) error {
+	batchStartTime := time.Now()
+	numRemovedInBatch := uint32(0)
+	for _, hash := range deadHashes {
+		if common.IsContextDone(ctx) {
+			return core.ErrContextClosing
+		}
+
+		err := storer.Remove(hash)
+		if err != nil {
+			return fmt.Errorf("%w while removing node %x", err, hash)
+		}
+		report.NumRemovedNodes++
+
+		numRemovedInBatch++
+		if gc.maxRemovalsPerSecond == 0 || numRemovedInBatch < gc.maxRemovalsPerSecond {
+			continue
+		}
+
+		select {
+		case <-time.After(time.Second - time.Since(batchStartTime)):
+		case <-ctx.Done():
+			return core.ErrContextClosing
+		}
+		batchStartTime = time.Now()
+		numRemovedInBatch = 0
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gc *garbageCollector) IsInterfaceNil() bool {
+	return gc == nil
+}
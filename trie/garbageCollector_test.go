@@ -0,0 +1,355 @@
+package trie_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsGarbageCollector() trie.ArgsGarbageCollector {
+	return trie.ArgsGarbageCollector{
+		Marshaller:         &marshal.GogoProtoMarshalizer{},
+		Hasher:             &testscommon.KeccakMock{},
+		MarkSetSizeInBytes: core.MegabyteSize,
+	}
+}
+
+// createTrieWithOldRoot commits a trie, then changes a leaf and commits it again, returning the db and both root hashes
+func createTrieWithOldRoot(t *testing.T) (*testscommon.SnapshotPruningStorerMock, common.Trie, []byte, []byte) {
+	args := trie.GetDefaultTrieStorageManagerParameters()
+	storageManager, _ := trie.NewTrieStorageManager(args)
+	tr, oldRootHash := createTrieInStorage(t, storageManager, args, 100)
+
+	require.Nil(t, tr.Update(args.Hasher.Compute("key0"), []byte("new value")))
+	require.Nil(t, tr.Commit())
+	newRootHash, _ := tr.RootHash()
+	require.NotEqual(t, oldRootHash, newRootHash)
+
+	return args.MainStorer.(*testscommon.SnapshotPruningStorerMock), tr, oldRootHash, newRootHash
+}
+
+func countStoredEntries(db *testscommon.SnapshotPruningStorerMock) int {
+	numEntries := 0
+	db.RangeKeys(func(_ []byte, _ []byte) bool {
+		numEntries++
+		return true
+	})
+
+	return numEntries
+}
+
+func TestNewGarbageCollector(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsGarbageCollector()
+	args.Marshaller = nil
+	gc, err := trie.NewGarbageCollector(args)
+	assert.Equal(t, trie.ErrNilMarshalizer, err)
+	assert.Nil(t, gc)
+
+	args = createArgsGarbageCollector()
+	args.Hasher = nil
+	gc, err = trie.NewGarbageCollector(args)
+	assert.Equal(t, trie.ErrNilHasher, err)
+	assert.Nil(t, gc)
+
+	args = createArgsGarbageCollector()
+	args.MarkSetSizeInBytes = 0
+	gc, err = trie.NewGarbageCollector(args)
+	assert.Equal(t, trie.ErrInvalidMarkSetSize, err)
+	assert.Nil(t, gc)
+
+	gc, err = trie.NewGarbageCollector(createArgsGarbageCollector())
+	assert.Nil(t, err)
+	assert.False(t, gc.IsInterfaceNil())
+}
+
+func TestGarbageCollector_CollectGarbage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		gc, _ := trie.NewGarbageCollector(createArgsGarbageCollector())
+		db := testscommon.NewMemDbMock()
+
+		report, err := gc.CollectGarbage(context.Background(), nil, db, []trie.SweepableStorer{db})
+		assert.Equal(t, trie.ErrNoRootHashToKeep, err)
+		assert.Nil(t, report)
+
+		report, err = gc.CollectGarbage(context.Background(), [][]byte{[]byte("root")}, nil, []trie.SweepableStorer{db})
+		assert.Equal(t, trie.ErrNilDatabase, err)
+		assert.Nil(t, report)
+
+		report, err = gc.CollectGarbage(context.Background(), [][]byte{[]byte("root")}, db, []trie.SweepableStorer{nil})
+		assert.Equal(t, trie.ErrNilSweepableStorer, err)
+		assert.Nil(t, report)
+	})
+	t.Run("should remove only the unreachable nodes", func(t *testing.T) {
+		t.Parallel()
+
+		db, tr, oldRootHash, newRootHash := createTrieWithOldRoot(t)
+		require.Nil(t, db.Put([]byte("not a node hash"), []byte("value")))
+		require.Nil(t, db.Put(make([]byte, 32), []byte("value with a key of hash size")))
+		newHashes, _ := tr.GetAllHashes()
+		numEntriesBefore := countStoredEntries(db)
+
+		gc, _ := trie.NewGarbageCollector(createArgsGarbageCollector())
+		report, err := gc.CollectGarbage(context.Background(), [][]byte{newRootHash}, db, []trie.SweepableStorer{db})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(len(newHashes)), report.NumReachableNodes)
+		assert.True(t, report.NumRemovedNodes > 0)
+		assert.True(t, report.RemovedNodesSize > 0)
+		assert.Equal(t, report.NumReachableNodes+report.NumRemovedNodes, report.NumScannedNodes)
+		assert.Equal(t, numEntriesBefore-int(report.NumRemovedNodes), countStoredEntries(db))
+
+		assert.Nil(t, trie.WalkNodes(newRootHash, db, &marshal.GogoProtoMarshalizer{}, &testscommon.KeccakMock{}, func(_ []byte, _ []byte, _ []byte) error {
+			return nil
+		}))
+		assert.NotNil(t, trie.WalkNodes(oldRootHash, db, &marshal.GogoProtoMarshalizer{}, &testscommon.KeccakMock{}, func(_ []byte, _ []byte, _ []byte) error {
+			return nil
+		}))
+		_, err = db.Get([]byte("not a node hash"))
+		assert.Nil(t, err)
+		_, err = db.Get(make([]byte, 32))
+		assert.Nil(t, err)
+	})
+	t.Run("should remove the unreachable nodes in batches", func(t *testing.T) {
+		t.Parallel()
+
+		db, _, oldRootHash, newRootHash := createTrieWithOldRoot(t)
+		gc, _ := trie.NewGarbageCollector(createArgsGarbageCollector())
+		dryRunReport, err := gc.CollectGarbage(context.Background(), [][]byte{newRootHash}, db, nil)
+		require.Nil(t, err)
+
+		numRangeKeys := 0
+		sweptStorer := &sweepableStorerStub{
+			rangeKeys: func(handler func(key []byte, val []byte) bool) {
+				numRangeKeys++
+				db.RangeKeys(handler)
+			},
+			remove: db.Remove,
+		}
+		trie.SetSweepBatchSize(gc, 1)
+		report, err := gc.CollectGarbage(context.Background(), [][]byte{newRootHash}, db, []trie.SweepableStorer{sweptStorer})
+		require.Nil(t, err)
+		assert.True(t, report.NumRemovedNodes > 1)
+		assert.Equal(t, int(report.NumRemovedNodes)+1, numRangeKeys)
+		assert.Equal(t, dryRunReport.NumReachableNodes, report.NumReachableNodes)
+		assert.Equal(t, report.NumReachableNodes+report.NumRemovedNodes, report.NumScannedNodes)
+		assert.NotNil(t, trie.WalkNodes(oldRootHash, db, &marshal.GogoProtoMarshalizer{}, &testscommon.KeccakMock{}, func(_ []byte, _ []byte, _ []byte) error {
+			return nil
+		}))
+	})
+	t.Run("nodes not removed by the storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, _, _, newRootHash := createTrieWithOldRoot(t)
+		sweptStorer := &sweepableStorerStub{
+			rangeKeys: db.RangeKeys,
+			remove: func(_ []byte) error {
+				return nil
+			},
+		}
+
+		gc, _ := trie.NewGarbageCollector(createArgsGarbageCollector())
+		trie.SetSweepBatchSize(gc, 1)
+		report, err := gc.CollectGarbage(context.Background(), [][]byte{newRootHash}, db, []trie.SweepableStorer{sweptStorer})
+		assert.True(t, errors.Is(err, trie.ErrNodeNotRemoved))
+		assert.Nil(t, report)
+	})
+	t.Run("should keep the nodes of all the provided root hashes", func(t *testing.T) {
+		t.Parallel()
+
+		db, _, oldRootHash, newRootHash := createTrieWithOldRoot(t)
+
+		gc, _ := trie.NewGarbageCollector(createArgsGarbageCollector())
+		report, err := gc.CollectGarbage(context.Background(), [][]byte{newRootHash, oldRootHash}, db, []trie.SweepableStorer{db})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), report.NumRemovedNodes)
+		assert.Equal(t, report.NumReachableNodes, report.NumScannedNodes)
+	})
+	t.Run("dry run should not remove nodes", func(t *testing.T) {
+		t.Parallel()
+
+		db, _, _, newRootHash := createTrieWithOldRoot(t)
+		numEntriesBefore := countStoredEntries(db)
+
+		args := createArgsGarbageCollector()
+		args.DryRun = true
+		gc, _ := trie.NewGarbageCollector(args)
+		report, err := gc.CollectGarbage(context.Background(), [][]byte{newRootHash}, db, []trie.SweepableStorer{db})
+		require.Nil(t, err)
+		assert.True(t, report.NumRemovedNodes > 0)
+		assert.Equal(t, numEntriesBefore, countStoredEntries(db))
+	})
+	t.Run("missing node should abort without removing nodes", func(t *testing.T) {
+		t.Parallel()
+
+		db, tr, _, newRootHash := createTrieWithOldRoot(t)
+		newHashes, _ := tr.GetAllHashes()
+		for _, hash := range newHashes {
+			if string(hash) != string(newRootHash) {
+				require.Nil(t, db.Remove(hash))
+				break
+			}
+		}
+		numEntriesBefore := countStoredEntries(db)
+
+		gc, _ := trie.NewGarbageCollector(createArgsGarbageCollector())
+		report, err := gc.CollectGarbage(context.Background(), [][]byte{newRootHash}, db, []trie.SweepableStorer{db})
+		assert.True(t, errors.Is(err, trie.ErrNodeNotFound))
+		assert.Nil(t, report)
+		assert.Equal(t, numEntriesBefore, countStoredEntries(db))
+	})
+	t.Run("should keep the data tries of the kept accounts", func(t *testing.T) {
+		t.Parallel()
+
+		args := trie.GetDefaultTrieStorageManagerParameters()
+		storageManager, _ := trie.NewTrieStorageManager(args)
+		keptDataTrie, keptDataTrieRootHash := createTrieInStorage(t, storageManager, args, 20)
+		removedDataTrie, removedDataTrieRootHash := createTrieInStorage(t, storageManager, args, 10)
+		keptDataTrieHashes, _ := keptDataTrie.GetAllHashes()
+		removedDataTrieHashes, _ := removedDataTrie.GetAllHashes()
+
+		// the data tries have common leaves, which should be kept
+		keptHashes := make(map[string]struct{})
+		for _, hash := range keptDataTrieHashes {
+			keptHashes[string(hash)] = struct{}{}
+		}
+		numRemovedDataTrieNodes := 0
+		for _, hash := range removedDataTrieHashes {
+			if _, isKept := keptHashes[string(hash)]; !isKept {
+				numRemovedDataTrieNodes++
+			}
+		}
+
+		mainTrie, _ := trie.NewTrie(storageManager, args.Marshalizer, args.Hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+		require.Nil(t, mainTrie.Update(args.Hasher.Compute("address0"), keptDataTrieRootHash))
+		require.Nil(t, mainTrie.Update(args.Hasher.Compute("address1"), []byte(noDataTrieValue)))
+		require.Nil(t, mainTrie.Commit())
+		rootHash, _ := mainTrie.RootHash()
+
+		db := args.MainStorer.(*testscommon.SnapshotPruningStorerMock)
+		gcArgs := createArgsGarbageCollector()
+		gcArgs.AccountRootHashParser = &valueAsRootHashParser{}
+		gc, _ := trie.NewGarbageCollector(gcArgs)
+		report, err := gc.CollectGarbage(context.Background(), [][]byte{rootHash}, db, []trie.SweepableStorer{db})
+		require.Nil(t, err)
+		assert.Equal(t, uint64(numRemovedDataTrieNodes), report.NumRemovedNodes)
+
+		_, err = db.Get(keptDataTrieRootHash)
+		assert.Nil(t, err)
+		_, err = db.Get(removedDataTrieRootHash)
+		assert.NotNil(t, err)
+	})
+	t.Run("account parsing error should abort", func(t *testing.T) {
+		t.Parallel()
+
+		db, _, _, newRootHash := createTrieWithOldRoot(t)
+		expectedErr := errors.New("expected error")
+
+		args := createArgsGarbageCollector()
+		args.AccountRootHashParser = &failingRootHashParser{err: expectedErr}
+		gc, _ := trie.NewGarbageCollector(args)
+		report, err := gc.CollectGarbage(context.Background(), [][]byte{newRootHash}, db, []trie.SweepableStorer{db})
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Nil(t, report)
+	})
+	t.Run("closed context should abort", func(t *testing.T) {
+		t.Parallel()
+
+		db, _, _, newRootHash := createTrieWithOldRoot(t)
+		numEntriesBefore := countStoredEntries(db)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		gc, _ := trie.NewGarbageCollector(createArgsGarbageCollector())
+		report, err := gc.CollectGarbage(ctx, [][]byte{newRootHash}, db, []trie.SweepableStorer{db})
+		assert.Equal(t, core.ErrContextClosing, err)
+		assert.Nil(t, report)
+		assert.Equal(t, numEntriesBefore, countStoredEntries(db))
+	})
+	t.Run("removal error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		db, _, _, newRootHash := createTrieWithOldRoot(t)
+		expectedErr := errors.New("expected error")
+		sweptStorer := &sweepableStorerStub{
+			rangeKeys: db.RangeKeys,
+			remove: func(_ []byte) error {
+				return expectedErr
+			},
+		}
+
+		gc, _ := trie.NewGarbageCollector(createArgsGarbageCollector())
+		report, err := gc.CollectGarbage(context.Background(), [][]byte{newRootHash}, db, []trie.SweepableStorer{sweptStorer})
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Nil(t, report)
+	})
+}
+
+type failingRootHashParser struct {
+	err error
+}
+
+func (parser *failingRootHashParser) ParseRootHash(_ []byte) ([]byte, error) {
+	return nil, parser.err
+}
+
+func (parser *failingRootHashParser) IsInterfaceNil() bool {
+	return parser == nil
+}
+
+type sweepableStorerStub struct {
+	rangeKeys func(handler func(key []byte, val []byte) bool)
+	remove    func(key []byte) error
+}
+
+func (stub *sweepableStorerStub) RangeKeys(handler func(key []byte, val []byte) bool) {
+	stub.rangeKeys(handler)
+}
+
+func (stub *sweepableStorerStub) Remove(key []byte) error {
+	return stub.remove(key)
+}
+
+func (stub *sweepableStorerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestGarbageCollector_CollectGarbageMultipleSweptStorers(t *testing.T) {
+	t.Parallel()
+
+	db, _, _, newRootHash := createTrieWithOldRoot(t)
+	epochStorers := []*testscommon.MemDbMock{testscommon.NewMemDbMock(), testscommon.NewMemDbMock()}
+	i := 0
+	db.RangeKeys(func(key []byte, val []byte) bool {
+		_ = epochStorers[i%len(epochStorers)].Put(key, val)
+		i++
+		return true
+	})
+
+	gc, _ := trie.NewGarbageCollector(createArgsGarbageCollector())
+	report, err := gc.CollectGarbage(context.Background(), [][]byte{newRootHash}, db, []trie.SweepableStorer{epochStorers[0], epochStorers[1]})
+	require.Nil(t, err)
+	assert.True(t, report.NumRemovedNodes > 0)
+	numRemainingEntries := 0
+	for _, epochStorer := range epochStorers {
+		epochStorer.RangeKeys(func(_ []byte, _ []byte) bool {
+			numRemainingEntries++
+			return true
+		})
+	}
+	assert.Equal(t, int(report.NumReachableNodes), numRemainingEntries)
+}
@@ -0,0 +1,248 @@
+package trie
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// incrementalSnapshotTracker memorizes the hashes committed since the start of the last snapshot. A node whose hash was
+// not committed since then belongs to a sub-trie that was already saved by the previous snapshots, so an incremental
+// snapshot only needs to copy the tracked nodes. Each incremental snapshot epoch only holds its own delta, so after it
+// ends, the snapshots compaction copies into the same epoch all the nodes which are not found in the epoch of the last
+// full snapshot. After that, the delta epochs between the two are no longer needed. The tracking is interrupted (and the
+// next snapshot is a full one) after a restart, after a failed snapshot or if more than maxTrackedHashes hashes are
+// committed between two snapshots.
+type incrementalSnapshotTracker struct {
+	mutex                        sync.RWMutex
+	enabled                      bool
+	maxTrackedHashes             int
+	modifiedHashes               common.ModifiedHashes
+	snapshotInProgressHashes     common.ModifiedHashes
+	isIncrementalSnapshotRunning bool
+	snapshotInProgressEpoch      uint32
+	fullSnapshotEpoch            core.OptionalUint32
+	compactionBaseEpoch          core.OptionalUint32
+	compactionEpoch              uint32
+}
+
+func newIncrementalSnapshotTracker(enabled bool, maxTrackedHashes uint32) *incrementalSnapshotTracker {
+	return &incrementalSnapshotTracker{
+		enabled:          enabled,
+		maxTrackedHashes: int(maxTrackedHashes),
+	}
+}
+
+// addHash tracks the provided committed hash
+func (ist *incrementalSnapshotTracker) addHash(hash []byte) {
+	if !ist.enabled {
+		return
+	}
+
+	ist.mutex.Lock()
+	defer ist.mutex.Unlock()
+
+	if ist.modifiedHashes == nil {
+		return
+	}
+
+	ist.modifiedHashes[string(hash)] = struct{}{}
+	if len(ist.modifiedHashes) <= ist.maxTrackedHashes {
+		return
+	}
+
+	log.Debug("too many trie nodes committed since the last snapshot, the next snapshot will be a full one",
+		"max tracked hashes", ist.maxTrackedHashes)
+	ist.modifiedHashes = nil
+}
+
+// removeHash stops tracking the provided hash, as it was pruned
+func (ist *incrementalSnapshotTracker) removeHash(hash []byte) {
+	if !ist.enabled {
+		return
+	}
+
+	ist.mutex.Lock()
+	defer ist.mutex.Unlock()
+
+	delete(ist.modifiedHashes, string(hash))
+	delete(ist.snapshotInProgressHashes, string(hash))
+}
+
+// isModified returns true if the provided hash was committed since the start of the previous snapshot
+func (ist *incrementalSnapshotTracker) isModified(hash []byte) bool {
+	ist.mutex.RLock()
+	defer ist.mutex.RUnlock()
+
+	_, found := ist.snapshotInProgressHashes[string(hash)]
+	if found {
+		return true
+	}
+
+	_, found = ist.modifiedHashes[string(hash)]
+	return found
+}
+
+// prepareSnapshot starts tracking the hashes committed from now on, and returns true if the snapshot about to be taken
+// in the provided epoch can be an incremental one
+func (ist *incrementalSnapshotTracker) prepareSnapshot(epoch uint32) bool {
+	if !ist.enabled {
+		return false
+	}
+
+	ist.mutex.Lock()
+	defer ist.mutex.Unlock()
+
+	isIncremental := ist.modifiedHashes != nil && ist.fullSnapshotEpoch.HasValue
+
+	ist.snapshotInProgressHashes = ist.modifiedHashes
+	ist.modifiedHashes = make(common.ModifiedHashes)
+	ist.isIncrementalSnapshotRunning = isIncremental
+	ist.snapshotInProgressEpoch = epoch
+
+	return isIncremental
+}
+
+// isIncrementalSnapshotInProgress returns true if the snapshot currently taken is an incremental one
+func (ist *incrementalSnapshotTracker) isIncrementalSnapshotInProgress() bool {
+	ist.mutex.RLock()
+	defer ist.mutex.RUnlock()
+
+	return ist.isIncrementalSnapshotRunning
+}
+
+// finalizeSnapshot releases the hashes saved by the snapshot. After a failed snapshot, the next one will be a full one
+func (ist *incrementalSnapshotTracker) finalizeSnapshot(isSuccessful bool) {
+	if !ist.enabled {
+		return
+	}
+
+	ist.mutex.Lock()
+	defer ist.mutex.Unlock()
+
+	ist.snapshotInProgressHashes = nil
+	wasIncremental := ist.isIncrementalSnapshotRunning
+	ist.isIncrementalSnapshotRunning = false
+
+	if !isSuccessful {
+		ist.modifiedHashes = nil
+		return
+	}
+
+	if !wasIncremental {
+		ist.fullSnapshotEpoch = core.OptionalUint32{
+			Value:    ist.snapshotInProgressEpoch,
+			HasValue: true,
+		}
+	}
+}
+
+// prepareCompaction returns the epoch of the last full snapshot, if the incremental snapshots taken since then can be
+// compacted into the provided epoch. From now on, the snapshots are taken in compaction mode
+func (ist *incrementalSnapshotTracker) prepareCompaction(epoch uint32) (uint32, bool) {
+	if !ist.enabled {
+		return 0, false
+	}
+
+	ist.mutex.Lock()
+	defer ist.mutex.Unlock()
+
+	if !ist.fullSnapshotEpoch.HasValue || ist.fullSnapshotEpoch.Value >= epoch {
+		return 0, false
+	}
+
+	ist.compactionBaseEpoch = ist.fullSnapshotEpoch
+	ist.compactionEpoch = epoch
+
+	return ist.fullSnapshotEpoch.Value, true
+}
+
+// getCompactionBaseEpoch returns the epoch of the full snapshot the compaction in progress is based on, if any
+func (ist *incrementalSnapshotTracker) getCompactionBaseEpoch() core.OptionalUint32 {
+	ist.mutex.RLock()
+	defer ist.mutex.RUnlock()
+
+	return ist.compactionBaseEpoch
+}
+
+// finalizeCompaction ends the compaction mode and returns the epoch of the last full snapshot and the epoch in which
+// the snapshots were compacted
+func (ist *incrementalSnapshotTracker) finalizeCompaction() (uint32, uint32, bool) {
+	ist.mutex.Lock()
+	defer ist.mutex.Unlock()
+
+	baseEpoch := ist.compactionBaseEpoch
+	ist.compactionBaseEpoch = core.OptionalUint32{}
+
+	return baseEpoch.Value, ist.compactionEpoch, baseEpoch.HasValue
+}
+
+// incrementalSnapshotTrieStorageManager is used while taking an incremental snapshot, to skip the sub-tries which were
+// not modified since the previous snapshot
+type incrementalSnapshotTrieStorageManager struct {
+	*snapshotTrieStorageManager
+	tracker *incrementalSnapshotTracker
+}
+
+func (istsm *incrementalSnapshotTrieStorageManager) shouldSnapshotNode(hash []byte) bool {
+	return istsm.tracker.isModified(hash)
+}
+
+// snapshotsCompactionTrieStorageManager is used while compacting the incremental snapshots, to skip the sub-tries which
+// were saved by the last full snapshot and to copy all the other nodes into the compacted epoch
+type snapshotsCompactionTrieStorageManager struct {
+	*snapshotTrieStorageManager
+	compactionStorer snapshotsCompactionStorer
+	baseEpoch        uint32
+}
+
+func newSnapshotsCompactionTrieStorageManager(stsm *snapshotTrieStorageManager, baseEpoch uint32) (*snapshotsCompactionTrieStorageManager, error) {
+	storer, ok := stsm.mainStorer.(snapshotsCompactionStorer)
+	if !ok {
+		return nil, fmt.Errorf("invalid storer for snapshots compaction, type is %T", stsm.mainStorer)
+	}
+
+	return &snapshotsCompactionTrieStorageManager{
+		snapshotTrieStorageManager: stsm,
+		compactionStorer:           storer,
+		baseEpoch:                  baseEpoch,
+	}, nil
+}
+
+// Get checks all the storers for the given key, including the compacted epoch. Unlike the snapshots, the found values
+// are not copied in the previous epoch, as that epoch is about to be released
+func (sctsm *snapshotsCompactionTrieStorageManager) Get(key []byte) ([]byte, error) {
+	sctsm.storageOperationMutex.Lock()
+	defer sctsm.storageOperationMutex.Unlock()
+
+	if sctsm.closed {
+		log.Debug("snapshotsCompactionTrieStorageManager get context closing", "key", key)
+		return nil, core.ErrContextClosing
+	}
+
+	val, _, err := sctsm.mainSnapshotStorer.GetFromOldEpochsWithoutAddingToCache(key)
+	if core.IsClosingError(err) {
+		return nil, err
+	}
+	if len(val) != 0 {
+		return val, nil
+	}
+
+	val, err = sctsm.compactionStorer.GetFromEpochWithoutCache(key, sctsm.epoch)
+	if core.IsClosingError(err) {
+		return nil, err
+	}
+	if len(val) == 0 {
+		return nil, ErrKeyNotFound
+	}
+
+	return val, nil
+}
+
+// a node saved by the last full snapshot has its whole sub-trie in the same epoch
+func (sctsm *snapshotsCompactionTrieStorageManager) shouldSnapshotNode(hash []byte) bool {
+	val, err := sctsm.compactionStorer.GetFromEpochWithoutCache(hash, sctsm.baseEpoch)
+	return err != nil || len(val) == 0
+}
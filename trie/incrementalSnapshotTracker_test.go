@@ -0,0 +1,163 @@
+package trie
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncrementalSnapshotTracker_Disabled(t *testing.T) {
+	t.Parallel()
+
+	tracker := newIncrementalSnapshotTracker(false, 10)
+	assert.False(t, tracker.prepareSnapshot(1))
+
+	tracker.addHash([]byte("hash"))
+	assert.False(t, tracker.isModified([]byte("hash")))
+	tracker.finalizeSnapshot(true)
+
+	assert.False(t, tracker.prepareSnapshot(2))
+	assert.False(t, tracker.isIncrementalSnapshotInProgress())
+}
+
+func TestIncrementalSnapshotTracker_ShouldTakeIncrementalSnapshotsAfterTheFullOne(t *testing.T) {
+	t.Parallel()
+
+	tracker := newIncrementalSnapshotTracker(true, 10)
+
+	// nothing was tracked before the first snapshot
+	tracker.addHash([]byte("hash0"))
+	assert.False(t, tracker.isModified([]byte("hash0")))
+	assert.False(t, tracker.prepareSnapshot(1))
+	assert.False(t, tracker.isIncrementalSnapshotInProgress())
+	tracker.finalizeSnapshot(true)
+
+	tracker.addHash([]byte("hash1"))
+	assert.True(t, tracker.prepareSnapshot(2))
+	assert.True(t, tracker.isIncrementalSnapshotInProgress())
+	tracker.addHash([]byte("hash2"))
+	assert.True(t, tracker.isModified([]byte("hash1")))
+	assert.True(t, tracker.isModified([]byte("hash2")))
+	tracker.finalizeSnapshot(true)
+	assert.False(t, tracker.isIncrementalSnapshotInProgress())
+	assert.False(t, tracker.isModified([]byte("hash1")))
+	assert.True(t, tracker.isModified([]byte("hash2")))
+
+	// the incremental snapshots are never replaced by a full one
+	for i := uint32(3); i < 13; i++ {
+		assert.True(t, tracker.prepareSnapshot(i))
+		tracker.finalizeSnapshot(true)
+	}
+}
+
+func TestIncrementalSnapshotTracker_FailedSnapshotShouldResetTracking(t *testing.T) {
+	t.Parallel()
+
+	tracker := newIncrementalSnapshotTracker(true, 10)
+	assert.False(t, tracker.prepareSnapshot(1))
+	tracker.finalizeSnapshot(true)
+
+	tracker.addHash([]byte("hash1"))
+	assert.True(t, tracker.prepareSnapshot(2))
+	tracker.finalizeSnapshot(false)
+
+	tracker.addHash([]byte("hash2"))
+	assert.False(t, tracker.isModified([]byte("hash2")))
+	assert.False(t, tracker.prepareSnapshot(3))
+	tracker.finalizeSnapshot(true)
+
+	assert.True(t, tracker.prepareSnapshot(4))
+}
+
+func TestIncrementalSnapshotTracker_TooManyHashesShouldResetTracking(t *testing.T) {
+	t.Parallel()
+
+	tracker := newIncrementalSnapshotTracker(true, 2)
+	assert.False(t, tracker.prepareSnapshot(1))
+	tracker.finalizeSnapshot(true)
+
+	tracker.addHash([]byte("hash1"))
+	tracker.addHash([]byte("hash2"))
+	assert.True(t, tracker.isModified([]byte("hash2")))
+
+	tracker.addHash([]byte("hash3"))
+	assert.False(t, tracker.isModified([]byte("hash1")))
+	assert.False(t, tracker.prepareSnapshot(2))
+}
+
+func TestIncrementalSnapshotTracker_RemoveHash(t *testing.T) {
+	t.Parallel()
+
+	tracker := newIncrementalSnapshotTracker(true, 10)
+	assert.False(t, tracker.prepareSnapshot(1))
+	tracker.finalizeSnapshot(true)
+
+	tracker.addHash([]byte("hash1"))
+	tracker.addHash([]byte("hash2"))
+	assert.True(t, tracker.prepareSnapshot(2))
+	tracker.addHash([]byte("hash3"))
+
+	tracker.removeHash([]byte("hash1"))
+	tracker.removeHash([]byte("hash3"))
+	assert.False(t, tracker.isModified([]byte("hash1")))
+	assert.True(t, tracker.isModified([]byte("hash2")))
+	assert.False(t, tracker.isModified([]byte("hash3")))
+}
+
+func TestIncrementalSnapshotTracker_Compaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled tracker can not compact", func(t *testing.T) {
+		t.Parallel()
+
+		tracker := newIncrementalSnapshotTracker(false, 10)
+		_, ok := tracker.prepareCompaction(2)
+		assert.False(t, ok)
+	})
+	t.Run("should compact only after a full snapshot from an older epoch", func(t *testing.T) {
+		t.Parallel()
+
+		tracker := newIncrementalSnapshotTracker(true, 10)
+		_, ok := tracker.prepareCompaction(2)
+		assert.False(t, ok)
+
+		assert.False(t, tracker.prepareSnapshot(3))
+		tracker.finalizeSnapshot(true)
+		_, ok = tracker.prepareCompaction(3)
+		assert.False(t, ok)
+
+		assert.True(t, tracker.prepareSnapshot(5))
+		tracker.finalizeSnapshot(true)
+		baseEpoch, ok := tracker.prepareCompaction(5)
+		assert.True(t, ok)
+		assert.Equal(t, uint32(3), baseEpoch)
+		assert.Equal(t, core.OptionalUint32{Value: 3, HasValue: true}, tracker.getCompactionBaseEpoch())
+
+		baseEpoch, compactedEpoch, wasCompacting := tracker.finalizeCompaction()
+		assert.True(t, wasCompacting)
+		assert.Equal(t, uint32(3), baseEpoch)
+		assert.Equal(t, uint32(5), compactedEpoch)
+		assert.False(t, tracker.getCompactionBaseEpoch().HasValue)
+
+		_, _, wasCompacting = tracker.finalizeCompaction()
+		assert.False(t, wasCompacting)
+	})
+	t.Run("a new full snapshot should become the compaction base", func(t *testing.T) {
+		t.Parallel()
+
+		tracker := newIncrementalSnapshotTracker(true, 10)
+		assert.False(t, tracker.prepareSnapshot(1))
+		tracker.finalizeSnapshot(true)
+		assert.True(t, tracker.prepareSnapshot(2))
+		tracker.finalizeSnapshot(false)
+		assert.False(t, tracker.prepareSnapshot(3))
+		tracker.finalizeSnapshot(true)
+		assert.True(t, tracker.prepareSnapshot(4))
+		tracker.finalizeSnapshot(true)
+
+		baseEpoch, ok := tracker.prepareCompaction(4)
+		assert.True(t, ok)
+		assert.Equal(t, uint32(3), baseEpoch)
+	})
+}
@@ -0,0 +1,133 @@
+package trie
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// maxReportedHashes limits the number of missing or corrupted node hashes kept in an integrity check result
+const maxReportedHashes = 100
+
+// IntegrityCheckResult holds the outcome of a trie integrity check
+type IntegrityCheckResult struct {
+	NumNodes          uint64
+	NumLeaves         uint64
+	NumBytes          uint64
+	MaxDepth          uint32
+	NumMissingNodes   uint64
+	NumCorruptedNodes uint64
+	// MissingNodes and CorruptedNodes hold at most maxReportedHashes hashes each
+	MissingNodes   [][]byte
+	CorruptedNodes [][]byte
+}
+
+// IsValid returns true if all the trie nodes were found and decoded successfully
+func (icr *IntegrityCheckResult) IsValid() bool {
+	return icr.NumMissingNodes == 0 && icr.NumCorruptedNodes == 0
+}
+
+type nodeToCheck struct {
+	hash  []byte
+	depth uint32
+}
+
+// CheckIntegrity walks the trie starting from the provided root hash, loading every node from the storer. Each node
+// should exist, should be decodable and its hash should match the key it is stored under. The walk does not stop on
+// the first error, so all the unreachable sub-tries are reported
+func CheckIntegrity(
+	rootHash []byte,
+	db common.BaseStorer,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*IntegrityCheckResult, error) {
+	if len(rootHash) == 0 {
+		return nil, ErrNilRootHash
+	}
+	if check.IfNil(db) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	result := &IntegrityCheckResult{
+		MissingNodes:   make([][]byte, 0),
+		CorruptedNodes: make([][]byte, 0),
+	}
+
+	stack := []nodeToCheck{{hash: rootHash}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		children, ok := checkNode(current, db, marshaller, hasher, result)
+		if !ok {
+			continue
+		}
+
+		for _, childHash := range children {
+			stack = append(stack, nodeToCheck{hash: childHash, depth: current.depth + 1})
+		}
+	}
+
+	return result, nil
+}
+
+func checkNode(
+	current nodeToCheck,
+	db common.BaseStorer,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+	result *IntegrityCheckResult,
+) ([][]byte, bool) {
+	encodedNode, err := db.Get(current.hash)
+	if err != nil || len(encodedNode) == 0 {
+		result.NumMissingNodes++
+		result.MissingNodes = appendReportedHash(result.MissingNodes, current.hash)
+		return nil, false
+	}
+
+	decodedNode, err := decodeNode(encodedNode, marshaller, hasher)
+	if err != nil || !bytes.Equal(hasher.Compute(string(encodedNode)), current.hash) {
+		result.NumCorruptedNodes++
+		result.CorruptedNodes = appendReportedHash(result.CorruptedNodes, current.hash)
+		return nil, false
+	}
+
+	result.NumNodes++
+	result.NumBytes += uint64(len(encodedNode))
+	if current.depth > result.MaxDepth {
+		result.MaxDepth = current.depth
+	}
+
+	switch n := decodedNode.(type) {
+	case *branchNode:
+		children := make([][]byte, 0, nrOfChildren)
+		for _, childHash := range n.EncodedChildren {
+			if len(childHash) > 0 {
+				children = append(children, childHash)
+			}
+		}
+		return children, true
+	case *extensionNode:
+		return [][]byte{n.EncodedChild}, true
+	default:
+		result.NumLeaves++
+		return nil, true
+	}
+}
+
+func appendReportedHash(hashes [][]byte, hash []byte) [][]byte {
+	if len(hashes) >= maxReportedHashes {
+		return hashes
+	}
+
+	return append(hashes, hash)
+}
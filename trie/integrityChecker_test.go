@@ -0,0 +1,122 @@
+package trie_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createCommittedTrie(t *testing.T, numLeaves int) (common.Trie, common.BaseStorer, []byte) {
+	args := trie.GetDefaultTrieStorageManagerParameters()
+	trieStorageManager, _ := trie.NewTrieStorageManager(args)
+	tr, _ := trie.NewTrie(trieStorageManager, args.Marshalizer, args.Hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+
+	for i := 0; i < numLeaves; i++ {
+		key := args.Hasher.Compute(fmt.Sprintf("key%d", i))
+		require.Nil(t, tr.Update(key, []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return tr, args.MainStorer, rootHash
+}
+
+func TestCheckIntegrity(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := &testscommon.KeccakMock{}
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		db := testscommon.CreateMemUnit()
+		result, err := trie.CheckIntegrity(nil, db, marshaller, hasher)
+		assert.Equal(t, trie.ErrNilRootHash, err)
+		assert.Nil(t, result)
+
+		result, err = trie.CheckIntegrity([]byte("root"), nil, marshaller, hasher)
+		assert.Equal(t, trie.ErrNilDatabase, err)
+		assert.Nil(t, result)
+
+		result, err = trie.CheckIntegrity([]byte("root"), db, nil, hasher)
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+		assert.Nil(t, result)
+
+		result, err = trie.CheckIntegrity([]byte("root"), db, marshaller, nil)
+		assert.Equal(t, trie.ErrNilHasher, err)
+		assert.Nil(t, result)
+	})
+	t.Run("valid trie", func(t *testing.T) {
+		t.Parallel()
+
+		numLeaves := 100
+		tr, db, rootHash := createCommittedTrie(t, numLeaves)
+		allHashes, err := tr.GetAllHashes()
+		require.Nil(t, err)
+
+		result, err := trie.CheckIntegrity(rootHash, db, marshaller, hasher)
+		require.Nil(t, err)
+		assert.True(t, result.IsValid())
+		assert.Equal(t, uint64(numLeaves), result.NumLeaves)
+		assert.Equal(t, uint64(len(allHashes)), result.NumNodes)
+		assert.True(t, result.NumBytes > 0)
+		assert.True(t, result.MaxDepth > 0)
+	})
+	t.Run("missing root should report it", func(t *testing.T) {
+		t.Parallel()
+
+		_, db, _ := createCommittedTrie(t, 10)
+		missingRoot := []byte("missing root hash")
+
+		result, err := trie.CheckIntegrity(missingRoot, db, marshaller, hasher)
+		require.Nil(t, err)
+		assert.False(t, result.IsValid())
+		assert.Equal(t, uint64(1), result.NumMissingNodes)
+		assert.Equal(t, [][]byte{missingRoot}, result.MissingNodes)
+		assert.Equal(t, uint64(0), result.NumNodes)
+	})
+	t.Run("missing and corrupted nodes should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		tr, db, rootHash := createCommittedTrie(t, 100)
+		allHashes, err := tr.GetAllHashes()
+		require.Nil(t, err)
+
+		var missingHash, corruptedHash []byte
+		for _, hash := range allHashes {
+			if string(hash) == string(rootHash) {
+				continue
+			}
+			if missingHash == nil {
+				missingHash = hash
+				continue
+			}
+			corruptedHash = hash
+			break
+		}
+		require.Nil(t, db.Remove(missingHash))
+		encodedNode, err := db.Get(corruptedHash)
+		require.Nil(t, err)
+		encodedNode[0]++
+		require.Nil(t, db.Put(corruptedHash, encodedNode))
+
+		result, err := trie.CheckIntegrity(rootHash, db, marshaller, hasher)
+		require.Nil(t, err)
+		assert.False(t, result.IsValid())
+		assert.Equal(t, uint64(1), result.NumMissingNodes)
+		assert.Equal(t, [][]byte{missingHash}, result.MissingNodes)
+		assert.Equal(t, uint64(1), result.NumCorruptedNodes)
+		assert.Equal(t, [][]byte{corruptedHash}, result.CorruptedNodes)
+		assert.True(t, result.NumNodes < uint64(len(allHashes)))
+	})
+}
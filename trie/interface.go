@@ -67,6 +67,10 @@ type snapshotNode interface {
 	commitSnapshot(originDb common.TrieStorageInteractor, leavesChan chan core.KeyValueHolder, missingNodesChan chan []byte, ctx context.Context, stats common.TrieStatisticsHandler, idleProvider IdleNodeProvider, depthLevel int) error
 }
 
+type snapshotNodesFilter interface {
+	shouldSnapshotNode(hash []byte) bool
+}
+
 // RequestHandler defines the methods through which request to data can be made
 type RequestHandler interface {
 	RequestTrieNodes(destShardID uint32, hashes [][]byte, topic string)
@@ -74,6 +78,36 @@ type RequestHandler interface {
 	IsInterfaceNil() bool
 }
 
+// SyncCheckpointHandler persists the pending hashes of the ongoing trie syncs, so they can be resumed after a restart
+type SyncCheckpointHandler interface {
+	SaveCheckpoint(rootHash []byte, checkpoint *SyncCheckpoint) error
+	GetCheckpoint(rootHash []byte) (*SyncCheckpoint, error)
+	RemoveCheckpoint(rootHash []byte) error
+	CheckpointInterval() time.Duration
+	IsInterfaceNil() bool
+}
+
+// AccountRootHashParser extracts the data trie root hash of the account saved in a main trie leaf
+type AccountRootHashParser interface {
+	ParseRootHash(leafValue []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// SweepableStorer defines a storer whose entries can be iterated and removed by the garbage collector
+type SweepableStorer interface {
+	RangeKeys(handler func(key []byte, val []byte) bool)
+	Remove(key []byte) error
+	IsInterfaceNil() bool
+}
+
+// OldEpochsStorer defines a storer able to iterate and remove the entries of its old epochs
+type OldEpochsStorer interface {
+	GetLatestStorageEpoch() (uint32, error)
+	RangeKeysInEpochsBefore(epoch uint32, handler func(key []byte, val []byte) bool)
+	RemoveFromEpochsBefore(epoch uint32, key []byte) error
+	IsInterfaceNil() bool
+}
+
 // TimeoutHandler is able to tell if a timeout has occurred
 type TimeoutHandler interface {
 	ResetWatchdog()
@@ -99,6 +133,12 @@ type snapshotPruningStorer interface {
 	RemoveFromAllActiveEpochs(key []byte) error
 }
 
+type snapshotsCompactionStorer interface {
+	GetFromEpochWithoutCache(key []byte, epoch uint32) ([]byte, error)
+	RemoveFromEpoch(key []byte, epoch uint32) error
+	ClearEpoch(epoch uint32) error
+}
+
 // EpochNotifier can notify upon an epoch change and provide the current epoch
 type EpochNotifier interface {
 	RegisterNotifyHandler(handler vmcommon.EpochSubscriberHandler)
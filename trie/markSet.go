@@ -0,0 +1,69 @@
+package trie
+
+import (
+	"hash/fnv"
+)
+
+const numMarkSetHashFunctions = 4
+
+// markSet is a bloom filter holding the hashes of the reachable trie nodes. Its memory usage is fixed, whatever the
+// size of the marked tries. A false positive only keeps an unreachable node, so it is safe for the sweep phase, but
+// it can not tell that a subtrie was already marked
+type markSet struct {
+	bits    []uint64
+	numBits uint64
+}
+
+func newMarkSet(sizeInBytes uint64) *markSet {
+	numWords := sizeInBytes / 8
+	if numWords == 0 {
+		numWords = 1
+	}
+
+	return &markSet{
+		bits:    make([]uint64, numWords),
+		numBits: numWords * 64,
+	}
+}
+
+// add marks the provided hash, returning true if it was not already marked
+func (ms *markSet) add(hash []byte) bool {
+	isNew := false
+	h1, h2 := computeMarkSetHashes(hash)
+	for i := uint64(0); i < numMarkSetHashFunctions; i++ {
+		position := (h1 + i*h2) % ms.numBits
+		word, mask := position/64, uint64(1)<<(position%64)
+		if ms.bits[word]&mask == 0 {
+			ms.bits[word] |= mask
+			isNew = true
+		}
+	}
+
+	return isNew
+}
+
+// has returns true if the provided hash might have been marked
+func (ms *markSet) has(hash []byte) bool {
+	h1, h2 := computeMarkSetHashes(hash)
+	for i := uint64(0); i < numMarkSetHashFunctions; i++ {
+		position := (h1 + i*h2) % ms.numBits
+		if ms.bits[position/64]&(uint64(1)<<(position%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func computeMarkSetHashes(hash []byte) (uint64, uint64) {
+	hasher := fnv.New64a()
+	_, _ = hasher.Write(hash)
+	h1 := hasher.Sum64()
+
+	hasher = fnv.New64()
+	_, _ = hasher.Write(hash)
+	// an odd step visits different positions for each hash function
+	h2 := hasher.Sum64() | 1
+
+	return h1, h2
+}
@@ -0,0 +1,41 @@
+package trie
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkSet(t *testing.T) {
+	t.Parallel()
+
+	ms := newMarkSet(1024)
+	numMarked := 100
+	for i := 0; i < numMarked; i++ {
+		hash := []byte(fmt.Sprintf("hash%d", i))
+		assert.False(t, ms.has(hash))
+		assert.True(t, ms.add(hash))
+		assert.False(t, ms.add(hash))
+		assert.True(t, ms.has(hash))
+	}
+	for i := 0; i < numMarked; i++ {
+		assert.True(t, ms.has([]byte(fmt.Sprintf("hash%d", i))))
+	}
+
+	numFalsePositives := 0
+	for i := numMarked; i < 10*numMarked; i++ {
+		if ms.has([]byte(fmt.Sprintf("hash%d", i))) {
+			numFalsePositives++
+		}
+	}
+	assert.Less(t, numFalsePositives, numMarked/10)
+}
+
+func TestMarkSet_TooSmallSizeShouldStillWork(t *testing.T) {
+	t.Parallel()
+
+	ms := newMarkSet(1)
+	assert.True(t, ms.add([]byte("hash")))
+	assert.True(t, ms.has([]byte("hash")))
+}
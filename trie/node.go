@@ -289,6 +289,17 @@ func treatCommitSnapshotError(err error, hash []byte, missingNodesChan chan []by
 	return true, nil
 }
 
+// shouldSkipNodeInSnapshot returns true if the snapshot storage filters out the node with the provided hash, as it was
+// already saved by a previous snapshot
+func shouldSkipNodeInSnapshot(db common.TrieStorageInteractor, hash []byte) bool {
+	filter, ok := db.(snapshotNodesFilter)
+	if !ok {
+		return false
+	}
+
+	return !filter.shouldSnapshotNode(hash)
+}
+
 func shouldMigrateCurrentNode(
 	currentNode node,
 	migrationArgs vmcommon.ArgsMigrateDataTrieLeaves,
@@ -574,30 +574,135 @@ func (tr *patriciaMerkleTrie) GetProof(key []byte) ([][]byte, []byte, error) {
 		return nil, nil, ErrNilNode
 	}
 
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var proof [][]byte
-	hexKey := keyBytesToHex(key)
-	currentNode := tr.root
+	value, err := tr.getProofNodes(key, func(encodedNode []byte) {
+		proof = append(proof, encodedNode)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return proof, value, nil
+}
 
-	err := currentNode.setRootHash()
+// GetMultiProof computes a single Merkle proof for all the given keys. The nodes shared by the paths of
+// the keys are added only once. The values are returned in the same order as the keys
+func (tr *patriciaMerkleTrie) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, nil, ErrNilNode
+	}
+
+	err := tr.root.setRootHash()
 	if err != nil {
 		return nil, nil, err
 	}
 
-	for {
-		encodedNode, errGet := currentNode.getEncodedNode()
+	proof := make([][]byte, 0)
+	addedNodes := make(map[string]struct{})
+	addEncodedNode := func(encodedNode []byte) {
+		_, isAdded := addedNodes[string(encodedNode)]
+		if isAdded {
+			return
+		}
+
+		addedNodes[string(encodedNode)] = struct{}{}
+		proof = append(proof, encodedNode)
+	}
+
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		value, errGet := tr.getProofNodes(key, addEncodedNode)
 		if errGet != nil {
-			return nil, nil, errGet
+			return nil, nil, fmt.Errorf("%w for key %s", errGet, hex.EncodeToString(key))
 		}
+
+		values = append(values, value)
+	}
+
+	return proof, values, nil
+}
+
+// GetRangeProof computes a Merkle proof for all the leaves that have the keys between startKey and endKey,
+// inclusive. The leaves are ordered by their path in the trie. An empty endKey means that there is no upper bound.
+// The auto balanced leaves are saved under hashed keys, so a range of original keys can not be proven for them: if
+// the range proof touches such a leaf or node, ErrRangeProofOnAutoBalancedLeaves is returned.
+// If there are more than maxLeaves leaves in the range, the range is truncated after maxLeaves leaves.
+func (tr *patriciaMerkleTrie) GetRangeProof(startKey []byte, endKey []byte, maxLeaves int) (*common.TrieRangeProof, error) {
+	if maxLeaves <= 0 {
+		return nil, ErrInvalidMaxLeaves
+	}
+
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, ErrNilNode
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	return newRangeProofBuilder(startKey, endKey, maxLeaves, tr.trieStorage).build(tr.root)
+}
+
+// GetAbsenceProof computes a Merkle proof that the given key is not present in the trie. The proof holds the nodes
+// on the path of the key, up to the node where the path diverges from the key
+func (tr *patriciaMerkleTrie) GetAbsenceProof(key []byte) ([][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	proof := make([][]byte, 0)
+	if tr.root == nil {
+		return proof, nil
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tr.getProofNodes(key, func(encodedNode []byte) {
 		proof = append(proof, encodedNode)
+	})
+	if err == nil {
+		return nil, ErrKeyPresentInTrie
+	}
+	if isKeyMissingError(err) {
+		return proof, nil
+	}
+
+	return nil, err
+}
+
+func (tr *patriciaMerkleTrie) getProofNodes(key []byte, addEncodedNode func([]byte)) ([]byte, error) {
+	hexKey := keyBytesToHex(key)
+	currentNode := tr.root
+
+	for {
+		encodedNode, err := currentNode.getEncodedNode()
+		if err != nil {
+			return nil, err
+		}
+		addEncodedNode(encodedNode)
 		value := currentNode.getValue()
 
-		currentNode, hexKey, errGet = currentNode.getNext(hexKey, tr.trieStorage)
-		if errGet != nil {
-			return nil, nil, errGet
+		currentNode, hexKey, err = currentNode.getNext(hexKey, tr.trieStorage)
+		if err != nil {
+			return nil, err
 		}
 
 		if currentNode == nil {
-			return proof, value, nil
+			return value, nil
 		}
 	}
 }
@@ -618,6 +723,133 @@ func (tr *patriciaMerkleTrie) VerifyProof(rootHash []byte, key []byte, proof [][
 	return tr.verifyProof(rootHash, key, proof)
 }
 
+// VerifyMultiProof verifies that all the given keys are proven by the given Merkle multi proof, and that their leaves
+// hold the given values. The values are expected in the same order as the keys
+func (tr *patriciaMerkleTrie) VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error) {
+	if len(keys) != len(values) {
+		return false, ErrKeysAndValuesLengthMismatch
+	}
+
+	proofNodes := tr.mapProofNodesByHash(proof)
+	for i, key := range keys {
+		ok, err := tr.verifyKeyValueInProofNodes(rootHash, tr.hasher.Compute(string(key)), values[i], proofNodes)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			continue
+		}
+
+		ok, err = tr.verifyKeyValueInProofNodes(rootHash, key, values[i], proofNodes)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// VerifyRangeProof verifies that the given Merkle proof holds all the leaves that have the keys between startKey
+// and endKey, inclusive, and returns those leaves ordered by their path in the trie
+func (tr *patriciaMerkleTrie) VerifyRangeProof(
+	rootHash []byte,
+	startKey []byte,
+	endKey []byte,
+	proof [][]byte,
+) (bool, []core.KeyValueHolder, error) {
+	verifier := &rangeProofVerifier{
+		keyRange:    newTrieKeyRange(startKey, endKey),
+		proofNodes:  tr.mapProofNodesByHash(proof),
+		marshalizer: tr.marshalizer,
+		hasher:      tr.hasher,
+	}
+
+	return verifier.verify(rootHash)
+}
+
+// VerifyAbsenceProof verifies that the given Merkle proof shows that the key is not present in the trie. The key is
+// absent only if all the nodes on its path, up to the node where the path diverges from the key, are in the proof
+func (tr *patriciaMerkleTrie) VerifyAbsenceProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
+	if len(rootHash) == 0 || bytes.Equal(rootHash, common.EmptyTrieHash) {
+		return true, nil
+	}
+
+	status, _, err := tr.getKeyStatusInProofNodes(rootHash, key, tr.mapProofNodesByHash(proof))
+	if err != nil {
+		return false, err
+	}
+
+	return status == keyAbsentFromProof, nil
+}
+
+func (tr *patriciaMerkleTrie) mapProofNodesByHash(proof [][]byte) map[string][]byte {
+	proofNodes := make(map[string][]byte, len(proof))
+	for _, encodedNode := range proof {
+		if encodedNode == nil {
+			continue
+		}
+
+		proofNodes[string(tr.hasher.Compute(string(encodedNode)))] = encodedNode
+	}
+
+	return proofNodes
+}
+
+func (tr *patriciaMerkleTrie) verifyKeyValueInProofNodes(rootHash []byte, key []byte, value []byte, proofNodes map[string][]byte) (bool, error) {
+	status, leafValue, err := tr.getKeyStatusInProofNodes(rootHash, key, proofNodes)
+	if err != nil {
+		return false, err
+	}
+
+	return status == keyPresentInProof && bytes.Equal(leafValue, value), nil
+}
+
+// getKeyStatusInProofNodes follows the path of the key through the proof nodes. The key is present if its leaf is
+// reached, in which case the leaf value is also returned, and it is absent if the path diverges from the key. If a
+// node on the path is missing from the proof, nothing is proven about the key
+func (tr *patriciaMerkleTrie) getKeyStatusInProofNodes(rootHash []byte, key []byte, proofNodes map[string][]byte) (proofKeyStatus, []byte, error) {
+	wantHash := rootHash
+	hexKey := keyBytesToHex(key)
+	for range proofNodes {
+		encodedNode, ok := proofNodes[string(wantHash)]
+		if !ok {
+			return keyNotProven, nil, nil
+		}
+
+		n, err := decodeNode(encodedNode, tr.marshalizer, tr.hasher)
+		if err != nil {
+			return keyNotProven, nil, err
+		}
+
+		switch currentNode := n.(type) {
+		case *leafNode:
+			if bytes.Equal(currentNode.Key, hexKey) {
+				return keyPresentInProof, currentNode.Value, nil
+			}
+
+			return keyAbsentFromProof, nil, nil
+		case *extensionNode:
+			keyTooShort := len(hexKey) < len(currentNode.Key)
+			if keyTooShort || !bytes.Equal(currentNode.Key, hexKey[:len(currentNode.Key)]) {
+				return keyAbsentFromProof, nil, nil
+			}
+		case *branchNode:
+			if len(hexKey) == 0 {
+				return keyNotProven, nil, nil
+			}
+			if childPosOutOfRange(hexKey[0]) || len(currentNode.EncodedChildren[hexKey[0]]) == 0 {
+				return keyAbsentFromProof, nil, nil
+			}
+		default:
+			return keyNotProven, nil, ErrInvalidNode
+		}
+
+		_, wantHash, hexKey = n.getNextHashAndKey(hexKey)
+	}
+
+	return keyNotProven, nil, nil
+}
+
 func (tr *patriciaMerkleTrie) verifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	wantHash := rootHash
 	key = keyBytesToHex(key)
@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
@@ -31,3 +32,23 @@ func NewMerkleProofVerifier(marshalizer marshal.Marshalizer, hasher hashing.Hash
 func (mpv *merkleProofVerifier) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	return mpv.trie.VerifyProof(rootHash, key, proof)
 }
+
+// VerifyMultiProof verifies that all the given keys are proven, with the given values, by the given Merkle multi proof
+func (mpv *merkleProofVerifier) VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error) {
+	return mpv.trie.VerifyMultiProof(rootHash, keys, values, proof)
+}
+
+// VerifyRangeProof verifies the given Merkle range proof and returns the leaves between startKey and endKey
+func (mpv *merkleProofVerifier) VerifyRangeProof(
+	rootHash []byte,
+	startKey []byte,
+	endKey []byte,
+	proof [][]byte,
+) (bool, []core.KeyValueHolder, error) {
+	return mpv.trie.VerifyRangeProof(rootHash, startKey, endKey, proof)
+}
+
+// VerifyAbsenceProof verifies that the given Merkle proof shows that the key is not present in the trie
+func (mpv *merkleProofVerifier) VerifyAbsenceProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
+	return mpv.trie.VerifyAbsenceProof(rootHash, key, proof)
+}
@@ -0,0 +1,304 @@
+package trie
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/keyValStorage"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+)
+
+// trieKeyRange holds the bounds of a keys range as paths in the trie, without the hex terminator
+type trieKeyRange struct {
+	start       []byte
+	end         []byte
+	hasEndBound bool
+}
+
+func newTrieKeyRange(startKey []byte, endKey []byte) *trieKeyRange {
+	return &trieKeyRange{
+		start:       keyToPath(startKey),
+		end:         keyToPath(endKey),
+		hasEndBound: len(endKey) > 0,
+	}
+}
+
+func keyToPath(key []byte) []byte {
+	if len(key) == 0 {
+		return nil
+	}
+
+	hexKey := keyBytesToHex(key)
+
+	return hexKey[:len(hexKey)-1]
+}
+
+func pathToKey(path []byte) ([]byte, error) {
+	kb := keyBuilder.NewKeyBuilder()
+	kb.BuildKey(path)
+
+	return kb.GetKey()
+}
+
+// intersectsPrefix returns true if there might be paths starting with the given prefix inside the range
+func (tkr *trieKeyRange) intersectsPrefix(prefix []byte) bool {
+	if tkr.hasEndBound && bytes.Compare(prefix, tkr.end) > 0 {
+		return false
+	}
+
+	startPrefix := tkr.start
+	if len(startPrefix) > len(prefix) {
+		startPrefix = startPrefix[:len(prefix)]
+	}
+
+	return bytes.Compare(prefix, startPrefix) >= 0
+}
+
+func (tkr *trieKeyRange) containsPath(path []byte) bool {
+	if bytes.Compare(path, tkr.start) < 0 {
+		return false
+	}
+
+	return !tkr.hasEndBound || bytes.Compare(path, tkr.end) <= 0
+}
+
+// leafPath returns the full path of a leaf node, without the hex terminator
+func leafPath(parentPath []byte, ln *leafNode) []byte {
+	path := concat(parentPath, ln.Key...)
+	if len(path) > 0 && path[len(path)-1] == hexTerminator {
+		path = path[:len(path)-1]
+	}
+
+	return path
+}
+
+// hasAutoBalancedData returns true if the node is an auto balanced leaf, or if it points to auto balanced data. The
+// versions are part of the encoded nodes, so they are covered by the node hashes
+func hasAutoBalancedData(n node) bool {
+	switch currentNode := n.(type) {
+	case *leafNode:
+		return core.TrieNodeVersion(currentNode.Version) != core.NotSpecified
+	case *extensionNode:
+		return core.TrieNodeVersion(currentNode.ChildVersion) != core.NotSpecified
+	case *branchNode:
+		for _, childVersion := range currentNode.ChildrenVersion {
+			if core.TrieNodeVersion(childVersion) != core.NotSpecified {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// rangeProofBuilder traverses, in the order of the paths, all the nodes of the trie that might hold leaves inside the
+// range, and collects those nodes and leaves
+type rangeProofBuilder struct {
+	keyRange  *trieKeyRange
+	endKey    []byte
+	maxLeaves int
+	db        common.TrieStorageInteractor
+
+	rangeProof  *common.TrieRangeProof
+	isTruncated bool
+}
+
+func newRangeProofBuilder(startKey []byte, endKey []byte, maxLeaves int, db common.TrieStorageInteractor) *rangeProofBuilder {
+	return &rangeProofBuilder{
+		keyRange:  newTrieKeyRange(startKey, endKey),
+		endKey:    endKey,
+		maxLeaves: maxLeaves,
+		db:        db,
+		rangeProof: &common.TrieRangeProof{
+			Proof:  make([][]byte, 0),
+			Keys:   make([][]byte, 0),
+			Values: make([][]byte, 0),
+		},
+	}
+}
+
+func (rpb *rangeProofBuilder) build(root node) (*common.TrieRangeProof, error) {
+	err := rpb.addNode(root, make([]byte, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	// the proven range ends with the last collected leaf only if the range was truncated
+	rpb.rangeProof.Complete = !rpb.isTruncated
+	if rpb.rangeProof.Complete {
+		rpb.rangeProof.LastKey = rpb.endKey
+	}
+
+	return rpb.rangeProof, nil
+}
+
+func (rpb *rangeProofBuilder) addNode(n node, path []byte) error {
+	if rpb.isTruncated || !rpb.keyRange.intersectsPrefix(path) {
+		return nil
+	}
+	if hasAutoBalancedData(n) {
+		return ErrRangeProofOnAutoBalancedLeaves
+	}
+
+	switch currentNode := n.(type) {
+	case *leafNode:
+		return rpb.addLeaf(currentNode, path)
+	case *extensionNode:
+		err := rpb.addEncodedNode(currentNode)
+		if err != nil {
+			return err
+		}
+
+		err = resolveIfCollapsed(currentNode, 0, rpb.db)
+		if err != nil {
+			return err
+		}
+
+		return rpb.addNode(currentNode.child, concat(path, currentNode.Key...))
+	case *branchNode:
+		err := rpb.addEncodedNode(currentNode)
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < nrOfChildren; i++ {
+			err = resolveIfCollapsed(currentNode, byte(i), rpb.db)
+			if err != nil {
+				return err
+			}
+			if currentNode.children[i] == nil {
+				continue
+			}
+
+			err = rpb.addNode(currentNode.children[i], concat(path, byte(i)))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return ErrInvalidNode
+	}
+}
+
+func (rpb *rangeProofBuilder) addLeaf(ln *leafNode, parentPath []byte) error {
+	path := leafPath(parentPath, ln)
+	if !rpb.keyRange.containsPath(path) {
+		return rpb.addEncodedNode(ln)
+	}
+
+	if len(rpb.rangeProof.Keys) == rpb.maxLeaves {
+		rpb.isTruncated = true
+		return nil
+	}
+
+	err := rpb.addEncodedNode(ln)
+	if err != nil {
+		return err
+	}
+
+	key, err := pathToKey(concat(path, hexTerminator))
+	if err != nil {
+		return err
+	}
+
+	rpb.rangeProof.Keys = append(rpb.rangeProof.Keys, key)
+	rpb.rangeProof.Values = append(rpb.rangeProof.Values, ln.Value)
+	rpb.rangeProof.LastKey = key
+
+	return nil
+}
+
+func (rpb *rangeProofBuilder) addEncodedNode(n node) error {
+	encodedNode, err := n.getEncodedNode()
+	if err != nil {
+		return err
+	}
+
+	rpb.rangeProof.Proof = append(rpb.rangeProof.Proof, encodedNode)
+
+	return nil
+}
+
+// rangeProofVerifier rebuilds, from the proof nodes, all the paths of the trie that might hold leaves inside the
+// range. The proof is valid only if all the nodes on those paths are present in the proof.
+type rangeProofVerifier struct {
+	keyRange    *trieKeyRange
+	proofNodes  map[string][]byte
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+
+	leaves []core.KeyValueHolder
+}
+
+func (rpv *rangeProofVerifier) verify(rootHash []byte) (bool, []core.KeyValueHolder, error) {
+	rpv.leaves = make([]core.KeyValueHolder, 0)
+
+	ok, err := rpv.verifyNode(rootHash, make([]byte, 0))
+	if err != nil || !ok {
+		return false, nil, err
+	}
+
+	return true, rpv.leaves, nil
+}
+
+func (rpv *rangeProofVerifier) verifyNode(hash []byte, path []byte) (bool, error) {
+	if !rpv.keyRange.intersectsPrefix(path) {
+		return true, nil
+	}
+
+	encodedNode, ok := rpv.proofNodes[string(hash)]
+	if !ok {
+		return false, nil
+	}
+
+	n, err := decodeNode(encodedNode, rpv.marshalizer, rpv.hasher)
+	if err != nil {
+		return false, err
+	}
+	if hasAutoBalancedData(n) {
+		return false, ErrRangeProofOnAutoBalancedLeaves
+	}
+
+	switch currentNode := n.(type) {
+	case *leafNode:
+		return true, rpv.addLeaf(currentNode, path)
+	case *extensionNode:
+		return rpv.verifyNode(currentNode.EncodedChild, concat(path, currentNode.Key...))
+	case *branchNode:
+		for i, childHash := range currentNode.EncodedChildren {
+			if len(childHash) == 0 {
+				continue
+			}
+
+			ok, err = rpv.verifyNode(childHash, concat(path, byte(i)))
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+
+		return true, nil
+	default:
+		return false, ErrInvalidNode
+	}
+}
+
+func (rpv *rangeProofVerifier) addLeaf(ln *leafNode, parentPath []byte) error {
+	path := leafPath(parentPath, ln)
+	if !rpv.keyRange.containsPath(path) {
+		return nil
+	}
+
+	key, err := pathToKey(concat(path, hexTerminator))
+	if err != nil {
+		return err
+	}
+
+	rpv.leaves = append(rpv.leaves, keyValStorage.NewKeyValStorage(key, ln.Value))
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package trie_test
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// trieOrderPath returns the nibbles of the key in the order used by the trie paths
+func trieOrderPath(key []byte) []byte {
+	path := make([]byte, 0, len(key)*2)
+	for i := len(key) - 1; i >= 0; i-- {
+		path = append(path, key[i]&0x0f, key[i]>>4)
+	}
+
+	return path
+}
+
+func initTrieWithSortedKeys(t *testing.T, numLeaves int) (common.Trie, [][]byte) {
+	tr := emptyTrie()
+	hasher := keccak.NewKeccak()
+	keys := make([][]byte, 0, numLeaves)
+	for i := 0; i < numLeaves; i++ {
+		key := hasher.Compute(fmt.Sprint(i))
+		require.Nil(t, tr.Update(key, append([]byte("value"), key...)))
+		keys = append(keys, key)
+	}
+	require.Nil(t, tr.Commit())
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(trieOrderPath(keys[i]), trieOrderPath(keys[j])) < 0
+	})
+
+	return tr, keys
+}
+
+func TestPatriciaMerkleTrie_GetAndVerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	tr, keys := initTrieWithSortedKeys(t, 200)
+	rootHash, _ := tr.RootHash()
+	provenKeys := [][]byte{keys[3], keys[77], keys[150], keys[3]}
+
+	proof, values, err := tr.GetMultiProof(provenKeys)
+	require.Nil(t, err)
+	require.Equal(t, len(provenKeys), len(values))
+	for i, key := range provenKeys {
+		assert.Equal(t, append([]byte("value"), key...), values[i])
+	}
+
+	numNodes := 0
+	for _, key := range provenKeys {
+		singleProof, _, _ := tr.GetProof(key)
+		numNodes += len(singleProof)
+	}
+	assert.True(t, len(proof) < numNodes)
+
+	ok, err := tr.VerifyMultiProof(rootHash, provenKeys, values, proof)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = tr.VerifyMultiProof(rootHash, append(provenKeys, keys[100]), append(values, values[0]), proof)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	wrongValues := append([][]byte{[]byte("wrong value")}, values[1:]...)
+	ok, err = tr.VerifyMultiProof(rootHash, provenKeys, wrongValues, proof)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	ok, err = tr.VerifyMultiProof(rootHash, provenKeys, values[1:], proof)
+	assert.Equal(t, trie.ErrKeysAndValuesLengthMismatch, err)
+	assert.False(t, ok)
+
+	_, _, err = tr.GetMultiProof([][]byte{keys[0], []byte("missing key")})
+	assert.NotNil(t, err)
+}
+
+func TestPatriciaMerkleTrie_GetRangeProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max leaves should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieWithSortedKeys(t, 10)
+		rangeProof, err := tr.GetRangeProof(nil, nil, 0)
+		assert.Nil(t, rangeProof)
+		assert.Equal(t, trie.ErrInvalidMaxLeaves, err)
+	})
+	t.Run("empty trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		rangeProof, err := emptyTrie().GetRangeProof(nil, nil, 10)
+		assert.Nil(t, rangeProof)
+		assert.Equal(t, trie.ErrNilNode, err)
+	})
+	t.Run("complete range", func(t *testing.T) {
+		t.Parallel()
+
+		tr, keys := initTrieWithSortedKeys(t, 300)
+		rootHash, _ := tr.RootHash()
+		startKey, endKey := keys[40], keys[120]
+
+		rangeProof, err := tr.GetRangeProof(startKey, endKey, 1000)
+		require.Nil(t, err)
+		assert.True(t, rangeProof.Complete)
+		assert.Equal(t, endKey, rangeProof.LastKey)
+		assert.Equal(t, keys[40:121], rangeProof.Keys)
+
+		ok, leaves, err := tr.VerifyRangeProof(rootHash, startKey, endKey, rangeProof.Proof)
+		require.Nil(t, err)
+		require.True(t, ok)
+		require.Equal(t, len(rangeProof.Keys), len(leaves))
+		for i, leaf := range leaves {
+			assert.Equal(t, rangeProof.Keys[i], leaf.Key())
+			assert.Equal(t, rangeProof.Values[i], leaf.Value())
+		}
+	})
+	t.Run("unbounded range", func(t *testing.T) {
+		t.Parallel()
+
+		tr, keys := initTrieWithSortedKeys(t, 100)
+		rootHash, _ := tr.RootHash()
+
+		rangeProof, err := tr.GetRangeProof(nil, nil, 1000)
+		require.Nil(t, err)
+		assert.True(t, rangeProof.Complete)
+		assert.Equal(t, keys, rangeProof.Keys)
+
+		ok, leaves, err := tr.VerifyRangeProof(rootHash, nil, nil, rangeProof.Proof)
+		require.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, len(keys), len(leaves))
+	})
+	t.Run("truncated range", func(t *testing.T) {
+		t.Parallel()
+
+		tr, keys := initTrieWithSortedKeys(t, 300)
+		rootHash, _ := tr.RootHash()
+
+		rangeProof, err := tr.GetRangeProof(keys[10], nil, 25)
+		require.Nil(t, err)
+		assert.False(t, rangeProof.Complete)
+		assert.Equal(t, keys[10:35], rangeProof.Keys)
+		assert.Equal(t, keys[34], rangeProof.LastKey)
+
+		ok, leaves, err := tr.VerifyRangeProof(rootHash, keys[10], rangeProof.LastKey, rangeProof.Proof)
+		require.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, 25, len(leaves))
+
+		ok, _, err = tr.VerifyRangeProof(rootHash, keys[10], keys[60], rangeProof.Proof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("proof with a missing node should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		tr, keys := initTrieWithSortedKeys(t, 300)
+		rootHash, _ := tr.RootHash()
+
+		rangeProof, err := tr.GetRangeProof(keys[40], keys[120], 1000)
+		require.Nil(t, err)
+
+		for i := range rangeProof.Proof {
+			proof := make([][]byte, 0, len(rangeProof.Proof)-1)
+			proof = append(proof, rangeProof.Proof[:i]...)
+			proof = append(proof, rangeProof.Proof[i+1:]...)
+
+			ok, leaves, errVerify := tr.VerifyRangeProof(rootHash, keys[40], keys[120], proof)
+			assert.Nil(t, errVerify)
+			assert.False(t, ok)
+			assert.Nil(t, leaves)
+		}
+	})
+}
+
+func TestPatriciaMerkleTrie_RangeProofOnAutoBalancedLeaves(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrieWithCustomEnableEpochsHandler(enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.AutoBalanceDataTriesFlag))
+	dataTrie, ok := tr.(interface {
+		UpdateWithVersion(key []byte, value []byte, version core.TrieNodeVersion) error
+	})
+	require.True(t, ok)
+
+	hasher := keccak.NewKeccak()
+	for i := 0; i < 20; i++ {
+		key := hasher.Compute(fmt.Sprint(i))
+		require.Nil(t, dataTrie.UpdateWithVersion(key, key, core.AutoBalanceEnabled))
+	}
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.RootHash()
+
+	rangeProof, err := tr.GetRangeProof(nil, nil, 100)
+	assert.Nil(t, rangeProof)
+	assert.Equal(t, trie.ErrRangeProofOnAutoBalancedLeaves, err)
+
+	proof, _, err := tr.GetMultiProof([][]byte{hasher.Compute("0")})
+	require.Nil(t, err)
+	ok, leaves, err := tr.VerifyRangeProof(rootHash, nil, nil, proof)
+	assert.Equal(t, trie.ErrRangeProofOnAutoBalancedLeaves, err)
+	assert.False(t, ok)
+	assert.Nil(t, leaves)
+}
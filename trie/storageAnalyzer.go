@@ -0,0 +1,323 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// ArgsStorageAnalysis is the DTO used to analyze the storage used by a trie
+type ArgsStorageAnalysis struct {
+	// Ctx bounds the duration of the analysis: once it is done, the analysis stops with core.ErrContextClosing
+	Ctx        context.Context
+	RootHash   []byte
+	DB         common.BaseStorer
+	Marshaller marshal.Marshalizer
+	Hasher     hashing.Hasher
+	// AccountRootHashParser is optional. If set, the analyzed trie is considered a main trie and the data tries of its
+	// accounts are analyzed as well
+	AccountRootHashParser AccountRootHashParser
+	NumLargestDataTries   int
+	// TrackReachableNodes should be set if the dead nodes are to be counted after the analysis
+	TrackReachableNodes bool
+}
+
+// DataTrieUsage holds the statistics of the data trie of an account
+type DataTrieUsage struct {
+	Address    []byte
+	RootHash   []byte
+	Statistics *common.TrieNodesStatistics
+}
+
+// StorageReport holds the outcome of a trie storage analysis
+type StorageReport struct {
+	MainTrie     *common.TrieNodesStatistics
+	DataTries    *common.TrieNodesStatistics
+	NumDataTries uint64
+	// LargestDataTries holds at most NumLargestDataTries data tries, in descending order of their size
+	LargestDataTries []*DataTrieUsage
+	NumMissingNodes  uint64
+
+	hasher          hashing.Hasher
+	reachableHashes map[string]struct{}
+}
+
+// DeadNodesReport holds the number and the size of the stored trie nodes, and of the ones that are not reachable
+// from the analyzed root hash
+type DeadNodesReport struct {
+	NumStoredNodes  uint64
+	StoredNodesSize uint64
+	NumDeadNodes    uint64
+	DeadNodesSize   uint64
+}
+
+type nodeToAnalyze struct {
+	hash  []byte
+	depth uint32
+	path  []byte
+}
+
+type storageAnalyzer struct {
+	args   ArgsStorageAnalysis
+	report *StorageReport
+}
+
+// AnalyzeStorage walks the trie starting from the provided root hash, loading every node from the storer, and
+// collects the number and the size of the nodes by type and by depth. If an account root hash parser is provided,
+// the data tries of all the accounts are walked as well. Missing or undecodable nodes are counted, without stopping
+// the analysis
+func AnalyzeStorage(args ArgsStorageAnalysis) (*StorageReport, error) {
+	if args.Ctx == nil {
+		return nil, ErrNilContext
+	}
+	if len(args.RootHash) == 0 {
+		return nil, ErrNilRootHash
+	}
+	if check.IfNil(args.DB) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if args.NumLargestDataTries < 0 {
+		return nil, ErrInvalidNumLargestDataTries
+	}
+
+	sa := &storageAnalyzer{
+		args: args,
+		report: &StorageReport{
+			MainTrie:         newTrieNodesStatistics(),
+			DataTries:        newTrieNodesStatistics(),
+			LargestDataTries: make([]*DataTrieUsage, 0, args.NumLargestDataTries),
+			hasher:           args.Hasher,
+		},
+	}
+	if args.TrackReachableNodes {
+		sa.report.reachableHashes = make(map[string]struct{})
+	}
+
+	var leafHandler func(path []byte, ln *leafNode)
+	if !check.IfNil(args.AccountRootHashParser) {
+		leafHandler = sa.analyzeDataTrie
+	}
+	sa.analyzeTrie(args.RootHash, sa.report.MainTrie, leafHandler)
+	if common.IsContextDone(args.Ctx) {
+		return nil, core.ErrContextClosing
+	}
+
+	return sa.report, nil
+}
+
+func newTrieNodesStatistics() *common.TrieNodesStatistics {
+	return &common.TrieNodesStatistics{
+		NumNodesByDepth: make([]uint64, 0),
+	}
+}
+
+func (sa *storageAnalyzer) analyzeTrie(
+	rootHash []byte,
+	stats *common.TrieNodesStatistics,
+	leafHandler func(path []byte, ln *leafNode),
+) {
+	stack := []nodeToAnalyze{{hash: rootHash, path: make([]byte, 0)}}
+	for len(stack) > 0 {
+		if common.IsContextDone(sa.args.Ctx) {
+			return
+		}
+
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		encodedNode, err := sa.args.DB.Get(current.hash)
+		if err != nil || len(encodedNode) == 0 {
+			sa.report.NumMissingNodes++
+			continue
+		}
+
+		decodedNode, err := decodeNode(encodedNode, sa.args.Marshaller, sa.args.Hasher)
+		if err != nil {
+			sa.report.NumMissingNodes++
+			continue
+		}
+
+		if sa.report.reachableHashes != nil {
+			sa.report.reachableHashes[string(current.hash)] = struct{}{}
+		}
+		addNodeToStatistics(stats, decodedNode, uint64(len(encodedNode)), current.depth)
+
+		switch n := decodedNode.(type) {
+		case *branchNode:
+			for i, childHash := range n.EncodedChildren {
+				if len(childHash) == 0 {
+					continue
+				}
+				stack = append(stack, nodeToAnalyze{hash: childHash, depth: current.depth + 1, path: concat(current.path, byte(i))})
+			}
+		case *extensionNode:
+			stack = append(stack, nodeToAnalyze{hash: n.EncodedChild, depth: current.depth + 1, path: concat(current.path, n.Key...)})
+		case *leafNode:
+			if leafHandler != nil {
+				leafHandler(current.path, n)
+			}
+		}
+	}
+}
+
+func addNodeToStatistics(stats *common.TrieNodesStatistics, n node, size uint64, depth uint32) {
+	switch n.(type) {
+	case *branchNode:
+		stats.NumBranchNodes++
+		stats.BranchNodesSize += size
+	case *extensionNode:
+		stats.NumExtensionNodes++
+		stats.ExtensionNodesSize += size
+	default:
+		stats.NumLeafNodes++
+		stats.LeafNodesSize += size
+	}
+
+	stats.NumNodes++
+	stats.TotalSize += size
+	if depth > stats.MaxDepth {
+		stats.MaxDepth = depth
+	}
+	for uint32(len(stats.NumNodesByDepth)) <= depth {
+		stats.NumNodesByDepth = append(stats.NumNodesByDepth, 0)
+	}
+	stats.NumNodesByDepth[depth]++
+}
+
+func (sa *storageAnalyzer) analyzeDataTrie(parentPath []byte, ln *leafNode) {
+	dataTrieRootHash, err := sa.args.AccountRootHashParser.ParseRootHash(ln.Value)
+	if err != nil {
+		log.Debug("could not parse the account root hash during storage analysis", "error", err)
+		return
+	}
+	if common.IsEmptyTrie(dataTrieRootHash) {
+		return
+	}
+
+	address, err := pathToKey(concat(parentPath, ln.Key...))
+	if err != nil {
+		log.Debug("could not build the account address during storage analysis", "error", err)
+		return
+	}
+
+	stats := newTrieNodesStatistics()
+	sa.analyzeTrie(dataTrieRootHash, stats, nil)
+
+	mergeTrieNodesStatistics(sa.report.DataTries, stats)
+	sa.report.NumDataTries++
+	sa.addToLargestDataTries(&DataTrieUsage{
+		Address:    address,
+		RootHash:   dataTrieRootHash,
+		Statistics: stats,
+	})
+}
+
+func mergeTrieNodesStatistics(dest *common.TrieNodesStatistics, src *common.TrieNodesStatistics) {
+	dest.NumNodes += src.NumNodes
+	dest.NumBranchNodes += src.NumBranchNodes
+	dest.NumExtensionNodes += src.NumExtensionNodes
+	dest.NumLeafNodes += src.NumLeafNodes
+	dest.TotalSize += src.TotalSize
+	dest.BranchNodesSize += src.BranchNodesSize
+	dest.ExtensionNodesSize += src.ExtensionNodesSize
+	dest.LeafNodesSize += src.LeafNodesSize
+	if src.MaxDepth > dest.MaxDepth {
+		dest.MaxDepth = src.MaxDepth
+	}
+	for len(dest.NumNodesByDepth) < len(src.NumNodesByDepth) {
+		dest.NumNodesByDepth = append(dest.NumNodesByDepth, 0)
+	}
+	for depth, numNodes := range src.NumNodesByDepth {
+		dest.NumNodesByDepth[depth] += numNodes
+	}
+}
+
+func (sa *storageAnalyzer) addToLargestDataTries(usage *DataTrieUsage) {
+	maxDataTries := sa.args.NumLargestDataTries
+	largest := sa.report.LargestDataTries
+	if maxDataTries == 0 {
+		return
+	}
+
+	insertIndex := sort.Search(len(largest), func(i int) bool {
+		return largest[i].Statistics.TotalSize < usage.Statistics.TotalSize
+	})
+	if insertIndex == maxDataTries {
+		return
+	}
+
+	if len(largest) < maxDataTries {
+		largest = append(largest, nil)
+	}
+	copy(largest[insertIndex+1:], largest[insertIndex:])
+	largest[insertIndex] = usage
+
+	sa.report.LargestDataTries = largest
+}
+
+// CountDeadNodes iterates all the entries provided by rangeKeys and counts the trie nodes that were not reached
+// during the analysis. Only the entries whose key is the hash of their value are trie nodes, the other entries (e.g.
+// the ones holding the code or other metadata saved in the same storer) are ignored. Nodes reachable only from
+// other root hashes, e.g. the ones of previous epochs, are also counted as dead nodes
+func (sr *StorageReport) CountDeadNodes(rangeKeys func(handler func(key []byte, val []byte) bool)) (*DeadNodesReport, error) {
+	if sr.reachableHashes == nil {
+		return nil, ErrReachableNodesNotTracked
+	}
+	if rangeKeys == nil {
+		return nil, ErrNilDatabase
+	}
+
+	deadNodesReport := &DeadNodesReport{}
+	rangeKeys(func(key []byte, val []byte) bool {
+		if !bytes.Equal(sr.hasher.Compute(string(val)), key) {
+			return true
+		}
+
+		deadNodesReport.NumStoredNodes++
+		deadNodesReport.StoredNodesSize += uint64(len(val))
+
+		_, isReachable := sr.reachableHashes[string(key)]
+		if !isReachable {
+			deadNodesReport.NumDeadNodes++
+			deadNodesReport.DeadNodesSize += uint64(len(val))
+		}
+
+		return true
+	})
+
+	return deadNodesReport, nil
+}
+
+// ToAPIReport converts the report to the API response format, using the provided encoder for the addresses
+func (sr *StorageReport) ToAPIReport(rootHash []byte, encodeAddress func(address []byte) string) *common.TrieStorageReport {
+	largestDataTries := make([]*common.DataTrieStorageUsage, 0, len(sr.LargestDataTries))
+	for _, usage := range sr.LargestDataTries {
+		largestDataTries = append(largestDataTries, &common.DataTrieStorageUsage{
+			Address:             encodeAddress(usage.Address),
+			RootHash:            hex.EncodeToString(usage.RootHash),
+			TrieNodesStatistics: usage.Statistics,
+		})
+	}
+
+	return &common.TrieStorageReport{
+		RootHash:         hex.EncodeToString(rootHash),
+		MainTrie:         sr.MainTrie,
+		DataTries:        sr.DataTries,
+		NumDataTries:     sr.NumDataTries,
+		LargestDataTries: largestDataTries,
+		NumMissingNodes:  sr.NumMissingNodes,
+	}
+}
@@ -0,0 +1,275 @@
+package trie_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const noDataTrieValue = "no data trie"
+
+// valueAsRootHashParser considers the value of a main trie leaf to be the root hash of the data trie
+type valueAsRootHashParser struct{}
+
+func (parser *valueAsRootHashParser) ParseRootHash(leafValue []byte) ([]byte, error) {
+	if string(leafValue) == noDataTrieValue {
+		return nil, nil
+	}
+
+	return leafValue, nil
+}
+
+func (parser *valueAsRootHashParser) IsInterfaceNil() bool {
+	return parser == nil
+}
+
+func createTrieInStorage(t *testing.T, storageManager common.StorageManager, args trie.NewTrieStorageManagerArgs, numLeaves int) (common.Trie, []byte) {
+	tr, _ := trie.NewTrie(storageManager, args.Marshalizer, args.Hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	for i := 0; i < numLeaves; i++ {
+		key := args.Hasher.Compute(fmt.Sprintf("key%d", i))
+		require.Nil(t, tr.Update(key, []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.Nil(t, tr.Commit())
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return tr, rootHash
+}
+
+func createArgsStorageAnalysis(rootHash []byte, db common.BaseStorer) trie.ArgsStorageAnalysis {
+	return trie.ArgsStorageAnalysis{
+		Ctx:                 context.Background(),
+		RootHash:            rootHash,
+		DB:                  db,
+		Marshaller:          &marshal.GogoProtoMarshalizer{},
+		Hasher:              &testscommon.KeccakMock{},
+		NumLargestDataTries: 10,
+	}
+}
+
+func sumNodesByDepth(stats *common.TrieNodesStatistics) uint64 {
+	numNodes := uint64(0)
+	for _, numNodesAtDepth := range stats.NumNodesByDepth {
+		numNodes += numNodesAtDepth
+	}
+
+	return numNodes
+}
+
+func TestAnalyzeStorage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		db := testscommon.CreateMemUnit()
+
+		args := createArgsStorageAnalysis([]byte("root"), db)
+		args.Ctx = nil
+		report, err := trie.AnalyzeStorage(args)
+		assert.Equal(t, trie.ErrNilContext, err)
+		assert.Nil(t, report)
+
+		args = createArgsStorageAnalysis(nil, db)
+		report, err = trie.AnalyzeStorage(args)
+		assert.Equal(t, trie.ErrNilRootHash, err)
+		assert.Nil(t, report)
+
+		args = createArgsStorageAnalysis([]byte("root"), nil)
+		report, err = trie.AnalyzeStorage(args)
+		assert.Equal(t, trie.ErrNilDatabase, err)
+		assert.Nil(t, report)
+
+		args = createArgsStorageAnalysis([]byte("root"), db)
+		args.Marshaller = nil
+		report, err = trie.AnalyzeStorage(args)
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+		assert.Nil(t, report)
+
+		args = createArgsStorageAnalysis([]byte("root"), db)
+		args.Hasher = nil
+		report, err = trie.AnalyzeStorage(args)
+		assert.Equal(t, trie.ErrNilHasher, err)
+		assert.Nil(t, report)
+
+		args = createArgsStorageAnalysis([]byte("root"), db)
+		args.NumLargestDataTries = -1
+		report, err = trie.AnalyzeStorage(args)
+		assert.Equal(t, trie.ErrInvalidNumLargestDataTries, err)
+		assert.Nil(t, report)
+	})
+	t.Run("done context should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, db, rootHash := createCommittedTrie(t, 10)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		args := createArgsStorageAnalysis(rootHash, db)
+		args.Ctx = ctx
+		report, err := trie.AnalyzeStorage(args)
+		assert.Equal(t, core.ErrContextClosing, err)
+		assert.Nil(t, report)
+	})
+	t.Run("single trie", func(t *testing.T) {
+		t.Parallel()
+
+		numLeaves := 100
+		tr, db, rootHash := createCommittedTrie(t, numLeaves)
+		allHashes, err := tr.GetAllHashes()
+		require.Nil(t, err)
+
+		report, err := trie.AnalyzeStorage(createArgsStorageAnalysis(rootHash, db))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(numLeaves), report.MainTrie.NumLeafNodes)
+		assert.Equal(t, uint64(len(allHashes)), report.MainTrie.NumNodes)
+		assert.Equal(t, report.MainTrie.NumNodes, sumNodesByDepth(report.MainTrie))
+		assert.Equal(t, uint64(1), report.MainTrie.NumNodesByDepth[0])
+		assert.Equal(t, int(report.MainTrie.MaxDepth)+1, len(report.MainTrie.NumNodesByDepth))
+		assert.Equal(t,
+			report.MainTrie.BranchNodesSize+report.MainTrie.ExtensionNodesSize+report.MainTrie.LeafNodesSize,
+			report.MainTrie.TotalSize,
+		)
+		assert.Equal(t, uint64(0), report.NumDataTries)
+		assert.Equal(t, uint64(0), report.NumMissingNodes)
+	})
+	t.Run("main trie with data tries", func(t *testing.T) {
+		t.Parallel()
+
+		args := trie.GetDefaultTrieStorageManagerParameters()
+		storageManager, _ := trie.NewTrieStorageManager(args)
+
+		numLeavesPerDataTrie := []int{5, 50, 20}
+		dataTrieRootHashes := make([][]byte, 0, len(numLeavesPerDataTrie))
+		for _, numLeaves := range numLeavesPerDataTrie {
+			_, dataTrieRootHash := createTrieInStorage(t, storageManager, args, numLeaves)
+			dataTrieRootHashes = append(dataTrieRootHashes, dataTrieRootHash)
+		}
+
+		mainTrie, _ := trie.NewTrie(storageManager, args.Marshalizer, args.Hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+		addresses := make([][]byte, 0, len(dataTrieRootHashes))
+		for i, dataTrieRootHash := range dataTrieRootHashes {
+			address := args.Hasher.Compute(fmt.Sprintf("address%d", i))
+			addresses = append(addresses, address)
+			require.Nil(t, mainTrie.Update(address, dataTrieRootHash))
+		}
+		require.Nil(t, mainTrie.Update(args.Hasher.Compute("address without data trie"), []byte(noDataTrieValue)))
+		require.Nil(t, mainTrie.Commit())
+		rootHash, _ := mainTrie.RootHash()
+
+		analysisArgs := createArgsStorageAnalysis(rootHash, args.MainStorer)
+		analysisArgs.AccountRootHashParser = &valueAsRootHashParser{}
+		analysisArgs.NumLargestDataTries = 2
+		report, err := trie.AnalyzeStorage(analysisArgs)
+		require.Nil(t, err)
+
+		assert.Equal(t, uint64(4), report.MainTrie.NumLeafNodes)
+		assert.Equal(t, uint64(3), report.NumDataTries)
+		assert.Equal(t, uint64(75), report.DataTries.NumLeafNodes)
+		assert.Equal(t, report.DataTries.NumNodes, sumNodesByDepth(report.DataTries))
+		assert.Equal(t, uint64(0), report.NumMissingNodes)
+
+		require.Equal(t, 2, len(report.LargestDataTries))
+		assert.Equal(t, addresses[1], report.LargestDataTries[0].Address)
+		assert.Equal(t, dataTrieRootHashes[1], report.LargestDataTries[0].RootHash)
+		assert.Equal(t, uint64(50), report.LargestDataTries[0].Statistics.NumLeafNodes)
+		assert.Equal(t, addresses[2], report.LargestDataTries[1].Address)
+		assert.Equal(t, uint64(20), report.LargestDataTries[1].Statistics.NumLeafNodes)
+
+		apiReport := report.ToAPIReport(rootHash, func(address []byte) string {
+			return string(address)
+		})
+		assert.Equal(t, string(addresses[1]), apiReport.LargestDataTries[0].Address)
+		assert.Equal(t, report.LargestDataTries[0].Statistics, apiReport.LargestDataTries[0].TrieNodesStatistics)
+		assert.Equal(t, report.MainTrie, apiReport.MainTrie)
+	})
+	t.Run("missing nodes should be counted", func(t *testing.T) {
+		t.Parallel()
+
+		tr, db, rootHash := createCommittedTrie(t, 100)
+		allHashes, err := tr.GetAllHashes()
+		require.Nil(t, err)
+		for _, hash := range allHashes {
+			if string(hash) != string(rootHash) {
+				require.Nil(t, db.Remove(hash))
+				break
+			}
+		}
+
+		report, err := trie.AnalyzeStorage(createArgsStorageAnalysis(rootHash, db))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), report.NumMissingNodes)
+		assert.True(t, report.MainTrie.NumNodes < uint64(len(allHashes)))
+	})
+}
+
+func TestStorageReport_CountDeadNodes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reachable nodes not tracked should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, db, rootHash := createCommittedTrie(t, 10)
+		report, err := trie.AnalyzeStorage(createArgsStorageAnalysis(rootHash, db))
+		require.Nil(t, err)
+
+		deadNodesReport, err := report.CountDeadNodes(testscommon.NewMemDbMock().RangeKeys)
+		assert.Nil(t, deadNodesReport)
+		assert.Equal(t, trie.ErrReachableNodesNotTracked, err)
+	})
+	t.Run("should count the nodes of the old root hash", func(t *testing.T) {
+		t.Parallel()
+
+		args := trie.GetDefaultTrieStorageManagerParameters()
+		storageManager, _ := trie.NewTrieStorageManager(args)
+		tr, oldRootHash := createTrieInStorage(t, storageManager, args, 100)
+		oldHashes, err := tr.GetAllHashes()
+		require.Nil(t, err)
+
+		require.Nil(t, tr.Update(args.Hasher.Compute("key0"), []byte("new value")))
+		require.Nil(t, tr.Commit())
+		newRootHash, _ := tr.RootHash()
+		newHashes, err := tr.GetAllHashes()
+		require.Nil(t, err)
+		require.NotEqual(t, oldRootHash, newRootHash)
+
+		db := args.MainStorer.(*testscommon.SnapshotPruningStorerMock)
+		require.Nil(t, db.Put([]byte("not a node hash"), []byte("value")))
+		notNodeKey := args.Hasher.Compute("hash sized key")
+		require.Nil(t, db.Put(notNodeKey, []byte("value with a hash sized key")))
+
+		analysisArgs := createArgsStorageAnalysis(newRootHash, db)
+		analysisArgs.TrackReachableNodes = true
+		report, err := trie.AnalyzeStorage(analysisArgs)
+		require.Nil(t, err)
+
+		deadNodesReport, err := report.CountDeadNodes(db.RangeKeys)
+		require.Nil(t, err)
+
+		newHashesMap := make(map[string]struct{})
+		for _, hash := range newHashes {
+			newHashesMap[string(hash)] = struct{}{}
+		}
+		numDeadNodes := 0
+		for _, hash := range oldHashes {
+			if _, found := newHashesMap[string(hash)]; !found {
+				numDeadNodes++
+			}
+		}
+		assert.True(t, numDeadNodes > 0)
+		assert.Equal(t, uint64(numDeadNodes), deadNodesReport.NumDeadNodes)
+		assert.Equal(t, uint64(len(newHashes)+numDeadNodes), deadNodesReport.NumStoredNodes)
+		assert.True(t, deadNodesReport.DeadNodesSize > 0)
+		assert.True(t, deadNodesReport.StoredNodesSize > deadNodesReport.DeadNodesSize)
+	})
+}
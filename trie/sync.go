@@ -60,6 +60,7 @@ type ArgTrieSyncer struct {
 	CheckNodesOnDisk          bool
 	TimeoutHandler            TimeoutHandler
 	LeavesChan                chan core.KeyValueHolder
+	SyncCheckpointHandler     SyncCheckpointHandler
 }
 
 // NewTrieSyncer creates a new instance of trieSyncer
@@ -119,6 +120,9 @@ func checkArguments(arg ArgTrieSyncer) error {
 	if check.IfNil(arg.TimeoutHandler) {
 		return ErrNilTimeoutHandler
 	}
+	if check.IfNil(arg.SyncCheckpointHandler) {
+		return ErrNilSyncCheckpointHandler
+	}
 	if arg.MaxHardCapForMissingNodes < 1 {
 		return fmt.Errorf("%w provided: %v", ErrInvalidMaxHardCapForMissingNodes, arg.MaxHardCapForMissingNodes)
 	}
@@ -156,6 +160,7 @@ func (ts *trieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
 
 		numUnResolved := ts.requestNodes()
 		if !shouldRetryAfterRequest && numUnResolved == 0 {
+			ts.setProgress(1)
 			return nil
 		}
 
@@ -0,0 +1,124 @@
+package trie
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const syncCheckpointKeyPrefix = "trieSyncCheckpoint_"
+const weightSize = 8
+
+// SyncCheckpoint holds the hashes that were not yet processed by a trie syncer, along with the fraction of the key
+// space covered by each of them. NumLeavesSent is not persisted, it holds the number of leaves the syncer has written
+// on its leaves channel before the checkpoint was created
+type SyncCheckpoint struct {
+	PendingHashes [][]byte
+	Weights       []float64
+	NumLeavesSent uint64
+}
+
+// ArgsSyncCheckpointer is the argument for the sync checkpointer
+type ArgsSyncCheckpointer struct {
+	Storer             common.BaseStorer
+	Marshaller         marshal.Marshalizer
+	CheckpointInterval time.Duration
+}
+
+type syncCheckpointer struct {
+	storer             common.BaseStorer
+	marshaller         marshal.Marshalizer
+	checkpointInterval time.Duration
+}
+
+// NewSyncCheckpointer creates a sync checkpoint handler which persists the checkpoints in the provided storer
+func NewSyncCheckpointer(args ArgsSyncCheckpointer) (*syncCheckpointer, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshalizer
+	}
+
+	return &syncCheckpointer{
+		storer:             args.Storer,
+		marshaller:         args.Marshaller,
+		checkpointInterval: args.CheckpointInterval,
+	}, nil
+}
+
+// SaveCheckpoint persists the pending hashes of the provided checkpoint, overwriting the previous one
+func (sc *syncCheckpointer) SaveCheckpoint(rootHash []byte, checkpoint *SyncCheckpoint) error {
+	if checkpoint == nil || len(checkpoint.PendingHashes) != len(checkpoint.Weights) {
+		return ErrInvalidSyncCheckpoint
+	}
+
+	entries := make([][]byte, 0, 2*len(checkpoint.PendingHashes))
+	for i, hash := range checkpoint.PendingHashes {
+		weight := make([]byte, weightSize)
+		binary.BigEndian.PutUint64(weight, math.Float64bits(checkpoint.Weights[i]))
+		entries = append(entries, hash, weight)
+	}
+
+	buff, err := sc.marshaller.Marshal(&batch.Batch{Data: entries})
+	if err != nil {
+		return err
+	}
+
+	return sc.storer.Put(syncCheckpointKey(rootHash), buff)
+}
+
+// GetCheckpoint returns the last checkpoint saved for the provided root hash
+func (sc *syncCheckpointer) GetCheckpoint(rootHash []byte) (*SyncCheckpoint, error) {
+	buff, err := sc.storer.Get(syncCheckpointKey(rootHash))
+	if err != nil || len(buff) == 0 {
+		return nil, ErrSyncCheckpointNotFound
+	}
+
+	entries := &batch.Batch{}
+	err = sc.marshaller.Unmarshal(entries, buff)
+	if err != nil || len(entries.Data)%2 != 0 {
+		return nil, fmt.Errorf("%w for root hash %x", ErrInvalidSyncCheckpoint, rootHash)
+	}
+
+	checkpoint := &SyncCheckpoint{
+		PendingHashes: make([][]byte, 0, len(entries.Data)/2),
+		Weights:       make([]float64, 0, len(entries.Data)/2),
+	}
+	for i := 0; i < len(entries.Data); i += 2 {
+		weight := entries.Data[i+1]
+		if len(weight) != weightSize {
+			return nil, fmt.Errorf("%w for root hash %x", ErrInvalidSyncCheckpoint, rootHash)
+		}
+
+		checkpoint.PendingHashes = append(checkpoint.PendingHashes, entries.Data[i])
+		checkpoint.Weights = append(checkpoint.Weights, math.Float64frombits(binary.BigEndian.Uint64(weight)))
+	}
+
+	return checkpoint, nil
+}
+
+// RemoveCheckpoint removes the checkpoint saved for the provided root hash
+func (sc *syncCheckpointer) RemoveCheckpoint(rootHash []byte) error {
+	return sc.storer.Remove(syncCheckpointKey(rootHash))
+}
+
+// CheckpointInterval returns the time between two consecutive checkpoints of the same trie sync
+func (sc *syncCheckpointer) CheckpointInterval() time.Duration {
+	return sc.checkpointInterval
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sc *syncCheckpointer) IsInterfaceNil() bool {
+	return sc == nil
+}
+
+func syncCheckpointKey(rootHash []byte) []byte {
+	return append([]byte(syncCheckpointKeyPrefix), rootHash...)
+}
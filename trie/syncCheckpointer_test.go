@@ -0,0 +1,198 @@
+package trie
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSyncCheckpointer() ArgsSyncCheckpointer {
+	return ArgsSyncCheckpointer{
+		Storer:             testscommon.NewMemDbMock(),
+		Marshaller:         &marshallerMock.MarshalizerMock{},
+		CheckpointInterval: time.Nanosecond,
+	}
+}
+
+func TestNewSyncCheckpointer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyncCheckpointer()
+		args.Storer = nil
+		sc, err := NewSyncCheckpointer(args)
+		assert.True(t, check.IfNil(sc))
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyncCheckpointer()
+		args.Marshaller = nil
+		sc, err := NewSyncCheckpointer(args)
+		assert.True(t, check.IfNil(sc))
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sc, err := NewSyncCheckpointer(createMockArgsSyncCheckpointer())
+		assert.False(t, check.IfNil(sc))
+		assert.Nil(t, err)
+		assert.Equal(t, time.Nanosecond, sc.CheckpointInterval())
+	})
+}
+
+func TestSyncCheckpointer_SaveGetRemove(t *testing.T) {
+	t.Parallel()
+
+	sc, _ := NewSyncCheckpointer(createMockArgsSyncCheckpointer())
+	rootHash := []byte("root hash")
+
+	checkpoint, err := sc.GetCheckpoint(rootHash)
+	assert.Nil(t, checkpoint)
+	assert.Equal(t, ErrSyncCheckpointNotFound, err)
+
+	err = sc.SaveCheckpoint(rootHash, &SyncCheckpoint{PendingHashes: [][]byte{[]byte("hash")}})
+	assert.Equal(t, ErrInvalidSyncCheckpoint, err)
+
+	saved := &SyncCheckpoint{
+		PendingHashes: [][]byte{[]byte("hash1"), []byte("hash2")},
+		Weights:       []float64{0.25, 0.0625},
+		NumLeavesSent: 37,
+	}
+	require.Nil(t, sc.SaveCheckpoint(rootHash, saved))
+
+	checkpoint, err = sc.GetCheckpoint(rootHash)
+	require.Nil(t, err)
+	assert.Equal(t, saved.PendingHashes, checkpoint.PendingHashes)
+	assert.Equal(t, saved.Weights, checkpoint.Weights)
+	assert.Zero(t, checkpoint.NumLeavesSent)
+
+	_, err = sc.GetCheckpoint([]byte("other root hash"))
+	assert.Equal(t, ErrSyncCheckpointNotFound, err)
+
+	require.Nil(t, sc.RemoveCheckpoint(rootHash))
+	_, err = sc.GetCheckpoint(rootHash)
+	assert.Equal(t, ErrSyncCheckpointNotFound, err)
+}
+
+func TestSyncCheckpointer_GetInvalidCheckpointShouldError(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSyncCheckpointer()
+	sc, _ := NewSyncCheckpointer(args)
+	rootHash := []byte("root hash")
+	_ = args.Storer.Put(syncCheckpointKey(rootHash), []byte("invalid"))
+
+	checkpoint, err := sc.GetCheckpoint(rootHash)
+	assert.Nil(t, checkpoint)
+	assert.True(t, errors.Is(err, ErrInvalidSyncCheckpoint))
+}
+
+func TestTrieSyncers_ResumeFromCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	t.Run("double list trie syncer", func(t *testing.T) {
+		t.Parallel()
+
+		testResumeFromCheckpoint(t, func(arg ArgTrieSyncer) (TrieSyncer, error) {
+			return NewDoubleListTrieSyncer(arg)
+		})
+	})
+	t.Run("depth first trie syncer", func(t *testing.T) {
+		t.Parallel()
+
+		testResumeFromCheckpoint(t, func(arg ArgTrieSyncer) (TrieSyncer, error) {
+			return NewDepthFirstTrieSyncer(arg)
+		})
+	})
+}
+
+func testResumeFromCheckpoint(t *testing.T, createSyncer func(arg ArgTrieSyncer) (TrieSyncer, error)) {
+	numKeysValues := 200
+	trSource, _ := createInMemoryTrie()
+	addDataToTrie(numKeysValues, trSource)
+	_ = trSource.Commit()
+	rootHash, _ := trSource.RootHash()
+
+	checkpointer, _ := NewSyncCheckpointer(createMockArgsSyncCheckpointer())
+
+	// the first sync is stopped by the request handler, right after a few request batches were answered. As the saved
+	// checkpoint is the one created an iteration before, enough batches are answered for it to skip some leaves
+	arg := createMockArgument(time.Minute)
+	arg.LeavesChan = make(chan core.KeyValueHolder, numKeysValues)
+	arg.MaxHardCapForMissingNodes = 10
+	arg.SyncCheckpointHandler = checkpointer
+	numAnsweredBatches := int32(10)
+	ctx, cancel := context.WithCancel(context.Background())
+	resolver := createRequesterResolver(trSource, arg.InterceptedNodes, nil)
+	numRequestedBatches := int32(0)
+	arg.RequestHandler = &testscommon.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			if len(hashes) == 0 {
+				return
+			}
+			if atomic.AddInt32(&numRequestedBatches, 1) > numAnsweredBatches {
+				cancel()
+				return
+			}
+			resolver.RequestTrieNodes(destShardID, hashes, topic)
+		},
+	}
+
+	ts, err := createSyncer(arg)
+	require.Nil(t, err)
+	err = ts.StartSyncing(rootHash, ctx)
+	cancel()
+	require.Equal(t, core.ErrContextClosing, err)
+
+	checkpoint, err := checkpointer.GetCheckpoint(rootHash)
+	require.Nil(t, err)
+	assert.NotEmpty(t, checkpoint.PendingHashes)
+	assert.True(t, ts.Progress() > 0 && ts.Progress() < 1)
+	numNodesFirstSync := ts.NumTrieNodes()
+
+	// the second sync resumes from the saved checkpoint, using the same storage
+	resumedArg := arg
+	resumedArg.InterceptedNodes = testscommon.NewCacherMock()
+	resumedArg.LeavesChan = make(chan core.KeyValueHolder, numKeysValues)
+	requestedRoot := false
+	resumedResolver := createRequesterResolver(trSource, resumedArg.InterceptedNodes, nil)
+	resumedArg.RequestHandler = &testscommon.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			requestedRoot = requestedRoot || hashInList(rootHash, hashes)
+			resumedResolver.RequestTrieNodes(destShardID, hashes, topic)
+		},
+	}
+
+	ts, err = createSyncer(resumedArg)
+	require.Nil(t, err)
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	err = ts.StartSyncing(rootHash, ctx)
+	require.Nil(t, err)
+
+	assert.False(t, requestedRoot)
+	assert.True(t, ts.NumLeaves() < uint64(numKeysValues))
+	assert.Equal(t, float64(1), ts.Progress())
+	assert.True(t, numNodesFirstSync > 0)
+
+	_, err = checkpointer.GetCheckpoint(rootHash)
+	assert.Equal(t, ErrSyncCheckpointNotFound, err)
+
+	result, err := CheckIntegrity(rootHash, resumedArg.DB, marshalizer, hasherMock)
+	require.Nil(t, err)
+	assert.True(t, result.IsValid())
+}
@@ -19,6 +19,7 @@ type TrieSyncer interface {
 	NumBytes() uint64
 	NumTrieNodes() uint64
 	Duration() time.Duration
+	Progress() float64
 	IsInterfaceNil() bool
 }
 
@@ -0,0 +1,128 @@
+package trie
+
+import (
+	"time"
+)
+
+// syncProgressTracker estimates the progress of a trie sync as the fraction of the key space already synced. Each
+// pending hash covers a fraction of the key space, which is evenly split between the children of the node when the
+// node gets processed. As the keys of the accounts tries are hashes, the leaves are evenly spread in the key space.
+type syncProgressTracker struct {
+	weights   map[string]float64
+	completed float64
+}
+
+func newSyncProgressTracker() *syncProgressTracker {
+	return &syncProgressTracker{
+		weights: make(map[string]float64),
+	}
+}
+
+func (spt *syncProgressTracker) addPendingHash(hash string, weight float64) {
+	spt.weights[hash] += weight
+}
+
+// replaceWithChildren moves the weight of the processed node to its children. A node without children completes
+// the fraction of the key space it covers
+func (spt *syncProgressTracker) replaceWithChildren(hash string, childrenHashes []string) {
+	weight := spt.weights[hash]
+	delete(spt.weights, hash)
+
+	if len(childrenHashes) == 0 {
+		spt.completed += weight
+		return
+	}
+
+	childWeight := weight / float64(len(childrenHashes))
+	for _, childHash := range childrenHashes {
+		spt.weights[childHash] += childWeight
+	}
+}
+
+func (spt *syncProgressTracker) weight(hash string) float64 {
+	return spt.weights[hash]
+}
+
+func (spt *syncProgressTracker) progress() float64 {
+	if spt.completed > 1 {
+		return 1
+	}
+
+	return spt.completed
+}
+
+func (spt *syncProgressTracker) setResumedProgress(pendingWeights []float64) {
+	total := float64(0)
+	for _, weight := range pendingWeights {
+		total += weight
+	}
+
+	spt.completed = 1 - total
+	if spt.completed < 0 {
+		spt.completed = 0
+	}
+}
+
+func hashesOfNodes(nodes []node, hashes [][]byte) []string {
+	result := make([]string, 0, len(nodes)+len(hashes))
+	for _, n := range nodes {
+		result = append(result, string(n.getHash()))
+	}
+	for _, hash := range hashes {
+		result = append(result, string(hash))
+	}
+
+	return result
+}
+
+// syncCheckpointSaver periodically saves the checkpoints of a trie sync. The saved checkpoint is the one created at the
+// previous interval, so all the nodes processed before it had enough time to be written by the batched persisters.
+type syncCheckpointSaver struct {
+	handler      SyncCheckpointHandler
+	rootHash     []byte
+	lastCreation time.Time
+	previous     *SyncCheckpoint
+}
+
+func newSyncCheckpointSaver(handler SyncCheckpointHandler, rootHash []byte) *syncCheckpointSaver {
+	return &syncCheckpointSaver{
+		handler:      handler,
+		rootHash:     rootHash,
+		lastCreation: time.Now(),
+	}
+}
+
+func (scs *syncCheckpointSaver) getCheckpoint() (*SyncCheckpoint, bool) {
+	checkpoint, err := scs.handler.GetCheckpoint(scs.rootHash)
+	if err != nil || len(checkpoint.PendingHashes) == 0 || len(checkpoint.PendingHashes) != len(checkpoint.Weights) {
+		return nil, false
+	}
+
+	log.Debug("resuming trie sync from checkpoint", "root hash", scs.rootHash, "num pending hashes", len(checkpoint.PendingHashes))
+
+	return checkpoint, true
+}
+
+func (scs *syncCheckpointSaver) saveIfNeeded(createCheckpoint func() *SyncCheckpoint) {
+	interval := scs.handler.CheckpointInterval()
+	if interval <= 0 || time.Since(scs.lastCreation) < interval {
+		return
+	}
+
+	if scs.previous != nil {
+		err := scs.handler.SaveCheckpoint(scs.rootHash, scs.previous)
+		if err != nil {
+			log.Warn("could not save the trie sync checkpoint", "root hash", scs.rootHash, "error", err)
+		}
+	}
+
+	scs.previous = createCheckpoint()
+	scs.lastCreation = time.Now()
+}
+
+func (scs *syncCheckpointSaver) remove() {
+	err := scs.handler.RemoveCheckpoint(scs.rootHash)
+	if err != nil {
+		log.Debug("could not remove the trie sync checkpoint", "root hash", scs.rootHash, "error", err)
+	}
+}
@@ -0,0 +1,30 @@
+package trie
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncProgressTracker(t *testing.T) {
+	t.Parallel()
+
+	spt := newSyncProgressTracker()
+	spt.addPendingHash("root", 1)
+	assert.Equal(t, float64(0), spt.progress())
+
+	spt.replaceWithChildren("root", []string{"a", "b", "c", "d"})
+	assert.Equal(t, 0.25, spt.weight("a"))
+	assert.Equal(t, float64(0), spt.progress())
+
+	spt.replaceWithChildren("a", nil)
+	assert.Equal(t, 0.25, spt.progress())
+
+	spt.replaceWithChildren("b", []string{"e", "f"})
+	spt.replaceWithChildren("e", nil)
+	assert.Equal(t, 0.375, spt.progress())
+
+	resumed := newSyncProgressTracker()
+	resumed.setResumedProgress([]float64{spt.weight("c"), spt.weight("d"), spt.weight("f")})
+	assert.Equal(t, 0.375, resumed.progress())
+}
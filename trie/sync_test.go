@@ -42,6 +42,7 @@ func createMockArgument(timeout time.Duration) ArgTrieSyncer {
 		TimeoutHandler:            testscommon.NewTimeoutHandlerMock(timeout),
 		MaxHardCapForMissingNodes: 500,
 		LeavesChan:                make(chan core.KeyValueHolder, 100),
+		SyncCheckpointHandler:     NewDisabledSyncCheckpointer(),
 	}
 }
 
@@ -136,6 +137,17 @@ func TestNewTrieSyncer(t *testing.T) {
 		assert.True(t, errors.Is(err, ErrNilTimeoutHandler))
 	})
 
+	t.Run("nil sync checkpoint handler", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgument(time.Minute)
+		arg.SyncCheckpointHandler = nil
+
+		ts, err := NewTrieSyncer(arg)
+		assert.True(t, check.IfNil(ts))
+		assert.True(t, errors.Is(err, ErrNilSyncCheckpointHandler))
+	})
+
 	t.Run("invalid max hard capacity for missing nodes", func(t *testing.T) {
 		t.Parallel()
 
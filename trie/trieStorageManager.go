@@ -31,27 +31,31 @@ type trieStorageManager struct {
 	idleProvider          IdleNodeProvider
 	identifier            string
 	statsCollector        common.StateStatisticsHandler
+	snapshotTracker       *incrementalSnapshotTracker
 }
 
 type snapshotsQueueEntry struct {
-	address          string
-	rootHash         []byte
-	mainTrieRootHash []byte
-	iteratorChannels *common.TrieIteratorChannels
-	missingNodesChan chan []byte
-	stats            common.SnapshotStatisticsHandler
-	epoch            uint32
+	address             string
+	rootHash            []byte
+	mainTrieRootHash    []byte
+	iteratorChannels    *common.TrieIteratorChannels
+	missingNodesChan    chan []byte
+	stats               common.SnapshotStatisticsHandler
+	epoch               uint32
+	isIncremental       bool
+	compactionBaseEpoch core.OptionalUint32
 }
 
 // NewTrieStorageManagerArgs holds the arguments needed for creating a new trieStorageManager
 type NewTrieStorageManagerArgs struct {
-	MainStorer     common.BaseStorer
-	Marshalizer    marshal.Marshalizer
-	Hasher         hashing.Hasher
-	GeneralConfig  config.TrieStorageManagerConfig
-	IdleProvider   IdleNodeProvider
-	Identifier     string
-	StatsCollector common.StateStatisticsHandler
+	MainStorer                  common.BaseStorer
+	Marshalizer                 marshal.Marshalizer
+	Hasher                      hashing.Hasher
+	GeneralConfig               config.TrieStorageManagerConfig
+	IdleProvider                IdleNodeProvider
+	Identifier                  string
+	StatsCollector              common.StateStatisticsHandler
+	IncrementalSnapshotsEnabled bool
 }
 
 // NewTrieStorageManager creates a new instance of trieStorageManager
@@ -74,6 +78,12 @@ func NewTrieStorageManager(args NewTrieStorageManagerArgs) (*trieStorageManager,
 	if check.IfNil(args.StatsCollector) {
 		return nil, storage.ErrNilStatsCollector
 	}
+	if args.IncrementalSnapshotsEnabled {
+		err := checkIncrementalSnapshotsConfig(args.GeneralConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
@@ -86,6 +96,10 @@ func NewTrieStorageManager(args NewTrieStorageManagerArgs) (*trieStorageManager,
 		idleProvider:       args.IdleProvider,
 		identifier:         args.Identifier,
 		statsCollector:     args.StatsCollector,
+		snapshotTracker: newIncrementalSnapshotTracker(
+			args.IncrementalSnapshotsEnabled,
+			args.GeneralConfig.MaxTrackedHashesForIncrementalSnapshots,
+		),
 	}
 	goRoutinesThrottler, err := throttler.NewNumGoRoutinesThrottler(int32(args.GeneralConfig.SnapshotsGoroutineNum))
 	if err != nil {
@@ -96,6 +110,14 @@ func NewTrieStorageManager(args NewTrieStorageManagerArgs) (*trieStorageManager,
 	return tsm, nil
 }
 
+func checkIncrementalSnapshotsConfig(generalConfig config.TrieStorageManagerConfig) error {
+	if generalConfig.MaxTrackedHashesForIncrementalSnapshots == 0 {
+		return fmt.Errorf("%w, MaxTrackedHashesForIncrementalSnapshots should be greater than 0", ErrInvalidIncrementalSnapshotsConfig)
+	}
+
+	return nil
+}
+
 func (tsm *trieStorageManager) doSnapshot(ctx context.Context, msh marshal.Marshalizer, hsh hashing.Hasher, goRoutinesThrottler core.Throttler) {
 	tsm.doProcessLoop(ctx, msh, hsh, goRoutinesThrottler)
 	tsm.cleanupChans()
@@ -215,7 +237,14 @@ func (tsm *trieStorageManager) Put(key []byte, val []byte) error {
 		return core.ErrContextClosing
 	}
 
-	return tsm.mainStorer.Put(key, val)
+	err := tsm.mainStorer.Put(key, val)
+	if err != nil {
+		return err
+	}
+
+	tsm.snapshotTracker.addHash(key)
+
+	return nil
 }
 
 // PutInEpoch adds the given value to the main storer in the specified epoch
@@ -330,13 +359,15 @@ func (tsm *trieStorageManager) TakeSnapshot(
 	tsm.EnterPruningBufferingMode()
 
 	snapshotEntry := &snapshotsQueueEntry{
-		address:          address,
-		rootHash:         rootHash,
-		mainTrieRootHash: mainTrieRootHash,
-		iteratorChannels: iteratorChannels,
-		missingNodesChan: missingNodesChan,
-		stats:            stats,
-		epoch:            epoch,
+		address:             address,
+		rootHash:            rootHash,
+		mainTrieRootHash:    mainTrieRootHash,
+		iteratorChannels:    iteratorChannels,
+		missingNodesChan:    missingNodesChan,
+		stats:               stats,
+		epoch:               epoch,
+		isIncremental:       tsm.snapshotTracker.isIncrementalSnapshotInProgress(),
+		compactionBaseEpoch: tsm.snapshotTracker.getCompactionBaseEpoch(),
 	}
 	select {
 	case tsm.snapshotReq <- snapshotEntry:
@@ -372,7 +403,36 @@ func (tsm *trieStorageManager) takeSnapshot(snapshotEntry *snapshotsQueueEntry,
 		return
 	}
 
-	newRoot, err := newSnapshotNode(stsm, msh, hsh, snapshotEntry.rootHash, snapshotEntry.missingNodesChan)
+	var snapshotDb common.TrieStorageInteractor = stsm
+	if snapshotEntry.compactionBaseEpoch.HasValue {
+		sctsm, errCreate := newSnapshotsCompactionTrieStorageManager(stsm, snapshotEntry.compactionBaseEpoch.Value)
+		if errCreate != nil {
+			snapshotEntry.iteratorChannels.ErrChan.WriteInChanNonBlocking(errCreate)
+			log.Error("takeSnapshot: trie storage manager: newSnapshotsCompactionTrieStorageManager",
+				"rootHash", snapshotEntry.rootHash,
+				"main trie rootHash", snapshotEntry.mainTrieRootHash,
+				"err", errCreate.Error())
+			return
+		}
+		if !sctsm.shouldSnapshotNode(snapshotEntry.rootHash) {
+			log.Trace("trie already saved by the last full snapshot", "rootHash", snapshotEntry.rootHash)
+			return
+		}
+
+		snapshotDb = sctsm
+	} else if snapshotEntry.isIncremental {
+		if !tsm.snapshotTracker.isModified(snapshotEntry.rootHash) {
+			log.Trace("trie not modified since the previous snapshot", "rootHash", snapshotEntry.rootHash)
+			return
+		}
+
+		snapshotDb = &incrementalSnapshotTrieStorageManager{
+			snapshotTrieStorageManager: stsm,
+			tracker:                    tsm.snapshotTracker,
+		}
+	}
+
+	newRoot, err := newSnapshotNode(snapshotDb, msh, hsh, snapshotEntry.rootHash, snapshotEntry.missingNodesChan)
 	if err != nil {
 		snapshotEntry.iteratorChannels.ErrChan.WriteInChanNonBlocking(err)
 		treatSnapshotError(err,
@@ -384,7 +444,7 @@ func (tsm *trieStorageManager) takeSnapshot(snapshotEntry *snapshotsQueueEntry,
 	}
 
 	stats := statistics.NewTrieStatistics()
-	err = newRoot.commitSnapshot(stsm, snapshotEntry.iteratorChannels.LeavesChan, snapshotEntry.missingNodesChan, ctx, stats, tsm.idleProvider, rootDepthLevel)
+	err = newRoot.commitSnapshot(snapshotDb, snapshotEntry.iteratorChannels.LeavesChan, snapshotEntry.missingNodesChan, ctx, stats, tsm.idleProvider, rootDepthLevel)
 	if err != nil {
 		snapshotEntry.iteratorChannels.ErrChan.WriteInChanNonBlocking(err)
 		treatSnapshotError(err,
@@ -450,6 +510,8 @@ func (tsm *trieStorageManager) Remove(hash []byte) error {
 	tsm.storageOperationMutex.Lock()
 	defer tsm.storageOperationMutex.Unlock()
 
+	tsm.snapshotTracker.removeHash(hash)
+
 	storer, ok := tsm.mainStorer.(snapshotPruningStorer)
 	if !ok {
 		return tsm.mainStorer.Remove(hash)
@@ -532,6 +594,74 @@ func (tsm *trieStorageManager) ShouldTakeSnapshot() bool {
 	return true
 }
 
+// PrepareSnapshot starts tracking the nodes committed from now on, as they will be part of the next snapshot. It returns
+// true if the snapshot about to be taken in the provided epoch will only save the nodes committed since the previous one
+func (tsm *trieStorageManager) PrepareSnapshot(epoch uint32) bool {
+	return tsm.snapshotTracker.prepareSnapshot(epoch)
+}
+
+// FinalizeSnapshot marks the end of the snapshot started with PrepareSnapshot
+func (tsm *trieStorageManager) FinalizeSnapshot(isSuccessful bool) {
+	tsm.snapshotTracker.finalizeSnapshot(isSuccessful)
+}
+
+// PrepareSnapshotsCompaction returns true if the incremental snapshots taken since the last full snapshot can be
+// compacted into the provided epoch. If so, the snapshots taken until FinalizeSnapshotsCompaction is called will copy
+// into that epoch all the nodes which were not saved by the last full snapshot
+func (tsm *trieStorageManager) PrepareSnapshotsCompaction(epoch uint32) bool {
+	_, ok := tsm.mainStorer.(snapshotsCompactionStorer)
+	if !ok {
+		log.Debug("the incremental snapshots can not be compacted", "storer type", fmt.Sprintf("%T", tsm.mainStorer))
+		return false
+	}
+
+	_, canCompact := tsm.snapshotTracker.prepareCompaction(epoch)
+	return canCompact
+}
+
+// FinalizeSnapshotsCompaction marks the end of the compaction started with PrepareSnapshotsCompaction. After a
+// successful compaction, the delta epochs between the last full snapshot and the compacted one are released: they are
+// no longer marked as active and, except for the recent epochs which might still hold the nodes of the not yet final
+// roots, their content is removed
+func (tsm *trieStorageManager) FinalizeSnapshotsCompaction(isSuccessful bool) error {
+	baseEpoch, compactedEpoch, wasCompacting := tsm.snapshotTracker.finalizeCompaction()
+	if !wasCompacting || !isSuccessful {
+		return nil
+	}
+
+	tsm.storageOperationMutex.Lock()
+	defer tsm.storageOperationMutex.Unlock()
+
+	if tsm.closed {
+		return core.ErrContextClosing
+	}
+
+	storer, ok := tsm.mainStorer.(snapshotsCompactionStorer)
+	if !ok {
+		return fmt.Errorf("invalid storer type for FinalizeSnapshotsCompaction")
+	}
+
+	for epoch := baseEpoch + 1; epoch < compactedEpoch; epoch++ {
+		if epoch+numRecentEpochsNotCollected > compactedEpoch {
+			err := storer.RemoveFromEpoch([]byte(common.ActiveDBKey), epoch)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		err := storer.ClearEpoch(epoch)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("incremental snapshots compacted", "last full snapshot epoch", baseEpoch, "compacted epoch", compactedEpoch)
+
+	return nil
+}
+
 // IsSnapshotSupported returns true as the snapshotting process is supported by the current implementation
 func (tsm *trieStorageManager) IsSnapshotSupported() bool {
 	return true
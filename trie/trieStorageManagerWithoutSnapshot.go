@@ -57,6 +57,25 @@ func (tsm *trieStorageManagerWithoutSnapshot) ShouldTakeSnapshot() bool {
 	return false
 }
 
+// PrepareSnapshot returns false for this implementation
+func (tsm *trieStorageManagerWithoutSnapshot) PrepareSnapshot(_ uint32) bool {
+	return false
+}
+
+// FinalizeSnapshot does nothing for this implementation
+func (tsm *trieStorageManagerWithoutSnapshot) FinalizeSnapshot(_ bool) {
+}
+
+// PrepareSnapshotsCompaction returns false for this implementation
+func (tsm *trieStorageManagerWithoutSnapshot) PrepareSnapshotsCompaction(_ uint32) bool {
+	return false
+}
+
+// FinalizeSnapshotsCompaction does nothing for this implementation
+func (tsm *trieStorageManagerWithoutSnapshot) FinalizeSnapshotsCompaction(_ bool) error {
+	return nil
+}
+
 // IsSnapshotSupported returns false as the snapshotting process is not supported by the current implementation
 func (tsm *trieStorageManagerWithoutSnapshot) IsSnapshotSupported() bool {
 	return false
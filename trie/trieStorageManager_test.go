@@ -2,6 +2,7 @@ package trie_test
 
 import (
 	errorsGo "errors"
+	"fmt"
 	"strings"
 	"sync"
 	"testing"
@@ -12,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common/errChan"
 	storageMx "github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
 	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
@@ -80,6 +82,16 @@ func TestNewTrieStorageManager(t *testing.T) {
 		assert.Nil(t, ts)
 		assert.Error(t, err)
 	})
+	t.Run("invalid incremental snapshots config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := trie.GetDefaultTrieStorageManagerParameters()
+		args.IncrementalSnapshotsEnabled = true
+		args.GeneralConfig.MaxTrackedHashesForIncrementalSnapshots = 0
+		ts, err := trie.NewTrieStorageManager(args)
+		assert.Nil(t, ts)
+		assert.True(t, errorsGo.Is(err, trie.ErrInvalidIncrementalSnapshotsConfig))
+	})
 	t.Run("invalid identifier", func(t *testing.T) {
 		t.Parallel()
 
@@ -727,3 +739,206 @@ func TestTrieStorageManager_IsSnapshotSupportedShouldReturnTrue(t *testing.T) {
 
 	assert.True(t, ts.IsSnapshotSupported())
 }
+
+func TestTrieStorageManager_IncrementalSnapshot(t *testing.T) {
+	t.Parallel()
+
+	mutSnapshotKeys := sync.Mutex{}
+	snapshotKeys := make(map[string]struct{})
+	storer := &trieMock.SnapshotPruningStorerStub{
+		MemDbMock: testscommon.NewMemDbMock(),
+	}
+	storer.GetFromOldEpochsWithoutAddingToCacheCalled = func(key []byte) ([]byte, core.OptionalUint32, error) {
+		val, err := storer.Get(key)
+		return val, core.OptionalUint32{}, err
+	}
+	storer.PutInEpochWithoutCacheCalled = func(key []byte, data []byte, _ uint32) error {
+		mutSnapshotKeys.Lock()
+		snapshotKeys[string(key)] = struct{}{}
+		mutSnapshotKeys.Unlock()
+
+		return nil
+	}
+
+	args := trie.GetDefaultTrieStorageManagerParameters()
+	args.MainStorer = storer
+	args.IncrementalSnapshotsEnabled = true
+	args.GeneralConfig.MaxTrackedHashesForIncrementalSnapshots = 1000
+	tsm, _ := trie.NewTrieStorageManager(args)
+	tr, _ := trie.NewTrie(tsm, args.Marshalizer, args.Hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+
+	takeSnapshot := func(rootHash []byte) map[string]struct{} {
+		mutSnapshotKeys.Lock()
+		snapshotKeys = make(map[string]struct{})
+		mutSnapshotKeys.Unlock()
+
+		iteratorChannels := &common.TrieIteratorChannels{
+			LeavesChan: make(chan core.KeyValueHolder),
+			ErrChan:    errChan.NewErrChanWrapper(),
+		}
+		tsm.TakeSnapshot("", rootHash, rootHash, iteratorChannels, make(chan []byte, 10), &trieMock.MockStatistics{}, 1)
+		for range iteratorChannels.LeavesChan {
+		}
+		require.Nil(t, iteratorChannels.ErrChan.ReadFromChanNonBlocking())
+
+		mutSnapshotKeys.Lock()
+		defer mutSnapshotKeys.Unlock()
+
+		return snapshotKeys
+	}
+
+	for i := 0; i < 100; i++ {
+		_ = tr.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.RootHash()
+	allHashes, _ := tr.GetAllHashes()
+
+	// the first snapshot is always a full one
+	require.False(t, tsm.PrepareSnapshot(1))
+	keys := takeSnapshot(rootHash)
+	tsm.FinalizeSnapshot(true)
+	assert.Equal(t, len(allHashes), len(keys))
+
+	_ = tr.Update([]byte("key1"), []byte("new value"))
+	require.Nil(t, tr.Commit())
+	newRootHash, _ := tr.RootHash()
+
+	require.True(t, tsm.PrepareSnapshot(2))
+	keys = takeSnapshot(newRootHash)
+	tsm.FinalizeSnapshot(true)
+	assert.True(t, len(keys) > 0)
+	assert.True(t, len(keys) < len(allHashes))
+	_, containsRoot := keys[string(newRootHash)]
+	assert.True(t, containsRoot)
+
+	// an unchanged trie should not be copied again
+	require.True(t, tsm.PrepareSnapshot(3))
+	keys = takeSnapshot(newRootHash)
+	tsm.FinalizeSnapshot(true)
+	assert.Equal(t, 0, len(keys))
+
+	// after a failed snapshot, the whole trie is copied
+	require.True(t, tsm.PrepareSnapshot(4))
+	tsm.FinalizeSnapshot(false)
+	require.False(t, tsm.PrepareSnapshot(5))
+	keys = takeSnapshot(newRootHash)
+	tsm.FinalizeSnapshot(true)
+	assert.Equal(t, len(allHashes), len(keys))
+}
+
+func TestTrieStorageManager_SnapshotsCompaction(t *testing.T) {
+	t.Parallel()
+
+	mutEpochs := sync.Mutex{}
+	keysInEpochs := make(map[uint32]map[string]struct{})
+	clearedEpochs := make([]uint32, 0)
+	epochsWithActiveDBKeyRemoved := make([]uint32, 0)
+	storer := &trieMock.SnapshotPruningStorerStub{
+		MemDbMock: testscommon.NewMemDbMock(),
+	}
+	storer.GetFromOldEpochsWithoutAddingToCacheCalled = func(key []byte) ([]byte, core.OptionalUint32, error) {
+		val, err := storer.Get(key)
+		return val, core.OptionalUint32{}, err
+	}
+	storer.PutInEpochWithoutCacheCalled = func(key []byte, data []byte, epoch uint32) error {
+		mutEpochs.Lock()
+		defer mutEpochs.Unlock()
+
+		if keysInEpochs[epoch] == nil {
+			keysInEpochs[epoch] = make(map[string]struct{})
+		}
+		keysInEpochs[epoch][string(key)] = struct{}{}
+
+		return nil
+	}
+	storer.GetFromEpochWithoutCacheCalled = func(key []byte, epoch uint32) ([]byte, error) {
+		mutEpochs.Lock()
+		defer mutEpochs.Unlock()
+
+		_, found := keysInEpochs[epoch][string(key)]
+		if !found {
+			return nil, errorsGo.New("key not found")
+		}
+
+		return storer.Get(key)
+	}
+	storer.RemoveFromEpochCalled = func(key []byte, epoch uint32) error {
+		assert.Equal(t, []byte(common.ActiveDBKey), key)
+		epochsWithActiveDBKeyRemoved = append(epochsWithActiveDBKeyRemoved, epoch)
+		return nil
+	}
+	storer.ClearEpochCalled = func(epoch uint32) error {
+		clearedEpochs = append(clearedEpochs, epoch)
+		return nil
+	}
+
+	args := trie.GetDefaultTrieStorageManagerParameters()
+	args.MainStorer = storer
+	args.IncrementalSnapshotsEnabled = true
+	args.GeneralConfig.MaxTrackedHashesForIncrementalSnapshots = 1000
+	tsm, _ := trie.NewTrieStorageManager(args)
+	tr, _ := trie.NewTrie(tsm, args.Marshalizer, args.Hasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+
+	takeSnapshot := func(rootHash []byte, epoch uint32) {
+		iteratorChannels := &common.TrieIteratorChannels{
+			LeavesChan: make(chan core.KeyValueHolder),
+			ErrChan:    errChan.NewErrChanWrapper(),
+		}
+		tsm.TakeSnapshot("", rootHash, rootHash, iteratorChannels, make(chan []byte, 10), &trieMock.MockStatistics{}, epoch)
+		for range iteratorChannels.LeavesChan {
+		}
+		require.Nil(t, iteratorChannels.ErrChan.ReadFromChanNonBlocking())
+	}
+
+	for i := 0; i < 100; i++ {
+		_ = tr.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.RootHash()
+	allHashes, _ := tr.GetAllHashes()
+
+	// nothing to compact before the first full snapshot
+	require.False(t, tsm.PrepareSnapshotsCompaction(1))
+
+	require.False(t, tsm.PrepareSnapshot(1))
+	takeSnapshot(rootHash, 1)
+	tsm.FinalizeSnapshot(true)
+	require.False(t, tsm.PrepareSnapshotsCompaction(1))
+
+	_ = tr.Update([]byte("key1"), []byte("new value"))
+	require.Nil(t, tr.Commit())
+	require.True(t, tsm.PrepareSnapshot(2))
+	rootHash, _ = tr.RootHash()
+	takeSnapshot(rootHash, 2)
+	tsm.FinalizeSnapshot(true)
+
+	_ = tr.Update([]byte("key2"), []byte("new value"))
+	require.Nil(t, tr.Commit())
+	require.True(t, tsm.PrepareSnapshot(4))
+	rootHash, _ = tr.RootHash()
+	takeSnapshot(rootHash, 4)
+	tsm.FinalizeSnapshot(true)
+	numKeysInIncrementalSnapshot := len(keysInEpochs[4])
+
+	require.True(t, tsm.PrepareSnapshotsCompaction(4))
+	takeSnapshot(rootHash, 4)
+	require.Nil(t, tsm.FinalizeSnapshotsCompaction(true))
+
+	// the nodes saved by the snapshot of epoch 2 which are still reachable were copied in the compacted epoch
+	newHashes, _ := tr.GetAllHashes()
+	assert.True(t, len(keysInEpochs[4]) > numKeysInIncrementalSnapshot)
+	assert.True(t, len(keysInEpochs[4]) < len(allHashes))
+	for _, hash := range newHashes {
+		_, isInFullSnapshot := keysInEpochs[1][string(hash)]
+		_, isInCompactedEpoch := keysInEpochs[4][string(hash)]
+		assert.True(t, isInFullSnapshot || isInCompactedEpoch)
+	}
+	assert.Equal(t, []uint32{2}, clearedEpochs)
+	assert.Equal(t, []uint32{3}, epochsWithActiveDBKeyRemoved)
+
+	// an unsuccessful compaction does not release any epoch
+	require.True(t, tsm.PrepareSnapshotsCompaction(5))
+	require.Nil(t, tsm.FinalizeSnapshotsCompaction(false))
+	assert.Equal(t, []uint32{2}, clearedEpochs)
+}
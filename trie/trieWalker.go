@@ -0,0 +1,78 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// NodeHandler is called for every node reached while walking a trie. The leaf value is provided only for leaf nodes
+type NodeHandler func(hash []byte, encodedNode []byte, leafValue []byte) error
+
+// WalkNodes loads every node of the trie with the provided root hash from the storer, in depth first order, and
+// calls the handler for each of them. Unlike CheckIntegrity, the walk stops on the first missing or corrupted node
+func WalkNodes(
+	rootHash []byte,
+	db common.BaseStorer,
+	marshaller marshal.Marshalizer,
+	hasher hashing.Hasher,
+	handler NodeHandler,
+) error {
+	if len(rootHash) == 0 {
+		return ErrNilRootHash
+	}
+	if check.IfNil(db) {
+		return ErrNilDatabase
+	}
+	if check.IfNil(marshaller) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return ErrNilHasher
+	}
+	if handler == nil {
+		return ErrNilNodeHandler
+	}
+
+	stack := [][]byte{rootHash}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		encodedNode, err := db.Get(hash)
+		if err != nil || len(encodedNode) == 0 {
+			return fmt.Errorf("%w, hash: %x", ErrNodeNotFound, hash)
+		}
+
+		decodedNode, err := decodeNode(encodedNode, marshaller, hasher)
+		if err != nil || !bytes.Equal(hasher.Compute(string(encodedNode)), hash) {
+			return fmt.Errorf("%w, hash: %x", ErrInvalidNode, hash)
+		}
+
+		switch n := decodedNode.(type) {
+		case *branchNode:
+			err = handler(hash, encodedNode, nil)
+			for i := len(n.EncodedChildren) - 1; i >= 0; i-- {
+				if len(n.EncodedChildren[i]) > 0 {
+					stack = append(stack, n.EncodedChildren[i])
+				}
+			}
+		case *extensionNode:
+			err = handler(hash, encodedNode, nil)
+			stack = append(stack, n.EncodedChild)
+		case *leafNode:
+			err = handler(hash, encodedNode, n.Value)
+		default:
+			err = ErrWrongTypeAssertion
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
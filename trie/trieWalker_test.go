@@ -0,0 +1,83 @@
+package trie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalkNodes(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := &testscommon.KeccakMock{}
+	handler := func(_ []byte, _ []byte, _ []byte) error { return nil }
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		db := testscommon.CreateMemUnit()
+		assert.Equal(t, trie.ErrNilRootHash, trie.WalkNodes(nil, db, marshaller, hasher, handler))
+		assert.Equal(t, trie.ErrNilDatabase, trie.WalkNodes([]byte("root"), nil, marshaller, hasher, handler))
+		assert.Equal(t, trie.ErrNilMarshalizer, trie.WalkNodes([]byte("root"), db, nil, hasher, handler))
+		assert.Equal(t, trie.ErrNilHasher, trie.WalkNodes([]byte("root"), db, marshaller, nil, handler))
+		assert.Equal(t, trie.ErrNilNodeHandler, trie.WalkNodes([]byte("root"), db, marshaller, hasher, nil))
+	})
+	t.Run("should call the handler for all nodes", func(t *testing.T) {
+		t.Parallel()
+
+		numLeaves := 100
+		tr, db, rootHash := createCommittedTrie(t, numLeaves)
+		allHashes, err := tr.GetAllHashes()
+		require.Nil(t, err)
+
+		numNodes := 0
+		numLeafValues := 0
+		err = trie.WalkNodes(rootHash, db, marshaller, hasher, func(hash []byte, encodedNode []byte, leafValue []byte) error {
+			numNodes++
+			if len(leafValue) > 0 {
+				numLeafValues++
+			}
+			assert.Equal(t, hash, hasher.Compute(string(encodedNode)))
+
+			return nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, len(allHashes), numNodes)
+		assert.Equal(t, numLeaves, numLeafValues)
+	})
+	t.Run("missing node should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, db, _ := createCommittedTrie(t, 10)
+		err := trie.WalkNodes([]byte("missing root hash"), db, marshaller, hasher, handler)
+		assert.True(t, errors.Is(err, trie.ErrNodeNotFound))
+	})
+	t.Run("corrupted node should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, db, rootHash := createCommittedTrie(t, 10)
+		require.Nil(t, db.Put(rootHash, []byte("corrupted")))
+
+		err := trie.WalkNodes(rootHash, db, marshaller, hasher, handler)
+		assert.True(t, errors.Is(err, trie.ErrInvalidNode))
+	})
+	t.Run("handler error should stop the walk", func(t *testing.T) {
+		t.Parallel()
+
+		_, db, rootHash := createCommittedTrie(t, 10)
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		err := trie.WalkNodes(rootHash, db, marshaller, hasher, func(_ []byte, _ []byte, _ []byte) error {
+			numCalls++
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+}
@@ -158,6 +158,7 @@ func (a *accountDBSyncersContainerFactory) createUserAccountsSyncer(shardId uint
 			UserAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 			AppStatusHandler:                  disabled.NewAppStatusHandler(),
 			EnableEpochsHandler:               a.enableEpochsHandler,
+			SyncCheckpointHandler:             trie.NewDisabledSyncCheckpointer(),
 		},
 		ShardId:                shardId,
 		Throttler:              thr,
@@ -188,6 +189,7 @@ func (a *accountDBSyncersContainerFactory) createValidatorAccountsSyncer(shardId
 			UserAccountsSyncStatisticsHandler: statistics.NewTrieSyncStatistics(),
 			AppStatusHandler:                  disabled.NewAppStatusHandler(),
 			EnableEpochsHandler:               a.enableEpochsHandler,
+			SyncCheckpointHandler:             trie.NewDisabledSyncCheckpointer(),
 		},
 	}
 	accountSyncer, err := syncer.NewValidatorAccountsSyncer(args)
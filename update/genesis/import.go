@@ -18,6 +18,7 @@ import (
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
 	disabledState "github.com/multiversx/mx-chain-go/state/disabled"
 	"github.com/multiversx/mx-chain-go/state/factory"
 	"github.com/multiversx/mx-chain-go/state/storagePruningManager/disabled"
@@ -376,8 +377,7 @@ func (si *stateImport) importDataTrie(identifier string, shID uint32, keys [][]b
 			err = update.ErrKeyTypeMismatch
 			break
 		}
-		// TODO this will not work for a partially migrated trie
-		err = dataTrie.Update(address, value)
+		err = dataTrie.UpdateWithVersion(address, value, si.getDataTrieLeafVersion(address, value))
 		if err != nil {
 			break
 		}
@@ -404,6 +404,23 @@ func (si *stateImport) importDataTrie(identifier string, shID uint32, keys [][]b
 	return nil
 }
 
+// getDataTrieLeafVersion detects the version of an exported data trie leaf, so partially migrated data tries are
+// imported with the same leaves versions as the original ones
+func (si *stateImport) getDataTrieLeafVersion(trieKey []byte, trieValue []byte) core.TrieNodeVersion {
+	leafData := &dataTrieValue.TrieLeafData{}
+	err := si.marshalizer.Unmarshal(leafData, trieValue)
+	if err != nil {
+		return core.NotSpecified
+	}
+
+	isAutoBalancedLeaf := len(leafData.Key) > 0 && bytes.Equal(si.hasher.Compute(string(leafData.Key)), trieKey)
+	if isAutoBalancedLeaf {
+		return core.AutoBalanceEnabled
+	}
+
+	return core.NotSpecified
+}
+
 func (si *stateImport) getAccountsDB(accType Type, shardID uint32, accountFactory state.AccountFactory) (state.AccountsDBImporter, common.Trie, error) {
 	currentTrie, err := si.getTrie(shardID, accType)
 	if err != nil {
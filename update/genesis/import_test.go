@@ -11,9 +11,11 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/state/dataTrieValue"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
 	"github.com/multiversx/mx-chain-go/update"
 	"github.com/multiversx/mx-chain-go/update/mock"
@@ -150,3 +152,60 @@ func TestStateImport_ImportUnFinishedMetaBlocksShouldWork(t *testing.T) {
 	require.Nil(t, err)
 	assert.Equal(t, importState.importedUnFinishedMetaBlocks[string(metaBlockHash)], metaBlock)
 }
+
+func TestStateImport_GetDataTrieLeafVersion(t *testing.T) {
+	t.Parallel()
+
+	trieStorageManagers := make(map[string]common.StorageManager)
+	trieStorageManagers[dataRetriever.UserAccountsUnit.String()] = &storageManager.StorageManagerStub{}
+
+	hasher := &hashingMocks.HasherMock{}
+	marshaller := &marshallerMock.MarshalizerMock{}
+	args := ArgsNewStateImport{
+		HardforkStorer:      &mock.HardforkStorerStub{},
+		Hasher:              hasher,
+		Marshalizer:         marshaller,
+		TrieStorageManagers: trieStorageManagers,
+		AddressConverter:    &testscommon.PubkeyConverterMock{},
+		EnableEpochsHandler: &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+	}
+	importState, _ := NewStateImport(args)
+
+	key := []byte("key")
+	address := []byte("address")
+
+	t.Run("auto balanced leaf", func(t *testing.T) {
+		t.Parallel()
+
+		leafData := &dataTrieValue.TrieLeafData{
+			Value:   []byte("value"),
+			Key:     key,
+			Address: address,
+		}
+		leafBytes, _ := marshaller.Marshal(leafData)
+
+		version := importState.getDataTrieLeafVersion(hasher.Compute(string(key)), leafBytes)
+		assert.Equal(t, core.AutoBalanceEnabled, version)
+	})
+	t.Run("auto balanced leaf under a different key", func(t *testing.T) {
+		t.Parallel()
+
+		leafData := &dataTrieValue.TrieLeafData{
+			Value:   []byte("value"),
+			Key:     key,
+			Address: address,
+		}
+		leafBytes, _ := marshaller.Marshal(leafData)
+
+		version := importState.getDataTrieLeafVersion(key, leafBytes)
+		assert.Equal(t, core.NotSpecified, version)
+	})
+	t.Run("not specified leaf", func(t *testing.T) {
+		t.Parallel()
+
+		leafBytes := append(append([]byte("value"), key...), address...)
+
+		version := importState.getDataTrieLeafVersion(key, leafBytes)
+		assert.Equal(t, core.NotSpecified, version)
+	})
+}